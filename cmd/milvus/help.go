@@ -59,6 +59,17 @@ milvus mck run [flags]
 	-minioBucketName ''
 		The bucket to operate the data in it
 
+milvus mck checkMeta [flags]
+	Check the datacoord segment meta, index meta and channel checkpoints with each other
+	and with the object storage, report orphan files, missing binlogs, segments of dropped
+	collections, stuck index tasks and row count mismatches.
+	Tips: The flags of 'milvus mck run' can be used as well.
+[flags]
+	-repair 'false'
+		Quarantine the inconsistent meta into the trash, it's a dry run if not set.
+	-orphanGracePeriod '1h'
+		The files modified within the period are not reported as orphans.
+
 milvus mck cleanTrash [flags]
 	Clean the back inconsistent data
 	Tips: The flags is the same as its of the 'milvus mck [flags]'
@@ -61,6 +61,10 @@ type mck struct {
 	minioPassword   string
 	minioUseSSL     string
 	minioBucketName string
+	metaRootPath    string
+
+	repair            bool
+	orphanGracePeriod time.Duration
 
 	flagStartIndex int
 }
@@ -97,6 +101,8 @@ func (c *mck) execute(args []string, flags *flag.FlagSet) {
 	case MckTypeClean:
 		c.cleanTrash()
 		return
+	case MckTypeCheckMeta:
+		c.checkMeta()
 	default:
 		fmt.Fprintln(os.Stderr, mckLine)
 		return
@@ -183,6 +189,7 @@ func (c *mck) run() {
 }
 
 func (c *mck) initParam() {
+	c.params = paramtable.Get()
 	c.taskKeyMap = make(map[int64]string)
 	c.taskNameMap = make(map[int64]string)
 	c.allTaskInfo = make(map[string]string)
@@ -201,8 +208,10 @@ func (c *mck) formatFlags(args []string, flags *flag.FlagSet) {
 	flags.StringVar(&c.minioPassword, "minioPassword", "", "Minio password")
 	flags.StringVar(&c.minioUseSSL, "minioUseSSL", "", "Minio to use ssl")
 	flags.StringVar(&c.minioBucketName, "minioBucketName", "", "Minio bucket name")
+	flags.BoolVar(&c.repair, "repair", false, "Quarantine the inconsistent meta found by checkMeta")
+	flags.DurationVar(&c.orphanGracePeriod, "orphanGracePeriod", time.Hour, "The files modified within the period are not reported as orphans by checkMeta")
 
-	if err := flags.Parse(os.Args[2:]); err != nil {
+	if err := flags.Parse(args[3:]); err != nil {
 		log.Fatal("failed to parse flags", zap.Error(err))
 	}
 	log.Info("args", zap.Strings("args", args))
@@ -230,6 +239,7 @@ func (c *mck) connectEctd() {
 
 	rootPath := getConfigValue(c.ectdRootPath, c.params.EtcdCfg.MetaRootPath.GetValue(), "ectd_root_path")
 	c.etcdKV = etcdkv.NewEtcdKV(etcdCli, rootPath)
+	c.metaRootPath = rootPath
 	log.Info("Etcd root path", zap.String("root_path", rootPath))
 }
 
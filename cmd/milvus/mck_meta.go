@@ -0,0 +1,468 @@
+package milvus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/msgpb"
+	datacoordkv "github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	rootcoordkv "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	MckTypeCheckMeta = "checkMeta"
+
+	issueOrphanFile         = "Orphan Files"
+	issueMissingBinlog      = "Missing Binlogs"
+	issueDroppedCollection  = "Segments Of Dropped Collections"
+	issueRowCountMismatch   = "Row Count Mismatches"
+	issueStuckIndex         = "Stuck Index Tasks"
+	issueMissingIndexFile   = "Missing Index Files"
+	issueOrphanIndexMeta    = "Orphan Segment Indexes"
+	issueStaleChannelCP     = "Stale Channel Checkpoints"
+	metaQuarantineTrashType = "meta"
+)
+
+// metaCheckIssue is an inconsistency found by the meta checker.
+type metaCheckIssue struct {
+	kind   string
+	detail string
+	// metaKeys and metaPrefixes are the meta moved to the trash on repair,
+	// the issue can't be repaired by the checker if both are empty.
+	metaKeys     []string
+	metaPrefixes []string
+}
+
+func (issue *metaCheckIssue) repairable() bool {
+	return len(issue.metaKeys) > 0 || len(issue.metaPrefixes) > 0
+}
+
+// metaChecker cross-checks the datacoord meta with each other and with the object storage.
+type metaChecker struct {
+	collections    map[int64]pb.CollectionState
+	segments       []*datapb.SegmentInfo
+	indexes        []*model.Index
+	segmentIndexes []*model.SegmentIndex
+	channelCPs     map[string]*msgpb.MsgPosition
+	indexNodes     map[int64]struct{}
+	// files are the binlogs and index files in the object storage with their modification time
+	files         map[string]time.Time
+	chunkRootPath string
+
+	// orphan files modified within the grace period may be written by the ongoing flush or index tasks
+	orphanGracePeriod time.Duration
+	now               time.Time
+}
+
+func (c *mck) checkMeta() {
+	c.connectMinio()
+
+	checker, err := c.loadMetaChecker(context.Background())
+	if err != nil {
+		log.Fatal("failed to load meta", zap.Error(err))
+	}
+	issues := checker.check()
+	printMetaCheckIssues(issues)
+	if !c.repair {
+		if len(issues) > 0 {
+			fmt.Println("Dry run, add '-repair' to quarantine the bad meta")
+		}
+		return
+	}
+
+	for _, issue := range issues {
+		if !issue.repairable() {
+			continue
+		}
+		if err := c.quarantineMeta(issue); err != nil {
+			log.Warn("failed to quarantine meta", zap.String("issue", issue.detail), zap.Error(err))
+			redPrint(fmt.Sprintf("Failed to quarantine meta of %s: %s\n", issue.detail, err.Error()))
+			continue
+		}
+		fmt.Printf("Quarantine meta of %s successfully, back path: %s\n", issue.detail, getTrashKey(metaQuarantineTrashType, ""))
+	}
+}
+
+func (c *mck) loadMetaChecker(ctx context.Context) (*metaChecker, error) {
+	checker := &metaChecker{
+		collections:       make(map[int64]pb.CollectionState),
+		indexNodes:        make(map[int64]struct{}),
+		files:             make(map[string]time.Time),
+		chunkRootPath:     c.minioChunkManager.RootPath(),
+		orphanGracePeriod: c.orphanGracePeriod,
+		now:               time.Now(),
+	}
+
+	_, values, err := c.etcdKV.LoadWithPrefix(rootcoordkv.CollectionMetaPrefix + "/")
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range values {
+		collInfo := &pb.CollectionInfo{}
+		// the tombstones of the dropped collections can't be unmarshalled
+		if err := proto.Unmarshal([]byte(value), collInfo); err != nil {
+			continue
+		}
+		checker.collections[collInfo.GetID()] = collInfo.GetState()
+	}
+
+	_, values, err = c.etcdKV.LoadWithPrefix(sessionutil.DefaultServiceRoot)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range values {
+		session := &sessionutil.Session{}
+		if err := json.Unmarshal([]byte(value), session); err != nil {
+			continue
+		}
+		if session.ServerName == typeutil.IndexNodeRole {
+			checker.indexNodes[session.ServerID] = struct{}{}
+		}
+	}
+
+	catalog := datacoordkv.NewCatalog(c.etcdKV, checker.chunkRootPath, c.metaRootPath)
+	if checker.segments, err = catalog.ListSegments(ctx); err != nil {
+		return nil, err
+	}
+	if checker.indexes, err = catalog.ListIndexes(ctx); err != nil {
+		return nil, err
+	}
+	if checker.segmentIndexes, err = catalog.ListSegmentIndexes(ctx); err != nil {
+		return nil, err
+	}
+	if checker.channelCPs, err = catalog.ListChannelCheckpoint(ctx); err != nil {
+		return nil, err
+	}
+
+	for _, subPath := range []string{common.SegmentInsertLogPath, common.SegmentDeltaLogPath, common.SegmentStatslogPath, common.SegmentIndexPath} {
+		files, modTimes, err := c.minioChunkManager.ListWithPrefix(ctx, path.Join(checker.chunkRootPath, subPath)+"/", true)
+		if err != nil {
+			return nil, err
+		}
+		for i, file := range files {
+			checker.files[file] = modTimes[i]
+		}
+	}
+	return checker, nil
+}
+
+func (mc *metaChecker) collectionDropped(collectionID int64) bool {
+	state, ok := mc.collections[collectionID]
+	return !ok || state == pb.CollectionState_CollectionDropping || state == pb.CollectionState_CollectionDropped
+}
+
+func (mc *metaChecker) check() []*metaCheckIssue {
+	var issues []*metaCheckIssue
+	issues = append(issues, mc.checkSegments()...)
+	issues = append(issues, mc.checkSegmentIndexes()...)
+	issues = append(issues, mc.checkChannelCheckpoints()...)
+	issues = append(issues, mc.checkOrphanFiles()...)
+	return issues
+}
+
+func segmentMetaIssue(kind string, segment *datapb.SegmentInfo, detail string) *metaCheckIssue {
+	segmentPath := metautil.JoinIDPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID())
+	return &metaCheckIssue{
+		kind:     kind,
+		detail:   fmt.Sprintf("segment %d of collection %d, %s", segment.GetID(), segment.GetCollectionID(), detail),
+		metaKeys: []string{path.Join(datacoordkv.SegmentPrefix, segmentPath)},
+		metaPrefixes: []string{
+			path.Join(datacoordkv.SegmentBinlogPathPrefix, segmentPath) + "/",
+			path.Join(datacoordkv.SegmentDeltalogPathPrefix, segmentPath) + "/",
+			path.Join(datacoordkv.SegmentStatslogPathPrefix, segmentPath) + "/",
+		},
+	}
+}
+
+func (mc *metaChecker) checkSegments() []*metaCheckIssue {
+	var issues []*metaCheckIssue
+	for _, segment := range mc.segments {
+		// the dropped segments are waiting for the garbage collection
+		if segment.GetState() == commonpb.SegmentState_Dropped {
+			continue
+		}
+		if mc.collectionDropped(segment.GetCollectionID()) {
+			issues = append(issues, segmentMetaIssue(issueDroppedCollection, segment, fmt.Sprintf("state %s", segment.GetState().String())))
+			continue
+		}
+
+		var missing []string
+		for _, fieldBinlogs := range [][]*datapb.FieldBinlog{segment.GetBinlogs(), segment.GetDeltalogs(), segment.GetStatslogs()} {
+			for _, fieldBinlog := range fieldBinlogs {
+				for _, binlog := range fieldBinlog.GetBinlogs() {
+					if _, ok := mc.files[binlog.GetLogPath()]; !ok {
+						missing = append(missing, binlog.GetLogPath())
+					}
+				}
+			}
+		}
+		if len(missing) > 0 {
+			issues = append(issues, segmentMetaIssue(issueMissingBinlog, segment, strings.Join(missing, ", ")))
+		}
+
+		if segment.GetState() == commonpb.SegmentState_Flushed {
+			issues = append(issues, mc.checkRowCount(segment)...)
+		}
+	}
+	return issues
+}
+
+// checkRowCount checks the row count of the segment with the entries num of its insert binlogs and statslogs.
+func (mc *metaChecker) checkRowCount(segment *datapb.SegmentInfo) []*metaCheckIssue {
+	var issues []*metaCheckIssue
+	report := func(logType string, fieldID int64, entriesNum int64) {
+		issues = append(issues, &metaCheckIssue{
+			kind: issueRowCountMismatch,
+			detail: fmt.Sprintf("segment %d of collection %d has %d rows, but the %s of field %d have %d entries",
+				segment.GetID(), segment.GetCollectionID(), segment.GetNumOfRows(), logType, fieldID, entriesNum),
+		})
+	}
+
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		var entriesNum int64
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			entriesNum += binlog.GetEntriesNum()
+		}
+		if entriesNum != segment.GetNumOfRows() {
+			report("binlogs", fieldBinlog.GetFieldID(), entriesNum)
+		}
+	}
+	for _, fieldBinlog := range segment.GetStatslogs() {
+		var entriesNum int64
+		counted := true
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			// the statslogs written by flush don't record the entries num
+			if binlog.GetEntriesNum() == 0 {
+				counted = false
+				break
+			}
+			entriesNum += binlog.GetEntriesNum()
+		}
+		if counted && len(fieldBinlog.GetBinlogs()) > 0 && entriesNum != segment.GetNumOfRows() {
+			report("statslogs", fieldBinlog.GetFieldID(), entriesNum)
+		}
+	}
+	return issues
+}
+
+func (mc *metaChecker) checkSegmentIndexes() []*metaCheckIssue {
+	segments := make(map[int64]*datapb.SegmentInfo, len(mc.segments))
+	for _, segment := range mc.segments {
+		segments[segment.GetID()] = segment
+	}
+	indexes := make(map[int64]*model.Index, len(mc.indexes))
+	for _, index := range mc.indexes {
+		indexes[index.IndexID] = index
+	}
+
+	var issues []*metaCheckIssue
+	for _, segIdx := range mc.segmentIndexes {
+		if segIdx.IsDeleted {
+			continue
+		}
+		newIssue := func(kind string, detail string) *metaCheckIssue {
+			return &metaCheckIssue{
+				kind: kind,
+				detail: fmt.Sprintf("build %d of segment %d, index %d, %s",
+					segIdx.BuildID, segIdx.SegmentID, segIdx.IndexID, detail),
+				metaKeys: []string{datacoordkv.BuildSegmentIndexKey(segIdx.CollectionID, segIdx.PartitionID, segIdx.SegmentID, segIdx.BuildID)},
+			}
+		}
+
+		segment, ok := segments[segIdx.SegmentID]
+		if !ok {
+			issues = append(issues, newIssue(issueOrphanIndexMeta, "segment not found"))
+			continue
+		}
+		segmentDropped := segment.GetState() == commonpb.SegmentState_Dropped
+		index, ok := indexes[segIdx.IndexID]
+		indexDropped := !ok || index.IsDeleted
+
+		switch segIdx.IndexState {
+		case commonpb.IndexState_Unissued, commonpb.IndexState_InProgress:
+			if segmentDropped || indexDropped {
+				issues = append(issues, newIssue(issueStuckIndex, fmt.Sprintf("state %s, segment or index is dropped", segIdx.IndexState.String())))
+				continue
+			}
+			if _, ok := mc.indexNodes[segIdx.NodeID]; segIdx.IndexState == commonpb.IndexState_InProgress && !ok {
+				issues = append(issues, newIssue(issueStuckIndex, fmt.Sprintf("state %s, indexnode %d is offline", segIdx.IndexState.String(), segIdx.NodeID)))
+			}
+		case commonpb.IndexState_Finished:
+			if segmentDropped || indexDropped {
+				continue
+			}
+			var missing []string
+			for _, filePath := range metautil.BuildSegmentIndexFilePaths(mc.chunkRootPath, segIdx.BuildID, segIdx.IndexVersion,
+				segIdx.PartitionID, segIdx.SegmentID, segIdx.IndexFileKeys) {
+				if _, ok := mc.files[filePath]; !ok {
+					missing = append(missing, filePath)
+				}
+			}
+			if len(missing) > 0 {
+				issues = append(issues, newIssue(issueMissingIndexFile, strings.Join(missing, ", ")))
+			}
+		}
+	}
+	return issues
+}
+
+func (mc *metaChecker) checkChannelCheckpoints() []*metaCheckIssue {
+	// the checkpoints of the channels without segments are not checked
+	channelCollections := make(map[string]int64)
+	for _, segment := range mc.segments {
+		channelCollections[segment.GetInsertChannel()] = segment.GetCollectionID()
+	}
+
+	var issues []*metaCheckIssue
+	for channel, position := range mc.channelCPs {
+		key := path.Join(datacoordkv.ChannelCheckpointPrefix, channel)
+		collectionID, ok := channelCollections[channel]
+		switch {
+		case position.GetTimestamp() == 0:
+			issues = append(issues, &metaCheckIssue{
+				kind:     issueStaleChannelCP,
+				detail:   fmt.Sprintf("channel %s, checkpoint without timestamp", channel),
+				metaKeys: []string{key},
+			})
+		case ok && mc.collectionDropped(collectionID):
+			issues = append(issues, &metaCheckIssue{
+				kind:     issueStaleChannelCP,
+				detail:   fmt.Sprintf("channel %s, collection %d is dropped", channel, collectionID),
+				metaKeys: []string{key},
+			})
+		}
+	}
+	sort.Slice(issues, func(i, j int) bool { return issues[i].detail < issues[j].detail })
+	return issues
+}
+
+// checkOrphanFiles finds the binlogs and index files which are not referenced by any meta.
+func (mc *metaChecker) checkOrphanFiles() []*metaCheckIssue {
+	referenced := make(map[string]struct{})
+	for _, segment := range mc.segments {
+		for _, fieldBinlogs := range [][]*datapb.FieldBinlog{segment.GetBinlogs(), segment.GetDeltalogs(), segment.GetStatslogs()} {
+			for _, fieldBinlog := range fieldBinlogs {
+				for _, binlog := range fieldBinlog.GetBinlogs() {
+					referenced[binlog.GetLogPath()] = struct{}{}
+				}
+			}
+		}
+	}
+	builds := make(map[int64]struct{}, len(mc.segmentIndexes))
+	for _, segIdx := range mc.segmentIndexes {
+		builds[segIdx.BuildID] = struct{}{}
+	}
+
+	indexPrefix := path.Join(mc.chunkRootPath, common.SegmentIndexPath) + "/"
+	var orphans []string
+	for file, modTime := range mc.files {
+		if mc.now.Sub(modTime) < mc.orphanGracePeriod {
+			continue
+		}
+		if strings.HasPrefix(file, indexPrefix) {
+			// index_files/{buildID}/{indexVersion}/{partitionID}/{segmentID}/{fileKey}
+			buildID, err := strconv.ParseInt(strings.Split(strings.TrimPrefix(file, indexPrefix), "/")[0], 10, 64)
+			if err != nil {
+				continue
+			}
+			if _, ok := builds[buildID]; !ok {
+				orphans = append(orphans, file)
+			}
+			continue
+		}
+		if _, ok := referenced[file]; !ok {
+			orphans = append(orphans, file)
+		}
+	}
+	sort.Strings(orphans)
+
+	issues := make([]*metaCheckIssue, 0, len(orphans))
+	for _, file := range orphans {
+		issues = append(issues, &metaCheckIssue{kind: issueOrphanFile, detail: file})
+	}
+	return issues
+}
+
+func printMetaCheckIssues(issues []*metaCheckIssue) {
+	if len(issues) == 0 {
+		line()
+		fmt.Println("No inconsistent meta found")
+		return
+	}
+
+	kinds := []string{issueDroppedCollection, issueMissingBinlog, issueRowCountMismatch, issueStuckIndex,
+		issueMissingIndexFile, issueOrphanIndexMeta, issueStaleChannelCP, issueOrphanFile}
+	for _, kind := range kinds {
+		var details []string
+		for _, issue := range issues {
+			if issue.kind == kind {
+				details = append(details, issue.detail)
+			}
+		}
+		if len(details) == 0 {
+			continue
+		}
+		line()
+		fmt.Printf("%s (%d)\n", kind, len(details))
+		for _, detail := range details {
+			fmt.Printf("\t%s\n", detail)
+		}
+	}
+}
+
+// quarantineMeta backs up the meta of the issue into the trash and removes it.
+func (c *mck) quarantineMeta(issue *metaCheckIssue) error {
+	kvs := make(map[string]string)
+	for _, key := range issue.metaKeys {
+		value, err := c.etcdKV.Load(key)
+		if err != nil {
+			if common.IsKeyNotExistError(err) {
+				continue
+			}
+			return err
+		}
+		kvs[key] = value
+	}
+	for _, prefix := range issue.metaPrefixes {
+		keys, values, err := c.etcdKV.LoadWithPrefix(prefix)
+		if err != nil {
+			return err
+		}
+		for i, key := range keys {
+			// the loaded keys are prefixed with the root path
+			kvs[strings.TrimPrefix(key, c.etcdKV.GetPath("")+"/")] = values[i]
+		}
+	}
+	if len(kvs) == 0 {
+		return nil
+	}
+
+	backups := make(map[string]string, len(kvs))
+	removals := make([]string, 0, len(kvs))
+	for key, value := range kvs {
+		backups[getTrashKey(metaQuarantineTrashType, key)] = value
+		removals = append(removals, key)
+	}
+	if err := c.etcdKV.MultiSave(backups); err != nil {
+		return err
+	}
+	return c.etcdKV.MultiRemove(removals)
+}
@@ -0,0 +1,132 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/msgpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+)
+
+func TestMetaChecker(t *testing.T) {
+	now := time.Now()
+	old := now.Add(-2 * time.Hour)
+	root := "files"
+	insertLog := func(segmentID, logID int64) string {
+		return metautil.BuildInsertLogPath(root, 1, 10, segmentID, 100, logID)
+	}
+	segment := func(id, collectionID int64, state commonpb.SegmentState, numRows int64, logIDs ...int64) *datapb.SegmentInfo {
+		binlogs := make([]*datapb.Binlog, 0, len(logIDs))
+		for _, logID := range logIDs {
+			binlogs = append(binlogs, &datapb.Binlog{LogPath: insertLog(id, logID), EntriesNum: 10})
+		}
+		return &datapb.SegmentInfo{
+			ID:            id,
+			CollectionID:  collectionID,
+			PartitionID:   10,
+			InsertChannel: "ch1",
+			State:         state,
+			NumOfRows:     numRows,
+			Binlogs:       []*datapb.FieldBinlog{{FieldID: 100, Binlogs: binlogs}},
+		}
+	}
+
+	checker := &metaChecker{
+		collections: map[int64]pb.CollectionState{
+			1: pb.CollectionState_CollectionCreated,
+			2: pb.CollectionState_CollectionDropped,
+		},
+		segments: []*datapb.SegmentInfo{
+			// healthy
+			segment(1000, 1, commonpb.SegmentState_Flushed, 20, 1, 2),
+			// missing binlog 4
+			segment(1001, 1, commonpb.SegmentState_Flushed, 20, 3, 4),
+			// row count mismatch
+			segment(1002, 1, commonpb.SegmentState_Flushed, 30, 5, 6),
+			// collection dropped
+			segment(1003, 2, commonpb.SegmentState_Flushed, 10),
+			// dropped segment is skipped
+			segment(1004, 1, commonpb.SegmentState_Dropped, 10, 7),
+		},
+		indexes: []*model.Index{{CollectionID: 1, IndexID: 1}},
+		segmentIndexes: []*model.SegmentIndex{
+			{SegmentID: 1000, CollectionID: 1, PartitionID: 10, IndexID: 1, BuildID: 1, NodeID: 1, IndexState: commonpb.IndexState_InProgress},
+			{SegmentID: 1001, CollectionID: 1, PartitionID: 10, IndexID: 1, BuildID: 2, NodeID: 2, IndexState: commonpb.IndexState_InProgress},
+			{SegmentID: 1002, CollectionID: 1, PartitionID: 10, IndexID: 1, BuildID: 3, IndexState: commonpb.IndexState_Finished,
+				IndexVersion: 1, IndexFileKeys: []string{"index"}},
+			{SegmentID: 9999, CollectionID: 1, PartitionID: 10, IndexID: 1, BuildID: 4, IndexState: commonpb.IndexState_Finished},
+			{SegmentID: 1004, CollectionID: 1, PartitionID: 10, IndexID: 1, BuildID: 5, IndexState: commonpb.IndexState_Unissued},
+		},
+		channelCPs: map[string]*msgpb.MsgPosition{
+			"ch1": {Timestamp: 100},
+			"ch2": {Timestamp: 0},
+		},
+		indexNodes: map[int64]struct{}{1: {}},
+		files: map[string]time.Time{
+			insertLog(1000, 1): old,
+			insertLog(1000, 2): old,
+			insertLog(1001, 3): old,
+			insertLog(1002, 5): old,
+			insertLog(1002, 6): old,
+			// orphan
+			insertLog(1005, 8): old,
+			// within the grace period
+			insertLog(1005, 9): now,
+			metautil.BuildSegmentIndexFilePath(root, 3, 1, 10, 1002, "index"): old,
+			metautil.BuildSegmentIndexFilePath(root, 6, 1, 10, 1002, "index"): old,
+		},
+		chunkRootPath:     root,
+		orphanGracePeriod: time.Hour,
+		now:               now,
+	}
+
+	issues := checker.check()
+	kinds := make(map[string]int)
+	for _, issue := range issues {
+		kinds[issue.kind]++
+	}
+	assert.Equal(t, map[string]int{
+		issueMissingBinlog:     1,
+		issueRowCountMismatch:  1,
+		issueDroppedCollection: 1,
+		issueStuckIndex:        2,
+		issueOrphanIndexMeta:   1,
+		issueStaleChannelCP:    1,
+		issueOrphanFile:        2,
+	}, kinds)
+
+	for _, issue := range issues {
+		switch issue.kind {
+		case issueMissingBinlog:
+			assert.Contains(t, issue.detail, insertLog(1001, 4))
+			assert.True(t, issue.repairable())
+			assert.Equal(t, []string{"datacoord-meta/s/1/10/1001"}, issue.metaKeys)
+		case issueRowCountMismatch, issueOrphanFile:
+			assert.False(t, issue.repairable())
+		case issueStaleChannelCP:
+			assert.Equal(t, []string{"datacoord-meta/channel-cp/ch2"}, issue.metaKeys)
+		}
+	}
+}
@@ -31,13 +31,16 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/cmd/components"
+	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 	"github.com/milvus-io/milvus/internal/http"
 	"github.com/milvus-io/milvus/internal/http/healthz"
+	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
 	rocksmqimpl "github.com/milvus-io/milvus/internal/mq/mqimpl/rocksmq/server"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/tracer"
+	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/logutil"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
@@ -202,6 +205,41 @@ func setupPrometheusHTTPServer(r *metrics.MilvusRegistry) {
 	})
 }
 
+// setupConfigHTTPServer serves the dynamic config admin API if enabled,
+// requests are authenticated against the credentials in rootcoord.
+func setupConfigHTTPServer(ctx context.Context) {
+	params := paramtable.Get()
+	if !params.CommonCfg.ConfigAPIEnabled.GetAsBool() {
+		return
+	}
+	etcdCli, err := etcd.GetEtcdClient(
+		params.EtcdCfg.UseEmbedEtcd.GetAsBool(),
+		params.EtcdCfg.EtcdUseSSL.GetAsBool(),
+		params.EtcdCfg.Endpoints.GetAsStrings(),
+		params.EtcdCfg.EtcdTLSCert.GetValue(),
+		params.EtcdCfg.EtcdTLSKey.GetValue(),
+		params.EtcdCfg.EtcdTLSCACert.GetValue(),
+		params.EtcdCfg.EtcdTLSMinVersion.GetValue())
+	if err != nil {
+		log.Warn("failed to serve config http server, connect etcd failed", zap.Error(err))
+		return
+	}
+	rc, err := rcc.NewClient(ctx, params.EtcdCfg.MetaRootPath.GetValue(), etcdCli)
+	if err != nil {
+		log.Warn("failed to serve config http server, create rootcoord client failed", zap.Error(err))
+		return
+	}
+	verify := http.NewRootCoordCredentialVerifier(rc)
+	http.Register(&http.Handler{
+		Path:        http.ConfigRouterPath,
+		HandlerFunc: http.ConfigHandler(verify),
+	})
+	http.Register(&http.Handler{
+		Path:        http.ConfigAuditRouterPath,
+		HandlerFunc: http.ConfigAuditHandler(verify),
+	})
+}
+
 // Run Milvus components.
 func (mr *MilvusRoles) Run(local bool, alias string) {
 	log.Info("starting running Milvus components")
@@ -233,6 +271,13 @@ func (mr *MilvusRoles) Run(local bool, alias string) {
 			defer stopRocksmq()
 		}
 
+		if params.MetaStoreCfg.SessionType.GetValue() == util.MetaStoreTypeRocksdb {
+			if err := rocksdbkv.InitSharedMetaKV(params.MetaStoreCfg.RocksdbPath.GetValue()); err != nil {
+				panic(err)
+			}
+			defer rocksdbkv.CloseSharedMetaKV()
+		}
+
 		if params.EtcdCfg.UseEmbedEtcd.GetAsBool() {
 			// Start etcd server.
 			etcd.InitEtcdServer(
@@ -249,9 +294,15 @@ func (mr *MilvusRoles) Run(local bool, alias string) {
 			log.Error("Failed to set deploy mode: ", zap.Error(err))
 		}
 		paramtable.Init()
+		if paramtable.Get().MetaStoreCfg.SessionType.GetValue() == util.MetaStoreTypeRocksdb {
+			panic("the rocksdb of sessions is only supported by standalone")
+		}
 	}
 
 	http.ServeHTTP()
+	if mr.EnableProxy || mr.EnableRootCoord || mr.EnableQueryCoord || mr.EnableDataCoord || mr.EnableIndexCoord {
+		setupConfigHTTPServer(ctx)
+	}
 
 	var rc *components.RootCoord
 	var wg sync.WaitGroup
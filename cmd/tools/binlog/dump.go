@@ -0,0 +1,339 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+type dumpOptions struct {
+	source     segmentSource
+	format     string
+	output     string
+	pkField    int64
+	pks        string
+	tsFrom     uint64
+	tsTo       uint64
+	deltaFiles string
+	noDelete   bool
+}
+
+func dump(args []string) error {
+	opts := &dumpOptions{}
+	fs := flag.NewFlagSet("dump", flag.ExitOnError)
+	opts.source.bindFlags(fs)
+	fs.StringVar(&opts.format, "format", "json", "Output format, json or csv")
+	fs.StringVar(&opts.output, "output", "", "Output file, stdout if not set")
+	fs.Int64Var(&opts.pkField, "pk-field", 0, "Primary key field ID, the first int64 or varchar user field if not set")
+	fs.StringVar(&opts.pks, "pk", "", "Comma separated primary keys to filter with")
+	fs.Uint64Var(&opts.tsFrom, "ts-from", 0, "Dump the rows with timestamp not less than it")
+	fs.Uint64Var(&opts.tsTo, "ts-to", 0, "Dump the rows with timestamp not larger than it")
+	fs.StringVar(&opts.deltaFiles, "delta", "", "Comma separated local delta log files to apply")
+	fs.BoolVar(&opts.noDelete, "no-delete", false, "Dump the deleted rows as well")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if opts.format != "json" && opts.format != "csv" {
+		return fmt.Errorf("unknown format %s", opts.format)
+	}
+
+	var insertBlobs, deltaBlobs []*storage.Blob
+	var err error
+	if opts.source.enabled() {
+		insertBlobs, deltaBlobs, err = opts.source.load(context.Background())
+	} else {
+		if fs.NArg() == 0 {
+			return fmt.Errorf("no binlog file specified")
+		}
+		insertBlobs, err = loadFiles(fs.Args())
+		if err == nil && len(opts.deltaFiles) > 0 {
+			deltaBlobs, err = loadFiles(strings.Split(opts.deltaFiles, ","))
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	_, _, _, insertData, err := storage.NewInsertCodec().DeserializeAll(insertBlobs)
+	if err != nil {
+		return err
+	}
+	var deleteData *storage.DeleteData
+	if len(deltaBlobs) > 0 && !opts.noDelete {
+		_, _, deleteData, err = storage.NewDeleteCodec().Deserialize(deltaBlobs)
+		if err != nil {
+			return err
+		}
+	}
+
+	out := os.Stdout
+	if len(opts.output) > 0 {
+		out, err = os.Create(opts.output)
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+	}
+	return dumpInsertData(out, insertData, deleteData, opts)
+}
+
+// segmentRows joins the field data of a segment by row.
+type segmentRows struct {
+	data     *storage.InsertData
+	fieldIDs []int64
+	rowNum   int
+	pkField  int64
+	pkType   schemapb.DataType
+}
+
+func newSegmentRows(data *storage.InsertData, pkField int64) (*segmentRows, error) {
+	tsData, ok := data.Data[common.TimeStampField]
+	if !ok {
+		return nil, fmt.Errorf("timestamp field not found")
+	}
+	rows := &segmentRows{
+		data:     data,
+		fieldIDs: make([]int64, 0, len(data.Data)),
+		rowNum:   tsData.RowNum(),
+	}
+	for fieldID, fieldData := range data.Data {
+		if fieldData.RowNum() != rows.rowNum {
+			return nil, fmt.Errorf("field %d has %d rows, but timestamp field has %d rows", fieldID, fieldData.RowNum(), rows.rowNum)
+		}
+		rows.fieldIDs = append(rows.fieldIDs, fieldID)
+	}
+	sort.Slice(rows.fieldIDs, func(i, j int) bool { return rows.fieldIDs[i] < rows.fieldIDs[j] })
+
+	for _, fieldID := range rows.fieldIDs {
+		if pkField != 0 && fieldID != pkField {
+			continue
+		}
+		if pkField == 0 && fieldID < common.StartOfUserFieldID {
+			continue
+		}
+		switch data.Data[fieldID].(type) {
+		case *storage.Int64FieldData:
+			rows.pkField, rows.pkType = fieldID, schemapb.DataType_Int64
+		case *storage.StringFieldData:
+			rows.pkField, rows.pkType = fieldID, schemapb.DataType_VarChar
+		default:
+			if pkField != 0 {
+				return nil, fmt.Errorf("field %d can't be primary key", pkField)
+			}
+			continue
+		}
+		break
+	}
+	if pkField != 0 && rows.pkField == 0 {
+		return nil, fmt.Errorf("primary key field %d not found", pkField)
+	}
+	return rows, nil
+}
+
+func (rows *segmentRows) timestamp(i int) uint64 {
+	return uint64(rows.data.Data[common.TimeStampField].GetRow(i).(int64))
+}
+
+func (rows *segmentRows) pk(i int) (storage.PrimaryKey, error) {
+	if rows.pkField == 0 {
+		return nil, fmt.Errorf("primary key field not found")
+	}
+	return storage.GenPrimaryKeyByRawData(rows.data.Data[rows.pkField].GetRow(i), rows.pkType)
+}
+
+func (rows *segmentRows) parsePks(values string) ([]storage.PrimaryKey, error) {
+	pks := make([]storage.PrimaryKey, 0)
+	for _, value := range strings.Split(values, ",") {
+		switch rows.pkType {
+		case schemapb.DataType_Int64:
+			v, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			pks = append(pks, storage.NewInt64PrimaryKey(v))
+		case schemapb.DataType_VarChar:
+			pks = append(pks, storage.NewVarCharPrimaryKey(value))
+		default:
+			return nil, fmt.Errorf("primary key field not found")
+		}
+	}
+	return pks, nil
+}
+
+// value converts the field value to the one which could be marshaled into json.
+func (rows *segmentRows) value(fieldID int64, i int) interface{} {
+	switch fieldData := rows.data.Data[fieldID].(type) {
+	case *storage.JSONFieldData:
+		if json.Valid(fieldData.Data[i]) {
+			return json.RawMessage(fieldData.Data[i])
+		}
+		return string(fieldData.Data[i])
+	case *storage.BinaryVectorFieldData:
+		bytes := fieldData.GetRow(i).([]byte)
+		ret := make([]int, 0, len(bytes))
+		for _, b := range bytes {
+			ret = append(ret, int(b))
+		}
+		return ret
+	default:
+		return fieldData.GetRow(i)
+	}
+}
+
+// deletedAt returns the max delete timestamp of each primary key.
+func deletedAt(deleteData *storage.DeleteData) map[interface{}]uint64 {
+	ret := make(map[interface{}]uint64)
+	if deleteData == nil {
+		return ret
+	}
+	for i, pk := range deleteData.Pks {
+		if deleteData.Tss[i] > ret[pk.GetValue()] {
+			ret[pk.GetValue()] = deleteData.Tss[i]
+		}
+	}
+	return ret
+}
+
+func dumpInsertData(out io.Writer, data *storage.InsertData, deleteData *storage.DeleteData, opts *dumpOptions) error {
+	rows, err := newSegmentRows(data, opts.pkField)
+	if err != nil {
+		return err
+	}
+
+	var filterPks map[interface{}]struct{}
+	if len(opts.pks) > 0 {
+		pks, err := rows.parsePks(opts.pks)
+		if err != nil {
+			return err
+		}
+		filterPks = make(map[interface{}]struct{}, len(pks))
+		for _, pk := range pks {
+			filterPks[pk.GetValue()] = struct{}{}
+		}
+	}
+	deleted := deletedAt(deleteData)
+	if len(deleted) > 0 && rows.pkField == 0 {
+		return fmt.Errorf("primary key field not found, could not apply delta logs")
+	}
+
+	writer := newRowWriter(out, opts.format, rows.fieldIDs)
+	for i := 0; i < rows.rowNum; i++ {
+		ts := rows.timestamp(i)
+		if ts < opts.tsFrom || (opts.tsTo != 0 && ts > opts.tsTo) {
+			continue
+		}
+		if filterPks != nil || len(deleted) > 0 {
+			pk, err := rows.pk(i)
+			if err != nil {
+				return err
+			}
+			if _, ok := filterPks[pk.GetValue()]; filterPks != nil && !ok {
+				continue
+			}
+			if deleteTs, ok := deleted[pk.GetValue()]; ok && deleteTs > ts {
+				continue
+			}
+		}
+
+		values := make([]interface{}, 0, len(rows.fieldIDs))
+		for _, fieldID := range rows.fieldIDs {
+			values = append(values, rows.value(fieldID, i))
+		}
+		if err := writer.write(values); err != nil {
+			return err
+		}
+	}
+	return writer.flush()
+}
+
+// rowWriter writes the rows as json lines or csv records.
+type rowWriter struct {
+	format   string
+	fieldIDs []int64
+	encoder  *json.Encoder
+	csv      *csv.Writer
+	header   bool
+}
+
+func newRowWriter(out io.Writer, format string, fieldIDs []int64) *rowWriter {
+	return &rowWriter{
+		format:   format,
+		fieldIDs: fieldIDs,
+		encoder:  json.NewEncoder(out),
+		csv:      csv.NewWriter(out),
+	}
+}
+
+func (w *rowWriter) write(values []interface{}) error {
+	if w.format == "json" {
+		row := make(map[string]interface{}, len(values))
+		for i, fieldID := range w.fieldIDs {
+			row[strconv.FormatInt(fieldID, 10)] = values[i]
+		}
+		return w.encoder.Encode(row)
+	}
+
+	if !w.header {
+		header := make([]string, 0, len(w.fieldIDs))
+		for _, fieldID := range w.fieldIDs {
+			header = append(header, strconv.FormatInt(fieldID, 10))
+		}
+		if err := w.csv.Write(header); err != nil {
+			return err
+		}
+		w.header = true
+	}
+	record := make([]string, 0, len(values))
+	for _, value := range values {
+		switch v := value.(type) {
+		case string:
+			record = append(record, v)
+		case json.RawMessage:
+			record = append(record, string(v))
+		case bool, int8, int16, int32, int64, float32, float64:
+			record = append(record, fmt.Sprint(v))
+		default:
+			bs, err := json.Marshal(v)
+			if err != nil {
+				return err
+			}
+			record = append(record, string(bs))
+		}
+	}
+	return w.csv.Write(record)
+}
+
+func (w *rowWriter) flush() error {
+	if w.format == "csv" {
+		w.csv.Flush()
+		return w.csv.Error()
+	}
+	return nil
+}
@@ -23,13 +23,45 @@ import (
 	"github.com/milvus-io/milvus/internal/storage"
 )
 
+const usage = `usage:
+  binlog [print] file1 file2 ...           print the events of binlog files
+  binlog dump [flags] [file1 file2 ...]    dump the rows of a segment as json or csv
+  binlog verify [flags] [file1 file2 ...]  verify the binlog files of a segment
+
+Files are read from the local file system, or from the configured object storage
+when -segment is specified. Run "binlog <command> -h" for the flags.`
+
 func main() {
 	if len(os.Args) == 1 {
-		fmt.Println("usage: binlog file1 file2 ...")
+		fmt.Println(usage)
+		return
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "dump":
+		err = dump(os.Args[2:])
+	case "verify":
+		err = verify(os.Args[2:])
+	case "print":
+		err = printFiles(os.Args[2:])
+	case "-h", "-help", "--help", "help":
+		fmt.Println(usage)
+		return
+	default:
+		// keep compatible with "binlog file1 file2 ..."
+		err = printFiles(os.Args[1:])
 	}
-	if err := storage.PrintBinlogFiles(os.Args[1:]); err != nil {
+	if err != nil {
 		fmt.Printf("error: %s\n", err.Error())
-	} else {
-		fmt.Printf("print binlog complete.\n")
+		os.Exit(1)
+	}
+}
+
+func printFiles(files []string) error {
+	if err := storage.PrintBinlogFiles(files); err != nil {
+		return err
 	}
+	fmt.Printf("print binlog complete.\n")
+	return nil
 }
@@ -0,0 +1,103 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// segmentSource locates the binlogs of a segment in the object storage configured by milvus.yaml.
+type segmentSource struct {
+	collectionID int64
+	partitionID  int64
+	segmentID    int64
+}
+
+func (s *segmentSource) bindFlags(fs *flag.FlagSet) {
+	fs.Int64Var(&s.collectionID, "collection", 0, "Collection ID of the segment to read from object storage")
+	fs.Int64Var(&s.partitionID, "partition", 0, "Partition ID of the segment to read from object storage")
+	fs.Int64Var(&s.segmentID, "segment", 0, "Segment ID to read from object storage, the local files are read if not set")
+}
+
+func (s *segmentSource) enabled() bool {
+	return s.segmentID != 0
+}
+
+// load reads the insert binlogs and delta logs of the segment from the object storage.
+func (s *segmentSource) load(ctx context.Context) ([]*storage.Blob, []*storage.Blob, error) {
+	if s.collectionID == 0 || s.partitionID == 0 {
+		return nil, nil, fmt.Errorf("-collection and -partition are required with -segment")
+	}
+
+	paramtable.Init()
+	cm, err := storage.NewChunkManagerFactoryWithParam(paramtable.Get()).NewPersistentStorageChunkManager(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	segmentPath := path.Join(strconv.FormatInt(s.collectionID, 10), strconv.FormatInt(s.partitionID, 10), strconv.FormatInt(s.segmentID, 10))
+	insertBlobs, err := loadWithPrefix(ctx, cm, path.Join(cm.RootPath(), common.SegmentInsertLogPath, segmentPath))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(insertBlobs) == 0 {
+		return nil, nil, fmt.Errorf("no insert binlog found for segment %d", s.segmentID)
+	}
+	deltaBlobs, err := loadWithPrefix(ctx, cm, path.Join(cm.RootPath(), common.SegmentDeltaLogPath, segmentPath))
+	if err != nil {
+		return nil, nil, err
+	}
+	return insertBlobs, deltaBlobs, nil
+}
+
+func loadWithPrefix(ctx context.Context, cm storage.ChunkManager, prefix string) ([]*storage.Blob, error) {
+	keys, _, err := cm.ListWithPrefix(ctx, prefix+"/", true)
+	if err != nil {
+		return nil, err
+	}
+	values, err := cm.MultiRead(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	blobs := make([]*storage.Blob, 0, len(keys))
+	for i := range keys {
+		blobs = append(blobs, &storage.Blob{Key: keys[i], Value: values[i]})
+	}
+	return blobs, nil
+}
+
+// loadFiles reads the local binlog files.
+func loadFiles(files []string) ([]*storage.Blob, error) {
+	blobs := make([]*storage.Blob, 0, len(files))
+	for _, file := range files {
+		value, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		blobs = append(blobs, &storage.Blob{Key: file, Value: value})
+	}
+	return blobs, nil
+}
@@ -0,0 +1,115 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func verify(args []string) error {
+	source := &segmentSource{}
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	source.bindFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var insertBlobs, deltaBlobs []*storage.Blob
+	var err error
+	if source.enabled() {
+		insertBlobs, deltaBlobs, err = source.load(context.Background())
+	} else {
+		if fs.NArg() == 0 {
+			return fmt.Errorf("no binlog file specified")
+		}
+		insertBlobs, err = loadFiles(fs.Args())
+	}
+	if err != nil {
+		return err
+	}
+
+	problems := verifyBlobs(insertBlobs, source.enabled())
+	problems += verifyBlobs(deltaBlobs, false)
+	if problems > 0 {
+		return fmt.Errorf("%d problems found", problems)
+	}
+	fmt.Printf("verify binlog complete, no problem found.\n")
+	return nil
+}
+
+// verifyBlobs verifies each binlog and the consistency between them, returns the number of problems.
+// The field ID in the path is checked when the blobs are read from object storage.
+func verifyBlobs(blobs []*storage.Blob, checkPath bool) int {
+	problems := 0
+	report := func(key string, format string, args ...interface{}) {
+		fmt.Printf("%s: %s\n", key, fmt.Sprintf(format, args...))
+		problems++
+	}
+
+	var first *storage.BinlogVerifyResult
+	fieldRows := make(map[storage.FieldID]int)
+	for _, blob := range blobs {
+		result, err := storage.VerifyBinlog(blob.Value)
+		if err != nil {
+			report(blob.Key, "failed to read binlog, %s", err.Error())
+			continue
+		}
+		fmt.Printf("%s: collection %d, partition %d, segment %d, field %d, type %s, events %d, rows %d, ts [%d, %d]\n",
+			blob.Key, result.CollectionID, result.PartitionID, result.SegmentID, result.FieldID, result.EventType.String(),
+			result.EventNum, result.RowNum, result.StartTimestamp, result.EndTimestamp)
+		for _, problem := range result.Problems {
+			report(blob.Key, "%s", problem)
+		}
+
+		if first == nil {
+			first = result
+		} else if result.CollectionID != first.CollectionID || result.PartitionID != first.PartitionID || result.SegmentID != first.SegmentID {
+			report(blob.Key, "belongs to collection %d, partition %d, segment %d, but the first binlog belongs to collection %d, partition %d, segment %d",
+				result.CollectionID, result.PartitionID, result.SegmentID, first.CollectionID, first.PartitionID, first.SegmentID)
+		}
+		if checkPath {
+			// insert_log/{collection}/{partition}/{segment}/{field}/{log}
+			fieldID, err := strconv.ParseInt(path.Base(path.Dir(blob.Key)), 10, 64)
+			if err != nil || fieldID != result.FieldID {
+				report(blob.Key, "field %d doesn't match the path", result.FieldID)
+			}
+		}
+		fieldRows[result.FieldID] += result.RowNum
+	}
+
+	if first == nil || first.EventType != storage.InsertEventType {
+		return problems
+	}
+	fieldIDs := make([]storage.FieldID, 0, len(fieldRows))
+	for fieldID := range fieldRows {
+		fieldIDs = append(fieldIDs, fieldID)
+	}
+	sort.Slice(fieldIDs, func(i, j int) bool { return fieldIDs[i] < fieldIDs[j] })
+	for _, fieldID := range fieldIDs {
+		if fieldRows[fieldID] != fieldRows[fieldIDs[0]] {
+			report(fmt.Sprintf("field %d", fieldID), "has %d rows, but field %d has %d rows", fieldRows[fieldID], fieldIDs[0], fieldRows[fieldIDs[0]])
+		}
+	}
+	return problems
+}
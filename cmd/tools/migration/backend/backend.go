@@ -16,6 +16,10 @@ import (
 type Backend interface {
 	Load() (*meta.Meta, error)
 	Save(meta *meta.Meta) error
+	// GenerateSaves returns the kvs which will be saved for the meta, the keys are relative to the meta root path.
+	GenerateSaves(meta *meta.Meta) (map[string]string, error)
+	// Dump returns the kvs which will be cleaned, the keys are relative to the meta root path.
+	Dump() (map[string]string, error)
 	Clean() error
 	Backup(meta *meta.Meta, backupFile string) error
 	BackupV2(file string) error
@@ -34,6 +38,8 @@ func NewBackend(cfg *configs.MilvusConfig, version string) (Backend, error) {
 		return newEtcd210(cfg)
 	} else if versions.Range22x(v) {
 		return newEtcd220(cfg)
+	} else if versions.Range23x(v) {
+		return newEtcd230(cfg)
 	}
 	return nil, fmt.Errorf("version not supported: %s", version)
 }
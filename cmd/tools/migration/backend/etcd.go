@@ -1,7 +1,14 @@
 package backend
 
 import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"strings"
+
 	"github.com/milvus-io/milvus/cmd/tools/migration/configs"
+	"github.com/milvus-io/milvus/cmd/tools/migration/console"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
@@ -18,6 +25,44 @@ func (b etcdBasedBackend) CleanWithPrefix(prefix string) error {
 	return b.txn.RemoveWithPrefix(prefix)
 }
 
+func (b etcdBasedBackend) cleanWithPrefixes(prefixes []string) error {
+	for _, prefix := range prefixes {
+		if err := b.CleanWithPrefix(prefix); err != nil {
+			return err
+		}
+		lineCleanPrefix(prefix)
+	}
+	return nil
+}
+
+func (b etcdBasedBackend) save(saves map[string]string) error {
+	for k, v := range saves {
+		if err := b.txn.Save(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dump returns the kvs with the prefixes, the keys are relative to the meta root path.
+func (b etcdBasedBackend) dump(prefixes []string) (map[string]string, error) {
+	rootPath := b.txn.GetPath("")
+	kvs := make(map[string]string)
+	for _, prefix := range prefixes {
+		keys, values, err := b.txn.LoadWithPrefix(prefix)
+		if err != nil {
+			return nil, err
+		}
+		if len(keys) != len(values) {
+			return nil, fmt.Errorf("length mismatch")
+		}
+		for i, key := range keys {
+			kvs[strings.TrimPrefix(strings.TrimPrefix(key, rootPath), "/")] = values[i]
+		}
+	}
+	return kvs, nil
+}
+
 func newEtcdBasedBackend(cfg *configs.MilvusConfig) (*etcdBasedBackend, error) {
 	etcdCli, err := etcd.GetEtcdClient(
 		cfg.EtcdCfg.UseEmbedEtcd.GetAsBool(),
@@ -34,3 +79,84 @@ func newEtcdBasedBackend(cfg *configs.MilvusConfig) (*etcdBasedBackend, error) {
 	b := &etcdBasedBackend{cfg: cfg, etcdCli: etcdCli, txn: txn}
 	return b, nil
 }
+
+func (b etcdBasedBackend) backupV2(file string) error {
+	var instance, metaPath string
+	metaRootPath := b.cfg.EtcdCfg.MetaRootPath.GetValue()
+	parts := strings.Split(metaRootPath, "/")
+	if len(parts) > 1 {
+		metaPath = parts[len(parts)-1]
+		instance = path.Join(parts[:len(parts)-1]...)
+	} else {
+		instance = metaRootPath
+	}
+
+	ctx := context.Background()
+	// TODO: optimize this if memory consumption is too large.
+	saves := make(map[string]string)
+	cntResp, err := b.etcdCli.Get(ctx, metaRootPath, clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		return err
+	}
+
+	opts := []clientv3.OpOption{clientv3.WithFromKey(), clientv3.WithRev(cntResp.Header.Revision), clientv3.WithLimit(1)}
+	currentKey := metaRootPath
+	for i := 0; int64(i) < cntResp.Count; i++ {
+		resp, err := b.etcdCli.Get(ctx, currentKey, opts...)
+		if err != nil {
+			return err
+		}
+		for _, kv := range resp.Kvs {
+			currentKey = string(append(kv.Key, 0))
+			if kv.Lease != 0 {
+				console.Warning(fmt.Sprintf("lease key won't be backuped: %s, lease id: %d", kv.Key, kv.Lease))
+				continue
+			}
+			saves[string(kv.Key)] = string(kv.Value)
+		}
+	}
+
+	header := &BackupHeader{
+		Version:   BackupHeaderVersionV1,
+		Instance:  instance,
+		MetaPath:  metaPath,
+		Entries:   int64(len(saves)),
+		Component: "",
+		Extra:     newBackupHeaderExtra(setEntryIncludeRootPath(true)).ToJSONBytes(),
+	}
+
+	codec := NewBackupCodec()
+	backup, err := codec.Serialize(header, saves)
+	if err != nil {
+		return err
+	}
+
+	console.Warning(fmt.Sprintf("backup to: %s", file))
+	return ioutil.WriteFile(file, backup, 0600)
+}
+
+func (b etcdBasedBackend) restore(backupFile string) error {
+	backup, err := ioutil.ReadFile(backupFile)
+	if err != nil {
+		return err
+	}
+	codec := NewBackupCodec()
+	header, saves, err := codec.DeSerialize(backup)
+	if err != nil {
+		return err
+	}
+	entryIncludeRootPath := GetExtra(header.Extra).EntryIncludeRootPath
+	getRealKey := func(key string) string {
+		if entryIncludeRootPath {
+			return key
+		}
+		return path.Join(header.Instance, header.MetaPath, key)
+	}
+	ctx := context.Background()
+	for k, v := range saves {
+		if _, err := b.etcdCli.Put(ctx, getRealKey(k), v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
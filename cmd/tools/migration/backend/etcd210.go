@@ -1,15 +1,12 @@
 package backend
 
 import (
-	"context"
 	"fmt"
 	"io/ioutil"
 	"path"
 	"strconv"
 	"strings"
 
-	clientv3 "go.etcd.io/etcd/client/v3"
-
 	"github.com/milvus-io/milvus/cmd/tools/migration/configs"
 	"github.com/milvus-io/milvus/cmd/tools/migration/legacy"
 
@@ -381,8 +378,9 @@ func lineCleanPrefix(prefix string) {
 	fmt.Printf("prefix %s will be removed!\n", prefix)
 }
 
-func (b etcd210) Clean() error {
-	prefixes := []string{
+// prefixes210 are the prefixes of the meta which will be migrated from 2.1.x.
+func prefixes210() []string {
+	return []string{
 		rootcoord.CollectionMetaPrefix,
 		path.Join(rootcoord.SnapshotPrefix, rootcoord.CollectionMetaPrefix),
 
@@ -400,13 +398,18 @@ func (b etcd210) Clean() error {
 		legacy.DDOperationPrefixBefore220,
 		path.Join(rootcoord.SnapshotPrefix, legacy.DDOperationPrefixBefore220),
 	}
-	for _, prefix := range prefixes {
-		if err := b.CleanWithPrefix(prefix); err != nil {
-			return err
-		}
-		lineCleanPrefix(prefix)
-	}
-	return nil
+}
+
+func (b etcd210) Clean() error {
+	return b.cleanWithPrefixes(prefixes210())
+}
+
+func (b etcd210) Dump() (map[string]string, error) {
+	return b.dump(prefixes210())
+}
+
+func (b etcd210) GenerateSaves(metas *meta.Meta) (map[string]string, error) {
+	return metas.Meta210.GenerateSaves(), nil
 }
 
 func (b etcd210) Backup(meta *meta.Meta, backupFile string) error {
@@ -438,82 +441,9 @@ func (b etcd210) Backup(meta *meta.Meta, backupFile string) error {
 }
 
 func (b etcd210) BackupV2(file string) error {
-	var instance, metaPath string
-	metaRootPath := b.cfg.EtcdCfg.MetaRootPath.GetValue()
-	parts := strings.Split(metaRootPath, "/")
-	if len(parts) > 1 {
-		metaPath = parts[len(parts)-1]
-		instance = path.Join(parts[:len(parts)-1]...)
-	} else {
-		instance = metaRootPath
-	}
-
-	ctx := context.Background()
-	// TODO: optimize this if memory consumption is too large.
-	saves := make(map[string]string)
-	cntResp, err := b.etcdCli.Get(ctx, metaRootPath, clientv3.WithPrefix(), clientv3.WithCountOnly())
-	if err != nil {
-		return err
-	}
-
-	opts := []clientv3.OpOption{clientv3.WithFromKey(), clientv3.WithRev(cntResp.Header.Revision), clientv3.WithLimit(1)}
-	currentKey := metaRootPath
-	for i := 0; int64(i) < cntResp.Count; i++ {
-		resp, err := b.etcdCli.Get(ctx, currentKey, opts...)
-		if err != nil {
-			return err
-		}
-		for _, kv := range resp.Kvs {
-			currentKey = string(append(kv.Key, 0))
-			if kv.Lease != 0 {
-				console.Warning(fmt.Sprintf("lease key won't be backuped: %s, lease id: %d", kv.Key, kv.Lease))
-				continue
-			}
-			saves[string(kv.Key)] = string(kv.Value)
-		}
-	}
-
-	header := &BackupHeader{
-		Version:   BackupHeaderVersionV1,
-		Instance:  instance,
-		MetaPath:  metaPath,
-		Entries:   int64(len(saves)),
-		Component: "",
-		Extra:     newBackupHeaderExtra(setEntryIncludeRootPath(true)).ToJSONBytes(),
-	}
-
-	codec := NewBackupCodec()
-	backup, err := codec.Serialize(header, saves)
-	if err != nil {
-		return err
-	}
-
-	console.Warning(fmt.Sprintf("backup to: %s", file))
-	return ioutil.WriteFile(file, backup, 0600)
+	return b.backupV2(file)
 }
 
 func (b etcd210) Restore(backupFile string) error {
-	backup, err := ioutil.ReadFile(backupFile)
-	if err != nil {
-		return err
-	}
-	codec := NewBackupCodec()
-	header, saves, err := codec.DeSerialize(backup)
-	if err != nil {
-		return err
-	}
-	entryIncludeRootPath := GetExtra(header.Extra).EntryIncludeRootPath
-	getRealKey := func(key string) string {
-		if entryIncludeRootPath {
-			return key
-		}
-		return path.Join(header.Instance, header.MetaPath, key)
-	}
-	ctx := context.Background()
-	for k, v := range saves {
-		if _, err := b.etcdCli.Put(ctx, getRealKey(k), v); err != nil {
-			return err
-		}
-	}
-	return nil
+	return b.restore(backupFile)
 }
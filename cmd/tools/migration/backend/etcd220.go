@@ -2,10 +2,16 @@ package backend
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
 
 	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
 
 	"github.com/milvus-io/milvus/cmd/tools/migration/configs"
+	"github.com/milvus-io/milvus/cmd/tools/migration/versions"
 
 	"github.com/milvus-io/milvus/pkg/util"
 
@@ -37,94 +43,9 @@ func printSaves(saves map[string]string) {
 	}
 }
 
-func (b etcd220) save(saves map[string]string) error {
-	for k, v := range saves {
-		if err := b.txn.Save(k, v); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (b etcd220) Save(metas *meta.Meta) error {
-	{
-		saves, err := metas.Meta220.TtCollections.GenerateSaves(metas.SourceVersion)
-		if err != nil {
-			return err
-		}
-		if err := b.save(saves); err != nil {
-			return err
-		}
-	}
-	{
-		saves, err := metas.Meta220.Collections.GenerateSaves(metas.SourceVersion)
-		if err != nil {
-			return err
-		}
-		if err := b.save(saves); err != nil {
-			return err
-		}
-	}
-	{
-		saves, err := metas.Meta220.TtAliases.GenerateSaves()
-		if err != nil {
-			return err
-		}
-		if err := b.save(saves); err != nil {
-			return err
-		}
-	}
-	{
-		saves, err := metas.Meta220.Aliases.GenerateSaves()
-		if err != nil {
-			return err
-		}
-		if err := b.save(saves); err != nil {
-			return err
-		}
-	}
-	{
-		saves, err := metas.Meta220.CollectionIndexes.GenerateSaves()
-		if err != nil {
-			return err
-		}
-		if err := b.save(saves); err != nil {
-			return err
-		}
-	}
-	{
-		saves, err := metas.Meta220.SegmentIndexes.GenerateSaves()
-		if err != nil {
-			return err
-		}
-		if err := b.save(saves); err != nil {
-			return err
-		}
-	}
-	{
-		saves, err := metas.Meta220.CollectionLoadInfos.GenerateSaves()
-		if err != nil {
-			return err
-		}
-		if err := b.save(saves); err != nil {
-			return err
-		}
-	}
-	{
-		saves, err := metas.Meta220.PartitionLoadInfos.GenerateSaves()
-		if err != nil {
-			return err
-		}
-		if err := b.save(saves); err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
-func (b etcd220) Clean() error {
-	prefixes := []string{
+// prefixes220 are the prefixes of the meta which will be migrated from or to 2.2.x.
+func prefixes220() []string {
+	return []string{
 		rootcoord.CollectionMetaPrefix,
 		rootcoord.PartitionMetaPrefix,
 		rootcoord.FieldMetaPrefix,
@@ -138,11 +59,110 @@ func (b etcd220) Clean() error {
 		querycoord.CollectionLoadInfoPrefix,
 		querycoord.PartitionLoadInfoPrefix,
 	}
-	for _, prefix := range prefixes {
-		if err := b.CleanWithPrefix(prefix); err != nil {
-			return err
+}
+
+// loadInfosFrom220 picks the load infos out of the kvs, the left kvs are returned as the raw meta.
+func loadInfosFrom220(kvs map[string]string) (meta.CollectionLoadInfo220, meta.PartitionLoadInfo220, meta.RawMeta, error) {
+	collectionLoadInfos := make(meta.CollectionLoadInfo220)
+	partitionLoadInfos := make(meta.PartitionLoadInfo220)
+	raw := make(meta.RawMeta)
+	for key, value := range kvs {
+		switch {
+		case strings.HasPrefix(key, querycoord.CollectionLoadInfoPrefix+"/"):
+			info := &querypb.CollectionLoadInfo{}
+			if err := proto.Unmarshal([]byte(value), info); err != nil {
+				return nil, nil, nil, err
+			}
+			collectionLoadInfos[info.GetCollectionID()] = &model.CollectionLoadInfo{
+				CollectionID:         info.GetCollectionID(),
+				ReleasedPartitionIDs: info.GetReleasedPartitions(),
+				LoadType:             info.GetLoadType(),
+				LoadPercentage:       100,
+				Status:               info.GetStatus(),
+				ReplicaNumber:        info.GetReplicaNumber(),
+				FieldIndexID:         info.GetFieldIndexID(),
+			}
+		case strings.HasPrefix(key, querycoord.PartitionLoadInfoPrefix+"/"):
+			info := &querypb.PartitionLoadInfo{}
+			if err := proto.Unmarshal([]byte(value), info); err != nil {
+				return nil, nil, nil, err
+			}
+			partitions, ok := partitionLoadInfos[info.GetCollectionID()]
+			if !ok {
+				partitions = make(map[int64]*model.PartitionLoadInfo)
+				partitionLoadInfos[info.GetCollectionID()] = partitions
+			}
+			partitions[info.GetPartitionID()] = &model.PartitionLoadInfo{
+				CollectionID:   info.GetCollectionID(),
+				PartitionID:    info.GetPartitionID(),
+				LoadType:       querypb.LoadType_LoadPartition,
+				LoadPercentage: 100,
+				Status:         info.GetStatus(),
+				ReplicaNumber:  info.GetReplicaNumber(),
+				FieldIndexID:   info.GetFieldIndexID(),
+			}
+		default:
+			raw[key] = value
 		}
-		lineCleanPrefix(prefix)
 	}
-	return nil
+	return collectionLoadInfos, partitionLoadInfos, raw, nil
+}
+
+// Load loads the load infos which are changed in 2.3.x, the other meta is kept as it is.
+func (b etcd220) Load() (*meta.Meta, error) {
+	kvs, err := b.Dump()
+	if err != nil {
+		return nil, err
+	}
+	collectionLoadInfos, partitionLoadInfos, raw, err := loadInfosFrom220(kvs)
+	if err != nil {
+		return nil, err
+	}
+	return &meta.Meta{
+		SourceVersion: versions.Version220,
+		Version:       versions.Version220,
+		Meta220: &meta.All220{
+			TtCollections:       make(meta.TtCollectionsMeta220),
+			Collections:         make(meta.CollectionsMeta220),
+			TtAliases:           make(meta.TtAliasesMeta220),
+			Aliases:             make(meta.AliasesMeta220),
+			TtPartitions:        make(meta.TtPartitionsMeta220),
+			Partitions:          make(meta.PartitionsMeta220),
+			TtFields:            make(meta.TtFieldsMeta220),
+			Fields:              make(meta.FieldsMeta220),
+			CollectionIndexes:   make(meta.CollectionIndexesMeta220),
+			SegmentIndexes:      make(meta.SegmentIndexesMeta220),
+			CollectionLoadInfos: collectionLoadInfos,
+			PartitionLoadInfos:  partitionLoadInfos,
+			Raw:                 raw,
+		},
+	}, nil
+}
+
+func (b etcd220) GenerateSaves(metas *meta.Meta) (map[string]string, error) {
+	return metas.Meta220.GenerateSaves(metas.SourceVersion)
+}
+
+func (b etcd220) Save(metas *meta.Meta) error {
+	saves, err := b.GenerateSaves(metas)
+	if err != nil {
+		return err
+	}
+	return b.save(saves)
+}
+
+func (b etcd220) Clean() error {
+	return b.cleanWithPrefixes(prefixes220())
+}
+
+func (b etcd220) Dump() (map[string]string, error) {
+	return b.dump(prefixes220())
+}
+
+func (b etcd220) BackupV2(file string) error {
+	return b.backupV2(file)
+}
+
+func (b etcd220) Restore(backupFile string) error {
+	return b.restore(backupFile)
 }
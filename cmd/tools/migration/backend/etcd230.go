@@ -0,0 +1,82 @@
+package backend
+
+import (
+	"github.com/milvus-io/milvus/cmd/tools/migration/configs"
+	"github.com/milvus-io/milvus/cmd/tools/migration/meta"
+	"github.com/milvus-io/milvus/cmd/tools/migration/versions"
+)
+
+// etcd230 implements Backend.
+type etcd230 struct {
+	Backend
+	*etcdBasedBackend
+}
+
+func newEtcd230(cfg *configs.MilvusConfig) (*etcd230, error) {
+	etcdBackend, err := newEtcdBasedBackend(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &etcd230{etcdBasedBackend: etcdBackend}, nil
+}
+
+// prefixes230 are the prefixes of the meta which will be migrated from or to 2.3.x,
+// the layout is the same as 2.2.x except the load infos.
+func prefixes230() []string {
+	return prefixes220()
+}
+
+// Load loads the load infos in the same way as 2.2.x, the other meta is kept as it is.
+func (b etcd230) Load() (*meta.Meta, error) {
+	kvs, err := b.Dump()
+	if err != nil {
+		return nil, err
+	}
+	collectionLoadInfos, partitionLoadInfos, raw, err := loadInfosFrom220(kvs)
+	if err != nil {
+		return nil, err
+	}
+	return &meta.Meta{
+		SourceVersion: versions.Version230,
+		Version:       versions.Version230,
+		Meta230: &meta.All230{
+			TtCollections:       make(meta.TtCollectionsMeta220),
+			Collections:         make(meta.CollectionsMeta220),
+			TtAliases:           make(meta.TtAliasesMeta220),
+			Aliases:             make(meta.AliasesMeta220),
+			CollectionIndexes:   make(meta.CollectionIndexesMeta220),
+			SegmentIndexes:      make(meta.SegmentIndexesMeta220),
+			CollectionLoadInfos: meta.CollectionLoadInfo230(collectionLoadInfos),
+			PartitionLoadInfos:  meta.PartitionLoadInfo230(partitionLoadInfos),
+			Raw:                 raw,
+		},
+	}, nil
+}
+
+func (b etcd230) GenerateSaves(metas *meta.Meta) (map[string]string, error) {
+	return metas.Meta230.GenerateSaves(metas.SourceVersion)
+}
+
+func (b etcd230) Save(metas *meta.Meta) error {
+	saves, err := b.GenerateSaves(metas)
+	if err != nil {
+		return err
+	}
+	return b.save(saves)
+}
+
+func (b etcd230) Clean() error {
+	return b.cleanWithPrefixes(prefixes230())
+}
+
+func (b etcd230) Dump() (map[string]string, error) {
+	return b.dump(prefixes230())
+}
+
+func (b etcd230) BackupV2(file string) error {
+	return b.backupV2(file)
+}
+
+func (b etcd230) Restore(backupFile string) error {
+	return b.restore(backupFile)
+}
@@ -0,0 +1,202 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/cmd/tools/migration/meta"
+	"github.com/milvus-io/milvus/cmd/tools/migration/versions"
+	"github.com/milvus-io/milvus/internal/kv"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
+	"github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+// memMetaKv is the in-memory MetaKv which is enough for the catalogs and the backends.
+type memMetaKv struct {
+	kv.MetaKv
+	mem *memkv.MemoryKV
+}
+
+func newMemMetaKv() *memMetaKv {
+	return &memMetaKv{mem: memkv.NewMemoryKV()}
+}
+
+func (m *memMetaKv) GetPath(key string) string {
+	return key
+}
+
+func (m *memMetaKv) Load(key string) (string, error) {
+	return m.mem.Load(key)
+}
+
+func (m *memMetaKv) LoadWithPrefix(key string) ([]string, []string, error) {
+	return m.mem.LoadWithPrefix(key)
+}
+
+func (m *memMetaKv) Save(key, value string) error {
+	return m.mem.Save(key, value)
+}
+
+func (m *memMetaKv) MultiSave(kvs map[string]string) error {
+	return m.mem.MultiSave(kvs)
+}
+
+func (m *memMetaKv) RemoveWithPrefix(key string) error {
+	return m.mem.RemoveWithPrefix(key)
+}
+
+func (m *memMetaKv) WalkWithPrefix(prefix string, paginationSize int, fn func([]byte, []byte) error) error {
+	keys, values, err := m.mem.LoadWithPrefix(prefix)
+	if err != nil {
+		return err
+	}
+	for i := range keys {
+		if err := fn([]byte(keys[i]), []byte(values[i])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newRootCoordCatalog(t *testing.T, metaKv kv.MetaKv) *rootcoord.Catalog {
+	snapshot, err := rootcoord.NewSuffixSnapshot(metaKv, rootcoord.SnapshotsSep, "", rootcoord.SnapshotPrefix)
+	require.NoError(t, err)
+	return &rootcoord.Catalog{Txn: metaKv, Snapshot: snapshot}
+}
+
+func createCollection(t *testing.T, catalog *rootcoord.Catalog, collectionID int64, partitionIDs ...int64) {
+	ctx := context.Background()
+	coll := &model.Collection{
+		CollectionID: collectionID,
+		Name:         fmt.Sprintf("coll%d", collectionID),
+		State:        pb.CollectionState_CollectionCreating,
+	}
+	for _, partitionID := range partitionIDs {
+		coll.Partitions = append(coll.Partitions, &model.Partition{
+			PartitionID:  partitionID,
+			CollectionID: collectionID,
+			State:        pb.PartitionState_PartitionCreated,
+		})
+	}
+	require.NoError(t, catalog.CreateCollection(ctx, coll, 100))
+	created := coll.Clone()
+	created.State = pb.CollectionState_CollectionCreated
+	require.NoError(t, catalog.AlterCollection(ctx, coll, created, metastore.MODIFY, 101))
+}
+
+func partitionIDsOf(infos []*querypb.PartitionLoadInfo) []int64 {
+	ret := make([]int64, 0, len(infos))
+	for _, info := range infos {
+		ret = append(ret, info.GetPartitionID())
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i] < ret[j] })
+	return ret
+}
+
+func TestEtcd220To230(t *testing.T) {
+	metaKv := newMemMetaKv()
+	rootCoordCatalog := newRootCoordCatalog(t, metaKv)
+	queryCoordCatalog := querycoord.NewCatalog(metaKv)
+	fieldIndexID := map[int64]int64{101: 1001}
+
+	// loaded by LoadCollection in 2.2.x, partition 12 is released.
+	createCollection(t, rootCoordCatalog, 1, 10, 11, 12)
+	require.NoError(t, queryCoordCatalog.SaveCollection(&querypb.CollectionLoadInfo{
+		CollectionID:       1,
+		ReleasedPartitions: []int64{12},
+		ReplicaNumber:      1,
+		Status:             querypb.LoadStatus_Loaded,
+		FieldIndexID:       fieldIndexID,
+	}))
+	// loaded by LoadPartitions in 2.2.x.
+	createCollection(t, rootCoordCatalog, 2, 20, 21)
+	require.NoError(t, queryCoordCatalog.SavePartition(&querypb.PartitionLoadInfo{
+		CollectionID:  2,
+		PartitionID:   20,
+		ReplicaNumber: 2,
+		Status:        querypb.LoadStatus_Loaded,
+		FieldIndexID:  fieldIndexID,
+	}))
+	// still loading, which will be released.
+	createCollection(t, rootCoordCatalog, 3, 30)
+	require.NoError(t, queryCoordCatalog.SaveCollection(&querypb.CollectionLoadInfo{
+		CollectionID:  3,
+		ReplicaNumber: 1,
+		Status:        querypb.LoadStatus_Loading,
+	}))
+
+	source := &etcd220{etcdBasedBackend: &etcdBasedBackend{txn: metaKv}}
+	before, err := source.Dump()
+	require.NoError(t, err)
+	metas, err := source.Load()
+	require.NoError(t, err)
+	// collection 2 only has partition load infos, as LoadPartitions saved in 2.2.x.
+	assert.Equal(t, 2, len(metas.Meta220.CollectionLoadInfos))
+	assert.Equal(t, 1, len(metas.Meta220.PartitionLoadInfos))
+
+	// the meta is saved as it is.
+	saves, err := source.GenerateSaves(metas)
+	require.NoError(t, err)
+	assert.Equal(t, before, saves)
+
+	metas230, err := meta.From220To230(metas)
+	require.NoError(t, err)
+	assert.True(t, metas230.Version.EQ(versions.Version230))
+
+	target := &etcd230{etcdBasedBackend: &etcdBasedBackend{txn: metaKv}}
+	require.NoError(t, source.Clean())
+	require.NoError(t, target.Save(metas230))
+
+	collections, err := queryCoordCatalog.GetCollections()
+	require.NoError(t, err)
+	loadTypes := make(map[int64]querypb.LoadType)
+	for _, info := range collections {
+		loadTypes[info.GetCollectionID()] = info.GetLoadType()
+		assert.Equal(t, querypb.LoadStatus_Loaded, info.GetStatus())
+		assert.Equal(t, fieldIndexID, info.GetFieldIndexID())
+	}
+	assert.Equal(t, map[int64]querypb.LoadType{
+		1: querypb.LoadType_LoadCollection,
+		2: querypb.LoadType_LoadPartition,
+	}, loadTypes)
+
+	partitions, err := queryCoordCatalog.GetPartitions()
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(partitions))
+	assert.Equal(t, []int64{10, 11}, partitionIDsOf(partitions[1]))
+	assert.Equal(t, []int64{20}, partitionIDsOf(partitions[2]))
+	for _, info := range partitions[1] {
+		assert.Equal(t, int32(1), info.GetReplicaNumber())
+		assert.Equal(t, fieldIndexID, info.GetFieldIndexID())
+	}
+
+	// the rootcoord meta isn't touched.
+	colls, err := rootCoordCatalog.ListCollections(context.Background(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(colls))
+	for _, coll := range colls {
+		assert.True(t, coll.Available())
+		if coll.CollectionID == 1 {
+			assert.Equal(t, 3, len(coll.Partitions))
+		}
+	}
+
+	// the 2.3.x meta is loaded and saved as it is.
+	after, err := target.Dump()
+	require.NoError(t, err)
+	reloaded, err := target.Load()
+	require.NoError(t, err)
+	saves, err = target.GenerateSaves(reloaded)
+	require.NoError(t, err)
+	assert.Equal(t, after, saves)
+}
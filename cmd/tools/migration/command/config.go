@@ -8,17 +8,23 @@ import (
 
 type commandParser struct {
 	configYaml string
+	dryRun     bool
 }
 
 func (c *commandParser) formatYaml(args []string, flags *flag.FlagSet) {
 	flags.StringVar(&c.configYaml, "config", "", "set config yaml")
 }
 
+func (c *commandParser) formatDryRun(args []string, flags *flag.FlagSet) {
+	flags.BoolVar(&c.dryRun, "dry-run", false, "show the keys to be changed by the migration without writing them")
+}
+
 func (c *commandParser) parse(args []string, flags *flag.FlagSet) {
 	console.AbnormalExitIf(flags.Parse(args[1:]), false)
 }
 
 func (c *commandParser) format(args []string, flags *flag.FlagSet) {
 	c.formatYaml(args, flags)
+	c.formatDryRun(args, flags)
 	c.parse(args, flags)
 }
@@ -7,6 +7,6 @@ var (
 		"%s\n", runLineV2)
 
 	runLineV2 = `
-migration -config=config.yaml
+migration -config=config.yaml [-dry-run]
 `
 )
@@ -22,6 +22,9 @@ func Execute(args []string) {
 	console.ErrorExitIf(c.configYaml == "", false, "config not set")
 
 	cfg := configs.NewConfig(c.configYaml)
+	if c.dryRun {
+		cfg.DryRun = true
+	}
 	switch cfg.Cmd {
 	case configs.RunCmd:
 		Run(cfg)
@@ -11,6 +11,10 @@ import (
 )
 
 func Run(c *configs.Config) {
+	if c.DryRun {
+		DryRun(c)
+		return
+	}
 	ctx := context.Background()
 	runner := migration.NewRunner(ctx, c)
 	console.AbnormalExitIf(runner.CheckSessions(), false)
@@ -28,3 +32,14 @@ func Run(c *configs.Config) {
 	}
 	console.AbnormalExitIf(runner.Migrate(), true, console.AddCallbacks(fn))
 }
+
+// DryRun shows the keys which would be added, changed or removed by the migration, the meta is left untouched.
+func DryRun(c *configs.Config) {
+	ctx := context.Background()
+	runner := migration.NewRunner(ctx, c)
+	fn := func() { runner.Stop() }
+	defer fn()
+	console.AbnormalExitIf(runner.Validate(), false, console.AddCallbacks(fn))
+	console.NormalExitIf(runner.CheckCompatible(), "version compatible, no need to migrate", console.AddCallbacks(fn))
+	console.AbnormalExitIf(runner.DryRun(), false, console.AddCallbacks(fn))
+}
@@ -19,6 +19,7 @@ type RunConfig struct {
 	base           *paramtable.BaseTable
 	Cmd            string
 	RunWithBackup  bool
+	DryRun         bool
 	SourceVersion  string
 	TargetVersion  string
 	BackupFilePath string
@@ -36,8 +37,8 @@ func (c *RunConfig) String() string {
 	}
 	switch c.Cmd {
 	case RunCmd:
-		return fmt.Sprintf("Cmd: %s, SourceVersion: %s, TargetVersion: %s, BackupFilePath: %s, RunWithBackup: %v",
-			c.Cmd, c.SourceVersion, c.TargetVersion, c.BackupFilePath, c.RunWithBackup)
+		return fmt.Sprintf("Cmd: %s, SourceVersion: %s, TargetVersion: %s, BackupFilePath: %s, RunWithBackup: %v, DryRun: %v",
+			c.Cmd, c.SourceVersion, c.TargetVersion, c.BackupFilePath, c.RunWithBackup, c.DryRun)
 	case BackupCmd:
 		return fmt.Sprintf("Cmd: %s, SourceVersion: %s, BackupFilePath: %s",
 			c.Cmd, c.SourceVersion, c.BackupFilePath)
@@ -58,6 +59,7 @@ func (c *RunConfig) init(base *paramtable.BaseTable) {
 
 	c.Cmd = c.base.GetWithDefault("cmd.type", "")
 	c.RunWithBackup, _ = strconv.ParseBool(c.base.GetWithDefault("cmd.runWithBackup", "false"))
+	c.DryRun, _ = strconv.ParseBool(c.base.GetWithDefault("cmd.dryRun", "false"))
 	c.SourceVersion = c.base.GetWithDefault("config.sourceVersion", "")
 	c.TargetVersion = c.base.GetWithDefault("config.targetVersion", "")
 	c.BackupFilePath = c.base.GetWithDefault("config.backupFilePath", "")
@@ -0,0 +1,133 @@
+package meta
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/cmd/tools/migration/versions"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+func loaded(status querypb.LoadStatus, replicaNumber int32) bool {
+	return status == querypb.LoadStatus_Loaded && replicaNumber > 0
+}
+
+// loadInfosTo230 converts the load infos like the querycoord recovers the load infos of 2.2.x:
+// the collections loaded by LoadCollection have no partition load infos in 2.2.x,
+// and the collections loaded by LoadPartitions have no collection load info.
+// The load infos not loaded are released.
+func (meta *All220) loadInfosTo230() (CollectionLoadInfo230, PartitionLoadInfo230, error) {
+	collectionLoadInfos := make(CollectionLoadInfo230)
+	partitionLoadInfos := make(PartitionLoadInfo230)
+
+	for collectionID, partitions := range meta.PartitionLoadInfos {
+		for partitionID, loadInfo := range partitions {
+			if !loaded(loadInfo.Status, loadInfo.ReplicaNumber) {
+				log.Warn("release the partition not loaded", zap.Int64("collectionID", collectionID), zap.Int64("partitionID", partitionID))
+				continue
+			}
+			if _, ok := partitionLoadInfos[collectionID]; !ok {
+				partitionLoadInfos[collectionID] = make(map[UniqueID]*model.PartitionLoadInfo)
+			}
+			partitionLoadInfos[collectionID][partitionID] = loadInfo
+		}
+	}
+
+	for collectionID, loadInfo := range meta.CollectionLoadInfos {
+		if !loaded(loadInfo.Status, loadInfo.ReplicaNumber) {
+			log.Warn("release the collection not loaded", zap.Int64("collectionID", collectionID))
+			delete(partitionLoadInfos, collectionID)
+			continue
+		}
+		if loadInfo.LoadType == querypb.LoadType_UnKnownType {
+			loadInfo.LoadType = querypb.LoadType_LoadCollection
+		}
+		collectionLoadInfos[collectionID] = loadInfo
+		if loadInfo.LoadType != querypb.LoadType_LoadCollection || len(partitionLoadInfos[collectionID]) > 0 {
+			continue
+		}
+
+		partitionIDs := loadInfo.PartitionIDs
+		if len(partitionIDs) == 0 {
+			var err error
+			partitionIDs, err = meta.ListPartitionIDs(collectionID)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		released := make(map[UniqueID]struct{}, len(loadInfo.ReleasedPartitionIDs))
+		for _, partitionID := range loadInfo.ReleasedPartitionIDs {
+			released[partitionID] = struct{}{}
+		}
+		partitions := make(map[UniqueID]*model.PartitionLoadInfo, len(partitionIDs))
+		for _, partitionID := range partitionIDs {
+			if _, ok := released[partitionID]; ok {
+				continue
+			}
+			partitions[partitionID] = &model.PartitionLoadInfo{
+				CollectionID:   collectionID,
+				PartitionID:    partitionID,
+				LoadType:       querypb.LoadType_LoadCollection,
+				LoadPercentage: 100,
+				Status:         querypb.LoadStatus_Loaded,
+				ReplicaNumber:  loadInfo.ReplicaNumber,
+				FieldIndexID:   loadInfo.FieldIndexID,
+			}
+		}
+		if len(partitions) == 0 {
+			log.Warn("release the collection without partitions", zap.Int64("collectionID", collectionID))
+			delete(collectionLoadInfos, collectionID)
+			continue
+		}
+		partitionLoadInfos[collectionID] = partitions
+	}
+
+	for collectionID, partitions := range partitionLoadInfos {
+		if _, ok := collectionLoadInfos[collectionID]; ok {
+			continue
+		}
+		for _, loadInfo := range partitions {
+			collectionLoadInfos[collectionID] = &model.CollectionLoadInfo{
+				CollectionID:   collectionID,
+				LoadType:       querypb.LoadType_LoadPartition,
+				LoadPercentage: 100,
+				Status:         loadInfo.Status,
+				ReplicaNumber:  loadInfo.ReplicaNumber,
+				FieldIndexID:   loadInfo.FieldIndexID,
+			}
+			break
+		}
+	}
+
+	return collectionLoadInfos, partitionLoadInfos, nil
+}
+
+func From220To230(metas *Meta) (*Meta, error) {
+	if !versions.Range22x(metas.Version) {
+		return nil, fmt.Errorf("version mismatch: %s", metas.Version.String())
+	}
+	collectionLoadInfos, partitionLoadInfos, err := metas.Meta220.loadInfosTo230()
+	if err != nil {
+		return nil, err
+	}
+
+	metas230 := &Meta{
+		SourceVersion: metas.SourceVersion,
+		Version:       versions.Version230,
+		Meta230: &All230{
+			TtCollections:       metas.Meta220.TtCollections,
+			Collections:         metas.Meta220.Collections,
+			TtAliases:           metas.Meta220.TtAliases,
+			Aliases:             metas.Meta220.Aliases,
+			CollectionIndexes:   metas.Meta220.CollectionIndexes,
+			SegmentIndexes:      metas.Meta220.SegmentIndexes,
+			CollectionLoadInfos: collectionLoadInfos,
+			PartitionLoadInfos:  partitionLoadInfos,
+			Raw:                 metas.Meta220.Raw,
+		},
+	}
+	return metas230, nil
+}
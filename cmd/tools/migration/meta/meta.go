@@ -14,4 +14,16 @@ type Meta struct {
 
 	Meta210 *All210
 	Meta220 *All220
+	Meta230 *All230
+}
+
+// RawMeta holds the meta whose layout isn't changed by the migration, key -> value.
+type RawMeta map[string]string
+
+func (meta *RawMeta) GenerateSaves() map[string]string {
+	saves := make(map[string]string, len(*meta))
+	for k, v := range *meta {
+		saves[k] = v
+	}
+	return saves
 }
@@ -1,6 +1,9 @@
 package meta
 
 import (
+	"sort"
+	"strings"
+
 	"github.com/blang/semver/v4"
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/cmd/tools/migration/versions"
@@ -8,7 +11,9 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
 	"github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
 	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 type TtCollectionsMeta220 map[UniqueID]map[Timestamp]*model.Collection // coll_id -> ts -> coll
@@ -294,4 +299,93 @@ type All220 struct {
 	// QueryCoord Meta
 	CollectionLoadInfos CollectionLoadInfo220
 	PartitionLoadInfos  PartitionLoadInfo220
+
+	// Raw is the meta loaded from 2.2.x as it is.
+	Raw RawMeta
+}
+
+func (meta *All220) GenerateSaves(sourceVersion semver.Version) (map[string]string, error) {
+	ttCollections, err := meta.TtCollections.GenerateSaves(sourceVersion)
+	if err != nil {
+		return nil, err
+	}
+	collections, err := meta.Collections.GenerateSaves(sourceVersion)
+	if err != nil {
+		return nil, err
+	}
+	ttAliases, err := meta.TtAliases.GenerateSaves()
+	if err != nil {
+		return nil, err
+	}
+	aliases, err := meta.Aliases.GenerateSaves()
+	if err != nil {
+		return nil, err
+	}
+	collectionIndexes, err := meta.CollectionIndexes.GenerateSaves()
+	if err != nil {
+		return nil, err
+	}
+	segmentIndexes, err := meta.SegmentIndexes.GenerateSaves()
+	if err != nil {
+		return nil, err
+	}
+	collectionLoadInfos, err := meta.CollectionLoadInfos.GenerateSaves()
+	if err != nil {
+		return nil, err
+	}
+	partitionLoadInfos, err := meta.PartitionLoadInfos.GenerateSaves()
+	if err != nil {
+		return nil, err
+	}
+
+	return merge(false,
+		meta.Raw.GenerateSaves(),
+		ttCollections, collections,
+		ttAliases, aliases,
+		collectionIndexes, segmentIndexes,
+		collectionLoadInfos, partitionLoadInfos), nil
+}
+
+// ListPartitionIDs returns the ids of the available partitions of the collection,
+// the partitions are either embedded in the collection or stored separately since 2.2.0.
+func (meta *All220) ListPartitionIDs(collectionID UniqueID) ([]UniqueID, error) {
+	partitionIDs := typeutil.NewUniqueSet()
+
+	if coll := meta.Collections[collectionID]; coll != nil && coll.Available() {
+		for _, partition := range coll.Partitions {
+			partitionIDs.Insert(partition.PartitionID)
+		}
+	}
+	for _, partition := range meta.Partitions[collectionID] {
+		if partition.Available() {
+			partitionIDs.Insert(partition.PartitionID)
+		}
+	}
+
+	if value, ok := meta.Raw[rootcoord.BuildCollectionKey(collectionID)]; ok && !rootcoord.IsTombstone(value) {
+		collectionPb := &pb.CollectionInfo{}
+		if err := proto.Unmarshal([]byte(value), collectionPb); err != nil {
+			return nil, err
+		}
+		if model.UnmarshalCollectionModel(collectionPb).Available() {
+			partitionIDs.Insert(collectionPb.GetPartitionIDs()...)
+		}
+	}
+	prefix := rootcoord.BuildPartitionPrefix(collectionID) + "/"
+	for key, value := range meta.Raw {
+		if !strings.HasPrefix(key, prefix) || rootcoord.IsTombstone(value) {
+			continue
+		}
+		partitionPb := &pb.PartitionInfo{}
+		if err := proto.Unmarshal([]byte(value), partitionPb); err != nil {
+			return nil, err
+		}
+		if model.UnmarshalPartitionModel(partitionPb).Available() {
+			partitionIDs.Insert(partitionPb.GetPartitionID())
+		}
+	}
+
+	ret := partitionIDs.Collect()
+	sort.Slice(ret, func(i, j int) bool { return ret[i] < ret[j] })
+	return ret, nil
 }
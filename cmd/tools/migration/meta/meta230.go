@@ -0,0 +1,113 @@
+package meta
+
+import (
+	"github.com/blang/semver/v4"
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+type CollectionLoadInfo230 map[UniqueID]*model.CollectionLoadInfo            // collectionID -> CollectionLoadInfo
+type PartitionLoadInfo230 map[UniqueID]map[UniqueID]*model.PartitionLoadInfo // collectionID, partitionID -> PartitionLoadInfo
+
+func (meta *CollectionLoadInfo230) GenerateSaves() (map[string]string, error) {
+	saves := make(map[string]string)
+	for _, loadInfo := range *meta {
+		k := querycoord.EncodeCollectionLoadInfoKey(loadInfo.CollectionID)
+		v, err := proto.Marshal(&querypb.CollectionLoadInfo{
+			CollectionID:  loadInfo.CollectionID,
+			ReplicaNumber: loadInfo.ReplicaNumber,
+			Status:        loadInfo.Status,
+			FieldIndexID:  loadInfo.FieldIndexID,
+			LoadType:      loadInfo.LoadType,
+		})
+		if err != nil {
+			return nil, err
+		}
+		saves[k] = string(v)
+	}
+	return saves, nil
+}
+
+func (meta *PartitionLoadInfo230) GenerateSaves() (map[string]string, error) {
+	saves := make(map[string]string)
+	for _, partitions := range *meta {
+		for _, loadInfo := range partitions {
+			k := querycoord.EncodePartitionLoadInfoKey(loadInfo.CollectionID, loadInfo.PartitionID)
+			v, err := proto.Marshal(&querypb.PartitionLoadInfo{
+				CollectionID:  loadInfo.CollectionID,
+				PartitionID:   loadInfo.PartitionID,
+				ReplicaNumber: loadInfo.ReplicaNumber,
+				Status:        loadInfo.Status,
+				FieldIndexID:  loadInfo.FieldIndexID,
+			})
+			if err != nil {
+				return nil, err
+			}
+			saves[k] = string(v)
+		}
+	}
+	return saves, nil
+}
+
+// All230 is the meta of 2.3.x, the layouts of the rootcoord and index meta are the same as 2.2.x.
+type All230 struct {
+	TtCollections TtCollectionsMeta220
+	Collections   CollectionsMeta220
+
+	TtAliases TtAliasesMeta220
+	Aliases   AliasesMeta220
+
+	CollectionIndexes CollectionIndexesMeta220
+	SegmentIndexes    SegmentIndexesMeta220
+
+	// QueryCoord Meta
+	CollectionLoadInfos CollectionLoadInfo230
+	PartitionLoadInfos  PartitionLoadInfo230
+
+	Raw RawMeta
+}
+
+func (meta *All230) GenerateSaves(sourceVersion semver.Version) (map[string]string, error) {
+	ttCollections, err := meta.TtCollections.GenerateSaves(sourceVersion)
+	if err != nil {
+		return nil, err
+	}
+	collections, err := meta.Collections.GenerateSaves(sourceVersion)
+	if err != nil {
+		return nil, err
+	}
+	ttAliases, err := meta.TtAliases.GenerateSaves()
+	if err != nil {
+		return nil, err
+	}
+	aliases, err := meta.Aliases.GenerateSaves()
+	if err != nil {
+		return nil, err
+	}
+	collectionIndexes, err := meta.CollectionIndexes.GenerateSaves()
+	if err != nil {
+		return nil, err
+	}
+	segmentIndexes, err := meta.SegmentIndexes.GenerateSaves()
+	if err != nil {
+		return nil, err
+	}
+	collectionLoadInfos, err := meta.CollectionLoadInfos.GenerateSaves()
+	if err != nil {
+		return nil, err
+	}
+	partitionLoadInfos, err := meta.PartitionLoadInfos.GenerateSaves()
+	if err != nil {
+		return nil, err
+	}
+
+	return merge(false,
+		meta.Raw.GenerateSaves(),
+		ttCollections, collections,
+		ttAliases, aliases,
+		collectionIndexes, segmentIndexes,
+		collectionLoadInfos, partitionLoadInfos), nil
+}
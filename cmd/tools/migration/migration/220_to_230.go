@@ -0,0 +1,16 @@
+package migration
+
+import (
+	"github.com/milvus-io/milvus/cmd/tools/migration/meta"
+)
+
+type migrator220To230 struct {
+}
+
+func (m migrator220To230) Migrate(metas *meta.Meta) (*meta.Meta, error) {
+	return meta.From220To230(metas)
+}
+
+func newMigrator220To230() *migrator220To230 {
+	return &migrator220To230{}
+}
@@ -0,0 +1,36 @@
+package migration
+
+import "sort"
+
+// KeysDiff is the difference between the kvs before and after the migration.
+type KeysDiff struct {
+	Added     []string
+	Changed   []string
+	Removed   []string
+	Unchanged int
+}
+
+// DiffKeys compares the kvs before and after the migration, the keys are sorted.
+func DiffKeys(before, after map[string]string) *KeysDiff {
+	diff := &KeysDiff{}
+	for key, value := range after {
+		old, ok := before[key]
+		switch {
+		case !ok:
+			diff.Added = append(diff.Added, key)
+		case old != value:
+			diff.Changed = append(diff.Changed, key)
+		default:
+			diff.Unchanged++
+		}
+	}
+	for key := range before {
+		if _, ok := after[key]; !ok {
+			diff.Removed = append(diff.Removed, key)
+		}
+	}
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Changed)
+	sort.Strings(diff.Removed)
+	return diff
+}
@@ -12,6 +12,49 @@ type Migrator interface {
 	Migrate(metas *meta.Meta) (*meta.Meta, error)
 }
 
+// step migrates the meta from the versions in range to the target version.
+type step struct {
+	inRange  func(version semver.Version) bool
+	target   semver.Version
+	migrator Migrator
+}
+
+var steps []step
+
+// registerStep registers the migrator which migrates the meta from the versions in range to the target version,
+// a source version should be in the range of only one step.
+func registerStep(inRange func(version semver.Version) bool, target semver.Version, migrator Migrator) {
+	steps = append(steps, step{inRange: inRange, target: target, migrator: migrator})
+}
+
+func init() {
+	registerStep(versions.Range21x, versions.Version220, newMigrator210To220())
+	registerStep(versions.Range22x, versions.Version230, newMigrator220To230())
+}
+
+func findStep(version semver.Version) (step, bool) {
+	for _, s := range steps {
+		if s.inRange(version) {
+			return s, true
+		}
+	}
+	return step{}, false
+}
+
+// chainMigrator applies the migrators one by one.
+type chainMigrator []Migrator
+
+func (c chainMigrator) Migrate(metas *meta.Meta) (*meta.Meta, error) {
+	var err error
+	for _, m := range c {
+		metas, err = m.Migrate(metas)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return metas, nil
+}
+
 func NewMigrator(sourceVersion, targetVersion string) (Migrator, error) {
 	source, err := semver.Parse(sourceVersion)
 	if err != nil {
@@ -23,10 +66,21 @@ func NewMigrator(sourceVersion, targetVersion string) (Migrator, error) {
 		return nil, err
 	}
 
-	if versions.Range21x(source) && versions.Range22x(target) {
-		return newMigrator210To220(), nil
+	chain := make(chainMigrator, 0)
+	current := source
+	for current.LT(target) && !versions.SameRange(current, target) {
+		s, ok := findStep(current)
+		if !ok {
+			return nil, fmt.Errorf("no migration step from version %s, source: %s, target: %s",
+				current.String(), sourceVersion, targetVersion)
+		}
+		chain = append(chain, s.migrator)
+		current = s.target
 	}
 
-	return nil, fmt.Errorf("migration from source version to target version is forbidden, source: %s, target: %s",
-		sourceVersion, targetVersion)
+	if len(chain) == 0 || !versions.SameRange(current, target) {
+		return nil, fmt.Errorf("migration from source version to target version is forbidden, source: %s, target: %s",
+			sourceVersion, targetVersion)
+	}
+	return chain, nil
 }
@@ -0,0 +1,47 @@
+package migration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMigrator(t *testing.T) {
+	tests := []struct {
+		source  string
+		target  string
+		steps   int
+		wantErr bool
+	}{
+		{source: "2.1.0", target: "2.2.0", steps: 1},
+		{source: "2.1.4", target: "2.2.8", steps: 1},
+		{source: "2.2.0", target: "2.3.0", steps: 1},
+		{source: "2.1.0", target: "2.3.1", steps: 2},
+		{source: "2.2.0", target: "2.2.1", wantErr: true},
+		{source: "2.3.0", target: "2.2.0", wantErr: true},
+		{source: "2.0.0", target: "2.2.0", wantErr: true},
+		{source: "2.2.0", target: "2.4.0", wantErr: true},
+		{source: "invalid", target: "2.2.0", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.source+"->"+tt.target, func(t *testing.T) {
+			migrator, err := NewMigrator(tt.source, tt.target)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.steps, len(migrator.(chainMigrator)))
+		})
+	}
+}
+
+func TestDiffKeys(t *testing.T) {
+	before := map[string]string{"a": "1", "b": "2", "c": "3"}
+	after := map[string]string{"a": "1", "b": "4", "e": "5", "d": "6"}
+	diff := DiffKeys(before, after)
+	assert.Equal(t, []string{"d", "e"}, diff.Added)
+	assert.Equal(t, []string{"b"}, diff.Changed)
+	assert.Equal(t, []string{"c"}, diff.Removed)
+	assert.Equal(t, 1, diff.Unchanged)
+}
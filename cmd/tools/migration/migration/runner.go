@@ -120,12 +120,15 @@ func (r *Runner) Validate() error {
 }
 
 func (r *Runner) CheckCompatible() bool {
-	// TODO: more accurate.
+	source, err := semver.Parse(r.cfg.SourceVersion)
+	if err != nil {
+		return false
+	}
 	target, err := semver.Parse(r.cfg.TargetVersion)
 	if err != nil {
 		return false
 	}
-	return target.LT(versions.Version220)
+	return target.LT(versions.Version220) || versions.SameRange(source, target)
 }
 
 func (r *Runner) checkSessionsWithPrefix(prefix string) error {
@@ -227,6 +230,55 @@ func (r *Runner) Migrate() error {
 	return target.Save(targetMetas)
 }
 
+// DryRun migrates the meta in memory and shows the keys which would be added, changed or removed,
+// nothing is written to the meta store.
+func (r *Runner) DryRun() error {
+	migrator, err := NewMigrator(r.cfg.SourceVersion, r.cfg.TargetVersion)
+	if err != nil {
+		return err
+	}
+	source, err := backend.NewBackend(r.cfg.MilvusConfig, r.cfg.SourceVersion)
+	if err != nil {
+		return err
+	}
+	before, err := source.Dump()
+	if err != nil {
+		return err
+	}
+	metas, err := source.Load()
+	if err != nil {
+		return err
+	}
+	targetMetas, err := migrator.Migrate(metas)
+	if err != nil {
+		return err
+	}
+	target, err := backend.NewBackend(r.cfg.MilvusConfig, r.cfg.TargetVersion)
+	if err != nil {
+		return err
+	}
+	after, err := target.GenerateSaves(targetMetas)
+	if err != nil {
+		return err
+	}
+	showDiff(DiffKeys(before, after))
+	return nil
+}
+
+func showDiff(diff *KeysDiff) {
+	for _, key := range diff.Added {
+		fmt.Printf("+ %s\n", key)
+	}
+	for _, key := range diff.Changed {
+		fmt.Printf("~ %s\n", key)
+	}
+	for _, key := range diff.Removed {
+		fmt.Printf("- %s\n", key)
+	}
+	console.Warning(fmt.Sprintf("dry run, added: %d, changed: %d, removed: %d, unchanged: %d",
+		len(diff.Added), len(diff.Changed), len(diff.Removed), diff.Unchanged))
+}
+
 func (r *Runner) waitUntilSessionExpired() {
 	for {
 		err := r.checkSessionsWithPrefix(Role)
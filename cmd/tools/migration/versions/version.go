@@ -6,6 +6,7 @@ const (
 	version210Str = "2.1.0"
 	version220Str = "2.2.0"
 	version230Str = "2.3.0"
+	version240Str = "2.4.0"
 	VersionMaxStr = "1000.1000.1000"
 )
 
@@ -13,6 +14,7 @@ var (
 	Version220 semver.Version
 	Version210 semver.Version
 	Version230 semver.Version
+	Version240 semver.Version
 	VersionMax semver.Version
 )
 
@@ -20,6 +22,7 @@ func init() {
 	Version210, _ = semver.Parse(version210Str)
 	Version220, _ = semver.Parse(version220Str)
 	Version230, _ = semver.Parse(version230Str)
+	Version240, _ = semver.Parse(version240Str)
 	VersionMax, _ = semver.Parse(VersionMaxStr)
 }
 
@@ -30,3 +33,12 @@ func Range21x(version semver.Version) bool {
 func Range22x(version semver.Version) bool {
 	return version.GTE(Version220) && version.LT(Version230)
 }
+
+func Range23x(version semver.Version) bool {
+	return version.GTE(Version230) && version.LT(Version240)
+}
+
+// SameRange returns true if the versions are of the same minor version, the meta layouts are identical in the range.
+func SameRange(v1, v2 semver.Version) bool {
+	return v1.Major == v2.Major && v1.Minor == v2.Minor
+}
@@ -75,3 +75,48 @@ func TestRange22x(t *testing.T) {
 		})
 	}
 }
+
+func TestRange23x(t *testing.T) {
+	type args struct {
+		version semver.Version
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			args: args{version: VersionMax},
+			want: false,
+		},
+		{
+			args: args{version: Version240},
+			want: false,
+		},
+		{
+			args: args{version: Version230},
+			want: true,
+		},
+		{
+			args: args{version: Version220},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Range23x(tt.args.version); got != tt.want {
+				t.Errorf("Range23x() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSameRange(t *testing.T) {
+	v221, _ := semver.Parse("2.2.1")
+	if !SameRange(Version220, v221) {
+		t.Errorf("SameRange(%s, %s) = false, want true", Version220, v221)
+	}
+	if SameRange(Version220, Version230) {
+		t.Errorf("SameRange(%s, %s) = true, want false", Version220, Version230)
+	}
+}
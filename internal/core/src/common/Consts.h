@@ -32,6 +32,12 @@ const char MAX_LENGTH[] = "max_length";
 const milvus::FieldId RowFieldID = milvus::FieldId(0);
 const milvus::FieldId TimestampFieldID = milvus::FieldId(1);
 
+// type param of the timestamp field carrying the collection ttl
+const char COLLECTION_TTL_KEY[] = "collection.ttl.seconds";
+
+// bits of the logical part of a hybrid timestamp
+const int LOGICAL_BITS = 18;
+
 // fill followed extra info to binlog file
 const char ORIGIN_SIZE_KEY[] = "original_size";
 const char INDEX_BUILD_ID_KEY[] = "indexBuildID";
@@ -57,6 +57,13 @@ Schema::ParseFrom(const milvus::proto::schema::CollectionSchema& schema_proto) {
             AssertInfo(is_system,
                        "invalid system type: name(" + name.get() + "), id(" +
                            std::to_string(field_id.get()) + ")");
+            if (field_id == TimestampFieldID) {
+                auto type_map = RepeatedKeyValToMap(child.type_params());
+                if (type_map.count(COLLECTION_TTL_KEY)) {
+                    schema->set_collection_ttl(boost::lexical_cast<int64_t>(
+                        type_map.at(COLLECTION_TTL_KEY)));
+                }
+            }
             continue;
         }
 
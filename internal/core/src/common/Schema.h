@@ -146,6 +146,17 @@ class Schema {
         return primary_field_id_opt_;
     }
 
+    // time to live of entities in seconds, 0 means never expire
+    void
+    set_collection_ttl(int64_t ttl_seconds) {
+        this->collection_ttl_ = ttl_seconds;
+    }
+
+    int64_t
+    get_collection_ttl() const {
+        return collection_ttl_;
+    }
+
  public:
     static std::shared_ptr<Schema>
     ParseFrom(const milvus::proto::schema::CollectionSchema& schema_proto);
@@ -179,6 +190,7 @@ class Schema {
 
     int64_t total_sizeof_ = 0;
     std::optional<FieldId> primary_field_id_opt_;
+    int64_t collection_ttl_ = 0;
 };
 
 using SchemaPtr = std::shared_ptr<Schema>;
@@ -16,6 +16,7 @@
 
 #include <string>
 #include "index/ScalarIndexSort.h"
+#include "index/ScalarIndexInverted.h"
 #include "index/StringIndexMarisa.h"
 #include "index/BoolIndex.h"
 
@@ -24,6 +25,12 @@ namespace milvus::index {
 template <typename T>
 inline ScalarIndexPtr<T>
 IndexFactory::CreateScalarIndex(const IndexType& index_type) {
+    if (index_type == INVERTED) {
+        return CreateScalarIndexInverted<T>();
+    }
+    if (index_type == BITMAP) {
+        return CreateScalarIndexBitmap<T>();
+    }
     return CreateScalarIndexSort<T>();
 }
 
@@ -36,6 +43,12 @@ IndexFactory::CreateScalarIndex(const IndexType& index_type) {
 template <>
 inline ScalarIndexPtr<std::string>
 IndexFactory::CreateScalarIndex(const IndexType& index_type) {
+    if (index_type == INVERTED) {
+        return CreateScalarIndexInverted<std::string>();
+    }
+    if (index_type == BITMAP) {
+        return CreateScalarIndexBitmap<std::string>();
+    }
 #if defined(__linux__) || defined(__APPLE__)
     return CreateStringIndexMarisa();
 #else
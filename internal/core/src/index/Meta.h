@@ -26,9 +26,13 @@ constexpr const char* LOWER_BOUND_INCLUSIVE = "lower_bound_inclusive";
 constexpr const char* UPPER_BOUND_VALUE = "upper_bound_value";
 constexpr const char* UPPER_BOUND_INCLUSIVE = "upper_bound_inclusive";
 constexpr const char* PREFIX_VALUE = "prefix_value";
+constexpr const char* BITMAP_CARDINALITY_LIMIT = "bitmap_cardinality_limit";
 // below configurations will be persistent, do not edit them.
 constexpr const char* MARISA_TRIE_INDEX = "marisa_trie_index";
 constexpr const char* MARISA_STR_IDS = "marisa_trie_str_ids";
+constexpr const char* INVERTED_INDEX_KEYS = "inverted_index_keys";
+constexpr const char* INVERTED_INDEX_OFFSETS = "inverted_index_offsets";
+constexpr const char* INVERTED_INDEX_USE_BITMAP = "inverted_index_use_bitmap";
 
 constexpr const char* INDEX_TYPE = "index_type";
 constexpr const char* METRIC_TYPE = "metric_type";
@@ -36,6 +40,8 @@ constexpr const char* METRIC_TYPE = "metric_type";
 // scalar index type
 constexpr const char* ASCENDING_SORT = "STL_SORT";
 constexpr const char* MARISA_TRIE = "Trie";
+constexpr const char* INVERTED = "INVERTED";
+constexpr const char* BITMAP = "BITMAP";
 
 // index meta
 constexpr const char* COLLECTION_ID = "collection_id";
@@ -0,0 +1,307 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include <algorithm>
+#include <cstring>
+#include <memory>
+#include <string>
+#include <vector>
+#include "Meta.h"
+#include "common/Utils.h"
+#include "index/Utils.h"
+#include "common/Slice.h"
+
+namespace milvus::index {
+
+template <typename T>
+inline ScalarIndexInverted<T>::ScalarIndexInverted(bool use_bitmap,
+                                                   size_t cardinality_limit)
+    : use_bitmap_(use_bitmap),
+      cardinality_limit_(cardinality_limit),
+      is_built_(false) {
+}
+
+template <typename T>
+inline void
+ScalarIndexInverted<T>::BuildWithRawData(size_t n,
+                                         const void* values,
+                                         const Config& config) {
+    auto limit =
+        GetValueFromConfig<std::string>(config, BITMAP_CARDINALITY_LIMIT);
+    if (limit.has_value()) {
+        cardinality_limit_ = std::stoul(limit.value());
+    }
+    ScalarIndexInvertedBase<T>::BuildWithRawData(n, values, config);
+}
+
+template <typename T>
+inline void
+ScalarIndexInverted<T>::Build(const size_t n, const T* values) {
+    if (is_built_)
+        return;
+    if (n == 0) {
+        throw std::invalid_argument(
+            "ScalarIndexInverted cannot build null values!");
+    }
+    keys_.assign(values, values + n);
+    std::sort(keys_.begin(), keys_.end());
+    keys_.erase(std::unique(keys_.begin(), keys_.end()), keys_.end());
+    if (keys_.size() > cardinality_limit_) {
+        use_bitmap_ = false;
+    }
+
+    offset_to_key_.resize(n);
+    for (size_t i = 0; i < n; ++i) {
+        offset_to_key_[i] = lower_bound(values[i]);
+    }
+    build_postings();
+    is_built_ = true;
+}
+
+template <typename T>
+inline void
+ScalarIndexInverted<T>::build_postings() {
+    if (use_bitmap_) {
+        bitmaps_.assign(keys_.size(), TargetBitmap(offset_to_key_.size()));
+        for (size_t i = 0; i < offset_to_key_.size(); ++i) {
+            bitmaps_[offset_to_key_[i]].set(i);
+        }
+        return;
+    }
+    postings_.assign(keys_.size(), std::vector<int32_t>());
+    for (size_t i = 0; i < offset_to_key_.size(); ++i) {
+        postings_[offset_to_key_[i]].push_back(i);
+    }
+}
+
+template <typename T>
+inline BinarySet
+ScalarIndexInverted<T>::Serialize(const Config& config) {
+    AssertInfo(is_built_, "index has not been built");
+
+    std::vector<uint8_t> keys_buf;
+    auto append = [&keys_buf](const void* data, size_t size) {
+        auto p = reinterpret_cast<const uint8_t*>(data);
+        keys_buf.insert(keys_buf.end(), p, p + size);
+    };
+    for (size_t i = 0; i < keys_.size(); ++i) {
+        if constexpr (std::is_same_v<T, std::string>) {
+            const std::string& key = keys_[i];
+            uint32_t len = key.size();
+            append(&len, sizeof(len));
+            append(key.data(), len);
+        } else {
+            T key = keys_[i];
+            append(&key, sizeof(T));
+        }
+    }
+    std::shared_ptr<uint8_t[]> keys_data(new uint8_t[keys_buf.size()]);
+    memcpy(keys_data.get(), keys_buf.data(), keys_buf.size());
+
+    auto offsets_size = offset_to_key_.size() * sizeof(int32_t);
+    std::shared_ptr<uint8_t[]> offsets_data(new uint8_t[offsets_size]);
+    memcpy(offsets_data.get(), offset_to_key_.data(), offsets_size);
+
+    std::shared_ptr<uint8_t[]> use_bitmap_data(new uint8_t[1]);
+    use_bitmap_data[0] = use_bitmap_ ? 1 : 0;
+
+    BinarySet res_set;
+    res_set.Append(INVERTED_INDEX_KEYS, keys_data, keys_buf.size());
+    res_set.Append(INVERTED_INDEX_OFFSETS, offsets_data, offsets_size);
+    res_set.Append(INVERTED_INDEX_USE_BITMAP, use_bitmap_data, 1);
+
+    milvus::Disassemble(res_set);
+
+    return res_set;
+}
+
+template <typename T>
+inline void
+ScalarIndexInverted<T>::Load(const BinarySet& index_binary,
+                             const Config& config) {
+    milvus::Assemble(const_cast<BinarySet&>(index_binary));
+
+    auto keys_data = index_binary.GetByName(INVERTED_INDEX_KEYS);
+    auto p = keys_data->data.get();
+    auto end = p + keys_data->size;
+    keys_.clear();
+    while (p < end) {
+        if constexpr (std::is_same_v<T, std::string>) {
+            uint32_t len;
+            memcpy(&len, p, sizeof(len));
+            p += sizeof(len);
+            keys_.emplace_back(reinterpret_cast<const char*>(p), len);
+            p += len;
+        } else {
+            T key;
+            memcpy(&key, p, sizeof(T));
+            p += sizeof(T);
+            keys_.push_back(key);
+        }
+    }
+
+    auto offsets_data = index_binary.GetByName(INVERTED_INDEX_OFFSETS);
+    offset_to_key_.resize(offsets_data->size / sizeof(int32_t));
+    memcpy(offset_to_key_.data(),
+           offsets_data->data.get(),
+           (size_t)offsets_data->size);
+
+    // the index built with too many distinct values keeps the posting lists.
+    if (index_binary.Contains(INVERTED_INDEX_USE_BITMAP)) {
+        auto use_bitmap_data =
+            index_binary.GetByName(INVERTED_INDEX_USE_BITMAP);
+        use_bitmap_ = use_bitmap_data->data[0] != 0;
+    }
+    build_postings();
+    is_built_ = true;
+}
+
+template <typename T>
+inline size_t
+ScalarIndexInverted<T>::lower_bound(const T& value) const {
+    return std::lower_bound(keys_.begin(), keys_.end(), value) -
+           keys_.begin();
+}
+
+template <typename T>
+inline size_t
+ScalarIndexInverted<T>::upper_bound(const T& value) const {
+    return std::upper_bound(keys_.begin(), keys_.end(), value) -
+           keys_.begin();
+}
+
+template <typename T>
+inline void
+ScalarIndexInverted<T>::fill(TargetBitmap& bitset,
+                             size_t begin,
+                             size_t end) const {
+    for (auto i = begin; i < end; ++i) {
+        if (use_bitmap_) {
+            bitset |= bitmaps_[i];
+            continue;
+        }
+        for (auto offset : postings_[i]) {
+            bitset.set(offset);
+        }
+    }
+}
+
+template <typename T>
+inline const TargetBitmapPtr
+ScalarIndexInverted<T>::In(const size_t n, const T* values) {
+    AssertInfo(is_built_, "index has not been built");
+    TargetBitmapPtr bitset = std::make_unique<TargetBitmap>(Count());
+    for (size_t i = 0; i < n; ++i) {
+        auto idx = lower_bound(values[i]);
+        if (idx < keys_.size() && keys_[idx] == values[i]) {
+            fill(*bitset, idx, idx + 1);
+        }
+    }
+    return bitset;
+}
+
+template <typename T>
+inline const TargetBitmapPtr
+ScalarIndexInverted<T>::NotIn(const size_t n, const T* values) {
+    AssertInfo(is_built_, "index has not been built");
+    TargetBitmapPtr bitset = std::make_unique<TargetBitmap>(Count());
+    for (size_t i = 0; i < n; ++i) {
+        auto idx = lower_bound(values[i]);
+        if (idx < keys_.size() && keys_[idx] == values[i]) {
+            fill(*bitset, idx, idx + 1);
+        }
+    }
+    bitset->flip();
+    return bitset;
+}
+
+template <typename T>
+inline const TargetBitmapPtr
+ScalarIndexInverted<T>::Range(const T value, const OpType op) {
+    AssertInfo(is_built_, "index has not been built");
+    TargetBitmapPtr bitset = std::make_unique<TargetBitmap>(Count());
+    size_t begin = 0;
+    size_t end = keys_.size();
+    switch (op) {
+        case OpType::LessThan:
+            end = lower_bound(value);
+            break;
+        case OpType::LessEqual:
+            end = upper_bound(value);
+            break;
+        case OpType::GreaterThan:
+            begin = upper_bound(value);
+            break;
+        case OpType::GreaterEqual:
+            begin = lower_bound(value);
+            break;
+        default:
+            throw std::invalid_argument(std::string("Invalid OperatorType: ") +
+                                        std::to_string((int)op) + "!");
+    }
+    fill(*bitset, begin, end);
+    return bitset;
+}
+
+template <typename T>
+inline const TargetBitmapPtr
+ScalarIndexInverted<T>::Range(T lower_bound_value,
+                              bool lb_inclusive,
+                              T upper_bound_value,
+                              bool ub_inclusive) {
+    AssertInfo(is_built_, "index has not been built");
+    TargetBitmapPtr bitset = std::make_unique<TargetBitmap>(Count());
+    if (lower_bound_value > upper_bound_value ||
+        (lower_bound_value == upper_bound_value &&
+         !(lb_inclusive && ub_inclusive))) {
+        return bitset;
+    }
+    auto begin = lb_inclusive ? lower_bound(lower_bound_value)
+                              : upper_bound(lower_bound_value);
+    auto end = ub_inclusive ? upper_bound(upper_bound_value)
+                            : lower_bound(upper_bound_value);
+    fill(*bitset, begin, end);
+    return bitset;
+}
+
+template <typename T>
+inline const TargetBitmapPtr
+ScalarIndexInverted<T>::PrefixMatch(const std::string_view prefix) {
+    AssertInfo(is_built_, "index has not been built");
+    TargetBitmapPtr bitset = std::make_unique<TargetBitmap>(Count());
+    if constexpr (std::is_same_v<T, std::string>) {
+        for (auto i = lower_bound(std::string(prefix)); i < keys_.size();
+             ++i) {
+            if (!milvus::PrefixMatch(keys_[i], prefix)) {
+                break;
+            }
+            fill(*bitset, i, i + 1);
+        }
+    } else {
+        PanicInfo("prefix match is only supported on string index");
+    }
+    return bitset;
+}
+
+template <typename T>
+inline T
+ScalarIndexInverted<T>::Reverse_Lookup(size_t offset) const {
+    AssertInfo(offset < offset_to_key_.size(), "out of range of total count");
+    AssertInfo(is_built_, "index has not been built");
+
+    return keys_[offset_to_key_[offset]];
+}
+}  // namespace milvus::index
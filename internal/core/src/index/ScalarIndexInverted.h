@@ -0,0 +1,148 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#pragma once
+
+#include <memory>
+#include <string>
+#include <string_view>
+#include <type_traits>
+#include <vector>
+#include "index/ScalarIndex.h"
+#include "index/StringIndex.h"
+
+namespace milvus::index {
+
+template <typename T>
+using ScalarIndexInvertedBase =
+    std::conditional_t<std::is_same_v<T, std::string>,
+                       StringIndex,
+                       ScalarIndex<T>>;
+
+// the BITMAP index falls back to posting lists if there are more distinct
+// values, since each bitmap takes one bit per row.
+constexpr size_t DEFAULT_BITMAP_CARDINALITY_LIMIT = 100;
+
+// ScalarIndexInverted keeps the sorted distinct values of a field, and for each
+// value the rows holding it. The rows are kept as posting lists (INVERTED), or
+// as bitmaps (BITMAP) which are cheaper to combine for low-cardinality fields.
+template <typename T>
+class ScalarIndexInverted : public ScalarIndexInvertedBase<T> {
+ public:
+    explicit ScalarIndexInverted(
+        bool use_bitmap = false,
+        size_t cardinality_limit = DEFAULT_BITMAP_CARDINALITY_LIMIT);
+
+    // reads the bitmap_cardinality_limit of the index params before building.
+    void
+    BuildWithRawData(size_t n,
+                     const void* values,
+                     const Config& config = {}) override;
+
+    BinarySet
+    Serialize(const Config& config) override;
+
+    void
+    Load(const BinarySet& index_binary, const Config& config = {}) override;
+
+    int64_t
+    Count() override {
+        return offset_to_key_.size();
+    }
+
+    void
+    Build(size_t n, const T* values) override;
+
+    const TargetBitmapPtr
+    In(size_t n, const T* values) override;
+
+    const TargetBitmapPtr
+    NotIn(size_t n, const T* values) override;
+
+    const TargetBitmapPtr
+    Range(T value, OpType op) override;
+
+    const TargetBitmapPtr
+    Range(T lower_bound_value,
+          bool lb_inclusive,
+          T upper_bound_value,
+          bool ub_inclusive) override;
+
+    // only overrides StringIndex::PrefixMatch for VarChar fields.
+    const TargetBitmapPtr
+    PrefixMatch(const std::string_view prefix);
+
+    T
+    Reverse_Lookup(size_t offset) const override;
+
+    int64_t
+    Size() override {
+        return (int64_t)keys_.size();
+    }
+
+    // whether the rows are kept as bitmaps, false if the BITMAP index falls
+    // back to posting lists for the high cardinality.
+    bool
+    UseBitmap() const {
+        return use_bitmap_;
+    }
+
+ private:
+    // index of the first key not less than value.
+    size_t
+    lower_bound(const T& value) const;
+
+    // index of the first key greater than value.
+    size_t
+    upper_bound(const T& value) const;
+
+    // set the rows holding keys_[begin, end).
+    void
+    fill(TargetBitmap& bitset, size_t begin, size_t end) const;
+
+    void
+    build_postings();
+
+ private:
+    bool use_bitmap_;
+    size_t cardinality_limit_;
+    bool is_built_;
+    std::vector<T> keys_;                // sorted distinct values.
+    std::vector<int32_t> offset_to_key_;  // used to retrieve.
+    std::vector<std::vector<int32_t>> postings_;
+    std::vector<TargetBitmap> bitmaps_;
+};
+
+template <typename T>
+using ScalarIndexInvertedPtr = std::unique_ptr<ScalarIndexInverted<T>>;
+
+}  // namespace milvus::index
+
+#include "index/ScalarIndexInverted-inl.h"
+
+namespace milvus::index {
+template <typename T>
+inline ScalarIndexInvertedPtr<T>
+CreateScalarIndexInverted() {
+    return std::make_unique<ScalarIndexInverted<T>>(false);
+}
+
+template <typename T>
+inline ScalarIndexInvertedPtr<T>
+CreateScalarIndexBitmap() {
+    return std::make_unique<ScalarIndexInverted<T>>(true);
+}
+}  // namespace milvus::index
@@ -51,7 +51,7 @@ void
 ScalarIndexCreator::Build(const milvus::DatasetPtr& dataset) {
     auto size = dataset->GetRows();
     auto data = dataset->GetTensor();
-    index_->BuildWithRawData(size, data);
+    index_->BuildWithRawData(size, data, config_);
 }
 
 milvus::BinarySet
@@ -66,8 +66,10 @@ ScalarIndexCreator::Load(const milvus::BinarySet& binary_set) {
 
 std::string
 ScalarIndexCreator::index_type() {
-    // TODO
-    return "sort";
+    if (config_.contains(index::INDEX_TYPE)) {
+        return config_[index::INDEX_TYPE].get<std::string>();
+    }
+    return index::ASCENDING_SORT;
 }
 
 }  // namespace milvus::indexbuilder
@@ -551,72 +551,73 @@ const char descriptor_table_protodef_plan_2eproto[] PROTOBUF_SECTION_VARIABLE(pr
   " \001(\0162\031.milvus.proto.plan.OpType\"o\n\010TermE"
   "xpr\0222\n\013column_info\030\001 \001(\0132\035.milvus.proto."
   "plan.ColumnInfo\022/\n\006values\030\002 \003(\0132\037.milvus"
-  ".proto.plan.GenericValue\"\206\001\n\tUnaryExpr\0220"
+  ".proto.plan.GenericValue\"\241\001\n\tUnaryExpr\0220"
   "\n\002op\030\001 \001(\0162$.milvus.proto.plan.UnaryExpr"
   ".UnaryOp\022&\n\005child\030\002 \001(\0132\027.milvus.proto.p"
-  "lan.Expr\"\037\n\007UnaryOp\022\013\n\007Invalid\020\000\022\007\n\003Not\020"
-  "\001\"\307\001\n\nBinaryExpr\0222\n\002op\030\001 \001(\0162&.milvus.pr"
-  "oto.plan.BinaryExpr.BinaryOp\022%\n\004left\030\002 \001"
-  "(\0132\027.milvus.proto.plan.Expr\022&\n\005right\030\003 \001"
-  "(\0132\027.milvus.proto.plan.Expr\"6\n\010BinaryOp\022"
-  "\013\n\007Invalid\020\000\022\016\n\nLogicalAnd\020\001\022\r\n\tLogicalO"
-  "r\020\002\"\255\001\n\rBinaryArithOp\0222\n\013column_info\030\001 \001"
-  "(\0132\035.milvus.proto.plan.ColumnInfo\0220\n\010ari"
-  "th_op\030\002 \001(\0162\036.milvus.proto.plan.ArithOpT"
-  "ype\0226\n\rright_operand\030\003 \001(\0132\037.milvus.prot"
-  "o.plan.GenericValue\"\214\001\n\017BinaryArithExpr\022"
-  "%\n\004left\030\001 \001(\0132\027.milvus.proto.plan.Expr\022&"
-  "\n\005right\030\002 \001(\0132\027.milvus.proto.plan.Expr\022*"
-  "\n\002op\030\003 \001(\0162\036.milvus.proto.plan.ArithOpTy"
-  "pe\"\221\002\n\032BinaryArithOpEvalRangeExpr\0222\n\013col"
-  "umn_info\030\001 \001(\0132\035.milvus.proto.plan.Colum"
-  "nInfo\0220\n\010arith_op\030\002 \001(\0162\036.milvus.proto.p"
-  "lan.ArithOpType\0226\n\rright_operand\030\003 \001(\0132\037"
-  ".milvus.proto.plan.GenericValue\022%\n\002op\030\004 "
-  "\001(\0162\031.milvus.proto.plan.OpType\022.\n\005value\030"
-  "\005 \001(\0132\037.milvus.proto.plan.GenericValue\"\347"
-  "\004\n\004Expr\0220\n\tterm_expr\030\001 \001(\0132\033.milvus.prot"
-  "o.plan.TermExprH\000\0222\n\nunary_expr\030\002 \001(\0132\034."
-  "milvus.proto.plan.UnaryExprH\000\0224\n\013binary_"
-  "expr\030\003 \001(\0132\035.milvus.proto.plan.BinaryExp"
-  "rH\000\0226\n\014compare_expr\030\004 \001(\0132\036.milvus.proto"
-  ".plan.CompareExprH\000\022=\n\020unary_range_expr\030"
-  "\005 \001(\0132!.milvus.proto.plan.UnaryRangeExpr"
-  "H\000\022\?\n\021binary_range_expr\030\006 \001(\0132\".milvus.p"
-  "roto.plan.BinaryRangeExprH\000\022X\n\037binary_ar"
-  "ith_op_eval_range_expr\030\007 \001(\0132-.milvus.pr"
-  "oto.plan.BinaryArithOpEvalRangeExprH\000\022\?\n"
-  "\021binary_arith_expr\030\010 \001(\0132\".milvus.proto."
-  "plan.BinaryArithExprH\000\0222\n\nvalue_expr\030\t \001"
-  "(\0132\034.milvus.proto.plan.ValueExprH\000\0224\n\013co"
-  "lumn_expr\030\n \001(\0132\035.milvus.proto.plan.Colu"
-  "mnExprH\000B\006\n\004expr\"\251\001\n\nVectorANNS\022\021\n\tis_bi"
-  "nary\030\001 \001(\010\022\020\n\010field_id\030\002 \001(\003\022+\n\npredicat"
-  "es\030\003 \001(\0132\027.milvus.proto.plan.Expr\0220\n\nque"
-  "ry_info\030\004 \001(\0132\034.milvus.proto.plan.QueryI"
-  "nfo\022\027\n\017placeholder_tag\030\005 \001(\t\"N\n\rQueryPla"
-  "nNode\022+\n\npredicates\030\001 \001(\0132\027.milvus.proto"
-  ".plan.Expr\022\020\n\010is_count\030\002 \001(\010\"\304\001\n\010PlanNod"
-  "e\0224\n\013vector_anns\030\001 \001(\0132\035.milvus.proto.pl"
-  "an.VectorANNSH\000\022-\n\npredicates\030\002 \001(\0132\027.mi"
-  "lvus.proto.plan.ExprH\000\0221\n\005query\030\004 \001(\0132 ."
-  "milvus.proto.plan.QueryPlanNodeH\000\022\030\n\020out"
-  "put_field_ids\030\003 \003(\003B\006\n\004node*\272\001\n\006OpType\022\013"
-  "\n\007Invalid\020\000\022\017\n\013GreaterThan\020\001\022\020\n\014GreaterE"
-  "qual\020\002\022\014\n\010LessThan\020\003\022\r\n\tLessEqual\020\004\022\t\n\005E"
-  "qual\020\005\022\014\n\010NotEqual\020\006\022\017\n\013PrefixMatch\020\007\022\020\n"
-  "\014PostfixMatch\020\010\022\t\n\005Match\020\t\022\t\n\005Range\020\n\022\006\n"
-  "\002In\020\013\022\t\n\005NotIn\020\014*G\n\013ArithOpType\022\013\n\007Unkno"
-  "wn\020\000\022\007\n\003Add\020\001\022\007\n\003Sub\020\002\022\007\n\003Mul\020\003\022\007\n\003Div\020\004"
-  "\022\007\n\003Mod\020\005B3Z1github.com/milvus-io/milvus"
-  "/internal/proto/planpbb\006proto3"
+  "lan.Expr\":\n\007UnaryOp\022\013\n\007Invalid\020\000\022\007\n\003Not\020"
+  "\001\022\n\n\006IsNull\020\002\022\r\n\tIsNotNull\020\003\"\307\001\n\nBinaryE"
+  "xpr\0222\n\002op\030\001 \001(\0162&.milvus.proto.plan.Bina"
+  "ryExpr.BinaryOp\022%\n\004left\030\002 \001(\0132\027.milvus.p"
+  "roto.plan.Expr\022&\n\005right\030\003 \001(\0132\027.milvus.p"
+  "roto.plan.Expr\"6\n\010BinaryOp\022\013\n\007Invalid\020\000\022"
+  "\016\n\nLogicalAnd\020\001\022\r\n\tLogicalOr\020\002\"\255\001\n\rBinar"
+  "yArithOp\0222\n\013column_info\030\001 \001(\0132\035.milvus.p"
+  "roto.plan.ColumnInfo\0220\n\010arith_op\030\002 \001(\0162\036"
+  ".milvus.proto.plan.ArithOpType\0226\n\rright_"
+  "operand\030\003 \001(\0132\037.milvus.proto.plan.Generi"
+  "cValue\"\214\001\n\017BinaryArithExpr\022%\n\004left\030\001 \001(\013"
+  "2\027.milvus.proto.plan.Expr\022&\n\005right\030\002 \001(\013"
+  "2\027.milvus.proto.plan.Expr\022*\n\002op\030\003 \001(\0162\036."
+  "milvus.proto.plan.ArithOpType\"\221\002\n\032Binary"
+  "ArithOpEvalRangeExpr\0222\n\013column_info\030\001 \001("
+  "\0132\035.milvus.proto.plan.ColumnInfo\0220\n\010arit"
+  "h_op\030\002 \001(\0162\036.milvus.proto.plan.ArithOpTy"
+  "pe\0226\n\rright_operand\030\003 \001(\0132\037.milvus.proto"
+  ".plan.GenericValue\022%\n\002op\030\004 \001(\0162\031.milvus."
+  "proto.plan.OpType\022.\n\005value\030\005 \001(\0132\037.milvu"
+  "s.proto.plan.GenericValue\"\347\004\n\004Expr\0220\n\tte"
+  "rm_expr\030\001 \001(\0132\033.milvus.proto.plan.TermEx"
+  "prH\000\0222\n\nunary_expr\030\002 \001(\0132\034.milvus.proto."
+  "plan.UnaryExprH\000\0224\n\013binary_expr\030\003 \001(\0132\035."
+  "milvus.proto.plan.BinaryExprH\000\0226\n\014compar"
+  "e_expr\030\004 \001(\0132\036.milvus.proto.plan.Compare"
+  "ExprH\000\022=\n\020unary_range_expr\030\005 \001(\0132!.milvu"
+  "s.proto.plan.UnaryRangeExprH\000\022\?\n\021binary_"
+  "range_expr\030\006 \001(\0132\".milvus.proto.plan.Bin"
+  "aryRangeExprH\000\022X\n\037binary_arith_op_eval_r"
+  "ange_expr\030\007 \001(\0132-.milvus.proto.plan.Bina"
+  "ryArithOpEvalRangeExprH\000\022\?\n\021binary_arith"
+  "_expr\030\010 \001(\0132\".milvus.proto.plan.BinaryAr"
+  "ithExprH\000\0222\n\nvalue_expr\030\t \001(\0132\034.milvus.p"
+  "roto.plan.ValueExprH\000\0224\n\013column_expr\030\n \001"
+  "(\0132\035.milvus.proto.plan.ColumnExprH\000B\006\n\004e"
+  "xpr\"\251\001\n\nVectorANNS\022\021\n\tis_binary\030\001 \001(\010\022\020\n"
+  "\010field_id\030\002 \001(\003\022+\n\npredicates\030\003 \001(\0132\027.mi"
+  "lvus.proto.plan.Expr\0220\n\nquery_info\030\004 \001(\013"
+  "2\034.milvus.proto.plan.QueryInfo\022\027\n\017placeh"
+  "older_tag\030\005 \001(\t\"N\n\rQueryPlanNode\022+\n\npred"
+  "icates\030\001 \001(\0132\027.milvus.proto.plan.Expr\022\020\n"
+  "\010is_count\030\002 \001(\010\"\304\001\n\010PlanNode\0224\n\013vector_a"
+  "nns\030\001 \001(\0132\035.milvus.proto.plan.VectorANNS"
+  "H\000\022-\n\npredicates\030\002 \001(\0132\027.milvus.proto.pl"
+  "an.ExprH\000\0221\n\005query\030\004 \001(\0132 .milvus.proto."
+  "plan.QueryPlanNodeH\000\022\030\n\020output_field_ids"
+  "\030\003 \003(\003B\006\n\004node*\272\001\n\006OpType\022\013\n\007Invalid\020\000\022\017"
+  "\n\013GreaterThan\020\001\022\020\n\014GreaterEqual\020\002\022\014\n\010Les"
+  "sThan\020\003\022\r\n\tLessEqual\020\004\022\t\n\005Equal\020\005\022\014\n\010Not"
+  "Equal\020\006\022\017\n\013PrefixMatch\020\007\022\020\n\014PostfixMatch"
+  "\020\010\022\t\n\005Match\020\t\022\t\n\005Range\020\n\022\006\n\002In\020\013\022\t\n\005NotI"
+  "n\020\014*G\n\013ArithOpType\022\013\n\007Unknown\020\000\022\007\n\003Add\020\001"
+  "\022\007\n\003Sub\020\002\022\007\n\003Mul\020\003\022\007\n\003Div\020\004\022\007\n\003Mod\020\005B3Z1"
+  "github.com/milvus-io/milvus/internal/pro"
+  "to/planpbb\006proto3"
   ;
 static const ::_pbi::DescriptorTable* const descriptor_table_plan_2eproto_deps[1] = {
   &::descriptor_table_schema_2eproto,
 };
 static ::_pbi::once_flag descriptor_table_plan_2eproto_once;
 const ::_pbi::DescriptorTable descriptor_table_plan_2eproto = {
-    false, false, 3510, descriptor_table_protodef_plan_2eproto,
+    false, false, 3537, descriptor_table_protodef_plan_2eproto,
     "plan.proto",
     &descriptor_table_plan_2eproto_once, descriptor_table_plan_2eproto_deps, 1, 18,
     schemas, file_default_instances, TableStruct_plan_2eproto::offsets,
@@ -640,6 +641,8 @@ bool UnaryExpr_UnaryOp_IsValid(int value) {
   switch (value) {
     case 0:
     case 1:
+    case 2:
+    case 3:
       return true;
     default:
       return false;
@@ -649,6 +652,8 @@ bool UnaryExpr_UnaryOp_IsValid(int value) {
 #if (__cplusplus < 201703) && (!defined(_MSC_VER) || (_MSC_VER >= 1900 && _MSC_VER < 1912))
 constexpr UnaryExpr_UnaryOp UnaryExpr::Invalid;
 constexpr UnaryExpr_UnaryOp UnaryExpr::Not;
+constexpr UnaryExpr_UnaryOp UnaryExpr::IsNull;
+constexpr UnaryExpr_UnaryOp UnaryExpr::IsNotNull;
 constexpr UnaryExpr_UnaryOp UnaryExpr::UnaryOp_MIN;
 constexpr UnaryExpr_UnaryOp UnaryExpr::UnaryOp_MAX;
 constexpr int UnaryExpr::UnaryOp_ARRAYSIZE;
@@ -133,12 +133,14 @@ namespace plan {
 enum UnaryExpr_UnaryOp : int {
   UnaryExpr_UnaryOp_Invalid = 0,
   UnaryExpr_UnaryOp_Not = 1,
+  UnaryExpr_UnaryOp_IsNull = 2,
+  UnaryExpr_UnaryOp_IsNotNull = 3,
   UnaryExpr_UnaryOp_UnaryExpr_UnaryOp_INT_MIN_SENTINEL_DO_NOT_USE_ = std::numeric_limits<int32_t>::min(),
   UnaryExpr_UnaryOp_UnaryExpr_UnaryOp_INT_MAX_SENTINEL_DO_NOT_USE_ = std::numeric_limits<int32_t>::max()
 };
 bool UnaryExpr_UnaryOp_IsValid(int value);
 constexpr UnaryExpr_UnaryOp UnaryExpr_UnaryOp_UnaryOp_MIN = UnaryExpr_UnaryOp_Invalid;
-constexpr UnaryExpr_UnaryOp UnaryExpr_UnaryOp_UnaryOp_MAX = UnaryExpr_UnaryOp_Not;
+constexpr UnaryExpr_UnaryOp UnaryExpr_UnaryOp_UnaryOp_MAX = UnaryExpr_UnaryOp_IsNotNull;
 constexpr int UnaryExpr_UnaryOp_UnaryOp_ARRAYSIZE = UnaryExpr_UnaryOp_UnaryOp_MAX + 1;
 
 const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* UnaryExpr_UnaryOp_descriptor();
@@ -2080,6 +2082,10 @@ class UnaryExpr final :
     UnaryExpr_UnaryOp_Invalid;
   static constexpr UnaryOp Not =
     UnaryExpr_UnaryOp_Not;
+  static constexpr UnaryOp IsNull =
+    UnaryExpr_UnaryOp_IsNull;
+  static constexpr UnaryOp IsNotNull =
+    UnaryExpr_UnaryOp_IsNotNull;
   static inline bool UnaryOp_IsValid(int value) {
     return UnaryExpr_UnaryOp_IsValid(value);
   }
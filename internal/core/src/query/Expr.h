@@ -225,4 +225,22 @@ struct CompareExpr : Expr {
     accept(ExprVisitor&) override;
 };
 
+// check whether the values of a nullable field are null
+struct NullExpr : Expr {
+    enum class OpType { Invalid = 0, IsNull = 1, IsNotNull = 2 };
+    const FieldId field_id_;
+    const DataType data_type_;
+    const OpType op_type_;
+
+    NullExpr(const FieldId field_id,
+             const DataType data_type,
+             const OpType op_type)
+        : field_id_(field_id), data_type_(data_type), op_type_(op_type) {
+    }
+
+ public:
+    void
+    accept(ExprVisitor&) override;
+};
+
 }  // namespace milvus::query
@@ -425,12 +425,31 @@ ProtoParser::ParseTermExpr(const proto::plan::TermExpr& expr_pb) {
 
 ExprPtr
 ProtoParser::ParseUnaryExpr(const proto::plan::UnaryExpr& expr_pb) {
+    if (expr_pb.op() == proto::plan::UnaryExpr::IsNull ||
+        expr_pb.op() == proto::plan::UnaryExpr::IsNotNull) {
+        return ParseNullExpr(expr_pb);
+    }
     auto op = static_cast<LogicalUnaryExpr::OpType>(expr_pb.op());
     Assert(op == LogicalUnaryExpr::OpType::LogicalNot);
     auto expr = this->ParseExpr(expr_pb.child());
     return std::make_unique<LogicalUnaryExpr>(op, expr);
 }
 
+ExprPtr
+ProtoParser::ParseNullExpr(const proto::plan::UnaryExpr& expr_pb) {
+    AssertInfo(expr_pb.child().has_column_expr(),
+               "child of null expr isn't column expr");
+    auto& column_info = expr_pb.child().column_expr().info();
+    auto field_id = FieldId(column_info.field_id());
+    auto data_type = schema[field_id].get_data_type();
+    Assert(data_type == static_cast<DataType>(column_info.data_type()));
+
+    auto op = expr_pb.op() == proto::plan::UnaryExpr::IsNull
+                  ? NullExpr::OpType::IsNull
+                  : NullExpr::OpType::IsNotNull;
+    return std::make_unique<NullExpr>(field_id, data_type, op);
+}
+
 ExprPtr
 ProtoParser::ParseBinaryExpr(const proto::plan::BinaryExpr& expr_pb) {
     auto op = static_cast<LogicalBinaryExpr::OpType>(expr_pb.op());
@@ -48,6 +48,9 @@ class ProtoParser {
     ExprPtr
     ParseUnaryExpr(const proto::plan::UnaryExpr& expr_pb);
 
+    ExprPtr
+    ParseNullExpr(const proto::plan::UnaryExpr& expr_pb);
+
     ExprPtr
     ParseBinaryExpr(const proto::plan::BinaryExpr& expr_pb);
 
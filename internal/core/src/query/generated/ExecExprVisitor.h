@@ -44,6 +44,9 @@ class ExecExprVisitor : public ExprVisitor {
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(NullExpr& expr) override;
+
  public:
     ExecExprVisitor(const segcore::SegmentInternalInterface& segment,
                     int64_t row_count,
@@ -53,11 +56,21 @@ class ExecExprVisitor : public ExprVisitor {
 
     BitsetType
     call_child(Expr& expr) {
+        BitsetTypeOpt unknown;
+        return call_child(expr, unknown);
+    }
+
+    // unknown is set to the rows whose results are unknown,
+    // as the values compared in them are null
+    BitsetType
+    call_child(Expr& expr, BitsetTypeOpt& unknown) {
         Assert(!bitset_opt_.has_value());
         expr.accept(*this);
         Assert(bitset_opt_.has_value());
         auto res = std::move(bitset_opt_);
         bitset_opt_ = std::nullopt;
+        unknown = std::move(unknown_opt_);
+        unknown_opt_ = std::nullopt;
         return std::move(res.value());
     }
 
@@ -100,11 +113,15 @@ class ExecExprVisitor : public ExprVisitor {
     ExecCompareExprDispatcher(CompareExpr& expr, CmpFunc cmp_func)
         -> BitsetType;
 
+    void
+    mask_with_valid_data(FieldId field_id, BitsetType& res);
+
  private:
     const segcore::SegmentInternalInterface& segment_;
     Timestamp timestamp_;
     int64_t row_count_;
 
     BitsetTypeOpt bitset_opt_;
+    BitsetTypeOpt unknown_opt_;
 };
 }  // namespace milvus::query
@@ -33,15 +33,20 @@ class ExecPlanNodeVisitor : public PlanNodeVisitor {
  public:
     ExecPlanNodeVisitor(const segcore::SegmentInterface& segment,
                         Timestamp timestamp,
-                        const PlaceholderGroup* placeholder_group)
+                        const PlaceholderGroup* placeholder_group,
+                        int64_t collection_ttl = 0)
         : segment_(segment),
           timestamp_(timestamp),
-          placeholder_group_(placeholder_group) {
+          placeholder_group_(placeholder_group),
+          collection_ttl_(collection_ttl) {
     }
 
     ExecPlanNodeVisitor(const segcore::SegmentInterface& segment,
-                        Timestamp timestamp)
-        : segment_(segment), timestamp_(timestamp) {
+                        Timestamp timestamp,
+                        int64_t collection_ttl = 0)
+        : segment_(segment),
+          timestamp_(timestamp),
+          collection_ttl_(collection_ttl) {
         placeholder_group_ = nullptr;
     }
 
@@ -77,6 +82,9 @@ class ExecPlanNodeVisitor : public PlanNodeVisitor {
     const segcore::SegmentInterface& segment_;
     Timestamp timestamp_;
     const PlaceholderGroup* placeholder_group_;
+    // the collection ttl of the plan, which follows the altered collection
+    // properties while the schema of the segment doesn't
+    int64_t collection_ttl_ = 0;
 
     SearchResultOpt search_result_opt_;
     RetrieveResultOpt retrieve_result_opt_;
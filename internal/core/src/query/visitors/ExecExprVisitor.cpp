@@ -36,6 +36,14 @@ class ExecExprVisitor : ExprVisitor {
 
     BitsetType
     call_child(Expr& expr) {
+        BitsetTypeOpt unknown;
+        return call_child(expr, unknown);
+    }
+
+    // unknown is set to the rows whose results are unknown,
+    // as the values compared in them are null
+    BitsetType
+    call_child(Expr& expr, BitsetTypeOpt& unknown) {
         AssertInfo(!bitset_opt_.has_value(),
                    "[ExecExprVisitor]Bitset already has value before accept");
         expr.accept(*this);
@@ -43,6 +51,8 @@ class ExecExprVisitor : ExprVisitor {
                    "[ExecExprVisitor]Bitset doesn't have value after accept");
         auto res = std::move(bitset_opt_);
         bitset_opt_ = std::nullopt;
+        unknown = std::move(unknown_opt_);
+        unknown_opt_ = std::nullopt;
         return std::move(res.value());
     }
 
@@ -79,22 +89,48 @@ class ExecExprVisitor : ExprVisitor {
     ExecCompareExprDispatcher(CompareExpr& expr, CmpFunc cmp_func)
         -> BitsetType;
 
+    void
+    mask_with_valid_data(FieldId field_id, BitsetType& res);
+
  private:
     const segcore::SegmentInternalInterface& segment_;
     int64_t row_count_;
     Timestamp timestamp_;
     BitsetTypeOpt bitset_opt_;
+    BitsetTypeOpt unknown_opt_;
 };
 }  // namespace impl
 
+// mask the rows in which the values of field_id are null,
+// the results of which are unknown
+void
+ExecExprVisitor::mask_with_valid_data(FieldId field_id, BitsetType& res) {
+    if (!segment_.has_valid_data(field_id)) {
+        return;
+    }
+    auto valid = segment_.get_valid_bitset(field_id, row_count_);
+    res &= valid;
+    valid.flip();
+    if (unknown_opt_.has_value()) {
+        unknown_opt_.value() |= valid;
+    } else {
+        unknown_opt_ = std::move(valid);
+    }
+}
+
 void
 ExecExprVisitor::visit(LogicalUnaryExpr& expr) {
     using OpType = LogicalUnaryExpr::OpType;
-    auto child_res = call_child(*expr.child_);
+    BitsetTypeOpt unknown;
+    auto child_res = call_child(*expr.child_, unknown);
     BitsetType res = std::move(child_res);
     switch (expr.op_type_) {
         case OpType::LogicalNot: {
             res.flip();
+            // not unknown is still unknown
+            if (unknown.has_value()) {
+                res -= unknown.value();
+            }
             break;
         }
         default: {
@@ -104,15 +140,76 @@ ExecExprVisitor::visit(LogicalUnaryExpr& expr) {
     AssertInfo(res.size() == row_count_,
                "[ExecExprVisitor]Size of results not equal row count");
     bitset_opt_ = std::move(res);
+    unknown_opt_ = std::move(unknown);
+}
+
+// evaluate the logical binary expr in three-valued logic,
+// a row is unknown if its result depends on the unknown rows of children
+static void
+ExecLogicalBinaryWithUnknown(LogicalBinaryExpr::OpType op_type,
+                             const BitsetType& left,
+                             const BitsetType& left_unknown,
+                             const BitsetType& right,
+                             const BitsetType& right_unknown,
+                             BitsetType& res,
+                             BitsetType& unknown) {
+    using OpType = LogicalBinaryExpr::OpType;
+    switch (op_type) {
+        case OpType::LogicalAnd: {
+            res = left & right;
+            unknown = (left_unknown & right_unknown) | (left_unknown & right) |
+                      (left & right_unknown);
+            break;
+        }
+        case OpType::LogicalOr: {
+            res = left | right;
+            unknown = (left_unknown | right_unknown) - res;
+            break;
+        }
+        case OpType::LogicalXor: {
+            unknown = left_unknown | right_unknown;
+            res = (left ^ right) - unknown;
+            break;
+        }
+        case OpType::LogicalMinus: {
+            auto not_right = ~right - right_unknown;
+            res = left & not_right;
+            unknown = (left_unknown & right_unknown) |
+                      (left_unknown & not_right) | (left & right_unknown);
+            break;
+        }
+        default: {
+            PanicInfo("Invalid Binary Op");
+        }
+    }
 }
 
 void
 ExecExprVisitor::visit(LogicalBinaryExpr& expr) {
     using OpType = LogicalBinaryExpr::OpType;
-    auto left = call_child(*expr.left_);
-    auto right = call_child(*expr.right_);
+    BitsetTypeOpt left_unknown;
+    BitsetTypeOpt right_unknown;
+    auto left = call_child(*expr.left_, left_unknown);
+    auto right = call_child(*expr.right_, right_unknown);
     AssertInfo(left.size() == right.size(),
                "[ExecExprVisitor]Left size not equal to right size");
+    if (left_unknown.has_value() || right_unknown.has_value()) {
+        BitsetType res;
+        BitsetType unknown;
+        ExecLogicalBinaryWithUnknown(
+            expr.op_type_,
+            left,
+            left_unknown.value_or(BitsetType(row_count_)),
+            right,
+            right_unknown.value_or(BitsetType(row_count_)),
+            res,
+            unknown);
+        AssertInfo(res.size() == row_count_,
+                   "[ExecExprVisitor]Size of results not equal row count");
+        bitset_opt_ = std::move(res);
+        unknown_opt_ = std::move(unknown);
+        return;
+    }
     auto res = std::move(left);
     switch (expr.op_type_) {
         case OpType::LogicalAnd: {
@@ -587,6 +684,7 @@ ExecExprVisitor::visit(UnaryRangeExpr& expr) {
     }
     AssertInfo(res.size() == row_count_,
                "[ExecExprVisitor]Size of results not equal row count");
+    mask_with_valid_data(expr.field_id_, res);
     bitset_opt_ = std::move(res);
 }
 
@@ -626,6 +724,7 @@ ExecExprVisitor::visit(BinaryArithOpEvalRangeExpr& expr) {
     }
     AssertInfo(res.size() == row_count_,
                "[ExecExprVisitor]Size of results not equal row count");
+    mask_with_valid_data(expr.field_id_, res);
     bitset_opt_ = std::move(res);
 }
 
@@ -677,6 +776,7 @@ ExecExprVisitor::visit(BinaryRangeExpr& expr) {
     }
     AssertInfo(res.size() == row_count_,
                "[ExecExprVisitor]Size of results not equal row count");
+    mask_with_valid_data(expr.field_id_, res);
     bitset_opt_ = std::move(res);
 }
 
@@ -955,6 +1055,8 @@ ExecExprVisitor::visit(CompareExpr& expr) {
     }
     AssertInfo(res.size() == row_count_,
                "[ExecExprVisitor]Size of results not equal row count");
+    mask_with_valid_data(expr.left_field_id_, res);
+    mask_with_valid_data(expr.right_field_id_, res);
     bitset_opt_ = std::move(res);
 }
 
@@ -1126,6 +1228,28 @@ ExecExprVisitor::visit(TermExpr& expr) {
         default:
             PanicInfo("unsupported");
     }
+    AssertInfo(res.size() == row_count_,
+               "[ExecExprVisitor]Size of results not equal row count");
+    mask_with_valid_data(expr.field_id_, res);
+    bitset_opt_ = std::move(res);
+}
+
+void
+ExecExprVisitor::visit(NullExpr& expr) {
+    using OpType = NullExpr::OpType;
+    auto res = segment_.get_valid_bitset(expr.field_id_, row_count_);
+    switch (expr.op_type_) {
+        case OpType::IsNull: {
+            res.flip();
+            break;
+        }
+        case OpType::IsNotNull: {
+            break;
+        }
+        default: {
+            PanicInfo("Invalid Null Op");
+        }
+    }
     AssertInfo(res.size() == row_count_,
                "[ExecExprVisitor]Size of results not equal row count");
     bitset_opt_ = std::move(res);
@@ -106,6 +106,7 @@ ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
         bitset_holder = std::make_unique<BitsetType>(active_count, false);
     }
     segment->mask_with_timestamps(*bitset_holder, timestamp_);
+    segment->mask_with_expiration(*bitset_holder, timestamp_, collection_ttl_);
 
     segment->mask_with_delete(*bitset_holder, active_count, timestamp_);
 
@@ -171,6 +172,7 @@ ExecPlanNodeVisitor::visit(RetrievePlanNode& node) {
     }
 
     segment->mask_with_timestamps(bitset_holder, timestamp_);
+    segment->mask_with_expiration(bitset_holder, timestamp_, collection_ttl_);
 
     segment->mask_with_delete(bitset_holder, active_count, timestamp_);
     // if bitset_holder is all 1's, we got empty result
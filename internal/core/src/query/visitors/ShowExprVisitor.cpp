@@ -317,4 +317,22 @@ ShowExprVisitor::visit(BinaryArithOpEvalRangeExpr& expr) {
     }
 }
 
+void
+ShowExprVisitor::visit(NullExpr& expr) {
+    AssertInfo(!json_opt_.has_value(),
+               "[ShowExprVisitor]Ret json already has value before visit");
+    using OpType = NullExpr::OpType;
+
+    AssertInfo(expr.op_type_ == OpType::IsNull ||
+                   expr.op_type_ == OpType::IsNotNull,
+               "[ShowExprVisitor]Expr op type isn't IsNull or IsNotNull");
+    auto op_name = expr.op_type_ == OpType::IsNull ? "IsNull" : "IsNotNull";
+
+    Json res{{"expr_type", "Null"},
+             {"field_id", expr.field_id_.get()},
+             {"data_type", datatype_name(expr.data_type_)},
+             {"op", op_name}};
+    json_opt_ = res;
+}
+
 }  // namespace milvus::query
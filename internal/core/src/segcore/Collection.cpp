@@ -21,6 +21,13 @@ Collection::Collection(const std::string_view collection_proto)
     parse();
 }
 
+void
+Collection::update_schema(const std::string_view collection_proto) {
+    replaced_schemas_.push_back(schema_);
+    schema_proto_ = collection_proto;
+    parse();
+}
+
 void
 Collection::parse() {
     // if (schema_proto_.empty()) {
@@ -13,6 +13,7 @@
 
 #include <memory>
 #include <string>
+#include <vector>
 
 #include "common/Schema.h"
 
@@ -25,6 +26,10 @@ class Collection {
     void
     parse();
 
+    // replaces the schema, the segments created before keep the old one
+    void
+    update_schema(const std::string_view collection_proto);
+
  public:
     SchemaPtr&
     get_schema() {
@@ -40,6 +45,9 @@ class Collection {
     std::string collection_name_;
     std::string schema_proto_;
     SchemaPtr schema_;
+    // plans reference the schema they are created with, the replaced schemas
+    // are kept alive for the plans still running
+    std::vector<SchemaPtr> replaced_schemas_;
 };
 
 using CollectionPtr = std::unique_ptr<Collection>;
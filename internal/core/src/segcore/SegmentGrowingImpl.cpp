@@ -471,4 +471,21 @@ SegmentGrowingImpl::mask_with_timestamps(BitsetType& bitset_chunk,
     // DO NOTHING
 }
 
+void
+SegmentGrowingImpl::mask_with_expiration(BitsetType& bitset_chunk,
+                                         Timestamp timestamp,
+                                         int64_t collection_ttl) const {
+    auto expire_ts = GetExpireTimestamp(collection_ttl, timestamp);
+    if (expire_ts == 0) {
+        return;
+    }
+    // bitset_chunk is sized by the active count, so all rows are inserted
+    auto& ts_vec = this->insert_record_.timestamps_;
+    for (int64_t i = 0; i < bitset_chunk.size(); ++i) {
+        if (ts_vec[i] < expire_ts) {
+            bitset_chunk.set(i);
+        }
+    }
+}
+
 }  // namespace milvus::segcore
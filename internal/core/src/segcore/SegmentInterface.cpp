@@ -11,6 +11,7 @@
 
 #include "SegmentInterface.h"
 
+#include <algorithm>
 #include <cstdint>
 
 #include "Utils.h"
@@ -68,7 +69,10 @@ SegmentInternalInterface::Search(
     Timestamp timestamp) const {
     std::shared_lock lck(mutex_);
     check_search(plan);
-    query::ExecPlanNodeVisitor visitor(*this, timestamp, placeholder_group);
+    query::ExecPlanNodeVisitor visitor(*this,
+                                       timestamp,
+                                       placeholder_group,
+                                       plan->schema_.get_collection_ttl());
     auto results = std::make_unique<SearchResult>();
     *results = visitor.get_moved_result(*plan->plan_node_);
     results->segment_ = (void*)this;
@@ -80,7 +84,8 @@ SegmentInternalInterface::Retrieve(const query::RetrievePlan* plan,
                                    Timestamp timestamp) const {
     std::shared_lock lck(mutex_);
     auto results = std::make_unique<proto::segcore::RetrieveResults>();
-    query::ExecPlanNodeVisitor visitor(*this, timestamp);
+    query::ExecPlanNodeVisitor visitor(
+        *this, timestamp, plan->schema_.get_collection_ttl());
     auto retrieve_results = visitor.get_retrieve_result(*plan->plan_node_);
     retrieve_results.segment_ = (void*)this;
 
@@ -178,4 +183,44 @@ SegmentInternalInterface::get_real_count() const {
     return res->fields_data()[0].scalars().long_data().data(0);
 }
 
+void
+SegmentInternalInterface::set_valid_data(FieldId field_id,
+                                         int64_t offset,
+                                         const bool* valid_data,
+                                         int64_t count) {
+    std::unique_lock lck(valid_data_mutex_);
+    auto& field_valid_data = valid_data_[field_id];
+    if (field_valid_data.size() < offset + count) {
+        field_valid_data.resize(offset + count, true);
+    }
+    std::copy_n(valid_data, count, field_valid_data.begin() + offset);
+}
+
+bool
+SegmentInternalInterface::has_valid_data(FieldId field_id) const {
+    std::shared_lock lck(valid_data_mutex_);
+    return valid_data_.find(field_id) != valid_data_.end();
+}
+
+BitsetType
+SegmentInternalInterface::get_valid_bitset(FieldId field_id,
+                                           int64_t row_count) const {
+    BitsetType res(row_count);
+    res.set();
+
+    std::shared_lock lck(valid_data_mutex_);
+    auto iter = valid_data_.find(field_id);
+    if (iter == valid_data_.end()) {
+        return res;
+    }
+    auto& field_valid_data = iter->second;
+    auto size = std::min<int64_t>(row_count, field_valid_data.size());
+    for (int64_t i = 0; i < size; ++i) {
+        if (!field_valid_data[i]) {
+            res.reset(i);
+        }
+    }
+    return res;
+}
+
 }  // namespace milvus::segcore
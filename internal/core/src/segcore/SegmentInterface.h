@@ -13,7 +13,9 @@
 
 #include <deque>
 #include <memory>
+#include <shared_mutex>
 #include <string>
+#include <unordered_map>
 #include <utility>
 #include <vector>
 #include <index/ScalarIndex.h>
@@ -143,6 +145,21 @@ class SegmentInternalInterface : public SegmentInterface {
     int64_t
     get_real_count() const override;
 
+    // set the validity of the values of a nullable field in the rows of
+    // [offset, offset + count), the values never set are valid
+    void
+    set_valid_data(FieldId field_id,
+                   int64_t offset,
+                   const bool* valid_data,
+                   int64_t count);
+
+    bool
+    has_valid_data(FieldId field_id) const;
+
+    // the bit of a row is set if the value of the field in the row is not null
+    BitsetType
+    get_valid_bitset(FieldId field_id, int64_t row_count) const;
+
  public:
     virtual void
     vector_search(SearchInfo& search_info,
@@ -169,6 +186,12 @@ class SegmentInternalInterface : public SegmentInterface {
     mask_with_timestamps(BitsetType& bitset_chunk,
                          Timestamp timestamp) const = 0;
 
+    // mask entities older than the collection ttl at the query timestamp
+    virtual void
+    mask_with_expiration(BitsetType& bitset_chunk,
+                         Timestamp timestamp,
+                         int64_t collection_ttl) const = 0;
+
     // count of chunks
     virtual int64_t
     num_chunk() const = 0;
@@ -216,6 +239,11 @@ class SegmentInternalInterface : public SegmentInterface {
 
  protected:
     mutable std::shared_mutex mutex_;
+
+    // validity of the values of nullable fields, guarded by valid_data_mutex_
+    // as it's read while holding mutex_ during search and retrieve
+    mutable std::shared_mutex valid_data_mutex_;
+    std::unordered_map<FieldId, std::vector<bool>> valid_data_;
 };
 
 }  // namespace milvus::segcore
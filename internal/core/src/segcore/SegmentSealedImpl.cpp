@@ -985,4 +985,24 @@ SegmentSealedImpl::mask_with_timestamps(BitsetType& bitset_chunk,
     bitset_chunk |= mask;
 }
 
+void
+SegmentSealedImpl::mask_with_expiration(BitsetType& bitset_chunk,
+                                        Timestamp timestamp,
+                                        int64_t collection_ttl) const {
+    auto expire_ts = GetExpireTimestamp(collection_ttl, timestamp);
+    if (expire_ts == 0) {
+        return;
+    }
+    AssertInfo(insert_record_.timestamps_.num_chunk() == 1,
+               "num chunk not equal to 1 for sealed segment");
+    const auto& timestamps_data = insert_record_.timestamps_.get_chunk(0);
+    auto row_count =
+        std::min<int64_t>(bitset_chunk.size(), timestamps_data.size());
+    for (int64_t i = 0; i < row_count; ++i) {
+        if (timestamps_data[i] < expire_ts) {
+            bitset_chunk.set(i);
+        }
+    }
+}
+
 }  // namespace milvus::segcore
@@ -174,6 +174,11 @@ class SegmentSealedImpl : public SegmentSealed {
     mask_with_timestamps(BitsetType& bitset_chunk,
                          Timestamp timestamp) const override;
 
+    void
+    mask_with_expiration(BitsetType& bitset_chunk,
+                         Timestamp timestamp,
+                         int64_t collection_ttl) const override;
+
     void
     vector_search(SearchInfo& search_info,
                   const void* query_data,
@@ -70,6 +70,22 @@ GetSizeOfIdArray(const IdArray& data) {
     PanicInfo("unsupported id type");
 }
 
+Timestamp
+GetExpireTimestamp(int64_t ttl_seconds, Timestamp query_timestamp) {
+    // reading at the max timestamp, like counting the rows of a segment,
+    // is not a query of any time point and sees all entities
+    if (ttl_seconds <= 0 || query_timestamp == MAX_TIMESTAMP) {
+        return 0;
+    }
+    // physical part of hybrid timestamps is in milliseconds
+    auto physical = query_timestamp >> LOGICAL_BITS;
+    auto ttl_ms = static_cast<uint64_t>(ttl_seconds) * 1000;
+    if (physical <= ttl_ms) {
+        return 0;
+    }
+    return (physical - ttl_ms) << LOGICAL_BITS;
+}
+
 // Note: this is temporary solution.
 // modify bulk script implement to make process more clear
 
@@ -36,6 +36,11 @@ ParsePksFromIDs(std::vector<PkType>& pks,
 int64_t
 GetSizeOfIdArray(const IdArray& data);
 
+// entities inserted before the returned timestamp are expired at the query
+// timestamp, 0 means no entity is expired.
+Timestamp
+GetExpireTimestamp(int64_t ttl_seconds, Timestamp query_timestamp);
+
 // Note: this is temporary solution.
 // modify bulk script implement to make process more clear
 std::unique_ptr<DataArray>
@@ -30,6 +30,12 @@ DeleteCollection(CCollection collection) {
     delete col;
 }
 
+void
+UpdateSchema(CCollection collection, const char* schema_proto_blob) {
+    auto col = (milvus::segcore::Collection*)collection;
+    col->update_schema(std::string(schema_proto_blob));
+}
+
 const char*
 GetCollectionName(CCollection collection) {
     auto col = (milvus::segcore::Collection*)collection;
@@ -23,6 +23,9 @@ NewCollection(const char* schema_proto_blob);
 void
 DeleteCollection(CCollection collection);
 
+void
+UpdateSchema(CCollection collection, const char* schema_proto_blob);
+
 const char*
 GetCollectionName(CCollection collection);
 
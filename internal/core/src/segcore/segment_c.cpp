@@ -205,6 +205,22 @@ PreInsert(CSegmentInterface c_segment, int64_t size, int64_t* offset) {
     }
 }
 
+CStatus
+InsertValidData(CSegmentInterface c_segment,
+                int64_t reserved_offset,
+                int64_t size,
+                int64_t field_id,
+                const bool* valid_data) {
+    try {
+        auto segment = static_cast<milvus::segcore::SegmentGrowing*>(c_segment);
+        segment->set_valid_data(
+            milvus::FieldId(field_id), reserved_offset, valid_data, size);
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
 CStatus
 Delete(CSegmentInterface c_segment,
        int64_t reserved_offset,
@@ -257,6 +273,25 @@ LoadFieldData(CSegmentInterface c_segment,
     }
 }
 
+CStatus
+LoadFieldValidData(CSegmentInterface c_segment,
+                   int64_t field_id,
+                   int64_t row_count,
+                   const bool* valid_data) {
+    try {
+        auto segment_interface =
+            reinterpret_cast<milvus::segcore::SegmentInterface*>(c_segment);
+        auto segment =
+            dynamic_cast<milvus::segcore::SegmentSealed*>(segment_interface);
+        AssertInfo(segment != nullptr, "segment conversion failed");
+        segment->set_valid_data(
+            milvus::FieldId(field_id), 0, valid_data, row_count);
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
 CStatus
 LoadDeletedRecord(CSegmentInterface c_segment,
                   CLoadDeletedRecordInfo deleted_record_info) {
@@ -83,11 +83,26 @@ Insert(CSegmentInterface c_segment,
 CStatus
 PreInsert(CSegmentInterface c_segment, int64_t size, int64_t* offset);
 
+// valid_data[i] is false if the value of field_id in the row at
+// reserved_offset + i is null, it must be inserted before the rows
+CStatus
+InsertValidData(CSegmentInterface c_segment,
+                int64_t reserved_offset,
+                int64_t size,
+                int64_t field_id,
+                const bool* valid_data);
+
 //////////////////////////////    interfaces for sealed segment    //////////////////////////////
 CStatus
 LoadFieldData(CSegmentInterface c_segment,
               CLoadFieldDataInfo load_field_data_info);
 
+CStatus
+LoadFieldValidData(CSegmentInterface c_segment,
+                   int64_t field_id,
+                   int64_t row_count,
+                   const bool* valid_data);
+
 CStatus
 LoadDeletedRecord(CSegmentInterface c_segment,
                   CLoadDeletedRecordInfo deleted_record_info);
@@ -235,6 +235,20 @@ TEST(CApiTest, GetCollectionNameTest) {
     free((void*)(name));
 }
 
+TEST(CApiTest, UpdateSchemaTest) {
+    auto collection = NewCollection(get_default_schema_config());
+    auto col = (milvus::segcore::Collection*)collection;
+    ASSERT_EQ(col->get_schema()->size(), 2);
+
+    auto schema = generate_collection_schema(knowhere::metric::L2, 16, false);
+    UpdateSchema(collection, schema.c_str());
+    ASSERT_EQ(col->get_schema()->size(), 3);
+    auto name = GetCollectionName(collection);
+    ASSERT_EQ(strcmp(name, "collection_test"), 0);
+    free((void*)(name));
+    DeleteCollection(collection);
+}
+
 TEST(CApiTest, SegmentTest) {
     auto collection = NewCollection(get_default_schema_config());
     auto segment = NewSegment(collection, Growing, -1);
@@ -1130,6 +1144,73 @@ TEST(CApiTest, RetrieveTestWithExpr) {
     DeleteSegment(segment);
 }
 
+TEST(CApiTest, RetrieveTestWithAlteredTTL) {
+    auto collection = NewCollection(get_default_schema_config());
+    auto segment = NewSegment(collection, Growing, -1);
+    auto col = (milvus::segcore::Collection*)collection;
+
+    int N = 100;
+    auto dataset = DataGen(col->get_schema(), N);
+
+    int64_t offset;
+    PreInsert(segment, N, &offset);
+
+    auto insert_data = serialize(dataset.raw_);
+    auto ins_res = Insert(segment,
+                          offset,
+                          N,
+                          dataset.row_ids_.data(),
+                          dataset.timestamps_.data(),
+                          insert_data.data(),
+                          insert_data.size());
+    ASSERT_EQ(ins_res.error_code, Success);
+
+    // the entities are inserted at the first milliseconds,
+    // so all of them expire with a ttl of one second
+    Timestamp query_ts = Timestamp(10000) << LOGICAL_BITS;
+    auto pks = dataset.get_col<int64_t>(FieldId(101));
+    auto retrieve = [&]() {
+        auto plan = std::make_unique<query::RetrievePlan>(*col->get_schema());
+        plan->plan_node_ = std::make_unique<query::RetrievePlanNode>();
+        plan->plan_node_->predicate_ =
+            std::make_unique<query::TermExprImpl<int64_t>>(
+                FieldId(101), DataType::INT64, pks);
+        plan->field_ids_ = {FieldId(101)};
+
+        CRetrieveResult retrieve_result;
+        auto res =
+            Retrieve(segment, plan.get(), {}, query_ts, &retrieve_result);
+        EXPECT_EQ(res.error_code, Success);
+        proto::segcore::RetrieveResults query_result;
+        EXPECT_TRUE(query_result.ParseFromArray(retrieve_result.proto_blob,
+                                                retrieve_result.proto_size));
+        DeleteRetrieveResult(&retrieve_result);
+        return query_result.ids().int_id().data().size();
+    };
+    ASSERT_EQ(retrieve(), N);
+
+    // alter the collection ttl, the segment created before follows it
+    proto::schema::CollectionSchema schema_proto;
+    ASSERT_TRUE(google::protobuf::TextFormat::ParseFromString(
+        get_default_schema_config(), &schema_proto));
+    auto ts_field = schema_proto.add_fields();
+    ts_field->set_fieldid(TimestampFieldID.get());
+    ts_field->set_name("Timestamp");
+    ts_field->set_data_type(proto::schema::DataType::Int64);
+    auto ttl_param = ts_field->add_type_params();
+    ttl_param->set_key(COLLECTION_TTL_KEY);
+    ttl_param->set_value("1");
+    std::string schema_string;
+    ASSERT_TRUE(google::protobuf::TextFormat::PrintToString(schema_proto,
+                                                            &schema_string));
+    UpdateSchema(collection, schema_string.c_str());
+    ASSERT_EQ(col->get_schema()->get_collection_ttl(), 1);
+    ASSERT_EQ(retrieve(), 0);
+
+    DeleteCollection(collection);
+    DeleteSegment(segment);
+}
+
 TEST(CApiTest, GetMemoryUsageInBytesTest) {
     auto collection = NewCollection(get_default_schema_config());
     auto segment = NewSegment(collection, Growing, -1);
@@ -11,6 +11,7 @@
 
 #include <boost/format.hpp>
 #include <gtest/gtest.h>
+#include <limits>
 #include <regex>
 
 #include "query/Expr.h"
@@ -635,6 +636,69 @@ TEST(Expr, TestCompare) {
     }
 }
 
+TEST(Expr, TestNullExpr) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    auto schema = std::make_shared<Schema>();
+    schema->AddDebugField(
+        "fakevec", DataType::VECTOR_FLOAT, 16, knowhere::metric::L2);
+    auto i64_fid = schema->AddDebugField("age", DataType::INT64);
+    auto i32_fid = schema->AddDebugField("tag", DataType::INT32);
+    schema->set_primary_field_id(i64_fid);
+
+    auto seg = CreateGrowingSegment(schema);
+    int N = 1000;
+    auto raw_data = DataGen(schema, N);
+    auto valid_data = std::make_unique<bool[]>(N);
+    for (int i = 0; i < N; ++i) {
+        valid_data[i] = i % 3 != 0;
+    }
+    seg->PreInsert(N);
+    seg->set_valid_data(i32_fid, 0, valid_data.get(), N);
+    seg->Insert(0,
+                N,
+                raw_data.row_ids_.data(),
+                raw_data.timestamps_.data(),
+                raw_data.raw_);
+
+    auto seg_promote = dynamic_cast<SegmentGrowingImpl*>(seg.get());
+    ExecExprVisitor visitor(
+        *seg_promote, seg_promote->get_row_count(), MAX_TIMESTAMP);
+
+    NullExpr is_null(i32_fid, DataType::INT32, NullExpr::OpType::IsNull);
+    auto res = visitor.call_child(is_null);
+    NullExpr is_not_null(
+        i32_fid, DataType::INT32, NullExpr::OpType::IsNotNull);
+    auto not_null_res = visitor.call_child(is_not_null);
+    ASSERT_EQ(res.size(), N);
+    for (int i = 0; i < N; ++i) {
+        ASSERT_EQ(res[i], !valid_data[i]) << i;
+        ASSERT_EQ(not_null_res[i], valid_data[i]) << i;
+    }
+
+    // the fields without valid data are not null
+    NullExpr age_is_null(i64_fid, DataType::INT64, NullExpr::OpType::IsNull);
+    ASSERT_TRUE(visitor.call_child(age_is_null).none());
+
+    // comparisons on null values are unknown, which are not matched,
+    // and the negation of unknown is still unknown
+    ExprPtr tag_ge_min = std::make_unique<UnaryRangeExprImpl<int32_t>>(
+        i32_fid,
+        DataType::INT32,
+        OpType::GreaterEqual,
+        std::numeric_limits<int32_t>::min());
+    ExprPtr not_tag_ge_min = std::make_unique<LogicalUnaryExpr>(
+        LogicalUnaryExpr::OpType::LogicalNot, tag_ge_min);
+    ExprPtr tag_is_null = std::make_unique<NullExpr>(
+        i32_fid, DataType::INT32, NullExpr::OpType::IsNull);
+    LogicalBinaryExpr not_ge_or_null(
+        LogicalBinaryExpr::OpType::LogicalOr, not_tag_ge_min, tag_is_null);
+    res = visitor.call_child(not_ge_or_null);
+    for (int i = 0; i < N; ++i) {
+        ASSERT_EQ(res[i], !valid_data[i]) << i;
+    }
+}
+
 TEST(Expr, TestCompareWithScalarIndex) {
     using namespace milvus::query;
     using namespace milvus::segcore;
@@ -196,3 +196,101 @@ REGISTER_TYPED_TEST_CASE_P(TypedScalarIndexTest,
                            Reverse);
 
 INSTANTIATE_TYPED_TEST_CASE_P(ArithmeticCheck, TypedScalarIndexTest, ScalarT);
+
+TEST(ScalarIndexInvertedTest, Bool) {
+    auto arr = std::make_unique<bool[]>(nb);
+    for (int64_t i = 0; i < nb; i++) {
+        arr[i] = i % 3 == 0;
+    }
+    auto true_value = std::make_unique<bool>(true);
+    auto false_value = std::make_unique<bool>(false);
+
+    for (const auto& index_type :
+         {milvus::index::INVERTED, milvus::index::BITMAP}) {
+        milvus::index::CreateIndexInfo create_index_info;
+        create_index_info.field_type = milvus::DataType::BOOL;
+        create_index_info.index_type = index_type;
+        auto index =
+            milvus::index::IndexFactory::GetInstance().CreateScalarIndex(
+                create_index_info);
+        auto scalar_index =
+            dynamic_cast<milvus::index::ScalarIndex<bool>*>(index.get());
+        scalar_index->Build(nb, arr.get());
+        ASSERT_EQ(nb, scalar_index->Count());
+
+        auto binary_set = index->Serialize(nullptr);
+        auto copy_index =
+            milvus::index::IndexFactory::GetInstance().CreateScalarIndex(
+                create_index_info);
+        copy_index->Load(binary_set);
+        auto copy_scalar_index =
+            dynamic_cast<milvus::index::ScalarIndex<bool>*>(copy_index.get());
+
+        for (auto bool_index : {scalar_index, copy_scalar_index}) {
+            auto in = bool_index->In(1, true_value.get());
+            auto not_in = bool_index->NotIn(1, true_value.get());
+            auto in_false = bool_index->In(1, false_value.get());
+            for (int64_t i = 0; i < nb; i++) {
+                ASSERT_EQ(arr[i], in->test(i));
+                ASSERT_EQ(!arr[i], not_in->test(i));
+                ASSERT_EQ(!arr[i], in_false->test(i));
+                ASSERT_EQ(arr[i], bool_index->Reverse_Lookup(i));
+            }
+        }
+    }
+}
+
+TEST(ScalarIndexInvertedTest, Int64) {
+    std::vector<int64_t> arr(nb);
+    for (int64_t i = 0; i < nb; i++) {
+        arr[i] = i % 10;
+    }
+
+    for (const auto& index_type :
+         {milvus::index::INVERTED, milvus::index::BITMAP}) {
+        milvus::index::CreateIndexInfo create_index_info;
+        create_index_info.field_type = milvus::DataType::INT64;
+        create_index_info.index_type = index_type;
+        auto index =
+            milvus::index::IndexFactory::GetInstance().CreateScalarIndex(
+                create_index_info);
+        auto scalar_index =
+            dynamic_cast<milvus::index::ScalarIndex<int64_t>*>(index.get());
+        scalar_index->Build(nb, arr.data());
+        ASSERT_EQ(10, scalar_index->Size());
+        assert_in<int64_t>(scalar_index, arr);
+        assert_not_in<int64_t>(scalar_index, arr);
+        assert_range<int64_t>(scalar_index, arr);
+        assert_reverse<int64_t>(scalar_index, arr);
+
+        auto bitset = scalar_index->Range(3, true, 5, false);
+        for (int64_t i = 0; i < nb; i++) {
+            ASSERT_EQ(arr[i] >= 3 && arr[i] < 5, bitset->test(i));
+        }
+    }
+}
+
+TEST(ScalarIndexInvertedTest, BitmapCardinalityLimit) {
+    std::vector<int64_t> arr(nb);
+    for (int64_t i = 0; i < nb; i++) {
+        arr[i] = i % 20;
+    }
+
+    for (const auto& limit : {"10", "20"}) {
+        milvus::Config config;
+        config[milvus::index::BITMAP_CARDINALITY_LIMIT] = limit;
+        auto index = milvus::index::CreateScalarIndexBitmap<int64_t>();
+        index->BuildWithRawData(nb, arr.data(), config);
+        // falls back to posting lists if there are more distinct values.
+        auto use_bitmap = std::string(limit) == "20";
+        ASSERT_EQ(use_bitmap, index->UseBitmap());
+
+        // the loaded index keeps the way the rows are kept.
+        auto copy_index = milvus::index::CreateScalarIndexBitmap<int64_t>();
+        copy_index->Load(index->Serialize(nullptr));
+        ASSERT_EQ(use_bitmap, copy_index->UseBitmap());
+        assert_in<int64_t>(copy_index.get(), arr);
+        assert_not_in<int64_t>(copy_index.get(), arr);
+        assert_range<int64_t>(copy_index.get(), arr);
+    }
+}
@@ -130,6 +130,25 @@ TEST_F(StringIndexMarisaTest, PrefixMatch) {
     }
 }
 
+TEST_F(StringIndexMarisaTest, InvertedPrefixMatch) {
+    for (const auto& index_type :
+         {milvus::index::INVERTED, milvus::index::BITMAP}) {
+        auto index = milvus::index::IndexFactory::GetInstance()
+                         .CreateScalarIndex<std::string>(index_type);
+        index->Build(nb, strs.data());
+        auto string_index =
+            dynamic_cast<milvus::index::StringIndex*>(index.get());
+        ASSERT_NE(string_index, nullptr);
+
+        for (size_t i = 0; i < strs.size(); i++) {
+            auto str = strs[i];
+            auto bitset = string_index->PrefixMatch(str);
+            ASSERT_EQ(bitset->size(), strs.size());
+            ASSERT_TRUE(bitset->test(i));
+        }
+    }
+}
+
 TEST_F(StringIndexMarisaTest, Query) {
     auto index = milvus::index::CreateStringIndexMarisa();
     index->Build(nb, strs.data());
@@ -109,3 +109,15 @@ TEST(Util, GetDeleteBitmap) {
         del_barrier, N, delete_record, insert_record, query_timestamp);
     ASSERT_EQ(res_bitmap->bitmap_ptr->count(), 0);
 }
+
+TEST(Util, GetExpireTimestamp) {
+    using namespace milvus;
+    using namespace milvus::segcore;
+
+    Timestamp query_timestamp = Timestamp(10000) << LOGICAL_BITS;
+    ASSERT_EQ(GetExpireTimestamp(0, query_timestamp), 0);
+    ASSERT_EQ(GetExpireTimestamp(10, query_timestamp), 0);
+    ASSERT_EQ(GetExpireTimestamp(3, query_timestamp),
+              Timestamp(7000) << LOGICAL_BITS);
+    ASSERT_EQ(GetExpireTimestamp(3, MAX_TIMESTAMP), 0);
+}
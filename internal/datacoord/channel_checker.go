@@ -24,7 +24,6 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/proto"
-	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
@@ -38,7 +37,7 @@ type channelStateTimer struct {
 
 	runningTimers     sync.Map
 	runningTimerStops sync.Map // channel name to timer stop channels
-	etcdWatcher       clientv3.WatchChan
+	etcdWatcher       kv.WatchChan
 	timeoutWatcher    chan *ackEvent
 	//Modifies afterwards must guarantee that runningTimerCount is updated synchronized with runningTimers
 	//in order to keep consistency
@@ -52,14 +51,14 @@ func newChannelStateTimer(kv kv.MetaKv) *channelStateTimer {
 	}
 }
 
-func (c *channelStateTimer) getWatchers(prefix string) (clientv3.WatchChan, chan *ackEvent) {
+func (c *channelStateTimer) getWatchers(prefix string) (kv.WatchChan, chan *ackEvent) {
 	if c.etcdWatcher == nil {
 		c.etcdWatcher = c.watchkv.WatchWithPrefix(prefix)
 	}
 	return c.etcdWatcher, c.timeoutWatcher
 }
 
-func (c *channelStateTimer) getWatchersWithRevision(prefix string, revision int64) (clientv3.WatchChan, chan *ackEvent) {
+func (c *channelStateTimer) getWatchersWithRevision(prefix string, revision int64) (kv.WatchChan, chan *ackEvent) {
 	c.etcdWatcher = c.watchkv.WatchWithRevision(prefix, revision)
 	return c.etcdWatcher, c.timeoutWatcher
 }
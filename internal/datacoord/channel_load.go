@@ -0,0 +1,142 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+)
+
+// channelLoad is the load of a channel reported by the datanode consuming it.
+type channelLoad struct {
+	insertThroughput float64 // bytes per second
+	bufferSize       int64   // bytes
+}
+
+// channelLoadGetter provides the latest channel loads to the load aware channel policies.
+type channelLoadGetter interface {
+	// GetChannelLoads returns the loads of channels, channels not reported are absent.
+	GetChannelLoads() map[string]channelLoad
+}
+
+// channelLoadCollector collects the channel loads from the quota metrics of datanodes periodically.
+type channelLoadCollector struct {
+	sessionManager *SessionManager
+
+	mu    sync.RWMutex
+	loads map[string]channelLoad
+}
+
+var _ channelLoadGetter = (*channelLoadCollector)(nil)
+
+func newChannelLoadCollector(sessionManager *SessionManager) *channelLoadCollector {
+	return &channelLoadCollector{
+		sessionManager: sessionManager,
+		loads:          make(map[string]channelLoad),
+	}
+}
+
+// GetChannelLoads implements channelLoadGetter.
+func (c *channelLoadCollector) GetChannelLoads() map[string]channelLoad {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	loads := make(map[string]channelLoad, len(c.loads))
+	for ch, load := range c.loads {
+		loads[ch] = load
+	}
+	return loads
+}
+
+// start collects the channel loads every collect interval until ctx done.
+func (c *channelLoadCollector) start(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(Params.DataCoordCfg.ChannelLoadCollectInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("channel load collector quit")
+			return
+		case <-ticker.C:
+			c.collect(ctx)
+		}
+	}
+}
+
+// collect fetches the channel loads of all the datanodes, the loads of unreachable nodes are kept.
+func (c *channelLoadCollector) collect(ctx context.Context) {
+	loads := make(map[string]channelLoad)
+	failed := false
+	for _, session := range c.sessionManager.GetSessions() {
+		metrics, err := c.getDataNodeQuotaMetrics(ctx, session)
+		if err != nil {
+			log.Warn("failed to get channel loads of datanode",
+				zap.Int64("nodeID", session.info.NodeID), zap.Error(err))
+			failed = true
+			continue
+		}
+		for _, cm := range metrics.Cms {
+			loads[cm.Channel] = channelLoad{
+				insertThroughput: cm.InsertThroughput,
+				bufferSize:       cm.BufferSize,
+			}
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if failed {
+		for ch, load := range c.loads {
+			if _, ok := loads[ch]; !ok {
+				loads[ch] = load
+			}
+		}
+	}
+	c.loads = loads
+}
+
+func (c *channelLoadCollector) getDataNodeQuotaMetrics(ctx context.Context, session *Session) (*metricsinfo.DataNodeQuotaMetrics, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	cli, err := session.GetOrCreateClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SystemInfoMetrics)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := cli.GetMetrics(ctx, req)
+	if err = VerifyResponse(resp, err); err != nil {
+		return nil, err
+	}
+	infos := metricsinfo.DataNodeInfos{}
+	if err := metricsinfo.UnmarshalComponentInfos(resp.GetResponse(), &infos); err != nil {
+		return nil, err
+	}
+	if infos.QuotaMetrics == nil {
+		return nil, errors.New("no quota metrics reported by datanode")
+	}
+	return infos.QuotaMetrics, nil
+}
@@ -22,8 +22,7 @@ import (
 	"sync"
 	"time"
 
-	v3rpc "go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
-	clientv3 "go.etcd.io/etcd/client/v3"
+	"github.com/cockroachdb/errors"
 	"go.uber.org/zap"
 	"stathat.com/c/consistent"
 
@@ -668,7 +667,7 @@ func (c *ChannelManager) watchChannelStatesLoop(ctx context.Context, revision in
 	// REF MEP#7 watchInfo paths are orgnized as: [prefix]/channel/{node_id}/{channel_name}
 	watchPrefix := Params.CommonCfg.DataCoordWatchSubPath.GetValue()
 	// TODO, this is risky, we'd better watch etcd with revision rather simply a path
-	var etcdWatcher clientv3.WatchChan
+	var etcdWatcher kv.WatchChan
 	var timeoutWatcher chan *ackEvent
 	if revision == common.LatestRevision {
 		etcdWatcher, timeoutWatcher = c.stateTimer.getWatchers(watchPrefix)
@@ -698,7 +697,7 @@ func (c *ChannelManager) watchChannelStatesLoop(ctx context.Context, revision in
 				log.Warn("datacoord watch channel hit error", zap.Error(event.Err()))
 				// https://github.com/etcd-io/etcd/issues/8980
 				// TODO add list and wathc with revision
-				if event.Err() == v3rpc.ErrCompacted {
+				if errors.Is(event.Err(), kv.ErrCompacted) {
 					go c.watchChannelStatesLoop(ctx, event.CompactRevision)
 					return
 				}
@@ -707,9 +706,9 @@ func (c *ChannelManager) watchChannelStatesLoop(ctx context.Context, revision in
 				return
 			}
 
-			revision = event.Header.GetRevision() + 1
+			revision = event.Revision + 1
 			for _, evt := range event.Events {
-				if evt.Type == clientv3.EventTypeDelete {
+				if evt.Type == kv.EventTypeDelete {
 					continue
 				}
 				key := string(evt.Kv.Key)
@@ -70,6 +70,36 @@ func (f *ChannelPolicyFactoryV1) NewBalancePolicy() BalanceChannelPolicy {
 	return AvgBalanceChannelPolicy
 }
 
+// LoadAwareChannelPolicyFactory assigns, reassigns and balances channels by the loads reported by datanodes,
+// other policies are the same as ChannelPolicyFactoryV1.
+type LoadAwareChannelPolicyFactory struct {
+	*ChannelPolicyFactoryV1
+	loads channelLoadGetter
+}
+
+// NewLoadAwareChannelPolicyFactory creates a load aware channel policy factory with the channel loads provider.
+func NewLoadAwareChannelPolicyFactory(kv kv.TxnKV, loads channelLoadGetter) *LoadAwareChannelPolicyFactory {
+	return &LoadAwareChannelPolicyFactory{
+		ChannelPolicyFactoryV1: NewChannelPolicyFactoryV1(kv),
+		loads:                  loads,
+	}
+}
+
+// NewAssignPolicy implementing ChannelPolicyFactory returns LoadAwareAssignPolicy.
+func (f *LoadAwareChannelPolicyFactory) NewAssignPolicy() ChannelAssignPolicy {
+	return LoadAwareAssignPolicy(f.loads)
+}
+
+// NewReassignPolicy implementing ChannelPolicyFactory returns LoadAwareReassignPolicy.
+func (f *LoadAwareChannelPolicyFactory) NewReassignPolicy() ChannelReassignPolicy {
+	return LoadAwareReassignPolicy(f.loads)
+}
+
+// NewBalancePolicy implementing ChannelPolicyFactory returns LoadAwareBalanceChannelPolicy.
+func (f *LoadAwareChannelPolicyFactory) NewBalancePolicy() BalanceChannelPolicy {
+	return LoadAwareBalanceChannelPolicy(f.loads)
+}
+
 // ConsistentHashChannelPolicyFactory use consistent hash to determine channel assignment
 type ConsistentHashChannelPolicyFactory struct {
 	hashring *consistent.Consistent
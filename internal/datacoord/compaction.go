@@ -24,6 +24,7 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
@@ -237,14 +238,22 @@ func (c *compactionPlanHandler) completeCompaction(result *datapb.CompactionResu
 		if err := c.handleMergeCompactionResult(plan, result); err != nil {
 			return err
 		}
+	case datapb.CompactionType_ClusteringCompaction:
+		if err := c.handleClusteringCompactionResult(plan, result); err != nil {
+			return err
+		}
 	default:
 		return errors.New("unknown compaction type")
 	}
 	c.plans[planID] = c.plans[planID].shadowClone(setState(completed), setResult(result))
 	c.executingTaskNum--
-	if c.plans[planID].plan.GetType() == datapb.CompactionType_MergeCompaction ||
-		c.plans[planID].plan.GetType() == datapb.CompactionType_MixCompaction {
+	switch c.plans[planID].plan.GetType() {
+	case datapb.CompactionType_MergeCompaction, datapb.CompactionType_MixCompaction:
 		c.flushCh <- result.GetSegmentID()
+	case datapb.CompactionType_ClusteringCompaction:
+		for _, segment := range result.GetClusteringSegments() {
+			c.flushCh <- segment.GetSegmentID()
+		}
 	}
 	// TODO: when to clean task list
 
@@ -288,6 +297,47 @@ func (c *compactionPlanHandler) handleMergeCompactionResult(plan *datapb.Compact
 	return nil
 }
 
+func (c *compactionPlanHandler) handleClusteringCompactionResult(plan *datapb.CompactionPlan, result *datapb.CompactionResult) error {
+	modSegments, newSegments, metricMutation, err := c.meta.PrepareCompleteClusteringCompactionMutation(plan.GetSegmentBinlogs(), result)
+	if err != nil {
+		return err
+	}
+	log := log.With(zap.Int64("planID", plan.GetPlanID()))
+
+	if err := c.meta.alterMetaStoreAfterClusteringCompaction(newSegments, modSegments); err != nil {
+		log.Warn("fail to alter meta store", zap.Error(err))
+		return err
+	}
+
+	var nodeID = c.plans[plan.GetPlanID()].dataNodeID
+	req := &datapb.SyncSegmentsRequest{
+		PlanID: plan.PlanID,
+		CompactedFrom: lo.Map(modSegments, func(segment *SegmentInfo, _ int) int64 {
+			return segment.GetID()
+		}),
+		ClusteringSegments: lo.Map(newSegments, func(segment *SegmentInfo, _ int) *datapb.CompactionResult {
+			return &datapb.CompactionResult{
+				SegmentID:           segment.GetID(),
+				NumOfRows:           segment.GetNumOfRows(),
+				Field2StatslogPaths: segment.GetStatslogs(),
+				ClusteringInfo:      segment.GetClusteringInfo(),
+			}
+		}),
+	}
+
+	log.Info("handleClusteringCompactionResult: syncing segments with node", zap.Int64("nodeID", nodeID))
+	if err := c.sessions.SyncSegments(nodeID, req); err != nil {
+		log.Warn("handleClusteringCompactionResult: fail to sync segments with node",
+			zap.Int64("nodeID", nodeID), zap.Error(err))
+		return err
+	}
+	metricMutation.commit()
+
+	log.Info("handleClusteringCompactionResult: success to handle clustering compaction result",
+		zap.Int("segment num", len(newSegments)))
+	return nil
+}
+
 // getCompaction return compaction task. If planId does not exist, return nil.
 func (c *compactionPlanHandler) getCompaction(planID int64) *compactionTask {
 	c.mu.RLock()
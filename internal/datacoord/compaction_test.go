@@ -344,7 +344,7 @@ func TestCompactionPlanHandler_handleMergeCompactionResult(t *testing.T) {
 	errMeta := &meta{
 		catalog: &datacoord.Catalog{MetaKv: &saveFailKV{MetaKv: NewMetaMemoryKV()}},
 		segments: &SegmentsInfo{
-			map[int64]*SegmentInfo{
+			segments: map[int64]*SegmentInfo{
 				seg1.ID: {SegmentInfo: seg1},
 				seg2.ID: {SegmentInfo: seg2},
 			},
@@ -354,7 +354,7 @@ func TestCompactionPlanHandler_handleMergeCompactionResult(t *testing.T) {
 	meta := &meta{
 		catalog: &datacoord.Catalog{MetaKv: NewMetaMemoryKV()},
 		segments: &SegmentsInfo{
-			map[int64]*SegmentInfo{
+			segments: map[int64]*SegmentInfo{
 				seg1.ID: {SegmentInfo: seg1},
 				seg2.ID: {SegmentInfo: seg2},
 			},
@@ -495,7 +495,7 @@ func TestCompactionPlanHandler_completeCompaction(t *testing.T) {
 		meta := &meta{
 			catalog: &datacoord.Catalog{MetaKv: NewMetaMemoryKV()},
 			segments: &SegmentsInfo{
-				map[int64]*SegmentInfo{
+				segments: map[int64]*SegmentInfo{
 					seg1.ID: {SegmentInfo: seg1},
 					seg2.ID: {SegmentInfo: seg2},
 				},
@@ -587,7 +587,7 @@ func TestCompactionPlanHandler_completeCompaction(t *testing.T) {
 		meta := &meta{
 			catalog: &datacoord.Catalog{MetaKv: NewMetaMemoryKV()},
 			segments: &SegmentsInfo{
-				map[int64]*SegmentInfo{
+				segments: map[int64]*SegmentInfo{
 					seg1.ID: {SegmentInfo: seg1},
 					seg2.ID: {SegmentInfo: seg2},
 				},
@@ -743,7 +743,7 @@ func Test_compactionPlanHandler_updateCompaction(t *testing.T) {
 				},
 				meta: &meta{
 					segments: &SegmentsInfo{
-						map[int64]*SegmentInfo{
+						segments: map[int64]*SegmentInfo{
 							1: {SegmentInfo: &datapb.SegmentInfo{ID: 1}},
 						},
 					},
@@ -28,6 +28,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
@@ -271,9 +272,9 @@ func (t *compactionTrigger) reCalcSegmentMaxNumOfRows(collectionID UniqueID, isD
 		return -1, fmt.Errorf("failed to get collection %d", collectionID)
 	}
 	if isDisk {
-		return t.estimateDiskSegmentPolicy(collMeta.Schema)
+		return calByCollectionPolicy(collMeta, t.estimateDiskSegmentPolicy)
 	}
-	return t.estimateNonDiskSegmentPolicy(collMeta.Schema)
+	return calByCollectionPolicy(collMeta, t.estimateNonDiskSegmentPolicy)
 }
 
 // TODO: Update segment info should be written back to Etcd.
@@ -368,7 +369,13 @@ func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) {
 			return
 		}
 
-		plans := t.generatePlans(group.segments, signal.isForce, isDiskIndex, ct)
+		segments := t.dropExpiredSegments(group.segments, ct)
+		var plans []*datapb.CompactionPlan
+		if clusteringField := t.getClusteringField(group.collectionID); clusteringField != nil {
+			plans = t.generateClusteringPlans(segments, clusteringField, signal.isForce, isDiskIndex, ct)
+		} else {
+			plans = t.generatePlans(segments, signal.isForce, isDiskIndex, ct)
+		}
 		for _, plan := range plans {
 			segIDs := fetchSegIDs(plan.GetSegmentBinlogs())
 
@@ -456,7 +463,13 @@ func (t *compactionTrigger) handleSignal(signal *compactionSignal) {
 		return
 	}
 
-	plans := t.generatePlans(segments, signal.isForce, isDiskIndex, ct)
+	segments = t.dropExpiredSegments(segments, ct)
+	var plans []*datapb.CompactionPlan
+	if clusteringField := t.getClusteringField(segment.GetCollectionID()); clusteringField != nil {
+		plans = t.generateClusteringPlans(segments, clusteringField, signal.isForce, isDiskIndex, ct)
+	} else {
+		plans = t.generatePlans(segments, signal.isForce, isDiskIndex, ct)
+	}
 	for _, plan := range plans {
 		if t.compactionHandler.isFull() {
 			log.Warn("compaction plan skipped due to handler full", zap.Int64("collection", signal.collectionID), zap.Int64("planID", plan.PlanID))
@@ -665,6 +678,101 @@ func (t *compactionTrigger) generatePlans(segments []*SegmentInfo, force bool, i
 	return plans
 }
 
+// getClusteringField returns the clustering field of the collection,
+// nil if the clustering compaction is disabled or the collection is not clustered.
+func (t *compactionTrigger) getClusteringField(collectionID UniqueID) *schemapb.FieldSchema {
+	if !Params.DataCoordCfg.EnableClusteringCompaction.GetAsBool() {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	coll, err := t.handler.GetCollection(ctx, collectionID)
+	if err != nil {
+		log.Warn("failed to get collection", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return nil
+	}
+	field, err := getClusteringField(coll)
+	if err != nil {
+		log.Warn("invalid clustering key, compact segments by merging", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return nil
+	}
+	return field
+}
+
+// generateClusteringPlans generates a plan to reorganize the segments by ranges of the clustering field.
+// The segments not clustered by the field yet are always included, the plan is generated only if there are
+// enough of them, or any segment needs compaction for its deleted or expired entities.
+// The clustered segments are never merged into mixed ones, which would lose the ranges.
+func (t *compactionTrigger) generateClusteringPlans(segments []*SegmentInfo, field *schemapb.FieldSchema, force bool, isDiskIndex bool, compactTime *compactTime) []*datapb.CompactionPlan {
+	if len(segments) == 0 {
+		return nil
+	}
+	var (
+		candidates      []*SegmentInfo
+		clustered       []*SegmentInfo
+		prioritized     bool
+		unclustered     int
+		unclusteredRows int64
+	)
+	for _, segment := range segments {
+		segment := segment.ShadowClone()
+		if segment.GetClusteringInfo().GetFieldID() != field.GetFieldID() {
+			unclustered++
+			unclusteredRows += segment.GetNumOfRows()
+			candidates = append(candidates, segment)
+		} else if force || t.ShouldDoSingleCompaction(segment, isDiskIndex, compactTime) {
+			prioritized = true
+			candidates = append(candidates, segment)
+		} else {
+			clustered = append(clustered, segment)
+		}
+	}
+
+	maxRowNum := segments[0].GetMaxRowNum()
+	if !force && !prioritized &&
+		unclustered < Params.DataCoordCfg.MinSegmentToMerge.GetAsInt() &&
+		unclusteredRows < int64(float64(maxRowNum)*Params.DataCoordCfg.SegmentCompactableProportion.GetAsFloat()) {
+		return nil
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	// the clustered segments are re-clustered together with the candidates, so their ranges don't overlap,
+	// the small ones first as they are likely the tail of a previous clustering
+	sort.Slice(clustered, func(i, j int) bool {
+		if clustered[i].GetNumOfRows() != clustered[j].GetNumOfRows() {
+			return clustered[i].GetNumOfRows() < clustered[j].GetNumOfRows()
+		}
+		return clustered[i].GetID() < clustered[j].GetID()
+	})
+	var (
+		bucket    []*SegmentInfo
+		totalSize int64
+		maxNum    = Params.DataCoordCfg.MaxSegmentToMerge.GetAsInt()
+		maxSize   = Params.DataCoordCfg.ClusteringCompactionMaxTotalSize.GetAsInt64()
+	)
+	for _, segment := range append(candidates, clustered...) {
+		if len(bucket) >= maxNum || len(bucket) > 0 && totalSize+segment.getSegmentSize() > maxSize {
+			break
+		}
+		bucket = append(bucket, segment)
+		totalSize += segment.getSegmentSize()
+	}
+
+	plan := segmentsToPlan(bucket, compactTime)
+	plan.Type = datapb.CompactionType_ClusteringCompaction
+	plan.ClusteringFieldID = field.GetFieldID()
+	plan.MaxSegmentRows = maxRowNum
+	log.Info("generate a clustering plan",
+		zap.Int64("clustering fieldID", field.GetFieldID()),
+		zap.Int64s("plan segment IDs", lo.Map(bucket, func(segment *SegmentInfo, _ int) int64 { return segment.GetID() })),
+		zap.Int64("total rows", plan.GetTotalRows()),
+		zap.Int64("total size", totalSize),
+		zap.Int64("max segment rows", maxRowNum))
+	return []*datapb.CompactionPlan{plan}
+}
+
 func segmentsToPlan(segments []*SegmentInfo, compactTime *compactTime) *datapb.CompactionPlan {
 	plan := &datapb.CompactionPlan{
 		Timetravel:    compactTime.travelTime,
@@ -847,6 +955,43 @@ func (t *compactionTrigger) ShouldDoSingleCompaction(segment *SegmentInfo, isDis
 	return false
 }
 
+// dropExpiredSegments drops the flushed segments whose entities are all expired,
+// there is no need to compact them, and returns the remaining segments.
+func (t *compactionTrigger) dropExpiredSegments(segments []*SegmentInfo, compactTime *compactTime) []*SegmentInfo {
+	if compactTime.expireTime == 0 {
+		return segments
+	}
+
+	remaining := make([]*SegmentInfo, 0, len(segments))
+	for _, segment := range segments {
+		if segment.GetState() == commonpb.SegmentState_Flushed && isExpiredSegment(segment, compactTime.expireTime) {
+			if err := t.meta.SetState(segment.GetID(), commonpb.SegmentState_Dropped); err != nil {
+				log.Warn("failed to drop expired segment", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+			} else {
+				log.Info("drop expired segment", zap.Int64("collectionID", segment.GetCollectionID()),
+					zap.Int64("segmentID", segment.GetID()), zap.Int64("numRows", segment.GetNumOfRows()))
+				continue
+			}
+		}
+		remaining = append(remaining, segment)
+	}
+	return remaining
+}
+
+// isExpiredSegment returns true if all the insert binlogs of the segment end before the expire time.
+func isExpiredSegment(segment *SegmentInfo, expireTime Timestamp) bool {
+	hasBinlog := false
+	for _, binlogs := range segment.GetBinlogs() {
+		for _, l := range binlogs.GetBinlogs() {
+			if l.GetTimestampTo() == 0 || l.GetTimestampTo() >= expireTime {
+				return false
+			}
+			hasBinlog = true
+		}
+	}
+	return hasBinlog
+}
+
 func isFlush(segment *SegmentInfo) bool {
 	return segment.GetState() == commonpb.SegmentState_Flushed || segment.GetState() == commonpb.SegmentState_Flushing
 }
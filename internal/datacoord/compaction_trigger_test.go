@@ -29,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -104,7 +105,7 @@ func Test_compactionTrigger_force(t *testing.T) {
 			fields{
 				&meta{
 					segments: &SegmentsInfo{
-						map[int64]*SegmentInfo{
+						segments: map[int64]*SegmentInfo{
 							1: {
 								SegmentInfo: &datapb.SegmentInfo{
 									ID:             1,
@@ -818,7 +819,7 @@ func Test_compactionTrigger_noplan(t *testing.T) {
 				&meta{
 					// 4 segment
 					segments: &SegmentsInfo{
-						map[int64]*SegmentInfo{
+						segments: map[int64]*SegmentInfo{
 							1: {
 								SegmentInfo: &datapb.SegmentInfo{
 									ID:             1,
@@ -986,7 +987,7 @@ func Test_compactionTrigger_PrioritizedCandi(t *testing.T) {
 				&meta{
 					// 4 small segments
 					segments: &SegmentsInfo{
-						map[int64]*SegmentInfo{
+						segments: map[int64]*SegmentInfo{
 							1: {
 								SegmentInfo:   genSeg(1, 20),
 								lastFlushTime: time.Now().Add(-100 * time.Minute),
@@ -1131,7 +1132,7 @@ func Test_compactionTrigger_SmallCandi(t *testing.T) {
 				&meta{
 					// 4 small segments
 					segments: &SegmentsInfo{
-						map[int64]*SegmentInfo{
+						segments: map[int64]*SegmentInfo{
 							1: {
 								SegmentInfo:   genSeg(1, 20),
 								lastFlushTime: time.Now().Add(-100 * time.Minute),
@@ -1269,7 +1270,7 @@ func Test_compactionTrigger_SqueezeNonPlannedSegs(t *testing.T) {
 				&meta{
 					// 4 small segments
 					segments: &SegmentsInfo{
-						map[int64]*SegmentInfo{
+						segments: map[int64]*SegmentInfo{
 							1: {
 								SegmentInfo:   genSeg(1, 60),
 								lastFlushTime: time.Now().Add(-100 * time.Minute),
@@ -1736,3 +1737,52 @@ func Test_getCompactTime(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, ct)
 }
+
+func Test_compactionTrigger_dropExpiredSegments(t *testing.T) {
+	meta, err := newMemoryMeta()
+	assert.NoError(t, err)
+
+	newSegment := func(id UniqueID, state commonpb.SegmentState, timestampTo ...Timestamp) *SegmentInfo {
+		binlogs := make([]*datapb.Binlog, 0, len(timestampTo))
+		for i, ts := range timestampTo {
+			logID := UniqueID(i + 1)
+			binlogs = append(binlogs, &datapb.Binlog{
+				EntriesNum:  10,
+				TimestampTo: ts,
+				LogID:       logID,
+				LogPath:     metautil.BuildInsertLogPath("", 1, 1, id, 1, logID),
+			})
+		}
+		return NewSegmentInfo(&datapb.SegmentInfo{
+			ID:           id,
+			CollectionID: 1,
+			PartitionID:  1,
+			State:        state,
+			NumOfRows:    int64(10 * len(timestampTo)),
+			Binlogs:      []*datapb.FieldBinlog{{FieldID: 1, Binlogs: binlogs}},
+		})
+	}
+	segments := []*SegmentInfo{
+		newSegment(1, commonpb.SegmentState_Flushed, 10, 20),
+		newSegment(2, commonpb.SegmentState_Flushed, 10, 200),
+		newSegment(3, commonpb.SegmentState_Flushing, 10, 20),
+		newSegment(4, commonpb.SegmentState_Flushed),
+	}
+	for _, segment := range segments {
+		assert.NoError(t, meta.AddSegment(segment))
+	}
+
+	trigger := newCompactionTrigger(meta, &compactionPlanHandler{}, newMockAllocator(), newMockHandler())
+
+	remaining := trigger.dropExpiredSegments(segments, &compactTime{expireTime: 0})
+	assert.Len(t, remaining, 4)
+
+	remaining = trigger.dropExpiredSegments(segments, &compactTime{expireTime: 100})
+	remainingIDs := make([]UniqueID, 0, len(remaining))
+	for _, segment := range remaining {
+		remainingIDs = append(remainingIDs, segment.GetID())
+	}
+	assert.ElementsMatch(t, []UniqueID{2, 3, 4}, remainingIDs)
+	assert.Equal(t, commonpb.SegmentState_Dropped, meta.GetSegment(1).GetState())
+	assert.Equal(t, commonpb.SegmentState_Flushed, meta.GetSegment(2).GetState())
+}
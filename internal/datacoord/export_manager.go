@@ -0,0 +1,423 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	milvushttp "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/exportutil"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+)
+
+// exportTaskPrefix is the kv prefix of the bulk export tasks
+const exportTaskPrefix = datacoord.MetaPrefix + "/export-task"
+
+// exportRequest is the request to export the flushed segments of a collection.
+type exportRequest struct {
+	CollectionID int64   `json:"collection_id"`
+	PartitionIDs []int64 `json:"partition_ids"`
+	Format       string  `json:"format"`
+	Prefix       string  `json:"prefix"`
+	Expr         string  `json:"expr"`
+}
+
+// exportManager runs the bulk export tasks.
+// An export task exports the segments flushed when the task is created, each segment is exported separately,
+// and the progress is persisted after each segment so that a task interrupted by a restart resumes from the
+// segments not exported yet.
+// The segments of a running task are locked in the segment reference manager, so that they are not garbage collected
+// even if they are compacted during the export.
+type exportManager struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	meta      *meta
+	handler   Handler
+	allocator allocator
+	kv        kv.TxnKV
+	cm        storage.ChunkManager
+	segRefer  *segmentReferenceManager
+	sem       chan struct{}
+
+	mu    sync.RWMutex
+	tasks map[int64]*datapb.ExportTaskInfo
+}
+
+func newExportManager(ctx context.Context, meta *meta, handler Handler, allocator allocator, kv kv.TxnKV,
+	cm storage.ChunkManager, segRefer *segmentReferenceManager, maxConcurrentTasks int,
+) *exportManager {
+	ctx, cancel := context.WithCancel(ctx)
+	return &exportManager{
+		ctx:       ctx,
+		cancel:    cancel,
+		meta:      meta,
+		handler:   handler,
+		allocator: allocator,
+		kv:        kv,
+		cm:        cm,
+		segRefer:  segRefer,
+		sem:       make(chan struct{}, maxConcurrentTasks),
+		tasks:     make(map[int64]*datapb.ExportTaskInfo),
+	}
+}
+
+// start reloads the tasks from kv and resumes the unfinished ones.
+func (m *exportManager) start() error {
+	_, values, err := m.kv.LoadWithPrefix(exportTaskPrefix)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, value := range values {
+		task := &datapb.ExportTaskInfo{}
+		if err := proto.Unmarshal([]byte(value), task); err != nil {
+			return err
+		}
+		m.tasks[task.GetId()] = task
+		if !isExportTaskDone(task) {
+			log.Info("resume export task", zap.Int64("taskID", task.GetId()),
+				zap.Int("exportedSegmentNum", len(task.GetExportedSegmentIds())), zap.Int("segmentNum", len(task.GetSegmentIds())))
+			// the locks are not persisted, locks the segments not exported yet again
+			segmentIDs := make([]int64, 0, len(task.GetSegmentIds()))
+			for _, segmentID := range task.GetSegmentIds() {
+				if !funcutil.SliceContain(task.GetExportedSegmentIds(), segmentID) {
+					segmentIDs = append(segmentIDs, segmentID)
+				}
+			}
+			m.segRefer.AddSegmentsLock(task.GetId(), segmentIDs)
+			m.runTask(task.GetId())
+		}
+	}
+	return nil
+}
+
+func (m *exportManager) stop() {
+	m.cancel()
+	m.wg.Wait()
+}
+
+func isExportTaskDone(task *datapb.ExportTaskInfo) bool {
+	return task.GetState() == commonpb.ImportState_ImportCompleted || task.GetState() == commonpb.ImportState_ImportFailed
+}
+
+// createTask creates an export task of the segments flushed now, and runs it in background.
+func (m *exportManager) createTask(ctx context.Context, req *exportRequest) (int64, error) {
+	if !exportutil.IsSupportedFormat(req.Format) {
+		return 0, fmt.Errorf("unsupported export format '%s'", req.Format)
+	}
+	prefix, err := m.checkPrefix(req.Prefix)
+	if err != nil {
+		return 0, err
+	}
+	coll, err := m.handler.GetCollection(ctx, req.CollectionID)
+	if err != nil {
+		return 0, err
+	}
+	if coll == nil {
+		return 0, fmt.Errorf("collection %d not found", req.CollectionID)
+	}
+	// validates the format and the filter expression before the task is created
+	if _, err := exportutil.NewExporter(ctx, coll.Schema, m.cm, prefix, req.Format, req.Expr); err != nil {
+		return 0, err
+	}
+
+	segments := m.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetCollectionID() == req.CollectionID &&
+			segment.GetState() == commonpb.SegmentState_Flushed &&
+			!segment.GetIsImporting() &&
+			(len(req.PartitionIDs) == 0 || funcutil.SliceContain(req.PartitionIDs, segment.GetPartitionID()))
+	})
+	segmentIDs := make([]int64, 0, len(segments))
+	for _, segment := range segments {
+		segmentIDs = append(segmentIDs, segment.GetID())
+	}
+
+	id, err := m.allocator.allocID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	// locks the segments before the task is saved, so that they are not garbage collected once the task is visible
+	m.segRefer.AddSegmentsLock(id, segmentIDs)
+	task := &datapb.ExportTaskInfo{
+		Id:           id,
+		CollectionId: req.CollectionID,
+		PartitionIds: req.PartitionIDs,
+		Format:       req.Format,
+		Prefix:       prefix,
+		Expr:         req.Expr,
+		State:        commonpb.ImportState_ImportPending,
+		SegmentIds:   segmentIDs,
+		CreateTs:     time.Now().Unix(),
+	}
+	if err := m.saveTask(task); err != nil {
+		m.segRefer.ReleaseSegmentsLock(id)
+		return 0, err
+	}
+
+	m.mu.Lock()
+	m.tasks[id] = task
+	m.mu.Unlock()
+	log.Info("export task created", zap.Int64("taskID", id), zap.Int64("collectionID", req.CollectionID),
+		zap.String("format", req.Format), zap.String("prefix", prefix), zap.Int("segmentNum", len(segmentIDs)))
+	m.runTask(id)
+	return id, nil
+}
+
+// checkPrefix returns the cleaned export prefix, the prefixes under the root path of the chunk manager are rejected
+// since the exported files may overwrite the binlogs and index files.
+func (m *exportManager) checkPrefix(prefix string) (string, error) {
+	if prefix == "" {
+		return "", errors.New("export prefix is empty")
+	}
+	prefix = path.Clean(prefix)
+	if prefix == "." || prefix == "/" || prefix == ".." || strings.HasPrefix(prefix, "../") {
+		return "", fmt.Errorf("invalid export prefix '%s'", prefix)
+	}
+	// the object keys may be written with or without the leading slash
+	root := strings.TrimPrefix(path.Clean(m.cm.RootPath()), "/")
+	key := strings.TrimPrefix(prefix, "/")
+	if root == "." || root == "" || key == root || strings.HasPrefix(key, root+"/") {
+		return "", fmt.Errorf("export prefix '%s' is under the root path '%s' of milvus data", prefix, m.cm.RootPath())
+	}
+	return prefix, nil
+}
+
+// getTask returns a copy of the task, nil if the task doesn't exist.
+func (m *exportManager) getTask(id int64) *datapb.ExportTaskInfo {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	task, ok := m.tasks[id]
+	if !ok {
+		return nil
+	}
+	return proto.Clone(task).(*datapb.ExportTaskInfo)
+}
+
+func (m *exportManager) saveTask(task *datapb.ExportTaskInfo) error {
+	value, err := proto.Marshal(task)
+	if err != nil {
+		return err
+	}
+	return m.kv.Save(path.Join(exportTaskPrefix, strconv.FormatInt(task.GetId(), 10)), string(value))
+}
+
+// updateTask applies the update on a copy of the task, and replaces the task if the copy is saved.
+func (m *exportManager) updateTask(id int64, update func(task *datapb.ExportTaskInfo)) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	task := proto.Clone(m.tasks[id]).(*datapb.ExportTaskInfo)
+	update(task)
+	if err := m.saveTask(task); err != nil {
+		return err
+	}
+	m.tasks[id] = task
+	return nil
+}
+
+func (m *exportManager) runTask(id int64) {
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		// the task locks the segments again when it is resumed
+		defer m.segRefer.ReleaseSegmentsLock(id)
+		select {
+		case <-m.ctx.Done():
+			return
+		case m.sem <- struct{}{}:
+		}
+		defer func() { <-m.sem }()
+
+		err := m.execute(id)
+		if err != nil && m.ctx.Err() == nil {
+			log.Warn("export task failed", zap.Int64("taskID", id), zap.Error(err))
+			if saveErr := m.updateTask(id, func(task *datapb.ExportTaskInfo) {
+				task.State = commonpb.ImportState_ImportFailed
+				task.ErrorMessage = err.Error()
+			}); saveErr != nil {
+				log.Warn("failed to save export task", zap.Int64("taskID", id), zap.Error(saveErr))
+			}
+		}
+	}()
+}
+
+func (m *exportManager) execute(id int64) error {
+	task := m.getTask(id)
+	if err := m.updateTask(id, func(task *datapb.ExportTaskInfo) {
+		task.State = commonpb.ImportState_ImportStarted
+	}); err != nil {
+		return err
+	}
+
+	coll, err := m.handler.GetCollection(m.ctx, task.GetCollectionId())
+	if err != nil {
+		return err
+	}
+	if coll == nil {
+		return fmt.Errorf("collection %d not found", task.GetCollectionId())
+	}
+	exporter, err := exportutil.NewExporter(m.ctx, coll.Schema, m.cm, task.GetPrefix(), task.GetFormat(), task.GetExpr())
+	if err != nil {
+		return err
+	}
+
+	for _, segmentID := range task.GetSegmentIds() {
+		if funcutil.SliceContain(task.GetExportedSegmentIds(), segmentID) {
+			continue
+		}
+		if m.ctx.Err() != nil {
+			return m.ctx.Err()
+		}
+		// the segments compacted after the task is created are kept in meta until they are garbage collected
+		segment := m.meta.GetSegment(segmentID)
+		if segment == nil {
+			return fmt.Errorf("segment %d to export has been garbage collected", segmentID)
+		}
+		rowNum, files, err := exporter.ExportSegment(segment.SegmentInfo)
+		if err != nil {
+			return err
+		}
+		if err := m.updateTask(id, func(task *datapb.ExportTaskInfo) {
+			task.ExportedSegmentIds = append(task.ExportedSegmentIds, segmentID)
+			task.RowCount += rowNum
+			task.Files = append(task.Files, files...)
+		}); err != nil {
+			return err
+		}
+	}
+
+	log.Info("export task completed", zap.Int64("taskID", id), zap.Int("segmentNum", len(task.GetSegmentIds())))
+	return m.updateTask(id, func(task *datapb.ExportTaskInfo) {
+		task.State = commonpb.ImportState_ImportCompleted
+	})
+}
+
+// exportTaskState is the state of an export task returned by the http api.
+type exportTaskState struct {
+	ID                 int64    `json:"id"`
+	CollectionID       int64    `json:"collection_id"`
+	State              string   `json:"state"`
+	ErrorMessage       string   `json:"error_message,omitempty"`
+	SegmentNum         int      `json:"segment_num"`
+	ExportedSegmentNum int      `json:"exported_segment_num"`
+	RowCount           int64    `json:"row_count"`
+	Files              []string `json:"files"`
+}
+
+// exportHandler serves the bulk export api of the active datacoord:
+//
+//	POST /datacoord/export {"collection_id":1,"format":"parquet","prefix":"a/b","expr":"pk > 0"}  create an export task
+//	GET  /datacoord/export?id=1                                                                  get the state of an export task
+//
+// Only root is allowed to call the api, by basic auth.
+// The handler is registered once per process since a path can't be registered twice,
+// the export manager is set when datacoord becomes active.
+type exportHandler struct {
+	mu      sync.RWMutex
+	manager *exportManager
+	verify  milvushttp.CredentialVerifier
+}
+
+var (
+	registerExportHandlerOnce sync.Once
+	defaultExportHandler      = &exportHandler{}
+)
+
+func (h *exportHandler) setManager(manager *exportManager, verify milvushttp.CredentialVerifier) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.manager = manager
+	h.verify = verify
+}
+
+func (h *exportHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	h.mu.RLock()
+	manager, verify := h.manager, h.verify
+	h.mu.RUnlock()
+	if manager == nil {
+		writeExportJSON(w, http.StatusServiceUnavailable, map[string]string{"error": "datacoord is not active"})
+		return
+	}
+	if _, ok := milvushttp.AuthenticateRoot(w, req, verify); !ok {
+		return
+	}
+
+	switch req.Method {
+	case http.MethodPost:
+		exportReq := &exportRequest{}
+		if err := json.NewDecoder(req.Body).Decode(exportReq); err != nil {
+			writeExportJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+		id, err := manager.createTask(req.Context(), exportReq)
+		if err != nil {
+			writeExportJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+		writeExportJSON(w, http.StatusOK, map[string]int64{"id": id})
+	case http.MethodGet:
+		id, err := strconv.ParseInt(req.URL.Query().Get("id"), 10, 64)
+		if err != nil {
+			writeExportJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+		task := manager.getTask(id)
+		if task == nil {
+			writeExportJSON(w, http.StatusNotFound, map[string]string{"error": fmt.Sprintf("export task %d not found", id)})
+			return
+		}
+		writeExportJSON(w, http.StatusOK, &exportTaskState{
+			ID:                 task.GetId(),
+			CollectionID:       task.GetCollectionId(),
+			State:              task.GetState().String(),
+			ErrorMessage:       task.GetErrorMessage(),
+			SegmentNum:         len(task.GetSegmentIds()),
+			ExportedSegmentNum: len(task.GetExportedSegmentIds()),
+			RowCount:           task.GetRowCount(),
+			Files:              task.GetFiles(),
+		})
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func writeExportJSON(w http.ResponseWriter, code int, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		log.Warn("failed to write export response", zap.Error(err))
+	}
+}
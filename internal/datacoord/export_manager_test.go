@@ -0,0 +1,259 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/exportutil"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util"
+)
+
+// newTestExportManager returns the export manager, the chunk manager with root path "<dir>/data" and the dir.
+func newTestExportManager(t *testing.T, kv *memkv.MemoryKV) (*exportManager, storage.ChunkManager, string) {
+	dir := t.TempDir()
+	cm := storage.NewLocalChunkManager(storage.RootPath(path.Join(dir, "data")))
+	meta, err := newMemoryMeta()
+	require.NoError(t, err)
+
+	schema := &schemapb.CollectionSchema{
+		Name: "export",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: "RowID", DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+		},
+	}
+	meta.AddCollection(&collectionInfo{ID: 1, Schema: schema, Partitions: []int64{10, 11}})
+
+	// a flushed segment in each partition, and a growing one
+	for i, partitionID := range []int64{10, 11} {
+		segmentID := int64(100 + i)
+		data := &storage.InsertData{Data: map[storage.FieldID]storage.FieldData{
+			common.RowIDField:     &storage.Int64FieldData{Data: []int64{1, 2}},
+			common.TimeStampField: &storage.Int64FieldData{Data: []int64{1, 2}},
+			100:                   &storage.Int64FieldData{Data: []int64{segmentID*10 + 1, segmentID*10 + 2}},
+		}}
+		blobs, _, err := storage.NewInsertCodecWithSchema(&etcdpb.CollectionMeta{ID: 1, Schema: schema}).Serialize(partitionID, segmentID, data)
+		require.NoError(t, err)
+		segment := &datapb.SegmentInfo{ID: segmentID, CollectionID: 1, PartitionID: partitionID,
+			State: commonpb.SegmentState_Flushed, NumOfRows: 2}
+		for _, blob := range blobs {
+			fieldID, err := strconv.ParseInt(blob.Key, 10, 64)
+			require.NoError(t, err)
+			logPath := path.Join(cm.RootPath(), "insert_log", strconv.FormatInt(segmentID, 10), blob.Key)
+			require.NoError(t, cm.Write(context.Background(), logPath, blob.Value))
+			segment.Binlogs = append(segment.Binlogs, &datapb.FieldBinlog{
+				FieldID: fieldID,
+				Binlogs: []*datapb.Binlog{{LogPath: logPath}},
+			})
+		}
+		require.NoError(t, meta.AddSegment(NewSegmentInfo(segment)))
+	}
+	require.NoError(t, meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 102, CollectionID: 1,
+		PartitionID: 10, State: commonpb.SegmentState_Growing})))
+
+	return newExportManager(context.Background(), meta, newMockHandlerWithMeta(meta), newMockAllocator(), kv, cm,
+		newSegmentReferenceManager(), 1), cm, dir
+}
+
+func waitExportTask(t *testing.T, m *exportManager, id int64) *datapb.ExportTaskInfo {
+	var task *datapb.ExportTaskInfo
+	assert.Eventually(t, func() bool {
+		task = m.getTask(id)
+		return isExportTaskDone(task)
+	}, 10*time.Second, 10*time.Millisecond)
+	return task
+}
+
+func TestExportManager_CreateTask(t *testing.T) {
+	kv := memkv.NewMemoryKV()
+	m, cm, dir := newTestExportManager(t, kv)
+	require.NoError(t, m.start())
+	defer m.stop()
+	ctx := context.Background()
+	prefix := path.Join(dir, "export")
+
+	_, err := m.createTask(ctx, &exportRequest{CollectionID: 1, Format: "csv", Prefix: prefix})
+	assert.Error(t, err)
+	_, err = m.createTask(ctx, &exportRequest{CollectionID: 1, Format: exportutil.JSONFormat})
+	assert.Error(t, err)
+	_, err = m.createTask(ctx, &exportRequest{CollectionID: 2, Format: exportutil.JSONFormat, Prefix: prefix})
+	assert.Error(t, err)
+	_, err = m.createTask(ctx, &exportRequest{CollectionID: 1, Format: exportutil.JSONFormat, Prefix: prefix, Expr: "pk >"})
+	assert.Error(t, err)
+	// the binlogs and index files must not be overwritten
+	for _, invalid := range []string{"/", ".", "../export", cm.RootPath(), path.Join(cm.RootPath(), "insert_log"),
+		path.Join(dir, "export", "..", "data", "index_files")} {
+		_, err = m.createTask(ctx, &exportRequest{CollectionID: 1, Format: exportutil.JSONFormat, Prefix: invalid})
+		assert.Error(t, err, invalid)
+	}
+
+	id, err := m.createTask(ctx, &exportRequest{CollectionID: 1, Format: exportutil.JSONFormat, Prefix: prefix})
+	assert.NoError(t, err)
+	task := waitExportTask(t, m, id)
+	assert.Equal(t, commonpb.ImportState_ImportCompleted, task.GetState())
+	// the segments are unlocked once the task is done
+	assert.Eventually(t, func() bool { return !m.segRefer.HasSegmentLock(100) && !m.segRefer.HasSegmentLock(101) },
+		time.Second, 10*time.Millisecond)
+	assert.ElementsMatch(t, []int64{100, 101}, task.GetSegmentIds())
+	assert.ElementsMatch(t, []int64{100, 101}, task.GetExportedSegmentIds())
+	assert.Equal(t, int64(4), task.GetRowCount())
+	assert.ElementsMatch(t, []string{path.Join(prefix, "100.json"), path.Join(prefix, "101.json")}, task.GetFiles())
+
+	content, err := cm.Read(ctx, path.Join(prefix, "101.json"))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"rows": [{"pk": 1011}, {"pk": 1012}]}`, string(content))
+
+	// export a partition with a filter
+	id, err = m.createTask(ctx, &exportRequest{CollectionID: 1, PartitionIDs: []int64{10},
+		Format: exportutil.NumpyFormat, Prefix: path.Join(dir, "numpy"), Expr: "pk > 1001"})
+	assert.NoError(t, err)
+	task = waitExportTask(t, m, id)
+	assert.Equal(t, commonpb.ImportState_ImportCompleted, task.GetState())
+	assert.Equal(t, []int64{100}, task.GetSegmentIds())
+	assert.Equal(t, int64(1), task.GetRowCount())
+
+	// the tasks are persisted
+	_, values, err := kv.LoadWithPrefix(exportTaskPrefix)
+	assert.NoError(t, err)
+	assert.Len(t, values, 2)
+}
+
+func TestExportManager_ResumeTask(t *testing.T) {
+	kv := memkv.NewMemoryKV()
+	m, _, dir := newTestExportManager(t, kv)
+
+	// the first segment was exported before restart
+	task := &datapb.ExportTaskInfo{
+		Id:                 1000,
+		CollectionId:       1,
+		Format:             exportutil.ParquetFormat,
+		Prefix:             path.Join(dir, "export"),
+		State:              commonpb.ImportState_ImportStarted,
+		SegmentIds:         []int64{100, 101},
+		ExportedSegmentIds: []int64{100},
+		RowCount:           2,
+		Files:              []string{path.Join(dir, "export", "100.parquet")},
+	}
+	assert.NoError(t, m.saveTask(task))
+	failed := proto.Clone(task).(*datapb.ExportTaskInfo)
+	failed.Id = 1001
+	failed.State = commonpb.ImportState_ImportFailed
+	assert.NoError(t, m.saveTask(failed))
+
+	require.NoError(t, m.start())
+	defer m.stop()
+	task = waitExportTask(t, m, 1000)
+	assert.Equal(t, commonpb.ImportState_ImportCompleted, task.GetState())
+	assert.Equal(t, []int64{100, 101}, task.GetExportedSegmentIds())
+	assert.Equal(t, int64(4), task.GetRowCount())
+	assert.Equal(t, []string{path.Join(dir, "export", "100.parquet"), path.Join(dir, "export", "101.parquet")}, task.GetFiles())
+	assert.Equal(t, commonpb.ImportState_ImportFailed, m.getTask(1001).GetState())
+
+	// the task fails if a segment is garbage collected
+	task.Id = 1002
+	task.State = commonpb.ImportState_ImportPending
+	task.SegmentIds = []int64{100, 999}
+	task.ExportedSegmentIds = nil
+	m.mu.Lock()
+	m.tasks[task.Id] = task
+	m.mu.Unlock()
+	m.runTask(task.Id)
+	task = waitExportTask(t, m, 1002)
+	assert.Equal(t, commonpb.ImportState_ImportFailed, task.GetState())
+	assert.Contains(t, task.GetErrorMessage(), "999")
+	assert.Equal(t, []int64{100}, task.GetExportedSegmentIds())
+}
+
+func TestExportHandler(t *testing.T) {
+	handler := &exportHandler{}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/datacoord/export?id=1", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+
+	m, _, dir := newTestExportManager(t, memkv.NewMemoryKV())
+	require.NoError(t, m.start())
+	defer m.stop()
+	handler.setManager(m, func(ctx context.Context, username, password string) error {
+		if password != "Milvus" {
+			return errors.New("wrong password")
+		}
+		return nil
+	})
+	serve := func(req *http.Request) *httptest.ResponseRecorder {
+		req.SetBasicAuth(util.UserRoot, "Milvus")
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	// only root is allowed
+	body := `{"collection_id": 1, "format": "json", "prefix": "` + path.Join(dir, "export") + `"}`
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/datacoord/export", strings.NewReader(body)))
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	recorder = httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/datacoord/export", strings.NewReader(body))
+	req.SetBasicAuth(util.UserRoot, "wrong")
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+	recorder = serve(httptest.NewRequest(http.MethodPost, "/datacoord/export", strings.NewReader(body)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	created := make(map[string]int64)
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &created))
+	waitExportTask(t, m, created["id"])
+
+	recorder = serve(httptest.NewRequest(http.MethodGet, "/datacoord/export?id="+strconv.FormatInt(created["id"], 10), nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	state := &exportTaskState{}
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), state))
+	assert.Equal(t, commonpb.ImportState_ImportCompleted.String(), state.State)
+	assert.Equal(t, 2, state.SegmentNum)
+	assert.Equal(t, 2, state.ExportedSegmentNum)
+	assert.Equal(t, int64(4), state.RowCount)
+
+	recorder = serve(httptest.NewRequest(http.MethodPost, "/datacoord/export", strings.NewReader(`{"format": "csv"}`)))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = serve(httptest.NewRequest(http.MethodGet, "/datacoord/export?id=12345", nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	recorder = serve(httptest.NewRequest(http.MethodDelete, "/datacoord/export", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
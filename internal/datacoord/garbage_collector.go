@@ -45,11 +45,12 @@ const (
 
 // GcOption garbage collection options
 type GcOption struct {
-	cli              storage.ChunkManager // client
-	enabled          bool                 // enable switch
-	checkInterval    time.Duration        // each interval
-	missingTolerance time.Duration        // key missing in meta tolerance time
-	dropTolerance    time.Duration        // dropped segment related key tolerance time
+	cli              storage.ChunkManager     // client
+	enabled          bool                     // enable switch
+	checkInterval    time.Duration            // each interval
+	missingTolerance time.Duration            // key missing in meta tolerance time
+	dropTolerance    time.Duration            // dropped segment related key tolerance time
+	segRefer         *segmentReferenceManager // segments locked by tasks are not collected
 }
 
 // garbageCollector handles garbage files in object storage
@@ -202,7 +203,7 @@ func (gc *garbageCollector) scan() {
 func (gc *garbageCollector) clearEtcd() {
 	all := gc.meta.SelectSegments(func(si *SegmentInfo) bool { return true })
 	drops := make(map[int64]*SegmentInfo, 0)
-	compactTo := make(map[int64]*SegmentInfo)
+	compactTo := make(map[int64][]*SegmentInfo)
 	channels := typeutil.NewSet[string]()
 	for _, segment := range all {
 		if segment.GetState() == commonpb.SegmentState_Dropped {
@@ -212,13 +213,13 @@ func (gc *garbageCollector) clearEtcd() {
 			// A(indexed), B(indexed) -> C(no indexed), D(no indexed) -> E(no indexed), A, B can not be GC
 		}
 		for _, from := range segment.GetCompactionFrom() {
-			compactTo[from] = segment
+			compactTo[from] = append(compactTo[from], segment)
 		}
 	}
 
 	droppedCompactTo := make(map[*SegmentInfo]struct{})
 	for id := range drops {
-		if to, ok := compactTo[id]; ok {
+		for _, to := range compactTo[id] {
 			droppedCompactTo[to] = struct{}{}
 		}
 	}
@@ -254,12 +255,21 @@ func (gc *garbageCollector) clearEtcd() {
 		}
 		// For compact A, B -> C, don't GC A or B if C is not indexed,
 		// guarantee replacing A, B with C won't downgrade performance
-		if to, ok := compactTo[segment.GetID()]; ok && !indexedSet.Contain(to.GetID()) {
+		// A clustering compaction produces several segments, all of them shall be indexed
+		if to, ok := lo.Find(compactTo[segment.GetID()], func(to *SegmentInfo) bool {
+			return !indexedSet.Contain(to.GetID())
+		}); ok {
 			log.WithRateGroup("GC_FAIL_COMPACT_TO_NOT_INDEXED", 1, 60).
 				RatedWarn(60, "skipping GC when compact target segment is not indexed",
 					zap.Int64("segmentID", to.GetID()))
 			continue
 		}
+		// the binlogs of the segment are being read, e.g. by an export task
+		if gc.option.segRefer.HasSegmentLock(segment.GetID()) {
+			log.WithRateGroup("GC_FAIL_SEGMENT_LOCKED", 1, 60).
+				RatedInfo(60, "skipping GC when segment is locked")
+			continue
+		}
 		logs := getLogs(segment)
 		log.Info("GC segment", zap.Int64("segmentID", segment.GetID()))
 		if gc.removeLogs(logs) {
@@ -787,7 +787,7 @@ func TestGarbageCollector_clearETCD(t *testing.T) {
 			},
 		},
 		segments: &SegmentsInfo{
-			map[UniqueID]*SegmentInfo{
+			segments: map[UniqueID]*SegmentInfo{
 				segID: {
 					SegmentInfo: &datapb.SegmentInfo{
 						ID:            segID,
@@ -993,15 +993,22 @@ func TestGarbageCollector_clearETCD(t *testing.T) {
 	}
 	cm := &mocks.ChunkManager{}
 	cm.EXPECT().Remove(mock.Anything, mock.Anything).Return(nil)
+	segRefer := newSegmentReferenceManager()
+	segRefer.AddSegmentsLock(1, []int64{segID + 5})
 	gc := &garbageCollector{
 		option: GcOption{
 			cli:           &mocks.ChunkManager{},
 			dropTolerance: 1,
+			segRefer:      segRefer,
 		},
 		meta:    m,
 		handler: newMockHandlerWithMeta(m),
 	}
 	gc.clearEtcd()
+	// the locked segment is not collected
+	assert.NotNil(t, gc.meta.GetSegment(segID+5))
+	segRefer.ReleaseSegmentsLock(1)
+	gc.clearEtcd()
 
 	segA := gc.meta.GetSegment(segID)
 	assert.NotNil(t, segA)
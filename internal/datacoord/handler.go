@@ -145,6 +145,13 @@ func (h *ServerHandler) GetQueryVChanPositions(channel *channel, partitionIDs ..
 			unIndexedIDs.Insert(s.GetID())
 		}
 	}
+	// segments produced by the same clustering compaction, by the first compacted one
+	clusteringGroups := make(map[UniqueID][]UniqueID)
+	for id, s := range segmentInfos {
+		if s.GetClusteringInfo() != nil && len(s.GetCompactionFrom()) > 0 {
+			clusteringGroups[s.GetCompactionFrom()[0]] = append(clusteringGroups[s.GetCompactionFrom()[0]], id)
+		}
+	}
 	hasUnIndexed := true
 	for hasUnIndexed {
 		hasUnIndexed = false
@@ -153,6 +160,13 @@ func (h *ServerHandler) GetQueryVChanPositions(channel *channel, partitionIDs ..
 			// replace it with the indexed ones
 			if len(segmentInfos[id].GetCompactionFrom()) > 0 {
 				unIndexedIDs.Remove(id)
+				// the segments of a clustering compaction are replaced as a whole,
+				// they overlap with the compacted ones
+				if segmentInfos[id].GetClusteringInfo() != nil {
+					siblings := clusteringGroups[segmentInfos[id].GetCompactionFrom()[0]]
+					indexedIDs.Remove(siblings...)
+					unIndexedIDs.Remove(siblings...)
+				}
 				for _, segID := range segmentInfos[id].GetCompactionFrom() {
 					if indexed.Contain(segID) {
 						indexedIDs.Insert(segID)
@@ -226,7 +226,7 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 			deleteFunc(buildID)
 			return true
 		}
-		indexParams := ib.meta.GetIndexParams(meta.CollectionID, meta.IndexID)
+		indexParams := ib.meta.GetSegmentIndexParams(meta)
 		if isFlatIndex(getIndexType(indexParams)) || meta.NumRows < Params.DataCoordCfg.MinSegmentNumRowsToEnableIndex.GetAsInt64() {
 			log.Ctx(ib.ctx).Debug("segment does not need index really", zap.Int64("buildID", buildID),
 				zap.Int64("segID", meta.SegmentID), zap.Int64("num rows", meta.NumRows))
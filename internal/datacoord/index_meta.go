@@ -41,7 +41,10 @@ func (m *meta) updateCollectionIndex(index *model.Index) {
 }
 
 func (m *meta) updateSegmentIndex(segIdx *model.SegmentIndex) {
-	m.segments.SetSegmentIndex(segIdx.SegmentID, segIdx)
+	// the builds of rebuild jobs are not serving until they are switched
+	if segIdx.RebuildJobID == 0 {
+		m.segments.SetSegmentIndex(segIdx.SegmentID, segIdx)
+	}
 	m.buildID2SegmentIndex[segIdx.BuildID] = segIdx
 }
 
@@ -449,6 +452,15 @@ func (m *meta) GetIndexParams(collID, indexID UniqueID) []*commonpb.KeyValuePair
 	return indexParams
 }
 
+// GetSegmentIndexParams returns the index params the segment index is built with,
+// which may differ from the index meta while a rebuild job is running.
+func (m *meta) GetSegmentIndexParams(segIdx *model.SegmentIndex) []*commonpb.KeyValuePair {
+	if len(segIdx.IndexParams) > 0 {
+		return common.CloneKeyValuePairs(segIdx.IndexParams)
+	}
+	return m.GetIndexParams(segIdx.CollectionID, segIdx.IndexID)
+}
+
 func (m *meta) GetTypeParams(collID, indexID UniqueID) []*commonpb.KeyValuePair {
 	m.RLock()
 	defer m.RUnlock()
@@ -594,7 +606,11 @@ func (m *meta) RemoveSegmentIndex(collID, partID, segID, indexID, buildID Unique
 		return err
 	}
 
-	m.segments.DropSegmentIndex(segID, indexID)
+	if segment := m.segments.GetSegment(segID); segment != nil {
+		if segIdx, ok := segment.segmentIndexes[indexID]; ok && segIdx.BuildID == buildID {
+			m.segments.DropSegmentIndex(segID, indexID)
+		}
+	}
 	delete(m.buildID2SegmentIndex, buildID)
 	m.updateIndexTasksMetrics()
 	return nil
@@ -686,3 +702,87 @@ func (m *meta) GetMetasByNodeID(nodeID UniqueID) []*model.SegmentIndex {
 	}
 	return metas
 }
+
+// GetRebuildSegmentIndexes returns the builds created by the rebuild job, both pending and retired.
+func (m *meta) GetRebuildSegmentIndexes(jobID UniqueID) []*model.SegmentIndex {
+	m.RLock()
+	defer m.RUnlock()
+
+	segIdxes := make([]*model.SegmentIndex, 0)
+	for _, segIdx := range m.buildID2SegmentIndex {
+		if segIdx.RebuildJobID == jobID {
+			segIdxes = append(segIdxes, model.CloneSegmentIndex(segIdx))
+		}
+	}
+	return segIdxes
+}
+
+// SwitchSegmentIndex makes the finished build of a rebuild job serving for its segment,
+// the build serving before is retired by the job and returned, nil if there is no one.
+func (m *meta) SwitchSegmentIndex(buildID UniqueID) (*model.SegmentIndex, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	segIdx, ok := m.buildID2SegmentIndex[buildID]
+	if !ok {
+		return nil, fmt.Errorf("there is no index with buildID: %d", buildID)
+	}
+	if segIdx.RebuildJobID == 0 || segIdx.IndexState != commonpb.IndexState_Finished {
+		return nil, fmt.Errorf("index with buildID %d can't be switched, rebuildJobID: %d, state: %s",
+			buildID, segIdx.RebuildJobID, segIdx.IndexState.String())
+	}
+	segment := m.segments.GetSegment(segIdx.SegmentID)
+	if segment == nil {
+		return nil, fmt.Errorf("segment is not exist with ID: %d", segIdx.SegmentID)
+	}
+
+	switched := model.CloneSegmentIndex(segIdx)
+	switched.RebuildJobID = 0
+	segIdxes := []*model.SegmentIndex{switched}
+	var retired *model.SegmentIndex
+	if serving, ok := segment.segmentIndexes[segIdx.IndexID]; ok {
+		retired = model.CloneSegmentIndex(serving)
+		retired.RebuildJobID = segIdx.RebuildJobID
+		segIdxes = append(segIdxes, retired)
+	}
+	// the builds are altered in one transaction, so the segment always has one serving build
+	if err := m.catalog.AlterSegmentIndexes(m.ctx, segIdxes); err != nil {
+		log.Error("failed to switch segment index in meta store", zap.Int64("buildID", buildID), zap.Error(err))
+		return nil, err
+	}
+	for _, segIdx := range segIdxes {
+		m.updateSegmentIndex(segIdx)
+	}
+	log.Info("meta update: switch segment index success", zap.Int64("segID", switched.SegmentID),
+		zap.Int64("indexID", switched.IndexID), zap.Int64("buildID", buildID))
+	if retired == nil {
+		return nil, nil
+	}
+	return model.CloneSegmentIndex(retired), nil
+}
+
+// AlterIndexParams replaces the index params of the index meta, used when a rebuild job finishes.
+func (m *meta) AlterIndexParams(collID, indexID UniqueID, indexParams []*commonpb.KeyValuePair) error {
+	m.Lock()
+	defer m.Unlock()
+
+	fieldIndexes, ok := m.indexes[collID]
+	if !ok {
+		return fmt.Errorf("collection not exist with ID: %d", collID)
+	}
+	index, ok := fieldIndexes[indexID]
+	if !ok || index.IsDeleted {
+		return fmt.Errorf("index not exist with ID: %d", indexID)
+	}
+	clonedIndex := model.CloneIndex(index)
+	clonedIndex.IndexParams = common.CloneKeyValuePairs(indexParams)
+	if err := m.catalog.AlterIndexes(m.ctx, []*model.Index{clonedIndex}); err != nil {
+		log.Error("failed to alter index params in meta store", zap.Int64("collID", collID),
+			zap.Int64("indexID", indexID), zap.Error(err))
+		return err
+	}
+	m.updateCollectionIndex(clonedIndex)
+	log.Info("meta update: alter index params success", zap.Int64("collID", collID),
+		zap.Int64("indexID", indexID), zap.Any("indexParams", indexParams))
+	return nil
+}
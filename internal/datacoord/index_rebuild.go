@@ -0,0 +1,519 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	milvushttp "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// indexRebuildPrefix is the kv prefix of the index rebuild jobs
+const indexRebuildPrefix = datacoord.MetaPrefix + "/index-rebuild"
+
+// indexRebuildRequest is the request to rebuild an index of a collection with new index params.
+type indexRebuildRequest struct {
+	CollectionID int64             `json:"collection_id"`
+	IndexName    string            `json:"index_name"`
+	IndexParams  map[string]string `json:"index_params"`
+}
+
+// indexRebuildManager runs the index rebuild jobs.
+// A rebuild job builds a new version of the index for each flushed segment alongside the serving one,
+// the new build is switched to serve once it's finished, and querycoord replaces the index loaded by
+// querynodes segment by segment. The retired builds are recycled after no querynode loads them,
+// and the index params of the index meta are replaced after all the segments are switched.
+type indexRebuildManager struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	meta      *meta
+	allocator allocator
+	kv        kv.TxnKV
+	enqueue   func(buildID UniqueID)
+	// getReferences returns the index builds loaded by querynodes, keyed by the loaded collections
+	getReferences func() (map[int64]*querypb.IndexReferences, error)
+
+	mu   sync.RWMutex
+	jobs map[int64]*indexpb.IndexRebuildJob
+}
+
+func newIndexRebuildManager(ctx context.Context, meta *meta, allocator allocator, kv kv.TxnKV,
+	enqueue func(buildID UniqueID), getReferences func() (map[int64]*querypb.IndexReferences, error),
+) *indexRebuildManager {
+	ctx, cancel := context.WithCancel(ctx)
+	return &indexRebuildManager{
+		ctx:           ctx,
+		cancel:        cancel,
+		meta:          meta,
+		allocator:     allocator,
+		kv:            kv,
+		enqueue:       enqueue,
+		getReferences: getReferences,
+		jobs:          make(map[int64]*indexpb.IndexRebuildJob),
+	}
+}
+
+// start reloads the jobs from kv and starts the loop to drive them.
+func (m *indexRebuildManager) start() error {
+	_, values, err := m.kv.LoadWithPrefix(indexRebuildPrefix)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	for _, value := range values {
+		job := &indexpb.IndexRebuildJob{}
+		if err := proto.Unmarshal([]byte(value), job); err != nil {
+			m.mu.Unlock()
+			return err
+		}
+		m.jobs[job.GetJobID()] = job
+	}
+	m.mu.Unlock()
+
+	m.wg.Add(1)
+	go m.loop()
+	return nil
+}
+
+func (m *indexRebuildManager) stop() {
+	m.cancel()
+	m.wg.Wait()
+}
+
+func (m *indexRebuildManager) loop() {
+	defer m.wg.Done()
+	ticker := time.NewTicker(Params.DataCoordCfg.IndexRebuildCheckInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			log.Info("index rebuild loop exit")
+			return
+		case <-ticker.C:
+			m.check()
+		}
+	}
+}
+
+// createJob creates a rebuild job of the index with the new index params.
+func (m *indexRebuildManager) createJob(ctx context.Context, req *indexRebuildRequest) (int64, error) {
+	indexes := m.meta.GetIndexesForCollection(req.CollectionID, req.IndexName)
+	if len(indexes) == 0 {
+		return 0, fmt.Errorf("index not found, collectionID: %d, indexName: '%s'", req.CollectionID, req.IndexName)
+	}
+	if len(indexes) > 1 {
+		return 0, fmt.Errorf("collection %d has multiple indexes, the index name must be specified", req.CollectionID)
+	}
+	index := indexes[0]
+
+	indexParams := make([]*commonpb.KeyValuePair, 0, len(req.IndexParams))
+	for key, value := range req.IndexParams {
+		indexParams = append(indexParams, &commonpb.KeyValuePair{Key: key, Value: value})
+	}
+	metricType := getParamValue(index.IndexParams, common.MetricTypeKey)
+	switch getParamValue(indexParams, common.MetricTypeKey) {
+	case "":
+		indexParams = append(indexParams, &commonpb.KeyValuePair{Key: common.MetricTypeKey, Value: metricType})
+	case metricType:
+	default:
+		return 0, fmt.Errorf("metric type can't be changed by rebuild, current metric type: %s", metricType)
+	}
+	sort.Slice(indexParams, func(i, j int) bool { return indexParams[i].GetKey() < indexParams[j].GetKey() })
+	if getIndexType(indexParams) == invalidIndex {
+		return 0, errors.New("index type is not specified")
+	}
+	if common.KeyValuePairs(index.IndexParams).Equal(indexParams) {
+		return 0, errors.New("index params are not changed")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, job := range m.jobs {
+		if job.GetIndexID() == index.IndexID && job.GetState() == commonpb.IndexState_InProgress {
+			return 0, fmt.Errorf("index %d has rebuild job %d in progress", index.IndexID, job.GetJobID())
+		}
+	}
+	id, err := m.allocator.allocID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	job := &indexpb.IndexRebuildJob{
+		JobID:        id,
+		CollectionID: index.CollectionID,
+		IndexID:      index.IndexID,
+		IndexParams:  indexParams,
+		State:        commonpb.IndexState_InProgress,
+		CreateTime:   uint64(time.Now().Unix()),
+	}
+	if err := m.saveJob(job); err != nil {
+		return 0, err
+	}
+	m.jobs[id] = job
+	log.Info("index rebuild job created", zap.Int64("jobID", id), zap.Int64("collectionID", index.CollectionID),
+		zap.Int64("indexID", index.IndexID), zap.Any("indexParams", indexParams))
+	return id, nil
+}
+
+func getParamValue(params []*commonpb.KeyValuePair, key string) string {
+	for _, param := range params {
+		if param.GetKey() == key {
+			return param.GetValue()
+		}
+	}
+	return ""
+}
+
+// getJob returns a copy of the job, nil if the job doesn't exist.
+func (m *indexRebuildManager) getJob(id int64) *indexpb.IndexRebuildJob {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	job, ok := m.jobs[id]
+	if !ok {
+		return nil
+	}
+	return proto.Clone(job).(*indexpb.IndexRebuildJob)
+}
+
+func (m *indexRebuildManager) listJobs() []*indexpb.IndexRebuildJob {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	jobs := make([]*indexpb.IndexRebuildJob, 0, len(m.jobs))
+	for _, job := range m.jobs {
+		jobs = append(jobs, proto.Clone(job).(*indexpb.IndexRebuildJob))
+	}
+	return jobs
+}
+
+func (m *indexRebuildManager) saveJob(job *indexpb.IndexRebuildJob) error {
+	value, err := proto.Marshal(job)
+	if err != nil {
+		return err
+	}
+	return m.kv.Save(path.Join(indexRebuildPrefix, strconv.FormatInt(job.GetJobID(), 10)), string(value))
+}
+
+// updateJob applies the update on a copy of the job, and replaces the job if the copy is saved.
+func (m *indexRebuildManager) updateJob(id int64, update func(job *indexpb.IndexRebuildJob)) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	job := proto.Clone(m.jobs[id]).(*indexpb.IndexRebuildJob)
+	update(job)
+	if err := m.saveJob(job); err != nil {
+		return err
+	}
+	m.jobs[id] = job
+	return nil
+}
+
+func (m *indexRebuildManager) check() {
+	refs, refErr := m.getReferences()
+	if refErr != nil {
+		log.Warn("failed to get index references, skip recycling retired index builds", zap.Error(refErr))
+	}
+	for _, job := range m.listJobs() {
+		if job.GetState() == commonpb.IndexState_InProgress {
+			if err := m.process(job); err != nil {
+				log.Warn("failed to process index rebuild job, wait for retry", zap.Int64("jobID", job.GetJobID()), zap.Error(err))
+			}
+		}
+		if refErr == nil {
+			m.recycle(m.getJob(job.GetJobID()), refs)
+		}
+	}
+}
+
+// isRetiredBuild tells whether the build of the job is retired or pending,
+// the pending builds are built with the params of the job while the retired ones aren't.
+func isRetiredBuild(job *indexpb.IndexRebuildJob, segIdx *model.SegmentIndex) bool {
+	return !common.KeyValuePairs(segIdx.IndexParams).Equal(job.GetIndexParams())
+}
+
+// process creates the builds of the segments not rebuilt yet, and switches the finished ones.
+func (m *indexRebuildManager) process(job *indexpb.IndexRebuildJob) error {
+	if !m.meta.IsIndexExist(job.GetCollectionID(), job.GetIndexID()) {
+		return m.failJob(job, "index has been dropped")
+	}
+
+	pendings := make(map[UniqueID]*model.SegmentIndex)
+	for _, segIdx := range m.meta.GetRebuildSegmentIndexes(job.GetJobID()) {
+		if !isRetiredBuild(job, segIdx) {
+			pendings[segIdx.SegmentID] = segIdx
+		}
+	}
+
+	segments := m.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetCollectionID() == job.GetCollectionID() && isFlush(segment) && !segment.GetIsImporting()
+	})
+	done := true
+	for _, segment := range segments {
+		serving, ok := segment.segmentIndexes[job.GetIndexID()]
+		if !ok || serving.IndexState != commonpb.IndexState_Finished {
+			// wait for the segment to be indexed first
+			done = false
+			continue
+		}
+		if common.KeyValuePairs(m.meta.GetSegmentIndexParams(serving)).Equal(job.GetIndexParams()) {
+			continue
+		}
+		done = false
+
+		pending, ok := pendings[segment.GetID()]
+		if !ok {
+			if err := m.createBuild(job, segment); err != nil {
+				return err
+			}
+			continue
+		}
+		switch pending.IndexState {
+		case commonpb.IndexState_Finished:
+			if err := m.switchBuild(job, pending); err != nil {
+				return err
+			}
+		case commonpb.IndexState_Failed:
+			return m.failJob(job, fmt.Sprintf("failed to build index for segment %d: %s", segment.GetID(), pending.FailReason))
+		}
+	}
+	if !done {
+		return nil
+	}
+
+	if err := m.meta.AlterIndexParams(job.GetCollectionID(), job.GetIndexID(), job.GetIndexParams()); err != nil {
+		return err
+	}
+	log.Info("index rebuild job finished", zap.Int64("jobID", job.GetJobID()),
+		zap.Int("retiredBuildNum", len(job.GetRetiredBuildIDs())))
+	return m.updateJob(job.GetJobID(), func(job *indexpb.IndexRebuildJob) {
+		job.State = commonpb.IndexState_Finished
+	})
+}
+
+func (m *indexRebuildManager) createBuild(job *indexpb.IndexRebuildJob, segment *SegmentInfo) error {
+	buildID, err := m.allocator.allocID(m.ctx)
+	if err != nil {
+		return err
+	}
+	segIdx := &model.SegmentIndex{
+		SegmentID:    segment.GetID(),
+		CollectionID: segment.GetCollectionID(),
+		PartitionID:  segment.GetPartitionID(),
+		NumRows:      segment.GetNumOfRows(),
+		IndexID:      job.GetIndexID(),
+		BuildID:      buildID,
+		CreateTime:   uint64(segment.GetID()),
+		IndexParams:  common.CloneKeyValuePairs(job.GetIndexParams()),
+		RebuildJobID: job.GetJobID(),
+	}
+	if err := m.meta.AddSegmentIndex(segIdx); err != nil {
+		return err
+	}
+	m.enqueue(buildID)
+	log.Info("index rebuild job creates build", zap.Int64("jobID", job.GetJobID()),
+		zap.Int64("segmentID", segment.GetID()), zap.Int64("buildID", buildID))
+	return nil
+}
+
+func (m *indexRebuildManager) switchBuild(job *indexpb.IndexRebuildJob, pending *model.SegmentIndex) error {
+	// the retire time is saved before the switch, so the references updated before it never recycle the build
+	retireTime := time.Now().UnixMilli()
+	if err := m.updateJob(job.GetJobID(), func(job *indexpb.IndexRebuildJob) {
+		job.LastRetireTime = retireTime
+	}); err != nil {
+		return err
+	}
+	retired, err := m.meta.SwitchSegmentIndex(pending.BuildID)
+	if err != nil {
+		return err
+	}
+	if retired == nil {
+		return nil
+	}
+	log.Info("index rebuild job switches build", zap.Int64("jobID", job.GetJobID()), zap.Int64("segmentID", pending.SegmentID),
+		zap.Int64("buildID", pending.BuildID), zap.Int64("retiredBuildID", retired.BuildID))
+	return m.updateJob(job.GetJobID(), func(job *indexpb.IndexRebuildJob) {
+		job.RetiredBuildIDs = append(job.RetiredBuildIDs, retired.BuildID)
+	})
+}
+
+// failJob marks the job as failed, and removes the pending builds of it.
+func (m *indexRebuildManager) failJob(job *indexpb.IndexRebuildJob, reason string) error {
+	log.Warn("index rebuild job failed", zap.Int64("jobID", job.GetJobID()), zap.String("reason", reason))
+	if err := m.updateJob(job.GetJobID(), func(job *indexpb.IndexRebuildJob) {
+		job.State = commonpb.IndexState_Failed
+		job.FailReason = reason
+	}); err != nil {
+		return err
+	}
+	for _, segIdx := range m.meta.GetRebuildSegmentIndexes(job.GetJobID()) {
+		if isRetiredBuild(job, segIdx) {
+			continue
+		}
+		if err := m.meta.RemoveSegmentIndex(segIdx.CollectionID, segIdx.PartitionID, segIdx.SegmentID, segIdx.IndexID, segIdx.BuildID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recycle removes the meta of the retired builds which are not loaded by any querynode,
+// the index files are removed by the garbage collector then.
+// The references must be updated after the last build is retired, otherwise a querynode
+// may not replace the retired build yet.
+func (m *indexRebuildManager) recycle(job *indexpb.IndexRebuildJob, refs map[int64]*querypb.IndexReferences) {
+	retired := make([]*model.SegmentIndex, 0)
+	for _, segIdx := range m.meta.GetRebuildSegmentIndexes(job.GetJobID()) {
+		if isRetiredBuild(job, segIdx) {
+			retired = append(retired, segIdx)
+		}
+	}
+	if len(retired) == 0 {
+		return
+	}
+	ref, loaded := refs[job.GetCollectionID()]
+	if loaded && ref.GetUpdateTime() <= job.GetLastRetireTime() {
+		return
+	}
+	referenced := typeutil.NewUniqueSet(ref.GetBuildIDs()...)
+	for _, segIdx := range retired {
+		if referenced.Contain(segIdx.BuildID) {
+			continue
+		}
+		if err := m.meta.RemoveSegmentIndex(segIdx.CollectionID, segIdx.PartitionID, segIdx.SegmentID, segIdx.IndexID, segIdx.BuildID); err != nil {
+			log.Warn("failed to remove retired index build, wait for retry", zap.Int64("jobID", job.GetJobID()),
+				zap.Int64("buildID", segIdx.BuildID), zap.Error(err))
+			continue
+		}
+		log.Info("retired index build recycled", zap.Int64("jobID", job.GetJobID()),
+			zap.Int64("segmentID", segIdx.SegmentID), zap.Int64("buildID", segIdx.BuildID))
+	}
+}
+
+// indexRebuildJobState is the state of an index rebuild job returned by the http api.
+type indexRebuildJobState struct {
+	ID              int64             `json:"id"`
+	CollectionID    int64             `json:"collection_id"`
+	IndexID         int64             `json:"index_id"`
+	IndexParams     map[string]string `json:"index_params"`
+	State           string            `json:"state"`
+	FailReason      string            `json:"fail_reason,omitempty"`
+	RetiredBuildIDs []int64           `json:"retired_build_ids"`
+}
+
+// indexRebuildHandler serves the index rebuild api of the active datacoord:
+//
+//	POST /datacoord/index/rebuild {"collection_id":1,"index_name":"vec","index_params":{"index_type":"HNSW","M":"16"}}  create a rebuild job
+//	GET  /datacoord/index/rebuild?id=1                                                                                get the state of a rebuild job
+//
+// Only root is allowed to call the api, by basic auth.
+// The handler is registered once per process like the export handler,
+// the rebuild manager is set when datacoord becomes active.
+type indexRebuildHandler struct {
+	mu      sync.RWMutex
+	manager *indexRebuildManager
+	verify  milvushttp.CredentialVerifier
+}
+
+var (
+	registerIndexRebuildHandlerOnce sync.Once
+	defaultIndexRebuildHandler      = &indexRebuildHandler{}
+)
+
+func (h *indexRebuildHandler) setManager(manager *indexRebuildManager, verify milvushttp.CredentialVerifier) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.manager = manager
+	h.verify = verify
+}
+
+func (h *indexRebuildHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	h.mu.RLock()
+	manager, verify := h.manager, h.verify
+	h.mu.RUnlock()
+	if manager == nil {
+		writeIndexRebuildJSON(w, http.StatusServiceUnavailable, map[string]string{"error": "datacoord is not active"})
+		return
+	}
+	if _, ok := milvushttp.AuthenticateRoot(w, req, verify); !ok {
+		return
+	}
+
+	switch req.Method {
+	case http.MethodPost:
+		rebuildReq := &indexRebuildRequest{}
+		if err := json.NewDecoder(req.Body).Decode(rebuildReq); err != nil {
+			writeIndexRebuildJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+		id, err := manager.createJob(req.Context(), rebuildReq)
+		if err != nil {
+			writeIndexRebuildJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+		writeIndexRebuildJSON(w, http.StatusOK, map[string]int64{"id": id})
+	case http.MethodGet:
+		id, err := strconv.ParseInt(req.URL.Query().Get("id"), 10, 64)
+		if err != nil {
+			writeIndexRebuildJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+		job := manager.getJob(id)
+		if job == nil {
+			writeIndexRebuildJSON(w, http.StatusNotFound, map[string]string{"error": fmt.Sprintf("index rebuild job %d not found", id)})
+			return
+		}
+		writeIndexRebuildJSON(w, http.StatusOK, &indexRebuildJobState{
+			ID:              job.GetJobID(),
+			CollectionID:    job.GetCollectionID(),
+			IndexID:         job.GetIndexID(),
+			IndexParams:     common.KeyValuePairs(job.GetIndexParams()).ToMap(),
+			State:           job.GetState().String(),
+			FailReason:      job.GetFailReason(),
+			RetiredBuildIDs: job.GetRetiredBuildIDs(),
+		})
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func writeIndexRebuildJSON(w http.ResponseWriter, code int, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		log.Warn("failed to write index rebuild response", zap.Error(err))
+	}
+}
@@ -0,0 +1,268 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util"
+)
+
+type testIndexRebuild struct {
+	manager  *indexRebuildManager
+	meta     *meta
+	kv       *memkv.MemoryKV
+	enqueued []UniqueID
+	refs     map[int64]*querypb.IndexReferences
+}
+
+func newTestIndexRebuild(t *testing.T) *testIndexRebuild {
+	meta, err := newMemoryMeta()
+	require.NoError(t, err)
+	require.NoError(t, meta.CreateIndex(&model.Index{
+		CollectionID: 1,
+		FieldID:      100,
+		IndexID:      1000,
+		IndexName:    "vec",
+		IndexParams: []*commonpb.KeyValuePair{
+			{Key: "index_type", Value: "IVF_FLAT"},
+			{Key: "metric_type", Value: "L2"},
+			{Key: "nlist", Value: "128"},
+		},
+	}))
+	// two flushed segments indexed, and a growing one
+	for i, segmentID := range []int64{100, 101} {
+		require.NoError(t, meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: segmentID, CollectionID: 1,
+			PartitionID: 10, State: commonpb.SegmentState_Flushed, NumOfRows: 2048})))
+		buildID := int64(10 + i)
+		require.NoError(t, meta.AddSegmentIndex(&model.SegmentIndex{SegmentID: segmentID, CollectionID: 1,
+			PartitionID: 10, IndexID: 1000, BuildID: buildID, NumRows: 2048}))
+		require.NoError(t, meta.FinishTask(&indexpb.IndexTaskInfo{BuildID: buildID, State: commonpb.IndexState_Finished}))
+	}
+	require.NoError(t, meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 102, CollectionID: 1,
+		PartitionID: 10, State: commonpb.SegmentState_Growing})))
+
+	r := &testIndexRebuild{meta: meta, kv: memkv.NewMemoryKV(), refs: make(map[int64]*querypb.IndexReferences)}
+	r.manager = newIndexRebuildManager(context.Background(), meta, newMockAllocator(), r.kv,
+		func(buildID UniqueID) { r.enqueued = append(r.enqueued, buildID) },
+		func() (map[int64]*querypb.IndexReferences, error) { return r.refs, nil })
+	return r
+}
+
+func (r *testIndexRebuild) servingBuild(segmentID UniqueID) UniqueID {
+	segIdxes := r.meta.GetSegmentIndexes(segmentID)
+	if len(segIdxes) != 1 {
+		return 0
+	}
+	return segIdxes[0].BuildID
+}
+
+func TestIndexRebuildManager_CreateJob(t *testing.T) {
+	r := newTestIndexRebuild(t)
+	ctx := context.Background()
+
+	_, err := r.manager.createJob(ctx, &indexRebuildRequest{CollectionID: 2, IndexParams: map[string]string{"index_type": "HNSW"}})
+	assert.Error(t, err)
+	_, err = r.manager.createJob(ctx, &indexRebuildRequest{CollectionID: 1, IndexName: "other", IndexParams: map[string]string{"index_type": "HNSW"}})
+	assert.Error(t, err)
+	_, err = r.manager.createJob(ctx, &indexRebuildRequest{CollectionID: 1, IndexParams: map[string]string{"nlist": "256"}})
+	assert.Error(t, err)
+	_, err = r.manager.createJob(ctx, &indexRebuildRequest{CollectionID: 1, IndexParams: map[string]string{"index_type": "HNSW", "metric_type": "IP"}})
+	assert.Error(t, err)
+	_, err = r.manager.createJob(ctx, &indexRebuildRequest{CollectionID: 1, IndexParams: map[string]string{"index_type": "IVF_FLAT", "nlist": "128"}})
+	assert.Error(t, err)
+
+	id, err := r.manager.createJob(ctx, &indexRebuildRequest{CollectionID: 1, IndexName: "vec",
+		IndexParams: map[string]string{"index_type": "HNSW", "M": "16"}})
+	assert.NoError(t, err)
+	job := r.manager.getJob(id)
+	assert.Equal(t, commonpb.IndexState_InProgress, job.GetState())
+	assert.Equal(t, map[string]string{"index_type": "HNSW", "M": "16", "metric_type": "L2"},
+		common.KeyValuePairs(job.GetIndexParams()).ToMap())
+
+	// only one job of an index runs at a time
+	_, err = r.manager.createJob(ctx, &indexRebuildRequest{CollectionID: 1, IndexParams: map[string]string{"index_type": "HNSW", "M": "32"}})
+	assert.Error(t, err)
+
+	// the jobs are reloaded on start
+	m := newIndexRebuildManager(context.Background(), r.meta, newMockAllocator(), r.kv, nil, nil)
+	require.NoError(t, m.start())
+	defer m.stop()
+	assert.True(t, proto.Equal(job, m.getJob(id)))
+}
+
+func TestIndexRebuildManager_Process(t *testing.T) {
+	r := newTestIndexRebuild(t)
+	id, err := r.manager.createJob(context.Background(), &indexRebuildRequest{CollectionID: 1,
+		IndexParams: map[string]string{"index_type": "HNSW", "M": "16"}})
+	require.NoError(t, err)
+
+	// the builds are created alongside the serving ones
+	r.manager.check()
+	require.Len(t, r.enqueued, 2)
+	assert.Equal(t, UniqueID(10), r.servingBuild(100))
+	assert.Equal(t, UniqueID(11), r.servingBuild(101))
+	pending, ok := r.meta.GetIndexJob(r.enqueued[0])
+	require.True(t, ok)
+	assert.Equal(t, id, pending.RebuildJobID)
+	assert.Equal(t, "HNSW", getIndexType(r.meta.GetSegmentIndexParams(pending)))
+
+	// nothing changes before the builds finish
+	r.manager.check()
+	assert.Len(t, r.enqueued, 2)
+
+	// the finished build is switched for its segment
+	require.NoError(t, r.meta.FinishTask(&indexpb.IndexTaskInfo{BuildID: r.enqueued[0], State: commonpb.IndexState_Finished}))
+	r.refs[1] = &querypb.IndexReferences{CollectionID: 1, BuildIDs: []int64{10, 11}, UpdateTime: time.Now().UnixMilli()}
+	r.manager.check()
+	assert.Equal(t, r.enqueued[0], r.servingBuild(pending.SegmentID))
+	job := r.manager.getJob(id)
+	assert.Equal(t, commonpb.IndexState_InProgress, job.GetState())
+	require.Len(t, job.GetRetiredBuildIDs(), 1)
+	retiredBuildID := job.GetRetiredBuildIDs()[0]
+	retired, ok := r.meta.GetIndexJob(retiredBuildID)
+	require.True(t, ok)
+	assert.Equal(t, id, retired.RebuildJobID)
+	assert.Equal(t, "IVF_FLAT", getIndexType(r.meta.GetSegmentIndexParams(retired)))
+
+	require.NoError(t, r.meta.FinishTask(&indexpb.IndexTaskInfo{BuildID: r.enqueued[1], State: commonpb.IndexState_Finished}))
+	r.manager.check()
+	r.manager.check()
+	job = r.manager.getJob(id)
+	assert.Equal(t, commonpb.IndexState_Finished, job.GetState())
+	assert.Len(t, job.GetRetiredBuildIDs(), 2)
+	assert.Equal(t, "HNSW", getIndexType(r.meta.GetIndexParams(1, 1000)))
+
+	// the retired builds are kept until querynodes don't load them after the last switch
+	_, ok = r.meta.GetIndexJob(retiredBuildID)
+	assert.True(t, ok)
+	r.refs[1] = &querypb.IndexReferences{CollectionID: 1, BuildIDs: []int64{r.enqueued[0], 11},
+		UpdateTime: job.GetLastRetireTime() + 1}
+	r.manager.check()
+	_, ok = r.meta.GetIndexJob(10)
+	assert.False(t, ok)
+	_, ok = r.meta.GetIndexJob(11)
+	assert.True(t, ok)
+
+	// the collection is released
+	delete(r.refs, 1)
+	r.manager.check()
+	_, ok = r.meta.GetIndexJob(11)
+	assert.False(t, ok)
+	assert.ElementsMatch(t, r.enqueued, []UniqueID{r.servingBuild(100), r.servingBuild(101)})
+}
+
+func TestIndexRebuildManager_Fail(t *testing.T) {
+	r := newTestIndexRebuild(t)
+	id, err := r.manager.createJob(context.Background(), &indexRebuildRequest{CollectionID: 1,
+		IndexParams: map[string]string{"index_type": "HNSW", "M": "16"}})
+	require.NoError(t, err)
+	r.manager.check()
+	require.Len(t, r.enqueued, 2)
+
+	require.NoError(t, r.meta.FinishTask(&indexpb.IndexTaskInfo{BuildID: r.enqueued[1], State: commonpb.IndexState_Failed,
+		FailReason: "mock failure"}))
+	r.manager.check()
+	job := r.manager.getJob(id)
+	assert.Equal(t, commonpb.IndexState_Failed, job.GetState())
+	assert.Contains(t, job.GetFailReason(), "mock failure")
+	// the pending builds are removed, the serving ones are kept
+	for _, buildID := range r.enqueued {
+		_, ok := r.meta.GetIndexJob(buildID)
+		assert.False(t, ok)
+	}
+	assert.Equal(t, UniqueID(10), r.servingBuild(100))
+	assert.Equal(t, UniqueID(11), r.servingBuild(101))
+	assert.Equal(t, "IVF_FLAT", getIndexType(r.meta.GetIndexParams(1, 1000)))
+}
+
+func TestIndexRebuildHandler(t *testing.T) {
+	handler := &indexRebuildHandler{}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/datacoord/index/rebuild?id=1", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+
+	r := newTestIndexRebuild(t)
+	handler.setManager(r.manager, func(ctx context.Context, username, password string) error {
+		if password != "Milvus" {
+			return errors.New("wrong password")
+		}
+		return nil
+	})
+	serve := func(req *http.Request) *httptest.ResponseRecorder {
+		req.SetBasicAuth(util.UserRoot, "Milvus")
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	// only root is allowed
+	body := `{"collection_id": 1, "index_name": "vec", "index_params": {"index_type": "HNSW", "M": "16"}}`
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/datacoord/index/rebuild", strings.NewReader(body)))
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	recorder = httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/datacoord/index/rebuild", strings.NewReader(body))
+	req.SetBasicAuth("user", "Milvus")
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/datacoord/index/rebuild?id=1", nil))
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	assert.Empty(t, r.manager.listJobs())
+
+	recorder = serve(httptest.NewRequest(http.MethodPost, "/datacoord/index/rebuild", strings.NewReader(body)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	created := make(map[string]int64)
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &created))
+
+	recorder = serve(httptest.NewRequest(http.MethodGet, "/datacoord/index/rebuild?id="+strconv.FormatInt(created["id"], 10), nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	state := &indexRebuildJobState{}
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), state))
+	assert.Equal(t, commonpb.IndexState_InProgress.String(), state.State)
+	assert.Equal(t, int64(1000), state.IndexID)
+	assert.Equal(t, "HNSW", state.IndexParams["index_type"])
+
+	recorder = serve(httptest.NewRequest(http.MethodPost, "/datacoord/index/rebuild", strings.NewReader(`{"collection_id": 2}`)))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = serve(httptest.NewRequest(http.MethodGet, "/datacoord/index/rebuild?id=12345", nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	recorder = serve(httptest.NewRequest(http.MethodDelete, "/datacoord/index/rebuild", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
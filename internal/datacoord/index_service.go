@@ -603,7 +603,7 @@ func (s *Server) GetIndexInfos(ctx context.Context, req *indexpb.GetIndexInfoReq
 							IndexID:        segIdx.IndexID,
 							BuildID:        segIdx.BuildID,
 							IndexName:      s.meta.GetIndexNameByID(segIdx.CollectionID, segIdx.IndexID),
-							IndexParams:    s.meta.GetIndexParams(segIdx.CollectionID, segIdx.IndexID),
+							IndexParams:    s.meta.GetSegmentIndexParams(segIdx),
 							IndexFilePaths: indexFilePaths,
 							SerializedSize: segIdx.IndexSize,
 							IndexVersion:   segIdx.IndexVersion,
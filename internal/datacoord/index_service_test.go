@@ -210,7 +210,7 @@ func TestServer_GetIndexState(t *testing.T) {
 				},
 			},
 		},
-		segments: &SegmentsInfo{map[UniqueID]*SegmentInfo{
+		segments: &SegmentsInfo{segments: map[UniqueID]*SegmentInfo{
 			segID: {
 				SegmentInfo: &datapb.SegmentInfo{
 					ID:             segID,
@@ -262,7 +262,7 @@ func TestServer_GetIndexState(t *testing.T) {
 				},
 			},
 		},
-		segments: &SegmentsInfo{map[UniqueID]*SegmentInfo{
+		segments: &SegmentsInfo{segments: map[UniqueID]*SegmentInfo{
 			segID: {
 				SegmentInfo: &datapb.SegmentInfo{
 					ID:             segID,
@@ -365,7 +365,7 @@ func TestServer_GetSegmentIndexState(t *testing.T) {
 		meta: &meta{
 			catalog:  &datacoord.Catalog{MetaKv: mocks.NewMetaKv(t)},
 			indexes:  map[UniqueID]map[UniqueID]*model.Index{},
-			segments: &SegmentsInfo{map[UniqueID]*SegmentInfo{}},
+			segments: &SegmentsInfo{segments: map[UniqueID]*SegmentInfo{}},
 		},
 		allocator:       newMockAllocator(),
 		notifyIndexChan: make(chan UniqueID, 1),
@@ -502,7 +502,7 @@ func TestServer_GetIndexBuildProgress(t *testing.T) {
 		meta: &meta{
 			catalog:  &datacoord.Catalog{MetaKv: mocks.NewMetaKv(t)},
 			indexes:  map[UniqueID]map[UniqueID]*model.Index{},
-			segments: &SegmentsInfo{map[UniqueID]*SegmentInfo{}},
+			segments: &SegmentsInfo{segments: map[UniqueID]*SegmentInfo{}},
 		},
 		allocator:       newMockAllocator(),
 		notifyIndexChan: make(chan UniqueID, 1),
@@ -786,7 +786,7 @@ func TestServer_DescribeIndex(t *testing.T) {
 					},
 				},
 			},
-			segments: &SegmentsInfo{map[UniqueID]*SegmentInfo{
+			segments: &SegmentsInfo{segments: map[UniqueID]*SegmentInfo{
 				invalidSegID: {
 					SegmentInfo: &datapb.SegmentInfo{
 						ID:             segID,
@@ -1052,7 +1052,7 @@ func TestServer_DropIndex(t *testing.T) {
 					},
 				},
 			},
-			segments: &SegmentsInfo{map[UniqueID]*SegmentInfo{
+			segments: &SegmentsInfo{segments: map[UniqueID]*SegmentInfo{
 				segID: {
 					SegmentInfo: &datapb.SegmentInfo{
 						ID:             segID,
@@ -1192,7 +1192,7 @@ func TestServer_GetIndexInfos(t *testing.T) {
 				},
 			},
 			segments: &SegmentsInfo{
-				map[UniqueID]*SegmentInfo{
+				segments: map[UniqueID]*SegmentInfo{
 					segID: {
 						SegmentInfo: &datapb.SegmentInfo{
 							ID:             segID,
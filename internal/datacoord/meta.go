@@ -79,6 +79,14 @@ type collectionInfo struct {
 	CreatedAt      Timestamp
 }
 
+// GetProperties returns the properties of collection, nil if the collection is nil.
+func (c *collectionInfo) GetProperties() map[string]string {
+	if c == nil {
+		return nil
+	}
+	return c.Properties
+}
+
 // NewMeta creates meta from provided `kv.TxnKV`
 func newMeta(ctx context.Context, catalog metastore.DataCoordCatalog, chunkManager storage.ChunkManager) (*meta, error) {
 	mt := &meta{
@@ -960,50 +968,11 @@ func (m *meta) PrepareCompleteCompactionMutation(compactionLogs []*datapb.Compac
 	m.Lock()
 	defer m.Unlock()
 
-	var (
-		oldSegments = make([]*SegmentInfo, 0, len(compactionLogs))
-		modSegments = make([]*SegmentInfo, 0, len(compactionLogs))
-	)
-
 	metricMutation := &segMetricMutation{
 		stateChange: make(map[string]int),
 	}
-	for _, cl := range compactionLogs {
-		if segment := m.segments.GetSegment(cl.GetSegmentID()); segment != nil {
-			oldSegments = append(oldSegments, segment.Clone())
-
-			cloned := segment.Clone()
-			updateSegStateAndPrepareMetrics(cloned, commonpb.SegmentState_Dropped, metricMutation)
-			cloned.DroppedAt = uint64(time.Now().UnixNano())
-			modSegments = append(modSegments, cloned)
-		}
-	}
+	oldSegments, modSegments, startPosition, dmlPosition, newAddedDeltalogs := m.prepareCompactedSegments(compactionLogs, metricMutation)
 
-	var startPosition, dmlPosition *msgpb.MsgPosition
-	for _, s := range modSegments {
-		if dmlPosition == nil ||
-			s.GetDmlPosition() != nil && s.GetDmlPosition().GetTimestamp() < dmlPosition.GetTimestamp() {
-			dmlPosition = s.GetDmlPosition()
-		}
-
-		if startPosition == nil ||
-			s.GetStartPosition() != nil && s.GetStartPosition().GetTimestamp() < startPosition.GetTimestamp() {
-			startPosition = s.GetStartPosition()
-		}
-	}
-
-	// find new added delta logs when executing compaction
-	var originDeltalogs []*datapb.FieldBinlog
-	for _, s := range modSegments {
-		originDeltalogs = append(originDeltalogs, s.GetDeltalogs()...)
-	}
-
-	var deletedDeltalogs []*datapb.FieldBinlog
-	for _, l := range compactionLogs {
-		deletedDeltalogs = append(deletedDeltalogs, l.GetDeltalogs()...)
-	}
-
-	newAddedDeltalogs := m.updateDeltalogs(originDeltalogs, deletedDeltalogs, nil)
 	copiedDeltalogs, err := m.copyDeltaFiles(newAddedDeltalogs, modSegments[0].CollectionID, modSegments[0].PartitionID, result.GetSegmentID())
 	if err != nil {
 		return nil, nil, nil, nil, err
@@ -1043,6 +1012,110 @@ func (m *meta) PrepareCompleteCompactionMutation(compactionLogs []*datapb.Compac
 	return oldSegments, modSegments, segment, metricMutation, nil
 }
 
+// PrepareCompleteClusteringCompactionMutation prepares the segments produced by a clustering compaction,
+// each of them covers a range of the clustering field. The delta logs added during the compaction are
+// copied into all of them, as the deleted entities may be in any one.
+func (m *meta) PrepareCompleteClusteringCompactionMutation(compactionLogs []*datapb.CompactionSegmentBinlogs,
+	result *datapb.CompactionResult) ([]*SegmentInfo, []*SegmentInfo, *segMetricMutation, error) {
+	log.Info("meta update: prepare for complete clustering compaction mutation")
+	m.Lock()
+	defer m.Unlock()
+
+	metricMutation := &segMetricMutation{
+		stateChange: make(map[string]int),
+	}
+	_, modSegments, startPosition, dmlPosition, newAddedDeltalogs := m.prepareCompactedSegments(compactionLogs, metricMutation)
+
+	compactionFrom := make([]UniqueID, 0, len(modSegments))
+	for _, s := range modSegments {
+		compactionFrom = append(compactionFrom, s.GetID())
+	}
+
+	newSegments := make([]*SegmentInfo, 0, len(result.GetClusteringSegments()))
+	for _, r := range result.GetClusteringSegments() {
+		copiedDeltalogs, err := m.copyDeltaFiles(newAddedDeltalogs, modSegments[0].CollectionID, modSegments[0].PartitionID, r.GetSegmentID())
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		// the segment without any row is dropped at once
+		state := commonpb.SegmentState_Flushing
+		if r.GetNumOfRows() == 0 {
+			state = commonpb.SegmentState_Dropped
+		}
+		segment := NewSegmentInfo(&datapb.SegmentInfo{
+			ID:                  r.GetSegmentID(),
+			CollectionID:        modSegments[0].CollectionID,
+			PartitionID:         modSegments[0].PartitionID,
+			InsertChannel:       modSegments[0].InsertChannel,
+			NumOfRows:           r.GetNumOfRows(),
+			State:               state,
+			MaxRowNum:           modSegments[0].MaxRowNum,
+			Binlogs:             r.GetInsertLogs(),
+			Statslogs:           r.GetField2StatslogPaths(),
+			Deltalogs:           append(r.GetDeltalogs(), copiedDeltalogs...),
+			StartPosition:       startPosition,
+			DmlPosition:         dmlPosition,
+			CreatedByCompaction: true,
+			CompactionFrom:      compactionFrom,
+			ClusteringInfo:      r.GetClusteringInfo(),
+		})
+		metricMutation.addNewSeg(segment.GetState(), segment.GetNumOfRows())
+		newSegments = append(newSegments, segment)
+	}
+	log.Info("meta update: prepare for complete clustering compaction mutation - complete",
+		zap.Int64("collection ID", modSegments[0].GetCollectionID()),
+		zap.Int64("partition ID", modSegments[0].GetPartitionID()),
+		zap.Int64s("new segment IDs", lo.Map(newSegments, func(s *SegmentInfo, _ int) int64 { return s.GetID() })),
+		zap.Int64s("compacted from", compactionFrom))
+
+	return modSegments, newSegments, metricMutation, nil
+}
+
+// prepareCompactedSegments marks the compacted segments as dropped,
+// returns the positions of them and the delta logs added during the compaction.
+// Must be called with the lock held.
+func (m *meta) prepareCompactedSegments(compactionLogs []*datapb.CompactionSegmentBinlogs, metricMutation *segMetricMutation) (
+	oldSegments, modSegments []*SegmentInfo, startPosition, dmlPosition *msgpb.MsgPosition, newAddedDeltalogs []*datapb.FieldBinlog) {
+	oldSegments = make([]*SegmentInfo, 0, len(compactionLogs))
+	modSegments = make([]*SegmentInfo, 0, len(compactionLogs))
+	for _, cl := range compactionLogs {
+		if segment := m.segments.GetSegment(cl.GetSegmentID()); segment != nil {
+			oldSegments = append(oldSegments, segment.Clone())
+
+			cloned := segment.Clone()
+			updateSegStateAndPrepareMetrics(cloned, commonpb.SegmentState_Dropped, metricMutation)
+			cloned.DroppedAt = uint64(time.Now().UnixNano())
+			modSegments = append(modSegments, cloned)
+		}
+	}
+
+	for _, s := range modSegments {
+		if dmlPosition == nil ||
+			s.GetDmlPosition() != nil && s.GetDmlPosition().GetTimestamp() < dmlPosition.GetTimestamp() {
+			dmlPosition = s.GetDmlPosition()
+		}
+
+		if startPosition == nil ||
+			s.GetStartPosition() != nil && s.GetStartPosition().GetTimestamp() < startPosition.GetTimestamp() {
+			startPosition = s.GetStartPosition()
+		}
+	}
+
+	// find new added delta logs when executing compaction
+	var originDeltalogs []*datapb.FieldBinlog
+	for _, s := range modSegments {
+		originDeltalogs = append(originDeltalogs, s.GetDeltalogs()...)
+	}
+
+	var deletedDeltalogs []*datapb.FieldBinlog
+	for _, l := range compactionLogs {
+		deletedDeltalogs = append(deletedDeltalogs, l.GetDeltalogs()...)
+	}
+
+	newAddedDeltalogs = m.updateDeltalogs(originDeltalogs, deletedDeltalogs, nil)
+	return oldSegments, modSegments, startPosition, dmlPosition, newAddedDeltalogs
+}
+
 func (m *meta) copyDeltaFiles(binlogs []*datapb.FieldBinlog, collectionID, partitionID, targetSegmentID int64) ([]*datapb.FieldBinlog, error) {
 	ret := make([]*datapb.FieldBinlog, 0, len(binlogs))
 	for _, fieldBinlog := range binlogs {
@@ -1110,6 +1183,47 @@ func (m *meta) alterMetaStoreAfterCompaction(segmentCompactTo *SegmentInfo, segm
 	return nil
 }
 
+// alterMetaStoreAfterClusteringCompaction saves the segments produced by a clustering compaction,
+// together with the compacted segments marked as dropped.
+func (m *meta) alterMetaStoreAfterClusteringCompaction(segmentsCompactTo []*SegmentInfo, segmentsCompactFrom []*SegmentInfo) error {
+	infos := make([]*datapb.SegmentInfo, 0, len(segmentsCompactTo)+len(segmentsCompactFrom))
+	for _, s := range segmentsCompactTo {
+		infos = append(infos, s.SegmentInfo)
+	}
+	for _, s := range segmentsCompactFrom {
+		infos = append(infos, s.SegmentInfo)
+	}
+
+	log.Info("meta update: alter meta store for clustering compaction updates",
+		zap.Int64s("compact from segments (segments to be updated as dropped)",
+			lo.Map(segmentsCompactFrom, func(s *SegmentInfo, _ int) int64 { return s.GetID() })),
+		zap.Int64s("new segmentIds", lo.Map(segmentsCompactTo, func(s *SegmentInfo, _ int) int64 { return s.GetID() })))
+
+	if err := m.catalog.AlterSegments(m.ctx, infos); err != nil {
+		log.Warn("fail to alter segments and new segments", zap.Error(err))
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	for _, s := range segmentsCompactFrom {
+		m.segments.SetSegment(s.GetID(), s)
+	}
+	for _, s := range segmentsCompactTo {
+		m.segments.SetSegment(s.GetID(), s)
+	}
+	return nil
+}
+
+// GetClusteringSiblings returns the healthy segments produced by the same clustering compaction as the segment.
+func (m *meta) GetClusteringSiblings(segment *SegmentInfo) []*SegmentInfo {
+	m.RLock()
+	defer m.RUnlock()
+	return lo.Filter(m.segments.GetClusteringSiblings(segment), func(s *SegmentInfo, _ int) bool {
+		return s.GetID() != segment.GetID() && isSegmentHealthy(s)
+	})
+}
+
 func (m *meta) updateBinlogs(origin []*datapb.FieldBinlog, removes []*datapb.FieldBinlog, adds []*datapb.FieldBinlog) []*datapb.FieldBinlog {
 	fieldBinlogs := make(map[int64]map[string]*datapb.Binlog)
 	for _, f := range origin {
@@ -546,7 +546,7 @@ func TestMeta_alterMetaStore(t *testing.T) {
 
 	m := &meta{
 		catalog: &datacoord.Catalog{MetaKv: NewMetaMemoryKV()},
-		segments: &SegmentsInfo{map[int64]*SegmentInfo{
+		segments: &SegmentsInfo{segments: map[int64]*SegmentInfo{
 			1: {SegmentInfo: &datapb.SegmentInfo{
 				ID:        1,
 				Binlogs:   []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log1", "log2")},
@@ -564,7 +564,7 @@ func TestMeta_alterMetaStore(t *testing.T) {
 
 func TestMeta_PrepareCompleteCompactionMutation(t *testing.T) {
 	prepareSegments := &SegmentsInfo{
-		map[UniqueID]*SegmentInfo{
+		segments: map[UniqueID]*SegmentInfo{
 			1: {SegmentInfo: &datapb.SegmentInfo{
 				ID:           1,
 				CollectionID: 100,
@@ -648,6 +648,56 @@ func TestMeta_PrepareCompleteCompactionMutation(t *testing.T) {
 	assert.NotZero(t, newSegment.lastFlushTime)
 }
 
+func TestMeta_CompleteClusteringCompaction(t *testing.T) {
+	m := &meta{
+		catalog:  &datacoord.Catalog{MetaKv: NewMetaMemoryKV()},
+		segments: NewSegmentsInfo(),
+	}
+	for _, id := range []UniqueID{1, 2} {
+		m.segments.SetSegment(id, NewSegmentInfo(&datapb.SegmentInfo{
+			ID:           id,
+			CollectionID: 100,
+			PartitionID:  10,
+			State:        commonpb.SegmentState_Flushed,
+			NumOfRows:    2,
+		}))
+	}
+
+	compactionLogs := []*datapb.CompactionSegmentBinlogs{{SegmentID: 1}, {SegmentID: 2}}
+	result := &datapb.CompactionResult{
+		SegmentID: 3,
+		ClusteringSegments: []*datapb.CompactionResult{
+			{SegmentID: 3, NumOfRows: 3, ClusteringInfo: &datapb.ClusteringInfo{FieldID: 101}},
+			{SegmentID: 4, NumOfRows: 0, ClusteringInfo: &datapb.ClusteringInfo{FieldID: 101}},
+			{SegmentID: 5, NumOfRows: 1, ClusteringInfo: &datapb.ClusteringInfo{FieldID: 101}},
+		},
+	}
+	modSegments, newSegments, metricMutation, err := m.PrepareCompleteClusteringCompactionMutation(compactionLogs, result)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(modSegments))
+	require.Equal(t, 3, len(newSegments))
+
+	// the segment without any row is dropped at once
+	assert.Equal(t, commonpb.SegmentState_Flushing, newSegments[0].GetState())
+	assert.Equal(t, commonpb.SegmentState_Dropped, newSegments[1].GetState())
+	assert.Equal(t, commonpb.SegmentState_Flushing, newSegments[2].GetState())
+	assert.Equal(t, -2, metricMutation.stateChange[commonpb.SegmentState_Flushed.String()])
+	assert.Equal(t, 3, metricMutation.stateChange[commonpb.SegmentState_Dropped.String()])
+	assert.Equal(t, 2, metricMutation.stateChange[commonpb.SegmentState_Flushing.String()])
+	assert.Equal(t, int64(0), metricMutation.rowCountChange)
+
+	err = m.alterMetaStoreAfterClusteringCompaction(newSegments, modSegments)
+	require.NoError(t, err)
+
+	siblings := m.GetClusteringSiblings(m.GetSegment(3))
+	require.Equal(t, 1, len(siblings))
+	assert.Equal(t, UniqueID(5), siblings[0].GetID())
+	assert.Empty(t, m.GetClusteringSiblings(m.GetSegment(1)))
+
+	m.segments.DropSegment(5)
+	assert.Empty(t, m.GetClusteringSiblings(m.GetSegment(3)))
+}
+
 func Test_meta_SetSegmentCompacting(t *testing.T) {
 	type fields struct {
 		client   kv.MetaKv
@@ -667,7 +717,7 @@ func Test_meta_SetSegmentCompacting(t *testing.T) {
 			fields{
 				NewMetaMemoryKV(),
 				&SegmentsInfo{
-					map[int64]*SegmentInfo{
+					segments: map[int64]*SegmentInfo{
 						1: {
 							SegmentInfo: &datapb.SegmentInfo{
 								ID:    1,
@@ -716,7 +766,7 @@ func Test_meta_SetSegmentImporting(t *testing.T) {
 			fields{
 				NewMetaMemoryKV(),
 				&SegmentsInfo{
-					map[int64]*SegmentInfo{
+					segments: map[int64]*SegmentInfo{
 						1: {
 							SegmentInfo: &datapb.SegmentInfo{
 								ID:          1,
@@ -763,7 +813,7 @@ func Test_meta_GetSegmentsOfCollection(t *testing.T) {
 			"test get segments",
 			fields{
 				&SegmentsInfo{
-					map[int64]*SegmentInfo{
+					segments: map[int64]*SegmentInfo{
 						1: {
 							SegmentInfo: &datapb.SegmentInfo{
 								ID:           1,
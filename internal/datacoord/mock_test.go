@@ -23,7 +23,6 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
-	clientv3 "go.etcd.io/etcd/client/v3"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
@@ -77,19 +76,23 @@ func (mm *metaMemoryKV) LoadWithRevision(key string) ([]string, []string, int64,
 	panic("implement me")
 }
 
-func (mm *metaMemoryKV) Watch(key string) clientv3.WatchChan {
+func (mm *metaMemoryKV) Watch(key string) kv.WatchChan {
 	panic("implement me")
 }
 
-func (mm *metaMemoryKV) WatchWithPrefix(key string) clientv3.WatchChan {
+func (mm *metaMemoryKV) WatchWithPrefix(key string) kv.WatchChan {
 	panic("implement me")
 }
 
-func (mm *metaMemoryKV) WatchWithRevision(key string, revision int64) clientv3.WatchChan {
+func (mm *metaMemoryKV) WatchWithRevision(key string, revision int64) kv.WatchChan {
 	panic("implement me")
 }
 
-func (mm *metaMemoryKV) SaveWithLease(key, value string, id clientv3.LeaseID) error {
+func (mm *metaMemoryKV) WatchWithOptions(ctx context.Context, key string, opts ...kv.WatchOption) kv.WatchChan {
+	panic("implement me")
+}
+
+func (mm *metaMemoryKV) SaveWithLease(key, value string, id kv.LeaseID) error {
 	panic("implement me")
 }
 
@@ -97,19 +100,23 @@ func (mm *metaMemoryKV) SaveWithIgnoreLease(key, value string) error {
 	panic("implement me")
 }
 
-func (mm *metaMemoryKV) Grant(ttl int64) (id clientv3.LeaseID, err error) {
+func (mm *metaMemoryKV) Grant(ttl int64) (id kv.LeaseID, err error) {
+	panic("implement me")
+}
+
+func (mm *metaMemoryKV) KeepAlive(ctx context.Context, id kv.LeaseID) (<-chan *kv.LeaseKeepAliveResponse, error) {
 	panic("implement me")
 }
 
-func (mm *metaMemoryKV) KeepAlive(id clientv3.LeaseID) (<-chan *clientv3.LeaseKeepAliveResponse, error) {
+func (mm *metaMemoryKV) Revoke(id kv.LeaseID) error {
 	panic("implement me")
 }
 
-func (mm *metaMemoryKV) CompareValueAndSwap(key, value, target string, opts ...clientv3.OpOption) (bool, error) {
+func (mm *metaMemoryKV) CompareValueAndSwap(key, value, target string, opts ...kv.PutOption) (bool, error) {
 	panic("implement me")
 }
 
-func (mm *metaMemoryKV) CompareVersionAndSwap(key string, version int64, target string, opts ...clientv3.OpOption) (bool, error) {
+func (mm *metaMemoryKV) CompareVersionAndSwap(key string, version int64, target string, opts ...kv.PutOption) (bool, error) {
 	panic("implement me")
 }
 
@@ -624,3 +624,214 @@ func formatNodeIDsWithFilter(ids []int64, filter int64) []string {
 	}
 	return formatted
 }
+
+// channelWeights returns the relative loads of channels, the throughput and buffer size contribute equally.
+// The average weight of the channels with load reported is 1, the channels without load reported weigh 1 as well,
+// so that the weights degrade to the channel number if no load is reported.
+func channelWeights(loads map[string]channelLoad, channels []*channel) map[string]float64 {
+	var (
+		reported        int
+		totalThroughput float64
+		totalBufferSize float64
+	)
+	for _, ch := range channels {
+		if load, ok := loads[ch.Name]; ok {
+			reported++
+			totalThroughput += load.insertThroughput
+			totalBufferSize += float64(load.bufferSize)
+		}
+	}
+
+	weights := make(map[string]float64, len(channels))
+	for _, ch := range channels {
+		load, ok := loads[ch.Name]
+		if !ok || (totalThroughput == 0 && totalBufferSize == 0) {
+			weights[ch.Name] = 1
+			continue
+		}
+		var share float64
+		parts := 0
+		if totalThroughput > 0 {
+			share += load.insertThroughput / totalThroughput
+			parts++
+		}
+		if totalBufferSize > 0 {
+			share += float64(load.bufferSize) / totalBufferSize
+			parts++
+		}
+		weights[ch.Name] = share / float64(parts) * float64(reported)
+	}
+	return weights
+}
+
+// nodeLoad is the sum of the channel weights of a node.
+type nodeLoad struct {
+	nodeID       int64
+	load         float64
+	channelCount int
+}
+
+// getNodeLoads returns the loads of nodes and the weights of the channels of nodes and the extra channels.
+func getNodeLoads(loads channelLoadGetter, nodes []*NodeChannelInfo, extra []*channel) ([]*nodeLoad, map[string]float64) {
+	channels := make([]*channel, 0)
+	for _, node := range nodes {
+		channels = append(channels, node.Channels...)
+	}
+	weights := channelWeights(loads.GetChannelLoads(), append(channels, extra...))
+
+	nodeLoads := make([]*nodeLoad, 0, len(nodes))
+	for _, node := range nodes {
+		nl := &nodeLoad{nodeID: node.NodeID, channelCount: len(node.Channels)}
+		for _, ch := range node.Channels {
+			nl.load += weights[ch.Name]
+		}
+		nodeLoads = append(nodeLoads, nl)
+	}
+	return nodeLoads, weights
+}
+
+// leastLoadedNode returns the node with least load not excluded, nil if no node available.
+func leastLoadedNode(nodeLoads []*nodeLoad, exclude map[int64]struct{}) *nodeLoad {
+	var least *nodeLoad
+	for _, nl := range nodeLoads {
+		if _, ok := exclude[nl.nodeID]; ok {
+			continue
+		}
+		if least == nil || nl.load < least.load ||
+			(nl.load == least.load && (nl.channelCount < least.channelCount ||
+				(nl.channelCount == least.channelCount && nl.nodeID < least.nodeID))) {
+			least = nl
+		}
+	}
+	return least
+}
+
+// LoadAwareAssignPolicy assigns each channel to the node with least load,
+// the load of a node is the sum of the relative loads of its channels.
+func LoadAwareAssignPolicy(loads channelLoadGetter) ChannelAssignPolicy {
+	return func(store ROChannelStore, channels []*channel) ChannelOpSet {
+		newChannels := filterChannels(store, channels)
+		if len(newChannels) == 0 {
+			return nil
+		}
+
+		opSet := ChannelOpSet{}
+		allDataNodes := store.GetNodesChannels()
+		// If no datanode alive, save channels in buffer
+		if len(allDataNodes) == 0 {
+			opSet.Add(bufferID, channels)
+			return opSet
+		}
+
+		nodeLoads, weights := getNodeLoads(loads, allDataNodes, newChannels)
+		updates := make(map[int64][]*channel)
+		for _, ch := range newChannels {
+			target := leastLoadedNode(nodeLoads, nil)
+			target.load += weights[ch.Name]
+			target.channelCount++
+			updates[target.nodeID] = append(updates[target.nodeID], ch)
+		}
+		for id, chs := range updates {
+			opSet.Add(id, chs)
+		}
+		return opSet
+	}
+}
+
+// LoadAwareReassignPolicy reassigns each channel to the node with least load except the original nodes.
+func LoadAwareReassignPolicy(loads channelLoadGetter) ChannelReassignPolicy {
+	return func(store ROChannelStore, reassigns []*NodeChannelInfo) ChannelOpSet {
+		exclude := make(map[int64]struct{})
+		reassignChannels := make([]*channel, 0)
+		for _, reassign := range reassigns {
+			exclude[reassign.NodeID] = struct{}{}
+			reassignChannels = append(reassignChannels, reassign.Channels...)
+		}
+		nodeLoads, weights := getNodeLoads(loads, store.GetNodesChannels(), reassignChannels)
+
+		ret := ChannelOpSet{}
+		if leastLoadedNode(nodeLoads, exclude) == nil {
+			// if no node is left, do not reassign
+			log.Warn("there is no available nodes when reassigning, return")
+			return ret
+		}
+		updates := make(map[int64][]*channel)
+		for _, reassign := range reassigns {
+			ret.Delete(reassign.NodeID, reassign.Channels)
+			for _, ch := range reassign.Channels {
+				target := leastLoadedNode(nodeLoads, exclude)
+				target.load += weights[ch.Name]
+				target.channelCount++
+				updates[target.nodeID] = append(updates[target.nodeID], ch)
+			}
+		}
+		for id, chs := range updates {
+			ret.Add(id, chs)
+		}
+		return ret
+	}
+}
+
+// LoadAwareBalanceChannelPolicy releases a channel from the most loaded node
+// if its load exceeds the average by dataCoord.channel.loadAware.imbalanceRatio.
+// The channel moved won't be moved again within dataCoord.channel.loadAware.balanceCooldown to avoid churn.
+func LoadAwareBalanceChannelPolicy(loads channelLoadGetter) BalanceChannelPolicy {
+	lastMoved := make(map[string]time.Time)
+	return func(store ROChannelStore, ts time.Time) ChannelOpSet {
+		cooldown := Params.DataCoordCfg.ChannelLoadBalanceCooldown.GetAsDuration(time.Second)
+		for name, movedAt := range lastMoved {
+			if ts.Sub(movedAt) >= cooldown {
+				delete(lastMoved, name)
+			}
+		}
+
+		nodes := store.GetNodesChannels()
+		if len(nodes) < 2 {
+			return nil
+		}
+		nodeLoads, weights := getNodeLoads(loads, nodes, nil)
+		var (
+			total float64
+			most  int
+		)
+		for i, nl := range nodeLoads {
+			total += nl.load
+			if nl.load > nodeLoads[most].load {
+				most = i
+			}
+		}
+		avg := total / float64(len(nodeLoads))
+		source, target := nodeLoads[most], leastLoadedNode(nodeLoads, nil)
+		if source.load <= avg*Params.DataCoordCfg.ChannelLoadImbalanceRatio.GetAsFloat() || source.channelCount < 2 {
+			return nil
+		}
+
+		// move the heaviest channel which makes the pair more balanced
+		var toMove *channel
+		for _, ch := range nodes[most].Channels {
+			if _, ok := lastMoved[ch.Name]; ok {
+				continue
+			}
+			w := weights[ch.Name]
+			if target.load+w >= source.load {
+				continue
+			}
+			if toMove == nil || w > weights[toMove.Name] {
+				toMove = ch
+			}
+		}
+		if toMove == nil {
+			return nil
+		}
+		lastMoved[toMove.Name] = ts
+		log.Info("channel load balancer releases channel from the most loaded node",
+			zap.Int64("nodeID", source.nodeID), zap.String("channel", toMove.Name),
+			zap.Float64("nodeLoad", source.load), zap.Float64("averageLoad", avg),
+			zap.Float64("channelLoad", weights[toMove.Name]))
+		return ChannelOpSet{{
+			Type:     Add,
+			NodeID:   source.nodeID,
+			Channels: []*channel{toMove},
+		}}
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"stathat.com/c/consistent"
 
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
 func fillEmptyPosition(operations ChannelOpSet) {
@@ -743,3 +744,144 @@ func TestAvgAssignRegisterPolicy(t *testing.T) {
 		})
 	}
 }
+
+type mockChannelLoads map[string]channelLoad
+
+func (m mockChannelLoads) GetChannelLoads() map[string]channelLoad {
+	return m
+}
+
+func TestChannelWeights(t *testing.T) {
+	channels := []*channel{{Name: "chan1"}, {Name: "chan2"}, {Name: "chan3"}}
+
+	// no load reported, weigh by channel number
+	weights := channelWeights(mockChannelLoads{}, channels)
+	assert.Equal(t, map[string]float64{"chan1": 1, "chan2": 1, "chan3": 1}, weights)
+
+	weights = channelWeights(mockChannelLoads{
+		"chan1": {insertThroughput: 300, bufferSize: 100},
+		"chan2": {insertThroughput: 100, bufferSize: 300},
+	}, channels)
+	assert.InDelta(t, 1, weights["chan1"], 1e-9)
+	assert.InDelta(t, 1, weights["chan2"], 1e-9)
+	assert.InDelta(t, 1, weights["chan3"], 1e-9)
+
+	weights = channelWeights(mockChannelLoads{
+		"chan1": {insertThroughput: 900},
+		"chan2": {insertThroughput: 100},
+	}, channels)
+	assert.InDelta(t, 1.8, weights["chan1"], 1e-9)
+	assert.InDelta(t, 0.2, weights["chan2"], 1e-9)
+	assert.InDelta(t, 1, weights["chan3"], 1e-9)
+}
+
+func TestLoadAwareAssignPolicy(t *testing.T) {
+	loads := mockChannelLoads{
+		"chan1": {insertThroughput: 1000},
+		"chan2": {insertThroughput: 10},
+		"chan3": {insertThroughput: 10},
+	}
+	policy := LoadAwareAssignPolicy(loads)
+
+	// no node, buffer the channels
+	store := &ChannelStore{memkv.NewMemoryKV(), map[int64]*NodeChannelInfo{}}
+	ops := policy(store, []*channel{{Name: "chan4", CollectionID: 1}})
+	assert.EqualValues(t, []*ChannelOp{{Add, bufferID, []*channel{{Name: "chan4", CollectionID: 1}}, nil}}, ops)
+
+	// the node with fewer but heavier channels is avoided
+	store = &ChannelStore{
+		memkv.NewMemoryKV(),
+		map[int64]*NodeChannelInfo{
+			1: {1, []*channel{{Name: "chan1", CollectionID: 1}}},
+			2: {2, []*channel{{Name: "chan2", CollectionID: 1}, {Name: "chan3", CollectionID: 1}}},
+		},
+	}
+	ops = policy(store, []*channel{{Name: "chan4", CollectionID: 1}, {Name: "chan5", CollectionID: 1}})
+	assert.EqualValues(t, []*ChannelOp{{Add, 2, []*channel{{Name: "chan4", CollectionID: 1}, {Name: "chan5", CollectionID: 1}}, nil}}, ops)
+
+	// the channel assigned is filtered
+	assert.Nil(t, policy(store, []*channel{{Name: "chan1", CollectionID: 1}}))
+}
+
+func TestLoadAwareReassignPolicy(t *testing.T) {
+	loads := mockChannelLoads{
+		"chan1": {insertThroughput: 1000},
+		"chan2": {insertThroughput: 10},
+		"chan3": {insertThroughput: 10},
+		"chan4": {insertThroughput: 10},
+	}
+	policy := LoadAwareReassignPolicy(loads)
+	store := &ChannelStore{
+		memkv.NewMemoryKV(),
+		map[int64]*NodeChannelInfo{
+			1: {1, []*channel{{Name: "chan1", CollectionID: 1}}},
+			2: {2, []*channel{{Name: "chan2", CollectionID: 1}, {Name: "chan3", CollectionID: 1}}},
+			3: {3, []*channel{{Name: "chan4", CollectionID: 1}}},
+		},
+	}
+	reassigns := []*NodeChannelInfo{{3, []*channel{{Name: "chan4", CollectionID: 1}}}}
+	ops := policy(store, reassigns)
+	assert.EqualValues(t, []*ChannelOp{
+		{Delete, 3, []*channel{{Name: "chan4", CollectionID: 1}}, nil},
+		{Add, 2, []*channel{{Name: "chan4", CollectionID: 1}}, nil},
+	}, ops)
+
+	// no node left
+	store = &ChannelStore{
+		memkv.NewMemoryKV(),
+		map[int64]*NodeChannelInfo{3: {3, []*channel{{Name: "chan4", CollectionID: 1}}}},
+	}
+	assert.Empty(t, policy(store, reassigns))
+}
+
+func TestLoadAwareBalanceChannelPolicy(t *testing.T) {
+	paramtable.Get().Save(Params.DataCoordCfg.ChannelLoadBalanceCooldown.Key, "60")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.ChannelLoadBalanceCooldown.Key)
+
+	loads := mockChannelLoads{
+		"chan1": {insertThroughput: 1000},
+		"chan2": {insertThroughput: 800},
+		"chan3": {insertThroughput: 10},
+		"chan4": {insertThroughput: 10},
+	}
+	store := &ChannelStore{
+		memkv.NewMemoryKV(),
+		map[int64]*NodeChannelInfo{
+			1: {1, []*channel{{Name: "chan1", CollectionID: 1}, {Name: "chan2", CollectionID: 1}}},
+			2: {2, []*channel{{Name: "chan3", CollectionID: 1}, {Name: "chan4", CollectionID: 1}}},
+		},
+	}
+	policy := LoadAwareBalanceChannelPolicy(loads)
+	now := time.Now()
+	// release the heaviest channel
+	ops := policy(store, now)
+	assert.EqualValues(t, []*ChannelOp{{Add, 1, []*channel{{Name: "chan1", CollectionID: 1}}, nil}}, ops)
+
+	// chan1 is cooling down
+	ops = policy(store, now.Add(time.Second))
+	assert.EqualValues(t, []*ChannelOp{{Add, 1, []*channel{{Name: "chan2", CollectionID: 1}}, nil}}, ops)
+	assert.Nil(t, policy(store, now.Add(2*time.Second)))
+
+	// chan1 cooled down
+	ops = policy(store, now.Add(time.Minute))
+	assert.EqualValues(t, []*ChannelOp{{Add, 1, []*channel{{Name: "chan1", CollectionID: 1}}, nil}}, ops)
+
+	// balanced enough
+	store = &ChannelStore{
+		memkv.NewMemoryKV(),
+		map[int64]*NodeChannelInfo{
+			1: {1, []*channel{{Name: "chan1", CollectionID: 1}, {Name: "chan3", CollectionID: 1}}},
+			2: {2, []*channel{{Name: "chan2", CollectionID: 1}, {Name: "chan4", CollectionID: 1}}},
+		},
+	}
+	assert.Nil(t, LoadAwareBalanceChannelPolicy(loads)(store, now))
+
+	// only one node
+	store = &ChannelStore{
+		memkv.NewMemoryKV(),
+		map[int64]*NodeChannelInfo{
+			1: {1, []*channel{{Name: "chan1", CollectionID: 1}, {Name: "chan2", CollectionID: 1}}},
+		},
+	}
+	assert.Nil(t, LoadAwareBalanceChannelPolicy(loads)(store, now))
+}
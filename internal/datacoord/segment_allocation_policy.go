@@ -25,28 +25,22 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 type calUpperLimitPolicy func(schema *schemapb.CollectionSchema) (int, error)
 
 func calBySchemaPolicy(schema *schemapb.CollectionSchema) (int, error) {
-	if schema == nil {
-		return -1, errors.New("nil schema")
-	}
-	sizePerRecord, err := typeutil.EstimateSizePerRecord(schema)
-	if err != nil {
-		return -1, err
-	}
-	// check zero value, preventing panicking
-	if sizePerRecord == 0 {
-		return -1, errors.New("zero size record schema found")
-	}
-	threshold := Params.DataCoordCfg.SegmentMaxSize.GetAsFloat() * 1024 * 1024
-	return int(threshold / float64(sizePerRecord)), nil
+	return calBySchemaAndSize(schema, Params.DataCoordCfg.SegmentMaxSize.GetAsFloat())
 }
 
 func calBySchemaPolicyWithDiskIndex(schema *schemapb.CollectionSchema) (int, error) {
+	return calBySchemaAndSize(schema, Params.DataCoordCfg.DiskSegmentMaxSize.GetAsFloat())
+}
+
+// calBySchemaAndSize estimates the max number of rows of a segment of maxSize MB.
+func calBySchemaAndSize(schema *schemapb.CollectionSchema, maxSize float64) (int, error) {
 	if schema == nil {
 		return -1, errors.New("nil schema")
 	}
@@ -58,10 +52,19 @@ func calBySchemaPolicyWithDiskIndex(schema *schemapb.CollectionSchema) (int, err
 	if sizePerRecord == 0 {
 		return -1, errors.New("zero size record schema found")
 	}
-	threshold := Params.DataCoordCfg.DiskSegmentMaxSize.GetAsFloat() * 1024 * 1024
+	threshold := maxSize * 1024 * 1024
 	return int(threshold / float64(sizePerRecord)), nil
 }
 
+// calByCollectionPolicy estimates the max number of rows of a segment of the collection,
+// the segment max size specified by collection properties takes precedence over the policy.
+func calByCollectionPolicy(collection *collectionInfo, policy calUpperLimitPolicy) (int, error) {
+	if maxSize, ok := getCollectionSegmentMaxSize(collection.Properties); ok {
+		return calBySchemaAndSize(collection.Schema, maxSize)
+	}
+	return policy(collection.Schema)
+}
+
 // AllocatePolicy helper function definition to allocate Segment space
 type AllocatePolicy func(segments []*SegmentInfo, count int64,
 	maxCountPerSegment int64) ([]*Allocation, []*Allocation)
@@ -103,12 +106,13 @@ func AllocatePolicyV1(segments []*SegmentInfo, count int64,
 	return newSegmentAllocations, existedSegmentAllocations
 }
 
-// segmentSealPolicy seal policy applies to segment
-type segmentSealPolicy func(segment *SegmentInfo, ts Timestamp) bool
+// segmentSealPolicy seal policy applies to segment,
+// collection is the collection of segment, whose properties may override the params of policy, could be nil
+type segmentSealPolicy func(collection *collectionInfo, segment *SegmentInfo, ts Timestamp) bool
 
 // getSegmentCapacityPolicy get segmentSealPolicy with segment size factor policy
 func getSegmentCapacityPolicy(sizeFactor float64) segmentSealPolicy {
-	return func(segment *SegmentInfo, ts Timestamp) bool {
+	return func(collection *collectionInfo, segment *SegmentInfo, ts Timestamp) bool {
 		var allocSize int64
 		for _, allocation := range segment.allocations {
 			allocSize += allocation.NumOfRows
@@ -119,17 +123,17 @@ func getSegmentCapacityPolicy(sizeFactor float64) segmentSealPolicy {
 
 // sealByMaxBinlogSizePolicy get segmentSealPolicy with lifetime limit compares ts - segment.lastExpireTime
 func sealByLifetimePolicy(lifetime time.Duration) segmentSealPolicy {
-	return func(segment *SegmentInfo, ts Timestamp) bool {
+	return func(collection *collectionInfo, segment *SegmentInfo, ts Timestamp) bool {
 		pts, _ := tsoutil.ParseTS(ts)
 		epts, _ := tsoutil.ParseTS(segment.GetLastExpireTime())
 		d := pts.Sub(epts)
-		return d >= lifetime
+		return d >= getCollectionDuration(collection.GetProperties(), common.CollectionSegmentMaxLifetimeKey, lifetime)
 	}
 }
 
 // sealByMaxBinlogSizePolicy seal segment if binlog file number of segment exceed configured max number
 func sealByMaxBinlogFileNumberPolicy(maxBinlogFileNumber int) segmentSealPolicy {
-	return func(segment *SegmentInfo, ts Timestamp) bool {
+	return func(collection *collectionInfo, segment *SegmentInfo, ts Timestamp) bool {
 		logFileCounter := 0
 		for _, fieldBinlog := range segment.GetStatslogs() {
 			logFileCounter += len(fieldBinlog.GetBinlogs())
@@ -148,9 +152,14 @@ func sealByMaxBinlogFileNumberPolicy(maxBinlogFileNumber int) segmentSealPolicy
 // Q: Why we don't decrease the expiry time directly?
 // A: We don't want to influence segments which are accepting `frequent small` batch entities.
 func sealLongTimeIdlePolicy(idleTimeTolerance time.Duration, minSizeToSealIdleSegment float64, maxSizeOfSegment float64) segmentSealPolicy {
-	return func(segment *SegmentInfo, ts Timestamp) bool {
-		limit := (minSizeToSealIdleSegment / maxSizeOfSegment) * float64(segment.GetMaxRowNum())
-		return time.Since(segment.lastWrittenTime) > idleTimeTolerance &&
+	return func(collection *collectionInfo, segment *SegmentInfo, ts Timestamp) bool {
+		properties := collection.GetProperties()
+		maxSize, ok := getCollectionSegmentMaxSize(properties)
+		if !ok {
+			maxSize = maxSizeOfSegment
+		}
+		limit := (minSizeToSealIdleSegment / maxSize) * float64(segment.GetMaxRowNum())
+		return time.Since(segment.lastWrittenTime) > getCollectionDuration(properties, common.CollectionSegmentMaxIdleTimeKey, idleTimeTolerance) &&
 			float64(segment.currRows) > limit
 	}
 }
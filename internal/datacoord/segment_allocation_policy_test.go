@@ -27,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/common"
 )
 
 func TestUpperLimitCalBySchema(t *testing.T) {
@@ -93,6 +94,31 @@ func TestUpperLimitCalBySchema(t *testing.T) {
 	}
 }
 
+func TestUpperLimitCalByCollection(t *testing.T) {
+	collection := &collectionInfo{
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{DataType: schemapb.DataType_Int64},
+				{DataType: schemapb.DataType_Int32},
+			},
+		},
+	}
+	result, err := calByCollectionPolicy(collection, calBySchemaPolicy)
+	assert.NoError(t, err)
+	assert.Equal(t, int(Params.DataCoordCfg.SegmentMaxSize.GetAsFloat()*1024*1024/float64(12)), result)
+
+	collection.Properties = map[string]string{common.CollectionSegmentMaxSizeKey: "12"}
+	result, err = calByCollectionPolicy(collection, calBySchemaPolicy)
+	assert.NoError(t, err)
+	assert.Equal(t, 1024*1024, result)
+
+	// invalid size is ignored
+	collection.Properties = map[string]string{common.CollectionSegmentMaxSizeKey: "-1"}
+	result, err = calByCollectionPolicy(collection, calBySchemaPolicyWithDiskIndex)
+	assert.NoError(t, err)
+	assert.Equal(t, int(Params.DataCoordCfg.DiskSegmentMaxSize.GetAsFloat()*1024*1024/float64(12)), result)
+}
+
 func TestGetChannelOpenSegCapacityPolicy(t *testing.T) {
 	p := getChannelOpenSegCapacityPolicy(3)
 	type testCase struct {
@@ -171,12 +197,31 @@ func TestSealSegmentPolicy(t *testing.T) {
 			},
 		}
 
-		shouldSeal := p(segment, tsoutil.ComposeTS(nosealTs, 0))
+		shouldSeal := p(nil, segment, tsoutil.ComposeTS(nosealTs, 0))
 		assert.False(t, shouldSeal)
 
-		shouldSeal = p(segment, tsoutil.ComposeTS(sealTs, 0))
+		shouldSeal = p(nil, segment, tsoutil.ComposeTS(sealTs, 0))
 		assert.True(t, shouldSeal)
 	})
+
+	t.Run("test seal segment by lifetime of collection", func(t *testing.T) {
+		now := time.Now()
+		curTS := now.UnixNano() / int64(time.Millisecond)
+		ts := (now.Add(2 * time.Second)).UnixNano() / int64(time.Millisecond)
+
+		p := sealByLifetimePolicy(time.Hour)
+		segment := &SegmentInfo{
+			SegmentInfo: &datapb.SegmentInfo{
+				ID:             1,
+				LastExpireTime: tsoutil.ComposeTS(curTS, 0),
+			},
+		}
+		collection := &collectionInfo{
+			Properties: map[string]string{common.CollectionSegmentMaxLifetimeKey: "1"},
+		}
+		assert.False(t, p(nil, segment, tsoutil.ComposeTS(ts, 0)))
+		assert.True(t, p(collection, segment, tsoutil.ComposeTS(ts, 0)))
+	})
 }
 
 func Test_sealLongTimeIdlePolicy(t *testing.T) {
@@ -185,9 +230,21 @@ func Test_sealLongTimeIdlePolicy(t *testing.T) {
 	maxSizeOfSegment := 512.0
 	policy := sealLongTimeIdlePolicy(idleTimeTolerance, minSizeToSealIdleSegment, maxSizeOfSegment)
 	seg1 := &SegmentInfo{lastWrittenTime: time.Now().Add(idleTimeTolerance * 5)}
-	assert.False(t, policy(seg1, 100))
+	assert.False(t, policy(nil, seg1, 100))
 	seg2 := &SegmentInfo{lastWrittenTime: getZeroTime(), currRows: 1, SegmentInfo: &datapb.SegmentInfo{MaxRowNum: 10000}}
-	assert.False(t, policy(seg2, 100))
+	assert.False(t, policy(nil, seg2, 100))
 	seg3 := &SegmentInfo{lastWrittenTime: getZeroTime(), currRows: 1000, SegmentInfo: &datapb.SegmentInfo{MaxRowNum: 10000}}
-	assert.True(t, policy(seg3, 100))
+	assert.True(t, policy(nil, seg3, 100))
+
+	// the collection tolerates idle longer, with smaller segment
+	collection := &collectionInfo{
+		Properties: map[string]string{
+			common.CollectionSegmentMaxIdleTimeKey: "3600",
+		},
+	}
+	seg4 := &SegmentInfo{lastWrittenTime: time.Now().Add(-idleTimeTolerance * 5), currRows: 1000, SegmentInfo: &datapb.SegmentInfo{MaxRowNum: 10000}}
+	assert.True(t, policy(nil, seg4, 100))
+	assert.False(t, policy(collection, seg4, 100))
+	collection.Properties = map[string]string{common.CollectionSegmentMaxSizeKey: "32"}
+	assert.False(t, policy(collection, seg4, 100))
 }
@@ -27,11 +27,15 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 // SegmentsInfo wraps a map, which maintains ID to SegmentInfo relation
 type SegmentsInfo struct {
 	segments map[UniqueID]*SegmentInfo
+	// clusteringSiblings indexes the segments produced by the same clustering compaction,
+	// by the first segment they are compacted from
+	clusteringSiblings map[UniqueID]typeutil.UniqueSet
 }
 
 // SegmentInfo wraps datapb.SegmentInfo and patches some extra info on it
@@ -66,7 +70,10 @@ func NewSegmentInfo(info *datapb.SegmentInfo) *SegmentInfo {
 // NewSegmentsInfo creates a `SegmentsInfo` instance, which makes sure internal map is initialized
 // note that no mutex is wrapped so external concurrent control is needed
 func NewSegmentsInfo() *SegmentsInfo {
-	return &SegmentsInfo{segments: make(map[UniqueID]*SegmentInfo)}
+	return &SegmentsInfo{
+		segments:           make(map[UniqueID]*SegmentInfo),
+		clusteringSiblings: make(map[UniqueID]typeutil.UniqueSet),
+	}
 }
 
 // GetSegment returns SegmentInfo
@@ -88,15 +95,68 @@ func (s *SegmentsInfo) GetSegments() []*SegmentInfo {
 	return segments
 }
 
+// GetClusteringSiblings returns the segments produced by the same clustering compaction as the segment,
+// including itself.
+func (s *SegmentsInfo) GetClusteringSiblings(segment *SegmentInfo) []*SegmentInfo {
+	group, ok := getClusteringGroup(segment)
+	if !ok {
+		return nil
+	}
+	siblings := make([]*SegmentInfo, 0, s.clusteringSiblings[group].Len())
+	for id := range s.clusteringSiblings[group] {
+		if sibling, ok := s.segments[id]; ok {
+			siblings = append(siblings, sibling)
+		}
+	}
+	return siblings
+}
+
 // DropSegment deletes provided segmentID
 // no extra method is taken when segmentID not exists
 func (s *SegmentsInfo) DropSegment(segmentID UniqueID) {
+	if segment, ok := s.segments[segmentID]; ok {
+		s.removeClusteringSibling(segment)
+	}
 	delete(s.segments, segmentID)
 }
 
 // SetSegment sets SegmentInfo with segmentID, perform overwrite if already exists
 func (s *SegmentsInfo) SetSegment(segmentID UniqueID, segment *SegmentInfo) {
+	if old, ok := s.segments[segmentID]; ok {
+		s.removeClusteringSibling(old)
+	}
 	s.segments[segmentID] = segment
+	if group, ok := getClusteringGroup(segment); ok {
+		if s.clusteringSiblings == nil {
+			s.clusteringSiblings = make(map[UniqueID]typeutil.UniqueSet)
+		}
+		if _, ok := s.clusteringSiblings[group]; !ok {
+			s.clusteringSiblings[group] = typeutil.NewUniqueSet()
+		}
+		s.clusteringSiblings[group].Insert(segment.GetID())
+	}
+}
+
+func (s *SegmentsInfo) removeClusteringSibling(segment *SegmentInfo) {
+	group, ok := getClusteringGroup(segment)
+	if !ok {
+		return
+	}
+	if siblings, ok := s.clusteringSiblings[group]; ok {
+		siblings.Remove(segment.GetID())
+		if siblings.Len() == 0 {
+			delete(s.clusteringSiblings, group)
+		}
+	}
+}
+
+// getClusteringGroup returns the first segment which the segment produced by clustering compaction is compacted from,
+// the segments produced by the same compaction share it.
+func getClusteringGroup(segment *SegmentInfo) (UniqueID, bool) {
+	if segment.GetClusteringInfo() == nil || len(segment.GetCompactionFrom()) == 0 {
+		return 0, false
+	}
+	return segment.GetCompactionFrom()[0], true
 }
 
 // SetSegmentIndex sets SegmentIndex with segmentID, perform overwrite if already exists
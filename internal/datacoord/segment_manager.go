@@ -396,7 +396,7 @@ func (s *SegmentManager) estimateMaxNumOfRows(collectionID UniqueID) (int, error
 	if collMeta == nil {
 		return -1, fmt.Errorf("failed to get collection %d", collectionID)
 	}
-	return s.estimatePolicy(collMeta.Schema)
+	return calByCollectionPolicy(collMeta, s.estimatePolicy)
 }
 
 // DropSegment drop the segment from manager.
@@ -553,8 +553,9 @@ func (s *SegmentManager) tryToSealSegment(ts Timestamp, channel string) error {
 			continue
 		}
 		// change shouldSeal to segment seal policy logic
+		collection := s.meta.GetCollection(info.GetCollectionID())
 		for _, policy := range s.segmentSealPolicies {
-			if policy(info, ts) {
+			if policy(collection, info, ts) {
 				if err := s.meta.SetState(id, commonpb.SegmentState_Sealed); err != nil {
 					return err
 				}
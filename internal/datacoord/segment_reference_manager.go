@@ -0,0 +1,72 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"sync"
+)
+
+// segmentReferenceManager tracks the segments locked by the tasks reading their binlogs, e.g. the export tasks,
+// the locked segments are not garbage collected even if they are dropped.
+// The locks are not persisted, the tasks lock the segments again when they are resumed.
+type segmentReferenceManager struct {
+	mu sync.RWMutex
+	// task id -> locked segment ids
+	taskSegments map[int64][]int64
+	// segment id -> number of tasks locking it
+	segmentRefs map[int64]int
+}
+
+func newSegmentReferenceManager() *segmentReferenceManager {
+	return &segmentReferenceManager{
+		taskSegments: make(map[int64][]int64),
+		segmentRefs:  make(map[int64]int),
+	}
+}
+
+// AddSegmentsLock locks the segments for the task, the segments locked by the task before are kept locked.
+func (m *segmentReferenceManager) AddSegmentsLock(taskID int64, segmentIDs []int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.taskSegments[taskID] = append(m.taskSegments[taskID], segmentIDs...)
+	for _, segmentID := range segmentIDs {
+		m.segmentRefs[segmentID]++
+	}
+}
+
+// ReleaseSegmentsLock releases all the segments locked by the task.
+func (m *segmentReferenceManager) ReleaseSegmentsLock(taskID int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, segmentID := range m.taskSegments[taskID] {
+		m.segmentRefs[segmentID]--
+		if m.segmentRefs[segmentID] <= 0 {
+			delete(m.segmentRefs, segmentID)
+		}
+	}
+	delete(m.taskSegments, taskID)
+}
+
+// HasSegmentLock returns true if the segment is locked by any task.
+func (m *segmentReferenceManager) HasSegmentLock(segmentID int64) bool {
+	if m == nil {
+		return false
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.segmentRefs[segmentID] > 0
+}
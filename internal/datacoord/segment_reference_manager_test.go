@@ -0,0 +1,48 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSegmentReferenceManager(t *testing.T) {
+	var nilManager *segmentReferenceManager
+	assert.False(t, nilManager.HasSegmentLock(1))
+
+	m := newSegmentReferenceManager()
+	m.AddSegmentsLock(1, []int64{10, 11})
+	m.AddSegmentsLock(2, []int64{11, 12})
+	assert.True(t, m.HasSegmentLock(10))
+	assert.True(t, m.HasSegmentLock(11))
+	assert.True(t, m.HasSegmentLock(12))
+	assert.False(t, m.HasSegmentLock(13))
+
+	m.ReleaseSegmentsLock(1)
+	assert.False(t, m.HasSegmentLock(10))
+	assert.True(t, m.HasSegmentLock(11))
+
+	m.ReleaseSegmentsLock(2)
+	assert.False(t, m.HasSegmentLock(11))
+	assert.False(t, m.HasSegmentLock(12))
+	assert.Empty(t, m.segmentRefs)
+
+	// releasing a task without locks is fine
+	m.ReleaseSegmentsLock(3)
+}
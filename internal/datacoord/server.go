@@ -37,8 +37,14 @@ import (
 	datanodeclient "github.com/milvus-io/milvus/internal/distributed/datanode/client"
 	indexnodeclient "github.com/milvus-io/milvus/internal/distributed/indexnode/client"
 	rootcoordclient "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
+	"github.com/milvus-io/milvus/internal/http"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/db/dao"
+	dbdatacoord "github.com/milvus-io/milvus/internal/metastore/db/datacoord"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbcore"
 	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	querycoordcatalog "github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
@@ -48,6 +54,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/logutil"
@@ -115,6 +122,9 @@ type Server struct {
 	gcOpt            GcOption
 	handler          Handler
 
+	// channelLoadCollector is nil unless the load aware channel policies are enabled
+	channelLoadCollector *channelLoadCollector
+
 	compactionTrigger trigger
 	compactionHandler compactionPlanContext
 
@@ -139,9 +149,11 @@ type Server struct {
 	rootCoordClientCreator rootCoordCreatorFunc
 	//indexCoord             types.IndexCoord
 
-	//segReferManager  *SegmentReferenceManager
+	segReferManager  *segmentReferenceManager
 	indexBuilder     *indexBuilder
 	indexNodeManager *IndexNodeManager
+	exportManager    *exportManager
+	rebuildManager   *indexRebuildManager
 }
 
 // ServerHelper datacoord server injection helper
@@ -347,8 +359,11 @@ func (s *Server) initDataCoord() error {
 	}
 	s.initSegmentManager()
 
+	s.segReferManager = newSegmentReferenceManager()
 	s.initGarbageCollection(storageCli)
 	s.initIndexBuilder(storageCli)
+	s.initExportManager(storageCli)
+	s.initIndexRebuildManager()
 
 	s.serverLoopCtx, s.serverLoopCancel = context.WithCancel(s.ctx)
 
@@ -392,12 +407,16 @@ func (s *Server) initCluster() error {
 	}
 
 	var err error
-	s.channelManager, err = NewChannelManager(s.kvClient, s.handler, withMsgstreamFactory(s.factory),
-		withStateChecker(), withBgChecker())
+	s.sessionManager = NewSessionManager(withSessionCreator(s.dataNodeCreator))
+	opts := []ChannelManagerOpt{withMsgstreamFactory(s.factory), withStateChecker(), withBgChecker()}
+	if Params.DataCoordCfg.ChannelLoadAwareEnable.GetAsBool() {
+		s.channelLoadCollector = newChannelLoadCollector(s.sessionManager)
+		opts = append(opts, withFactory(NewLoadAwareChannelPolicyFactory(s.kvClient, s.channelLoadCollector)))
+	}
+	s.channelManager, err = NewChannelManager(s.kvClient, s.handler, opts...)
 	if err != nil {
 		return err
 	}
-	s.sessionManager = NewSessionManager(withSessionCreator(s.dataNodeCreator))
 	s.cluster = NewCluster(s.sessionManager, s.channelManager)
 	return nil
 }
@@ -456,9 +475,21 @@ func (s *Server) initGarbageCollection(cli storage.ChunkManager) {
 		checkInterval:    Params.DataCoordCfg.GCInterval.GetAsDuration(time.Second),
 		missingTolerance: Params.DataCoordCfg.GCMissingTolerance.GetAsDuration(time.Second),
 		dropTolerance:    Params.DataCoordCfg.GCDropTolerance.GetAsDuration(time.Second),
+		segRefer:         s.segReferManager,
 	})
 }
 
+func (s *Server) initExportManager(cli storage.ChunkManager) {
+	s.exportManager = newExportManager(s.ctx, s.meta, s.handler, s.allocator, s.kvClient, cli, s.segReferManager,
+		Params.DataCoordCfg.ExportMaxConcurrentTasks.GetAsInt())
+}
+
+func (s *Server) initIndexRebuildManager() {
+	refCatalog := querycoordcatalog.NewCatalog(s.kvClient)
+	s.rebuildManager = newIndexRebuildManager(s.ctx, s.meta, s.allocator, s.kvClient,
+		s.indexBuilder.enqueue, refCatalog.GetIndexReferences)
+}
+
 func (s *Server) initServiceDiscovery() error {
 	r := semver.MustParseRange(">=2.2.3")
 	sessions, rev, err := s.session.GetSessionsWithVersionRange(typeutil.DataNodeRole, r)
@@ -521,8 +552,24 @@ func (s *Server) initMeta(chunkManager storage.ChunkManager) error {
 
 	s.kvClient = etcdKV
 	reloadEtcdFn := func() error {
+		var catalog metastore.DataCoordCatalog
 		var err error
-		catalog := datacoord.NewCatalog(etcdKV, chunkManager.RootPath(), Params.EtcdCfg.MetaRootPath.GetValue())
+
+		switch Params.MetaStoreCfg.MetaStoreType.GetValue() {
+		case util.MetaStoreTypeEtcd:
+			catalog = datacoord.NewCatalog(etcdKV, chunkManager.RootPath(), Params.EtcdCfg.MetaRootPath.GetValue())
+		case util.MetaStoreTypeMysql:
+			// connect to database
+			err = dbcore.Connect(&Params.DBCfg)
+			if err != nil {
+				return err
+			}
+
+			catalog = dbdatacoord.NewTableCatalog(dbcore.NewTxImpl(), dao.NewMetaDomain())
+		default:
+			return retry.Unrecoverable(fmt.Errorf("not supported meta store: %s", Params.MetaStoreCfg.MetaStoreType.GetValue()))
+		}
+
 		s.meta, err = newMeta(s.ctx, catalog, chunkManager)
 		if err != nil {
 			return err
@@ -551,6 +598,30 @@ func (s *Server) startServerLoop() {
 	s.startFlushLoop(s.serverLoopCtx)
 	s.startIndexService(s.serverLoopCtx)
 	s.garbageCollector.start()
+	if err := s.exportManager.start(); err != nil {
+		log.Warn("failed to resume export tasks", zap.Error(err))
+	}
+	registerExportHandlerOnce.Do(func() {
+		http.Register(&http.Handler{
+			Path:    http.ExportRouterPath,
+			Handler: defaultExportHandler,
+		})
+	})
+	defaultExportHandler.setManager(s.exportManager, http.NewRootCoordCredentialVerifier(s.rootCoordClient))
+	if err := s.rebuildManager.start(); err != nil {
+		log.Warn("failed to resume index rebuild jobs", zap.Error(err))
+	}
+	registerIndexRebuildHandlerOnce.Do(func() {
+		http.Register(&http.Handler{
+			Path:    http.IndexRebuildRouterPath,
+			Handler: defaultIndexRebuildHandler,
+		})
+	})
+	defaultIndexRebuildHandler.setManager(s.rebuildManager, http.NewRootCoordCredentialVerifier(s.rootCoordClient))
+	if s.channelLoadCollector != nil {
+		s.serverLoopWg.Add(1)
+		go s.channelLoadCollector.start(s.serverLoopCtx, &s.serverLoopWg)
+	}
 }
 
 // startDataNodeTtLoop start a goroutine to recv data node tt msg from msgstream
@@ -928,6 +999,10 @@ func (s *Server) Stop() error {
 	logutil.Logger(s.ctx).Info("server shutdown")
 	s.cluster.Close()
 	s.garbageCollector.close()
+	defaultExportHandler.setManager(nil, nil)
+	s.exportManager.stop()
+	defaultIndexRebuildHandler.setManager(nil, nil)
+	s.rebuildManager.stop()
 	s.stopServerLoop()
 
 	if Params.DataCoordCfg.EnableCompaction.GetAsBool() {
@@ -469,6 +469,22 @@ func (s *Server) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPath
 
 	log.Info("flush segment with meta", zap.Any("meta", req.GetField2BinlogPaths()))
 
+	// the deletes of the segments compacted by clustering are flushed into one of the produced segments,
+	// copy them to the others as the deleted entities may be in any one.
+	if len(req.GetDeltalogs()) > 0 {
+		for _, sibling := range s.meta.GetClusteringSiblings(segment) {
+			deltalogs, err := s.meta.copyDeltaFiles(req.GetDeltalogs(), sibling.GetCollectionID(), sibling.GetPartitionID(), sibling.GetID())
+			if err == nil {
+				err = s.meta.UpdateFlushSegmentsInfo(sibling.GetID(), false, false, false, nil, nil, deltalogs, nil, nil)
+			}
+			if err != nil {
+				log.Error("failed to copy delta logs to clustering sibling segment", zap.Int64("sibling", sibling.GetID()), zap.Error(err))
+				resp.Reason = err.Error()
+				return resp, nil
+			}
+		}
+	}
+
 	if req.GetFlushed() {
 		s.segmentManager.DropSegment(ctx, req.SegmentID)
 		s.flushCh <- req.SegmentID
@@ -1566,6 +1582,10 @@ func (s *Server) BroadcastAlteredCollection(ctx context.Context, req *datapb.Alt
 	}
 
 	clonedColl.Properties = properties
+	// schema may be changed by adding fields, keep the latest one for compaction.
+	if req.GetSchema() != nil {
+		clonedColl.Schema = req.GetSchema()
+	}
 	s.meta.AddCollection(clonedColl)
 	return &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
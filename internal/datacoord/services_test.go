@@ -12,6 +12,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -63,6 +64,26 @@ func TestBroadcastAlteredCollection(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, s.meta.collections[1].Properties)
 	})
+
+	t.Run("test update schema", func(t *testing.T) {
+		s := &Server{meta: &meta{collections: map[UniqueID]*collectionInfo{
+			1: {ID: 1, Schema: &schemapb.CollectionSchema{Name: "coll"}},
+		}}}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+		schema := &schemapb.CollectionSchema{
+			Name:   "coll",
+			Fields: []*schemapb.FieldSchema{{FieldID: 100, Name: "f", DataType: schemapb.DataType_Int64}},
+		}
+		req := &datapb.AlterCollectionRequest{
+			CollectionID: 1,
+			Schema:       schema,
+		}
+
+		resp, err := s.BroadcastAlteredCollection(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.Equal(t, schema, s.meta.collections[1].Schema)
+	})
 }
 
 func TestServer_GcConfirm(t *testing.T) {
@@ -161,6 +161,59 @@ func getCollectionTTL(properties map[string]string) (time.Duration, error) {
 	return Params.CommonCfg.EntityExpirationTTL.GetAsDuration(time.Second), nil
 }
 
+// getCollectionSegmentMaxSize returns the segment max size in MB specified by collection properties,
+// false if not specified or invalid.
+func getCollectionSegmentMaxSize(properties map[string]string) (float64, bool) {
+	v, ok := properties[common.CollectionSegmentMaxSizeKey]
+	if !ok {
+		return 0, false
+	}
+	size, err := strconv.ParseFloat(v, 64)
+	if err != nil || size <= 0 {
+		log.Warn("invalid segment max size of collection, use the default one", zap.String("value", v))
+		return 0, false
+	}
+	return size, true
+}
+
+// getCollectionDuration returns the duration in seconds specified by collection property key,
+// or the default one if not specified or invalid.
+func getCollectionDuration(properties map[string]string, key string, defaultValue time.Duration) time.Duration {
+	v, ok := properties[key]
+	if !ok {
+		return defaultValue
+	}
+	seconds, err := strconv.ParseFloat(v, 64)
+	if err != nil || seconds <= 0 {
+		log.Warn("invalid duration of collection property, use the default one",
+			zap.String("key", key), zap.String("value", v), zap.Duration("default", defaultValue))
+		return defaultValue
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// getClusteringField returns the field named by the clustering key of the collection,
+// nil if the collection is not clustered.
+func getClusteringField(coll *collectionInfo) (*schemapb.FieldSchema, error) {
+	name, ok := coll.Properties[common.CollectionClusteringKey]
+	if !ok || name == "" {
+		return nil, nil
+	}
+	for _, field := range coll.Schema.GetFields() {
+		if field.GetName() != name {
+			continue
+		}
+		switch field.GetDataType() {
+		case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64,
+			schemapb.DataType_Float, schemapb.DataType_Double, schemapb.DataType_VarChar:
+			return field, nil
+		default:
+			return nil, errors.Newf("clustering key %s of type %s is not supported", name, field.GetDataType().String())
+		}
+	}
+	return nil, errors.Newf("clustering key %s not found in collection %d", name, coll.ID)
+}
+
 func getIndexType(indexParams []*commonpb.KeyValuePair) string {
 	for _, param := range indexParams {
 		if param.Key == "index_type" {
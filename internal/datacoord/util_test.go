@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -196,3 +197,33 @@ func (suite *UtilSuite) TestGetCollectionTTL() {
 	suite.NoError(err)
 	suite.Equal(ttl, Params.CommonCfg.EntityExpirationTTL.GetAsDuration(time.Second))
 }
+
+func (suite *UtilSuite) TestGetClusteringField() {
+	coll := &collectionInfo{
+		ID: 1,
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64},
+				{FieldID: 101, Name: "json", DataType: schemapb.DataType_JSON},
+			},
+		},
+		Properties: map[string]string{},
+	}
+
+	field, err := getClusteringField(coll)
+	suite.NoError(err)
+	suite.Nil(field)
+
+	coll.Properties[common.CollectionClusteringKey] = "pk"
+	field, err = getClusteringField(coll)
+	suite.NoError(err)
+	suite.EqualValues(100, field.GetFieldID())
+
+	coll.Properties[common.CollectionClusteringKey] = "json"
+	_, err = getClusteringField(coll)
+	suite.Error(err)
+
+	coll.Properties[common.CollectionClusteringKey] = "not_exist"
+	_, err = getClusteringField(coll)
+	suite.Error(err)
+}
@@ -24,6 +24,7 @@ import (
 	"sync"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
@@ -62,6 +63,16 @@ func (m *DeltaBufferManager) GetEntriesNum(segID UniqueID) int64 {
 func (m *DeltaBufferManager) UpdateCompactedSegments() {
 	compactedTo2From := m.channel.listCompactedSegmentIDs()
 	for compactedTo, compactedFrom := range compactedTo2From {
+		clustered := lo.Filter(compactedFrom, func(segID UniqueID, _ int) bool {
+			return m.dispatchClusteringDeletes(segID)
+		})
+		if len(clustered) > 0 {
+			m.channel.removeSegments(clustered...)
+			compactedFrom = lo.Without(compactedFrom, clustered...)
+			if len(compactedFrom) == 0 {
+				continue
+			}
+		}
 
 		// if the compactedTo segment has 0 numRows, there'll be no segments
 		// in the channel meta, so remove all compacted from segments related
@@ -103,6 +114,48 @@ func (m *DeltaBufferManager) UpdateCompactedSegments() {
 	}
 }
 
+// dispatchClusteringDeletes moves the buffered deletes of the segment compacted by clustering
+// to the produced segments which may contain the primary keys, returns false if it's not compacted by clustering.
+func (m *DeltaBufferManager) dispatchClusteringDeletes(segID UniqueID) bool {
+	targets := m.channel.getClusteringTargets(segID)
+	if len(targets) == 0 {
+		return false
+	}
+	delDataBuf, loaded := m.Load(segID)
+	if !loaded {
+		return true
+	}
+	var (
+		delData  = delDataBuf.delData
+		tr       = TimeRange{timestampMin: delDataBuf.TimestampFrom, timestampMax: delDataBuf.TimestampTo}
+		startPos = delDataBuf.startPos
+		endPos   = delDataBuf.endPos
+	)
+	m.Delete(segID)
+
+	for _, target := range targets {
+		var (
+			pks []primaryKey
+			tss []Timestamp
+		)
+		for i, pk := range delData.Pks {
+			if target.isPKExist(pk) {
+				pks = append(pks, pk)
+				tss = append(tss, delData.Tss[i])
+			}
+		}
+		if len(pks) > 0 {
+			m.bufferDeletes(target.segmentID, pks, tss, tr, startPos, endPos)
+		}
+	}
+	log.Info("dispatch delBuf of segment compacted by clustering",
+		zap.Int64("segmentID", segID),
+		zap.Int64s("clusteringTo", lo.Map(targets, func(target *Segment, _ int) UniqueID { return target.segmentID })),
+		zap.Int("entriesNum", len(delData.Pks)),
+		zap.Int64("usedMemory", m.usedMemory.Load()))
+	return true
+}
+
 func (m *DeltaBufferManager) updateMeta(segID UniqueID, delDataBuf *DelDataBuf) {
 	m.channel.setCurDeleteBuffer(segID, delDataBuf)
 }
@@ -129,6 +182,14 @@ func (m *DeltaBufferManager) deleteFromHeap(buffer *DelDataBuf) {
 }
 
 func (m *DeltaBufferManager) StoreNewDeletes(segID UniqueID, pks []primaryKey,
+	tss []Timestamp, tr TimeRange, startPos, endPos *msgpb.MsgPosition) {
+	m.bufferDeletes(segID, pks, tss, tr, startPos, endPos)
+
+	metrics.DataNodeConsumeMsgRowsCount.WithLabelValues(
+		fmt.Sprint(paramtable.GetNodeID()), metrics.DeleteLabel).Add(float64(len(pks)))
+}
+
+func (m *DeltaBufferManager) bufferDeletes(segID UniqueID, pks []primaryKey,
 	tss []Timestamp, tr TimeRange, startPos, endPos *msgpb.MsgPosition) {
 	buffer, loaded := m.Load(segID)
 	if !loaded {
@@ -140,9 +201,6 @@ func (m *DeltaBufferManager) StoreNewDeletes(segID UniqueID, pks []primaryKey,
 	m.pushOrFixHeap(segID, buffer)
 	m.updateMeta(segID, buffer)
 	m.usedMemory.Add(size)
-
-	metrics.DataNodeConsumeMsgRowsCount.WithLabelValues(
-		fmt.Sprint(paramtable.GetNodeID()), metrics.DeleteLabel).Add(float64(len(pks)))
 }
 
 func (m *DeltaBufferManager) Load(segID UniqueID) (delDataBuf *DelDataBuf, ok bool) {
@@ -25,6 +25,8 @@ import (
 	"testing"
 	"time"
 
+	bloom "github.com/bits-and-blooms/bloom/v3"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -233,6 +235,59 @@ func Test_CompactSegBuff(t *testing.T) {
 	assert.Equal(t, Timestamp(200), cp.Timestamp) // evict all buffer, use ttPos as cp
 }
 
+func Test_CompactSegBuffClustering(t *testing.T) {
+	channel := &ChannelMeta{
+		collectionID: 1,
+		segments:     make(map[UniqueID]*Segment),
+	}
+	delBufferManager := &DeltaBufferManager{
+		channel:    channel,
+		delBufHeap: &PriorityQueue{},
+	}
+
+	// the segment 1111 is compacted by clustering to 3333 with pk [0, 10) and 4444 with pk [10, 20)
+	compacted := &Segment{collectionID: 1, segmentID: 1111}
+	compacted.setType(datapb.SegmentType_Flushed)
+	channel.segments[compacted.segmentID] = compacted
+
+	newTarget := func(segID UniqueID, from, to int64) *Segment {
+		stat := &storage.PkStatistics{PkFilter: bloom.NewWithEstimates(1000, 0.0001)}
+		ids := &storage.Int64FieldData{}
+		for pk := from; pk < to; pk++ {
+			ids.Data = append(ids.Data, pk)
+		}
+		require.NoError(t, stat.UpdatePKRange(ids))
+		return &Segment{collectionID: 1, segmentID: segID, numRows: to - from, currentStat: stat}
+	}
+	target1 := newTarget(3333, 0, 10)
+	target2 := newTarget(4444, 10, 20)
+
+	delBufferManager.StoreNewDeletes(compacted.segmentID,
+		[]primaryKey{newInt64PrimaryKey(1), newInt64PrimaryKey(11), newInt64PrimaryKey(12)},
+		[]Timestamp{100, 101, 102}, TimeRange{timestampMin: 100, timestampMax: 102},
+		&msgpb.MsgPosition{Timestamp: 100}, &msgpb.MsgPosition{Timestamp: 102})
+
+	err := channel.mergeFlushedSegments(context.Background(), target1, 100, []UniqueID{compacted.segmentID}, target2)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []UniqueID{3333, 4444},
+		lo.Map(channel.getClusteringTargets(compacted.segmentID), func(s *Segment, _ int) UniqueID { return s.segmentID }))
+
+	delBufferManager.UpdateCompactedSegments()
+
+	// the deletes are dispatched to the segments by primary key
+	assert.False(t, channel.hasSegment(compacted.segmentID, true))
+	buf1, ok := delBufferManager.Load(target1.segmentID)
+	require.True(t, ok)
+	assert.Equal(t, []primaryKey{newInt64PrimaryKey(1)}, buf1.delData.Pks)
+	assert.Equal(t, []Timestamp{100}, buf1.delData.Tss)
+	buf2, ok := delBufferManager.Load(target2.segmentID)
+	require.True(t, ok)
+	assert.Equal(t, []primaryKey{newInt64PrimaryKey(11), newInt64PrimaryKey(12)}, buf2.delData.Pks)
+	assert.Equal(t, []Timestamp{101, 102}, buf2.delData.Tss)
+	assert.Equal(t, int64(3), buf1.EntriesNum+buf2.EntriesNum)
+	assert.Equal(t, buf1.GetMemorySize()+buf2.GetMemorySize(), delBufferManager.usedMemory.Load())
+}
+
 func TestUpdateCompactedSegments(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 	defer cancel()
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"strconv"
 	"sync"
 	"time"
 
@@ -56,6 +57,7 @@ var (
 type Channel interface {
 	getCollectionID() UniqueID
 	getCollectionSchema(collectionID UniqueID, ts Timestamp) (*schemapb.CollectionSchema, error)
+	refreshCollectionSchema(collectionID UniqueID, ts Timestamp) (*schemapb.CollectionSchema, error)
 	getCollectionAndPartitionID(segID UniqueID) (collID, partitionID UniqueID, err error)
 	getChannelName(segID UniqueID) string
 
@@ -67,10 +69,11 @@ type Channel interface {
 	listNewSegmentsStartPositions() []*datapb.SegmentStartPosition
 	transferNewSegments(segmentIDs []UniqueID)
 	updateSegmentPKRange(segID UniqueID, ids storage.FieldData)
-	mergeFlushedSegments(ctx context.Context, seg *Segment, planID UniqueID, compactedFrom []UniqueID) error
+	mergeFlushedSegments(ctx context.Context, seg *Segment, planID UniqueID, compactedFrom []UniqueID, clusteringSegs ...*Segment) error
 	hasSegment(segID UniqueID, countFlushed bool) bool
 	removeSegments(segID ...UniqueID)
 	listCompactedSegmentIDs() map[UniqueID][]UniqueID
+	getClusteringTargets(segID UniqueID) []*Segment
 	listSegmentIDsToSync(ts Timestamp) []UniqueID
 	setSegmentLastSyncTs(segID UniqueID, ts Timestamp)
 
@@ -113,6 +116,13 @@ type ChannelMeta struct {
 
 	metaService  *metaService
 	chunkManager storage.ChunkManager
+
+	// properties of the collection, which may override the sync policies,
+	// refreshed from rootcoord in background at most once per collectionPropertiesRefreshInterval
+	propertiesMut        sync.Mutex
+	properties           map[string]string
+	propertiesFetched    time.Time
+	propertiesRefreshing bool
 }
 
 type addSegmentReq struct {
@@ -138,14 +148,14 @@ func newChannel(channelName string, collID UniqueID, schema *schemapb.Collection
 		segments: make(map[UniqueID]*Segment),
 
 		needToSync: atomic.NewBool(false),
-		syncPolicies: []segmentSyncPolicy{
-			syncPeriodically(),
-			syncMemoryTooHigh(),
-		},
 
 		metaService:  metaService,
 		chunkManager: cm,
 	}
+	channel.syncPolicies = []segmentSyncPolicy{
+		syncPeriodically(channel.getSyncPeriod),
+		syncMemoryTooHigh(),
+	}
 
 	return &channel
 }
@@ -259,6 +269,25 @@ func (c *ChannelMeta) listCompactedSegmentIDs() map[UniqueID][]UniqueID {
 	return compactedTo2From
 }
 
+// getClusteringTargets returns the segments in channel produced by the clustering compaction of the segment,
+// nil if the segment is not compacted by clustering.
+func (c *ChannelMeta) getClusteringTargets(segID UniqueID) []*Segment {
+	c.segMu.RLock()
+	defer c.segMu.RUnlock()
+
+	seg, ok := c.segments[segID]
+	if !ok || seg.isValid() {
+		return nil
+	}
+	var targets []*Segment
+	for _, targetID := range seg.clusteringTo {
+		if target, ok := c.segments[targetID]; ok && target.isValid() {
+			targets = append(targets, target)
+		}
+	}
+	return targets
+}
+
 func (c *ChannelMeta) listSegmentIDsToSync(ts Timestamp) []UniqueID {
 	c.segMu.RLock()
 	defer c.segMu.RUnlock()
@@ -556,7 +585,81 @@ func (c *ChannelMeta) getCollectionSchema(collID UniqueID, ts Timestamp) (*schem
 	return c.collSchema, nil
 }
 
-func (c *ChannelMeta) mergeFlushedSegments(ctx context.Context, seg *Segment, planID UniqueID, compactedFrom []UniqueID) error {
+// getCollectionProperties returns the properties of collection cached,
+// a background refresh from rootcoord is triggered if they are stale.
+func (c *ChannelMeta) getCollectionProperties() map[string]string {
+	c.propertiesMut.Lock()
+	defer c.propertiesMut.Unlock()
+	if !c.propertiesRefreshing && time.Since(c.propertiesFetched) >= collectionPropertiesRefreshInterval {
+		c.propertiesRefreshing = true
+		go c.refreshCollectionProperties()
+	}
+	return c.properties
+}
+
+// refreshCollectionProperties fetches the properties of collection from rootcoord,
+// the stale ones are kept if failed to refresh.
+func (c *ChannelMeta) refreshCollectionProperties() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	resp, err := c.metaService.getCollectionInfo(ctx, c.collectionID, 0)
+
+	c.propertiesMut.Lock()
+	defer c.propertiesMut.Unlock()
+	c.propertiesRefreshing = false
+	c.propertiesFetched = time.Now()
+	if err != nil {
+		log.Warn("failed to refresh collection properties",
+			zap.Int64("collectionID", c.collectionID), zap.Error(err))
+		return
+	}
+	properties := make(map[string]string, len(resp.GetProperties()))
+	for _, kv := range resp.GetProperties() {
+		properties[kv.GetKey()] = kv.GetValue()
+	}
+	c.properties = properties
+}
+
+// getSyncPeriod returns the sync period specified by collection properties,
+// or the configured one if not specified or invalid.
+func (c *ChannelMeta) getSyncPeriod() time.Duration {
+	defaultPeriod := Params.DataNodeCfg.SyncPeriod.GetAsDuration(time.Second)
+	v, ok := c.getCollectionProperties()[common.CollectionSyncPeriodKey]
+	if !ok {
+		return defaultPeriod
+	}
+	seconds, err := strconv.ParseFloat(v, 64)
+	if err != nil || seconds <= 0 {
+		log.Warn("invalid sync period of collection, use the default one",
+			zap.Int64("collectionID", c.collectionID), zap.String("value", v))
+		return defaultPeriod
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// refreshCollectionSchema fetches the collection schema from rootcoord and replaces the cached one,
+// it's used when a field is added to the collection.
+func (c *ChannelMeta) refreshCollectionSchema(collID UniqueID, ts Timestamp) (*schemapb.CollectionSchema, error) {
+	if collID != c.collectionID {
+		log.Warn("failed to refreshCollectionSchema, collection mismatch",
+			zap.Int64("current collection ID", collID),
+			zap.Int64("expected collection ID", c.collectionID))
+		return nil, merr.WrapErrParameterInvalid(c.collectionID, collID, "collection not match")
+	}
+
+	sch, err := c.metaService.getCollectionSchema(context.Background(), collID, ts)
+	if err != nil {
+		return nil, err
+	}
+
+	c.schemaMut.Lock()
+	defer c.schemaMut.Unlock()
+	c.collSchema = sch
+	return c.collSchema, nil
+}
+
+// The segments produced by a clustering compaction are passed as clusteringSegs besides seg.
+func (c *ChannelMeta) mergeFlushedSegments(ctx context.Context, seg *Segment, planID UniqueID, compactedFrom []UniqueID, clusteringSegs ...*Segment) error {
 	log := log.Ctx(ctx).With(
 		zap.Int64("segment ID", seg.segmentID),
 		zap.Int64("collection ID", seg.collectionID),
@@ -565,11 +668,18 @@ func (c *ChannelMeta) mergeFlushedSegments(ctx context.Context, seg *Segment, pl
 		zap.Int64("planID", planID),
 		zap.String("channel name", c.channelName))
 
-	if seg.collectionID != c.collectionID {
-		log.Warn("failed to mergeFlushedSegments, collection mismatch",
-			zap.Int64("current collection ID", seg.collectionID),
-			zap.Int64("expected collection ID", c.collectionID))
-		return merr.WrapErrParameterInvalid(c.collectionID, seg.collectionID, "collection not match")
+	targets := append([]*Segment{seg}, clusteringSegs...)
+	for _, target := range targets {
+		if target.collectionID != c.collectionID {
+			log.Warn("failed to mergeFlushedSegments, collection mismatch",
+				zap.Int64("current collection ID", target.collectionID),
+				zap.Int64("expected collection ID", c.collectionID))
+			return merr.WrapErrParameterInvalid(c.collectionID, target.collectionID, "collection not match")
+		}
+	}
+	var clusteringTo []UniqueID
+	if len(clusteringSegs) > 0 {
+		clusteringTo = lo.Map(targets, func(target *Segment, _ int) UniqueID { return target.segmentID })
 	}
 
 	var inValidSegments []UniqueID
@@ -598,6 +708,7 @@ func (c *ChannelMeta) mergeFlushedSegments(ctx context.Context, seg *Segment, pl
 		// the existent of the segments are already checked
 		s := c.segments[ID]
 		s.compactedTo = seg.segmentID
+		s.clusteringTo = clusteringTo
 		s.setType(datapb.SegmentType_Compacted)
 		// release bloom filter
 		s.currentStat = nil
@@ -605,9 +716,11 @@ func (c *ChannelMeta) mergeFlushedSegments(ctx context.Context, seg *Segment, pl
 	}
 
 	// only store segments with numRows > 0
-	if seg.numRows > 0 {
-		seg.setType(datapb.SegmentType_Flushed)
-		c.segments[seg.segmentID] = seg
+	for _, target := range targets {
+		if target.numRows > 0 {
+			target.setType(datapb.SegmentType_Flushed)
+			c.segments[target.segmentID] = target
+		}
 	}
 
 	return nil
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math/rand"
 	"testing"
+	"time"
 
 	bloom "github.com/bits-and-blooms/bloom/v3"
 	"github.com/cockroachdb/errors"
@@ -47,6 +48,36 @@ func TestNewChannel(t *testing.T) {
 	assert.NotNil(t, channel)
 }
 
+func TestChannelMeta_getSyncPeriod(t *testing.T) {
+	rc := &RootCoordFactory{pkType: schemapb.DataType_Int64}
+	cm := storage.NewLocalChunkManager(storage.RootPath(channelMetaNodeTestDir))
+	defer cm.RemoveWithPrefix(context.Background(), cm.RootPath())
+	channel := newChannel("channel", 1, nil, rc, cm)
+	refreshed := func() bool {
+		channel.propertiesMut.Lock()
+		defer channel.propertiesMut.Unlock()
+		return !channel.propertiesRefreshing && !channel.propertiesFetched.IsZero()
+	}
+
+	// no property specified by collection, the properties are refreshed in background
+	assert.Equal(t, Params.DataNodeCfg.SyncPeriod.GetAsDuration(time.Second), channel.getSyncPeriod())
+	assert.Eventually(t, refreshed, time.Second, 10*time.Millisecond)
+
+	channel.properties = map[string]string{common.CollectionSyncPeriodKey: "30"}
+	assert.Equal(t, 30*time.Second, channel.getSyncPeriod())
+
+	channel.properties = map[string]string{common.CollectionSyncPeriodKey: "invalid"}
+	assert.Equal(t, Params.DataNodeCfg.SyncPeriod.GetAsDuration(time.Second), channel.getSyncPeriod())
+
+	// failed to refresh, the stale properties are used
+	rc.collectionID = -1
+	channel.properties = map[string]string{common.CollectionSyncPeriodKey: "30"}
+	channel.propertiesFetched = time.Time{}
+	assert.Equal(t, 30*time.Second, channel.getSyncPeriod())
+	assert.Eventually(t, refreshed, time.Second, 10*time.Millisecond)
+	assert.Equal(t, 30*time.Second, channel.getSyncPeriod())
+}
+
 type mockDataCM struct {
 	storage.ChunkManager
 }
@@ -464,6 +495,26 @@ func TestChannelMeta_InterfaceMethod(t *testing.T) {
 		rc.setCollectionID(1)
 	})
 
+	t.Run("Test_refreshCollectionSchema", func(t *testing.T) {
+		channel := newChannel("a", 1, &schemapb.CollectionSchema{Name: "stale"}, rc, cm)
+
+		_, err := channel.refreshCollectionSchema(2, Timestamp(0))
+		assert.Error(t, err)
+
+		rc.setCollectionID(-1)
+		_, err = channel.refreshCollectionSchema(1, Timestamp(0))
+		assert.Error(t, err)
+		rc.setCollectionID(1)
+
+		s, err := channel.refreshCollectionSchema(1, Timestamp(0))
+		assert.NoError(t, err)
+		assert.NotEqual(t, "stale", s.GetName())
+
+		cached, err := channel.getCollectionSchema(1, Timestamp(0))
+		assert.NoError(t, err)
+		assert.Same(t, s, cached)
+	})
+
 	t.Run("Test listAllSegmentIDs", func(t *testing.T) {
 		s1 := Segment{segmentID: 1}
 		s2 := Segment{segmentID: 2}
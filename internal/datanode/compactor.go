@@ -213,11 +213,20 @@ func (t *compactionTask) uploadSingleInsertLog(
 			return nil, nil, errors.New("Unexpected error")
 		}
 
+		validData, err := replaceNullContent(tp, content)
+		if err != nil {
+			log.Warn("replace null content wrong", zap.Error(err))
+			return nil, nil, err
+		}
+
 		fData, err := interface2FieldData(tp, content, int64(len(content)))
 		if err != nil {
 			log.Warn("transfer interface to FieldData wrong", zap.Error(err))
 			return nil, nil, err
 		}
+		if validData != nil {
+			fData.(storage.NullableFieldData).SetValidData(validData)
+		}
 		iData.Data[fID] = fData
 	}
 
@@ -294,9 +303,22 @@ func (t *compactionTask) merge(
 		}
 	}
 
+	// fields added after the segments were written are filled with their default values
+	fID2Default := make(map[UniqueID]interface{})
+
 	// get pkID, pkType, dim
 	for _, fs := range meta.GetSchema().GetFields() {
 		fID2Type[fs.GetFieldID()] = fs.GetDataType()
+		if fs.GetDefaultValue() != nil {
+			defaultValue, err := typeutil.GetDefaultValue(fs)
+			if err != nil {
+				log.Warn("failed to get default value", zap.Int64("fieldID", fs.GetFieldID()), zap.Error(err))
+				return nil, nil, 0, err
+			}
+			fID2Default[fs.GetFieldID()] = defaultValue
+		} else if typeutil.IsFieldNullable(fs) {
+			fID2Default[fs.GetFieldID()] = nil
+		}
 		if fs.GetIsPrimaryKey() && fs.GetFieldID() >= 100 && typeutil.IsPrimaryFieldType(fs.GetDataType()) {
 			pkID = fs.GetFieldID()
 			pkType = fs.GetDataType()
@@ -363,6 +385,12 @@ func (t *compactionTask) merge(
 				return nil, nil, 0, errors.New("unexpected error")
 			}
 
+			for fID, defaultValue := range fID2Default {
+				if _, ok := row[fID]; !ok {
+					row[fID] = defaultValue
+				}
+			}
+
 			for fID, vInter := range row {
 				if _, ok := fID2Content[fID]; !ok {
 					fID2Content[fID] = make([]interface{}, 0)
@@ -444,7 +472,8 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 		log.Warn("compact wrong, there's no segments in segment binlogs")
 		return nil, errIllegalCompactionPlan
 
-	case t.plan.GetType() == datapb.CompactionType_MergeCompaction || t.plan.GetType() == datapb.CompactionType_MixCompaction:
+	case t.plan.GetType() == datapb.CompactionType_MergeCompaction || t.plan.GetType() == datapb.CompactionType_MixCompaction ||
+		t.plan.GetType() == datapb.CompactionType_ClusteringCompaction:
 		targetSegID, err = t.AllocOne()
 		if err != nil {
 			log.Warn("compact wrong", zap.Error(err))
@@ -643,6 +672,31 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 		return nil, err
 	}
 
+	if t.plan.GetType() == datapb.CompactionType_ClusteringCompaction {
+		results, err := t.clusteringMerge(ctxTimeout, allPs, targetSegID, partID, meta, deltaPk2Ts, deltaBuf)
+		if err != nil {
+			log.Warn("compact wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
+			return nil, err
+		}
+		t.inject = ti
+
+		log.Info("clustering compaction done",
+			zap.Int64("planID", t.plan.GetPlanID()),
+			zap.Int64("clustering fieldID", t.plan.GetClusteringFieldID()),
+			zap.Int64s("compactedFrom", segIDs),
+			zap.Int("num of segments", len(results)),
+		)
+		log.Info("overall elapse in ms", zap.Int64("planID", t.plan.GetPlanID()), zap.Float64("elapse", nano2Milli(time.Since(compactStart))))
+		metrics.DataNodeCompactionLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Observe(float64(t.tr.ElapseSpan().Milliseconds()))
+
+		return &datapb.CompactionResult{
+			PlanID:             t.plan.GetPlanID(),
+			SegmentID:          targetSegID,
+			Channel:            t.plan.GetChannel(),
+			ClusteringSegments: results,
+		}, nil
+	}
+
 	inPaths, statsPaths, numRows, err := t.merge(ctxTimeout, allPs, targetSegID, partID, meta, deltaPk2Ts)
 	if err != nil {
 		log.Warn("compact wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
@@ -703,6 +757,30 @@ func (t *compactionTask) injectDone() {
 }
 
 // TODO copy maybe expensive, but this seems to be the only convinent way.
+// replaceNullContent replaces the null values in content with the zero value of the data type,
+// and returns the validity of content, nil if there is no null value.
+func replaceNullContent(schemaDataType schemapb.DataType, content []interface{}) ([]bool, error) {
+	var validData []bool
+	for i, c := range content {
+		if c != nil {
+			continue
+		}
+		if validData == nil {
+			validData = make([]bool, len(content))
+			for j := range validData {
+				validData[j] = true
+			}
+		}
+		zero, err := typeutil.GetDefaultValue(&schemapb.FieldSchema{DataType: schemaDataType})
+		if err != nil {
+			return nil, err
+		}
+		content[i] = zero
+		validData[i] = false
+	}
+	return validData, nil
+}
+
 func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}, numRows int64) (storage.FieldData, error) {
 	var rst storage.FieldData
 	switch schemaDataType {
@@ -0,0 +1,403 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+	"golang.org/x/exp/constraints"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// clusteringEntry locates an alive row of the compacted segments by its clustering value.
+type clusteringEntry struct {
+	key    interface{}
+	path   int
+	offset int
+}
+
+// clusteringMerge sorts the alive rows of the compacted segments by the clustering field
+// and writes them into segments of at most maxSegmentRows rows, each covers a contiguous range of the field.
+// The rows with the same value are always written into the same segment, so the ranges never overlap.
+// Only the clustering values are kept while sorting, the rows of a segment are read again when it is written,
+// so at most one segment is held in memory.
+// The first segment is targetSegID, which the flushes injected during compaction are redirected to,
+// the delta data not compacted is written into every segment as the deleted entities may be in any one.
+func (t *compactionTask) clusteringMerge(
+	ctxTimeout context.Context,
+	unMergedInsertlogs [][]string,
+	targetSegID UniqueID,
+	partID UniqueID,
+	meta *etcdpb.CollectionMeta,
+	delta map[interface{}]Timestamp,
+	deltaBuf *DelDataBuf) ([]*datapb.CompactionResult, error) {
+	log := log.With(zap.Int64("planID", t.getPlanID()))
+	mergeStart := time.Now()
+
+	var (
+		pkID         UniqueID
+		pkType       schemapb.DataType
+		clusterField *schemapb.FieldSchema
+
+		fID2Type    = make(map[UniqueID]schemapb.DataType)
+		fID2Default = make(map[UniqueID]interface{})
+
+		entries []clusteringEntry
+		expired int64
+	)
+	for _, fs := range meta.GetSchema().GetFields() {
+		fID2Type[fs.GetFieldID()] = fs.GetDataType()
+		if fs.GetDefaultValue() != nil {
+			defaultValue, err := typeutil.GetDefaultValue(fs)
+			if err != nil {
+				log.Warn("failed to get default value", zap.Int64("fieldID", fs.GetFieldID()), zap.Error(err))
+				return nil, err
+			}
+			fID2Default[fs.GetFieldID()] = defaultValue
+		} else if typeutil.IsFieldNullable(fs) {
+			fID2Default[fs.GetFieldID()] = nil
+		}
+		if fs.GetIsPrimaryKey() && fs.GetFieldID() >= 100 && typeutil.IsPrimaryFieldType(fs.GetDataType()) {
+			pkID = fs.GetFieldID()
+			pkType = fs.GetDataType()
+		}
+		if fs.GetFieldID() == t.plan.GetClusteringFieldID() {
+			clusterField = fs
+		}
+	}
+	if clusterField == nil {
+		log.Warn("clustering field not found", zap.Int64("fieldID", t.plan.GetClusteringFieldID()))
+		return nil, errIllegalCompactionPlan
+	}
+	clusterID := clusterField.GetFieldID()
+
+	size, err := typeutil.EstimateSizePerRecord(meta.GetSchema())
+	if err != nil {
+		log.Warn("failed to estimate size per record", zap.Error(err))
+		return nil, err
+	}
+	maxRowsPerBinlog := int(Params.DataNodeCfg.BinLogMaxSize.GetAsInt64() / int64(size))
+	if Params.DataNodeCfg.BinLogMaxSize.GetAsInt64()%int64(size) != 0 {
+		maxRowsPerBinlog++
+	}
+
+	// the first pass only keeps the clustering value and the location of each alive row
+	currentTs := t.GetCurrentTime()
+	pathRows := make([]int, len(unMergedInsertlogs))
+	for i, path := range unMergedInsertlogs {
+		pathRows[i], err = t.iterateClusteringRows(ctxTimeout, path, pkID, pkType, func(offset int, v *storage.Value, row map[UniqueID]interface{}) {
+			// same as merge, the upsert data shares the ts with its delete
+			if ts, ok := delta[v.PK.GetValue()]; ok && uint64(v.Timestamp) < ts {
+				return
+			}
+			if t.isExpiredEntity(Timestamp(v.Timestamp), currentTs) {
+				expired++
+				return
+			}
+			key, ok := row[clusterID]
+			if !ok {
+				key = fID2Default[clusterID]
+			}
+			entries = append(entries, clusteringEntry{key: key, path: i, offset: offset})
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the entries are appended by path and offset, the stable sort keeps the order of the same value
+	sort.SliceStable(entries, func(i, j int) bool {
+		return compareClusteringValue(entries[i].key, entries[j].key) < 0
+	})
+	positions := make([][]int, len(unMergedInsertlogs))
+	for i, num := range pathRows {
+		positions[i] = make([]int, num)
+		for j := range positions[i] {
+			positions[i][j] = -1
+		}
+	}
+	for i, entry := range entries {
+		positions[entry.path][entry.offset] = i
+	}
+
+	maxSegmentRows := int(t.plan.GetMaxSegmentRows())
+	if maxSegmentRows <= 0 {
+		maxSegmentRows = len(entries)
+	}
+
+	var results []*datapb.CompactionResult
+	for start := 0; start < len(entries) || len(results) == 0; {
+		end := start + maxSegmentRows
+		if end > len(entries) {
+			end = len(entries)
+		}
+		for end < len(entries) && compareClusteringValue(entries[end].key, entries[end-1].key) == 0 {
+			end++
+		}
+
+		segID := targetSegID
+		if len(results) > 0 {
+			segID, err = t.AllocOne()
+			if err != nil {
+				log.Warn("failed to alloc segment ID", zap.Int("start", start), zap.Error(err))
+				return nil, err
+			}
+		}
+
+		// the second pass reads the paths holding the rows of the segment and places the rows in order
+		paths := typeutil.NewUniqueSet()
+		for _, entry := range entries[start:end] {
+			paths.Insert(int64(entry.path))
+		}
+		rows := make([]map[UniqueID]interface{}, end-start)
+		for i, path := range unMergedInsertlogs {
+			if !paths.Contain(int64(i)) {
+				continue
+			}
+			_, err = t.iterateClusteringRows(ctxTimeout, path, pkID, pkType, func(offset int, _ *storage.Value, row map[UniqueID]interface{}) {
+				pos := positions[i][offset]
+				if pos < start || pos >= end {
+					return
+				}
+				for fID, defaultValue := range fID2Default {
+					if _, ok := row[fID]; !ok {
+						row[fID] = defaultValue
+					}
+				}
+				rows[pos-start] = row
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		result, err := t.uploadClusteringSegment(ctxTimeout, segID, partID, meta, rows, maxRowsPerBinlog, fID2Type, deltaBuf)
+		if err != nil {
+			return nil, err
+		}
+		result.ClusteringInfo = newClusteringInfo(clusterField, rows)
+		results = append(results, result)
+		start = end
+	}
+
+	log.Info("clustering merge end",
+		zap.Int("remaining insert numRows", len(entries)),
+		zap.Int64("expired entities", expired),
+		zap.Int("segment number", len(results)),
+		zap.Float64("merge elapse in ms", nano2Milli(time.Since(mergeStart))))
+	return results, nil
+}
+
+// iterateClusteringRows downloads the insert logs and calls fn with the offset of every row, returns the number of rows.
+func (t *compactionTask) iterateClusteringRows(
+	ctxTimeout context.Context,
+	path []string,
+	pkID UniqueID,
+	pkType schemapb.DataType,
+	fn func(offset int, v *storage.Value, row map[UniqueID]interface{})) (int, error) {
+	data, err := t.download(ctxTimeout, path)
+	if err != nil {
+		log.Warn("download insertlogs wrong", zap.Error(err))
+		return 0, err
+	}
+
+	iter, err := storage.NewInsertBinlogIterator(data, pkID, pkType)
+	if err != nil {
+		log.Warn("new insert binlogs Itr wrong", zap.Error(err))
+		return 0, err
+	}
+	offset := 0
+	for iter.HasNext() {
+		vInter, _ := iter.Next()
+		v, ok := vInter.(*storage.Value)
+		if !ok {
+			log.Warn("transfer interface to Value wrong")
+			return 0, errors.New("unexpected error")
+		}
+		row, ok := v.Value.(map[UniqueID]interface{})
+		if !ok {
+			log.Warn("transfer interface to map wrong")
+			return 0, errors.New("unexpected error")
+		}
+		fn(offset, v, row)
+		offset++
+	}
+	return offset, nil
+}
+
+// uploadClusteringSegment writes the rows and the delta data into the segment.
+func (t *compactionTask) uploadClusteringSegment(
+	ctxTimeout context.Context,
+	segID UniqueID,
+	partID UniqueID,
+	meta *etcdpb.CollectionMeta,
+	rows []map[UniqueID]interface{},
+	maxRowsPerBinlog int,
+	fID2Type map[UniqueID]schemapb.DataType,
+	deltaBuf *DelDataBuf) (*datapb.CompactionResult, error) {
+	var (
+		insertField2Path = make(map[UniqueID]*datapb.FieldBinlog)
+		statField2Path   = make(map[UniqueID]*datapb.FieldBinlog)
+	)
+	addFieldPath := func(field2Path map[UniqueID]*datapb.FieldBinlog, paths map[UniqueID]*datapb.FieldBinlog) {
+		for fID, path := range paths {
+			if binlog, ok := field2Path[fID]; ok {
+				binlog.Binlogs = append(binlog.Binlogs, path.GetBinlogs()...)
+			} else {
+				field2Path[fID] = path
+			}
+		}
+	}
+
+	for start := 0; start < len(rows); start += maxRowsPerBinlog {
+		end := start + maxRowsPerBinlog
+		if end > len(rows) {
+			end = len(rows)
+		}
+		fID2Content := make(map[UniqueID][]interface{})
+		for _, row := range rows[start:end] {
+			for fID, v := range row {
+				fID2Content[fID] = append(fID2Content[fID], v)
+			}
+		}
+		inPaths, statsPaths, err := t.uploadSingleInsertLog(ctxTimeout, segID, partID, meta, fID2Content, fID2Type)
+		if err != nil {
+			log.Warn("failed to upload single insert log", zap.Int64("segmentID", segID), zap.Error(err))
+			return nil, err
+		}
+		addFieldPath(insertField2Path, inPaths)
+		addFieldPath(statField2Path, statsPaths)
+	}
+
+	deltaInfo, err := t.uploadDeltaLog(ctxTimeout, segID, partID, deltaBuf.delData, meta)
+	if err != nil {
+		log.Warn("failed to upload delta log", zap.Int64("segmentID", segID), zap.Error(err))
+		return nil, err
+	}
+	for _, fbl := range deltaInfo {
+		for _, deltaLogInfo := range fbl.GetBinlogs() {
+			deltaLogInfo.LogSize = deltaBuf.GetLogSize()
+			deltaLogInfo.TimestampFrom = deltaBuf.GetTimestampFrom()
+			deltaLogInfo.TimestampTo = deltaBuf.GetTimestampTo()
+			deltaLogInfo.EntriesNum = deltaBuf.GetEntriesNum()
+		}
+	}
+
+	result := &datapb.CompactionResult{
+		PlanID:    t.getPlanID(),
+		SegmentID: segID,
+		NumOfRows: int64(len(rows)),
+		Deltalogs: deltaInfo,
+		Channel:   t.plan.GetChannel(),
+	}
+	for _, path := range insertField2Path {
+		result.InsertLogs = append(result.InsertLogs, path)
+	}
+	for _, path := range statField2Path {
+		result.Field2StatslogPaths = append(result.Field2StatslogPaths, path)
+	}
+	return result, nil
+}
+
+// newClusteringInfo returns the range of the clustering field of the sorted rows, null values are ignored.
+func newClusteringInfo(field *schemapb.FieldSchema, rows []map[UniqueID]interface{}) *datapb.ClusteringInfo {
+	info := &datapb.ClusteringInfo{
+		FieldID: field.GetFieldID(),
+	}
+	for _, row := range rows {
+		if v := row[field.GetFieldID()]; v != nil {
+			info.Min = toValueField(v)
+			break
+		}
+	}
+	if len(rows) > 0 {
+		info.Max = toValueField(rows[len(rows)-1][field.GetFieldID()])
+	}
+	return info
+}
+
+func toValueField(v interface{}) *schemapb.ValueField {
+	switch v := v.(type) {
+	case int8:
+		return &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: int32(v)}}
+	case int16:
+		return &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: int32(v)}}
+	case int32:
+		return &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: v}}
+	case int64:
+		return &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: v}}
+	case float32:
+		return &schemapb.ValueField{Data: &schemapb.ValueField_FloatData{FloatData: v}}
+	case float64:
+		return &schemapb.ValueField{Data: &schemapb.ValueField_DoubleData{DoubleData: v}}
+	case string:
+		return &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: v}}
+	default:
+		return nil
+	}
+}
+
+// compareClusteringValue compares two values of the clustering field, null is less than any value.
+func compareClusteringValue(a, b interface{}) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+	switch a := a.(type) {
+	case int8:
+		return compareOrdered(a, b.(int8))
+	case int16:
+		return compareOrdered(a, b.(int16))
+	case int32:
+		return compareOrdered(a, b.(int32))
+	case int64:
+		return compareOrdered(a, b.(int64))
+	case float32:
+		return compareOrdered(a, b.(float32))
+	case float64:
+		return compareOrdered(a, b.(float64))
+	case string:
+		return compareOrdered(a, b.(string))
+	default:
+		return 0
+	}
+}
+
+func compareOrdered[T constraints.Ordered](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
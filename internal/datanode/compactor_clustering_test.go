@@ -0,0 +1,56 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+)
+
+func TestCompareClusteringValue(t *testing.T) {
+	assert.Equal(t, 0, compareClusteringValue(nil, nil))
+	assert.Equal(t, -1, compareClusteringValue(nil, int64(1)))
+	assert.Equal(t, 1, compareClusteringValue(int64(1), nil))
+	assert.Equal(t, -1, compareClusteringValue(int8(1), int8(2)))
+	assert.Equal(t, 1, compareClusteringValue(float32(2), float32(1)))
+	assert.Equal(t, 0, compareClusteringValue(float64(1), float64(1)))
+	assert.Equal(t, -1, compareClusteringValue("a", "b"))
+}
+
+func TestNewClusteringInfo(t *testing.T) {
+	field := &schemapb.FieldSchema{FieldID: 101, DataType: schemapb.DataType_Int64}
+
+	info := newClusteringInfo(field, []map[UniqueID]interface{}{
+		{101: nil},
+		{101: int64(3)},
+		{101: int64(5)},
+	})
+	assert.EqualValues(t, 101, info.GetFieldID())
+	assert.EqualValues(t, 3, info.GetMin().GetLongData())
+	assert.EqualValues(t, 5, info.GetMax().GetLongData())
+
+	info = newClusteringInfo(field, []map[UniqueID]interface{}{{101: nil}})
+	assert.Nil(t, info.GetMin())
+	assert.Nil(t, info.GetMax())
+
+	info = newClusteringInfo(field, nil)
+	assert.Nil(t, info.GetMin())
+	assert.Nil(t, info.GetMax())
+}
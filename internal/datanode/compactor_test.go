@@ -133,6 +133,22 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 
 	})
 
+	t.Run("Test.replaceNullContent", func(t *testing.T) {
+		content := []interface{}{int64(1), int64(2)}
+		validData, err := replaceNullContent(schemapb.DataType_Int64, content)
+		assert.NoError(t, err)
+		assert.Nil(t, validData)
+
+		content = []interface{}{nil, "a", nil}
+		validData, err = replaceNullContent(schemapb.DataType_VarChar, content)
+		assert.NoError(t, err)
+		assert.Equal(t, []bool{false, true, false}, validData)
+		assert.Equal(t, []interface{}{"", "a", ""}, content)
+
+		_, err = replaceNullContent(schemapb.DataType_FloatVector, []interface{}{nil})
+		assert.Error(t, err)
+	})
+
 	t.Run("Test mergeDeltalogs", func(t *testing.T) {
 		t.Run("One segment with timetravel", func(t *testing.T) {
 			invalidBlobs := map[UniqueID][]*Blob{
@@ -390,6 +406,50 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 			assert.Equal(t, 0, len(statsPaths))
 		})
 
+		t.Run("Clustering merge", func(t *testing.T) {
+			mockbIO := &binlogIO{cm, alloc}
+			paramtable.Get().Save(Params.CommonCfg.EntityExpirationTTL.Key, "0")
+			meta := NewMetaFactory().GetCollectionMeta(1, "test", schemapb.DataType_Int64)
+
+			// the same rows are uploaded twice, the equal values are in different paths
+			var allPaths [][]string
+			for i := 0; i < 2; i++ {
+				inpath, _, err := mockbIO.uploadInsertLog(context.Background(), 1, 0, genInsertDataWithExpiredTS(), meta)
+				assert.NoError(t, err)
+				var ps []string
+				for _, path := range inpath {
+					ps = append(ps, path.GetBinlogs()[0].GetLogPath())
+				}
+				allPaths = append(allPaths, ps)
+			}
+
+			segAlloc := allocator.NewMockAllocator(t)
+			segAlloc.EXPECT().AllocOne().Return(3, nil)
+			ct := &compactionTask{
+				Channel:    channel,
+				downloader: mockbIO,
+				uploader:   mockbIO,
+				Allocator:  segAlloc,
+				plan: &datapb.CompactionPlan{
+					Type:              datapb.CompactionType_ClusteringCompaction,
+					ClusteringFieldID: 105,
+					MaxSegmentRows:    1,
+				},
+				done: make(chan struct{}, 1),
+			}
+			results, err := ct.clusteringMerge(context.Background(), allPaths, 2, 0, meta, map[interface{}]Timestamp{}, newDelDataBuf(2))
+			assert.NoError(t, err)
+			assert.Equal(t, 2, len(results))
+			assert.Equal(t, int64(2), results[0].GetSegmentID())
+			assert.Equal(t, int64(2), results[0].GetNumOfRows())
+			assert.Equal(t, int32(9), results[0].GetClusteringInfo().GetMin().GetIntData())
+			assert.Equal(t, int32(9), results[0].GetClusteringInfo().GetMax().GetIntData())
+			assert.Equal(t, int64(3), results[1].GetSegmentID())
+			assert.Equal(t, int64(2), results[1].GetNumOfRows())
+			assert.Equal(t, int32(10), results[1].GetClusteringInfo().GetMin().GetIntData())
+			assert.Equal(t, int32(10), results[1].GetClusteringInfo().GetMax().GetIntData())
+		})
+
 		t.Run("Merge with meta error", func(t *testing.T) {
 			mockbIO := &binlogIO{cm, alloc}
 			paramtable.Get().Save(Params.CommonCfg.EntityExpirationTTL.Key, "0")
@@ -34,7 +34,6 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/golang/protobuf/proto"
-	v3rpc "go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 
@@ -300,7 +299,7 @@ func (node *DataNode) StartWatchChannels(ctx context.Context) {
 			if err := event.Err(); err != nil {
 				log.Warn("datanode watch channel canceled", zap.Error(event.Err()))
 				// https://github.com/etcd-io/etcd/issues/8980
-				if event.Err() == v3rpc.ErrCompacted {
+				if errors.Is(event.Err(), kv.ErrCompacted) {
 					go node.StartWatchChannels(ctx)
 					return
 				}
@@ -332,16 +331,16 @@ func (node *DataNode) checkWatchedList() error {
 }
 
 // handleChannelEvt handles event from kv watch event
-func (node *DataNode) handleChannelEvt(evt *clientv3.Event) {
+func (node *DataNode) handleChannelEvt(evt *kv.Event) {
 	var e *event
 	switch evt.Type {
-	case clientv3.EventTypePut: // datacoord shall put channels needs to be watched here
+	case kv.EventTypePut: // datacoord shall put channels needs to be watched here
 		e = &event{
 			eventType: putEventType,
 			version:   evt.Kv.Version,
 		}
 
-	case clientv3.EventTypeDelete:
+	case kv.EventTypeDelete:
 		e = &event{
 			eventType: deleteEventType,
 			version:   evt.Kv.Version,
@@ -62,6 +62,7 @@ type dataSyncService struct {
 	flushManager     flushManager // flush manager handles flush process
 	chunkManager     storage.ChunkManager
 	compactor        *compactionExecutor // reference to compaction executor
+	wal              *channelWAL         // write-ahead log of the channel, nil if disabled
 
 	serverID      int64
 	stopOnce      sync.Once
@@ -135,6 +136,7 @@ type nodeConfig struct {
 	channel      Channel // Channel info
 	allocator    allocator.Allocator
 	serverID     int64
+	wal          *channelWAL // write-ahead log of the channel, nil if disabled
 	// defaults
 	parallelConfig
 }
@@ -168,6 +170,11 @@ func (dsService *dataSyncService) close() {
 		close(dsService.flushCh)
 		dsService.flushManager.close()
 		dsService.cancelFn()
+		if dsService.wal != nil {
+			if err := dsService.wal.close(); err != nil {
+				log.Warn("dataSyncService failed to close write-ahead log", zap.String("vChanName", dsService.vchannelName), zap.Error(err))
+			}
+		}
 	})
 }
 
@@ -283,6 +290,13 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo, tick
 		return err
 	}
 
+	if Params.DataNodeCfg.WALEnabled.GetAsBool() {
+		dsService.wal, err = openChannelWAL(getWALDir(), vchanInfo.GetChannelName(), Params.DataNodeCfg.WALSegmentSize.GetAsInt64())
+		if err != nil {
+			return err
+		}
+	}
+
 	c := &nodeConfig{
 		msFactory:    dsService.msFactory,
 		collectionID: vchanInfo.GetCollectionID(),
@@ -292,10 +306,11 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo, tick
 
 		parallelConfig: newParallelConfig(),
 		serverID:       dsService.serverID,
+		wal:            dsService.wal,
 	}
 
 	var dmStreamNode Node
-	dmStreamNode, err = newDmInputNode(dsService.dispClient, vchanInfo.GetSeekPosition(), c)
+	dmStreamNode, err = newDmInputNode(dsService.ctx, dsService.dispClient, vchanInfo.GetSeekPosition(), c)
 	if err != nil {
 		return err
 	}
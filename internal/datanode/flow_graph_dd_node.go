@@ -189,7 +189,9 @@ func (ddn *ddNode) Operate(in []Msg) []Msg {
 				continue
 			}
 
-			rateCol.Add(metricsinfo.InsertConsumeThroughput, float64(proto.Size(&imsg.InsertRequest)))
+			insertSize := float64(proto.Size(&imsg.InsertRequest))
+			rateCol.Add(metricsinfo.InsertConsumeThroughput, insertSize)
+			rateCol.Add(channelInsertLabel(ddn.vChannelName), insertSize)
 
 			metrics.DataNodeConsumeBytesCount.
 				WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.InsertLabel).
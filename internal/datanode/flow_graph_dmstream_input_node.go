@@ -17,6 +17,7 @@
 package datanode
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -38,12 +39,20 @@ import (
 //
 // messages between two timeticks to the following flowgraph node. In DataNode, the following flow graph node is
 // flowgraph ddNode.
-func newDmInputNode(dispatcherClient msgdispatcher.Client, seekPos *msgpb.MsgPosition, dmNodeConfig *nodeConfig) (*flowgraph.InputNode, error) {
+func newDmInputNode(ctx context.Context, dispatcherClient msgdispatcher.Client, seekPos *msgpb.MsgPosition, dmNodeConfig *nodeConfig) (*flowgraph.InputNode, error) {
 	log := log.With(zap.Int64("nodeID", paramtable.GetNodeID()),
 		zap.Int64("collection ID", dmNodeConfig.collectionID),
 		zap.String("vchannel", dmNodeConfig.vChannelName))
 	var err error
 	var input <-chan *msgstream.MsgPack
+	var replayed []*msgstream.MsgPack
+	if dmNodeConfig.wal != nil {
+		replayed, seekPos, err = replayWAL(dmNodeConfig.wal, seekPos)
+		if err != nil {
+			return nil, err
+		}
+		log.Info("datanode replayed write-ahead log", zap.Int("packNum", len(replayed)))
+	}
 	if seekPos != nil && len(seekPos.MsgID) != 0 {
 		input, err = dispatcherClient.Register(dmNodeConfig.vChannelName, seekPos, mqwrapper.SubscriptionPositionUnknown)
 		if err != nil {
@@ -61,8 +70,30 @@ func newDmInputNode(dispatcherClient msgdispatcher.Client, seekPos *msgpb.MsgPos
 		log.Info("datanode consume successfully when register to msgDispatcher")
 	}
 
+	if dmNodeConfig.wal != nil {
+		input = dmNodeConfig.wal.forward(ctx, replayed, input)
+	}
+
 	name := fmt.Sprintf("dmInputNode-data-%d-%s", dmNodeConfig.collectionID, dmNodeConfig.vChannelName)
 	node := flowgraph.NewInputNode(input, name, dmNodeConfig.maxQueueLength, dmNodeConfig.maxParallelism,
 		typeutil.DataNodeRole, paramtable.GetNodeID(), dmNodeConfig.collectionID, metrics.AllLabel)
 	return node, nil
 }
+
+// replayWAL returns the message packs after the seek position in the write-ahead log,
+// and the position to consume the message queue from, which is the end of the last replayed pack.
+// The message stream only delivers the messages after the seek position, so nothing is consumed twice.
+func replayWAL(wal *channelWAL, seekPos *msgpb.MsgPosition) ([]*msgstream.MsgPack, *msgpb.MsgPosition, error) {
+	if seekPos == nil || len(seekPos.MsgID) == 0 {
+		// consume from the earliest, the log is useless
+		return nil, seekPos, wal.reset()
+	}
+	packs, err := wal.replay(seekPos.GetTimestamp())
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(packs) == 0 || len(packs[len(packs)-1].EndPositions) == 0 {
+		return nil, seekPos, wal.reset()
+	}
+	return packs, packs[len(packs)-1].EndPositions[0], nil
+}
@@ -31,6 +31,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/datanode/allocator"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/storage"
@@ -578,6 +579,15 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, startP
 		return err
 	}
 
+	// the insert message carries fields unknown to the cached schema, which means fields were added
+	if hasUnknownField(collSchema, msg) {
+		collSchema, err = ibNode.channel.refreshCollectionSchema(collectionID, msg.EndTs())
+		if err != nil {
+			log.Warn("refresh schema wrong:", zap.Error(err))
+			return err
+		}
+	}
+
 	// load or store insertBuffer
 	var buffer *BufferData
 	var loaded bool
@@ -587,6 +597,10 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, startP
 		if err != nil {
 			return fmt.Errorf("newBufferData failed, segment=%d, channel=%s, err=%w", currentSegID, ibNode.channelName, err)
 		}
+	} else if err = storage.FillDefaultFieldData(collSchema, buffer.buffer); err != nil {
+		// rows buffered before fields were added
+		log.Warn("failed to fill default field data", zap.Error(err))
+		return err
 	}
 
 	addedBuffer, err := storage.InsertMsgToInsertData(msg, collSchema)
@@ -625,6 +639,20 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, startP
 	return nil
 }
 
+// hasUnknownField checks whether msg carries fields which are not in schema.
+func hasUnknownField(schema *schemapb.CollectionSchema, msg *msgstream.InsertMsg) bool {
+	fieldIDs := make(map[UniqueID]struct{}, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		fieldIDs[field.GetFieldID()] = struct{}{}
+	}
+	for _, fieldData := range msg.GetFieldsData() {
+		if _, ok := fieldIDs[fieldData.GetFieldId()]; !ok {
+			return true
+		}
+	}
+	return false
+}
+
 func (ibNode *insertBufferNode) getTimestampRange(tsData *storage.Int64FieldData) TimeRange {
 	tr := TimeRange{
 		timestampMin: math.MaxUint64,
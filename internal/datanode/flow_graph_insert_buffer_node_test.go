@@ -1121,6 +1121,24 @@ func TestInsertBufferNode_getTimestampRange(t *testing.T) {
 	}
 }
 
+func TestInsertBufferNode_hasUnknownField(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, DataType: schemapb.DataType_Int64},
+		},
+	}
+	msg := &msgstream.InsertMsg{
+		InsertRequest: msgpb.InsertRequest{
+			FieldsData: []*schemapb.FieldData{{FieldId: 100}, {FieldId: 101}},
+		},
+	}
+	assert.False(t, hasUnknownField(schema, msg))
+
+	msg.FieldsData = append(msg.FieldsData, &schemapb.FieldData{FieldId: 102})
+	assert.True(t, hasUnknownField(schema, msg))
+}
+
 func TestInsertBufferNode_collectSegmentsToSync(t *testing.T) {
 	tests := []struct {
 		description    string
@@ -30,7 +30,9 @@ import (
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/hardware"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
 )
 
 type flowgraphManager struct {
@@ -110,6 +112,7 @@ func (fm *flowgraphManager) addAndStart(dn *DataNode, vchan *datapb.VchannelInfo
 	}
 
 	channel := newChannel(vchan.GetChannelName(), vchan.GetCollectionID(), schema, dn.rootCoord, dn.chunkManager)
+	rateCol.Register(channelInsertLabel(vchan.GetChannelName()))
 
 	dataSyncService, err := newDataSyncService(dn.ctx, make(chan flushMsg, 100), make(chan resendTTMsg, 100), channel,
 		dn.allocator, dn.dispClient, dn.factory, vchan, dn.clearSignal, dn.dataCoord, dn.segmentCache, dn.chunkManager, dn.compactionExecutor, tickler, dn.GetSession().ServerID)
@@ -126,7 +129,14 @@ func (fm *flowgraphManager) addAndStart(dn *DataNode, vchan *datapb.VchannelInfo
 
 func (fm *flowgraphManager) release(vchanName string) {
 	if fg, loaded := fm.flowgraphs.LoadAndDelete(vchanName); loaded {
-		fg.(*dataSyncService).close()
+		dsService := fg.(*dataSyncService)
+		dsService.close()
+		// the channel may be watched by another datanode, the log would be stale if it comes back
+		if dsService.wal != nil {
+			if err := dsService.wal.remove(); err != nil {
+				log.Warn("failed to remove write-ahead log", zap.String("vChanName", vchanName), zap.Error(err))
+			}
+		}
 		metrics.DataNodeNumFlowGraphs.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Dec()
 	}
 	rateCol.removeFlowGraphChannel(vchanName)
@@ -218,6 +228,23 @@ func (fm *flowgraphManager) getFlowGraphNum() int {
 	return length
 }
 
+// getChannelLoads returns the insert throughput and buffer size of each flow graph channel.
+func (fm *flowgraphManager) getChannelLoads() []metricsinfo.ChannelLoadMetric {
+	loads := make([]metricsinfo.ChannelLoadMetric, 0)
+	fm.flowgraphs.Range(func(key, value interface{}) bool {
+		channel := key.(string)
+		// the rate is zero if no data consumed yet
+		rate, _ := rateCol.Rate(channelInsertLabel(channel), ratelimitutil.DefaultAvgDuration)
+		loads = append(loads, metricsinfo.ChannelLoadMetric{
+			Channel:          channel,
+			InsertThroughput: rate,
+			BufferSize:       value.(*dataSyncService).channel.getTotalMemorySize(),
+		})
+		return true
+	})
+	return loads
+}
+
 func (fm *flowgraphManager) dropAll() {
 	log.Info("start drop all flowgraph resources in DataNode")
 	fm.flowgraphs.Range(func(key, value interface{}) bool {
@@ -19,6 +19,8 @@ package datanode
 import (
 	"context"
 	"fmt"
+	"os"
+	"path"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -28,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
 func TestFlowGraphManager(t *testing.T) {
@@ -90,6 +93,28 @@ func TestFlowGraphManager(t *testing.T) {
 		fm.dropAll()
 	})
 
+	t.Run("Test Release removes write-ahead log", func(t *testing.T) {
+		walDir := t.TempDir()
+		paramtable.Get().Save(Params.DataNodeCfg.WALEnabled.Key, "true")
+		paramtable.Get().Save(Params.DataNodeCfg.WALDirPath.Key, walDir)
+		defer paramtable.Get().Reset(Params.DataNodeCfg.WALEnabled.Key)
+		defer paramtable.Get().Reset(Params.DataNodeCfg.WALDirPath.Key)
+
+		vchanName := "by-dev-rootcoord-dml-test-flowgraphmanager-ReleaseWAL"
+		vchan := &datapb.VchannelInfo{
+			CollectionID: 1,
+			ChannelName:  vchanName,
+		}
+		err := fm.addAndStart(node, vchan, nil, genTestTickler())
+		require.NoError(t, err)
+		_, err = os.Stat(path.Join(walDir, vchanName))
+		assert.NoError(t, err)
+
+		fm.release(vchanName)
+		_, err = os.Stat(path.Join(walDir, vchanName))
+		assert.True(t, os.IsNotExist(err))
+	})
+
 	t.Run("Test getChannel", func(t *testing.T) {
 		vchanName := "by-dev-rootcoord-dml-test-flowgraphmanager-getChannel"
 		vchan := &datapb.VchannelInfo{
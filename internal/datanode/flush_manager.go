@@ -749,6 +749,11 @@ func dropVirtualChannelFunc(dsService *dataSyncService, opts ...retry.Option) fl
 			dsService.channel.segmentFlushed(segID)
 			dsService.flushingSegCache.Remove(segID)
 		}
+		if dsService.wal != nil {
+			if err := dsService.wal.remove(); err != nil {
+				log.Warn("failed to remove write-ahead log", zap.String("channel", dsService.vchannelName), zap.Error(err))
+			}
+		}
 	}
 }
 
@@ -866,5 +871,11 @@ func flushNotifyFunc(dsService *dataSyncService, opts ...retry.Option) notifyMet
 		dsService.flushingSegCache.Remove(req.GetSegmentID())
 		dsService.channel.evictHistoryInsertBuffer(req.GetSegmentID(), pack.pos)
 		dsService.channel.evictHistoryDeleteBuffer(req.GetSegmentID(), pack.pos)
+		if dsService.wal != nil && pack.pos != nil {
+			// the messages before channel checkpoint won't be consumed again
+			if err := dsService.wal.trim(dsService.channel.getChannelCheckpoint(pack.pos).GetTimestamp()); err != nil {
+				log.Warn("failed to trim write-ahead log", zap.String("channel", dsService.vchannelName), zap.Error(err))
+			}
+		}
 	}
 }
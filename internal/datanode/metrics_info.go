@@ -57,6 +57,7 @@ func (node *DataNode) getQuotaMetrics() (*metricsinfo.DataNodeQuotaMetrics, erro
 			MinFlowGraphTt:      minFGTt,
 			NumFlowGraph:        node.flowgraphManager.getFlowGraphNum(),
 		},
+		Cms: node.flowgraphManager.getChannelLoads(),
 	}, nil
 }
 
@@ -17,8 +17,10 @@
 package datanode
 
 import (
+	"fmt"
 	"sync"
 
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -50,11 +52,17 @@ func (r *rateCollector) updateFlowGraphTt(channel string, t Timestamp) {
 	r.flowGraphTt[channel] = t
 }
 
-// removeFlowGraphChannel removes channel from flowGraphTt.
+// removeFlowGraphChannel removes channel from flowGraphTt and the insert throughput of channel.
 func (r *rateCollector) removeFlowGraphChannel(channel string) {
 	r.flowGraphTtMu.Lock()
 	defer r.flowGraphTtMu.Unlock()
 	delete(r.flowGraphTt, channel)
+	r.Deregister(channelInsertLabel(channel))
+}
+
+// channelInsertLabel returns the rate label of insert throughput of the channel.
+func channelInsertLabel(channel string) string {
+	return fmt.Sprintf("%s-%s", metricsinfo.InsertConsumeThroughput, channel)
 }
 
 // getMinFlowGraphTt returns the vchannel and minimal time tick of flow graphs.
@@ -40,6 +40,9 @@ type Segment struct {
 	numRows     int64
 	memorySize  int64
 	compactedTo UniqueID
+	// clusteringTo are all the segments produced by the clustering compaction of this segment,
+	// its buffered deletes are dispatched to them by primary key
+	clusteringTo []UniqueID
 
 	curInsertBuf     *BufferData
 	curDeleteBuf     *DelDataBuf
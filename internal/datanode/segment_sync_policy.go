@@ -30,17 +30,25 @@ import (
 
 const minSyncSize = 0.5 * 1024 * 1024
 
+// collectionPropertiesRefreshInterval is the interval to refresh the collection properties overriding sync policies.
+const collectionPropertiesRefreshInterval = time.Minute
+
 // segmentsSyncPolicy sync policy applies to segments
 type segmentSyncPolicy func(segments []*Segment, ts Timestamp, needToSync *atomic.Bool) []UniqueID
 
-// syncPeriodically get segmentSyncPolicy with segments sync periodically.
-func syncPeriodically() segmentSyncPolicy {
+// syncPeriodically get segmentSyncPolicy with segments sync periodically,
+// syncPeriod returns the period of the collection.
+func syncPeriodically(syncPeriod func() time.Duration) segmentSyncPolicy {
 	return func(segments []*Segment, ts Timestamp, _ *atomic.Bool) []UniqueID {
 		segsToSync := make([]UniqueID, 0)
+		if len(segments) == 0 {
+			return segsToSync
+		}
+		period := syncPeriod()
 		for _, seg := range segments {
 			endTime := tsoutil.PhysicalTime(ts)
 			lastSyncTime := tsoutil.PhysicalTime(seg.lastSyncTs)
-			shouldSync := endTime.Sub(lastSyncTime) >= Params.DataNodeCfg.SyncPeriod.GetAsDuration(time.Second) && !seg.isBufferEmpty()
+			shouldSync := endTime.Sub(lastSyncTime) >= period && !seg.isBufferEmpty()
 			if shouldSync {
 				segsToSync = append(segsToSync, seg.segmentID)
 			}
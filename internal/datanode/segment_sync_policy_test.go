@@ -44,7 +44,9 @@ func TestSyncPeriodically(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			policy := syncPeriodically()
+			policy := syncPeriodically(func() time.Duration {
+				return Params.DataNodeCfg.SyncPeriod.GetAsDuration(time.Second)
+			})
 			segment := &Segment{}
 			segment.lastSyncTs = tsoutil.ComposeTSByTime(test.lastTs, 0)
 			if !test.isBufferEmpty {
@@ -341,6 +341,7 @@ func (node *DataNode) SyncSegments(ctx context.Context, req *datapb.SyncSegments
 		zap.Int64("target segmentID", req.GetCompactedTo()),
 		zap.Int64s("compacted from", req.GetCompactedFrom()),
 		zap.Int64("numOfRows", req.GetNumOfRows()),
+		zap.Int("clustering segments", len(req.GetClusteringSegments())),
 	)
 
 	if !node.isHealthy() {
@@ -382,23 +383,35 @@ func (node *DataNode) SyncSegments(ctx context.Context, req *datapb.SyncSegments
 
 	// oneSegment is definitely in the channel, guaranteed by the check before.
 	collID, partID, _ := channel.getCollectionAndPartitionID(oneSegment)
-	targetSeg := &Segment{
-		collectionID: collID,
-		partitionID:  partID,
-		segmentID:    req.GetCompactedTo(),
-		numRows:      req.GetNumOfRows(),
-		lastSyncTs:   tsoutil.GetCurrentTime(),
-	}
-
-	err = channel.InitPKstats(ctx, targetSeg, req.GetStatsLogs(), tsoutil.GetCurrentTime())
-	if err != nil {
-		return merr.Status(err), nil
+	targets := []*datapb.CompactionResult{{
+		SegmentID:           req.GetCompactedTo(),
+		NumOfRows:           req.GetNumOfRows(),
+		Field2StatslogPaths: req.GetStatsLogs(),
+	}}
+	if len(req.GetClusteringSegments()) > 0 {
+		targets = req.GetClusteringSegments()
+	}
+	targetSegs := make([]*Segment, 0, len(targets))
+	for _, target := range targets {
+		targetSeg := &Segment{
+			collectionID: collID,
+			partitionID:  partID,
+			segmentID:    target.GetSegmentID(),
+			numRows:      target.GetNumOfRows(),
+			lastSyncTs:   tsoutil.GetCurrentTime(),
+		}
+		err = channel.InitPKstats(ctx, targetSeg, target.GetField2StatslogPaths(), tsoutil.GetCurrentTime())
+		if err != nil {
+			return merr.Status(err), nil
+		}
+		targetSegs = append(targetSegs, targetSeg)
 	}
 
 	// block all flow graph so it's safe to remove segment
 	ds.fg.Blockall()
 	defer ds.fg.Unblock()
-	if err := channel.mergeFlushedSegments(ctx, targetSeg, req.GetPlanID(), req.GetCompactedFrom()); err != nil {
+	// the buffered deletes of the segments compacted by clustering are dispatched to all the targets by primary key
+	if err := channel.mergeFlushedSegments(ctx, targetSegs[0], req.GetPlanID(), req.GetCompactedFrom(), targetSegs[1:]...); err != nil {
 		return merr.Status(err), nil
 	}
 	node.compactionExecutor.injectDone(req.GetPlanID())
@@ -519,9 +532,13 @@ func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest)
 	if err != nil {
 		return returnFailFunc(err)
 	}
+	fileGroups, err := importutil.ParseFileGroups(req.GetImportTask().GetInfos())
+	if err != nil {
+		return returnFailFunc(err)
+	}
 	log.Info("import time range", zap.Uint64("start_ts", tsStart), zap.Uint64("end_ts", tsEnd))
 	err = importWrapper.Import(req.GetImportTask().GetFiles(),
-		importutil.ImportOptions{OnlyValidate: false, TsStartPoint: tsStart, TsEndPoint: tsEnd, IsBackup: isBackup})
+		importutil.ImportOptions{OnlyValidate: false, TsStartPoint: tsStart, TsEndPoint: tsEnd, IsBackup: isBackup, FileGroups: fileGroups})
 	if err != nil {
 		return returnFailFunc(err)
 	}
@@ -0,0 +1,570 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/msgpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	walFileSuffix = ".wal"
+	// walRecordHeaderSize is the size of the record length and the crc32 of the record
+	walRecordHeaderSize = 8
+)
+
+var (
+	errWALCorrupted = errors.New("write-ahead log corrupted")
+	errWALClosed    = errors.New("write-ahead log closed")
+)
+
+// getWALDir returns the root folder of the write-ahead logs.
+func getWALDir() string {
+	if dir := Params.DataNodeCfg.WALDirPath.GetValue(); dir != "" {
+		return dir
+	}
+	return path.Join(Params.LocalStorageCfg.Path.GetValue(), "datanode_wal")
+}
+
+type walFile struct {
+	seq   int64
+	path  string
+	size  int64
+	maxTs Timestamp // the max end timestamp of the message packs in the file
+}
+
+// channelWAL is the local write-ahead log of the dml messages consumed by a vchannel.
+//
+// A message pack is appended before it is passed to the flowgraph, and the packs after the channel checkpoint
+// are replayed on restart, so the buffered but unflushed data survives even if the message queue has dropped them.
+// The log is split into files of about `segmentSize` bytes, a file is removed as a whole once all its packs are
+// before the channel checkpoint.
+//
+// Each record is a message pack, encoded as [uint32 length][uint32 crc32][payload].
+type channelWAL struct {
+	mu          sync.Mutex
+	channel     string
+	dir         string
+	segmentSize int64
+
+	files    []*walFile // ordered by sequence, the last one is the active file if `active` is not nil
+	active   *os.File
+	nextSeq  int64
+	closed   bool
+	disabled bool // set after an append failure, nothing is logged any more
+}
+
+// openChannelWAL opens the write-ahead log of the channel under the folder,
+// a torn record at the tail is truncated.
+func openChannelWAL(dir string, channel string, segmentSize int64) (*channelWAL, error) {
+	w := &channelWAL{
+		channel:     channel,
+		dir:         path.Join(dir, channel),
+		segmentSize: segmentSize,
+	}
+	if err := os.MkdirAll(w.dir, 0o755); err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(w.dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), walFileSuffix) {
+			continue
+		}
+		seq, err := strconv.ParseInt(strings.TrimSuffix(entry.Name(), walFileSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		w.files = append(w.files, &walFile{seq: seq, path: path.Join(w.dir, entry.Name())})
+	}
+	sort.Slice(w.files, func(i, j int) bool { return w.files[i].seq < w.files[j].seq })
+
+	for i, file := range w.files {
+		size, maxTs, err := scanWALFile(file.path)
+		if err == nil {
+			file.size, file.maxTs = size, maxTs
+			continue
+		}
+		if !errors.Is(err, errWALCorrupted) {
+			return nil, err
+		}
+		// the records after a corrupted one can't be replayed in order, drop them all
+		log.Warn("write-ahead log corrupted, drop the records after it",
+			zap.String("channel", channel), zap.String("file", file.path), zap.Int64("validSize", size), zap.Error(err))
+		if err := os.Truncate(file.path, size); err != nil {
+			return nil, err
+		}
+		file.size, file.maxTs = size, maxTs
+		for _, dropped := range w.files[i+1:] {
+			if err := os.Remove(dropped.path); err != nil {
+				return nil, err
+			}
+		}
+		w.files = w.files[:i+1]
+		break
+	}
+	if len(w.files) > 0 {
+		w.nextSeq = w.files[len(w.files)-1].seq + 1
+	}
+	w.updateSizeMetric()
+	return w, nil
+}
+
+// scanWALFile returns the size of the valid records in the file and their max end timestamp.
+func scanWALFile(filePath string) (int64, Timestamp, error) {
+	var (
+		offset int64
+		maxTs  Timestamp
+	)
+	err := readWALFile(filePath, func(payload []byte) error {
+		if len(payload) < 16 {
+			return fmt.Errorf("%w: record too short", errWALCorrupted)
+		}
+		if endTs := common.Endian.Uint64(payload[8:16]); endTs > maxTs {
+			maxTs = endTs
+		}
+		offset += int64(walRecordHeaderSize + len(payload))
+		return nil
+	})
+	return offset, maxTs, err
+}
+
+// readWALFile calls fn with the payload of each record in the file,
+// errWALCorrupted is returned if a record is torn or its checksum mismatches.
+func readWALFile(filePath string, fn func(payload []byte) error) error {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+	for len(content) > 0 {
+		if len(content) < walRecordHeaderSize {
+			return fmt.Errorf("%w: torn record header", errWALCorrupted)
+		}
+		length := common.Endian.Uint32(content[0:4])
+		checksum := common.Endian.Uint32(content[4:8])
+		if uint64(len(content)-walRecordHeaderSize) < uint64(length) {
+			return fmt.Errorf("%w: torn record", errWALCorrupted)
+		}
+		payload := content[walRecordHeaderSize : walRecordHeaderSize+int(length)]
+		if crc32.ChecksumIEEE(payload) != checksum {
+			return fmt.Errorf("%w: checksum mismatch", errWALCorrupted)
+		}
+		if err := fn(payload); err != nil {
+			return err
+		}
+		content = content[walRecordHeaderSize+int(length):]
+	}
+	return nil
+}
+
+// append writes the message pack into the log, the packs without any message are skipped.
+func (w *channelWAL) append(pack *msgstream.MsgPack) error {
+	payload, err := marshalWALPack(pack)
+	if err != nil || payload == nil {
+		return err
+	}
+	start := time.Now()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return errWALClosed
+	}
+	if w.disabled {
+		return nil
+	}
+	if w.active != nil && w.files[len(w.files)-1].size >= w.segmentSize {
+		if err := w.closeActive(); err != nil {
+			return err
+		}
+	}
+	if w.active == nil {
+		if err := w.openActive(); err != nil {
+			return err
+		}
+	}
+
+	record := make([]byte, walRecordHeaderSize+len(payload))
+	common.Endian.PutUint32(record[0:4], uint32(len(payload)))
+	common.Endian.PutUint32(record[4:8], crc32.ChecksumIEEE(payload))
+	copy(record[walRecordHeaderSize:], payload)
+	file := w.files[len(w.files)-1]
+	if _, err := w.active.Write(record); err != nil {
+		// drop the torn record, so the following records are still readable
+		_ = w.active.Truncate(file.size)
+		_, _ = w.active.Seek(file.size, io.SeekStart)
+		return err
+	}
+	if Params.DataNodeCfg.WALSyncOnWrite.GetAsBool() {
+		if err := w.active.Sync(); err != nil {
+			return err
+		}
+	}
+
+	file.size += int64(len(record))
+	if pack.EndTs > file.maxTs {
+		file.maxTs = pack.EndTs
+	}
+	w.updateSizeMetric()
+	metrics.DataNodeWALAppendLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).
+		Observe(float64(time.Since(start).Milliseconds()))
+	return nil
+}
+
+func (w *channelWAL) openActive() error {
+	filePath := path.Join(w.dir, fmt.Sprintf("%020d%s", w.nextSeq, walFileSuffix))
+	f, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	w.active = f
+	w.files = append(w.files, &walFile{seq: w.nextSeq, path: filePath})
+	w.nextSeq++
+	return nil
+}
+
+func (w *channelWAL) closeActive() error {
+	if w.active == nil {
+		return nil
+	}
+	err := w.active.Close()
+	w.active = nil
+	return err
+}
+
+// replay reads the messages after the timestamp in the log, returns nil if there is nothing to replay.
+func (w *channelWAL) replay(ts Timestamp) ([]*msgstream.MsgPack, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var (
+		packs    []*msgstream.MsgPack
+		msgCount int
+	)
+	for _, file := range w.files {
+		if file.maxTs <= ts {
+			continue
+		}
+		err := readWALFile(file.path, func(payload []byte) error {
+			pack, err := unmarshalWALPack(payload)
+			if err != nil {
+				return err
+			}
+			if pack.EndTs <= ts {
+				return nil
+			}
+			msgs := pack.Msgs[:0]
+			for _, msg := range pack.Msgs {
+				if msg.EndTs() > ts {
+					msgs = append(msgs, msg)
+				}
+			}
+			pack.Msgs = msgs
+			msgCount += len(msgs)
+			packs = append(packs, pack)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	metrics.DataNodeWALReplayMsgCount.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), w.channel).Add(float64(msgCount))
+	return packs, nil
+}
+
+// trim removes the log files whose messages are all before the timestamp.
+func (w *channelWAL) trim(ts Timestamp) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.trimLocked(ts)
+}
+
+func (w *channelWAL) trimLocked(ts Timestamp) error {
+	var i int
+	for i < len(w.files) && w.files[i].maxTs <= ts {
+		if i == len(w.files)-1 {
+			if err := w.closeActive(); err != nil {
+				return err
+			}
+		}
+		if err := os.Remove(w.files[i].path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		i++
+	}
+	w.files = w.files[i:]
+	w.updateSizeMetric()
+	return nil
+}
+
+// reset removes all the log files.
+func (w *channelWAL) reset() error {
+	return w.trim(typeutil.MaxTimestamp)
+}
+
+// disable stops logging and removes all the log files.
+// A log missing some packs can't be replayed, since the message queue is consumed from the end of the last replayed pack,
+// so the channel recovers from its checkpoint in the message queue instead.
+func (w *channelWAL) disable() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return errWALClosed
+	}
+	w.disabled = true
+	return w.trimLocked(typeutil.MaxTimestamp)
+}
+
+// close closes the active log file, the log files are kept for replay.
+func (w *channelWAL) close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.closed = true
+	metrics.DataNodeWALSize.DeleteLabelValues(fmt.Sprint(paramtable.GetNodeID()), w.channel)
+	metrics.DataNodeWALReplayMsgCount.DeleteLabelValues(fmt.Sprint(paramtable.GetNodeID()), w.channel)
+	return w.closeActive()
+}
+
+// remove closes and removes the log of the channel, called when the channel is dropped.
+func (w *channelWAL) remove() error {
+	if err := w.close(); err != nil {
+		return err
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.files = nil
+	return os.RemoveAll(w.dir)
+}
+
+func (w *channelWAL) updateSizeMetric() {
+	var size int64
+	for _, file := range w.files {
+		size += file.size
+	}
+	metrics.DataNodeWALSize.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), w.channel).Set(float64(size))
+}
+
+// forward replays the packs and then passes the packs from input to the returned channel,
+// each pack is appended to the log before it's passed on.
+// The log is disabled if a pack fails to be appended, and the forwarding stops if it can't be disabled either,
+// so a pack is never passed on without being logged unless the log is gone.
+func (w *channelWAL) forward(ctx context.Context, replayed []*msgstream.MsgPack, input <-chan *msgstream.MsgPack) <-chan *msgstream.MsgPack {
+	output := make(chan *msgstream.MsgPack)
+	go func() {
+		defer close(output)
+		for _, pack := range replayed {
+			select {
+			case output <- pack:
+			case <-ctx.Done():
+				return
+			}
+		}
+		for {
+			select {
+			case pack, ok := <-input:
+				if !ok {
+					return
+				}
+				err := w.append(pack)
+				if err != nil && !errors.Is(err, errWALClosed) {
+					// the message queue still has the messages, so keep consuming without the log
+					log.Warn("failed to append write-ahead log, disable it", zap.String("channel", w.channel), zap.Error(err))
+					err = w.disable()
+				}
+				if errors.Is(err, errWALClosed) {
+					// the channel is closing, the pack isn't passed on, so it's consumed again after restart
+					return
+				}
+				if err != nil {
+					log.Error("failed to disable write-ahead log, stop consuming", zap.String("channel", w.channel), zap.Error(err))
+					return
+				}
+				select {
+				case output <- pack:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return output
+}
+
+var walUnmarshalDispatcher = (&msgstream.ProtoUDFactory{}).NewUnmarshalDispatcher()
+
+func writeWALBytes(buf *bytes.Buffer, data []byte) {
+	_ = binary.Write(buf, common.Endian, uint32(len(data)))
+	buf.Write(data)
+}
+
+func readWALBytes(reader *bytes.Reader) ([]byte, error) {
+	var length uint32
+	if err := binary.Read(reader, common.Endian, &length); err != nil {
+		return nil, err
+	}
+	if int64(length) > int64(reader.Len()) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	data := make([]byte, length)
+	_, err := io.ReadFull(reader, data)
+	return data, err
+}
+
+func marshalWALPosition(buf *bytes.Buffer, positions []*msgpb.MsgPosition) error {
+	var data []byte
+	if len(positions) > 0 {
+		var err error
+		if data, err = proto.Marshal(positions[0]); err != nil {
+			return err
+		}
+	}
+	writeWALBytes(buf, data)
+	return nil
+}
+
+func unmarshalWALPosition(reader *bytes.Reader) (*msgpb.MsgPosition, error) {
+	data, err := readWALBytes(reader)
+	if err != nil || len(data) == 0 {
+		return nil, err
+	}
+	position := &msgpb.MsgPosition{}
+	return position, proto.Unmarshal(data, position)
+}
+
+// marshalWALPack encodes the begin/end timestamps and positions and the messages of a pack,
+// it returns nil if the pack has no message to log.
+func marshalWALPack(pack *msgstream.MsgPack) ([]byte, error) {
+	msgs := make([]msgstream.TsMsg, 0, len(pack.Msgs))
+	for _, msg := range pack.Msgs {
+		if _, ok := walUnmarshalDispatcher.TempMap[msg.Type()]; ok {
+			msgs = append(msgs, msg)
+		}
+	}
+	if len(msgs) == 0 {
+		return nil, nil
+	}
+
+	buf := &bytes.Buffer{}
+	_ = binary.Write(buf, common.Endian, pack.BeginTs)
+	_ = binary.Write(buf, common.Endian, pack.EndTs)
+	if err := marshalWALPosition(buf, pack.StartPositions); err != nil {
+		return nil, err
+	}
+	if err := marshalWALPosition(buf, pack.EndPositions); err != nil {
+		return nil, err
+	}
+	_ = binary.Write(buf, common.Endian, uint32(len(msgs)))
+	for _, msg := range msgs {
+		data, err := msg.Marshal(msg)
+		if err != nil {
+			return nil, err
+		}
+		content, ok := data.([]byte)
+		if !ok {
+			return nil, fmt.Errorf("unexpected marshal type %T of msg type %s", data, msg.Type().String())
+		}
+		_ = binary.Write(buf, common.Endian, int32(msg.Type()))
+		var position []byte
+		if msg.Position() != nil {
+			if position, err = proto.Marshal(msg.Position()); err != nil {
+				return nil, err
+			}
+		}
+		writeWALBytes(buf, position)
+		writeWALBytes(buf, content)
+	}
+	return buf.Bytes(), nil
+}
+
+func unmarshalWALPack(payload []byte) (*msgstream.MsgPack, error) {
+	reader := bytes.NewReader(payload)
+	pack := &msgstream.MsgPack{}
+	if err := binary.Read(reader, common.Endian, &pack.BeginTs); err != nil {
+		return nil, err
+	}
+	if err := binary.Read(reader, common.Endian, &pack.EndTs); err != nil {
+		return nil, err
+	}
+	startPosition, err := unmarshalWALPosition(reader)
+	if err != nil {
+		return nil, err
+	}
+	endPosition, err := unmarshalWALPosition(reader)
+	if err != nil {
+		return nil, err
+	}
+	if startPosition != nil {
+		pack.StartPositions = []*msgpb.MsgPosition{startPosition}
+	}
+	if endPosition != nil {
+		pack.EndPositions = []*msgpb.MsgPosition{endPosition}
+	}
+
+	var msgNum uint32
+	if err := binary.Read(reader, common.Endian, &msgNum); err != nil {
+		return nil, err
+	}
+	for i := uint32(0); i < msgNum; i++ {
+		var msgType int32
+		if err := binary.Read(reader, common.Endian, &msgType); err != nil {
+			return nil, err
+		}
+		position, err := unmarshalWALPosition(reader)
+		if err != nil {
+			return nil, err
+		}
+		content, err := readWALBytes(reader)
+		if err != nil {
+			return nil, err
+		}
+		msg, err := walUnmarshalDispatcher.Unmarshal(content, commonpb.MsgType(msgType))
+		if err != nil {
+			return nil, err
+		}
+		msg.SetPosition(position)
+		pack.Msgs = append(pack.Msgs, msg)
+	}
+	return pack, nil
+}
@@ -0,0 +1,230 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/msgpb"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+)
+
+func genWALPack(ts Timestamp) *msgstream.MsgPack {
+	position := &msgpb.MsgPosition{ChannelName: "ch", MsgID: []byte{byte(ts)}, Timestamp: ts}
+	insertMsg := &msgstream.InsertMsg{
+		BaseMsg: msgstream.BaseMsg{BeginTimestamp: ts, EndTimestamp: ts, MsgPosition: position},
+		InsertRequest: msgpb.InsertRequest{
+			Base:       &commonpb.MsgBase{MsgType: commonpb.MsgType_Insert, Timestamp: ts},
+			ShardName:  "ch",
+			SegmentID:  1,
+			Timestamps: []uint64{ts},
+			RowIDs:     []int64{int64(ts)},
+			NumRows:    1,
+		},
+	}
+	deleteMsg := &msgstream.DeleteMsg{
+		BaseMsg: msgstream.BaseMsg{BeginTimestamp: ts, EndTimestamp: ts, MsgPosition: position},
+		DeleteRequest: msgpb.DeleteRequest{
+			Base:       &commonpb.MsgBase{MsgType: commonpb.MsgType_Delete, Timestamp: ts},
+			ShardName:  "ch",
+			Timestamps: []uint64{ts},
+			NumRows:    1,
+		},
+	}
+	return &msgstream.MsgPack{
+		BeginTs:        ts - 5,
+		EndTs:          ts,
+		Msgs:           []msgstream.TsMsg{insertMsg, deleteMsg},
+		StartPositions: []*msgpb.MsgPosition{{ChannelName: "ch", MsgID: []byte{byte(ts - 5)}, Timestamp: ts - 5}},
+		EndPositions:   []*msgpb.MsgPosition{position},
+	}
+}
+
+func TestChannelWAL_AppendReplay(t *testing.T) {
+	dir := t.TempDir()
+	wal, err := openChannelWAL(dir, "ch", 1)
+	require.NoError(t, err)
+
+	for _, ts := range []Timestamp{10, 20, 30} {
+		assert.NoError(t, wal.append(genWALPack(ts)))
+	}
+	// the pack without messages is not logged
+	assert.NoError(t, wal.append(&msgstream.MsgPack{BeginTs: 30, EndTs: 35}))
+	assert.Len(t, wal.files, 3)
+
+	packs, err := wal.replay(15)
+	assert.NoError(t, err)
+	require.Len(t, packs, 2)
+	assert.Equal(t, Timestamp(20), packs[0].EndTs)
+	assert.Equal(t, Timestamp(15), packs[0].BeginTs)
+	assert.Equal(t, Timestamp(20), packs[0].EndPositions[0].GetTimestamp())
+	require.Len(t, packs[0].Msgs, 2)
+	assert.Equal(t, commonpb.MsgType_Insert, packs[0].Msgs[0].Type())
+	assert.Equal(t, []int64{20}, packs[0].Msgs[0].(*msgstream.InsertMsg).GetRowIDs())
+	assert.Equal(t, Timestamp(20), packs[0].Msgs[0].Position().GetTimestamp())
+	assert.Equal(t, commonpb.MsgType_Delete, packs[0].Msgs[1].Type())
+	assert.NoError(t, wal.close())
+	assert.Error(t, wal.append(genWALPack(40)))
+
+	// the log is kept after restart
+	wal, err = openChannelWAL(dir, "ch", 1)
+	require.NoError(t, err)
+	packs, err = wal.replay(0)
+	assert.NoError(t, err)
+	assert.Len(t, packs, 3)
+	assert.NoError(t, wal.append(genWALPack(40)))
+	assert.Len(t, wal.files, 4)
+
+	assert.NoError(t, wal.trim(20))
+	assert.Len(t, wal.files, 2)
+	packs, err = wal.replay(0)
+	assert.NoError(t, err)
+	require.Len(t, packs, 2)
+	assert.Equal(t, Timestamp(30), packs[0].EndTs)
+
+	// the active file is removed too if it's trimmed
+	assert.NoError(t, wal.trim(40))
+	assert.Empty(t, wal.files)
+	assert.NoError(t, wal.append(genWALPack(50)))
+	packs, err = wal.replay(0)
+	assert.NoError(t, err)
+	assert.Len(t, packs, 1)
+
+	assert.NoError(t, wal.remove())
+	_, err = os.Stat(path.Join(dir, "ch"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestChannelWAL_CorruptedTail(t *testing.T) {
+	dir := t.TempDir()
+	wal, err := openChannelWAL(dir, "ch", 1<<20)
+	require.NoError(t, err)
+	assert.NoError(t, wal.append(genWALPack(10)))
+	assert.NoError(t, wal.append(genWALPack(20)))
+	validSize := wal.files[0].size
+	assert.NoError(t, wal.close())
+
+	// a torn record at the tail
+	f, err := os.OpenFile(wal.files[0].path, os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{100, 0, 0, 0, 1, 2})
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+
+	wal, err = openChannelWAL(dir, "ch", 1<<20)
+	require.NoError(t, err)
+	assert.Equal(t, validSize, wal.files[0].size)
+	info, err := os.Stat(wal.files[0].path)
+	assert.NoError(t, err)
+	assert.Equal(t, validSize, info.Size())
+	packs, err := wal.replay(0)
+	assert.NoError(t, err)
+	assert.Len(t, packs, 2)
+
+	// the records are appended into a new file
+	assert.NoError(t, wal.append(genWALPack(30)))
+	assert.Len(t, wal.files, 2)
+	assert.NoError(t, wal.close())
+}
+
+func TestReplayWAL(t *testing.T) {
+	wal, err := openChannelWAL(t.TempDir(), "ch", 1<<20)
+	require.NoError(t, err)
+	defer wal.close()
+	assert.NoError(t, wal.append(genWALPack(10)))
+	assert.NoError(t, wal.append(genWALPack(20)))
+
+	seekPos := &msgpb.MsgPosition{ChannelName: "ch", MsgID: []byte{5}, Timestamp: 10}
+	packs, pos, err := replayWAL(wal, seekPos)
+	assert.NoError(t, err)
+	assert.Len(t, packs, 1)
+	assert.Equal(t, Timestamp(20), pos.GetTimestamp())
+
+	// the packs replayed first, then the packs from input are logged and forwarded
+	input := make(chan *msgstream.MsgPack, 1)
+	input <- genWALPack(30)
+	close(input)
+	var forwarded []Timestamp
+	for pack := range wal.forward(context.Background(), packs, input) {
+		forwarded = append(forwarded, pack.EndTs)
+	}
+	assert.Equal(t, []Timestamp{20, 30}, forwarded)
+
+	// nothing to replay, the log is cleared
+	seekPos.Timestamp = 30
+	packs, pos, err = replayWAL(wal, seekPos)
+	assert.NoError(t, err)
+	assert.Empty(t, packs)
+	assert.Equal(t, seekPos, pos)
+	assert.Empty(t, wal.files)
+
+	packs, pos, err = replayWAL(wal, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, packs)
+	assert.Nil(t, pos)
+}
+
+func TestChannelWAL_ForwardAppendFailure(t *testing.T) {
+	dir := t.TempDir()
+	wal, err := openChannelWAL(dir, "ch", 1)
+	require.NoError(t, err)
+	assert.NoError(t, wal.append(genWALPack(10)))
+
+	// the new log file can't be created any more
+	wal.dir = path.Join(dir, "not_exist", "ch")
+
+	input := make(chan *msgstream.MsgPack, 2)
+	input <- genWALPack(20)
+	input <- genWALPack(30)
+	close(input)
+	var forwarded []Timestamp
+	for pack := range wal.forward(context.Background(), nil, input) {
+		forwarded = append(forwarded, pack.EndTs)
+	}
+	// the packs are still forwarded, and the log is dropped to recover from the checkpoint
+	assert.Equal(t, []Timestamp{20, 30}, forwarded)
+	assert.True(t, wal.disabled)
+	assert.Empty(t, wal.files)
+	packs, err := wal.replay(0)
+	assert.NoError(t, err)
+	assert.Empty(t, packs)
+	assert.NoError(t, wal.close())
+}
+
+func TestChannelWAL_ForwardClosed(t *testing.T) {
+	wal, err := openChannelWAL(t.TempDir(), "ch", 1<<20)
+	require.NoError(t, err)
+	assert.NoError(t, wal.append(genWALPack(10)))
+	assert.NoError(t, wal.close())
+
+	input := make(chan *msgstream.MsgPack, 1)
+	input <- genWALPack(20)
+	var forwarded []Timestamp
+	for pack := range wal.forward(context.Background(), nil, input) {
+		forwarded = append(forwarded, pack.EndTs)
+	}
+	// the pack is not forwarded and the log is kept for replay
+	assert.Empty(t, forwarded)
+	assert.Len(t, wal.files, 1)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/types"
 )
 
@@ -57,6 +58,7 @@ func (h *Handlers) RegisterRoutesTo(router gin.IRouter) {
 	router.DELETE("/entities", wrapHandler(h.handleDelete))
 	router.POST("/search", wrapHandler(h.handleSearch))
 	router.POST("/query", wrapHandler(h.handleQuery))
+	router.POST("/explain", wrapHandler(h.handleExplain))
 
 	router.POST("/persist", wrapHandler(h.handleFlush))
 	router.GET("/distance", wrapHandler(h.handleCalcDistance))
@@ -115,7 +117,9 @@ func (h *Handlers) handleCreateCollection(c *gin.Context) (interface{}, error) {
 		ConsistencyLevel: wrappedReq.ConsistencyLevel,
 		Properties:       wrappedReq.Properties,
 	}
-	return h.proxy.CreateCollection(c, req)
+	resp, err := h.proxy.CreateCollection(c, req)
+	auditRequest(c, "CreateCollection", req, resp, err)
+	return resp, err
 }
 
 func (h *Handlers) handleDropCollection(c *gin.Context) (interface{}, error) {
@@ -124,7 +128,9 @@ func (h *Handlers) handleDropCollection(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.DropCollection(c, &req)
+	resp, err := h.proxy.DropCollection(c, &req)
+	auditRequest(c, "DropCollection", &req, resp, err)
+	return resp, err
 }
 
 func (h *Handlers) handleHasCollection(c *gin.Context) (interface{}, error) {
@@ -187,7 +193,9 @@ func (h *Handlers) handleCreatePartition(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.CreatePartition(c, &req)
+	resp, err := h.proxy.CreatePartition(c, &req)
+	auditRequest(c, "CreatePartition", &req, resp, err)
+	return resp, err
 }
 
 func (h *Handlers) handleDropPartition(c *gin.Context) (interface{}, error) {
@@ -196,7 +204,9 @@ func (h *Handlers) handleDropPartition(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.DropPartition(c, &req)
+	resp, err := h.proxy.DropPartition(c, &req)
+	auditRequest(c, "DropPartition", &req, resp, err)
+	return resp, err
 }
 
 func (h *Handlers) handleHasPartition(c *gin.Context) (interface{}, error) {
@@ -250,7 +260,9 @@ func (h *Handlers) handleCreateAlias(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.CreateAlias(c, &req)
+	resp, err := h.proxy.CreateAlias(c, &req)
+	auditRequest(c, "CreateAlias", &req, resp, err)
+	return resp, err
 }
 
 func (h *Handlers) handleDropAlias(c *gin.Context) (interface{}, error) {
@@ -259,7 +271,9 @@ func (h *Handlers) handleDropAlias(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.DropAlias(c, &req)
+	resp, err := h.proxy.DropAlias(c, &req)
+	auditRequest(c, "DropAlias", &req, resp, err)
+	return resp, err
 }
 
 func (h *Handlers) handleAlterAlias(c *gin.Context) (interface{}, error) {
@@ -268,7 +282,9 @@ func (h *Handlers) handleAlterAlias(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.AlterAlias(c, &req)
+	resp, err := h.proxy.AlterAlias(c, &req)
+	auditRequest(c, "AlterAlias", &req, resp, err)
+	return resp, err
 }
 
 func (h *Handlers) handleCreateIndex(c *gin.Context) (interface{}, error) {
@@ -277,7 +293,9 @@ func (h *Handlers) handleCreateIndex(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.CreateIndex(c, &req)
+	resp, err := h.proxy.CreateIndex(c, &req)
+	auditRequest(c, "CreateIndex", &req, resp, err)
+	return resp, err
 }
 
 func (h *Handlers) handleDescribeIndex(c *gin.Context) (interface{}, error) {
@@ -313,7 +331,9 @@ func (h *Handlers) handleDropIndex(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.DropIndex(c, &req)
+	resp, err := h.proxy.DropIndex(c, &req)
+	auditRequest(c, "DropIndex", &req, resp, err)
+	return resp, err
 }
 
 func (h *Handlers) handleInsert(c *gin.Context) (interface{}, error) {
@@ -335,7 +355,9 @@ func (h *Handlers) handleDelete(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.Delete(c, &req)
+	resp, err := h.proxy.Delete(c, &req)
+	auditRequest(c, "Delete", &req, resp, err)
+	return resp, err
 }
 
 func (h *Handlers) handleSearch(c *gin.Context) (interface{}, error) {
@@ -362,7 +384,7 @@ func (h *Handlers) handleSearch(c *gin.Context) (interface{}, error) {
 	} else {
 		req.PlaceholderGroup = vector2Bytes(wrappedReq.Vectors)
 	}
-	return h.proxy.Search(c, &req)
+	return h.proxy.Search(withHeaderStream(c), &req)
 }
 
 func (h *Handlers) handleQuery(c *gin.Context) (interface{}, error) {
@@ -371,7 +393,16 @@ func (h *Handlers) handleQuery(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.Query(c, &req)
+	return h.proxy.Query(withHeaderStream(c), &req)
+}
+
+func (h *Handlers) handleExplain(c *gin.Context) (interface{}, error) {
+	req := proxypb.ExplainRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.Explain(c, &req)
 }
 
 func (h *Handlers) handleFlush(c *gin.Context) (interface{}, error) {
@@ -486,7 +517,9 @@ func (h *Handlers) handleImport(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.Import(c, &req)
+	resp, err := h.proxy.Import(c, &req)
+	auditRequest(c, "Import", &req, resp, err)
+	return resp, err
 }
 
 func (h *Handlers) handleGetImportState(c *gin.Context) (interface{}, error) {
@@ -513,7 +546,9 @@ func (h *Handlers) handleCreateCredential(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.CreateCredential(c, &req)
+	resp, err := h.proxy.CreateCredential(c, &req)
+	auditRequest(c, "CreateCredential", &req, resp, err)
+	return resp, err
 }
 
 func (h *Handlers) handleUpdateCredential(c *gin.Context) (interface{}, error) {
@@ -522,7 +557,9 @@ func (h *Handlers) handleUpdateCredential(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.UpdateCredential(c, &req)
+	resp, err := h.proxy.UpdateCredential(c, &req)
+	auditRequest(c, "UpdateCredential", &req, resp, err)
+	return resp, err
 }
 
 func (h *Handlers) handleDeleteCredential(c *gin.Context) (interface{}, error) {
@@ -531,7 +568,9 @@ func (h *Handlers) handleDeleteCredential(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.DeleteCredential(c, &req)
+	resp, err := h.proxy.DeleteCredential(c, &req)
+	auditRequest(c, "DeleteCredential", &req, resp, err)
+	return resp, err
 }
 
 func (h *Handlers) handleListCredUsers(c *gin.Context) (interface{}, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -185,6 +186,18 @@ func (m *mockProxyComponent) Query(ctx context.Context, request *milvuspb.QueryR
 	return &queryResult, nil
 }
 
+var explainResult = proxypb.ExplainResponse{
+	Status:   &commonpb.Status{},
+	ExprTree: "expr tree",
+}
+
+func (m *mockProxyComponent) Explain(ctx context.Context, request *proxypb.ExplainRequest) (*proxypb.ExplainResponse, error) {
+	if request.Expr == "" {
+		return nil, errors.New("body parse err")
+	}
+	return &explainResult, nil
+}
+
 var flushResult = milvuspb.FlushResponse{
 	DbName: "default",
 }
@@ -455,6 +468,10 @@ func TestHandlers(t *testing.T) {
 			http.MethodPost, "/query", milvuspb.QueryRequest{Expr: "some expr"},
 			http.StatusOK, &queryResult,
 		},
+		{
+			http.MethodPost, "/explain", proxypb.ExplainRequest{Expr: "some expr"},
+			http.StatusOK, &explainResult,
+		},
 		{
 			http.MethodPost, "/persist", milvuspb.FlushRequest{CollectionNames: []string{"c1"}},
 			http.StatusOK, flushResult,
@@ -1,14 +1,18 @@
 package httpserver
 
 import (
+	"context"
 	"io"
 	"net/http"
 
 	"github.com/cockroachdb/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proxy/accesslog"
 )
 
 var (
@@ -73,3 +77,42 @@ func getBinding(contentType string) binding.Binding {
 		return binding.JSON
 	}
 }
+
+// headerStream implements grpc.ServerTransportStream to forward the grpc headers set by proxy,
+// e.g. the tokens of query and search iterators, to the http response headers.
+type headerStream struct {
+	c *gin.Context
+}
+
+func (s *headerStream) Method() string {
+	return s.c.FullPath()
+}
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	for key, values := range md {
+		for _, value := range values {
+			s.c.Writer.Header().Add(key, value)
+		}
+	}
+	return nil
+}
+
+func (s *headerStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *headerStream) SetTrailer(md metadata.MD) error {
+	return nil
+}
+
+// withHeaderStream returns the context passed to proxy, with which the grpc headers are returned in http.
+func withHeaderStream(c *gin.Context) context.Context {
+	return grpc.NewContextWithServerTransportStream(c, &headerStream{c: c})
+}
+
+// auditRequest records the audited request in the audit log, the RESTful api isn't passed through
+// the grpc interceptors of proxy.
+func auditRequest(c *gin.Context, method string, req interface{}, resp interface{}, err error) {
+	user, _, _ := c.Request.BasicAuth()
+	accesslog.PrintRestAuditInfo(c, method, user, "http-"+c.Request.RemoteAddr, req, resp, err)
+}
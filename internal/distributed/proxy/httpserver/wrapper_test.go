@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/cockroachdb/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -57,3 +59,17 @@ func TestWrapHandler(t *testing.T) {
 	})
 
 }
+
+func TestWithHeaderStream(t *testing.T) {
+	testEngine := gin.New()
+	testEngine.GET("/test", wrapHandler(func(c *gin.Context) (interface{}, error) {
+		err := grpc.SetHeader(withHeaderStream(c), metadata.Pairs("iterator_token", "token"))
+		return gin.H{"status": "ok"}, err
+	}))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+	testEngine.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "token", w.Header().Get("iterator_token"))
+}
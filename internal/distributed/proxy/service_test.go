@@ -654,6 +654,10 @@ func (m *MockProxy) GetQuerySegmentInfo(ctx context.Context, request *milvuspb.G
 	return nil, nil
 }
 
+func (m *MockProxy) Explain(ctx context.Context, request *proxypb.ExplainRequest) (*proxypb.ExplainResponse, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) Dummy(ctx context.Context, request *milvuspb.DummyRequest) (*milvuspb.DummyResponse, error) {
 	return nil, nil
 }
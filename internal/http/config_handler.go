@@ -0,0 +1,174 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/config"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+const defaultAuditLimit = 100
+
+// CredentialVerifier verifies the password of the user
+type CredentialVerifier func(ctx context.Context, username, password string) error
+
+type credentialGetter interface {
+	GetCredential(ctx context.Context, req *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error)
+}
+
+// NewRootCoordCredentialVerifier returns the CredentialVerifier verifying passwords against the credentials in rootcoord.
+func NewRootCoordCredentialVerifier(rc credentialGetter) CredentialVerifier {
+	return func(ctx context.Context, username, password string) error {
+		resp, err := rc.GetCredential(ctx, &rootcoordpb.GetCredentialRequest{Username: username})
+		if err != nil {
+			return err
+		}
+		if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return errors.New(resp.GetStatus().GetReason())
+		}
+		return bcrypt.CompareHashAndPassword([]byte(resp.GetPassword()), []byte(password))
+	}
+}
+
+// ConfigRequest is the body to set a dynamic config.
+type ConfigRequest struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ConfigHandler serves the dynamic configs:
+//
+//	GET    /config?key=a&key=b             get the dynamic configs, all of them if no key is given
+//	PUT    /config {"key":"a","value":"b"} set the dynamic config on all the nodes
+//	DELETE /config?key=a                   reset the dynamic config to the value from other sources
+//
+// Requests must be authenticated as root by basic auth, all changes are audited.
+func ConfigHandler(verify CredentialVerifier) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		operator, ok := AuthenticateRoot(w, req, verify)
+		if !ok {
+			return
+		}
+		params := paramtable.Get()
+		switch req.Method {
+		case http.MethodGet:
+			configs, err := params.GetDynamicConfigs(req.URL.Query()["key"]...)
+			if err != nil {
+				writeConfigError(w, err)
+				return
+			}
+			writeConfigJSON(w, http.StatusOK, configs)
+		case http.MethodPut, http.MethodPost:
+			configReq := &ConfigRequest{}
+			if err := json.NewDecoder(req.Body).Decode(configReq); err != nil {
+				writeConfigJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+				return
+			}
+			err := params.SetDynamicConfig(req.Context(), configReq.Key, configReq.Value, operator, req.RemoteAddr)
+			if err != nil {
+				writeConfigError(w, err)
+				return
+			}
+			writeConfigJSON(w, http.StatusOK, map[string]string{configReq.Key: configReq.Value})
+		case http.MethodDelete:
+			key := req.URL.Query().Get("key")
+			if err := params.ResetDynamicConfig(req.Context(), key, operator, req.RemoteAddr); err != nil {
+				writeConfigError(w, err)
+				return
+			}
+			writeConfigJSON(w, http.StatusOK, map[string]string{})
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}
+}
+
+// ConfigAuditHandler serves the audit trail of dynamic configs, GET /config/audit?limit=100
+func ConfigAuditHandler(verify CredentialVerifier) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if _, ok := AuthenticateRoot(w, req, verify); !ok {
+			return
+		}
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		limit := defaultAuditLimit
+		if limitStr := req.URL.Query().Get("limit"); limitStr != "" {
+			var err error
+			limit, err = strconv.Atoi(limitStr)
+			if err != nil {
+				writeConfigJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+				return
+			}
+		}
+		audits, err := paramtable.Get().ListConfigAudits(req.Context(), limit)
+		if err != nil {
+			writeConfigError(w, err)
+			return
+		}
+		writeConfigJSON(w, http.StatusOK, audits)
+	}
+}
+
+// AuthenticateRoot checks the request is sent by root with the right password by basic auth, and returns the operator.
+// The response is written if it fails.
+func AuthenticateRoot(w http.ResponseWriter, req *http.Request, verify CredentialVerifier) (string, bool) {
+	username, password, ok := req.BasicAuth()
+	if !ok || username != util.UserRoot {
+		w.Header().Set("WWW-Authenticate", `Basic realm="milvus"`)
+		writeConfigJSON(w, http.StatusUnauthorized, map[string]string{"error": fmt.Sprintf("only %s is allowed", util.UserRoot)})
+		return "", false
+	}
+	if err := verify(req.Context(), username, password); err != nil {
+		log.Warn("failed to authenticate request", zap.String("username", username), zap.String("remote", req.RemoteAddr), zap.Error(err))
+		w.Header().Set("WWW-Authenticate", `Basic realm="milvus"`)
+		writeConfigJSON(w, http.StatusUnauthorized, map[string]string{"error": "wrong username or password"})
+		return "", false
+	}
+	return username, true
+}
+
+func writeConfigError(w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	if errors.Is(err, paramtable.ErrNotDynamicConfig) || errors.Is(err, config.ErrInvalidValue) {
+		code = http.StatusBadRequest
+	}
+	writeConfigJSON(w, code, map[string]string{"error": err.Error()})
+}
+
+func writeConfigJSON(w http.ResponseWriter, code int, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		log.Warn("failed to write config response", zap.Error(err))
+	}
+}
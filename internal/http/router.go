@@ -21,3 +21,15 @@ const HealthzRouterPath = "/healthz"
 
 // LogLevelRouterPath is path for Get and Update log level at runtime.
 const LogLevelRouterPath = "/log/level"
+
+// ConfigRouterPath is path for Get, Update and Reset dynamic configs at runtime.
+const ConfigRouterPath = "/config"
+
+// ConfigAuditRouterPath is path for Get the changes of dynamic configs.
+const ConfigAuditRouterPath = "/config/audit"
+
+// ExportRouterPath is path for Create and Get the bulk export tasks of datacoord.
+const ExportRouterPath = "/datacoord/export"
+
+// IndexRebuildRouterPath is path for Create and Get the index rebuild jobs of datacoord.
+const IndexRebuildRouterPath = "/datacoord/index/rebuild"
@@ -25,13 +25,18 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/cockroachdb/errors"
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus/internal/http/healthz"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
 func TestGetHTTPAddr(t *testing.T) {
@@ -125,6 +130,88 @@ func (suite *HTTPServerTestSuite) TestHealthzHandler() {
 	suite.Equal("{\"state\":\"component m2 state is Abnormal\",\"detail\":[{\"name\":\"m1\",\"code\":1},{\"name\":\"m2\",\"code\":2}]}", string(body))
 }
 
+func (suite *HTTPServerTestSuite) TestConfigHandler() {
+	paramtable.Init()
+	params := paramtable.Get()
+	password, err := crypto.PasswordEncrypt("Milvus")
+	suite.NoError(err)
+	rc := &mockCredentialGetter{password: password}
+	handler := ConfigHandler(NewRootCoordCredentialVerifier(rc))
+
+	serveAs := func(username, password string, method string, url string, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, url, bytes.NewBufferString(body))
+		if username != "" {
+			req.SetBasicAuth(username, password)
+		}
+		recorder := httptest.NewRecorder()
+		handler(recorder, req)
+		return recorder
+	}
+	serve := func(method string, url string, body string) *httptest.ResponseRecorder {
+		return serveAs(util.UserRoot, "Milvus", method, url, body)
+	}
+
+	// only root with the right password is allowed
+	resp := serveAs("", "", http.MethodGet, ConfigRouterPath, "")
+	suite.Equal(http.StatusUnauthorized, resp.Code)
+	resp = serveAs("user", "Milvus", http.MethodGet, ConfigRouterPath, "")
+	suite.Equal(http.StatusUnauthorized, resp.Code)
+	resp = serveAs(util.UserRoot, "wrong", http.MethodPut, ConfigRouterPath, `{"key": "common.retentionDuration", "value": "1"}`)
+	suite.Equal(http.StatusUnauthorized, resp.Code)
+	rc.err = errors.New("mock error")
+	resp = serve(http.MethodGet, ConfigRouterPath, "")
+	suite.Equal(http.StatusUnauthorized, resp.Code)
+	rc.err = nil
+
+	resp = serve(http.MethodGet, ConfigRouterPath, "")
+	suite.Equal(http.StatusOK, resp.Code)
+	configs := make(map[string]string)
+	suite.NoError(json.Unmarshal(resp.Body.Bytes(), &configs))
+	suite.Contains(configs, params.CommonCfg.RetentionDuration.Key)
+
+	resp = serve(http.MethodGet, ConfigRouterPath+"?key="+params.CommonCfg.ClusterPrefix.Key, "")
+	suite.Equal(http.StatusBadRequest, resp.Code)
+
+	resp = serve(http.MethodPut, ConfigRouterPath, `{"key": "common.retentionDuration", "value": "-1"}`)
+	suite.Equal(http.StatusBadRequest, resp.Code)
+
+	resp = serve(http.MethodPut, ConfigRouterPath, `{"key": "common.retentionDuration"`)
+	suite.Equal(http.StatusBadRequest, resp.Code)
+
+	resp = serve(http.MethodDelete, ConfigRouterPath+"?key=unknown.key", "")
+	suite.Equal(http.StatusBadRequest, resp.Code)
+
+	resp = serve(http.MethodPatch, ConfigRouterPath, "")
+	suite.Equal(http.StatusMethodNotAllowed, resp.Code)
+
+	auditHandler := ConfigAuditHandler(NewRootCoordCredentialVerifier(rc))
+	recorder := httptest.NewRecorder()
+	auditHandler(recorder, httptest.NewRequest(http.MethodGet, ConfigAuditRouterPath+"?limit=abc", nil))
+	suite.Equal(http.StatusUnauthorized, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, ConfigAuditRouterPath+"?limit=abc", nil)
+	req.SetBasicAuth(util.UserRoot, "Milvus")
+	auditHandler(recorder, req)
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+}
+
+type mockCredentialGetter struct {
+	password string
+	err      error
+}
+
+func (m *mockCredentialGetter) GetCredential(ctx context.Context, req *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &rootcoordpb.GetCredentialResponse{
+		Status:   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Username: req.GetUsername(),
+		Password: m.password,
+	}, nil
+}
+
 func TestHTTPServerSuite(t *testing.T) {
 	suite.Run(t, new(HTTPServerTestSuite))
 }
@@ -387,12 +387,12 @@ func (kv *EmbedEtcdKV) SaveBytes(key string, value []byte) error {
 }
 
 // SaveWithLease is a function to put value in etcd with etcd lease options.
-func (kv *EmbedEtcdKV) SaveWithLease(key, value string, id clientv3.LeaseID) error {
+func (kv *EmbedEtcdKV) SaveWithLease(key, value string, id kv.LeaseID) error {
 	log.Debug("Embedded Etcd saving with lease", zap.String("etcd_key", key))
 	key = path.Join(kv.rootPath, key)
 	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
 	defer cancel()
-	_, err := kv.client.Put(ctx, key, value, clientv3.WithLease(id))
+	_, err := kv.client.Put(ctx, key, value, clientv3.WithLease(clientv3.LeaseID(id)))
 	return err
 }
 
@@ -407,11 +407,11 @@ func (kv *EmbedEtcdKV) SaveWithIgnoreLease(key, value string) error {
 }
 
 // SaveBytesWithLease is a function to put value in etcd with etcd lease options.
-func (kv *EmbedEtcdKV) SaveBytesWithLease(key string, value []byte, id clientv3.LeaseID) error {
+func (kv *EmbedEtcdKV) SaveBytesWithLease(key string, value []byte, id kv.LeaseID) error {
 	key = path.Join(kv.rootPath, key)
 	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
 	defer cancel()
-	_, err := kv.client.Put(ctx, key, string(value), clientv3.WithLease(id))
+	_, err := kv.client.Put(ctx, key, string(value), clientv3.WithLease(clientv3.LeaseID(id)))
 	return err
 }
 
@@ -513,22 +513,28 @@ func (kv *EmbedEtcdKV) MultiSaveBytesAndRemove(saves map[string][]byte, removals
 	return err
 }
 
-func (kv *EmbedEtcdKV) Watch(key string) clientv3.WatchChan {
+func (kv *EmbedEtcdKV) Watch(key string) kv.WatchChan {
 	key = path.Join(kv.rootPath, key)
 	rch := kv.client.Watch(context.Background(), key, clientv3.WithCreatedNotify())
-	return rch
+	return convertWatchChan(context.Background(), rch)
 }
 
-func (kv *EmbedEtcdKV) WatchWithPrefix(key string) clientv3.WatchChan {
+func (kv *EmbedEtcdKV) WatchWithPrefix(key string) kv.WatchChan {
 	key = path.Join(kv.rootPath, key)
 	rch := kv.client.Watch(context.Background(), key, clientv3.WithPrefix(), clientv3.WithCreatedNotify())
-	return rch
+	return convertWatchChan(context.Background(), rch)
 }
 
-func (kv *EmbedEtcdKV) WatchWithRevision(key string, revision int64) clientv3.WatchChan {
+func (kv *EmbedEtcdKV) WatchWithRevision(key string, revision int64) kv.WatchChan {
 	key = path.Join(kv.rootPath, key)
 	rch := kv.client.Watch(context.Background(), key, clientv3.WithPrefix(), clientv3.WithPrevKV(), clientv3.WithRev(revision))
-	return rch
+	return convertWatchChan(context.Background(), rch)
+}
+
+func (kv *EmbedEtcdKV) WatchWithOptions(ctx context.Context, key string, opts ...kv.WatchOption) kv.WatchChan {
+	key = path.Join(kv.rootPath, key)
+	rch := kv.client.Watch(ctx, key, watchOpOptions(opts...)...)
+	return convertWatchChan(ctx, rch)
 }
 
 func (kv *EmbedEtcdKV) MultiRemoveWithPrefix(keys []string) error {
@@ -581,24 +587,35 @@ func (kv *EmbedEtcdKV) MultiSaveBytesAndRemoveWithPrefix(saves map[string][]byte
 }
 
 // Grant creates a new lease implemented in etcd grant interface.
-func (kv *EmbedEtcdKV) Grant(ttl int64) (id clientv3.LeaseID, err error) {
+func (kv *EmbedEtcdKV) Grant(ttl int64) (id kv.LeaseID, err error) {
 	resp, err := kv.client.Grant(context.Background(), ttl)
-	return resp.ID, err
+	if err != nil {
+		return id, err
+	}
+	return convertLeaseID(resp.ID), nil
 }
 
-// KeepAlive keeps the lease alive forever with leaseID.
+// KeepAlive keeps the lease alive until ctx is done with leaseID.
 // Implemented in etcd interface.
-func (kv *EmbedEtcdKV) KeepAlive(id clientv3.LeaseID) (<-chan *clientv3.LeaseKeepAliveResponse, error) {
-	ch, err := kv.client.KeepAlive(context.Background(), id)
+func (kv *EmbedEtcdKV) KeepAlive(ctx context.Context, id kv.LeaseID) (<-chan *kv.LeaseKeepAliveResponse, error) {
+	ch, err := kv.client.KeepAlive(ctx, clientv3.LeaseID(id))
 	if err != nil {
 		return nil, err
 	}
-	return ch, nil
+	return convertKeepAliveChan(ctx, ch), nil
+}
+
+// Revoke revokes the lease, the keys attached to the lease are removed.
+func (kv *EmbedEtcdKV) Revoke(id kv.LeaseID) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
+	defer cancel()
+	_, err := kv.client.Revoke(ctx, clientv3.LeaseID(id))
+	return err
 }
 
 // CompareValueAndSwap compares the existing value with compare, and if they are
 // equal, the target is stored in etcd.
-func (kv *EmbedEtcdKV) CompareValueAndSwap(key, value, target string, opts ...clientv3.OpOption) (bool, error) {
+func (kv *EmbedEtcdKV) CompareValueAndSwap(key, value, target string, opts ...kv.PutOption) (bool, error) {
 	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
 	defer cancel()
 	resp, err := kv.client.Txn(ctx).If(
@@ -606,7 +623,7 @@ func (kv *EmbedEtcdKV) CompareValueAndSwap(key, value, target string, opts ...cl
 			clientv3.Value(path.Join(kv.rootPath, key)),
 			"=",
 			value)).
-		Then(clientv3.OpPut(path.Join(kv.rootPath, key), target, opts...)).Commit()
+		Then(clientv3.OpPut(path.Join(kv.rootPath, key), target, putOpOptions(opts...)...)).Commit()
 	if err != nil {
 		return false, err
 	}
@@ -615,7 +632,7 @@ func (kv *EmbedEtcdKV) CompareValueAndSwap(key, value, target string, opts ...cl
 
 // CompareValueAndSwapBytes compares the existing value with compare, and if they are
 // equal, the target is stored in etcd.
-func (kv *EmbedEtcdKV) CompareValueAndSwapBytes(key string, value, target []byte, opts ...clientv3.OpOption) (bool, error) {
+func (kv *EmbedEtcdKV) CompareValueAndSwapBytes(key string, value, target []byte, opts ...kv.PutOption) (bool, error) {
 	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
 	defer cancel()
 	resp, err := kv.client.Txn(ctx).If(
@@ -623,7 +640,7 @@ func (kv *EmbedEtcdKV) CompareValueAndSwapBytes(key string, value, target []byte
 			clientv3.Value(path.Join(kv.rootPath, key)),
 			"=",
 			string(value))).
-		Then(clientv3.OpPut(path.Join(kv.rootPath, key), string(target), opts...)).Commit()
+		Then(clientv3.OpPut(path.Join(kv.rootPath, key), string(target), putOpOptions(opts...)...)).Commit()
 	if err != nil {
 		return false, err
 	}
@@ -632,7 +649,7 @@ func (kv *EmbedEtcdKV) CompareValueAndSwapBytes(key string, value, target []byte
 
 // CompareVersionAndSwap compares the existing key-value's version with version, and if
 // they are equal, the target is stored in etcd.
-func (kv *EmbedEtcdKV) CompareVersionAndSwap(key string, version int64, target string, opts ...clientv3.OpOption) (bool, error) {
+func (kv *EmbedEtcdKV) CompareVersionAndSwap(key string, version int64, target string, opts ...kv.PutOption) (bool, error) {
 	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
 	defer cancel()
 	resp, err := kv.client.Txn(ctx).If(
@@ -640,7 +657,7 @@ func (kv *EmbedEtcdKV) CompareVersionAndSwap(key string, version int64, target s
 			clientv3.Version(path.Join(kv.rootPath, key)),
 			"=",
 			version)).
-		Then(clientv3.OpPut(path.Join(kv.rootPath, key), target, opts...)).Commit()
+		Then(clientv3.OpPut(path.Join(kv.rootPath, key), target, putOpOptions(opts...)...)).Commit()
 	if err != nil {
 		return false, err
 	}
@@ -649,7 +666,7 @@ func (kv *EmbedEtcdKV) CompareVersionAndSwap(key string, version int64, target s
 
 // CompareVersionAndSwapBytes compares the existing key-value's version with version, and if
 // they are equal, the target is stored in etcd.
-func (kv *EmbedEtcdKV) CompareVersionAndSwapBytes(key string, version int64, target []byte, opts ...clientv3.OpOption) (bool, error) {
+func (kv *EmbedEtcdKV) CompareVersionAndSwapBytes(key string, version int64, target []byte, opts ...kv.PutOption) (bool, error) {
 	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
 	defer cancel()
 	resp, err := kv.client.Txn(ctx).If(
@@ -657,7 +674,7 @@ func (kv *EmbedEtcdKV) CompareVersionAndSwapBytes(key string, version int64, tar
 			clientv3.Version(path.Join(kv.rootPath, key)),
 			"=",
 			version)).
-		Then(clientv3.OpPut(path.Join(kv.rootPath, key), string(target), opts...)).Commit()
+		Then(clientv3.OpPut(path.Join(kv.rootPath, key), string(target), putOpOptions(opts...)...)).Commit()
 	if err != nil {
 		return false, err
 	}
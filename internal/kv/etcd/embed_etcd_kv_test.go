@@ -17,6 +17,7 @@
 package etcdkv_test
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"testing"
@@ -25,9 +26,9 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	clientv3 "go.etcd.io/etcd/client/v3"
 	"golang.org/x/exp/maps"
 
+	"github.com/milvus-io/milvus/internal/kv"
 	embed_etcd_kv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -807,7 +808,7 @@ func TestEmbedEtcd(te *testing.T) {
 			resp := <-ch
 			assert.Equal(t, 1, len(resp.Events))
 			assert.Equal(t, test.secondValue, string(resp.Events[0].Kv.Value))
-			assert.Equal(t, revision+1, resp.Header.Revision)
+			assert.Equal(t, revision+1, resp.Revision)
 		}
 
 		success, err := metaKv.CompareVersionAndSwap("a/b/c", 0, "1")
@@ -863,7 +864,7 @@ func TestEmbedEtcd(te *testing.T) {
 			resp := <-ch
 			assert.Equal(t, 1, len(resp.Events))
 			assert.Equal(t, test.secondValue, resp.Events[0].Kv.Value)
-			assert.Equal(t, revision+1, resp.Header.Revision)
+			assert.Equal(t, revision+1, resp.Revision)
 		}
 
 		success, err := metaKv.CompareVersionAndSwapBytes("a/b/c", 0, []byte("1"))
@@ -899,7 +900,7 @@ func TestEmbedEtcd(te *testing.T) {
 		leaseID, err := metaKv.Grant(10)
 		assert.NoError(t, err)
 
-		metaKv.KeepAlive(leaseID)
+		metaKv.KeepAlive(context.Background(), leaseID)
 
 		tests := map[string]string{
 			"a/b":   "v1",
@@ -915,7 +916,7 @@ func TestEmbedEtcd(te *testing.T) {
 			err = metaKv.SaveWithLease(k, v, leaseID)
 			assert.NoError(t, err)
 
-			err = metaKv.SaveWithLease(k, v, clientv3.LeaseID(999))
+			err = metaKv.SaveWithLease(k, v, kv.LeaseID(999))
 			assert.Error(t, err)
 		}
 
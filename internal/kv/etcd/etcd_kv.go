@@ -26,6 +26,7 @@ import (
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
@@ -379,14 +380,14 @@ func (kv *EtcdKV) SaveBytes(key string, value []byte) error {
 }
 
 // SaveWithLease is a function to put value in etcd with etcd lease options.
-func (kv *EtcdKV) SaveWithLease(key, value string, id clientv3.LeaseID) error {
+func (kv *EtcdKV) SaveWithLease(key, value string, id kv.LeaseID) error {
 	log.Debug("Etcd saving with lease", zap.String("etcd_key", key))
 	start := time.Now()
 	key = path.Join(kv.rootPath, key)
 	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
 	defer cancel()
 	CheckValueSizeAndWarn(key, value)
-	_, err := kv.client.Put(ctx, key, value, clientv3.WithLease(id))
+	_, err := kv.client.Put(ctx, key, value, clientv3.WithLease(clientv3.LeaseID(id)))
 	CheckElapseAndWarn(start, "Slow etcd operation save with lease", zap.String("key", key))
 	return err
 }
@@ -405,13 +406,13 @@ func (kv *EtcdKV) SaveWithIgnoreLease(key, value string) error {
 }
 
 // SaveBytesWithLease is a function to put value in etcd with etcd lease options.
-func (kv *EtcdKV) SaveBytesWithLease(key string, value []byte, id clientv3.LeaseID) error {
+func (kv *EtcdKV) SaveBytesWithLease(key string, value []byte, id kv.LeaseID) error {
 	start := time.Now()
 	key = path.Join(kv.rootPath, key)
 	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
 	defer cancel()
 	CheckValueSizeAndWarn(key, value)
-	_, err := kv.client.Put(ctx, key, string(value), clientv3.WithLease(id))
+	_, err := kv.client.Put(ctx, key, string(value), clientv3.WithLease(clientv3.LeaseID(id)))
 	CheckElapseAndWarn(start, "Slow etcd operation save with lease", zap.String("key", key))
 	return err
 }
@@ -564,30 +565,39 @@ func (kv *EtcdKV) MultiSaveBytesAndRemove(saves map[string][]byte, removals []st
 }
 
 // Watch starts watching a key, returns a watch channel.
-func (kv *EtcdKV) Watch(key string) clientv3.WatchChan {
+func (kv *EtcdKV) Watch(key string) kv.WatchChan {
 	start := time.Now()
 	key = path.Join(kv.rootPath, key)
 	rch := kv.client.Watch(context.Background(), key, clientv3.WithCreatedNotify())
 	CheckElapseAndWarn(start, "Slow etcd operation watch", zap.String("key", key))
-	return rch
+	return convertWatchChan(context.Background(), rch)
 }
 
 // WatchWithPrefix starts watching a key with prefix, returns a watch channel.
-func (kv *EtcdKV) WatchWithPrefix(key string) clientv3.WatchChan {
+func (kv *EtcdKV) WatchWithPrefix(key string) kv.WatchChan {
 	start := time.Now()
 	key = path.Join(kv.rootPath, key)
 	rch := kv.client.Watch(context.Background(), key, clientv3.WithPrefix(), clientv3.WithCreatedNotify())
 	CheckElapseAndWarn(start, "Slow etcd operation watch with prefix", zap.String("key", key))
-	return rch
+	return convertWatchChan(context.Background(), rch)
 }
 
 // WatchWithRevision starts watching a key with revision, returns a watch channel.
-func (kv *EtcdKV) WatchWithRevision(key string, revision int64) clientv3.WatchChan {
+func (kv *EtcdKV) WatchWithRevision(key string, revision int64) kv.WatchChan {
 	start := time.Now()
 	key = path.Join(kv.rootPath, key)
 	rch := kv.client.Watch(context.Background(), key, clientv3.WithPrefix(), clientv3.WithPrevKV(), clientv3.WithRev(revision))
 	CheckElapseAndWarn(start, "Slow etcd operation watch with revision", zap.String("key", key))
-	return rch
+	return convertWatchChan(context.Background(), rch)
+}
+
+// WatchWithOptions starts watching a key with options, the watch is canceled once ctx is done.
+func (kv *EtcdKV) WatchWithOptions(ctx context.Context, key string, opts ...kv.WatchOption) kv.WatchChan {
+	start := time.Now()
+	key = path.Join(kv.rootPath, key)
+	rch := kv.client.Watch(ctx, key, watchOpOptions(opts...)...)
+	CheckElapseAndWarn(start, "Slow etcd operation watch with options", zap.String("key", key))
+	return convertWatchChan(ctx, rch)
 }
 
 // MultiRemoveWithPrefix removes the keys with given prefix.
@@ -670,28 +680,41 @@ func (kv *EtcdKV) MultiSaveBytesAndRemoveWithPrefix(saves map[string][]byte, rem
 }
 
 // Grant creates a new lease implemented in etcd grant interface.
-func (kv *EtcdKV) Grant(ttl int64) (id clientv3.LeaseID, err error) {
+func (kv *EtcdKV) Grant(ttl int64) (id kv.LeaseID, err error) {
 	start := time.Now()
 	resp, err := kv.client.Grant(context.Background(), ttl)
+	if err != nil {
+		return id, err
+	}
 	CheckElapseAndWarn(start, "Slow etcd operation grant")
-	return resp.ID, err
+	return convertLeaseID(resp.ID), nil
 }
 
-// KeepAlive keeps the lease alive forever with leaseID.
+// KeepAlive keeps the lease alive until ctx is done with leaseID.
 // Implemented in etcd interface.
-func (kv *EtcdKV) KeepAlive(id clientv3.LeaseID) (<-chan *clientv3.LeaseKeepAliveResponse, error) {
+func (kv *EtcdKV) KeepAlive(ctx context.Context, id kv.LeaseID) (<-chan *kv.LeaseKeepAliveResponse, error) {
 	start := time.Now()
-	ch, err := kv.client.KeepAlive(context.Background(), id)
+	ch, err := kv.client.KeepAlive(ctx, clientv3.LeaseID(id))
 	if err != nil {
 		return nil, err
 	}
 	CheckElapseAndWarn(start, "Slow etcd operation keepAlive")
-	return ch, nil
+	return convertKeepAliveChan(ctx, ch), nil
+}
+
+// Revoke revokes the lease, the keys attached to the lease are removed.
+func (kv *EtcdKV) Revoke(id kv.LeaseID) error {
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
+	defer cancel()
+	_, err := kv.client.Revoke(ctx, clientv3.LeaseID(id))
+	CheckElapseAndWarn(start, "Slow etcd operation revoke")
+	return err
 }
 
 // CompareValueAndSwap compares the existing value with compare, and if they are
 // equal, the target is stored in etcd.
-func (kv *EtcdKV) CompareValueAndSwap(key, value, target string, opts ...clientv3.OpOption) (bool, error) {
+func (kv *EtcdKV) CompareValueAndSwap(key, value, target string, opts ...kv.PutOption) (bool, error) {
 	start := time.Now()
 	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
 	defer cancel()
@@ -700,7 +723,7 @@ func (kv *EtcdKV) CompareValueAndSwap(key, value, target string, opts ...clientv
 			clientv3.Value(path.Join(kv.rootPath, key)),
 			"=",
 			value)).
-		Then(clientv3.OpPut(path.Join(kv.rootPath, key), target, opts...)).Commit()
+		Then(clientv3.OpPut(path.Join(kv.rootPath, key), target, putOpOptions(opts...)...)).Commit()
 	if err != nil {
 		return false, err
 	}
@@ -710,7 +733,7 @@ func (kv *EtcdKV) CompareValueAndSwap(key, value, target string, opts ...clientv
 
 // CompareValueAndSwapBytes compares the existing value with compare, and if they are
 // equal, the target is stored in etcd.
-func (kv *EtcdKV) CompareValueAndSwapBytes(key string, value, target []byte, opts ...clientv3.OpOption) (bool, error) {
+func (kv *EtcdKV) CompareValueAndSwapBytes(key string, value, target []byte, opts ...kv.PutOption) (bool, error) {
 	start := time.Now()
 	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
 	defer cancel()
@@ -719,7 +742,7 @@ func (kv *EtcdKV) CompareValueAndSwapBytes(key string, value, target []byte, opt
 			clientv3.Value(path.Join(kv.rootPath, key)),
 			"=",
 			string(value))).
-		Then(clientv3.OpPut(path.Join(kv.rootPath, key), string(target), opts...)).Commit()
+		Then(clientv3.OpPut(path.Join(kv.rootPath, key), string(target), putOpOptions(opts...)...)).Commit()
 	if err != nil {
 		return false, err
 	}
@@ -729,7 +752,7 @@ func (kv *EtcdKV) CompareValueAndSwapBytes(key string, value, target []byte, opt
 
 // CompareVersionAndSwap compares the existing key-value's version with version, and if
 // they are equal, the target is stored in etcd.
-func (kv *EtcdKV) CompareVersionAndSwap(key string, source int64, target string, opts ...clientv3.OpOption) (bool, error) {
+func (kv *EtcdKV) CompareVersionAndSwap(key string, source int64, target string, opts ...kv.PutOption) (bool, error) {
 	start := time.Now()
 	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
 	defer cancel()
@@ -738,7 +761,7 @@ func (kv *EtcdKV) CompareVersionAndSwap(key string, source int64, target string,
 			clientv3.Version(path.Join(kv.rootPath, key)),
 			"=",
 			source)).
-		Then(clientv3.OpPut(path.Join(kv.rootPath, key), target, opts...)).Commit()
+		Then(clientv3.OpPut(path.Join(kv.rootPath, key), target, putOpOptions(opts...)...)).Commit()
 	if err != nil {
 		return false, err
 	}
@@ -748,7 +771,7 @@ func (kv *EtcdKV) CompareVersionAndSwap(key string, source int64, target string,
 
 // CompareVersionAndSwapBytes compares the existing key-value's version with version, and if
 // they are equal, the target is stored in etcd.
-func (kv *EtcdKV) CompareVersionAndSwapBytes(key string, source int64, target []byte, opts ...clientv3.OpOption) (bool, error) {
+func (kv *EtcdKV) CompareVersionAndSwapBytes(key string, source int64, target []byte, opts ...kv.PutOption) (bool, error) {
 	start := time.Now()
 	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
 	defer cancel()
@@ -757,7 +780,7 @@ func (kv *EtcdKV) CompareVersionAndSwapBytes(key string, source int64, target []
 			clientv3.Version(path.Join(kv.rootPath, key)),
 			"=",
 			source)).
-		Then(clientv3.OpPut(path.Join(kv.rootPath, key), string(target), opts...)).Commit()
+		Then(clientv3.OpPut(path.Join(kv.rootPath, key), string(target), putOpOptions(opts...)...)).Commit()
 	if err != nil {
 		return false, err
 	}
@@ -17,6 +17,7 @@
 package etcdkv_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"sort"
@@ -26,9 +27,9 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	clientv3 "go.etcd.io/etcd/client/v3"
 	"golang.org/x/exp/maps"
 
+	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
@@ -740,7 +741,7 @@ func TestEtcdKV_Load(te *testing.T) {
 			resp := <-ch
 			assert.Equal(t, 1, len(resp.Events))
 			assert.Equal(t, test.secondValue, string(resp.Events[0].Kv.Value))
-			assert.Equal(t, revision+1, resp.Header.Revision)
+			assert.Equal(t, revision+1, resp.Revision)
 		}
 
 		success, err := etcdKV.CompareVersionAndSwap("a/b/c", 0, "1")
@@ -793,7 +794,7 @@ func TestEtcdKV_Load(te *testing.T) {
 			resp := <-ch
 			assert.Equal(t, 1, len(resp.Events))
 			assert.Equal(t, string(test.secondValue), string(resp.Events[0].Kv.Value))
-			assert.Equal(t, revision+1, resp.Header.Revision)
+			assert.Equal(t, revision+1, resp.Revision)
 		}
 
 		success, err := etcdKV.CompareVersionAndSwapBytes("a/b/c", 0, []byte("1"))
@@ -827,7 +828,7 @@ func TestEtcdKV_Load(te *testing.T) {
 		leaseID, err := etcdKV.Grant(10)
 		assert.NoError(t, err)
 
-		etcdKV.KeepAlive(leaseID)
+		etcdKV.KeepAlive(context.Background(), leaseID)
 
 		tests := map[string]string{
 			"a/b":   "v1",
@@ -843,7 +844,7 @@ func TestEtcdKV_Load(te *testing.T) {
 			err = etcdKV.SaveWithLease(k, v, leaseID)
 			assert.NoError(t, err)
 
-			err = etcdKV.SaveWithLease(k, v, clientv3.LeaseID(999))
+			err = etcdKV.SaveWithLease(k, v, kv.LeaseID(999))
 			assert.Error(t, err)
 		}
 	})
@@ -895,7 +896,7 @@ func TestEtcdKV_Load(te *testing.T) {
 		leaseID, err := etcdKV.Grant(10)
 		assert.NoError(t, err)
 
-		etcdKV.KeepAlive(leaseID)
+		etcdKV.KeepAlive(context.Background(), leaseID)
 
 		tests := map[string][]byte{
 			"a/b":   []byte("v1"),
@@ -907,7 +908,7 @@ func TestEtcdKV_Load(te *testing.T) {
 			err = etcdKV.SaveBytesWithLease(k, v, leaseID)
 			assert.NoError(t, err)
 
-			err = etcdKV.SaveBytesWithLease(k, v, clientv3.LeaseID(999))
+			err = etcdKV.SaveBytesWithLease(k, v, kv.LeaseID(999))
 			assert.Error(t, err)
 		}
 	})
@@ -0,0 +1,134 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdkv
+
+import (
+	"context"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	v3rpc "go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/milvus-io/milvus/internal/kv"
+)
+
+// watchOpOptions converts the watch options to etcd op options.
+func watchOpOptions(opts ...kv.WatchOption) []clientv3.OpOption {
+	watchOpts := kv.NewWatchOptions(opts...)
+	ret := make([]clientv3.OpOption, 0, 3)
+	if watchOpts.Prefix {
+		ret = append(ret, clientv3.WithPrefix())
+	}
+	if watchOpts.Revision > 0 {
+		ret = append(ret, clientv3.WithRev(watchOpts.Revision))
+	}
+	if watchOpts.PrevKV {
+		ret = append(ret, clientv3.WithPrevKV())
+	}
+	return ret
+}
+
+// putOpOptions converts the put options to etcd op options.
+func putOpOptions(opts ...kv.PutOption) []clientv3.OpOption {
+	putOpts := kv.NewPutOptions(opts...)
+	if putOpts.LeaseID != kv.NoLease {
+		return []clientv3.OpOption{clientv3.WithLease(clientv3.LeaseID(putOpts.LeaseID))}
+	}
+	return nil
+}
+
+func convertLeaseID(id clientv3.LeaseID) kv.LeaseID {
+	return kv.LeaseID(id)
+}
+
+func convertKeyValue(kvs *mvccpb.KeyValue) *kv.KeyValue {
+	if kvs == nil {
+		return nil
+	}
+	return &kv.KeyValue{
+		Key:            kvs.Key,
+		Value:          kvs.Value,
+		CreateRevision: kvs.CreateRevision,
+		ModRevision:    kvs.ModRevision,
+		Version:        kvs.Version,
+		Lease:          convertLeaseID(clientv3.LeaseID(kvs.Lease)),
+	}
+}
+
+func convertWatchResponse(resp clientv3.WatchResponse) kv.WatchResponse {
+	ret := kv.WatchResponse{
+		Events:          make([]*kv.Event, 0, len(resp.Events)),
+		Revision:        resp.Header.GetRevision(),
+		Created:         resp.Created,
+		CompactRevision: resp.CompactRevision,
+		Canceled:        resp.Canceled,
+	}
+	for _, evt := range resp.Events {
+		eventType := kv.EventTypePut
+		if evt.Type == mvccpb.DELETE {
+			eventType = kv.EventTypeDelete
+		}
+		ret.Events = append(ret.Events, &kv.Event{
+			Type:   eventType,
+			Kv:     convertKeyValue(evt.Kv),
+			PrevKv: convertKeyValue(evt.PrevKv),
+		})
+	}
+	// keep the errors which are not represented by the fields
+	if err := resp.Err(); err != nil && err != v3rpc.ErrCompacted {
+		ret.Canceled = true
+		ret.CancelReason = err.Error()
+	}
+	return ret
+}
+
+// convertWatchChan forwards the etcd watch responses as kv watch responses,
+// the returned channel is closed once the etcd watch channel is closed or ctx is done.
+func convertWatchChan(ctx context.Context, rch clientv3.WatchChan) kv.WatchChan {
+	ch := make(chan kv.WatchResponse)
+	go func() {
+		defer close(ch)
+		for resp := range rch {
+			select {
+			case ch <- convertWatchResponse(resp):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// convertKeepAliveChan forwards the etcd keep alive responses,
+// the returned channel is closed once the etcd keep alive channel is closed or ctx is done.
+func convertKeepAliveChan(ctx context.Context, kch <-chan *clientv3.LeaseKeepAliveResponse) <-chan *kv.LeaseKeepAliveResponse {
+	ch := make(chan *kv.LeaseKeepAliveResponse, 1)
+	go func() {
+		defer close(ch)
+		for resp := range kch {
+			if resp == nil {
+				return
+			}
+			select {
+			case ch <- &kv.LeaseKeepAliveResponse{ID: convertLeaseID(resp.ID), TTL: resp.TTL}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
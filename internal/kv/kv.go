@@ -17,7 +17,7 @@
 package kv
 
 import (
-	clientv3 "go.etcd.io/etcd/client/v3"
+	"context"
 
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -70,15 +70,18 @@ type MetaKv interface {
 	LoadWithPrefix2(key string) ([]string, []string, []int64, error)
 	LoadWithRevisionAndVersions(key string) ([]string, []string, []int64, int64, error)
 	LoadWithRevision(key string) ([]string, []string, int64, error)
-	Watch(key string) clientv3.WatchChan
-	WatchWithPrefix(key string) clientv3.WatchChan
-	WatchWithRevision(key string, revision int64) clientv3.WatchChan
-	SaveWithLease(key, value string, id clientv3.LeaseID) error
+	Watch(key string) WatchChan
+	WatchWithPrefix(key string) WatchChan
+	WatchWithRevision(key string, revision int64) WatchChan
+	// WatchWithOptions watches the key until ctx is done, the key is relative to the root path.
+	WatchWithOptions(ctx context.Context, key string, opts ...WatchOption) WatchChan
+	SaveWithLease(key, value string, id LeaseID) error
 	SaveWithIgnoreLease(key, value string) error
-	Grant(ttl int64) (id clientv3.LeaseID, err error)
-	KeepAlive(id clientv3.LeaseID) (<-chan *clientv3.LeaseKeepAliveResponse, error)
-	CompareValueAndSwap(key, value, target string, opts ...clientv3.OpOption) (bool, error)
-	CompareVersionAndSwap(key string, version int64, target string, opts ...clientv3.OpOption) (bool, error)
+	Grant(ttl int64) (id LeaseID, err error)
+	KeepAlive(ctx context.Context, id LeaseID) (<-chan *LeaseKeepAliveResponse, error)
+	Revoke(id LeaseID) error
+	CompareValueAndSwap(key, value, target string, opts ...PutOption) (bool, error)
+	CompareVersionAndSwap(key string, version int64, target string, opts ...PutOption) (bool, error)
 	WalkWithPrefix(prefix string, paginationSize int, fn func([]byte, []byte) error) error
 }
 
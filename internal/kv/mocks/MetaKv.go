@@ -3,8 +3,9 @@
 package mocks
 
 import (
-	clientv3 "go.etcd.io/etcd/client/v3"
+	context "context"
 
+	kv "github.com/milvus-io/milvus/internal/kv"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -49,7 +50,7 @@ func (_c *MetaKv_Close_Call) Return() *MetaKv_Close_Call {
 }
 
 // CompareValueAndSwap provides a mock function with given fields: key, value, target, opts
-func (_m *MetaKv) CompareValueAndSwap(key string, value string, target string, opts ...clientv3.OpOption) (bool, error) {
+func (_m *MetaKv) CompareValueAndSwap(key string, value string, target string, opts ...kv.PutOption) (bool, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -60,14 +61,14 @@ func (_m *MetaKv) CompareValueAndSwap(key string, value string, target string, o
 	ret := _m.Called(_ca...)
 
 	var r0 bool
-	if rf, ok := ret.Get(0).(func(string, string, string, ...clientv3.OpOption) bool); ok {
+	if rf, ok := ret.Get(0).(func(string, string, string, ...kv.PutOption) bool); ok {
 		r0 = rf(key, value, target, opts...)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, string, ...clientv3.OpOption) error); ok {
+	if rf, ok := ret.Get(1).(func(string, string, string, ...kv.PutOption) error); ok {
 		r1 = rf(key, value, target, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -85,18 +86,18 @@ type MetaKv_CompareValueAndSwap_Call struct {
 //   - key string
 //   - value string
 //   - target string
-//   - opts ...clientv3.OpOption
+//   - opts ...kv.PutOption
 func (_e *MetaKv_Expecter) CompareValueAndSwap(key interface{}, value interface{}, target interface{}, opts ...interface{}) *MetaKv_CompareValueAndSwap_Call {
 	return &MetaKv_CompareValueAndSwap_Call{Call: _e.mock.On("CompareValueAndSwap",
 		append([]interface{}{key, value, target}, opts...)...)}
 }
 
-func (_c *MetaKv_CompareValueAndSwap_Call) Run(run func(key string, value string, target string, opts ...clientv3.OpOption)) *MetaKv_CompareValueAndSwap_Call {
+func (_c *MetaKv_CompareValueAndSwap_Call) Run(run func(key string, value string, target string, opts ...kv.PutOption)) *MetaKv_CompareValueAndSwap_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]clientv3.OpOption, len(args)-3)
+		variadicArgs := make([]kv.PutOption, len(args)-3)
 		for i, a := range args[3:] {
 			if a != nil {
-				variadicArgs[i] = a.(clientv3.OpOption)
+				variadicArgs[i] = a.(kv.PutOption)
 			}
 		}
 		run(args[0].(string), args[1].(string), args[2].(string), variadicArgs...)
@@ -110,7 +111,7 @@ func (_c *MetaKv_CompareValueAndSwap_Call) Return(_a0 bool, _a1 error) *MetaKv_C
 }
 
 // CompareVersionAndSwap provides a mock function with given fields: key, version, target, opts
-func (_m *MetaKv) CompareVersionAndSwap(key string, version int64, target string, opts ...clientv3.OpOption) (bool, error) {
+func (_m *MetaKv) CompareVersionAndSwap(key string, version int64, target string, opts ...kv.PutOption) (bool, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -121,14 +122,14 @@ func (_m *MetaKv) CompareVersionAndSwap(key string, version int64, target string
 	ret := _m.Called(_ca...)
 
 	var r0 bool
-	if rf, ok := ret.Get(0).(func(string, int64, string, ...clientv3.OpOption) bool); ok {
+	if rf, ok := ret.Get(0).(func(string, int64, string, ...kv.PutOption) bool); ok {
 		r0 = rf(key, version, target, opts...)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, int64, string, ...clientv3.OpOption) error); ok {
+	if rf, ok := ret.Get(1).(func(string, int64, string, ...kv.PutOption) error); ok {
 		r1 = rf(key, version, target, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -146,18 +147,18 @@ type MetaKv_CompareVersionAndSwap_Call struct {
 //   - key string
 //   - version int64
 //   - target string
-//   - opts ...clientv3.OpOption
+//   - opts ...kv.PutOption
 func (_e *MetaKv_Expecter) CompareVersionAndSwap(key interface{}, version interface{}, target interface{}, opts ...interface{}) *MetaKv_CompareVersionAndSwap_Call {
 	return &MetaKv_CompareVersionAndSwap_Call{Call: _e.mock.On("CompareVersionAndSwap",
 		append([]interface{}{key, version, target}, opts...)...)}
 }
 
-func (_c *MetaKv_CompareVersionAndSwap_Call) Run(run func(key string, version int64, target string, opts ...clientv3.OpOption)) *MetaKv_CompareVersionAndSwap_Call {
+func (_c *MetaKv_CompareVersionAndSwap_Call) Run(run func(key string, version int64, target string, opts ...kv.PutOption)) *MetaKv_CompareVersionAndSwap_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]clientv3.OpOption, len(args)-3)
+		variadicArgs := make([]kv.PutOption, len(args)-3)
 		for i, a := range args[3:] {
 			if a != nil {
-				variadicArgs[i] = a.(clientv3.OpOption)
+				variadicArgs[i] = a.(kv.PutOption)
 			}
 		}
 		run(args[0].(string), args[1].(int64), args[2].(string), variadicArgs...)
@@ -208,14 +209,14 @@ func (_c *MetaKv_GetPath_Call) Return(_a0 string) *MetaKv_GetPath_Call {
 }
 
 // Grant provides a mock function with given fields: ttl
-func (_m *MetaKv) Grant(ttl int64) (clientv3.LeaseID, error) {
+func (_m *MetaKv) Grant(ttl int64) (kv.LeaseID, error) {
 	ret := _m.Called(ttl)
 
-	var r0 clientv3.LeaseID
-	if rf, ok := ret.Get(0).(func(int64) clientv3.LeaseID); ok {
+	var r0 kv.LeaseID
+	if rf, ok := ret.Get(0).(func(int64) kv.LeaseID); ok {
 		r0 = rf(ttl)
 	} else {
-		r0 = ret.Get(0).(clientv3.LeaseID)
+		r0 = ret.Get(0).(kv.LeaseID)
 	}
 
 	var r1 error
@@ -246,27 +247,27 @@ func (_c *MetaKv_Grant_Call) Run(run func(ttl int64)) *MetaKv_Grant_Call {
 	return _c
 }
 
-func (_c *MetaKv_Grant_Call) Return(id clientv3.LeaseID, err error) *MetaKv_Grant_Call {
+func (_c *MetaKv_Grant_Call) Return(id kv.LeaseID, err error) *MetaKv_Grant_Call {
 	_c.Call.Return(id, err)
 	return _c
 }
 
-// KeepAlive provides a mock function with given fields: id
-func (_m *MetaKv) KeepAlive(id clientv3.LeaseID) (<-chan *clientv3.LeaseKeepAliveResponse, error) {
-	ret := _m.Called(id)
+// KeepAlive provides a mock function with given fields: ctx, id
+func (_m *MetaKv) KeepAlive(ctx context.Context, id kv.LeaseID) (<-chan *kv.LeaseKeepAliveResponse, error) {
+	ret := _m.Called(ctx, id)
 
-	var r0 <-chan *clientv3.LeaseKeepAliveResponse
-	if rf, ok := ret.Get(0).(func(clientv3.LeaseID) <-chan *clientv3.LeaseKeepAliveResponse); ok {
-		r0 = rf(id)
+	var r0 <-chan *kv.LeaseKeepAliveResponse
+	if rf, ok := ret.Get(0).(func(context.Context, kv.LeaseID) <-chan *kv.LeaseKeepAliveResponse); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(<-chan *clientv3.LeaseKeepAliveResponse)
+			r0 = ret.Get(0).(<-chan *kv.LeaseKeepAliveResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(clientv3.LeaseID) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, kv.LeaseID) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -280,19 +281,20 @@ type MetaKv_KeepAlive_Call struct {
 }
 
 // KeepAlive is a helper method to define mock.On call
-//   - id clientv3.LeaseID
-func (_e *MetaKv_Expecter) KeepAlive(id interface{}) *MetaKv_KeepAlive_Call {
-	return &MetaKv_KeepAlive_Call{Call: _e.mock.On("KeepAlive", id)}
+//   - ctx context.Context
+//   - id kv.LeaseID
+func (_e *MetaKv_Expecter) KeepAlive(ctx interface{}, id interface{}) *MetaKv_KeepAlive_Call {
+	return &MetaKv_KeepAlive_Call{Call: _e.mock.On("KeepAlive", ctx, id)}
 }
 
-func (_c *MetaKv_KeepAlive_Call) Run(run func(id clientv3.LeaseID)) *MetaKv_KeepAlive_Call {
+func (_c *MetaKv_KeepAlive_Call) Run(run func(ctx context.Context, id kv.LeaseID)) *MetaKv_KeepAlive_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(clientv3.LeaseID))
+		run(args[0].(context.Context), args[1].(kv.LeaseID))
 	})
 	return _c
 }
 
-func (_c *MetaKv_KeepAlive_Call) Return(_a0 <-chan *clientv3.LeaseKeepAliveResponse, _a1 error) *MetaKv_KeepAlive_Call {
+func (_c *MetaKv_KeepAlive_Call) Return(_a0 <-chan *kv.LeaseKeepAliveResponse, _a1 error) *MetaKv_KeepAlive_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
@@ -900,6 +902,43 @@ func (_c *MetaKv_RemoveWithPrefix_Call) Return(_a0 error) *MetaKv_RemoveWithPref
 	return _c
 }
 
+// Revoke provides a mock function with given fields: id
+func (_m *MetaKv) Revoke(id kv.LeaseID) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(kv.LeaseID) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MetaKv_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type MetaKv_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - id kv.LeaseID
+func (_e *MetaKv_Expecter) Revoke(id interface{}) *MetaKv_Revoke_Call {
+	return &MetaKv_Revoke_Call{Call: _e.mock.On("Revoke", id)}
+}
+
+func (_c *MetaKv_Revoke_Call) Run(run func(id kv.LeaseID)) *MetaKv_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(kv.LeaseID))
+	})
+	return _c
+}
+
+func (_c *MetaKv_Revoke_Call) Return(_a0 error) *MetaKv_Revoke_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 // Save provides a mock function with given fields: key, value
 func (_m *MetaKv) Save(key string, value string) error {
 	ret := _m.Called(key, value)
@@ -977,11 +1016,11 @@ func (_c *MetaKv_SaveWithIgnoreLease_Call) Return(_a0 error) *MetaKv_SaveWithIgn
 }
 
 // SaveWithLease provides a mock function with given fields: key, value, id
-func (_m *MetaKv) SaveWithLease(key string, value string, id clientv3.LeaseID) error {
+func (_m *MetaKv) SaveWithLease(key string, value string, id kv.LeaseID) error {
 	ret := _m.Called(key, value, id)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, clientv3.LeaseID) error); ok {
+	if rf, ok := ret.Get(0).(func(string, string, kv.LeaseID) error); ok {
 		r0 = rf(key, value, id)
 	} else {
 		r0 = ret.Error(0)
@@ -998,14 +1037,14 @@ type MetaKv_SaveWithLease_Call struct {
 // SaveWithLease is a helper method to define mock.On call
 //   - key string
 //   - value string
-//   - id clientv3.LeaseID
+//   - id kv.LeaseID
 func (_e *MetaKv_Expecter) SaveWithLease(key interface{}, value interface{}, id interface{}) *MetaKv_SaveWithLease_Call {
 	return &MetaKv_SaveWithLease_Call{Call: _e.mock.On("SaveWithLease", key, value, id)}
 }
 
-func (_c *MetaKv_SaveWithLease_Call) Run(run func(key string, value string, id clientv3.LeaseID)) *MetaKv_SaveWithLease_Call {
+func (_c *MetaKv_SaveWithLease_Call) Run(run func(key string, value string, id kv.LeaseID)) *MetaKv_SaveWithLease_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string), args[2].(clientv3.LeaseID))
+		run(args[0].(string), args[1].(string), args[2].(kv.LeaseID))
 	})
 	return _c
 }
@@ -1055,15 +1094,15 @@ func (_c *MetaKv_WalkWithPrefix_Call) Return(_a0 error) *MetaKv_WalkWithPrefix_C
 }
 
 // Watch provides a mock function with given fields: key
-func (_m *MetaKv) Watch(key string) clientv3.WatchChan {
+func (_m *MetaKv) Watch(key string) kv.WatchChan {
 	ret := _m.Called(key)
 
-	var r0 clientv3.WatchChan
-	if rf, ok := ret.Get(0).(func(string) clientv3.WatchChan); ok {
+	var r0 kv.WatchChan
+	if rf, ok := ret.Get(0).(func(string) kv.WatchChan); ok {
 		r0 = rf(key)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(clientv3.WatchChan)
+			r0 = ret.Get(0).(kv.WatchChan)
 		}
 	}
 
@@ -1088,21 +1127,76 @@ func (_c *MetaKv_Watch_Call) Run(run func(key string)) *MetaKv_Watch_Call {
 	return _c
 }
 
-func (_c *MetaKv_Watch_Call) Return(_a0 clientv3.WatchChan) *MetaKv_Watch_Call {
+func (_c *MetaKv_Watch_Call) Return(_a0 kv.WatchChan) *MetaKv_Watch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// WatchWithOptions provides a mock function with given fields: ctx, key, opts
+func (_m *MetaKv) WatchWithOptions(ctx context.Context, key string, opts ...kv.WatchOption) kv.WatchChan {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, key)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 kv.WatchChan
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...kv.WatchOption) kv.WatchChan); ok {
+		r0 = rf(ctx, key, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(kv.WatchChan)
+		}
+	}
+
+	return r0
+}
+
+// MetaKv_WatchWithOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchWithOptions'
+type MetaKv_WatchWithOptions_Call struct {
+	*mock.Call
+}
+
+// WatchWithOptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - opts ...kv.WatchOption
+func (_e *MetaKv_Expecter) WatchWithOptions(ctx interface{}, key interface{}, opts ...interface{}) *MetaKv_WatchWithOptions_Call {
+	return &MetaKv_WatchWithOptions_Call{Call: _e.mock.On("WatchWithOptions",
+		append([]interface{}{ctx, key}, opts...)...)}
+}
+
+func (_c *MetaKv_WatchWithOptions_Call) Run(run func(ctx context.Context, key string, opts ...kv.WatchOption)) *MetaKv_WatchWithOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]kv.WatchOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(kv.WatchOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MetaKv_WatchWithOptions_Call) Return(_a0 kv.WatchChan) *MetaKv_WatchWithOptions_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
 // WatchWithPrefix provides a mock function with given fields: key
-func (_m *MetaKv) WatchWithPrefix(key string) clientv3.WatchChan {
+func (_m *MetaKv) WatchWithPrefix(key string) kv.WatchChan {
 	ret := _m.Called(key)
 
-	var r0 clientv3.WatchChan
-	if rf, ok := ret.Get(0).(func(string) clientv3.WatchChan); ok {
+	var r0 kv.WatchChan
+	if rf, ok := ret.Get(0).(func(string) kv.WatchChan); ok {
 		r0 = rf(key)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(clientv3.WatchChan)
+			r0 = ret.Get(0).(kv.WatchChan)
 		}
 	}
 
@@ -1127,21 +1221,21 @@ func (_c *MetaKv_WatchWithPrefix_Call) Run(run func(key string)) *MetaKv_WatchWi
 	return _c
 }
 
-func (_c *MetaKv_WatchWithPrefix_Call) Return(_a0 clientv3.WatchChan) *MetaKv_WatchWithPrefix_Call {
+func (_c *MetaKv_WatchWithPrefix_Call) Return(_a0 kv.WatchChan) *MetaKv_WatchWithPrefix_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
 // WatchWithRevision provides a mock function with given fields: key, revision
-func (_m *MetaKv) WatchWithRevision(key string, revision int64) clientv3.WatchChan {
+func (_m *MetaKv) WatchWithRevision(key string, revision int64) kv.WatchChan {
 	ret := _m.Called(key, revision)
 
-	var r0 clientv3.WatchChan
-	if rf, ok := ret.Get(0).(func(string, int64) clientv3.WatchChan); ok {
+	var r0 kv.WatchChan
+	if rf, ok := ret.Get(0).(func(string, int64) kv.WatchChan); ok {
 		r0 = rf(key, revision)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(clientv3.WatchChan)
+			r0 = ret.Get(0).(kv.WatchChan)
 		}
 	}
 
@@ -1167,7 +1261,7 @@ func (_c *MetaKv_WatchWithRevision_Call) Run(run func(key string, revision int64
 	return _c
 }
 
-func (_c *MetaKv_WatchWithRevision_Call) Return(_a0 clientv3.WatchChan) *MetaKv_WatchWithRevision_Call {
+func (_c *MetaKv_WatchWithRevision_Call) Return(_a0 kv.WatchChan) *MetaKv_WatchWithRevision_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
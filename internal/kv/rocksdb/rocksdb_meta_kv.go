@@ -0,0 +1,971 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rocksdbkv
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/tecbot/gorocksdb"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+var _ kv.MetaKv = (*RocksdbMetaKV)(nil)
+
+const (
+	// metaDataPrefix is the prefix of the keys saved by RocksdbMetaKV in rocksdb.
+	metaDataPrefix = "data/"
+	// metaRevisionKey is the rocksdb key of the latest revision.
+	metaRevisionKey = "revision"
+	// metaHistorySize is the count of the revisions kept in memory for watching with revision.
+	metaHistorySize = 10000
+	// metaRecordHeaderSize is the size of create revision, mod revision, version and lease before the value.
+	metaRecordHeaderSize = 32
+
+	leaseCheckInterval = 500 * time.Millisecond
+)
+
+// ErrLeaseNotFound is returned when the lease is not granted, expired or revoked.
+var ErrLeaseNotFound = errors.New("requested lease not found")
+
+// record is the value saved in rocksdb, it carries the revision information like etcd does.
+type record struct {
+	createRevision int64
+	modRevision    int64
+	version        int64
+	lease          kv.LeaseID
+	value          []byte
+}
+
+func (r *record) encode() []byte {
+	data := make([]byte, metaRecordHeaderSize+len(r.value))
+	binary.BigEndian.PutUint64(data[0:], uint64(r.createRevision))
+	binary.BigEndian.PutUint64(data[8:], uint64(r.modRevision))
+	binary.BigEndian.PutUint64(data[16:], uint64(r.version))
+	binary.BigEndian.PutUint64(data[24:], uint64(r.lease))
+	copy(data[metaRecordHeaderSize:], r.value)
+	return data
+}
+
+func decodeRecord(data []byte) (*record, error) {
+	if len(data) < metaRecordHeaderSize {
+		return nil, fmt.Errorf("invalid meta record, size %d", len(data))
+	}
+	return &record{
+		createRevision: int64(binary.BigEndian.Uint64(data[0:])),
+		modRevision:    int64(binary.BigEndian.Uint64(data[8:])),
+		version:        int64(binary.BigEndian.Uint64(data[16:])),
+		lease:          kv.LeaseID(binary.BigEndian.Uint64(data[24:])),
+		value:          data[metaRecordHeaderSize:],
+	}, nil
+}
+
+func (r *record) keyValue(key string) *kv.KeyValue {
+	if r == nil {
+		return nil
+	}
+	return &kv.KeyValue{
+		Key:            []byte(key),
+		Value:          r.value,
+		CreateRevision: r.createRevision,
+		ModRevision:    r.modRevision,
+		Version:        r.version,
+		Lease:          r.lease,
+	}
+}
+
+// revisionEvents are the events happened in one revision.
+type revisionEvents struct {
+	revision int64
+	events   []*kv.Event
+}
+
+type lease struct {
+	ttl      int64
+	expireAt time.Time
+	keys     map[string]struct{}
+}
+
+// writeOp is a put or delete of a full key.
+type writeOp struct {
+	key         string
+	value       []byte
+	delete      bool
+	lease       kv.LeaseID
+	ignoreLease bool
+}
+
+// RocksdbMetaKV implements MetaKv upon rocksdb for the deployments running in a single process,
+// the watches and leases are emulated in process so they are only visible to the RocksdbMetaKVs
+// sharing the same metaStore.
+type RocksdbMetaKV struct {
+	*metaStore
+	rootPath string
+}
+
+// metaStore is the rocksdb with the revisions, watches and leases, shared by the RocksdbMetaKVs with different root paths.
+type metaStore struct {
+	db *RocksdbKV
+
+	mu              sync.RWMutex
+	closed          bool
+	revision        int64
+	compactRevision int64
+	history         []*revisionEvents
+	watchers        map[*watcher]struct{}
+	leases          map[kv.LeaseID]*lease
+	nextLeaseID     kv.LeaseID
+
+	closeCh chan struct{}
+	wg      sync.WaitGroup
+}
+
+// NewRocksdbMetaKV opens the rocksdb with the name as a MetaKv, the keys attached to leases before
+// are removed since the leases are not persisted.
+func NewRocksdbMetaKV(name string, rootPath string) (*RocksdbMetaKV, error) {
+	db, err := NewRocksdbKV(name)
+	if err != nil {
+		return nil, err
+	}
+	rkv := &RocksdbMetaKV{
+		metaStore: &metaStore{
+			db:          db,
+			watchers:    make(map[*watcher]struct{}),
+			leases:      make(map[kv.LeaseID]*lease),
+			nextLeaseID: kv.LeaseID(time.Now().UnixNano()),
+			closeCh:     make(chan struct{}),
+		},
+		rootPath: rootPath,
+	}
+	if err := rkv.recover(); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	rkv.wg.Add(1)
+	go rkv.checkLeases()
+	return rkv, nil
+}
+
+var (
+	sharedMetaKvMu sync.Mutex
+	sharedMetaKv   *RocksdbMetaKV
+)
+
+// InitSharedMetaKV opens the rocksdb with the name as the MetaKv shared by the components running in the process,
+// e.g. the sessions of standalone. It's a no-op if the shared MetaKv has been opened.
+func InitSharedMetaKV(name string) error {
+	sharedMetaKvMu.Lock()
+	defer sharedMetaKvMu.Unlock()
+	if sharedMetaKv != nil {
+		return nil
+	}
+	metaKv, err := NewRocksdbMetaKV(name, "")
+	if err != nil {
+		return err
+	}
+	sharedMetaKv = metaKv
+	return nil
+}
+
+// SharedMetaKV returns the shared MetaKv with the root path, nil if it's not opened by InitSharedMetaKV.
+func SharedMetaKV(rootPath string) *RocksdbMetaKV {
+	sharedMetaKvMu.Lock()
+	defer sharedMetaKvMu.Unlock()
+	if sharedMetaKv == nil {
+		return nil
+	}
+	return sharedMetaKv.WithRootPath(rootPath)
+}
+
+// CloseSharedMetaKV closes the shared MetaKv if it's opened.
+func CloseSharedMetaKV() {
+	sharedMetaKvMu.Lock()
+	defer sharedMetaKvMu.Unlock()
+	if sharedMetaKv != nil {
+		sharedMetaKv.Close()
+		sharedMetaKv = nil
+	}
+}
+
+// WithRootPath returns the RocksdbMetaKV with another root path upon the same rocksdb,
+// the watches and leases are shared between them, and closing any of them closes the rocksdb.
+func (rkv *RocksdbMetaKV) WithRootPath(rootPath string) *RocksdbMetaKV {
+	return &RocksdbMetaKV{metaStore: rkv.metaStore, rootPath: rootPath}
+}
+
+// recover loads the revision and removes the keys attached to leases.
+func (rkv *RocksdbMetaKV) recover() error {
+	value, err := rkv.db.LoadBytes(metaRevisionKey)
+	if err != nil {
+		return err
+	}
+	if len(value) != 0 {
+		rkv.revision = int64(binary.BigEndian.Uint64(value))
+	}
+
+	keys, records, err := rkv.scan("")
+	if err != nil {
+		return err
+	}
+	ops := make([]writeOp, 0)
+	for i, r := range records {
+		if r.lease != kv.NoLease {
+			ops = append(ops, writeOp{key: keys[i], delete: true})
+		}
+	}
+	if err := rkv.commit(ops); err != nil {
+		return err
+	}
+	log.Info("rocksdb meta kv recovered", zap.String("name", rkv.db.GetName()),
+		zap.Int64("revision", rkv.revision), zap.Int("removedLeaseKeys", len(ops)))
+
+	// the events before opening are not kept, watching from the latest revision is still allowed
+	// since nobody could have loaded an older snapshot from this instance.
+	rkv.history = nil
+	rkv.compactRevision = rkv.revision - 1
+	return nil
+}
+
+func dataKey(key string) []byte {
+	return []byte(metaDataPrefix + key)
+}
+
+func encodeRevision(revision int64) []byte {
+	data := make([]byte, 8)
+	binary.BigEndian.PutUint64(data, uint64(revision))
+	return data
+}
+
+// get returns the record of the full key, nil if the key doesn't exist.
+func (rkv *RocksdbMetaKV) get(key string) (*record, error) {
+	data, err := rkv.db.LoadBytes(string(dataKey(key)))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	return decodeRecord(data)
+}
+
+// scan returns the full keys and records with the prefix, sorted by key.
+func (rkv *RocksdbMetaKV) scan(prefix string) ([]string, []*record, error) {
+	keys, values, err := rkv.db.LoadBytesWithPrefix(string(dataKey(prefix)))
+	if err != nil {
+		return nil, nil, err
+	}
+	records := make([]*record, 0, len(values))
+	for i, value := range values {
+		r, err := decodeRecord(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		keys[i] = strings.TrimPrefix(keys[i], metaDataPrefix)
+		records = append(records, r)
+	}
+	return keys, records, nil
+}
+
+// commit applies the ops in one revision and notifies the watchers, mu must be held.
+func (rkv *RocksdbMetaKV) commit(ops []writeOp) error {
+	revision := rkv.revision + 1
+	batch := gorocksdb.NewWriteBatch()
+	defer batch.Destroy()
+
+	events := make([]*kv.Event, 0, len(ops))
+	updated := make(map[string]*record)
+	for _, op := range ops {
+		prev, ok := updated[op.key]
+		if !ok {
+			var err error
+			prev, err = rkv.get(op.key)
+			if err != nil {
+				return err
+			}
+		}
+
+		if op.delete {
+			if prev == nil {
+				continue
+			}
+			batch.Delete(dataKey(op.key))
+			updated[op.key] = nil
+			events = append(events, &kv.Event{
+				Type:   kv.EventTypeDelete,
+				Kv:     &kv.KeyValue{Key: []byte(op.key), ModRevision: revision},
+				PrevKv: prev.keyValue(op.key),
+			})
+			continue
+		}
+
+		cur := &record{createRevision: revision, modRevision: revision, version: 1, lease: op.lease, value: op.value}
+		if prev != nil {
+			cur.createRevision = prev.createRevision
+			cur.version = prev.version + 1
+		}
+		if op.ignoreLease {
+			if prev == nil {
+				return fmt.Errorf("key %s not found to save with ignore lease", op.key)
+			}
+			cur.lease = prev.lease
+		} else if cur.lease != kv.NoLease {
+			if _, ok := rkv.leases[cur.lease]; !ok {
+				return ErrLeaseNotFound
+			}
+		}
+		batch.Put(dataKey(op.key), cur.encode())
+		updated[op.key] = cur
+		events = append(events, &kv.Event{
+			Type:   kv.EventTypePut,
+			Kv:     cur.keyValue(op.key),
+			PrevKv: prev.keyValue(op.key),
+		})
+	}
+	if len(events) == 0 {
+		return nil
+	}
+
+	batch.Put([]byte(metaRevisionKey), encodeRevision(revision))
+	if err := rkv.db.DB.Write(rkv.db.WriteOptions, batch); err != nil {
+		return err
+	}
+	rkv.revision = revision
+
+	for _, evt := range events {
+		if evt.PrevKv != nil && evt.PrevKv.Lease != kv.NoLease {
+			if l, ok := rkv.leases[evt.PrevKv.Lease]; ok {
+				delete(l.keys, string(evt.PrevKv.Key))
+			}
+		}
+		if evt.Type == kv.EventTypePut && evt.Kv.Lease != kv.NoLease {
+			if l, ok := rkv.leases[evt.Kv.Lease]; ok {
+				l.keys[string(evt.Kv.Key)] = struct{}{}
+			}
+		}
+	}
+	rkv.publish(revision, events)
+	return nil
+}
+
+// publish records the events in history and sends them to the watchers, mu must be held.
+func (rkv *RocksdbMetaKV) publish(revision int64, events []*kv.Event) {
+	rkv.history = append(rkv.history, &revisionEvents{revision: revision, events: events})
+	if len(rkv.history) > 2*metaHistorySize {
+		dropped := len(rkv.history) - metaHistorySize
+		rkv.compactRevision = rkv.history[dropped-1].revision
+		rkv.history = append([]*revisionEvents(nil), rkv.history[dropped:]...)
+	}
+	for w := range rkv.watchers {
+		w.notify(revision, events)
+	}
+}
+
+func (rkv *RocksdbMetaKV) write(ops ...writeOp) error {
+	rkv.mu.Lock()
+	defer rkv.mu.Unlock()
+	if rkv.closed {
+		return errors.New("rocksdb meta kv is closed")
+	}
+	return rkv.commit(ops)
+}
+
+// prefixRemoveOps returns the delete ops of the keys with the full prefixes, mu must be held.
+func (rkv *RocksdbMetaKV) prefixRemoveOps(prefixes ...string) ([]writeOp, error) {
+	ops := make([]writeOp, 0)
+	for _, prefix := range prefixes {
+		keys, _, err := rkv.scan(prefix)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			ops = append(ops, writeOp{key: key, delete: true})
+		}
+	}
+	return ops, nil
+}
+
+// Close stops the watches and leases then closes the rocksdb.
+func (rkv *RocksdbMetaKV) Close() {
+	rkv.mu.Lock()
+	if rkv.closed {
+		rkv.mu.Unlock()
+		return
+	}
+	rkv.closed = true
+	for w := range rkv.watchers {
+		w.finish(kv.WatchResponse{Revision: rkv.revision, Canceled: true, CancelReason: "rocksdb meta kv is closed"})
+	}
+	rkv.mu.Unlock()
+
+	close(rkv.closeCh)
+	rkv.wg.Wait()
+	rkv.db.Close()
+}
+
+// GetPath returns the full path of the key.
+func (rkv *RocksdbMetaKV) GetPath(key string) string {
+	return path.Join(rkv.rootPath, key)
+}
+
+// Load returns value of the key.
+func (rkv *RocksdbMetaKV) Load(key string) (string, error) {
+	key = path.Join(rkv.rootPath, key)
+	rkv.mu.RLock()
+	defer rkv.mu.RUnlock()
+	r, err := rkv.get(key)
+	if err != nil {
+		return "", err
+	}
+	if r == nil {
+		return "", common.NewKeyNotExistError(key)
+	}
+	return string(r.value), nil
+}
+
+// MultiLoad returns the values of the keys, an error is returned if any key doesn't exist.
+func (rkv *RocksdbMetaKV) MultiLoad(keys []string) ([]string, error) {
+	rkv.mu.RLock()
+	defer rkv.mu.RUnlock()
+	result := make([]string, 0, len(keys))
+	invalid := make([]string, 0)
+	for _, key := range keys {
+		r, err := rkv.get(path.Join(rkv.rootPath, key))
+		if err != nil {
+			return nil, err
+		}
+		if r == nil {
+			invalid = append(invalid, key)
+			result = append(result, "")
+			continue
+		}
+		result = append(result, string(r.value))
+	}
+	if len(invalid) != 0 {
+		return result, fmt.Errorf("there are invalid keys: %s", invalid)
+	}
+	return result, nil
+}
+
+// LoadWithPrefix returns all the keys and values with the prefix.
+func (rkv *RocksdbMetaKV) LoadWithPrefix(key string) ([]string, []string, error) {
+	keys, values, _, _, err := rkv.LoadWithRevisionAndVersions(key)
+	return keys, values, err
+}
+
+// LoadWithPrefix2 returns all the keys, values and versions with the prefix.
+func (rkv *RocksdbMetaKV) LoadWithPrefix2(key string) ([]string, []string, []int64, error) {
+	keys, values, versions, _, err := rkv.LoadWithRevisionAndVersions(key)
+	return keys, values, versions, err
+}
+
+// LoadWithRevisionAndVersions returns all the keys, values and versions with the prefix, and the current revision.
+func (rkv *RocksdbMetaKV) LoadWithRevisionAndVersions(key string) ([]string, []string, []int64, int64, error) {
+	key = path.Join(rkv.rootPath, key)
+	rkv.mu.RLock()
+	defer rkv.mu.RUnlock()
+	keys, records, err := rkv.scan(key)
+	if err != nil {
+		return nil, nil, nil, 0, err
+	}
+	values := make([]string, 0, len(records))
+	versions := make([]int64, 0, len(records))
+	for _, r := range records {
+		values = append(values, string(r.value))
+		versions = append(versions, r.version)
+	}
+	return keys, values, versions, rkv.revision, nil
+}
+
+// LoadWithRevision returns all the keys and values with the prefix sorted by create revision, and the current revision.
+func (rkv *RocksdbMetaKV) LoadWithRevision(key string) ([]string, []string, int64, error) {
+	key = path.Join(rkv.rootPath, key)
+	rkv.mu.RLock()
+	defer rkv.mu.RUnlock()
+	keys, records, err := rkv.scan(key)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	idx := make([]int, len(keys))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return records[idx[i]].createRevision < records[idx[j]].createRevision
+	})
+	sortedKeys := make([]string, 0, len(keys))
+	values := make([]string, 0, len(keys))
+	for _, i := range idx {
+		sortedKeys = append(sortedKeys, keys[i])
+		values = append(values, string(records[i].value))
+	}
+	return sortedKeys, values, rkv.revision, nil
+}
+
+// WalkWithPrefix calls fn with the keys and values with the prefix in key order.
+func (rkv *RocksdbMetaKV) WalkWithPrefix(prefix string, paginationSize int, fn func([]byte, []byte) error) error {
+	prefix = path.Join(rkv.rootPath, prefix)
+	rkv.mu.RLock()
+	keys, records, err := rkv.scan(prefix)
+	rkv.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+	for i, key := range keys {
+		if err := fn([]byte(key), records[i].value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Save saves the key and value.
+func (rkv *RocksdbMetaKV) Save(key, value string) error {
+	return rkv.write(writeOp{key: path.Join(rkv.rootPath, key), value: []byte(value)})
+}
+
+// SaveWithLease saves the key and value attached to the lease.
+func (rkv *RocksdbMetaKV) SaveWithLease(key, value string, id kv.LeaseID) error {
+	return rkv.write(writeOp{key: path.Join(rkv.rootPath, key), value: []byte(value), lease: id})
+}
+
+// SaveWithIgnoreLease updates the value of the key and keeps its lease, the key must exist.
+func (rkv *RocksdbMetaKV) SaveWithIgnoreLease(key, value string) error {
+	return rkv.write(writeOp{key: path.Join(rkv.rootPath, key), value: []byte(value), ignoreLease: true})
+}
+
+// MultiSave saves the keys and values in one revision.
+func (rkv *RocksdbMetaKV) MultiSave(kvs map[string]string) error {
+	return rkv.MultiSaveAndRemove(kvs, nil)
+}
+
+// Remove removes the key.
+func (rkv *RocksdbMetaKV) Remove(key string) error {
+	return rkv.write(writeOp{key: path.Join(rkv.rootPath, key), delete: true})
+}
+
+// MultiRemove removes the keys in one revision.
+func (rkv *RocksdbMetaKV) MultiRemove(keys []string) error {
+	return rkv.MultiSaveAndRemove(nil, keys)
+}
+
+// RemoveWithPrefix removes the keys with the prefix.
+func (rkv *RocksdbMetaKV) RemoveWithPrefix(prefix string) error {
+	return rkv.MultiSaveAndRemoveWithPrefix(nil, []string{prefix})
+}
+
+// MultiRemoveWithPrefix removes the keys with the prefixes in one revision.
+func (rkv *RocksdbMetaKV) MultiRemoveWithPrefix(prefixes []string) error {
+	return rkv.MultiSaveAndRemoveWithPrefix(nil, prefixes)
+}
+
+// MultiSaveAndRemove saves and then removes the keys in one revision.
+func (rkv *RocksdbMetaKV) MultiSaveAndRemove(saves map[string]string, removals []string) error {
+	ops := make([]writeOp, 0, len(saves)+len(removals))
+	for _, key := range sortedKeys(saves) {
+		ops = append(ops, writeOp{key: path.Join(rkv.rootPath, key), value: []byte(saves[key])})
+	}
+	for _, key := range removals {
+		ops = append(ops, writeOp{key: path.Join(rkv.rootPath, key), delete: true})
+	}
+	return rkv.write(ops...)
+}
+
+// MultiSaveAndRemoveWithPrefix saves the keys and then removes the keys with the prefixes in one revision.
+func (rkv *RocksdbMetaKV) MultiSaveAndRemoveWithPrefix(saves map[string]string, removals []string) error {
+	ops := make([]writeOp, 0, len(saves))
+	for _, key := range sortedKeys(saves) {
+		ops = append(ops, writeOp{key: path.Join(rkv.rootPath, key), value: []byte(saves[key])})
+	}
+	prefixes := make([]string, 0, len(removals))
+	for _, prefix := range removals {
+		prefixes = append(prefixes, path.Join(rkv.rootPath, prefix))
+	}
+
+	rkv.mu.Lock()
+	defer rkv.mu.Unlock()
+	if rkv.closed {
+		return errors.New("rocksdb meta kv is closed")
+	}
+	removeOps, err := rkv.prefixRemoveOps(prefixes...)
+	if err != nil {
+		return err
+	}
+	for _, op := range ops {
+		// the saved keys with the prefixes are removed as well
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(op.key, prefix) {
+				removeOps = append(removeOps, writeOp{key: op.key, delete: true})
+				break
+			}
+		}
+	}
+	return rkv.commit(append(ops, removeOps...))
+}
+
+func sortedKeys(kvs map[string]string) []string {
+	keys := make([]string, 0, len(kvs))
+	for key := range kvs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// CompareValueAndSwap saves the target if the value of the key equals to value.
+func (rkv *RocksdbMetaKV) CompareValueAndSwap(key, value, target string, opts ...kv.PutOption) (bool, error) {
+	key = path.Join(rkv.rootPath, key)
+	rkv.mu.Lock()
+	defer rkv.mu.Unlock()
+	r, err := rkv.get(key)
+	if err != nil {
+		return false, err
+	}
+	if r == nil || string(r.value) != value {
+		return false, nil
+	}
+	putOpts := kv.NewPutOptions(opts...)
+	if err := rkv.commit([]writeOp{{key: key, value: []byte(target), lease: putOpts.LeaseID}}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// CompareVersionAndSwap saves the target if the version of the key equals to version,
+// the version of a key which doesn't exist is 0.
+func (rkv *RocksdbMetaKV) CompareVersionAndSwap(key string, version int64, target string, opts ...kv.PutOption) (bool, error) {
+	key = path.Join(rkv.rootPath, key)
+	rkv.mu.Lock()
+	defer rkv.mu.Unlock()
+	r, err := rkv.get(key)
+	if err != nil {
+		return false, err
+	}
+	var current int64
+	if r != nil {
+		current = r.version
+	}
+	if current != version {
+		return false, nil
+	}
+	putOpts := kv.NewPutOptions(opts...)
+	if err := rkv.commit([]writeOp{{key: key, value: []byte(target), lease: putOpts.LeaseID}}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Watch watches the key, the first response is the created notification.
+func (rkv *RocksdbMetaKV) Watch(key string) kv.WatchChan {
+	return rkv.watch(context.Background(), key, true)
+}
+
+// WatchWithPrefix watches the keys with the prefix, the first response is the created notification.
+func (rkv *RocksdbMetaKV) WatchWithPrefix(key string) kv.WatchChan {
+	return rkv.watch(context.Background(), key, true, kv.WithPrefix())
+}
+
+// WatchWithRevision watches the keys with the prefix since the revision.
+func (rkv *RocksdbMetaKV) WatchWithRevision(key string, revision int64) kv.WatchChan {
+	return rkv.watch(context.Background(), key, false, kv.WithPrefix(), kv.WithPrevKV(), kv.WithRevision(revision))
+}
+
+// WatchWithOptions watches the key with options until ctx is done.
+func (rkv *RocksdbMetaKV) WatchWithOptions(ctx context.Context, key string, opts ...kv.WatchOption) kv.WatchChan {
+	return rkv.watch(ctx, key, false, opts...)
+}
+
+func (rkv *RocksdbMetaKV) watch(ctx context.Context, key string, created bool, opts ...kv.WatchOption) kv.WatchChan {
+	watchOpts := kv.NewWatchOptions(opts...)
+	w := newWatcher(ctx, path.Join(rkv.rootPath, key), watchOpts.Prefix, watchOpts.PrevKV)
+	rkv.register(w, created, watchOpts.Revision)
+	go w.run(func() {
+		rkv.mu.Lock()
+		defer rkv.mu.Unlock()
+		delete(rkv.watchers, w)
+	})
+	return w.ch
+}
+
+// register replays the history since the revision to the watcher and adds it to the watchers.
+func (rkv *RocksdbMetaKV) register(w *watcher, created bool, revision int64) {
+	rkv.mu.Lock()
+	defer rkv.mu.Unlock()
+	if rkv.closed {
+		w.finish(kv.WatchResponse{Revision: rkv.revision, Canceled: true, CancelReason: "rocksdb meta kv is closed"})
+		return
+	}
+	if created {
+		w.push(kv.WatchResponse{Revision: rkv.revision, Created: true})
+	}
+	if revision > 0 {
+		if revision <= rkv.compactRevision {
+			w.finish(kv.WatchResponse{Revision: rkv.revision, CompactRevision: rkv.compactRevision})
+			return
+		}
+		for _, h := range rkv.history {
+			if h.revision >= revision {
+				w.notify(h.revision, h.events)
+			}
+		}
+	}
+	rkv.watchers[w] = struct{}{}
+}
+
+// Grant grants a lease with ttl in seconds.
+func (rkv *RocksdbMetaKV) Grant(ttl int64) (kv.LeaseID, error) {
+	if ttl <= 0 {
+		return kv.NoLease, fmt.Errorf("invalid lease ttl %d", ttl)
+	}
+	rkv.mu.Lock()
+	defer rkv.mu.Unlock()
+	rkv.nextLeaseID++
+	id := rkv.nextLeaseID
+	rkv.leases[id] = &lease{
+		ttl:      ttl,
+		expireAt: time.Now().Add(time.Duration(ttl) * time.Second),
+		keys:     make(map[string]struct{}),
+	}
+	return id, nil
+}
+
+// renew refreshes the lease, returns false if the lease is not found.
+func (rkv *RocksdbMetaKV) renew(id kv.LeaseID) (int64, bool) {
+	rkv.mu.Lock()
+	defer rkv.mu.Unlock()
+	l, ok := rkv.leases[id]
+	if !ok {
+		return 0, false
+	}
+	l.expireAt = time.Now().Add(time.Duration(l.ttl) * time.Second)
+	return l.ttl, true
+}
+
+// KeepAlive keeps the lease alive until ctx is done, the returned channel is closed once the lease is gone.
+func (rkv *RocksdbMetaKV) KeepAlive(ctx context.Context, id kv.LeaseID) (<-chan *kv.LeaseKeepAliveResponse, error) {
+	rkv.mu.Lock()
+	defer rkv.mu.Unlock()
+	if rkv.closed {
+		return nil, errors.New("rocksdb meta kv is closed")
+	}
+	l, ok := rkv.leases[id]
+	if !ok {
+		return nil, ErrLeaseNotFound
+	}
+	l.expireAt = time.Now().Add(time.Duration(l.ttl) * time.Second)
+
+	ch := make(chan *kv.LeaseKeepAliveResponse, 1)
+	ch <- &kv.LeaseKeepAliveResponse{ID: id, TTL: l.ttl}
+	interval := time.Duration(l.ttl) * time.Second / 3
+	if interval < leaseCheckInterval {
+		interval = leaseCheckInterval
+	}
+
+	rkv.wg.Add(1)
+	go func() {
+		defer rkv.wg.Done()
+		defer close(ch)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-rkv.closeCh:
+				return
+			case <-ticker.C:
+				ttl, ok := rkv.renew(id)
+				if !ok {
+					return
+				}
+				select {
+				case ch <- &kv.LeaseKeepAliveResponse{ID: id, TTL: ttl}:
+				default:
+				}
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// Revoke revokes the lease and removes the keys attached to it.
+func (rkv *RocksdbMetaKV) Revoke(id kv.LeaseID) error {
+	rkv.mu.Lock()
+	defer rkv.mu.Unlock()
+	if _, ok := rkv.leases[id]; !ok {
+		return ErrLeaseNotFound
+	}
+	return rkv.revoke(id)
+}
+
+// revoke removes the lease and its keys, mu must be held.
+func (rkv *RocksdbMetaKV) revoke(id kv.LeaseID) error {
+	l := rkv.leases[id]
+	delete(rkv.leases, id)
+	keys := make([]string, 0, len(l.keys))
+	for key := range l.keys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	ops := make([]writeOp, 0, len(keys))
+	for _, key := range keys {
+		ops = append(ops, writeOp{key: key, delete: true})
+	}
+	return rkv.commit(ops)
+}
+
+// checkLeases revokes the expired leases periodically.
+func (rkv *RocksdbMetaKV) checkLeases() {
+	defer rkv.wg.Done()
+	ticker := time.NewTicker(leaseCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-rkv.closeCh:
+			return
+		case now := <-ticker.C:
+			rkv.mu.Lock()
+			for id, l := range rkv.leases {
+				if now.Before(l.expireAt) {
+					continue
+				}
+				log.Info("rocksdb meta kv lease expired", zap.Int64("leaseID", int64(id)))
+				if err := rkv.revoke(id); err != nil {
+					log.Warn("failed to revoke expired lease", zap.Int64("leaseID", int64(id)), zap.Error(err))
+				}
+			}
+			rkv.mu.Unlock()
+		}
+	}
+}
+
+// watcher delivers the events of the matched keys in order without blocking the writers.
+type watcher struct {
+	ctx    context.Context
+	key    string
+	prefix bool
+	prevKV bool
+	ch     chan kv.WatchResponse
+
+	mu       sync.Mutex
+	queue    []kv.WatchResponse
+	finished bool
+	notifyCh chan struct{}
+}
+
+func newWatcher(ctx context.Context, key string, prefix bool, prevKV bool) *watcher {
+	return &watcher{
+		ctx:      ctx,
+		key:      key,
+		prefix:   prefix,
+		prevKV:   prevKV,
+		ch:       make(chan kv.WatchResponse),
+		notifyCh: make(chan struct{}, 1),
+	}
+}
+
+func (w *watcher) match(key []byte) bool {
+	if w.prefix {
+		return strings.HasPrefix(string(key), w.key)
+	}
+	return string(key) == w.key
+}
+
+// notify pushes the matched events of the revision.
+func (w *watcher) notify(revision int64, events []*kv.Event) {
+	matched := make([]*kv.Event, 0)
+	for _, evt := range events {
+		if !w.match(evt.Kv.Key) {
+			continue
+		}
+		if !w.prevKV && evt.PrevKv != nil {
+			evt = &kv.Event{Type: evt.Type, Kv: evt.Kv}
+		}
+		matched = append(matched, evt)
+	}
+	if len(matched) == 0 {
+		return
+	}
+	w.push(kv.WatchResponse{Events: matched, Revision: revision})
+}
+
+func (w *watcher) push(resp kv.WatchResponse) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.finished {
+		return
+	}
+	w.queue = append(w.queue, resp)
+	w.signal()
+}
+
+// finish pushes the last response, the channel is closed after it's delivered.
+func (w *watcher) finish(resp kv.WatchResponse) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.finished {
+		return
+	}
+	w.queue = append(w.queue, resp)
+	w.finished = true
+	w.signal()
+}
+
+func (w *watcher) signal() {
+	select {
+	case w.notifyCh <- struct{}{}:
+	default:
+	}
+}
+
+func (w *watcher) run(onExit func()) {
+	defer close(w.ch)
+	defer onExit()
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-w.notifyCh:
+		}
+		w.mu.Lock()
+		queue, finished := w.queue, w.finished
+		w.queue = nil
+		w.mu.Unlock()
+
+		for _, resp := range queue {
+			select {
+			case w.ch <- resp:
+			case <-w.ctx.Done():
+				return
+			}
+		}
+		if finished {
+			return
+		}
+	}
+}
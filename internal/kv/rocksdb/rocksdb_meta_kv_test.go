@@ -0,0 +1,260 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rocksdbkv_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func TestRocksdbMetaKV(t *testing.T) {
+	name := "/tmp/rocksdb_meta_kv"
+	defer os.RemoveAll(name)
+	metaKv, err := rocksdbkv.NewRocksdbMetaKV(name, "/root")
+	require.NoError(t, err)
+	defer metaKv.Close()
+
+	err = metaKv.Save("a/b", "v1")
+	assert.NoError(t, err)
+	err = metaKv.MultiSave(map[string]string{"a/c": "v2", "x": "v3"})
+	assert.NoError(t, err)
+
+	value, err := metaKv.Load("a/b")
+	assert.NoError(t, err)
+	assert.Equal(t, "v1", value)
+	_, err = metaKv.Load("not_exist")
+	assert.True(t, common.IsKeyNotExistError(err))
+
+	values, err := metaKv.MultiLoad([]string{"a/b", "x"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"v1", "v3"}, values)
+	_, err = metaKv.MultiLoad([]string{"a/b", "not_exist"})
+	assert.Error(t, err)
+
+	keys, values, err := metaKv.LoadWithPrefix("a")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/root/a/b", "/root/a/c"}, keys)
+	assert.Equal(t, []string{"v1", "v2"}, values)
+
+	err = metaKv.Save("a/b", "v4")
+	assert.NoError(t, err)
+	_, _, versions, err := metaKv.LoadWithPrefix2("a/b")
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2}, versions)
+
+	_, _, revision, err := metaKv.LoadWithRevision("")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), revision)
+
+	err = metaKv.MultiSaveAndRemoveWithPrefix(map[string]string{"y": "v5"}, []string{"a"})
+	assert.NoError(t, err)
+	keys, _, err = metaKv.LoadWithPrefix("")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/root/x", "/root/y"}, keys)
+
+	err = metaKv.MultiRemove([]string{"x", "y"})
+	assert.NoError(t, err)
+	keys, _, err = metaKv.LoadWithPrefix("")
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+}
+
+func TestRocksdbMetaKV_CompareAndSwap(t *testing.T) {
+	name := "/tmp/rocksdb_meta_kv_cas"
+	defer os.RemoveAll(name)
+	metaKv, err := rocksdbkv.NewRocksdbMetaKV(name, "")
+	require.NoError(t, err)
+	defer metaKv.Close()
+
+	succeeded, err := metaKv.CompareVersionAndSwap("key", 0, "v1")
+	assert.NoError(t, err)
+	assert.True(t, succeeded)
+	succeeded, err = metaKv.CompareVersionAndSwap("key", 0, "v2")
+	assert.NoError(t, err)
+	assert.False(t, succeeded)
+
+	succeeded, err = metaKv.CompareValueAndSwap("key", "v2", "v3")
+	assert.NoError(t, err)
+	assert.False(t, succeeded)
+	succeeded, err = metaKv.CompareValueAndSwap("key", "v1", "v3")
+	assert.NoError(t, err)
+	assert.True(t, succeeded)
+	succeeded, err = metaKv.CompareValueAndSwap("not_exist", "", "v1")
+	assert.NoError(t, err)
+	assert.False(t, succeeded)
+
+	value, err := metaKv.Load("key")
+	assert.NoError(t, err)
+	assert.Equal(t, "v3", value)
+
+	_, err = metaKv.CompareVersionAndSwap("lease_key", 0, "v1", kv.WithLease(999))
+	assert.ErrorIs(t, err, rocksdbkv.ErrLeaseNotFound)
+}
+
+func TestRocksdbMetaKV_Watch(t *testing.T) {
+	name := "/tmp/rocksdb_meta_kv_watch"
+	defer os.RemoveAll(name)
+	metaKv, err := rocksdbkv.NewRocksdbMetaKV(name, "/root")
+	require.NoError(t, err)
+	defer metaKv.Close()
+
+	ch := metaKv.WatchWithPrefix("a")
+	resp := <-ch
+	assert.True(t, resp.Created)
+
+	err = metaKv.Save("a/b", "v1")
+	assert.NoError(t, err)
+	err = metaKv.Save("x", "v2")
+	assert.NoError(t, err)
+	err = metaKv.Remove("a/b")
+	assert.NoError(t, err)
+
+	resp = <-ch
+	assert.NoError(t, resp.Err())
+	assert.Equal(t, 1, len(resp.Events))
+	assert.Equal(t, kv.EventTypePut, resp.Events[0].Type)
+	assert.Equal(t, "/root/a/b", string(resp.Events[0].Kv.Key))
+	assert.Equal(t, "v1", string(resp.Events[0].Kv.Value))
+
+	resp = <-ch
+	assert.Equal(t, 1, len(resp.Events))
+	assert.Equal(t, kv.EventTypeDelete, resp.Events[0].Type)
+	assert.Nil(t, resp.Events[0].PrevKv)
+
+	// replay the events since the revision with prev kv
+	ctx, cancel := context.WithCancel(context.Background())
+	ch = metaKv.WatchWithOptions(ctx, "a/b", kv.WithRevision(1), kv.WithPrevKV())
+	resp = <-ch
+	assert.Equal(t, int64(1), resp.Revision)
+	assert.Equal(t, kv.EventTypePut, resp.Events[0].Type)
+	resp = <-ch
+	assert.Equal(t, int64(3), resp.Revision)
+	assert.Equal(t, kv.EventTypeDelete, resp.Events[0].Type)
+	assert.Equal(t, "v1", string(resp.Events[0].PrevKv.Value))
+
+	cancel()
+	_, ok := <-ch
+	assert.False(t, ok)
+}
+
+func TestRocksdbMetaKV_Lease(t *testing.T) {
+	name := "/tmp/rocksdb_meta_kv_lease"
+	defer os.RemoveAll(name)
+	metaKv, err := rocksdbkv.NewRocksdbMetaKV(name, "")
+	require.NoError(t, err)
+
+	leaseID, err := metaKv.Grant(1)
+	assert.NoError(t, err)
+	err = metaKv.SaveWithLease("keep", "v1", leaseID)
+	assert.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	ch, err := metaKv.KeepAlive(ctx, leaseID)
+	assert.NoError(t, err)
+	resp := <-ch
+	assert.Equal(t, leaseID, resp.ID)
+
+	expiredID, err := metaKv.Grant(1)
+	assert.NoError(t, err)
+	err = metaKv.SaveWithLease("expire", "v2", expiredID)
+	assert.NoError(t, err)
+	err = metaKv.SaveWithIgnoreLease("expire", "v3")
+	assert.NoError(t, err)
+	err = metaKv.SaveWithIgnoreLease("not_exist", "v3")
+	assert.Error(t, err)
+
+	assert.Eventually(t, func() bool {
+		_, err := metaKv.Load("expire")
+		return common.IsKeyNotExistError(err)
+	}, 5*time.Second, 100*time.Millisecond)
+	time.Sleep(2 * time.Second)
+	value, err := metaKv.Load("keep")
+	assert.NoError(t, err)
+	assert.Equal(t, "v1", value)
+
+	revokedID, err := metaKv.Grant(10)
+	assert.NoError(t, err)
+	err = metaKv.SaveWithLease("revoke", "v4", revokedID)
+	assert.NoError(t, err)
+	err = metaKv.Revoke(revokedID)
+	assert.NoError(t, err)
+	_, err = metaKv.Load("revoke")
+	assert.True(t, common.IsKeyNotExistError(err))
+	err = metaKv.Revoke(revokedID)
+	assert.ErrorIs(t, err, rocksdbkv.ErrLeaseNotFound)
+
+	// the keys with lease are removed on reopen
+	err = metaKv.Save("persist", "v5")
+	assert.NoError(t, err)
+	cancel()
+	metaKv.Close()
+
+	metaKv, err = rocksdbkv.NewRocksdbMetaKV(name, "")
+	require.NoError(t, err)
+	defer metaKv.Close()
+	_, err = metaKv.Load("keep")
+	assert.True(t, common.IsKeyNotExistError(err))
+	value, err = metaKv.Load("persist")
+	assert.NoError(t, err)
+	assert.Equal(t, "v5", value)
+}
+
+func TestRocksdbMetaKV_Shared(t *testing.T) {
+	assert.Nil(t, rocksdbkv.SharedMetaKV(""))
+
+	name := "/tmp/rocksdb_meta_kv_shared"
+	defer os.RemoveAll(name)
+	err := rocksdbkv.InitSharedMetaKV(name)
+	require.NoError(t, err)
+	defer rocksdbkv.CloseSharedMetaKV()
+	// opening it again is a no-op
+	err = rocksdbkv.InitSharedMetaKV(name)
+	assert.NoError(t, err)
+
+	root := rocksdbkv.SharedMetaKV("")
+	sub := rocksdbkv.SharedMetaKV("/sub")
+	require.NotNil(t, root)
+	require.NotNil(t, sub)
+
+	err = sub.Save("a", "v1")
+	assert.NoError(t, err)
+	value, err := root.Load("/sub/a")
+	assert.NoError(t, err)
+	assert.Equal(t, "v1", value)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := root.WatchWithOptions(ctx, "/sub", kv.WithPrefix())
+	err = sub.Remove("a")
+	assert.NoError(t, err)
+	select {
+	case resp := <-ch:
+		require.Len(t, resp.Events, 1)
+		assert.Equal(t, kv.EventTypeDelete, resp.Events[0].Type)
+		assert.Equal(t, "/sub/a", string(resp.Events[0].Kv.Key))
+	case <-time.After(time.Second):
+		t.Fatal("no event of the shared rocksdb")
+	}
+}
@@ -0,0 +1,169 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"github.com/cockroachdb/errors"
+)
+
+var (
+	// ErrCompacted is returned by WatchResponse.Err when the requested revision has been compacted.
+	ErrCompacted = errors.New("kv: required revision has been compacted")
+	// ErrWatchCanceled is returned by WatchResponse.Err when the watch is canceled without reason.
+	ErrWatchCanceled = errors.New("kv: watch canceled")
+)
+
+// EventType is the type of a watched event.
+type EventType int32
+
+const (
+	// EventTypePut means the key is created or updated.
+	EventTypePut EventType = iota
+	// EventTypeDelete means the key is deleted.
+	EventTypeDelete
+)
+
+func (t EventType) String() string {
+	switch t {
+	case EventTypePut:
+		return "PUT"
+	case EventTypeDelete:
+		return "DELETE"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// LeaseID is the id of a lease granted by MetaKv.
+type LeaseID int64
+
+// NoLease means the key is not attached to any lease.
+const NoLease LeaseID = 0
+
+// KeyValue is a key with its value and revision information.
+type KeyValue struct {
+	Key            []byte
+	Value          []byte
+	CreateRevision int64
+	ModRevision    int64
+	// Version is the count of modifications since the key was created, it's reset to zero on deletion.
+	Version int64
+	Lease   LeaseID
+}
+
+// Event is a change of a watched key.
+type Event struct {
+	Type EventType
+	// Kv holds the key after the event, only the key and the revisions are set for a delete event.
+	Kv *KeyValue
+	// PrevKv holds the key before the event, it's only set when watching with WithPrevKV.
+	PrevKv *KeyValue
+}
+
+// WatchResponse is a batch of events delivered by a watch.
+type WatchResponse struct {
+	Events []*Event
+	// Revision is the revision of the store when the response is generated.
+	Revision int64
+	// Created is set on the first response of a watch which asks for the created notification.
+	Created bool
+	// CompactRevision is set when the requested revision has been compacted,
+	// the watch is canceled and the caller should reload and rewatch.
+	CompactRevision int64
+	// Canceled is set when the watch is canceled, CancelReason explains why.
+	Canceled     bool
+	CancelReason string
+}
+
+// Err returns the error of the response, nil if the response carries events.
+func (wr WatchResponse) Err() error {
+	switch {
+	case wr.CompactRevision != 0:
+		return ErrCompacted
+	case wr.Canceled:
+		if len(wr.CancelReason) != 0 {
+			return errors.New(wr.CancelReason)
+		}
+		return ErrWatchCanceled
+	}
+	return nil
+}
+
+// WatchChan delivers watch responses, it's closed when the watch is stopped.
+type WatchChan <-chan WatchResponse
+
+// LeaseKeepAliveResponse is sent every time the lease is renewed.
+type LeaseKeepAliveResponse struct {
+	ID  LeaseID
+	TTL int64
+}
+
+// WatchOptions are the options of a watch.
+type WatchOptions struct {
+	Prefix   bool
+	Revision int64
+	PrevKV   bool
+}
+
+// WatchOption configures a watch.
+type WatchOption func(*WatchOptions)
+
+// WithPrefix watches all the keys with the prefix.
+func WithPrefix() WatchOption {
+	return func(opts *WatchOptions) { opts.Prefix = true }
+}
+
+// WithRevision watches the events since the revision.
+func WithRevision(revision int64) WatchOption {
+	return func(opts *WatchOptions) { opts.Revision = revision }
+}
+
+// WithPrevKV returns the previous key value with the events.
+func WithPrevKV() WatchOption {
+	return func(opts *WatchOptions) { opts.PrevKV = true }
+}
+
+// NewWatchOptions builds WatchOptions from the options.
+func NewWatchOptions(opts ...WatchOption) *WatchOptions {
+	ret := &WatchOptions{}
+	for _, opt := range opts {
+		opt(ret)
+	}
+	return ret
+}
+
+// PutOptions are the options of the put of a compare and swap.
+type PutOptions struct {
+	LeaseID LeaseID
+}
+
+// PutOption configures the put of a compare and swap.
+type PutOption func(*PutOptions)
+
+// WithLease attaches the key to the lease.
+func WithLease(id LeaseID) PutOption {
+	return func(opts *PutOptions) { opts.LeaseID = id }
+}
+
+// NewPutOptions builds PutOptions from the options.
+func NewPutOptions(opts ...PutOption) *PutOptions {
+	ret := &PutOptions{}
+	for _, opt := range opts {
+		opt(ret)
+	}
+	return ret
+}
@@ -0,0 +1,47 @@
+package dao
+
+import (
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type binlogDb struct {
+	db *gorm.DB
+}
+
+func (s *binlogDb) List(tenantID string) ([]*dbmodel.Binlog, error) {
+	var r []*dbmodel.Binlog
+
+	// keep the order of binlogs in a field, which is the order of insertion
+	err := s.db.Model(&dbmodel.Binlog{}).Where("tenant_id = ?", tenantID).Order("id").Find(&r).Error
+	if err != nil {
+		log.Error("list binlogs failed", zap.String("tenant", tenantID), zap.Error(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (s *binlogDb) Insert(in []*dbmodel.Binlog) error {
+	err := s.db.CreateInBatches(in, 100).Error
+	if err != nil {
+		log.Error("insert binlogs failed", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *binlogDb) DeleteBySegmentID(tenantID string, segmentID typeutil.UniqueID) error {
+	err := s.db.Where("tenant_id = ? AND segment_id = ?", tenantID, segmentID).Delete(&dbmodel.Binlog{}).Error
+	if err != nil {
+		log.Error("delete binlogs by segment_id failed", zap.String("tenant", tenantID), zap.Int64("segmentID", segmentID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
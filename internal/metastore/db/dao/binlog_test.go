@@ -0,0 +1,134 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBinlog_List(t *testing.T) {
+	var binlogs = []*dbmodel.Binlog{
+		{
+			TenantID:      tenantID,
+			CollectionID:  collID1,
+			SegmentID:     segmentID1,
+			FieldID:       fieldID1,
+			LogType:       dbmodel.InsertLog,
+			LogID:         1,
+			NumEntries:    NumRows,
+			TimestampFrom: 100,
+			TimestampTo:   200,
+			LogPath:       "test_log_path_1",
+			LogSize:       1024,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `binlogs` WHERE tenant_id = ? ORDER BY id").
+		WithArgs(tenantID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "collection_id", "segment_id", "field_id", "log_type", "log_id", "num_entries", "timestamp_from", "timestamp_to", "log_path", "log_size", "created_at", "updated_at"}).
+				AddRow(binlogs[0].TenantID, binlogs[0].CollectionID, binlogs[0].SegmentID, binlogs[0].FieldID, binlogs[0].LogType, binlogs[0].LogID, binlogs[0].NumEntries, binlogs[0].TimestampFrom, binlogs[0].TimestampTo, binlogs[0].LogPath, binlogs[0].LogSize, binlogs[0].CreatedAt, binlogs[0].UpdatedAt))
+
+	// actual
+	res, err := binlogTestDb.List(tenantID)
+	assert.Nil(t, err)
+	assert.Equal(t, binlogs, res)
+}
+
+func TestBinlog_List_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `binlogs` WHERE tenant_id = ? ORDER BY id").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	res, err := binlogTestDb.List(tenantID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestBinlog_Insert(t *testing.T) {
+	var binlogs = []*dbmodel.Binlog{
+		{
+			TenantID:      tenantID,
+			CollectionID:  collID1,
+			SegmentID:     segmentID1,
+			FieldID:       fieldID1,
+			LogType:       dbmodel.DeltaLog,
+			LogID:         1,
+			NumEntries:    NumRows,
+			TimestampFrom: 100,
+			TimestampTo:   200,
+			LogPath:       "test_log_path_1",
+			LogSize:       1024,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `binlogs` (`tenant_id`,`collection_id`,`segment_id`,`field_id`,`log_type`,`log_id`,`num_entries`,`timestamp_from`,`timestamp_to`,`log_path`,`log_size`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?)").
+		WithArgs(binlogs[0].TenantID, binlogs[0].CollectionID, binlogs[0].SegmentID, binlogs[0].FieldID, binlogs[0].LogType, binlogs[0].LogID, binlogs[0].NumEntries, binlogs[0].TimestampFrom, binlogs[0].TimestampTo, binlogs[0].LogPath, binlogs[0].LogSize, binlogs[0].CreatedAt, binlogs[0].UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := binlogTestDb.Insert(binlogs)
+	assert.Nil(t, err)
+}
+
+func TestBinlog_Insert_Error(t *testing.T) {
+	var binlogs = []*dbmodel.Binlog{
+		{
+			TenantID:  tenantID,
+			SegmentID: segmentID1,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `binlogs` (`tenant_id`,`collection_id`,`segment_id`,`field_id`,`log_type`,`log_id`,`num_entries`,`timestamp_from`,`timestamp_to`,`log_path`,`log_size`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?)").
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := binlogTestDb.Insert(binlogs)
+	assert.Error(t, err)
+}
+
+func TestBinlog_DeleteBySegmentID(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `binlogs` WHERE tenant_id = ? AND segment_id = ?").
+		WithArgs(tenantID, segmentID1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := binlogTestDb.DeleteBySegmentID(tenantID, segmentID1)
+	assert.Nil(t, err)
+}
+
+func TestBinlog_DeleteBySegmentID_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `binlogs` WHERE tenant_id = ? AND segment_id = ?").
+		WithArgs(tenantID, segmentID1).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := binlogTestDb.DeleteBySegmentID(tenantID, segmentID1)
+	assert.Error(t, err)
+}
@@ -0,0 +1,50 @@
+package dao
+
+import (
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+type channelCheckpointDb struct {
+	db *gorm.DB
+}
+
+func (s *channelCheckpointDb) List(tenantID string) ([]*dbmodel.ChannelCheckpoint, error) {
+	var r []*dbmodel.ChannelCheckpoint
+
+	err := s.db.Model(&dbmodel.ChannelCheckpoint{}).Where("tenant_id = ?", tenantID).Find(&r).Error
+	if err != nil {
+		log.Error("list channel checkpoints failed", zap.String("tenant", tenantID), zap.Error(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (s *channelCheckpointDb) Upsert(in *dbmodel.ChannelCheckpoint) error {
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, channel_name)
+		DoUpdates: clause.AssignmentColumns([]string{"position"}),
+	}).Create(in).Error
+
+	if err != nil {
+		log.Error("upsert channel checkpoint failed", zap.String("tenant", in.TenantID), zap.String("channel", in.ChannelName), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *channelCheckpointDb) Delete(tenantID string, channelName string) error {
+	err := s.db.Where("tenant_id = ? AND channel_name = ?", tenantID, channelName).Delete(&dbmodel.ChannelCheckpoint{}).Error
+	if err != nil {
+		log.Error("delete channel checkpoint failed", zap.String("tenant", tenantID), zap.String("channel", channelName), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChannelCheckpoint_List(t *testing.T) {
+	var checkpoints = []*dbmodel.ChannelCheckpoint{
+		{
+			TenantID:    tenantID,
+			ChannelName: "test_channel_1",
+			Position:    []byte("position"),
+			CreatedAt:   time.Now(),
+			UpdatedAt:   time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `channel_checkpoints` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "channel_name", "position", "created_at", "updated_at"}).
+				AddRow(checkpoints[0].TenantID, checkpoints[0].ChannelName, checkpoints[0].Position, checkpoints[0].CreatedAt, checkpoints[0].UpdatedAt))
+
+	// actual
+	res, err := cpTestDb.List(tenantID)
+	assert.Nil(t, err)
+	assert.Equal(t, checkpoints, res)
+}
+
+func TestChannelCheckpoint_List_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `channel_checkpoints` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	res, err := cpTestDb.List(tenantID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestChannelCheckpoint_Upsert(t *testing.T) {
+	var checkpoint = &dbmodel.ChannelCheckpoint{
+		TenantID:    tenantID,
+		ChannelName: "test_channel_1",
+		Position:    []byte("position"),
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `channel_checkpoints` (`tenant_id`,`channel_name`,`position`,`created_at`,`updated_at`) VALUES (?,?,?,?,?) ON DUPLICATE KEY UPDATE `position`=VALUES(`position`)").
+		WithArgs(checkpoint.TenantID, checkpoint.ChannelName, checkpoint.Position, checkpoint.CreatedAt, checkpoint.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := cpTestDb.Upsert(checkpoint)
+	assert.Nil(t, err)
+}
+
+func TestChannelCheckpoint_Upsert_Error(t *testing.T) {
+	var checkpoint = &dbmodel.ChannelCheckpoint{
+		TenantID:    tenantID,
+		ChannelName: "test_channel_1",
+		Position:    []byte("position"),
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `channel_checkpoints` (`tenant_id`,`channel_name`,`position`,`created_at`,`updated_at`) VALUES (?,?,?,?,?) ON DUPLICATE KEY UPDATE `position`=VALUES(`position`)").
+		WithArgs(checkpoint.TenantID, checkpoint.ChannelName, checkpoint.Position, checkpoint.CreatedAt, checkpoint.UpdatedAt).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := cpTestDb.Upsert(checkpoint)
+	assert.Error(t, err)
+}
+
+func TestChannelCheckpoint_Delete(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `channel_checkpoints` WHERE tenant_id = ? AND channel_name = ?").
+		WithArgs(tenantID, "test_channel_1").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := cpTestDb.Delete(tenantID, "test_channel_1")
+	assert.Nil(t, err)
+}
+
+func TestChannelCheckpoint_Delete_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `channel_checkpoints` WHERE tenant_id = ? AND channel_name = ?").
+		WithArgs(tenantID, "test_channel_1").
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := cpTestDb.Delete(tenantID, "test_channel_1")
+	assert.Error(t, err)
+}
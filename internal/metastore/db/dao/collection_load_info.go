@@ -0,0 +1,102 @@
+package dao
+
+import (
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type collectionLoadInfoDb struct {
+	db *gorm.DB
+}
+
+func (s *collectionLoadInfoDb) List(tenantID string) ([]*dbmodel.CollectionLoadInfo, error) {
+	var r []*dbmodel.CollectionLoadInfo
+
+	err := s.db.Model(&dbmodel.CollectionLoadInfo{}).Where("tenant_id = ?", tenantID).Find(&r).Error
+	if err != nil {
+		log.Error("list collection load infos failed", zap.String("tenant", tenantID), zap.Error(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (s *collectionLoadInfoDb) Upsert(in *dbmodel.CollectionLoadInfo) error {
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, collection_id)
+		DoUpdates: clause.AssignmentColumns([]string{"load_info"}),
+	}).Create(in).Error
+
+	if err != nil {
+		log.Error("upsert collection load info failed", zap.String("tenant", in.TenantID), zap.Int64("collID", in.CollectionID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *collectionLoadInfoDb) Delete(tenantID string, collectionID typeutil.UniqueID) error {
+	err := s.db.Where("tenant_id = ? AND collection_id = ?", tenantID, collectionID).Delete(&dbmodel.CollectionLoadInfo{}).Error
+	if err != nil {
+		log.Error("delete collection load info failed", zap.String("tenant", tenantID), zap.Int64("collID", collectionID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+type partitionLoadInfoDb struct {
+	db *gorm.DB
+}
+
+func (s *partitionLoadInfoDb) List(tenantID string) ([]*dbmodel.PartitionLoadInfo, error) {
+	var r []*dbmodel.PartitionLoadInfo
+
+	err := s.db.Model(&dbmodel.PartitionLoadInfo{}).Where("tenant_id = ?", tenantID).Find(&r).Error
+	if err != nil {
+		log.Error("list partition load infos failed", zap.String("tenant", tenantID), zap.Error(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (s *partitionLoadInfoDb) Upsert(in []*dbmodel.PartitionLoadInfo) error {
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, collection_id, partition_id)
+		DoUpdates: clause.AssignmentColumns([]string{"load_info"}),
+	}).CreateInBatches(in, 100).Error
+
+	if err != nil {
+		log.Error("upsert partition load infos failed", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *partitionLoadInfoDb) DeleteByCollectionID(tenantID string, collectionID typeutil.UniqueID) error {
+	err := s.db.Where("tenant_id = ? AND collection_id = ?", tenantID, collectionID).Delete(&dbmodel.PartitionLoadInfo{}).Error
+	if err != nil {
+		log.Error("delete partition load infos by collection_id failed", zap.String("tenant", tenantID), zap.Int64("collID", collectionID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *partitionLoadInfoDb) Delete(tenantID string, collectionID typeutil.UniqueID, partitionIDs []typeutil.UniqueID) error {
+	err := s.db.Where("tenant_id = ? AND collection_id = ? AND partition_id IN ?", tenantID, collectionID, partitionIDs).Delete(&dbmodel.PartitionLoadInfo{}).Error
+	if err != nil {
+		log.Error("delete partition load infos failed", zap.String("tenant", tenantID), zap.Int64("collID", collectionID),
+			zap.Int64s("partitionIDs", partitionIDs), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
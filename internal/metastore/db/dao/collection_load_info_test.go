@@ -0,0 +1,251 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectionLoadInfo_List(t *testing.T) {
+	var infos = []*dbmodel.CollectionLoadInfo{
+		{
+			TenantID:     tenantID,
+			CollectionID: collID1,
+			LoadInfo:     []byte("load_info"),
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `collection_load_infos` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "collection_id", "load_info", "created_at", "updated_at"}).
+				AddRow(infos[0].TenantID, infos[0].CollectionID, infos[0].LoadInfo, infos[0].CreatedAt, infos[0].UpdatedAt))
+
+	// actual
+	res, err := collLoadTestDb.List(tenantID)
+	assert.Nil(t, err)
+	assert.Equal(t, infos, res)
+}
+
+func TestCollectionLoadInfo_List_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `collection_load_infos` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	res, err := collLoadTestDb.List(tenantID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestCollectionLoadInfo_Upsert(t *testing.T) {
+	var info = &dbmodel.CollectionLoadInfo{
+		TenantID:     tenantID,
+		CollectionID: collID1,
+		LoadInfo:     []byte("load_info"),
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `collection_load_infos` (`tenant_id`,`collection_id`,`load_info`,`created_at`,`updated_at`) VALUES (?,?,?,?,?) ON DUPLICATE KEY UPDATE `load_info`=VALUES(`load_info`)").
+		WithArgs(info.TenantID, info.CollectionID, info.LoadInfo, info.CreatedAt, info.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := collLoadTestDb.Upsert(info)
+	assert.Nil(t, err)
+}
+
+func TestCollectionLoadInfo_Upsert_Error(t *testing.T) {
+	var info = &dbmodel.CollectionLoadInfo{
+		TenantID:     tenantID,
+		CollectionID: collID1,
+		LoadInfo:     []byte("load_info"),
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `collection_load_infos` (`tenant_id`,`collection_id`,`load_info`,`created_at`,`updated_at`) VALUES (?,?,?,?,?) ON DUPLICATE KEY UPDATE `load_info`=VALUES(`load_info`)").
+		WithArgs(info.TenantID, info.CollectionID, info.LoadInfo, info.CreatedAt, info.UpdatedAt).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := collLoadTestDb.Upsert(info)
+	assert.Error(t, err)
+}
+
+func TestCollectionLoadInfo_Delete(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `collection_load_infos` WHERE tenant_id = ? AND collection_id = ?").
+		WithArgs(tenantID, collID1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := collLoadTestDb.Delete(tenantID, collID1)
+	assert.Nil(t, err)
+}
+
+func TestCollectionLoadInfo_Delete_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `collection_load_infos` WHERE tenant_id = ? AND collection_id = ?").
+		WithArgs(tenantID, collID1).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := collLoadTestDb.Delete(tenantID, collID1)
+	assert.Error(t, err)
+}
+
+func TestPartitionLoadInfo_List(t *testing.T) {
+	var infos = []*dbmodel.PartitionLoadInfo{
+		{
+			TenantID:     tenantID,
+			CollectionID: collID1,
+			PartitionID:  partitionID1,
+			LoadInfo:     []byte("load_info"),
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `partition_load_infos` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "collection_id", "partition_id", "load_info", "created_at", "updated_at"}).
+				AddRow(infos[0].TenantID, infos[0].CollectionID, infos[0].PartitionID, infos[0].LoadInfo, infos[0].CreatedAt, infos[0].UpdatedAt))
+
+	// actual
+	res, err := partLoadTestDb.List(tenantID)
+	assert.Nil(t, err)
+	assert.Equal(t, infos, res)
+}
+
+func TestPartitionLoadInfo_List_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `partition_load_infos` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	res, err := partLoadTestDb.List(tenantID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestPartitionLoadInfo_Upsert(t *testing.T) {
+	var infos = []*dbmodel.PartitionLoadInfo{
+		{
+			TenantID:     tenantID,
+			CollectionID: collID1,
+			PartitionID:  partitionID1,
+			LoadInfo:     []byte("load_info"),
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `partition_load_infos` (`tenant_id`,`collection_id`,`partition_id`,`load_info`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `load_info`=VALUES(`load_info`)").
+		WithArgs(infos[0].TenantID, infos[0].CollectionID, infos[0].PartitionID, infos[0].LoadInfo, infos[0].CreatedAt, infos[0].UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := partLoadTestDb.Upsert(infos)
+	assert.Nil(t, err)
+}
+
+func TestPartitionLoadInfo_Upsert_Error(t *testing.T) {
+	var infos = []*dbmodel.PartitionLoadInfo{
+		{
+			TenantID:     tenantID,
+			CollectionID: collID1,
+			PartitionID:  partitionID1,
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `partition_load_infos` (`tenant_id`,`collection_id`,`partition_id`,`load_info`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `load_info`=VALUES(`load_info`)").
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := partLoadTestDb.Upsert(infos)
+	assert.Error(t, err)
+}
+
+func TestPartitionLoadInfo_DeleteByCollectionID(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `partition_load_infos` WHERE tenant_id = ? AND collection_id = ?").
+		WithArgs(tenantID, collID1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := partLoadTestDb.DeleteByCollectionID(tenantID, collID1)
+	assert.Nil(t, err)
+}
+
+func TestPartitionLoadInfo_DeleteByCollectionID_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `partition_load_infos` WHERE tenant_id = ? AND collection_id = ?").
+		WithArgs(tenantID, collID1).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := partLoadTestDb.DeleteByCollectionID(tenantID, collID1)
+	assert.Error(t, err)
+}
+
+func TestPartitionLoadInfo_Delete(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `partition_load_infos` WHERE tenant_id = ? AND collection_id = ? AND partition_id IN (?,?)").
+		WithArgs(tenantID, collID1, partitionID1, partitionID1+1).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	mock.ExpectCommit()
+
+	// actual
+	err := partLoadTestDb.Delete(tenantID, collID1, []int64{partitionID1, partitionID1 + 1})
+	assert.Nil(t, err)
+}
+
+func TestPartitionLoadInfo_Delete_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `partition_load_infos` WHERE tenant_id = ? AND collection_id = ? AND partition_id IN (?)").
+		WithArgs(tenantID, collID1, partitionID1).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := partLoadTestDb.Delete(tenantID, collID1, []int64{partitionID1})
+	assert.Error(t, err)
+}
@@ -52,6 +52,14 @@ var (
 	userRoleTestDb  dbmodel.IUserRoleDb
 	grantTestDb     dbmodel.IGrantDb
 	grantIDTestDb   dbmodel.IGrantIDDb
+	segmentTestDb   dbmodel.ISegmentDb
+	binlogTestDb    dbmodel.IBinlogDb
+	dmChannelTestDb dbmodel.IDmChannelDb
+	cpTestDb        dbmodel.IChannelCheckpointDb
+	collLoadTestDb  dbmodel.ICollectionLoadInfoDb
+	partLoadTestDb  dbmodel.IPartitionLoadInfoDb
+	replicaTestDb   dbmodel.IReplicaDb
+	rgTestDb        dbmodel.IResourceGroupDb
 
 	properties = []*commonpb.KeyValuePair{
 		{
@@ -99,6 +107,14 @@ func TestMain(m *testing.M) {
 	userRoleTestDb = NewMetaDomain().UserRoleDb(ctx)
 	grantTestDb = NewMetaDomain().GrantDb(ctx)
 	grantIDTestDb = NewMetaDomain().GrantIDDb(ctx)
+	segmentTestDb = NewMetaDomain().SegmentDb(ctx)
+	binlogTestDb = NewMetaDomain().BinlogDb(ctx)
+	dmChannelTestDb = NewMetaDomain().DmChannelDb(ctx)
+	cpTestDb = NewMetaDomain().ChannelCheckpointDb(ctx)
+	collLoadTestDb = NewMetaDomain().CollectionLoadInfoDb(ctx)
+	partLoadTestDb = NewMetaDomain().PartitionLoadInfoDb(ctx)
+	replicaTestDb = NewMetaDomain().ReplicaDb(ctx)
+	rgTestDb = NewMetaDomain().ResourceGroupDb(ctx)
 
 	// m.Run entry for executing tests
 	os.Exit(m.Run())
@@ -0,0 +1,58 @@
+package dao
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+type dmChannelDb struct {
+	db *gorm.DB
+}
+
+func (s *dmChannelDb) Get(tenantID string, channelName string) (*dbmodel.DmChannel, error) {
+	var r *dbmodel.DmChannel
+
+	err := s.db.Model(&dbmodel.DmChannel{}).Where("tenant_id = ? AND channel_name = ?", tenantID, channelName).Take(&r).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, common.NewKeyNotExistError(fmt.Sprintf("%s/%s", tenantID, channelName))
+	}
+	if err != nil {
+		log.Error("get dm channel failed", zap.String("tenant", tenantID), zap.String("channel", channelName), zap.Error(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (s *dmChannelDb) Upsert(in *dbmodel.DmChannel) error {
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, channel_name)
+		DoUpdates: clause.AssignmentColumns([]string{"removed"}),
+	}).Create(in).Error
+
+	if err != nil {
+		log.Error("upsert dm channel failed", zap.String("tenant", in.TenantID), zap.String("channel", in.ChannelName), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *dmChannelDb) Delete(tenantID string, channelName string) error {
+	err := s.db.Where("tenant_id = ? AND channel_name = ?", tenantID, channelName).Delete(&dbmodel.DmChannel{}).Error
+	if err != nil {
+		log.Error("delete dm channel failed", zap.String("tenant", tenantID), zap.String("channel", channelName), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDmChannel_Get(t *testing.T) {
+	var channel = &dbmodel.DmChannel{
+		TenantID:    tenantID,
+		ChannelName: "test_channel_1",
+		Removed:     true,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `dm_channels` WHERE tenant_id = ? AND channel_name = ? LIMIT 1").
+		WithArgs(tenantID, channel.ChannelName).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "channel_name", "removed", "created_at", "updated_at"}).
+				AddRow(channel.TenantID, channel.ChannelName, channel.Removed, channel.CreatedAt, channel.UpdatedAt))
+
+	// actual
+	res, err := dmChannelTestDb.Get(tenantID, channel.ChannelName)
+	assert.Nil(t, err)
+	assert.Equal(t, channel, res)
+}
+
+func TestDmChannel_Get_NotFound(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `dm_channels` WHERE tenant_id = ? AND channel_name = ? LIMIT 1").
+		WithArgs(tenantID, "test_channel_1").
+		WillReturnRows(sqlmock.NewRows([]string{"tenant_id", "channel_name", "removed", "created_at", "updated_at"}))
+
+	// actual
+	res, err := dmChannelTestDb.Get(tenantID, "test_channel_1")
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestDmChannel_Get_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `dm_channels` WHERE tenant_id = ? AND channel_name = ? LIMIT 1").
+		WithArgs(tenantID, "test_channel_1").
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	res, err := dmChannelTestDb.Get(tenantID, "test_channel_1")
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestDmChannel_Upsert(t *testing.T) {
+	var channel = &dbmodel.DmChannel{
+		TenantID:    tenantID,
+		ChannelName: "test_channel_1",
+		Removed:     false,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `dm_channels` (`tenant_id`,`channel_name`,`removed`,`created_at`,`updated_at`) VALUES (?,?,?,?,?) ON DUPLICATE KEY UPDATE `removed`=VALUES(`removed`)").
+		WithArgs(channel.TenantID, channel.ChannelName, channel.Removed, channel.CreatedAt, channel.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := dmChannelTestDb.Upsert(channel)
+	assert.Nil(t, err)
+}
+
+func TestDmChannel_Upsert_Error(t *testing.T) {
+	var channel = &dbmodel.DmChannel{
+		TenantID:    tenantID,
+		ChannelName: "test_channel_1",
+		Removed:     true,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `dm_channels` (`tenant_id`,`channel_name`,`removed`,`created_at`,`updated_at`) VALUES (?,?,?,?,?) ON DUPLICATE KEY UPDATE `removed`=VALUES(`removed`)").
+		WithArgs(channel.TenantID, channel.ChannelName, channel.Removed, channel.CreatedAt, channel.UpdatedAt).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := dmChannelTestDb.Upsert(channel)
+	assert.Error(t, err)
+}
+
+func TestDmChannel_Delete(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `dm_channels` WHERE tenant_id = ? AND channel_name = ?").
+		WithArgs(tenantID, "test_channel_1").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := dmChannelTestDb.Delete(tenantID, "test_channel_1")
+	assert.Nil(t, err)
+}
+
+func TestDmChannel_Delete_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `dm_channels` WHERE tenant_id = ? AND channel_name = ?").
+		WithArgs(tenantID, "test_channel_1").
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := dmChannelTestDb.Delete(tenantID, "test_channel_1")
+	assert.Error(t, err)
+}
@@ -3,6 +3,7 @@ package dao
 import (
 	"go.uber.org/zap"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -29,7 +30,8 @@ func (s *indexDb) List(tenantID string) ([]*dbmodel.IndexResult, error) {
 	tx := s.db.Table("indexes").
 		Select("indexes.field_id AS field_id, indexes.collection_id AS collection_id, indexes.index_id AS index_id, "+
 			"indexes.index_name AS index_name, indexes.index_params AS index_params, indexes.type_params AS type_params, "+
-			"indexes.is_deleted AS is_deleted, indexes.create_time AS create_time").
+			"indexes.is_deleted AS is_deleted, indexes.create_time AS create_time, indexes.is_auto_index AS is_auto_index, "+
+			"indexes.user_index_params AS user_index_params").
 		Where("indexes.tenant_id = ?", tenantID)
 
 	var rs []*dbmodel.IndexResult
@@ -66,6 +68,31 @@ func (s *indexDb) Update(in *dbmodel.Index) error {
 	return nil
 }
 
+func (s *indexDb) Upsert(in []*dbmodel.Index) error {
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, collection_id, index_id)
+		DoUpdates: clause.AssignmentColumns([]string{"field_id", "index_name", "index_params", "type_params",
+			"user_index_params", "is_auto_index", "create_time", "is_deleted"}),
+	}).CreateInBatches(in, 100).Error
+
+	if err != nil {
+		log.Error("upsert indexes failed", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *indexDb) Delete(tenantID string, collID, indexID typeutil.UniqueID) error {
+	err := s.db.Where("tenant_id = ? AND collection_id = ? AND index_id = ?", tenantID, collID, indexID).Delete(&dbmodel.Index{}).Error
+	if err != nil {
+		log.Error("delete indexes failed", zap.String("tenant", tenantID), zap.Int64("collID", collID), zap.Int64("indexID", indexID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
 func (s *indexDb) MarkDeletedByCollectionID(tenantID string, collID typeutil.UniqueID) error {
 	err := s.db.Model(&dbmodel.Index{}).Where("tenant_id = ? AND collection_id = ?", tenantID, collID).Updates(dbmodel.Index{
 		IsDeleted: true,
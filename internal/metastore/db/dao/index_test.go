@@ -68,7 +68,7 @@ func TestIndex_List(t *testing.T) {
 	}
 
 	// expectation
-	mock.ExpectQuery("SELECT indexes.field_id AS field_id, indexes.collection_id AS collection_id, indexes.index_id AS index_id, indexes.index_name AS index_name, indexes.index_params AS index_params, indexes.type_params AS type_params, indexes.is_deleted AS is_deleted, indexes.create_time AS create_time FROM `indexes` WHERE indexes.tenant_id = ?").
+	mock.ExpectQuery("SELECT indexes.field_id AS field_id, indexes.collection_id AS collection_id, indexes.index_id AS index_id, indexes.index_name AS index_name, indexes.index_params AS index_params, indexes.type_params AS type_params, indexes.is_deleted AS is_deleted, indexes.create_time AS create_time, indexes.is_auto_index AS is_auto_index, indexes.user_index_params AS user_index_params FROM `indexes` WHERE indexes.tenant_id = ?").
 		WithArgs(tenantID).
 		WillReturnRows(
 			sqlmock.NewRows([]string{"field_id", "collection_id", "index_id", "index_name", "index_params", "type_params", "user_index_params", "is_auto_index", "is_deleted", "create_time"}).
@@ -82,7 +82,7 @@ func TestIndex_List(t *testing.T) {
 
 func TestIndex_List_Error(t *testing.T) {
 	// expectation
-	mock.ExpectQuery("SELECT indexes.field_id AS field_id, indexes.collection_id AS collection_id, indexes.index_id AS index_id, indexes.index_name AS index_name, indexes.index_params AS index_params, indexes.type_params AS type_params, indexes.is_deleted AS is_deleted, indexes.create_time AS create_time FROM `indexes` WHERE indexes.tenant_id = ?").
+	mock.ExpectQuery("SELECT indexes.field_id AS field_id, indexes.collection_id AS collection_id, indexes.index_id AS index_id, indexes.index_name AS index_name, indexes.index_params AS index_params, indexes.type_params AS type_params, indexes.is_deleted AS is_deleted, indexes.create_time AS create_time, indexes.is_auto_index AS is_auto_index, indexes.user_index_params AS user_index_params FROM `indexes` WHERE indexes.tenant_id = ?").
 		WithArgs(tenantID).
 		WillReturnError(errors.New("test error"))
 
@@ -246,3 +246,86 @@ func TestIndex_MarkDeletedByIdxID_Error(t *testing.T) {
 	err := indexTestDb.MarkDeletedByIndexID(tenantID, indexID1)
 	assert.Error(t, err)
 }
+
+func TestIndex_Upsert(t *testing.T) {
+	var indexes = []*dbmodel.Index{
+		{
+			TenantID:        tenantID,
+			FieldID:         fieldID1,
+			CollectionID:    collID1,
+			IndexID:         indexID1,
+			IndexName:       "test_index_1",
+			IndexParams:     "",
+			TypeParams:      "",
+			UserIndexParams: "",
+			IsAutoIndex:     false,
+			CreateTime:      uint64(1011),
+			IsDeleted:       false,
+			CreatedAt:       time.Now(),
+			UpdatedAt:       time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `indexes` (`tenant_id`,`field_id`,`collection_id`,`index_id`,`index_name`,`index_params`,`type_params`,`user_index_params`,`is_auto_index`,`create_time`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?) "+
+		"ON DUPLICATE KEY UPDATE `field_id`=VALUES(`field_id`),`index_name`=VALUES(`index_name`),`index_params`=VALUES(`index_params`),`type_params`=VALUES(`type_params`),"+
+		"`user_index_params`=VALUES(`user_index_params`),`is_auto_index`=VALUES(`is_auto_index`),`create_time`=VALUES(`create_time`),`is_deleted`=VALUES(`is_deleted`)").
+		WithArgs(indexes[0].TenantID, indexes[0].FieldID, indexes[0].CollectionID, indexes[0].IndexID, indexes[0].IndexName, indexes[0].IndexParams, indexes[0].TypeParams, indexes[0].UserIndexParams, indexes[0].IsAutoIndex, indexes[0].CreateTime, indexes[0].IsDeleted, indexes[0].CreatedAt, indexes[0].UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := indexTestDb.Upsert(indexes)
+	assert.Nil(t, err)
+}
+
+func TestIndex_Upsert_Error(t *testing.T) {
+	var indexes = []*dbmodel.Index{
+		{
+			TenantID:     tenantID,
+			CollectionID: collID1,
+			IndexID:      indexID1,
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `indexes` (`tenant_id`,`field_id`,`collection_id`,`index_id`,`index_name`,`index_params`,`type_params`,`user_index_params`,`is_auto_index`,`create_time`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?) " +
+		"ON DUPLICATE KEY UPDATE `field_id`=VALUES(`field_id`),`index_name`=VALUES(`index_name`),`index_params`=VALUES(`index_params`),`type_params`=VALUES(`type_params`)," +
+		"`user_index_params`=VALUES(`user_index_params`),`is_auto_index`=VALUES(`is_auto_index`),`create_time`=VALUES(`create_time`),`is_deleted`=VALUES(`is_deleted`)").
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := indexTestDb.Upsert(indexes)
+	assert.Error(t, err)
+}
+
+func TestIndex_Delete(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `indexes` WHERE tenant_id = ? AND collection_id = ? AND index_id = ?").
+		WithArgs(tenantID, collID1, indexID1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := indexTestDb.Delete(tenantID, collID1, indexID1)
+	assert.Nil(t, err)
+}
+
+func TestIndex_Delete_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `indexes` WHERE tenant_id = ? AND collection_id = ? AND index_id = ?").
+		WithArgs(tenantID, collID1, indexID1).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := indexTestDb.Delete(tenantID, collID1, indexID1)
+	assert.Error(t, err)
+}
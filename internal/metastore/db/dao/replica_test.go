@@ -0,0 +1,145 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplica_List(t *testing.T) {
+	var replicas = []*dbmodel.Replica{
+		{
+			TenantID:     tenantID,
+			CollectionID: collID1,
+			ReplicaID:    1,
+			ReplicaInfo:  []byte("replica_info"),
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `replicas` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "collection_id", "replica_id", "replica_info", "created_at", "updated_at"}).
+				AddRow(replicas[0].TenantID, replicas[0].CollectionID, replicas[0].ReplicaID, replicas[0].ReplicaInfo, replicas[0].CreatedAt, replicas[0].UpdatedAt))
+
+	// actual
+	res, err := replicaTestDb.List(tenantID)
+	assert.Nil(t, err)
+	assert.Equal(t, replicas, res)
+}
+
+func TestReplica_List_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `replicas` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	res, err := replicaTestDb.List(tenantID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestReplica_Upsert(t *testing.T) {
+	var replica = &dbmodel.Replica{
+		TenantID:     tenantID,
+		CollectionID: collID1,
+		ReplicaID:    1,
+		ReplicaInfo:  []byte("replica_info"),
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `replicas` (`tenant_id`,`collection_id`,`replica_id`,`replica_info`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `replica_info`=VALUES(`replica_info`)").
+		WithArgs(replica.TenantID, replica.CollectionID, replica.ReplicaID, replica.ReplicaInfo, replica.CreatedAt, replica.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := replicaTestDb.Upsert(replica)
+	assert.Nil(t, err)
+}
+
+func TestReplica_Upsert_Error(t *testing.T) {
+	var replica = &dbmodel.Replica{
+		TenantID:     tenantID,
+		CollectionID: collID1,
+		ReplicaID:    1,
+		ReplicaInfo:  []byte("replica_info"),
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `replicas` (`tenant_id`,`collection_id`,`replica_id`,`replica_info`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `replica_info`=VALUES(`replica_info`)").
+		WithArgs(replica.TenantID, replica.CollectionID, replica.ReplicaID, replica.ReplicaInfo, replica.CreatedAt, replica.UpdatedAt).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := replicaTestDb.Upsert(replica)
+	assert.Error(t, err)
+}
+
+func TestReplica_DeleteByCollectionID(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `replicas` WHERE tenant_id = ? AND collection_id = ?").
+		WithArgs(tenantID, collID1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := replicaTestDb.DeleteByCollectionID(tenantID, collID1)
+	assert.Nil(t, err)
+}
+
+func TestReplica_DeleteByCollectionID_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `replicas` WHERE tenant_id = ? AND collection_id = ?").
+		WithArgs(tenantID, collID1).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := replicaTestDb.DeleteByCollectionID(tenantID, collID1)
+	assert.Error(t, err)
+}
+
+func TestReplica_Delete(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `replicas` WHERE tenant_id = ? AND collection_id = ? AND replica_id = ?").
+		WithArgs(tenantID, collID1, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := replicaTestDb.Delete(tenantID, collID1, 1)
+	assert.Nil(t, err)
+}
+
+func TestReplica_Delete_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `replicas` WHERE tenant_id = ? AND collection_id = ? AND replica_id = ?").
+		WithArgs(tenantID, collID1, 1).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := replicaTestDb.Delete(tenantID, collID1, 1)
+	assert.Error(t, err)
+}
@@ -0,0 +1,50 @@
+package dao
+
+import (
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+type resourceGroupDb struct {
+	db *gorm.DB
+}
+
+func (s *resourceGroupDb) List(tenantID string) ([]*dbmodel.ResourceGroup, error) {
+	var r []*dbmodel.ResourceGroup
+
+	err := s.db.Model(&dbmodel.ResourceGroup{}).Where("tenant_id = ?", tenantID).Find(&r).Error
+	if err != nil {
+		log.Error("list resource groups failed", zap.String("tenant", tenantID), zap.Error(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (s *resourceGroupDb) Upsert(in []*dbmodel.ResourceGroup) error {
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, name)
+		DoUpdates: clause.AssignmentColumns([]string{"group_info"}),
+	}).CreateInBatches(in, 100).Error
+
+	if err != nil {
+		log.Error("upsert resource groups failed", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *resourceGroupDb) Delete(tenantID string, name string) error {
+	err := s.db.Where("tenant_id = ? AND name = ?", tenantID, name).Delete(&dbmodel.ResourceGroup{}).Error
+	if err != nil {
+		log.Error("delete resource group failed", zap.String("tenant", tenantID), zap.String("name", name), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
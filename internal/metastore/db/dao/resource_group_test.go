@@ -0,0 +1,118 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceGroup_List(t *testing.T) {
+	var rgs = []*dbmodel.ResourceGroup{
+		{
+			TenantID:  tenantID,
+			Name:      "rg1",
+			GroupInfo: []byte("group_info"),
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `resource_groups` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "name", "group_info", "created_at", "updated_at"}).
+				AddRow(rgs[0].TenantID, rgs[0].Name, rgs[0].GroupInfo, rgs[0].CreatedAt, rgs[0].UpdatedAt))
+
+	// actual
+	res, err := rgTestDb.List(tenantID)
+	assert.Nil(t, err)
+	assert.Equal(t, rgs, res)
+}
+
+func TestResourceGroup_List_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `resource_groups` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	res, err := rgTestDb.List(tenantID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestResourceGroup_Upsert(t *testing.T) {
+	var rgs = []*dbmodel.ResourceGroup{
+		{
+			TenantID:  tenantID,
+			Name:      "rg1",
+			GroupInfo: []byte("group_info"),
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `resource_groups` (`tenant_id`,`name`,`group_info`,`created_at`,`updated_at`) VALUES (?,?,?,?,?) ON DUPLICATE KEY UPDATE `group_info`=VALUES(`group_info`)").
+		WithArgs(rgs[0].TenantID, rgs[0].Name, rgs[0].GroupInfo, rgs[0].CreatedAt, rgs[0].UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := rgTestDb.Upsert(rgs)
+	assert.Nil(t, err)
+}
+
+func TestResourceGroup_Upsert_Error(t *testing.T) {
+	var rgs = []*dbmodel.ResourceGroup{
+		{
+			TenantID:  tenantID,
+			Name:      "rg1",
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `resource_groups` (`tenant_id`,`name`,`group_info`,`created_at`,`updated_at`) VALUES (?,?,?,?,?) ON DUPLICATE KEY UPDATE `group_info`=VALUES(`group_info`)").
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := rgTestDb.Upsert(rgs)
+	assert.Error(t, err)
+}
+
+func TestResourceGroup_Delete(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `resource_groups` WHERE tenant_id = ? AND name = ?").
+		WithArgs(tenantID, "rg1").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := rgTestDb.Delete(tenantID, "rg1")
+	assert.Nil(t, err)
+}
+
+func TestResourceGroup_Delete_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `resource_groups` WHERE tenant_id = ? AND name = ?").
+		WithArgs(tenantID, "rg1").
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := rgTestDb.Delete(tenantID, "rg1")
+	assert.Error(t, err)
+}
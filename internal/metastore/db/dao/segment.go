@@ -0,0 +1,76 @@
+package dao
+
+import (
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type segmentDb struct {
+	db *gorm.DB
+}
+
+func (s *segmentDb) List(tenantID string) ([]*dbmodel.Segment, error) {
+	var r []*dbmodel.Segment
+
+	err := s.db.Model(&dbmodel.Segment{}).Where("tenant_id = ?", tenantID).Find(&r).Error
+	if err != nil {
+		log.Error("list segments failed", zap.String("tenant", tenantID), zap.Error(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (s *segmentDb) Upsert(in []*dbmodel.Segment) error {
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, segment_id)
+		DoUpdates: clause.AssignmentColumns([]string{"dm_channel", "num_rows", "segment_state", "segment_info"}),
+	}).CreateInBatches(in, 100).Error
+
+	if err != nil {
+		log.Error("upsert segments failed", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *segmentDb) Delete(tenantID string, segmentID typeutil.UniqueID) error {
+	err := s.db.Where("tenant_id = ? AND segment_id = ?", tenantID, segmentID).Delete(&dbmodel.Segment{}).Error
+	if err != nil {
+		log.Error("delete segment failed", zap.String("tenant", tenantID), zap.Int64("segmentID", segmentID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *segmentDb) CountByCollectionID(tenantID string, collectionID typeutil.UniqueID) (int64, error) {
+	var count int64
+
+	err := s.db.Model(&dbmodel.Segment{}).Where("tenant_id = ? AND collection_id = ?", tenantID, collectionID).Count(&count).Error
+	if err != nil {
+		log.Error("count segments by collection_id failed", zap.String("tenant", tenantID), zap.Int64("collID", collectionID), zap.Error(err))
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (s *segmentDb) CountByPartitionID(tenantID string, collectionID, partitionID typeutil.UniqueID) (int64, error) {
+	var count int64
+
+	err := s.db.Model(&dbmodel.Segment{}).Where("tenant_id = ? AND collection_id = ? AND partition_id = ?", tenantID, collectionID, partitionID).Count(&count).Error
+	if err != nil {
+		log.Error("count segments by partition_id failed", zap.String("tenant", tenantID), zap.Int64("collID", collectionID),
+			zap.Int64("partitionID", partitionID), zap.Error(err))
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -32,8 +32,9 @@ func (s *segmentIndexDb) List(tenantID string) ([]*dbmodel.SegmentIndexResult, e
 			"segment_indexes.segment_id AS segment_id, segment_indexes.num_rows AS num_rows, segment_indexes.index_id AS index_id, "+
 			"segment_indexes.build_id AS build_id, segment_indexes.node_id AS node_id, segment_indexes.index_version AS index_version, "+
 			"segment_indexes.index_state AS index_state,segment_indexes.fail_reason AS fail_reason, segment_indexes.create_time AS create_time,"+
-			"segment_indexes.index_file_keys AS index_file_keys, segment_indexes.index_size AS index_size, segment_indexes.is_deleted AS is_deleted").
-		Where("indexes.tenant_id = ?", tenantID)
+			"segment_indexes.index_file_keys AS index_file_keys, segment_indexes.index_size AS index_size, segment_indexes.is_deleted AS is_deleted, "+
+			"segment_indexes.index_params AS index_params, segment_indexes.rebuild_job_id AS rebuild_job_id").
+		Where("segment_indexes.tenant_id = ?", tenantID)
 
 	var rs []*dbmodel.SegmentIndexResult
 	err := tx.Scan(&rs).Error
@@ -67,8 +68,9 @@ func (s *segmentIndexDb) Update(in *dbmodel.SegmentIndex) error {
 
 func (s *segmentIndexDb) Upsert(in []*dbmodel.SegmentIndex) error {
 	err := s.db.Clauses(clause.OnConflict{
-		// constraint UNIQUE (tenant_id, segment_id, index_id)
-		DoUpdates: clause.AssignmentColumns([]string{"index_build_id", "enable_index", "create_time"}),
+		// constraint UNIQUE (tenant_id, build_id)
+		DoUpdates: clause.AssignmentColumns([]string{"num_rows", "node_id", "index_version", "index_state", "fail_reason",
+			"create_time", "index_file_keys", "index_size", "index_params", "rebuild_job_id", "is_deleted"}),
 	}).CreateInBatches(in, 100).Error
 
 	if err != nil {
@@ -123,3 +125,13 @@ func (s *segmentIndexDb) MarkDeletedByBuildID(tenantID string, buildID typeutil.
 
 	return nil
 }
+
+func (s *segmentIndexDb) Delete(tenantID string, buildID typeutil.UniqueID) error {
+	err := s.db.Where("tenant_id = ? AND build_id = ?", tenantID, buildID).Delete(&dbmodel.SegmentIndex{}).Error
+	if err != nil {
+		log.Error("delete segment_indexes failed", zap.String("tenant", tenantID), zap.Int64("buildID", buildID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
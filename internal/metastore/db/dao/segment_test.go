@@ -0,0 +1,174 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSegment_List(t *testing.T) {
+	var segments = []*dbmodel.Segment{
+		{
+			TenantID:     tenantID,
+			SegmentID:    segmentID1,
+			CollectionID: collID1,
+			PartitionID:  partitionID1,
+			DmChannel:    "test_channel_1",
+			NumRows:      NumRows,
+			SegmentState: 3,
+			SegmentInfo:  []byte("segment_info"),
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `segments` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "segment_id", "collection_id", "partition_id", "dm_channel", "num_rows", "segment_state", "segment_info", "created_at", "updated_at"}).
+				AddRow(segments[0].TenantID, segments[0].SegmentID, segments[0].CollectionID, segments[0].PartitionID, segments[0].DmChannel, segments[0].NumRows, segments[0].SegmentState, segments[0].SegmentInfo, segments[0].CreatedAt, segments[0].UpdatedAt))
+
+	// actual
+	res, err := segmentTestDb.List(tenantID)
+	assert.Nil(t, err)
+	assert.Equal(t, segments, res)
+}
+
+func TestSegment_List_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `segments` WHERE tenant_id = ?").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	res, err := segmentTestDb.List(tenantID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestSegment_Upsert(t *testing.T) {
+	var segments = []*dbmodel.Segment{
+		{
+			TenantID:     tenantID,
+			SegmentID:    segmentID1,
+			CollectionID: collID1,
+			PartitionID:  partitionID1,
+			DmChannel:    "test_channel_1",
+			NumRows:      NumRows,
+			SegmentState: 3,
+			SegmentInfo:  []byte("segment_info"),
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `segments` (`tenant_id`,`segment_id`,`collection_id`,`partition_id`,`dm_channel`,`num_rows`,`segment_state`,`segment_info`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `dm_channel`=VALUES(`dm_channel`),`num_rows`=VALUES(`num_rows`),`segment_state`=VALUES(`segment_state`),`segment_info`=VALUES(`segment_info`)").
+		WithArgs(segments[0].TenantID, segments[0].SegmentID, segments[0].CollectionID, segments[0].PartitionID, segments[0].DmChannel, segments[0].NumRows, segments[0].SegmentState, segments[0].SegmentInfo, segments[0].CreatedAt, segments[0].UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := segmentTestDb.Upsert(segments)
+	assert.Nil(t, err)
+}
+
+func TestSegment_Upsert_Error(t *testing.T) {
+	var segments = []*dbmodel.Segment{
+		{
+			TenantID:  tenantID,
+			SegmentID: segmentID1,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `segments` (`tenant_id`,`segment_id`,`collection_id`,`partition_id`,`dm_channel`,`num_rows`,`segment_state`,`segment_info`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `dm_channel`=VALUES(`dm_channel`),`num_rows`=VALUES(`num_rows`),`segment_state`=VALUES(`segment_state`),`segment_info`=VALUES(`segment_info`)").
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := segmentTestDb.Upsert(segments)
+	assert.Error(t, err)
+}
+
+func TestSegment_Delete(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `segments` WHERE tenant_id = ? AND segment_id = ?").
+		WithArgs(tenantID, segmentID1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := segmentTestDb.Delete(tenantID, segmentID1)
+	assert.Nil(t, err)
+}
+
+func TestSegment_Delete_Error(t *testing.T) {
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `segments` WHERE tenant_id = ? AND segment_id = ?").
+		WithArgs(tenantID, segmentID1).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := segmentTestDb.Delete(tenantID, segmentID1)
+	assert.Error(t, err)
+}
+
+func TestSegment_CountByCollectionID(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT count(*) FROM `segments` WHERE tenant_id = ? AND collection_id = ?").
+		WithArgs(tenantID, collID1).
+		WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(2))
+
+	// actual
+	count, err := segmentTestDb.CountByCollectionID(tenantID, collID1)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 2, count)
+}
+
+func TestSegment_CountByCollectionID_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT count(*) FROM `segments` WHERE tenant_id = ? AND collection_id = ?").
+		WithArgs(tenantID, collID1).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	_, err := segmentTestDb.CountByCollectionID(tenantID, collID1)
+	assert.Error(t, err)
+}
+
+func TestSegment_CountByPartitionID(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT count(*) FROM `segments` WHERE tenant_id = ? AND collection_id = ? AND partition_id = ?").
+		WithArgs(tenantID, collID1, partitionID1).
+		WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(0))
+
+	// actual
+	count, err := segmentTestDb.CountByPartitionID(tenantID, collID1, partitionID1)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 0, count)
+}
+
+func TestSegment_CountByPartitionID_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT count(*) FROM `segments` WHERE tenant_id = ? AND collection_id = ? AND partition_id = ?").
+		WithArgs(tenantID, collID1, partitionID1).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	_, err := segmentTestDb.CountByPartitionID(tenantID, collID1, partitionID1)
+	assert.Error(t, err)
+}
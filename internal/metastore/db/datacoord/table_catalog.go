@@ -0,0 +1,637 @@
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/msgpb"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/segmentutil"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/contextutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const allPartitionID = -1
+
+type Catalog struct {
+	metaDomain dbmodel.IMetaDomain
+	txImpl     dbmodel.ITransaction
+}
+
+func NewTableCatalog(txImpl dbmodel.ITransaction, metaDomain dbmodel.IMetaDomain) *Catalog {
+	return &Catalog{
+		txImpl:     txImpl,
+		metaDomain: metaDomain,
+	}
+}
+
+func (tc *Catalog) ListSegments(ctx context.Context) ([]*datapb.SegmentInfo, error) {
+	tenantID := contextutil.TenantID(ctx)
+
+	segments, err := tc.metaDomain.SegmentDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	binlogs, err := tc.metaDomain.BinlogDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+	insertLogs, deltaLogs, statsLogs := unmarshalBinlogs(binlogs)
+
+	result := make([]*datapb.SegmentInfo, 0, len(segments))
+	for _, segment := range segments {
+		info := &datapb.SegmentInfo{}
+		err = proto.Unmarshal(segment.SegmentInfo, info)
+		if err != nil {
+			log.Error("unmarshal segment info failed", zap.String("tenant", tenantID), zap.Int64("segmentID", segment.SegmentID), zap.Error(err))
+			return nil, err
+		}
+		info.Binlogs = insertLogs[segment.SegmentID]
+		info.Deltalogs = deltaLogs[segment.SegmentID]
+		info.Statslogs = statsLogs[segment.SegmentID]
+		result = append(result, info)
+	}
+
+	return result, nil
+}
+
+func (tc *Catalog) AddSegment(ctx context.Context, segment *datapb.SegmentInfo) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		return tc.saveSegment(txCtx, tenantID, segment, true)
+	})
+}
+
+func (tc *Catalog) AlterSegments(ctx context.Context, newSegments []*datapb.SegmentInfo) error {
+	if len(newSegments) == 0 {
+		return nil
+	}
+	tenantID := contextutil.TenantID(ctx)
+
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		for _, segment := range newSegments {
+			if err := tc.saveSegment(txCtx, tenantID, segment, true); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, segment := range newSegments {
+		collectMetrics(segment)
+	}
+	return nil
+}
+
+func (tc *Catalog) AlterSegmentsAndAddNewSegment(ctx context.Context, segments []*datapb.SegmentInfo, newSegment *datapb.SegmentInfo) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		// the binlogs of compacted segments are unchanged
+		for _, segment := range segments {
+			if err := tc.saveSegment(txCtx, tenantID, segment, false); err != nil {
+				return err
+			}
+		}
+
+		if newSegment != nil {
+			return tc.saveSegment(txCtx, tenantID, newSegment, true)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if newSegment != nil && newSegment.GetNumOfRows() > 0 {
+		collectMetrics(newSegment)
+	}
+	return nil
+}
+
+func (tc *Catalog) AlterSegment(ctx context.Context, newSegment *datapb.SegmentInfo, oldSegment *datapb.SegmentInfo) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		return tc.saveSegment(txCtx, tenantID, newSegment, true)
+	})
+	if err != nil {
+		return err
+	}
+
+	collectMetrics(newSegment)
+	return nil
+}
+
+func (tc *Catalog) SaveDroppedSegmentsInBatch(ctx context.Context, segments []*datapb.SegmentInfo) error {
+	if len(segments) == 0 {
+		return nil
+	}
+	tenantID := contextutil.TenantID(ctx)
+
+	rows := make([]*dbmodel.Segment, 0, len(segments))
+	for _, segment := range segments {
+		row, err := marshalSegment(tenantID, segment)
+		if err != nil {
+			return err
+		}
+		rows = append(rows, row)
+	}
+
+	return tc.metaDomain.SegmentDb(ctx).Upsert(rows)
+}
+
+func (tc *Catalog) DropSegment(ctx context.Context, segment *datapb.SegmentInfo) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		err := tc.metaDomain.SegmentDb(txCtx).Delete(tenantID, segment.GetID())
+		if err != nil {
+			return err
+		}
+
+		return tc.metaDomain.BinlogDb(txCtx).DeleteBySegmentID(tenantID, segment.GetID())
+	})
+	if err != nil {
+		return err
+	}
+
+	metrics.CleanupDataCoordSegmentMetrics(segment.GetCollectionID(), segment.GetID())
+	return nil
+}
+
+// saveSegment saves the segment info, the binlogs of the segment are replaced if withBinlogs is true.
+func (tc *Catalog) saveSegment(txCtx context.Context, tenantID string, segment *datapb.SegmentInfo, withBinlogs bool) error {
+	row, err := marshalSegment(tenantID, segment)
+	if err != nil {
+		return err
+	}
+
+	err = tc.metaDomain.SegmentDb(txCtx).Upsert([]*dbmodel.Segment{row})
+	if err != nil {
+		return err
+	}
+
+	if !withBinlogs {
+		return nil
+	}
+
+	err = tc.metaDomain.BinlogDb(txCtx).DeleteBySegmentID(tenantID, segment.GetID())
+	if err != nil {
+		return err
+	}
+
+	binlogs := marshalBinlogs(tenantID, segment)
+	if len(binlogs) == 0 {
+		return nil
+	}
+	return tc.metaDomain.BinlogDb(txCtx).Insert(binlogs)
+}
+
+func (tc *Catalog) MarkChannelAdded(ctx context.Context, channel string) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	err := tc.metaDomain.DmChannelDb(ctx).Upsert(&dbmodel.DmChannel{
+		TenantID:    tenantID,
+		ChannelName: channel,
+		Removed:     false,
+	})
+	if err != nil {
+		log.Error("failed to mark channel added", zap.String("channel", channel), zap.Error(err))
+		return err
+	}
+	log.Info("NON remove flag added", zap.String("channel", channel))
+	return nil
+}
+
+func (tc *Catalog) MarkChannelDeleted(ctx context.Context, channel string) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	err := tc.metaDomain.DmChannelDb(ctx).Upsert(&dbmodel.DmChannel{
+		TenantID:    tenantID,
+		ChannelName: channel,
+		Removed:     true,
+	})
+	if err != nil {
+		log.Error("failed to mark channel dropped", zap.String("channel", channel), zap.Error(err))
+		return err
+	}
+	log.Info("remove flag added", zap.String("channel", channel))
+	return nil
+}
+
+func (tc *Catalog) ShouldDropChannel(ctx context.Context, channel string) bool {
+	tenantID := contextutil.TenantID(ctx)
+
+	ch, err := tc.metaDomain.DmChannelDb(ctx).Get(tenantID, channel)
+	return err == nil && ch.Removed
+}
+
+func (tc *Catalog) ChannelExists(ctx context.Context, channel string) bool {
+	tenantID := contextutil.TenantID(ctx)
+
+	ch, err := tc.metaDomain.DmChannelDb(ctx).Get(tenantID, channel)
+	return err == nil && !ch.Removed
+}
+
+// DropChannel removes channel remove flag after whole procedure is finished
+func (tc *Catalog) DropChannel(ctx context.Context, channel string) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	log.Info("removing channel remove flag", zap.String("channel", channel))
+	return tc.metaDomain.DmChannelDb(ctx).Delete(tenantID, channel)
+}
+
+func (tc *Catalog) ListChannelCheckpoint(ctx context.Context) (map[string]*msgpb.MsgPosition, error) {
+	tenantID := contextutil.TenantID(ctx)
+
+	rs, err := tc.metaDomain.ChannelCheckpointDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	channelCPs := make(map[string]*msgpb.MsgPosition, len(rs))
+	for _, r := range rs {
+		channelCP := &msgpb.MsgPosition{}
+		err = proto.Unmarshal(r.Position, channelCP)
+		if err != nil {
+			log.Error("unmarshal channelCP failed when ListChannelCheckpoint", zap.String("channel", r.ChannelName), zap.Error(err))
+			return nil, err
+		}
+		channelCPs[r.ChannelName] = channelCP
+	}
+
+	return channelCPs, nil
+}
+
+func (tc *Catalog) SaveChannelCheckpoint(ctx context.Context, vChannel string, pos *msgpb.MsgPosition) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	position, err := proto.Marshal(pos)
+	if err != nil {
+		return err
+	}
+
+	return tc.metaDomain.ChannelCheckpointDb(ctx).Upsert(&dbmodel.ChannelCheckpoint{
+		TenantID:    tenantID,
+		ChannelName: vChannel,
+		Position:    position,
+	})
+}
+
+func (tc *Catalog) DropChannelCheckpoint(ctx context.Context, vChannel string) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.ChannelCheckpointDb(ctx).Delete(tenantID, vChannel)
+}
+
+func (tc *Catalog) CreateIndex(ctx context.Context, index *model.Index) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	idx, err := marshalIndex(tenantID, index)
+	if err != nil {
+		return err
+	}
+
+	err = tc.metaDomain.IndexDb(ctx).Upsert([]*dbmodel.Index{idx})
+	if err != nil {
+		log.Error("insert indexes failed", zap.String("tenant", tenantID), zap.Int64("collID", index.CollectionID),
+			zap.Int64("indexID", index.IndexID), zap.String("indexName", index.IndexName), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (tc *Catalog) ListIndexes(ctx context.Context) ([]*model.Index, error) {
+	tenantID := contextutil.TenantID(ctx)
+
+	rs, err := tc.metaDomain.IndexDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dbmodel.UnmarshalIndexModel(rs)
+}
+
+func (tc *Catalog) AlterIndex(ctx context.Context, index *model.Index) error {
+	return tc.CreateIndex(ctx, index)
+}
+
+func (tc *Catalog) AlterIndexes(ctx context.Context, indexes []*model.Index) error {
+	if len(indexes) == 0 {
+		return nil
+	}
+	tenantID := contextutil.TenantID(ctx)
+
+	idxes := make([]*dbmodel.Index, 0, len(indexes))
+	for _, index := range indexes {
+		idx, err := marshalIndex(tenantID, index)
+		if err != nil {
+			return err
+		}
+		idxes = append(idxes, idx)
+	}
+
+	return tc.metaDomain.IndexDb(ctx).Upsert(idxes)
+}
+
+func (tc *Catalog) DropIndex(ctx context.Context, collID, dropIdxID typeutil.UniqueID) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	err := tc.metaDomain.IndexDb(ctx).Delete(tenantID, collID, dropIdxID)
+	if err != nil {
+		log.Error("drop collection index meta fail", zap.Int64("collectionID", collID),
+			zap.Int64("indexID", dropIdxID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (tc *Catalog) CreateSegmentIndex(ctx context.Context, segIdx *model.SegmentIndex) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	idx, err := marshalSegmentIndex(tenantID, segIdx)
+	if err != nil {
+		return err
+	}
+
+	err = tc.metaDomain.SegmentIndexDb(ctx).Upsert([]*dbmodel.SegmentIndex{idx})
+	if err != nil {
+		log.Error("failed to save segment index meta", zap.String("tenant", tenantID), zap.Int64("buildID", segIdx.BuildID),
+			zap.Int64("segmentID", segIdx.SegmentID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (tc *Catalog) ListSegmentIndexes(ctx context.Context) ([]*model.SegmentIndex, error) {
+	tenantID := contextutil.TenantID(ctx)
+
+	rs, err := tc.metaDomain.SegmentIndexDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dbmodel.UnmarshalSegmentIndexModel(rs)
+}
+
+func (tc *Catalog) AlterSegmentIndex(ctx context.Context, segIdx *model.SegmentIndex) error {
+	return tc.CreateSegmentIndex(ctx, segIdx)
+}
+
+func (tc *Catalog) AlterSegmentIndexes(ctx context.Context, segIdxes []*model.SegmentIndex) error {
+	if len(segIdxes) == 0 {
+		return nil
+	}
+	tenantID := contextutil.TenantID(ctx)
+
+	idxes := make([]*dbmodel.SegmentIndex, 0, len(segIdxes))
+	for _, segIdx := range segIdxes {
+		idx, err := marshalSegmentIndex(tenantID, segIdx)
+		if err != nil {
+			return err
+		}
+		idxes = append(idxes, idx)
+	}
+
+	return tc.metaDomain.SegmentIndexDb(ctx).Upsert(idxes)
+}
+
+func (tc *Catalog) DropSegmentIndex(ctx context.Context, collID, partID, segID, buildID typeutil.UniqueID) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	err := tc.metaDomain.SegmentIndexDb(ctx).Delete(tenantID, buildID)
+	if err != nil {
+		log.Error("drop segment index meta fail", zap.Int64("buildID", buildID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// GcConfirm returns true if related collection/partition is not found.
+// DataCoord will remove all the meta eventually after GC is finished.
+func (tc *Catalog) GcConfirm(ctx context.Context, collectionID, partitionID typeutil.UniqueID) bool {
+	tenantID := contextutil.TenantID(ctx)
+
+	var (
+		count int64
+		err   error
+	)
+	if partitionID == allPartitionID {
+		count, err = tc.metaDomain.SegmentDb(ctx).CountByCollectionID(tenantID, collectionID)
+	} else {
+		count, err = tc.metaDomain.SegmentDb(ctx).CountByPartitionID(tenantID, collectionID, partitionID)
+	}
+	// error case can be regarded as not finished.
+	return err == nil && count == 0
+}
+
+// marshalSegment converts the segment info to a row, the binlogs are excluded.
+func marshalSegment(tenantID string, segment *datapb.SegmentInfo) (*dbmodel.Segment, error) {
+	noBinlogsSegment := proto.Clone(segment).(*datapb.SegmentInfo)
+	noBinlogsSegment.Binlogs = nil
+	noBinlogsSegment.Deltalogs = nil
+	noBinlogsSegment.Statslogs = nil
+	segmentutil.ReCalcRowCount(segment, noBinlogsSegment)
+
+	segmentInfo, err := proto.Marshal(noBinlogsSegment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal segment: %d, err: %w", segment.GetID(), err)
+	}
+
+	return &dbmodel.Segment{
+		TenantID:     tenantID,
+		SegmentID:    segment.GetID(),
+		CollectionID: segment.GetCollectionID(),
+		PartitionID:  segment.GetPartitionID(),
+		DmChannel:    segment.GetInsertChannel(),
+		NumRows:      noBinlogsSegment.GetNumOfRows(),
+		SegmentState: int32(segment.GetState()),
+		SegmentInfo:  segmentInfo,
+	}, nil
+}
+
+func marshalBinlogs(tenantID string, segment *datapb.SegmentInfo) []*dbmodel.Binlog {
+	ret := make([]*dbmodel.Binlog, 0)
+	appendFn := func(logType int32, fieldBinlogs []*datapb.FieldBinlog) {
+		for _, fieldBinlog := range fieldBinlogs {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				ret = append(ret, &dbmodel.Binlog{
+					TenantID:      tenantID,
+					CollectionID:  segment.GetCollectionID(),
+					SegmentID:     segment.GetID(),
+					FieldID:       fieldBinlog.GetFieldID(),
+					LogType:       logType,
+					LogID:         binlog.GetLogID(),
+					NumEntries:    binlog.GetEntriesNum(),
+					TimestampFrom: binlog.GetTimestampFrom(),
+					TimestampTo:   binlog.GetTimestampTo(),
+					LogPath:       binlog.GetLogPath(),
+					LogSize:       binlog.GetLogSize(),
+				})
+			}
+		}
+	}
+
+	appendFn(dbmodel.InsertLog, segment.GetBinlogs())
+	appendFn(dbmodel.DeltaLog, segment.GetDeltalogs())
+	appendFn(dbmodel.StatsLog, segment.GetStatslogs())
+	return ret
+}
+
+// unmarshalBinlogs groups the binlogs by segment and field, returns insert logs, delta logs and stats logs.
+func unmarshalBinlogs(binlogs []*dbmodel.Binlog) (map[int64][]*datapb.FieldBinlog, map[int64][]*datapb.FieldBinlog, map[int64][]*datapb.FieldBinlog) {
+	logs := map[int32]map[int64][]*datapb.FieldBinlog{
+		dbmodel.InsertLog: make(map[int64][]*datapb.FieldBinlog),
+		dbmodel.DeltaLog:  make(map[int64][]*datapb.FieldBinlog),
+		dbmodel.StatsLog:  make(map[int64][]*datapb.FieldBinlog),
+	}
+
+	for _, binlog := range binlogs {
+		segmentLogs, ok := logs[binlog.LogType]
+		if !ok {
+			log.Warn("unknown binlog type", zap.Int64("segmentID", binlog.SegmentID), zap.Int32("logType", binlog.LogType))
+			continue
+		}
+
+		var fieldBinlog *datapb.FieldBinlog
+		for _, fb := range segmentLogs[binlog.SegmentID] {
+			if fb.GetFieldID() == binlog.FieldID {
+				fieldBinlog = fb
+				break
+			}
+		}
+		if fieldBinlog == nil {
+			fieldBinlog = &datapb.FieldBinlog{FieldID: binlog.FieldID}
+			segmentLogs[binlog.SegmentID] = append(segmentLogs[binlog.SegmentID], fieldBinlog)
+		}
+		fieldBinlog.Binlogs = append(fieldBinlog.Binlogs, &datapb.Binlog{
+			EntriesNum:    binlog.NumEntries,
+			TimestampFrom: binlog.TimestampFrom,
+			TimestampTo:   binlog.TimestampTo,
+			LogPath:       binlog.LogPath,
+			LogSize:       binlog.LogSize,
+			LogID:         binlog.LogID,
+		})
+	}
+
+	return logs[dbmodel.InsertLog], logs[dbmodel.DeltaLog], logs[dbmodel.StatsLog]
+}
+
+func marshalIndex(tenantID string, index *model.Index) (*dbmodel.Index, error) {
+	indexParamsBytes, err := json.Marshal(index.IndexParams)
+	if err != nil {
+		log.Error("marshal IndexParams of index failed", zap.String("tenant", tenantID),
+			zap.Int64("collID", index.CollectionID), zap.Int64("indexID", index.IndexID),
+			zap.String("indexName", index.IndexName), zap.Error(err))
+		return nil, err
+	}
+
+	userIndexParamsBytes, err := json.Marshal(index.UserIndexParams)
+	if err != nil {
+		log.Error("marshal userIndexParams of index failed", zap.String("tenant", tenantID),
+			zap.Int64("collID", index.CollectionID), zap.Int64("indexID", index.IndexID),
+			zap.String("indexName", index.IndexName), zap.Error(err))
+		return nil, err
+	}
+
+	typeParamsBytes, err := json.Marshal(index.TypeParams)
+	if err != nil {
+		log.Error("marshal TypeParams of index failed", zap.String("tenant", tenantID),
+			zap.Int64("collID", index.CollectionID), zap.Int64("indexID", index.IndexID),
+			zap.String("indexName", index.IndexName), zap.Error(err))
+		return nil, err
+	}
+
+	return &dbmodel.Index{
+		TenantID:        tenantID,
+		CollectionID:    index.CollectionID,
+		FieldID:         index.FieldID,
+		IndexID:         index.IndexID,
+		IndexName:       index.IndexName,
+		TypeParams:      string(typeParamsBytes),
+		IndexParams:     string(indexParamsBytes),
+		CreateTime:      index.CreateTime,
+		IsDeleted:       index.IsDeleted,
+		IsAutoIndex:     index.IsAutoIndex,
+		UserIndexParams: string(userIndexParamsBytes),
+	}, nil
+}
+
+func marshalSegmentIndex(tenantID string, segIdx *model.SegmentIndex) (*dbmodel.SegmentIndex, error) {
+	indexFileKeysBytes, err := json.Marshal(segIdx.IndexFileKeys)
+	if err != nil {
+		log.Error("marshal IndexFiles of segment index failed", zap.String("tenant", tenantID),
+			zap.Int64("collID", segIdx.CollectionID), zap.Int64("indexID", segIdx.IndexID),
+			zap.Int64("segID", segIdx.SegmentID), zap.Int64("buildID", segIdx.BuildID), zap.Error(err))
+		return nil, err
+	}
+
+	var indexParams string
+	if len(segIdx.IndexParams) > 0 {
+		indexParamsBytes, err := json.Marshal(segIdx.IndexParams)
+		if err != nil {
+			log.Error("marshal IndexParams of segment index failed", zap.String("tenant", tenantID),
+				zap.Int64("collID", segIdx.CollectionID), zap.Int64("indexID", segIdx.IndexID),
+				zap.Int64("segID", segIdx.SegmentID), zap.Int64("buildID", segIdx.BuildID), zap.Error(err))
+			return nil, err
+		}
+		indexParams = string(indexParamsBytes)
+	}
+
+	return &dbmodel.SegmentIndex{
+		TenantID:      tenantID,
+		CollectionID:  segIdx.CollectionID,
+		PartitionID:   segIdx.PartitionID,
+		SegmentID:     segIdx.SegmentID,
+		NumRows:       segIdx.NumRows,
+		IndexID:       segIdx.IndexID,
+		BuildID:       segIdx.BuildID,
+		NodeID:        segIdx.NodeID,
+		IndexVersion:  segIdx.IndexVersion,
+		IndexState:    int32(segIdx.IndexState),
+		FailReason:    segIdx.FailReason,
+		CreateTime:    segIdx.CreateTime,
+		IndexFileKeys: string(indexFileKeysBytes),
+		IndexSize:     segIdx.IndexSize,
+		IndexParams:   indexParams,
+		RebuildJobID:  segIdx.RebuildJobID,
+		IsDeleted:     segIdx.IsDeleted,
+	}, nil
+}
+
+func collectMetrics(s *datapb.SegmentInfo) {
+	statsFieldFn := func(fieldBinlogs []*datapb.FieldBinlog) int {
+		cnt := 0
+		for _, fbs := range fieldBinlogs {
+			cnt += len(fbs.Binlogs)
+		}
+		return cnt
+	}
+
+	cnt := 0
+	cnt += statsFieldFn(s.GetBinlogs())
+	cnt += statsFieldFn(s.GetStatslogs())
+	cnt += statsFieldFn(s.GetDeltalogs())
+
+	metrics.DataCoordSegmentBinLogFileCount.
+		WithLabelValues(fmt.Sprint(s.CollectionID), fmt.Sprint(s.GetID())).
+		Set(float64(cnt))
+}
@@ -0,0 +1,449 @@
+package datacoord
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/msgpb"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel/mocks"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/contextutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	tenantID     = "test_tenant"
+	collID1      = typeutil.UniqueID(101)
+	partitionID1 = typeutil.UniqueID(500)
+	fieldID1     = typeutil.UniqueID(1000)
+	indexID1     = typeutil.UniqueID(1500)
+	segmentID1   = typeutil.UniqueID(2000)
+	buildID1     = typeutil.UniqueID(3000)
+	channelName1 = "by-dev-rootcoord-dml_0_101v0"
+)
+
+var (
+	ctx              context.Context
+	metaDomainMock   *mocks.IMetaDomain
+	segmentDbMock    *mocks.ISegmentDb
+	binlogDbMock     *mocks.IBinlogDb
+	dmChannelDbMock  *mocks.IDmChannelDb
+	checkpointDbMock *mocks.IChannelCheckpointDb
+	indexDbMock      *mocks.IIndexDb
+	segIndexDbMock   *mocks.ISegmentIndexDb
+
+	mockCatalog *Catalog
+	errTest     = errors.New("test error")
+)
+
+// TestMain is the first function executed in current package, we will do some initial here
+func TestMain(m *testing.M) {
+	ctx = contextutil.WithTenantID(context.Background(), tenantID)
+
+	segmentDbMock = &mocks.ISegmentDb{}
+	binlogDbMock = &mocks.IBinlogDb{}
+	dmChannelDbMock = &mocks.IDmChannelDb{}
+	checkpointDbMock = &mocks.IChannelCheckpointDb{}
+	indexDbMock = &mocks.IIndexDb{}
+	segIndexDbMock = &mocks.ISegmentIndexDb{}
+
+	metaDomainMock = &mocks.IMetaDomain{}
+	metaDomainMock.On("SegmentDb", ctx).Return(segmentDbMock)
+	metaDomainMock.On("BinlogDb", ctx).Return(binlogDbMock)
+	metaDomainMock.On("DmChannelDb", ctx).Return(dmChannelDbMock)
+	metaDomainMock.On("ChannelCheckpointDb", ctx).Return(checkpointDbMock)
+	metaDomainMock.On("IndexDb", ctx).Return(indexDbMock)
+	metaDomainMock.On("SegmentIndexDb", ctx).Return(segIndexDbMock)
+
+	mockCatalog = NewTableCatalog(&NoopTransaction{}, metaDomainMock)
+
+	// m.Run entry for executing tests
+	os.Exit(m.Run())
+}
+
+type NoopTransaction struct{}
+
+func (*NoopTransaction) Transaction(ctx context.Context, fn func(txctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func getSegment() *datapb.SegmentInfo {
+	return &datapb.SegmentInfo{
+		ID:            segmentID1,
+		CollectionID:  collID1,
+		PartitionID:   partitionID1,
+		InsertChannel: channelName1,
+		NumOfRows:     100,
+		State:         commonpb.SegmentState_Flushed,
+		Binlogs: []*datapb.FieldBinlog{
+			{
+				FieldID: fieldID1,
+				Binlogs: []*datapb.Binlog{
+					{LogID: 1, EntriesNum: 60, LogPath: "insert_log/1"},
+					{LogID: 2, EntriesNum: 40, LogPath: "insert_log/2"},
+				},
+			},
+		},
+		Deltalogs: []*datapb.FieldBinlog{
+			{
+				FieldID: 0,
+				Binlogs: []*datapb.Binlog{{LogID: 3, EntriesNum: 5, LogPath: "delta_log/3"}},
+			},
+		},
+		Statslogs: []*datapb.FieldBinlog{
+			{
+				FieldID: fieldID1,
+				Binlogs: []*datapb.Binlog{{LogID: 4, LogPath: "stats_log/4"}},
+			},
+		},
+	}
+}
+
+func TestTableCatalog_ListSegments(t *testing.T) {
+	segment := getSegment()
+	row, err := marshalSegment(tenantID, segment)
+	require.NoError(t, err)
+
+	segmentDbMock.On("List", tenantID).Return([]*dbmodel.Segment{row}, nil).Once()
+	binlogDbMock.On("List", tenantID).Return(marshalBinlogs(tenantID, segment), nil).Once()
+
+	segments, err := mockCatalog.ListSegments(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(segments))
+	assert.True(t, proto.Equal(segment, segments[0]))
+}
+
+func TestTableCatalog_ListSegments_SegmentError(t *testing.T) {
+	segmentDbMock.On("List", tenantID).Return(nil, errTest).Once()
+
+	_, err := mockCatalog.ListSegments(ctx)
+	require.Equal(t, errTest, err)
+}
+
+func TestTableCatalog_ListSegments_BinlogError(t *testing.T) {
+	segmentDbMock.On("List", tenantID).Return([]*dbmodel.Segment{}, nil).Once()
+	binlogDbMock.On("List", tenantID).Return(nil, errTest).Once()
+
+	_, err := mockCatalog.ListSegments(ctx)
+	require.Equal(t, errTest, err)
+}
+
+func TestTableCatalog_ListSegments_UnmarshalError(t *testing.T) {
+	segmentDbMock.On("List", tenantID).Return([]*dbmodel.Segment{{SegmentID: segmentID1, SegmentInfo: []byte("invalid")}}, nil).Once()
+	binlogDbMock.On("List", tenantID).Return([]*dbmodel.Binlog{}, nil).Once()
+
+	_, err := mockCatalog.ListSegments(ctx)
+	require.Error(t, err)
+}
+
+func TestTableCatalog_AddSegment(t *testing.T) {
+	segment := getSegment()
+
+	segmentDbMock.On("Upsert", mock.MatchedBy(func(rows []*dbmodel.Segment) bool {
+		info := &datapb.SegmentInfo{}
+		if len(rows) != 1 || proto.Unmarshal(rows[0].SegmentInfo, info) != nil {
+			return false
+		}
+		return rows[0].SegmentID == segmentID1 && rows[0].NumRows == 100 && len(info.GetBinlogs()) == 0
+	})).Return(nil).Once()
+	binlogDbMock.On("DeleteBySegmentID", tenantID, segmentID1).Return(nil).Once()
+	binlogDbMock.On("Insert", mock.MatchedBy(func(rows []*dbmodel.Binlog) bool {
+		return len(rows) == 4
+	})).Return(nil).Once()
+
+	err := mockCatalog.AddSegment(ctx, segment)
+	require.NoError(t, err)
+}
+
+func TestTableCatalog_AddSegment_Error(t *testing.T) {
+	segmentDbMock.On("Upsert", mock.Anything).Return(errTest).Once()
+	err := mockCatalog.AddSegment(ctx, getSegment())
+	require.Equal(t, errTest, err)
+
+	segmentDbMock.On("Upsert", mock.Anything).Return(nil).Once()
+	binlogDbMock.On("DeleteBySegmentID", tenantID, segmentID1).Return(errTest).Once()
+	err = mockCatalog.AddSegment(ctx, getSegment())
+	require.Equal(t, errTest, err)
+
+	segmentDbMock.On("Upsert", mock.Anything).Return(nil).Once()
+	binlogDbMock.On("DeleteBySegmentID", tenantID, segmentID1).Return(nil).Once()
+	binlogDbMock.On("Insert", mock.Anything).Return(errTest).Once()
+	err = mockCatalog.AddSegment(ctx, getSegment())
+	require.Equal(t, errTest, err)
+}
+
+func TestTableCatalog_AlterSegments(t *testing.T) {
+	err := mockCatalog.AlterSegments(ctx, nil)
+	require.NoError(t, err)
+
+	segmentDbMock.On("Upsert", mock.Anything).Return(nil).Once()
+	binlogDbMock.On("DeleteBySegmentID", tenantID, segmentID1).Return(nil).Once()
+	binlogDbMock.On("Insert", mock.Anything).Return(nil).Once()
+	err = mockCatalog.AlterSegments(ctx, []*datapb.SegmentInfo{getSegment()})
+	require.NoError(t, err)
+
+	segmentDbMock.On("Upsert", mock.Anything).Return(errTest).Once()
+	err = mockCatalog.AlterSegments(ctx, []*datapb.SegmentInfo{getSegment()})
+	require.Equal(t, errTest, err)
+}
+
+func TestTableCatalog_AlterSegment(t *testing.T) {
+	segmentDbMock.On("Upsert", mock.Anything).Return(nil).Once()
+	binlogDbMock.On("DeleteBySegmentID", tenantID, segmentID1).Return(nil).Once()
+	binlogDbMock.On("Insert", mock.Anything).Return(nil).Once()
+	err := mockCatalog.AlterSegment(ctx, getSegment(), nil)
+	require.NoError(t, err)
+
+	segmentDbMock.On("Upsert", mock.Anything).Return(errTest).Once()
+	err = mockCatalog.AlterSegment(ctx, getSegment(), nil)
+	require.Equal(t, errTest, err)
+}
+
+func TestTableCatalog_AlterSegmentsAndAddNewSegment(t *testing.T) {
+	oldSegment := getSegment()
+	newSegment := getSegment()
+	newSegment.ID = segmentID1 + 1
+
+	// binlogs of the compacted segment are kept
+	segmentDbMock.On("Upsert", mock.Anything).Return(nil).Twice()
+	binlogDbMock.On("DeleteBySegmentID", tenantID, newSegment.ID).Return(nil).Once()
+	binlogDbMock.On("Insert", mock.Anything).Return(nil).Once()
+	err := mockCatalog.AlterSegmentsAndAddNewSegment(ctx, []*datapb.SegmentInfo{oldSegment}, newSegment)
+	require.NoError(t, err)
+
+	segmentDbMock.On("Upsert", mock.Anything).Return(errTest).Once()
+	err = mockCatalog.AlterSegmentsAndAddNewSegment(ctx, []*datapb.SegmentInfo{oldSegment}, newSegment)
+	require.Equal(t, errTest, err)
+}
+
+func TestTableCatalog_SaveDroppedSegmentsInBatch(t *testing.T) {
+	err := mockCatalog.SaveDroppedSegmentsInBatch(ctx, nil)
+	require.NoError(t, err)
+
+	segmentDbMock.On("Upsert", mock.MatchedBy(func(rows []*dbmodel.Segment) bool {
+		return len(rows) == 1
+	})).Return(nil).Once()
+	err = mockCatalog.SaveDroppedSegmentsInBatch(ctx, []*datapb.SegmentInfo{getSegment()})
+	require.NoError(t, err)
+}
+
+func TestTableCatalog_DropSegment(t *testing.T) {
+	segmentDbMock.On("Delete", tenantID, segmentID1).Return(nil).Once()
+	binlogDbMock.On("DeleteBySegmentID", tenantID, segmentID1).Return(nil).Once()
+	err := mockCatalog.DropSegment(ctx, getSegment())
+	require.NoError(t, err)
+
+	segmentDbMock.On("Delete", tenantID, segmentID1).Return(errTest).Once()
+	err = mockCatalog.DropSegment(ctx, getSegment())
+	require.Equal(t, errTest, err)
+
+	segmentDbMock.On("Delete", tenantID, segmentID1).Return(nil).Once()
+	binlogDbMock.On("DeleteBySegmentID", tenantID, segmentID1).Return(errTest).Once()
+	err = mockCatalog.DropSegment(ctx, getSegment())
+	require.Equal(t, errTest, err)
+}
+
+func TestTableCatalog_Channel(t *testing.T) {
+	dmChannelDbMock.On("Upsert", &dbmodel.DmChannel{TenantID: tenantID, ChannelName: channelName1, Removed: false}).Return(nil).Once()
+	err := mockCatalog.MarkChannelAdded(ctx, channelName1)
+	require.NoError(t, err)
+
+	dmChannelDbMock.On("Upsert", &dbmodel.DmChannel{TenantID: tenantID, ChannelName: channelName1, Removed: true}).Return(nil).Once()
+	err = mockCatalog.MarkChannelDeleted(ctx, channelName1)
+	require.NoError(t, err)
+
+	dmChannelDbMock.On("Upsert", mock.Anything).Return(errTest).Twice()
+	require.Equal(t, errTest, mockCatalog.MarkChannelAdded(ctx, channelName1))
+	require.Equal(t, errTest, mockCatalog.MarkChannelDeleted(ctx, channelName1))
+
+	dmChannelDbMock.On("Get", tenantID, channelName1).Return(&dbmodel.DmChannel{Removed: true}, nil).Twice()
+	assert.True(t, mockCatalog.ShouldDropChannel(ctx, channelName1))
+	assert.False(t, mockCatalog.ChannelExists(ctx, channelName1))
+
+	dmChannelDbMock.On("Get", tenantID, channelName1).Return(&dbmodel.DmChannel{Removed: false}, nil).Twice()
+	assert.False(t, mockCatalog.ShouldDropChannel(ctx, channelName1))
+	assert.True(t, mockCatalog.ChannelExists(ctx, channelName1))
+
+	dmChannelDbMock.On("Get", tenantID, channelName1).Return(nil, common.NewKeyNotExistError(channelName1)).Twice()
+	assert.False(t, mockCatalog.ShouldDropChannel(ctx, channelName1))
+	assert.False(t, mockCatalog.ChannelExists(ctx, channelName1))
+
+	dmChannelDbMock.On("Delete", tenantID, channelName1).Return(nil).Once()
+	err = mockCatalog.DropChannel(ctx, channelName1)
+	require.NoError(t, err)
+}
+
+func TestTableCatalog_ChannelCheckpoint(t *testing.T) {
+	pos := &msgpb.MsgPosition{ChannelName: channelName1, MsgID: []byte{1}, Timestamp: 1000}
+	position, err := proto.Marshal(pos)
+	require.NoError(t, err)
+
+	checkpointDbMock.On("Upsert", &dbmodel.ChannelCheckpoint{TenantID: tenantID, ChannelName: channelName1, Position: position}).Return(nil).Once()
+	err = mockCatalog.SaveChannelCheckpoint(ctx, channelName1, pos)
+	require.NoError(t, err)
+
+	checkpointDbMock.On("List", tenantID).Return([]*dbmodel.ChannelCheckpoint{{ChannelName: channelName1, Position: position}}, nil).Once()
+	cps, err := mockCatalog.ListChannelCheckpoint(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(cps))
+	assert.True(t, proto.Equal(pos, cps[channelName1]))
+
+	checkpointDbMock.On("List", tenantID).Return([]*dbmodel.ChannelCheckpoint{{ChannelName: channelName1, Position: []byte("invalid")}}, nil).Once()
+	_, err = mockCatalog.ListChannelCheckpoint(ctx)
+	require.Error(t, err)
+
+	checkpointDbMock.On("List", tenantID).Return(nil, errTest).Once()
+	_, err = mockCatalog.ListChannelCheckpoint(ctx)
+	require.Equal(t, errTest, err)
+
+	checkpointDbMock.On("Delete", tenantID, channelName1).Return(nil).Once()
+	err = mockCatalog.DropChannelCheckpoint(ctx, channelName1)
+	require.NoError(t, err)
+}
+
+func TestTableCatalog_Index(t *testing.T) {
+	index := &model.Index{
+		TenantID:     tenantID,
+		CollectionID: collID1,
+		FieldID:      fieldID1,
+		IndexID:      indexID1,
+		IndexName:    "test_index_name_1",
+		TypeParams:   []*commonpb.KeyValuePair{{Key: "dim", Value: "128"}},
+		IndexParams:  []*commonpb.KeyValuePair{{Key: "index_type", Value: "IVF_FLAT"}},
+		CreateTime:   10,
+	}
+
+	indexDbMock.On("Upsert", mock.MatchedBy(func(idxes []*dbmodel.Index) bool {
+		return len(idxes) == 1 && idxes[0].IndexID == indexID1 && idxes[0].TypeParams == `[{"key":"dim","value":"128"}]`
+	})).Return(nil).Twice()
+	require.NoError(t, mockCatalog.CreateIndex(ctx, index))
+	require.NoError(t, mockCatalog.AlterIndex(ctx, index))
+
+	indexDbMock.On("Upsert", mock.Anything).Return(errTest).Once()
+	require.Equal(t, errTest, mockCatalog.CreateIndex(ctx, index))
+
+	require.NoError(t, mockCatalog.AlterIndexes(ctx, nil))
+	indexDbMock.On("Upsert", mock.MatchedBy(func(idxes []*dbmodel.Index) bool {
+		return len(idxes) == 2
+	})).Return(nil).Once()
+	require.NoError(t, mockCatalog.AlterIndexes(ctx, []*model.Index{index, index}))
+
+	indexDbMock.On("List", tenantID).Return([]*dbmodel.IndexResult{
+		{
+			FieldID:      fieldID1,
+			CollectionID: collID1,
+			IndexID:      indexID1,
+			IndexName:    "test_index_name_1",
+			TypeParams:   `[{"key":"dim","value":"128"}]`,
+			IndexParams:  `[{"key":"index_type","value":"IVF_FLAT"}]`,
+			CreateTime:   10,
+		},
+	}, nil).Once()
+	indexes, err := mockCatalog.ListIndexes(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(indexes))
+	assert.Equal(t, indexID1, indexes[0].IndexID)
+	assert.Equal(t, "IVF_FLAT", indexes[0].IndexParams[0].GetValue())
+
+	indexDbMock.On("List", tenantID).Return(nil, errTest).Once()
+	_, err = mockCatalog.ListIndexes(ctx)
+	require.Equal(t, errTest, err)
+
+	indexDbMock.On("Delete", tenantID, collID1, indexID1).Return(nil).Once()
+	require.NoError(t, mockCatalog.DropIndex(ctx, collID1, indexID1))
+
+	indexDbMock.On("Delete", tenantID, collID1, indexID1).Return(errTest).Once()
+	require.Equal(t, errTest, mockCatalog.DropIndex(ctx, collID1, indexID1))
+}
+
+func TestTableCatalog_SegmentIndex(t *testing.T) {
+	segIdx := &model.SegmentIndex{
+		SegmentID:     segmentID1,
+		CollectionID:  collID1,
+		PartitionID:   partitionID1,
+		NumRows:       100,
+		IndexID:       indexID1,
+		BuildID:       buildID1,
+		IndexVersion:  1,
+		IndexState:    commonpb.IndexState_Finished,
+		IndexFileKeys: []string{"file1"},
+		IndexSize:     1024,
+		IndexParams:   []*commonpb.KeyValuePair{{Key: "nlist", Value: "128"}},
+		RebuildJobID:  7,
+	}
+
+	segIndexDbMock.On("Upsert", mock.MatchedBy(func(idxes []*dbmodel.SegmentIndex) bool {
+		return len(idxes) == 1 && idxes[0].BuildID == buildID1 && idxes[0].RebuildJobID == 7 &&
+			idxes[0].IndexParams == `[{"key":"nlist","value":"128"}]`
+	})).Return(nil).Twice()
+	require.NoError(t, mockCatalog.CreateSegmentIndex(ctx, segIdx))
+	require.NoError(t, mockCatalog.AlterSegmentIndex(ctx, segIdx))
+
+	segIndexDbMock.On("Upsert", mock.Anything).Return(errTest).Once()
+	require.Equal(t, errTest, mockCatalog.CreateSegmentIndex(ctx, segIdx))
+
+	require.NoError(t, mockCatalog.AlterSegmentIndexes(ctx, nil))
+	segIndexDbMock.On("Upsert", mock.MatchedBy(func(idxes []*dbmodel.SegmentIndex) bool {
+		return len(idxes) == 2
+	})).Return(nil).Once()
+	require.NoError(t, mockCatalog.AlterSegmentIndexes(ctx, []*model.SegmentIndex{segIdx, segIdx}))
+
+	segIndexDbMock.On("List", tenantID).Return([]*dbmodel.SegmentIndexResult{
+		{
+			CollectionID:  collID1,
+			PartitionID:   partitionID1,
+			SegmentID:     segmentID1,
+			NumRows:       100,
+			IndexID:       indexID1,
+			BuildID:       buildID1,
+			IndexVersion:  1,
+			IndexState:    int32(commonpb.IndexState_Finished),
+			IndexFileKeys: `["file1"]`,
+			IndexSize:     1024,
+			IndexParams:   `[{"key":"nlist","value":"128"}]`,
+			RebuildJobID:  7,
+		},
+	}, nil).Once()
+	segIdxes, err := mockCatalog.ListSegmentIndexes(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(segIdxes))
+	assert.Equal(t, buildID1, segIdxes[0].BuildID)
+	assert.Equal(t, []string{"file1"}, segIdxes[0].IndexFileKeys)
+	assert.Equal(t, "128", segIdxes[0].IndexParams[0].GetValue())
+	assert.EqualValues(t, 7, segIdxes[0].RebuildJobID)
+
+	segIndexDbMock.On("List", tenantID).Return(nil, errTest).Once()
+	_, err = mockCatalog.ListSegmentIndexes(ctx)
+	require.Equal(t, errTest, err)
+
+	segIndexDbMock.On("Delete", tenantID, buildID1).Return(nil).Once()
+	require.NoError(t, mockCatalog.DropSegmentIndex(ctx, collID1, partitionID1, segmentID1, buildID1))
+
+	segIndexDbMock.On("Delete", tenantID, buildID1).Return(errTest).Once()
+	require.Equal(t, errTest, mockCatalog.DropSegmentIndex(ctx, collID1, partitionID1, segmentID1, buildID1))
+}
+
+func TestTableCatalog_GcConfirm(t *testing.T) {
+	segmentDbMock.On("CountByCollectionID", tenantID, collID1).Return(int64(0), nil).Once()
+	assert.True(t, mockCatalog.GcConfirm(ctx, collID1, allPartitionID))
+
+	segmentDbMock.On("CountByCollectionID", tenantID, collID1).Return(int64(1), nil).Once()
+	assert.False(t, mockCatalog.GcConfirm(ctx, collID1, allPartitionID))
+
+	segmentDbMock.On("CountByPartitionID", tenantID, collID1, partitionID1).Return(int64(0), nil).Once()
+	assert.True(t, mockCatalog.GcConfirm(ctx, collID1, partitionID1))
+
+	segmentDbMock.On("CountByPartitionID", tenantID, collID1, partitionID1).Return(int64(0), errTest).Once()
+	assert.False(t, mockCatalog.GcConfirm(ctx, collID1, partitionID1))
+}
@@ -0,0 +1,43 @@
+package dbmodel
+
+import (
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// log types of binlogs
+const (
+	InsertLog int32 = iota + 1
+	DeltaLog
+	StatsLog
+)
+
+// Binlog is a binlog file of a segment field.
+type Binlog struct {
+	ID            int64     `gorm:"id"`
+	TenantID      string    `gorm:"tenant_id"`
+	CollectionID  int64     `gorm:"collection_id"`
+	SegmentID     int64     `gorm:"segment_id"`
+	FieldID       int64     `gorm:"field_id"`
+	LogType       int32     `gorm:"log_type"`
+	LogID         int64     `gorm:"log_id"`
+	NumEntries    int64     `gorm:"num_entries"`
+	TimestampFrom uint64    `gorm:"timestamp_from"`
+	TimestampTo   uint64    `gorm:"timestamp_to"`
+	LogPath       string    `gorm:"log_path"`
+	LogSize       int64     `gorm:"log_size"`
+	CreatedAt     time.Time `gorm:"created_at"`
+	UpdatedAt     time.Time `gorm:"updated_at"`
+}
+
+func (v Binlog) TableName() string {
+	return "binlogs"
+}
+
+//go:generate mockery --name=IBinlogDb
+type IBinlogDb interface {
+	List(tenantID string) ([]*Binlog, error)
+	Insert(in []*Binlog) error
+	DeleteBySegmentID(tenantID string, segmentID typeutil.UniqueID) error
+}
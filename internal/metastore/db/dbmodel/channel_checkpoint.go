@@ -0,0 +1,24 @@
+package dbmodel
+
+import "time"
+
+type ChannelCheckpoint struct {
+	ID          int64  `gorm:"id"`
+	TenantID    string `gorm:"tenant_id"`
+	ChannelName string `gorm:"channel_name"`
+	// Position is the marshaled msgpb.MsgPosition
+	Position  []byte    `gorm:"position"`
+	CreatedAt time.Time `gorm:"created_at"`
+	UpdatedAt time.Time `gorm:"updated_at"`
+}
+
+func (v ChannelCheckpoint) TableName() string {
+	return "channel_checkpoints"
+}
+
+//go:generate mockery --name=IChannelCheckpointDb
+type IChannelCheckpointDb interface {
+	List(tenantID string) ([]*ChannelCheckpoint, error)
+	Upsert(in *ChannelCheckpoint) error
+	Delete(tenantID string, channelName string) error
+}
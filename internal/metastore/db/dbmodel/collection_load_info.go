@@ -0,0 +1,51 @@
+package dbmodel
+
+import (
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type CollectionLoadInfo struct {
+	ID           int64  `gorm:"id"`
+	TenantID     string `gorm:"tenant_id"`
+	CollectionID int64  `gorm:"collection_id"`
+	// LoadInfo is the marshaled querypb.CollectionLoadInfo
+	LoadInfo  []byte    `gorm:"load_info"`
+	CreatedAt time.Time `gorm:"created_at"`
+	UpdatedAt time.Time `gorm:"updated_at"`
+}
+
+func (v CollectionLoadInfo) TableName() string {
+	return "collection_load_infos"
+}
+
+//go:generate mockery --name=ICollectionLoadInfoDb
+type ICollectionLoadInfoDb interface {
+	List(tenantID string) ([]*CollectionLoadInfo, error)
+	Upsert(in *CollectionLoadInfo) error
+	Delete(tenantID string, collectionID typeutil.UniqueID) error
+}
+
+type PartitionLoadInfo struct {
+	ID           int64  `gorm:"id"`
+	TenantID     string `gorm:"tenant_id"`
+	CollectionID int64  `gorm:"collection_id"`
+	PartitionID  int64  `gorm:"partition_id"`
+	// LoadInfo is the marshaled querypb.PartitionLoadInfo
+	LoadInfo  []byte    `gorm:"load_info"`
+	CreatedAt time.Time `gorm:"created_at"`
+	UpdatedAt time.Time `gorm:"updated_at"`
+}
+
+func (v PartitionLoadInfo) TableName() string {
+	return "partition_load_infos"
+}
+
+//go:generate mockery --name=IPartitionLoadInfoDb
+type IPartitionLoadInfoDb interface {
+	List(tenantID string) ([]*PartitionLoadInfo, error)
+	Upsert(in []*PartitionLoadInfo) error
+	DeleteByCollectionID(tenantID string, collectionID typeutil.UniqueID) error
+	Delete(tenantID string, collectionID typeutil.UniqueID, partitionIDs []typeutil.UniqueID) error
+}
@@ -16,6 +16,14 @@ type IMetaDomain interface {
 	UserRoleDb(ctx context.Context) IUserRoleDb
 	GrantDb(ctx context.Context) IGrantDb
 	GrantIDDb(ctx context.Context) IGrantIDDb
+	SegmentDb(ctx context.Context) ISegmentDb
+	BinlogDb(ctx context.Context) IBinlogDb
+	DmChannelDb(ctx context.Context) IDmChannelDb
+	ChannelCheckpointDb(ctx context.Context) IChannelCheckpointDb
+	CollectionLoadInfoDb(ctx context.Context) ICollectionLoadInfoDb
+	PartitionLoadInfoDb(ctx context.Context) IPartitionLoadInfoDb
+	ReplicaDb(ctx context.Context) IReplicaDb
+	ResourceGroupDb(ctx context.Context) IResourceGroupDb
 }
 
 type ITransaction interface {
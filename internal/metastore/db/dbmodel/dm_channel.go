@@ -0,0 +1,24 @@
+package dbmodel
+
+import "time"
+
+// DmChannel records whether a dm channel is being removed.
+type DmChannel struct {
+	ID          int64     `gorm:"id"`
+	TenantID    string    `gorm:"tenant_id"`
+	ChannelName string    `gorm:"channel_name"`
+	Removed     bool      `gorm:"removed"`
+	CreatedAt   time.Time `gorm:"created_at"`
+	UpdatedAt   time.Time `gorm:"updated_at"`
+}
+
+func (v DmChannel) TableName() string {
+	return "dm_channels"
+}
+
+//go:generate mockery --name=IDmChannelDb
+type IDmChannelDb interface {
+	Get(tenantID string, channelName string) (*DmChannel, error)
+	Upsert(in *DmChannel) error
+	Delete(tenantID string, channelName string) error
+}
@@ -56,6 +56,8 @@ type IIndexDb interface {
 	List(tenantID string) ([]*IndexResult, error)
 	Insert(in []*Index) error
 	Update(in *Index) error
+	Upsert(in []*Index) error
+	Delete(tenantID string, collID, indexID typeutil.UniqueID) error
 	MarkDeletedByCollectionID(tenantID string, collID typeutil.UniqueID) error
 	MarkDeletedByIndexID(tenantID string, idxID typeutil.UniqueID) error
 }
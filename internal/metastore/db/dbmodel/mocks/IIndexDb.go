@@ -12,6 +12,20 @@ type IIndexDb struct {
 	mock.Mock
 }
 
+// Delete provides a mock function with given fields: tenantID, collID, indexID
+func (_m *IIndexDb) Delete(tenantID string, collID int64, indexID int64) error {
+	ret := _m.Called(tenantID, collID, indexID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64, int64) error); ok {
+		r0 = rf(tenantID, collID, indexID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Get provides a mock function with given fields: tenantID, collectionID
 func (_m *IIndexDb) Get(tenantID string, collectionID int64) ([]*dbmodel.Index, error) {
 	ret := _m.Called(tenantID, collectionID)
@@ -114,6 +128,20 @@ func (_m *IIndexDb) Update(in *dbmodel.Index) error {
 	return r0
 }
 
+// Upsert provides a mock function with given fields: in
+func (_m *IIndexDb) Upsert(in []*dbmodel.Index) error {
+	ret := _m.Called(in)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]*dbmodel.Index) error); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewIIndexDb interface {
 	mock.TestingT
 	Cleanup(func())
@@ -12,6 +12,20 @@ type ISegmentIndexDb struct {
 	mock.Mock
 }
 
+// Delete provides a mock function with given fields: tenantID, buildID
+func (_m *ISegmentIndexDb) Delete(tenantID string, buildID int64) error {
+	ret := _m.Called(tenantID, buildID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(tenantID, buildID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Get provides a mock function with given fields: tenantID, collectionID, buildID
 func (_m *ISegmentIndexDb) Get(tenantID string, collectionID int64, buildID int64) ([]*dbmodel.SegmentIndexResult, error) {
 	ret := _m.Called(tenantID, collectionID, buildID)
@@ -128,6 +142,20 @@ func (_m *ISegmentIndexDb) Update(in *dbmodel.SegmentIndex) error {
 	return r0
 }
 
+// Upsert provides a mock function with given fields: in
+func (_m *ISegmentIndexDb) Upsert(in []*dbmodel.SegmentIndex) error {
+	ret := _m.Called(in)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]*dbmodel.SegmentIndex) error); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewISegmentIndexDb interface {
 	mock.TestingT
 	Cleanup(func())
@@ -0,0 +1,30 @@
+package dbmodel
+
+import (
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type Replica struct {
+	ID           int64  `gorm:"id"`
+	TenantID     string `gorm:"tenant_id"`
+	CollectionID int64  `gorm:"collection_id"`
+	ReplicaID    int64  `gorm:"replica_id"`
+	// ReplicaInfo is the marshaled querypb.Replica
+	ReplicaInfo []byte    `gorm:"replica_info"`
+	CreatedAt   time.Time `gorm:"created_at"`
+	UpdatedAt   time.Time `gorm:"updated_at"`
+}
+
+func (v Replica) TableName() string {
+	return "replicas"
+}
+
+//go:generate mockery --name=IReplicaDb
+type IReplicaDb interface {
+	List(tenantID string) ([]*Replica, error)
+	Upsert(in *Replica) error
+	DeleteByCollectionID(tenantID string, collectionID typeutil.UniqueID) error
+	Delete(tenantID string, collectionID, replicaID typeutil.UniqueID) error
+}
@@ -0,0 +1,24 @@
+package dbmodel
+
+import "time"
+
+type ResourceGroup struct {
+	ID       int64  `gorm:"id"`
+	TenantID string `gorm:"tenant_id"`
+	Name     string `gorm:"name"`
+	// GroupInfo is the marshaled querypb.ResourceGroup
+	GroupInfo []byte    `gorm:"group_info"`
+	CreatedAt time.Time `gorm:"created_at"`
+	UpdatedAt time.Time `gorm:"updated_at"`
+}
+
+func (v ResourceGroup) TableName() string {
+	return "resource_groups"
+}
+
+//go:generate mockery --name=IResourceGroupDb
+type IResourceGroupDb interface {
+	List(tenantID string) ([]*ResourceGroup, error)
+	Upsert(in []*ResourceGroup) error
+	Delete(tenantID string, name string) error
+}
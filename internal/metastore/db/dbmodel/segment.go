@@ -0,0 +1,36 @@
+package dbmodel
+
+import (
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// Segment is the segment meta of DataCoord, binlogs are saved in table `binlogs` separately.
+type Segment struct {
+	ID           int64  `gorm:"id"`
+	TenantID     string `gorm:"tenant_id"`
+	SegmentID    int64  `gorm:"segment_id"`
+	CollectionID int64  `gorm:"collection_id"`
+	PartitionID  int64  `gorm:"partition_id"`
+	DmChannel    string `gorm:"dm_channel"`
+	NumRows      int64  `gorm:"num_rows"`
+	SegmentState int32  `gorm:"segment_state"`
+	// SegmentInfo is the marshaled datapb.SegmentInfo without binlogs
+	SegmentInfo []byte    `gorm:"segment_info"`
+	CreatedAt   time.Time `gorm:"created_at"`
+	UpdatedAt   time.Time `gorm:"updated_at"`
+}
+
+func (v Segment) TableName() string {
+	return "segments"
+}
+
+//go:generate mockery --name=ISegmentDb
+type ISegmentDb interface {
+	List(tenantID string) ([]*Segment, error)
+	Upsert(in []*Segment) error
+	Delete(tenantID string, segmentID typeutil.UniqueID) error
+	CountByCollectionID(tenantID string, collectionID typeutil.UniqueID) (int64, error)
+	CountByPartitionID(tenantID string, collectionID, partitionID typeutil.UniqueID) (int64, error)
+}
@@ -1,8 +1,14 @@
 package dbmodel
 
 import (
+	"encoding/json"
 	"time"
 
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -24,6 +30,8 @@ type SegmentIndex struct {
 	CreateTime    uint64    `gorm:"create_time"`
 	IndexFileKeys string    `gorm:"index_file_keys"`
 	IndexSize     uint64    `gorm:"index_size"`
+	IndexParams   string    `gorm:"index_params"`
+	RebuildJobID  int64     `gorm:"rebuild_job_id"`
 	IsDeleted     bool      `gorm:"is_deleted"`
 	CreatedAt     time.Time `gorm:"created_at"`
 	UpdatedAt     time.Time `gorm:"updated_at"`
@@ -48,6 +56,8 @@ type SegmentIndexResult struct {
 	CreateTime    uint64
 	IndexFileKeys string
 	IndexSize     uint64
+	IndexParams   string
+	RebuildJobID  int64
 }
 
 //go:generate mockery --name=ISegmentIndexDb
@@ -56,44 +66,59 @@ type ISegmentIndexDb interface {
 	List(tenantID string) ([]*SegmentIndexResult, error)
 	Insert(in []*SegmentIndex) error
 	Update(in *SegmentIndex) error
+	Upsert(in []*SegmentIndex) error
+	Delete(tenantID string, buildID typeutil.UniqueID) error
 	MarkDeleted(tenantID string, in []*SegmentIndex) error
 	MarkDeletedByCollectionID(tenantID string, collID typeutil.UniqueID) error
 	MarkDeletedByBuildID(tenantID string, idxID typeutil.UniqueID) error
 }
 
-//func UnmarshalSegmentIndexModel(inputs []*SegmentIndexResult) ([]*model.SegmentIndex, error) {
-//	result := make([]*model.SegmentIndex, 0, len(inputs))
-//	for _, ir := range inputs {
-//
-//		var IndexFileKeys []string
-//		if ir.IndexFileKeys != "" {
-//			err := json.Unmarshal([]byte(ir.IndexFileKeys), &IndexFileKeys)
-//			if err != nil {
-//				log.Error("unmarshal index file paths of segment index failed", zap.Int64("collID", ir.CollectionID),
-//					zap.Int64("indexID", ir.IndexID), zap.Int64("segmentID", ir.SegmentID),
-//					zap.Int64("buildID", ir.BuildID), zap.Error(err))
-//				return nil, err
-//			}
-//		}
-//
-//		idx := &model.SegmentIndex{
-//			SegmentID:     ir.SegmentID,
-//			CollectionID:  ir.CollectionID,
-//			PartitionID:   ir.PartitionID,
-//			NumRows:       ir.NumRows,
-//			IndexID:       ir.IndexID,
-//			BuildID:       ir.BuildID,
-//			NodeID:        ir.NodeID,
-//			IndexVersion:  ir.IndexVersion,
-//			IndexState:    commonpb.IndexState(ir.IndexState),
-//			FailReason:    ir.FailReason,
-//			IsDeleted:     ir.IsDeleted,
-//			CreateTime:    ir.CreateTime,
-//			IndexFileKeys: IndexFileKeys,
-//			IndexSize:     ir.IndexSize,
-//		}
-//		result = append(result, idx)
-//	}
-//
-//	return result, nil
-//}
+func UnmarshalSegmentIndexModel(inputs []*SegmentIndexResult) ([]*model.SegmentIndex, error) {
+	result := make([]*model.SegmentIndex, 0, len(inputs))
+	for _, ir := range inputs {
+
+		var indexFileKeys []string
+		if ir.IndexFileKeys != "" {
+			err := json.Unmarshal([]byte(ir.IndexFileKeys), &indexFileKeys)
+			if err != nil {
+				log.Error("unmarshal index file paths of segment index failed", zap.Int64("collID", ir.CollectionID),
+					zap.Int64("indexID", ir.IndexID), zap.Int64("segmentID", ir.SegmentID),
+					zap.Int64("buildID", ir.BuildID), zap.Error(err))
+				return nil, err
+			}
+		}
+
+		var indexParams []*commonpb.KeyValuePair
+		if ir.IndexParams != "" {
+			err := json.Unmarshal([]byte(ir.IndexParams), &indexParams)
+			if err != nil {
+				log.Error("unmarshal IndexParams of segment index failed", zap.Int64("collID", ir.CollectionID),
+					zap.Int64("indexID", ir.IndexID), zap.Int64("segmentID", ir.SegmentID),
+					zap.Int64("buildID", ir.BuildID), zap.Error(err))
+				return nil, err
+			}
+		}
+
+		idx := &model.SegmentIndex{
+			SegmentID:     ir.SegmentID,
+			CollectionID:  ir.CollectionID,
+			PartitionID:   ir.PartitionID,
+			NumRows:       ir.NumRows,
+			IndexID:       ir.IndexID,
+			BuildID:       ir.BuildID,
+			NodeID:        ir.NodeID,
+			IndexVersion:  ir.IndexVersion,
+			IndexState:    commonpb.IndexState(ir.IndexState),
+			FailReason:    ir.FailReason,
+			IsDeleted:     ir.IsDeleted,
+			CreateTime:    ir.CreateTime,
+			IndexFileKeys: indexFileKeys,
+			IndexSize:     ir.IndexSize,
+			IndexParams:   indexParams,
+			RebuildJobID:  ir.RebuildJobID,
+		}
+		result = append(result, idx)
+	}
+
+	return result, nil
+}
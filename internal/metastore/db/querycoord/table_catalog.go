@@ -0,0 +1,263 @@
+package querycoord
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/contextutil"
+)
+
+type Catalog struct {
+	metaDomain dbmodel.IMetaDomain
+	txImpl     dbmodel.ITransaction
+}
+
+func NewTableCatalog(txImpl dbmodel.ITransaction, metaDomain dbmodel.IMetaDomain) *Catalog {
+	return &Catalog{
+		txImpl:     txImpl,
+		metaDomain: metaDomain,
+	}
+}
+
+func (tc *Catalog) SaveCollection(collection *querypb.CollectionLoadInfo, partitions ...*querypb.PartitionLoadInfo) error {
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	loadInfo, err := proto.Marshal(collection)
+	if err != nil {
+		return err
+	}
+	partitionLoadInfos, err := marshalPartitions(tenantID, partitions)
+	if err != nil {
+		return err
+	}
+
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		err := tc.metaDomain.CollectionLoadInfoDb(txCtx).Upsert(&dbmodel.CollectionLoadInfo{
+			TenantID:     tenantID,
+			CollectionID: collection.GetCollectionID(),
+			LoadInfo:     loadInfo,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(partitionLoadInfos) == 0 {
+			return nil
+		}
+		return tc.metaDomain.PartitionLoadInfoDb(txCtx).Upsert(partitionLoadInfos)
+	})
+}
+
+func (tc *Catalog) SavePartition(info ...*querypb.PartitionLoadInfo) error {
+	if len(info) == 0 {
+		return nil
+	}
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	partitionLoadInfos, err := marshalPartitions(tenantID, info)
+	if err != nil {
+		return err
+	}
+
+	return tc.metaDomain.PartitionLoadInfoDb(ctx).Upsert(partitionLoadInfos)
+}
+
+func (tc *Catalog) SaveReplica(replica *querypb.Replica) error {
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	replicaInfo, err := proto.Marshal(replica)
+	if err != nil {
+		return err
+	}
+
+	return tc.metaDomain.ReplicaDb(ctx).Upsert(&dbmodel.Replica{
+		TenantID:     tenantID,
+		CollectionID: replica.GetCollectionID(),
+		ReplicaID:    replica.GetID(),
+		ReplicaInfo:  replicaInfo,
+	})
+}
+
+func (tc *Catalog) SaveResourceGroup(rgs ...*querypb.ResourceGroup) error {
+	if len(rgs) == 0 {
+		return nil
+	}
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	groups := make([]*dbmodel.ResourceGroup, 0, len(rgs))
+	for _, rg := range rgs {
+		groupInfo, err := proto.Marshal(rg)
+		if err != nil {
+			return err
+		}
+		groups = append(groups, &dbmodel.ResourceGroup{
+			TenantID:  tenantID,
+			Name:      rg.GetName(),
+			GroupInfo: groupInfo,
+		})
+	}
+
+	return tc.metaDomain.ResourceGroupDb(ctx).Upsert(groups)
+}
+
+func (tc *Catalog) RemoveResourceGroup(rgName string) error {
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.ResourceGroupDb(ctx).Delete(tenantID, rgName)
+}
+
+func (tc *Catalog) GetCollections() ([]*querypb.CollectionLoadInfo, error) {
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	rs, err := tc.metaDomain.CollectionLoadInfoDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*querypb.CollectionLoadInfo, 0, len(rs))
+	for _, r := range rs {
+		info := &querypb.CollectionLoadInfo{}
+		if err := proto.Unmarshal(r.LoadInfo, info); err != nil {
+			log.Error("unmarshal collection load info failed", zap.Int64("collectionID", r.CollectionID), zap.Error(err))
+			return nil, err
+		}
+		ret = append(ret, info)
+	}
+
+	return ret, nil
+}
+
+func (tc *Catalog) GetPartitions() (map[int64][]*querypb.PartitionLoadInfo, error) {
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	rs, err := tc.metaDomain.PartitionLoadInfoDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make(map[int64][]*querypb.PartitionLoadInfo)
+	for _, r := range rs {
+		info := &querypb.PartitionLoadInfo{}
+		if err := proto.Unmarshal(r.LoadInfo, info); err != nil {
+			log.Error("unmarshal partition load info failed", zap.Int64("collectionID", r.CollectionID),
+				zap.Int64("partitionID", r.PartitionID), zap.Error(err))
+			return nil, err
+		}
+		ret[info.GetCollectionID()] = append(ret[info.GetCollectionID()], info)
+	}
+
+	return ret, nil
+}
+
+func (tc *Catalog) GetReplicas() ([]*querypb.Replica, error) {
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	rs, err := tc.metaDomain.ReplicaDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*querypb.Replica, 0, len(rs))
+	for _, r := range rs {
+		info := &querypb.Replica{}
+		if err := proto.Unmarshal(r.ReplicaInfo, info); err != nil {
+			log.Error("unmarshal replica failed", zap.Int64("collectionID", r.CollectionID),
+				zap.Int64("replicaID", r.ReplicaID), zap.Error(err))
+			return nil, err
+		}
+		ret = append(ret, info)
+	}
+
+	return ret, nil
+}
+
+func (tc *Catalog) GetResourceGroups() ([]*querypb.ResourceGroup, error) {
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	rs, err := tc.metaDomain.ResourceGroupDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*querypb.ResourceGroup, 0, len(rs))
+	for _, r := range rs {
+		rg := &querypb.ResourceGroup{}
+		if err := proto.Unmarshal(r.GroupInfo, rg); err != nil {
+			log.Error("unmarshal resource group failed", zap.String("name", r.Name), zap.Error(err))
+			return nil, err
+		}
+		ret = append(ret, rg)
+	}
+
+	return ret, nil
+}
+
+func (tc *Catalog) ReleaseCollection(collection int64) error {
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	// remove collection and its partitions
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		err := tc.metaDomain.PartitionLoadInfoDb(txCtx).DeleteByCollectionID(tenantID, collection)
+		if err != nil {
+			return err
+		}
+
+		return tc.metaDomain.CollectionLoadInfoDb(txCtx).Delete(tenantID, collection)
+	})
+}
+
+func (tc *Catalog) ReleasePartition(collection int64, partitions ...int64) error {
+	if len(partitions) == 0 {
+		return nil
+	}
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.PartitionLoadInfoDb(ctx).Delete(tenantID, collection, partitions)
+}
+
+func (tc *Catalog) ReleaseReplicas(collectionID int64) error {
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.ReplicaDb(ctx).DeleteByCollectionID(tenantID, collectionID)
+}
+
+func (tc *Catalog) ReleaseReplica(collection, replica int64) error {
+	ctx := context.TODO()
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.ReplicaDb(ctx).Delete(tenantID, collection, replica)
+}
+
+func marshalPartitions(tenantID string, partitions []*querypb.PartitionLoadInfo) ([]*dbmodel.PartitionLoadInfo, error) {
+	ret := make([]*dbmodel.PartitionLoadInfo, 0, len(partitions))
+	for _, partition := range partitions {
+		loadInfo, err := proto.Marshal(partition)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, &dbmodel.PartitionLoadInfo{
+			TenantID:     tenantID,
+			CollectionID: partition.GetCollectionID(),
+			PartitionID:  partition.GetPartitionID(),
+			LoadInfo:     loadInfo,
+		})
+	}
+	return ret, nil
+}
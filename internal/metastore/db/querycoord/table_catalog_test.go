@@ -0,0 +1,199 @@
+package querycoord
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel/mocks"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	collID1      = typeutil.UniqueID(101)
+	partitionID1 = typeutil.UniqueID(500)
+	partitionID2 = typeutil.UniqueID(501)
+	replicaID1   = typeutil.UniqueID(1000)
+	rgName1      = "rg1"
+)
+
+var (
+	metaDomainMock    *mocks.IMetaDomain
+	collLoadDbMock    *mocks.ICollectionLoadInfoDb
+	partLoadDbMock    *mocks.IPartitionLoadInfoDb
+	replicaDbMock     *mocks.IReplicaDb
+	resourceGrpDbMock *mocks.IResourceGroupDb
+
+	mockCatalog *Catalog
+	errTest     = errors.New("test error")
+)
+
+// TestMain is the first function executed in current package, we will do some initial here
+func TestMain(m *testing.M) {
+	collLoadDbMock = &mocks.ICollectionLoadInfoDb{}
+	partLoadDbMock = &mocks.IPartitionLoadInfoDb{}
+	replicaDbMock = &mocks.IReplicaDb{}
+	resourceGrpDbMock = &mocks.IResourceGroupDb{}
+
+	metaDomainMock = &mocks.IMetaDomain{}
+	metaDomainMock.On("CollectionLoadInfoDb", mock.Anything).Return(collLoadDbMock)
+	metaDomainMock.On("PartitionLoadInfoDb", mock.Anything).Return(partLoadDbMock)
+	metaDomainMock.On("ReplicaDb", mock.Anything).Return(replicaDbMock)
+	metaDomainMock.On("ResourceGroupDb", mock.Anything).Return(resourceGrpDbMock)
+
+	mockCatalog = NewTableCatalog(&NoopTransaction{}, metaDomainMock)
+
+	// m.Run entry for executing tests
+	os.Exit(m.Run())
+}
+
+type NoopTransaction struct{}
+
+func (*NoopTransaction) Transaction(ctx context.Context, fn func(txctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestTableCatalog_SaveCollection(t *testing.T) {
+	collection := &querypb.CollectionLoadInfo{CollectionID: collID1, ReplicaNumber: 1}
+	partition := &querypb.PartitionLoadInfo{CollectionID: collID1, PartitionID: partitionID1}
+
+	collLoadDbMock.On("Upsert", mock.MatchedBy(func(info *dbmodel.CollectionLoadInfo) bool {
+		return info.CollectionID == collID1
+	})).Return(nil).Once()
+	partLoadDbMock.On("Upsert", mock.MatchedBy(func(infos []*dbmodel.PartitionLoadInfo) bool {
+		return len(infos) == 1 && infos[0].PartitionID == partitionID1
+	})).Return(nil).Once()
+	err := mockCatalog.SaveCollection(collection, partition)
+	require.NoError(t, err)
+
+	// without partitions
+	collLoadDbMock.On("Upsert", mock.Anything).Return(nil).Once()
+	err = mockCatalog.SaveCollection(collection)
+	require.NoError(t, err)
+
+	collLoadDbMock.On("Upsert", mock.Anything).Return(errTest).Once()
+	err = mockCatalog.SaveCollection(collection, partition)
+	require.Equal(t, errTest, err)
+
+	collLoadDbMock.On("Upsert", mock.Anything).Return(nil).Once()
+	partLoadDbMock.On("Upsert", mock.Anything).Return(errTest).Once()
+	err = mockCatalog.SaveCollection(collection, partition)
+	require.Equal(t, errTest, err)
+}
+
+func TestTableCatalog_GetCollections(t *testing.T) {
+	collection := &querypb.CollectionLoadInfo{CollectionID: collID1, ReplicaNumber: 1}
+	loadInfo, err := proto.Marshal(collection)
+	require.NoError(t, err)
+
+	collLoadDbMock.On("List", "").Return([]*dbmodel.CollectionLoadInfo{{CollectionID: collID1, LoadInfo: loadInfo}}, nil).Once()
+	collections, err := mockCatalog.GetCollections()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(collections))
+	assert.True(t, proto.Equal(collection, collections[0]))
+
+	collLoadDbMock.On("List", "").Return([]*dbmodel.CollectionLoadInfo{{CollectionID: collID1, LoadInfo: []byte("invalid")}}, nil).Once()
+	_, err = mockCatalog.GetCollections()
+	require.Error(t, err)
+
+	collLoadDbMock.On("List", "").Return(nil, errTest).Once()
+	_, err = mockCatalog.GetCollections()
+	require.Equal(t, errTest, err)
+}
+
+func TestTableCatalog_Partitions(t *testing.T) {
+	require.NoError(t, mockCatalog.SavePartition())
+
+	partition1 := &querypb.PartitionLoadInfo{CollectionID: collID1, PartitionID: partitionID1}
+	partition2 := &querypb.PartitionLoadInfo{CollectionID: collID1, PartitionID: partitionID2}
+	partLoadDbMock.On("Upsert", mock.MatchedBy(func(infos []*dbmodel.PartitionLoadInfo) bool {
+		return len(infos) == 2
+	})).Return(nil).Once()
+	require.NoError(t, mockCatalog.SavePartition(partition1, partition2))
+
+	loadInfo1, err := proto.Marshal(partition1)
+	require.NoError(t, err)
+	loadInfo2, err := proto.Marshal(partition2)
+	require.NoError(t, err)
+	partLoadDbMock.On("List", "").Return([]*dbmodel.PartitionLoadInfo{
+		{CollectionID: collID1, PartitionID: partitionID1, LoadInfo: loadInfo1},
+		{CollectionID: collID1, PartitionID: partitionID2, LoadInfo: loadInfo2},
+	}, nil).Once()
+	partitions, err := mockCatalog.GetPartitions()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(partitions[collID1]))
+
+	partLoadDbMock.On("List", "").Return(nil, errTest).Once()
+	_, err = mockCatalog.GetPartitions()
+	require.Equal(t, errTest, err)
+
+	require.NoError(t, mockCatalog.ReleasePartition(collID1))
+	partLoadDbMock.On("Delete", "", collID1, []int64{partitionID1, partitionID2}).Return(nil).Once()
+	require.NoError(t, mockCatalog.ReleasePartition(collID1, partitionID1, partitionID2))
+}
+
+func TestTableCatalog_ReleaseCollection(t *testing.T) {
+	partLoadDbMock.On("DeleteByCollectionID", "", collID1).Return(nil).Once()
+	collLoadDbMock.On("Delete", "", collID1).Return(nil).Once()
+	require.NoError(t, mockCatalog.ReleaseCollection(collID1))
+
+	partLoadDbMock.On("DeleteByCollectionID", "", collID1).Return(errTest).Once()
+	require.Equal(t, errTest, mockCatalog.ReleaseCollection(collID1))
+}
+
+func TestTableCatalog_Replica(t *testing.T) {
+	replica := &querypb.Replica{ID: replicaID1, CollectionID: collID1, Nodes: []int64{1, 2}}
+	replicaInfo, err := proto.Marshal(replica)
+	require.NoError(t, err)
+
+	replicaDbMock.On("Upsert", &dbmodel.Replica{CollectionID: collID1, ReplicaID: replicaID1, ReplicaInfo: replicaInfo}).Return(nil).Once()
+	require.NoError(t, mockCatalog.SaveReplica(replica))
+
+	replicaDbMock.On("List", "").Return([]*dbmodel.Replica{{CollectionID: collID1, ReplicaID: replicaID1, ReplicaInfo: replicaInfo}}, nil).Once()
+	replicas, err := mockCatalog.GetReplicas()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(replicas))
+	assert.True(t, proto.Equal(replica, replicas[0]))
+
+	replicaDbMock.On("List", "").Return(nil, errTest).Once()
+	_, err = mockCatalog.GetReplicas()
+	require.Equal(t, errTest, err)
+
+	replicaDbMock.On("Delete", "", collID1, replicaID1).Return(nil).Once()
+	require.NoError(t, mockCatalog.ReleaseReplica(collID1, replicaID1))
+
+	replicaDbMock.On("DeleteByCollectionID", "", collID1).Return(nil).Once()
+	require.NoError(t, mockCatalog.ReleaseReplicas(collID1))
+}
+
+func TestTableCatalog_ResourceGroup(t *testing.T) {
+	require.NoError(t, mockCatalog.SaveResourceGroup())
+
+	rg := &querypb.ResourceGroup{Name: rgName1, Capacity: 3, Nodes: []int64{1, 2, 3}}
+	groupInfo, err := proto.Marshal(rg)
+	require.NoError(t, err)
+
+	resourceGrpDbMock.On("Upsert", []*dbmodel.ResourceGroup{{Name: rgName1, GroupInfo: groupInfo}}).Return(nil).Once()
+	require.NoError(t, mockCatalog.SaveResourceGroup(rg))
+
+	resourceGrpDbMock.On("List", "").Return([]*dbmodel.ResourceGroup{{Name: rgName1, GroupInfo: groupInfo}}, nil).Once()
+	rgs, err := mockCatalog.GetResourceGroups()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rgs))
+	assert.True(t, proto.Equal(rg, rgs[0]))
+
+	resourceGrpDbMock.On("List", "").Return(nil, errTest).Once()
+	_, err = mockCatalog.GetResourceGroups()
+	require.Equal(t, errTest, err)
+
+	resourceGrpDbMock.On("Delete", "", rgName1).Return(nil).Once()
+	require.NoError(t, mockCatalog.RemoveResourceGroup(rgName1))
+}
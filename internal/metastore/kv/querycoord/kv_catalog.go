@@ -6,7 +6,6 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/golang/protobuf/proto"
 	"github.com/samber/lo"
-	clientv3 "go.etcd.io/etcd/client/v3"
 
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus/internal/kv"
@@ -24,9 +23,10 @@ const (
 	ReplicaPrefix            = "querycoord-replica"
 	CollectionMetaPrefixV1   = "queryCoord-collectionMeta"
 	ReplicaMetaPrefixV1      = "queryCoord-ReplicaMeta"
+	IndexReferencePrefix     = "querycoord-index-reference"
 )
 
-type WatchStoreChan = clientv3.WatchChan
+type WatchStoreChan = kv.WatchChan
 
 type Catalog struct {
 	cli kv.MetaKv
@@ -176,6 +176,38 @@ func (s Catalog) RemoveHandoffEvent(info *querypb.SegmentInfo) error {
 	return s.cli.Remove(key)
 }
 
+// SaveIndexReferences saves the index builds loaded by querynodes of the collection,
+// datacoord recycles the retired index builds only if they are not referenced.
+func (s Catalog) SaveIndexReferences(refs *querypb.IndexReferences) error {
+	key := EncodeIndexReferenceKey(refs.GetCollectionID())
+	value, err := proto.Marshal(refs)
+	if err != nil {
+		return err
+	}
+	return s.cli.Save(key, string(value))
+}
+
+func (s Catalog) GetIndexReferences() (map[int64]*querypb.IndexReferences, error) {
+	_, values, err := s.cli.LoadWithPrefix(IndexReferencePrefix)
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[int64]*querypb.IndexReferences, len(values))
+	for _, v := range values {
+		refs := querypb.IndexReferences{}
+		if err := proto.Unmarshal([]byte(v), &refs); err != nil {
+			return nil, err
+		}
+		ret[refs.GetCollectionID()] = &refs
+	}
+	return ret, nil
+}
+
+func (s Catalog) RemoveIndexReferences(collectionID int64) error {
+	key := EncodeIndexReferenceKey(collectionID)
+	return s.cli.Remove(key)
+}
+
 func EncodeCollectionLoadInfoKey(collection int64) string {
 	return fmt.Sprintf("%s/%d", CollectionLoadInfoPrefix, collection)
 }
@@ -195,3 +227,7 @@ func EncodeCollectionReplicaKey(collection int64) string {
 func EncodeHandoffEventKey(collection, partition, segment int64) string {
 	return fmt.Sprintf("%s/%d/%d/%d", util.HandoffSegmentPrefix, collection, partition, segment)
 }
+
+func EncodeIndexReferenceKey(collection int64) string {
+	return fmt.Sprintf("%s/%d", IndexReferencePrefix, collection)
+}
@@ -378,6 +378,7 @@ func (kc *Catalog) alterModifyCollection(oldColl *model.Collection, newColl *mod
 	oldCollClone.CreateTime = newColl.CreateTime
 	oldCollClone.ConsistencyLevel = newColl.ConsistencyLevel
 	oldCollClone.State = newColl.State
+	oldCollClone.Properties = newColl.Properties
 	key := BuildCollectionKey(oldColl.CollectionID)
 	value, err := proto.Marshal(model.MarshalCollectionModel(oldCollClone))
 	if err != nil {
@@ -386,11 +387,53 @@ func (kc *Catalog) alterModifyCollection(oldColl *model.Collection, newColl *mod
 	return kc.Snapshot.Save(key, string(value), ts)
 }
 
+// alterAddCollectionFields saves the fields which exist in newColl but not in oldColl,
+// together with the collection properties, since the schema version is kept in them.
+func (kc *Catalog) alterAddCollectionFields(oldColl *model.Collection, newColl *model.Collection, ts typeutil.Timestamp) error {
+	if oldColl.TenantID != newColl.TenantID || oldColl.CollectionID != newColl.CollectionID {
+		return fmt.Errorf("altering tenant id or collection id is forbidden")
+	}
+
+	existed := make(map[int64]struct{}, len(oldColl.Fields))
+	for _, field := range oldColl.Fields {
+		existed[field.FieldID] = struct{}{}
+	}
+
+	kvs := make(map[string]string)
+	for _, field := range newColl.Fields {
+		if _, ok := existed[field.FieldID]; ok {
+			continue
+		}
+		v, err := proto.Marshal(model.MarshalFieldModel(field))
+		if err != nil {
+			return err
+		}
+		kvs[BuildFieldKey(oldColl.CollectionID, field.FieldID)] = string(v)
+	}
+	if len(kvs) == 0 {
+		return fmt.Errorf("no field to add, collection: %d", oldColl.CollectionID)
+	}
+
+	oldCollClone := oldColl.Clone()
+	oldCollClone.Properties = newColl.Properties
+	value, err := proto.Marshal(model.MarshalCollectionModel(oldCollClone))
+	if err != nil {
+		return err
+	}
+	kvs[BuildCollectionKey(oldColl.CollectionID)] = string(value)
+
+	return kc.Snapshot.MultiSave(kvs, ts)
+}
+
 func (kc *Catalog) AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, alterType metastore.AlterType, ts typeutil.Timestamp) error {
-	if alterType == metastore.MODIFY {
+	switch alterType {
+	case metastore.MODIFY:
 		return kc.alterModifyCollection(oldColl, newColl, ts)
+	case metastore.ADD:
+		return kc.alterAddCollectionFields(oldColl, newColl, ts)
+	default:
+		return fmt.Errorf("altering collection doesn't support %s", alterType.String())
 	}
-	return fmt.Errorf("altering collection doesn't support %s", alterType.String())
 }
 
 func (kc *Catalog) alterModifyPartition(oldPart *model.Partition, newPart *model.Partition, ts typeutil.Timestamp) error {
@@ -901,9 +901,56 @@ func Test_batchMultiSaveAndRemoveWithPrefix(t *testing.T) {
 
 func TestCatalog_AlterCollection(t *testing.T) {
 	t.Run("add", func(t *testing.T) {
+		snapshot := kv.NewMockSnapshotKV()
+		kvs := map[string]string{}
+		snapshot.MultiSaveFunc = func(saves map[string]string, ts typeutil.Timestamp) error {
+			for k, v := range saves {
+				kvs[k] = v
+			}
+			return nil
+		}
+		kc := &Catalog{Snapshot: snapshot}
+		ctx := context.Background()
+		var collectionID int64 = 1
+		oldC := &model.Collection{CollectionID: collectionID, Fields: []*model.Field{{FieldID: 100}}}
+		newC := oldC.Clone()
+		newC.Fields = append(newC.Fields, &model.Field{FieldID: 101, Name: "new_field"})
+		newC.Properties = []*commonpb.KeyValuePair{{Key: "k", Value: "v"}}
+		err := kc.AlterCollection(ctx, oldC, newC, metastore.ADD, 0)
+		assert.NoError(t, err)
+
+		assert.Equal(t, 2, len(kvs))
+		_, ok := kvs[BuildFieldKey(collectionID, 100)]
+		assert.False(t, ok)
+		value, ok := kvs[BuildFieldKey(collectionID, 101)]
+		assert.True(t, ok)
+		var fieldPb schemapb.FieldSchema
+		err = proto.Unmarshal([]byte(value), &fieldPb)
+		assert.NoError(t, err)
+		assert.Equal(t, "new_field", fieldPb.GetName())
+
+		value, ok = kvs[BuildCollectionKey(collectionID)]
+		assert.True(t, ok)
+		var collPb pb.CollectionInfo
+		err = proto.Unmarshal([]byte(value), &collPb)
+		assert.NoError(t, err)
+		assert.Equal(t, newC.Properties, collPb.GetProperties())
+	})
+
+	t.Run("add, nothing to add", func(t *testing.T) {
+		kc := &Catalog{}
+		ctx := context.Background()
+		oldC := &model.Collection{CollectionID: 1, Fields: []*model.Field{{FieldID: 100}}}
+		err := kc.AlterCollection(ctx, oldC, oldC.Clone(), metastore.ADD, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("add, collection id changed", func(t *testing.T) {
 		kc := &Catalog{}
 		ctx := context.Background()
-		err := kc.AlterCollection(ctx, nil, nil, metastore.ADD, 0)
+		oldC := &model.Collection{CollectionID: 1}
+		newC := &model.Collection{CollectionID: 2}
+		err := kc.AlterCollection(ctx, oldC, newC, metastore.ADD, 0)
 		assert.Error(t, err)
 	})
 
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/pkg/common"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
@@ -17,6 +18,7 @@ type Field struct {
 	IndexParams  []*commonpb.KeyValuePair
 	AutoID       bool
 	State        schemapb.FieldState
+	DefaultValue *schemapb.ValueField
 }
 
 func (f Field) Available() bool {
@@ -34,6 +36,7 @@ func (f Field) Clone() *Field {
 		IndexParams:  common.CloneKeyValuePairs(f.IndexParams),
 		AutoID:       f.AutoID,
 		State:        f.State,
+		DefaultValue: proto.Clone(f.DefaultValue).(*schemapb.ValueField),
 	}
 }
 
@@ -58,7 +61,8 @@ func (f Field) Equal(other Field) bool {
 		f.DataType == other.DataType &&
 		checkParamsEqual(f.TypeParams, f.TypeParams) &&
 		checkParamsEqual(f.IndexParams, other.IndexParams) &&
-		f.AutoID == other.AutoID
+		f.AutoID == other.AutoID &&
+		proto.Equal(f.DefaultValue, other.DefaultValue)
 }
 
 func CheckFieldsEqual(fieldsA, fieldsB []*Field) bool {
@@ -88,6 +92,7 @@ func MarshalFieldModel(field *Field) *schemapb.FieldSchema {
 		TypeParams:   field.TypeParams,
 		IndexParams:  field.IndexParams,
 		AutoID:       field.AutoID,
+		DefaultValue: field.DefaultValue,
 	}
 }
 
@@ -117,6 +122,7 @@ func UnmarshalFieldModel(fieldSchema *schemapb.FieldSchema) *Field {
 		TypeParams:   fieldSchema.TypeParams,
 		IndexParams:  fieldSchema.IndexParams,
 		AutoID:       fieldSchema.AutoID,
+		DefaultValue: fieldSchema.DefaultValue,
 	}
 }
 
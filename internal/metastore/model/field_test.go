@@ -138,3 +138,22 @@ func TestField_Available(t *testing.T) {
 		})
 	}
 }
+
+func TestFieldDefaultValue(t *testing.T) {
+	defaultValue := &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 10}}
+	fieldSchema := &schemapb.FieldSchema{
+		FieldID:      fieldID,
+		Name:         fieldName,
+		DataType:     schemapb.DataType_Int64,
+		DefaultValue: defaultValue,
+	}
+
+	field := UnmarshalFieldModel(fieldSchema)
+	assert.Equal(t, defaultValue, field.DefaultValue)
+	assert.Equal(t, fieldSchema, MarshalFieldModel(field))
+
+	cloned := field.Clone()
+	assert.True(t, field.Equal(*cloned))
+	cloned.DefaultValue.Data = &schemapb.ValueField_LongData{LongData: 20}
+	assert.False(t, field.Equal(*cloned))
+}
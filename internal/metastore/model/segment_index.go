@@ -23,6 +23,10 @@ type SegmentIndex struct {
 	IndexSize     uint64
 	// deprecated
 	WriteHandoff bool
+	// IndexParams overrides the params of the index meta for this build, set by rebuild jobs
+	IndexParams []*commonpb.KeyValuePair
+	// RebuildJobID is non-zero if the build isn't serving, either pending or retired by the rebuild job
+	RebuildJobID int64
 }
 
 func UnmarshalSegmentIndexModel(segIndex *indexpb.SegmentIndex) *SegmentIndex {
@@ -46,6 +50,8 @@ func UnmarshalSegmentIndexModel(segIndex *indexpb.SegmentIndex) *SegmentIndex {
 		IndexFileKeys: common.CloneStringList(segIndex.IndexFileKeys),
 		IndexSize:     segIndex.SerializeSize,
 		WriteHandoff:  segIndex.WriteHandoff,
+		IndexParams:   common.CloneKeyValuePairs(segIndex.IndexParams),
+		RebuildJobID:  segIndex.RebuildJobID,
 	}
 }
 
@@ -70,6 +76,8 @@ func MarshalSegmentIndexModel(segIdx *SegmentIndex) *indexpb.SegmentIndex {
 		CreateTime:    segIdx.CreateTime,
 		SerializeSize: segIdx.IndexSize,
 		WriteHandoff:  segIdx.WriteHandoff,
+		IndexParams:   common.CloneKeyValuePairs(segIdx.IndexParams),
+		RebuildJobID:  segIdx.RebuildJobID,
 	}
 }
 
@@ -90,5 +98,7 @@ func CloneSegmentIndex(segIndex *SegmentIndex) *SegmentIndex {
 		IndexFileKeys: common.CloneStringList(segIndex.IndexFileKeys),
 		IndexSize:     segIndex.IndexSize,
 		WriteHandoff:  segIndex.WriteHandoff,
+		IndexParams:   common.CloneKeyValuePairs(segIndex.IndexParams),
+		RebuildJobID:  segIndex.RebuildJobID,
 	}
 }
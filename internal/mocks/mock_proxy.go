@@ -1113,6 +1113,53 @@ func (_c *Proxy_Dummy_Call) Return(_a0 *milvuspb.DummyResponse, _a1 error) *Prox
 	return _c
 }
 
+// Explain provides a mock function with given fields: ctx, request
+func (_m *Proxy) Explain(ctx context.Context, request *proxypb.ExplainRequest) (*proxypb.ExplainResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *proxypb.ExplainResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.ExplainRequest) *proxypb.ExplainResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.ExplainResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.ExplainRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Proxy_Explain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Explain'
+type Proxy_Explain_Call struct {
+	*mock.Call
+}
+
+// Explain is a helper method to define mock.On call
+//  - ctx context.Context
+//  - request *proxypb.ExplainRequest
+func (_e *Proxy_Expecter) Explain(ctx interface{}, request interface{}) *Proxy_Explain_Call {
+	return &Proxy_Explain_Call{Call: _e.mock.On("Explain", ctx, request)}
+}
+
+func (_c *Proxy_Explain_Call) Run(run func(ctx context.Context, request *proxypb.ExplainRequest)) *Proxy_Explain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.ExplainRequest))
+	})
+	return _c
+}
+
+func (_c *Proxy_Explain_Call) Return(_a0 *proxypb.ExplainResponse, _a1 error) *Proxy_Explain_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // Flush provides a mock function with given fields: ctx, request
 func (_m *Proxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*milvuspb.FlushResponse, error) {
 	ret := _m.Called(ctx, request)
@@ -0,0 +1,124 @@
+package planparserv2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	parser "github.com/milvus-io/milvus/internal/parser/planparserv2/generated"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// nullIdentifier is the text of the identifier token which `null` in `is null` and `is not null`
+// is lexed as, which is not a valid identifier in expressions.
+const nullIdentifier = "<null>"
+
+// nullLexer lexes `expr is null` and `expr is not null` into the tokens of `expr == <null>` and
+// `expr != <null>`, which are parsed as equality and visited as null checks by ParserVisitor,
+// since the keywords are not in the grammar.
+type nullLexer struct {
+	*parser.PlanLexer
+	lookahead []antlr.Token
+}
+
+func newNullLexer(lexer *parser.PlanLexer) *nullLexer {
+	return &nullLexer{PlanLexer: lexer}
+}
+
+func (l *nullLexer) nextToken() antlr.Token {
+	if len(l.lookahead) > 0 {
+		token := l.lookahead[0]
+		l.lookahead = l.lookahead[1:]
+		return token
+	}
+	return l.PlanLexer.NextToken()
+}
+
+func isKeywordToken(token antlr.Token, keyword string) bool {
+	switch token.GetTokenType() {
+	case parser.PlanLexerIdentifier, parser.PlanLexerNOT:
+		return strings.EqualFold(token.GetText(), keyword)
+	default:
+		return false
+	}
+}
+
+// NextToken implements antlr.TokenSource.
+func (l *nullLexer) NextToken() antlr.Token {
+	token := l.nextToken()
+	if !isKeywordToken(token, "is") {
+		return token
+	}
+
+	tokens := []antlr.Token{token, l.nextToken()}
+	not := isKeywordToken(tokens[1], "not")
+	if not {
+		tokens = append(tokens, l.nextToken())
+	}
+	last := tokens[len(tokens)-1]
+	if !isKeywordToken(last, "null") {
+		l.lookahead = append(tokens[1:], l.lookahead...)
+		return token
+	}
+
+	opType, opText := parser.PlanLexerEQ, "=="
+	if not {
+		opType, opText = parser.PlanLexerNE, "!="
+	}
+	op := antlr.NewCommonToken(token.GetSource(), opType, antlr.TokenDefaultChannel, token.GetStart(), token.GetStop())
+	op.SetText(opText)
+	null := antlr.NewCommonToken(last.GetSource(), parser.PlanLexerIdentifier, antlr.TokenDefaultChannel, last.GetStart(), last.GetStop())
+	null.SetText(nullIdentifier)
+	l.lookahead = append([]antlr.Token{null}, l.lookahead...)
+	return op
+}
+
+func isNullIdentifier(ctx parser.IExprContext) bool {
+	identifier, ok := ctx.(*parser.IdentifierContext)
+	return ok && identifier.Identifier().GetText() == nullIdentifier
+}
+
+// visitNullExpr translates `column == <null>` and `column != <null>` lexed from `column is null`
+// and `column is not null` to the null check of the nullable column.
+func (v *ParserVisitor) visitNullExpr(ctx *parser.EqualityContext) interface{} {
+	child := ctx.Expr(0).Accept(v)
+	if err := getError(child); err != nil {
+		return err
+	}
+	childExpr := getExpr(child)
+	if childExpr == nil || childExpr.expr.GetColumnExpr() == nil {
+		return fmt.Errorf("null check is only supported on fields, but got: %s", ctx.Expr(0).GetText())
+	}
+
+	field, err := v.schema.GetFieldFromID(childExpr.expr.GetColumnExpr().GetInfo().GetFieldId())
+	if err != nil {
+		return err
+	}
+	if !typeutil.IsFieldNullable(field) {
+		return fmt.Errorf("field %s is not nullable", field.GetName())
+	}
+	dataType := field.GetDataType()
+	if !typeutil.IsBoolType(dataType) && !typeutil.IsIntegerType(dataType) &&
+		!typeutil.IsFloatingType(dataType) && !typeutil.IsStringType(dataType) {
+		return fmt.Errorf("null check is not supported for data type: %s", dataType.String())
+	}
+
+	op := planpb.UnaryExpr_IsNull
+	if ctx.GetOp().GetTokenType() == parser.PlanParserNE {
+		op = planpb.UnaryExpr_IsNotNull
+	}
+	return &ExprWithType{
+		expr: &planpb.Expr{
+			Expr: &planpb.Expr_UnaryExpr{
+				UnaryExpr: &planpb.UnaryExpr{
+					Op:    op,
+					Child: childExpr.expr,
+				},
+			},
+		},
+		dataType: schemapb.DataType_Bool,
+	}
+}
@@ -0,0 +1,76 @@
+package planparserv2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func newNullableTestSchemaHelper(t *testing.T) *typeutil.SchemaHelper {
+	schema := newTestSchema()
+	nullable := []*commonpb.KeyValuePair{{Key: common.FieldNullableKey, Value: "true"}}
+	schema.Fields = append(schema.Fields,
+		&schemapb.FieldSchema{FieldID: 1000, Name: "NullableInt64", DataType: schemapb.DataType_Int64, TypeParams: nullable},
+		&schemapb.FieldSchema{FieldID: 1001, Name: "NullableVarChar", DataType: schemapb.DataType_VarChar, TypeParams: nullable},
+		&schemapb.FieldSchema{FieldID: 1002, Name: "NullableBool", DataType: schemapb.DataType_Bool, TypeParams: nullable},
+		&schemapb.FieldSchema{FieldID: 1003, Name: "NullableVector", DataType: schemapb.DataType_FloatVector, TypeParams: nullable},
+	)
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+	return helper
+}
+
+func TestExpr_Null(t *testing.T) {
+	helper := newNullableTestSchemaHelper(t)
+
+	exprStrs := []string{
+		`NullableInt64 is null`,
+		`NullableInt64 IS NOT NULL`,
+		`NullableVarChar is null || NullableInt64 > 10`,
+		`NullableVarChar == "a is null" or NullableVarChar is null`,
+		`not (NullableBool is null)`,
+	}
+	for _, exprStr := range exprStrs {
+		assertValidExpr(t, helper, exprStr)
+	}
+
+	invalidExprs := []string{
+		`Int64Field is null`,
+		`NotExist is not null`,
+		`NullableVector is null`,
+		`NullableInt64 + 1 is null`,
+		`NullableInt64 == null`,
+		`NullableInt64 is 1`,
+		`NullableInt64 is not`,
+	}
+	for _, exprStr := range invalidExprs {
+		assertInvalidExpr(t, helper, exprStr)
+	}
+}
+
+func TestExpr_NullPlan(t *testing.T) {
+	helper := newNullableTestSchemaHelper(t)
+
+	expr, err := ParseExpr(helper, `NullableInt64 is null`)
+	assert.NoError(t, err)
+	assert.Equal(t, planpb.UnaryExpr_IsNull, expr.GetUnaryExpr().GetOp())
+	assert.Equal(t, int64(1000), expr.GetUnaryExpr().GetChild().GetColumnExpr().GetInfo().GetFieldId())
+
+	expr, err = ParseExpr(helper, `NullableVarChar is not null and Int64Field > 1`)
+	assert.NoError(t, err)
+	left := expr.GetBinaryExpr().GetLeft().GetUnaryExpr()
+	assert.Equal(t, planpb.UnaryExpr_IsNotNull, left.GetOp())
+	assert.Equal(t, int64(1001), left.GetChild().GetColumnExpr().GetInfo().GetFieldId())
+	assert.NotNil(t, expr.GetBinaryExpr().GetRight().GetUnaryRangeExpr())
+
+	// the literals are kept as they are
+	expr, err = ParseExpr(helper, `NullableVarChar == "a is null"`)
+	assert.NoError(t, err)
+	assert.Equal(t, "a is null", expr.GetUnaryRangeExpr().GetValue().GetStringVal())
+}
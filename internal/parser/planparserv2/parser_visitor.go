@@ -285,6 +285,10 @@ func (v *ParserVisitor) VisitMulDivMod(ctx *parser.MulDivModContext) interface{}
 
 // VisitEquality translates expr to compare/range plan.
 func (v *ParserVisitor) VisitEquality(ctx *parser.EqualityContext) interface{} {
+	if isNullIdentifier(ctx.Expr(1)) {
+		return v.visitNullExpr(ctx)
+	}
+
 	left := ctx.Expr(0).Accept(v)
 	if err := getError(left); err != nil {
 		return err
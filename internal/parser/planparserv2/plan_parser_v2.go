@@ -23,7 +23,7 @@ func handleExpr(schema *typeutil.SchemaHelper, exprStr string) interface{} {
 		return errorListener.err
 	}
 
-	parser := getParser(lexer, errorListener)
+	parser := getParser(newNullLexer(lexer), errorListener)
 	if errorListener.err != nil {
 		return errorListener.err
 	}
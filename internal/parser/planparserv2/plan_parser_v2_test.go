@@ -494,6 +494,21 @@ func TestCreateSearchPlan_Invalid(t *testing.T) {
 	})
 }
 
+func TestExprString(t *testing.T) {
+	schema := newTestSchema()
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	expr, err := ParseExpr(helper, `Int64Field > 10 and VarCharField in ["a", "b"]`)
+	assert.NoError(t, err)
+	str := ExprString(expr)
+	assert.Contains(t, str, `"expr_type": "LogicalAnd"`)
+	assert.Contains(t, str, `"expr_type": "term"`)
+	assert.Contains(t, str, `"op": "GreaterThan"`)
+
+	assert.Equal(t, "", ExprString(nil))
+}
+
 func Test_handleExpr(t *testing.T) {
 	schema := newTestSchema()
 	schemaHelper, err := typeutil.CreateSchemaHelper(schema)
@@ -32,7 +32,7 @@ func getLexer(stream *antlr.InputStream, listeners ...antlr.ErrorListener) *antl
 	return lexer
 }
 
-func getParser(lexer *antlrparser.PlanLexer, listeners ...antlr.ErrorListener) *antlrparser.PlanParser {
+func getParser(lexer antlr.Lexer, listeners ...antlr.ErrorListener) *antlrparser.PlanParser {
 	tokenStream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
 	parser, ok := parserPool.Get().(*antlrparser.PlanParser)
 	if !ok {
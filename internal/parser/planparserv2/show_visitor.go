@@ -161,10 +161,18 @@ func NewShowExprVisitor() LogicalExprVisitor {
 	return &ShowExprVisitor{}
 }
 
-// ShowExpr print the expr tree, used for debugging, not safe.
-func ShowExpr(expr *planpb.Expr) {
+// ExprString returns the expr tree in json, empty if the expr is nil.
+func ExprString(expr *planpb.Expr) string {
+	if expr == nil {
+		return ""
+	}
 	v := NewShowExprVisitor()
 	js := v.VisitExpr(expr)
 	b, _ := json.MarshalIndent(js, "", "  ")
-	log.Info("[ShowExpr]", zap.String("expr", string(b)))
+	return string(b)
+}
+
+// ShowExpr print the expr tree, used for debugging, not safe.
+func ShowExpr(expr *planpb.Expr) {
+	log.Info("[ShowExpr]", zap.String("expr", ExprString(expr)))
 }
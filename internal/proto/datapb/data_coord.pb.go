@@ -107,21 +107,24 @@ func (ChannelWatchState) EnumDescriptor() ([]byte, []int) {
 type CompactionType int32
 
 const (
-	CompactionType_UndefinedCompaction CompactionType = 0
-	CompactionType_MergeCompaction     CompactionType = 2
-	CompactionType_MixCompaction       CompactionType = 3
+	CompactionType_UndefinedCompaction  CompactionType = 0
+	CompactionType_MergeCompaction      CompactionType = 2
+	CompactionType_MixCompaction        CompactionType = 3
+	CompactionType_ClusteringCompaction CompactionType = 4
 )
 
 var CompactionType_name = map[int32]string{
 	0: "UndefinedCompaction",
 	2: "MergeCompaction",
 	3: "MixCompaction",
+	4: "ClusteringCompaction",
 }
 
 var CompactionType_value = map[string]int32{
-	"UndefinedCompaction": 0,
-	"MergeCompaction":     2,
-	"MixCompaction":       3,
+	"UndefinedCompaction":  0,
+	"MergeCompaction":      2,
+	"MixCompaction":        3,
+	"ClusteringCompaction": 4,
 }
 
 func (x CompactionType) String() string {
@@ -1500,11 +1503,12 @@ type SegmentInfo struct {
 	// A flag indicating if:
 	// (1) this segment is created by bulk insert, and
 	// (2) the bulk insert task that creates this segment has not yet reached `ImportCompleted` state.
-	IsImporting          bool     `protobuf:"varint,17,opt,name=is_importing,json=isImporting,proto3" json:"is_importing,omitempty"`
-	IsFake               bool     `protobuf:"varint,18,opt,name=is_fake,json=isFake,proto3" json:"is_fake,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	IsImporting          bool            `protobuf:"varint,17,opt,name=is_importing,json=isImporting,proto3" json:"is_importing,omitempty"`
+	IsFake               bool            `protobuf:"varint,18,opt,name=is_fake,json=isFake,proto3" json:"is_fake,omitempty"`
+	ClusteringInfo       *ClusteringInfo `protobuf:"bytes,19,opt,name=clustering_info,json=clusteringInfo,proto3" json:"clustering_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *SegmentInfo) Reset()         { *m = SegmentInfo{} }
@@ -1658,6 +1662,13 @@ func (m *SegmentInfo) GetIsFake() bool {
 	return false
 }
 
+func (m *SegmentInfo) GetClusteringInfo() *ClusteringInfo {
+	if m != nil {
+		return m.ClusteringInfo
+	}
+	return nil
+}
+
 type SegmentStartPosition struct {
 	StartPosition        *msgpb.MsgPosition `protobuf:"bytes,1,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
 	SegmentID            int64              `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
@@ -2876,14 +2887,15 @@ func (m *CompactionStateRequest) GetBase() *commonpb.MsgBase {
 }
 
 type SyncSegmentsRequest struct {
-	PlanID               int64          `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
-	CompactedTo          int64          `protobuf:"varint,2,opt,name=compacted_to,json=compactedTo,proto3" json:"compacted_to,omitempty"`
-	NumOfRows            int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
-	CompactedFrom        []int64        `protobuf:"varint,4,rep,packed,name=compacted_from,json=compactedFrom,proto3" json:"compacted_from,omitempty"`
-	StatsLogs            []*FieldBinlog `protobuf:"bytes,5,rep,name=stats_logs,json=statsLogs,proto3" json:"stats_logs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	PlanID               int64               `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
+	CompactedTo          int64               `protobuf:"varint,2,opt,name=compacted_to,json=compactedTo,proto3" json:"compacted_to,omitempty"`
+	NumOfRows            int64               `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	CompactedFrom        []int64             `protobuf:"varint,4,rep,packed,name=compacted_from,json=compactedFrom,proto3" json:"compacted_from,omitempty"`
+	StatsLogs            []*FieldBinlog      `protobuf:"bytes,5,rep,name=stats_logs,json=statsLogs,proto3" json:"stats_logs,omitempty"`
+	ClusteringSegments   []*CompactionResult `protobuf:"bytes,6,rep,name=clustering_segments,json=clusteringSegments,proto3" json:"clustering_segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *SyncSegmentsRequest) Reset()         { *m = SyncSegmentsRequest{} }
@@ -2946,6 +2958,13 @@ func (m *SyncSegmentsRequest) GetStatsLogs() []*FieldBinlog {
 	return nil
 }
 
+func (m *SyncSegmentsRequest) GetClusteringSegments() []*CompactionResult {
+	if m != nil {
+		return m.ClusteringSegments
+	}
+	return nil
+}
+
 type CompactionSegmentBinlogs struct {
 	SegmentID            int64          `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	FieldBinlogs         []*FieldBinlog `protobuf:"bytes,2,rep,name=fieldBinlogs,proto3" json:"fieldBinlogs,omitempty"`
@@ -3027,6 +3046,8 @@ type CompactionPlan struct {
 	Channel              string                      `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
 	CollectionTtl        int64                       `protobuf:"varint,8,opt,name=collection_ttl,json=collectionTtl,proto3" json:"collection_ttl,omitempty"`
 	TotalRows            int64                       `protobuf:"varint,9,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	ClusteringFieldID    int64                       `protobuf:"varint,10,opt,name=clustering_fieldID,json=clusteringFieldID,proto3" json:"clustering_fieldID,omitempty"`
+	MaxSegmentRows       int64                       `protobuf:"varint,11,opt,name=max_segment_rows,json=maxSegmentRows,proto3" json:"max_segment_rows,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
 	XXX_unrecognized     []byte                      `json:"-"`
 	XXX_sizecache        int32                       `json:"-"`
@@ -3120,17 +3141,33 @@ func (m *CompactionPlan) GetTotalRows() int64 {
 	return 0
 }
 
+func (m *CompactionPlan) GetClusteringFieldID() int64 {
+	if m != nil {
+		return m.ClusteringFieldID
+	}
+	return 0
+}
+
+func (m *CompactionPlan) GetMaxSegmentRows() int64 {
+	if m != nil {
+		return m.MaxSegmentRows
+	}
+	return 0
+}
+
 type CompactionResult struct {
-	PlanID               int64          `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
-	SegmentID            int64          `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	NumOfRows            int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
-	InsertLogs           []*FieldBinlog `protobuf:"bytes,4,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
-	Field2StatslogPaths  []*FieldBinlog `protobuf:"bytes,5,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
-	Deltalogs            []*FieldBinlog `protobuf:"bytes,6,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
-	Channel              string         `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	PlanID               int64               `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
+	SegmentID            int64               `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	NumOfRows            int64               `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	InsertLogs           []*FieldBinlog      `protobuf:"bytes,4,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
+	Field2StatslogPaths  []*FieldBinlog      `protobuf:"bytes,5,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
+	Deltalogs            []*FieldBinlog      `protobuf:"bytes,6,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	Channel              string              `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
+	ClusteringInfo       *ClusteringInfo     `protobuf:"bytes,8,opt,name=clustering_info,json=clusteringInfo,proto3" json:"clustering_info,omitempty"`
+	ClusteringSegments   []*CompactionResult `protobuf:"bytes,9,rep,name=clustering_segments,json=clusteringSegments,proto3" json:"clustering_segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *CompactionResult) Reset()         { *m = CompactionResult{} }
@@ -3207,6 +3244,20 @@ func (m *CompactionResult) GetChannel() string {
 	return ""
 }
 
+func (m *CompactionResult) GetClusteringInfo() *ClusteringInfo {
+	if m != nil {
+		return m.ClusteringInfo
+	}
+	return nil
+}
+
+func (m *CompactionResult) GetClusteringSegments() []*CompactionResult {
+	if m != nil {
+		return m.ClusteringSegments
+	}
+	return nil
+}
+
 type CompactionStateResult struct {
 	PlanID               int64                    `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
 	State                commonpb.CompactionState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.CompactionState" json:"state,omitempty"`
@@ -3924,6 +3975,7 @@ type ImportTaskInfo struct {
 	PartitionName        string                   `protobuf:"bytes,13,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
 	Infos                []*commonpb.KeyValuePair `protobuf:"bytes,14,rep,name=infos,proto3" json:"infos,omitempty"`
 	StartTs              int64                    `protobuf:"varint,15,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	JobId                int64                    `protobuf:"varint,16,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -4060,6 +4112,13 @@ func (m *ImportTaskInfo) GetStartTs() int64 {
 	return 0
 }
 
+func (m *ImportTaskInfo) GetJobId() int64 {
+	if m != nil {
+		return m.JobId
+	}
+	return 0
+}
+
 type ImportTaskResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	DatanodeId           int64            `protobuf:"varint,2,opt,name=datanode_id,json=datanodeId,proto3" json:"datanode_id,omitempty"`
@@ -4893,6 +4952,198 @@ func (m *GcConfirmResponse) GetGcFinished() bool {
 	return false
 }
 
+// ClusteringInfo is the range of the clustering field values in a segment
+type ClusteringInfo struct {
+	FieldID              int64                `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	Min                  *schemapb.ValueField `protobuf:"bytes,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max                  *schemapb.ValueField `protobuf:"bytes,3,opt,name=max,proto3" json:"max,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ClusteringInfo) Reset()         { *m = ClusteringInfo{} }
+func (m *ClusteringInfo) String() string { return proto.CompactTextString(m) }
+func (*ClusteringInfo) ProtoMessage()    {}
+func (*ClusteringInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{76}
+}
+
+func (m *ClusteringInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusteringInfo.Unmarshal(m, b)
+}
+func (m *ClusteringInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusteringInfo.Marshal(b, m, deterministic)
+}
+func (m *ClusteringInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusteringInfo.Merge(m, src)
+}
+func (m *ClusteringInfo) XXX_Size() int {
+	return xxx_messageInfo_ClusteringInfo.Size(m)
+}
+func (m *ClusteringInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusteringInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusteringInfo proto.InternalMessageInfo
+
+func (m *ClusteringInfo) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
+func (m *ClusteringInfo) GetMin() *schemapb.ValueField {
+	if m != nil {
+		return m.Min
+	}
+	return nil
+}
+
+func (m *ClusteringInfo) GetMax() *schemapb.ValueField {
+	if m != nil {
+		return m.Max
+	}
+	return nil
+}
+
+// ExportTaskInfo is the meta of a bulk export task, which exports the flushed segments of a collection to files
+type ExportTaskInfo struct {
+	Id                   int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CollectionId         int64                `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	PartitionIds         []int64              `protobuf:"varint,3,rep,packed,name=partition_ids,json=partitionIds,proto3" json:"partition_ids,omitempty"`
+	Format               string               `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	Prefix               string               `protobuf:"bytes,5,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Expr                 string               `protobuf:"bytes,6,opt,name=expr,proto3" json:"expr,omitempty"`
+	State                commonpb.ImportState `protobuf:"varint,7,opt,name=state,proto3,enum=milvus.proto.common.ImportState" json:"state,omitempty"`
+	ErrorMessage         string               `protobuf:"bytes,8,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	SegmentIds           []int64              `protobuf:"varint,9,rep,packed,name=segment_ids,json=segmentIds,proto3" json:"segment_ids,omitempty"`
+	ExportedSegmentIds   []int64              `protobuf:"varint,10,rep,packed,name=exported_segment_ids,json=exportedSegmentIds,proto3" json:"exported_segment_ids,omitempty"`
+	RowCount             int64                `protobuf:"varint,11,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	Files                []string             `protobuf:"bytes,12,rep,name=files,proto3" json:"files,omitempty"`
+	CreateTs             int64                `protobuf:"varint,13,opt,name=create_ts,json=createTs,proto3" json:"create_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ExportTaskInfo) Reset()         { *m = ExportTaskInfo{} }
+func (m *ExportTaskInfo) String() string { return proto.CompactTextString(m) }
+func (*ExportTaskInfo) ProtoMessage()    {}
+func (*ExportTaskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{77}
+}
+
+func (m *ExportTaskInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTaskInfo.Unmarshal(m, b)
+}
+func (m *ExportTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTaskInfo.Marshal(b, m, deterministic)
+}
+func (m *ExportTaskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTaskInfo.Merge(m, src)
+}
+func (m *ExportTaskInfo) XXX_Size() int {
+	return xxx_messageInfo_ExportTaskInfo.Size(m)
+}
+func (m *ExportTaskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTaskInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTaskInfo proto.InternalMessageInfo
+
+func (m *ExportTaskInfo) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetCollectionId() int64 {
+	if m != nil {
+		return m.CollectionId
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetPartitionIds() []int64 {
+	if m != nil {
+		return m.PartitionIds
+	}
+	return nil
+}
+
+func (m *ExportTaskInfo) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ExportTaskInfo) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *ExportTaskInfo) GetExpr() string {
+	if m != nil {
+		return m.Expr
+	}
+	return ""
+}
+
+func (m *ExportTaskInfo) GetState() commonpb.ImportState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.ImportState_ImportPending
+}
+
+func (m *ExportTaskInfo) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *ExportTaskInfo) GetSegmentIds() []int64 {
+	if m != nil {
+		return m.SegmentIds
+	}
+	return nil
+}
+
+func (m *ExportTaskInfo) GetExportedSegmentIds() []int64 {
+	if m != nil {
+		return m.ExportedSegmentIds
+	}
+	return nil
+}
+
+func (m *ExportTaskInfo) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *ExportTaskInfo) GetCreateTs() int64 {
+	if m != nil {
+		return m.CreateTs
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("milvus.proto.data.SegmentType", SegmentType_name, SegmentType_value)
 	proto.RegisterEnum("milvus.proto.data.ChannelWatchState", ChannelWatchState_name, ChannelWatchState_value)
@@ -4974,306 +5225,323 @@ func init() {
 	proto.RegisterType((*AlterCollectionRequest)(nil), "milvus.proto.data.AlterCollectionRequest")
 	proto.RegisterType((*GcConfirmRequest)(nil), "milvus.proto.data.GcConfirmRequest")
 	proto.RegisterType((*GcConfirmResponse)(nil), "milvus.proto.data.GcConfirmResponse")
+	proto.RegisterType((*ClusteringInfo)(nil), "milvus.proto.data.ClusteringInfo")
+	proto.RegisterType((*ExportTaskInfo)(nil), "milvus.proto.data.ExportTaskInfo")
 }
 
 func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
 
 var fileDescriptor_82cd95f524594f49 = []byte{
-	// 4704 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7c, 0xcb, 0x6f, 0x1c, 0x47,
-	0x7a, 0xb8, 0x7a, 0x5e, 0x9c, 0xf9, 0x66, 0x38, 0x1c, 0x96, 0x64, 0x6a, 0x34, 0xb6, 0x1e, 0x6e,
-	0x49, 0x36, 0x2d, 0xdb, 0x92, 0x4c, 0xfd, 0x16, 0xeb, 0xb5, 0xd6, 0xde, 0x15, 0x49, 0x4b, 0x9e,
-	0x5f, 0x44, 0x2d, 0xb7, 0x49, 0x49, 0x81, 0x37, 0xc0, 0xa0, 0x39, 0x5d, 0x1c, 0xf6, 0xb2, 0xa7,
-	0x7b, 0xd4, 0xdd, 0x43, 0x8a, 0x0e, 0x90, 0x38, 0x4f, 0x20, 0x0f, 0x24, 0x41, 0x90, 0x20, 0xc9,
-	0x6d, 0x91, 0x43, 0xb0, 0x49, 0xb0, 0xa7, 0x4d, 0x2e, 0xb9, 0xec, 0xd5, 0x41, 0x0e, 0x8b, 0x20,
-	0x40, 0x80, 0x20, 0xc8, 0x35, 0xc8, 0x3d, 0xff, 0x40, 0x50, 0x8f, 0xae, 0x7e, 0x55, 0xf7, 0x34,
-	0x49, 0xc9, 0x02, 0x92, 0xdb, 0x54, 0xf5, 0x57, 0x5f, 0x7d, 0x55, 0xf5, 0xbd, 0xbf, 0xaa, 0x81,
-	0x8e, 0xa1, 0xfb, 0xfa, 0x60, 0xe8, 0x38, 0xae, 0x71, 0x73, 0xe2, 0x3a, 0xbe, 0x83, 0x16, 0xc7,
-	0xa6, 0x75, 0x30, 0xf5, 0x58, 0xeb, 0x26, 0xf9, 0xdc, 0x6b, 0x0d, 0x9d, 0xf1, 0xd8, 0xb1, 0x59,
-	0x57, 0xaf, 0x6d, 0xda, 0x3e, 0x76, 0x6d, 0xdd, 0xe2, 0xed, 0x56, 0x74, 0x40, 0xaf, 0xe5, 0x0d,
-	0xf7, 0xf0, 0x58, 0xe7, 0xad, 0xc6, 0xd8, 0x1b, 0xf1, 0x9f, 0x8b, 0xa6, 0x6d, 0xe0, 0xe7, 0xd1,
-	0xa9, 0xd4, 0x39, 0xa8, 0x7e, 0x3a, 0x9e, 0xf8, 0x47, 0xea, 0xdf, 0x29, 0xd0, 0xba, 0x6f, 0x4d,
-	0xbd, 0x3d, 0x0d, 0x3f, 0x9b, 0x62, 0xcf, 0x47, 0xb7, 0xa1, 0xb2, 0xa3, 0x7b, 0xb8, 0xab, 0x5c,
-	0x51, 0x96, 0x9b, 0x2b, 0x6f, 0xdc, 0x8c, 0xd1, 0xc4, 0xa9, 0xd9, 0xf0, 0x46, 0xab, 0xba, 0x87,
-	0x35, 0x0a, 0x89, 0x10, 0x54, 0x8c, 0x9d, 0xfe, 0x7a, 0xb7, 0x74, 0x45, 0x59, 0x2e, 0x6b, 0xf4,
-	0x37, 0xba, 0x04, 0xe0, 0xe1, 0xd1, 0x18, 0xdb, 0x7e, 0x7f, 0xdd, 0xeb, 0x96, 0xaf, 0x94, 0x97,
-	0xcb, 0x5a, 0xa4, 0x07, 0xa9, 0xd0, 0x1a, 0x3a, 0x96, 0x85, 0x87, 0xbe, 0xe9, 0xd8, 0xfd, 0xf5,
-	0x6e, 0x85, 0x8e, 0x8d, 0xf5, 0xa1, 0x1e, 0xd4, 0x4d, 0xaf, 0x3f, 0x9e, 0x38, 0xae, 0xdf, 0xad,
-	0x5e, 0x51, 0x96, 0xeb, 0x9a, 0x68, 0xab, 0xff, 0xa9, 0xc0, 0x3c, 0x27, 0xdb, 0x9b, 0x38, 0xb6,
-	0x87, 0xd1, 0x1d, 0xa8, 0x79, 0xbe, 0xee, 0x4f, 0x3d, 0x4e, 0xf9, 0xeb, 0x52, 0xca, 0xb7, 0x28,
-	0x88, 0xc6, 0x41, 0xa5, 0xa4, 0x27, 0x49, 0x2b, 0x4b, 0x48, 0x8b, 0x2f, 0xaf, 0x92, 0x5a, 0xde,
-	0x32, 0x2c, 0xec, 0x12, 0xea, 0xb6, 0x42, 0xa0, 0x2a, 0x05, 0x4a, 0x76, 0x13, 0x4c, 0xbe, 0x39,
-	0xc6, 0xdf, 0xdb, 0xdd, 0xc2, 0xba, 0xd5, 0xad, 0xd1, 0xb9, 0x22, 0x3d, 0xea, 0x3f, 0x2b, 0xd0,
-	0x11, 0xe0, 0xc1, 0x19, 0x9d, 0x83, 0xea, 0xd0, 0x99, 0xda, 0x3e, 0x5d, 0xea, 0xbc, 0xc6, 0x1a,
-	0xe8, 0x4d, 0x68, 0x0d, 0xf7, 0x74, 0xdb, 0xc6, 0xd6, 0xc0, 0xd6, 0xc7, 0x98, 0x2e, 0xaa, 0xa1,
-	0x35, 0x79, 0xdf, 0x23, 0x7d, 0x8c, 0x0b, 0xad, 0xed, 0x0a, 0x34, 0x27, 0xba, 0xeb, 0x9b, 0xb1,
-	0x93, 0x89, 0x76, 0xe5, 0x1d, 0x0c, 0x99, 0xc1, 0xa4, 0xbf, 0xb6, 0x75, 0x6f, 0xbf, 0xbf, 0xce,
-	0x57, 0x14, 0xeb, 0x53, 0x7f, 0xa4, 0xc0, 0xd2, 0x3d, 0xcf, 0x33, 0x47, 0x76, 0x6a, 0x65, 0x4b,
-	0x50, 0xb3, 0x1d, 0x03, 0xf7, 0xd7, 0xe9, 0xd2, 0xca, 0x1a, 0x6f, 0xa1, 0xd7, 0xa1, 0x31, 0xc1,
-	0xd8, 0x1d, 0xb8, 0x8e, 0x15, 0x2c, 0xac, 0x4e, 0x3a, 0x34, 0xc7, 0xc2, 0xe8, 0xfb, 0xb0, 0xe8,
-	0x25, 0x10, 0x31, 0x9e, 0x6b, 0xae, 0x5c, 0xbd, 0x99, 0x92, 0xa9, 0x9b, 0xc9, 0x49, 0xb5, 0xf4,
-	0x68, 0xf5, 0xcb, 0x12, 0x9c, 0x15, 0x70, 0x8c, 0x56, 0xf2, 0x9b, 0xec, 0xbc, 0x87, 0x47, 0x82,
-	0x3c, 0xd6, 0x28, 0xb2, 0xf3, 0xe2, 0xc8, 0xca, 0xd1, 0x23, 0x2b, 0x22, 0x06, 0x89, 0xf3, 0xa8,
-	0xa6, 0xcf, 0xe3, 0x32, 0x34, 0xf1, 0xf3, 0x89, 0xe9, 0xe2, 0x01, 0x61, 0x1c, 0xba, 0xe5, 0x15,
-	0x0d, 0x58, 0xd7, 0xb6, 0x39, 0x8e, 0xca, 0xc6, 0x5c, 0x61, 0xd9, 0x50, 0xff, 0x52, 0x81, 0xf3,
-	0xa9, 0x53, 0xe2, 0xc2, 0xa6, 0x41, 0x87, 0xae, 0x3c, 0xdc, 0x19, 0x22, 0x76, 0x64, 0xc3, 0xdf,
-	0xca, 0xdb, 0xf0, 0x10, 0x5c, 0x4b, 0x8d, 0x8f, 0x10, 0x59, 0x2a, 0x4e, 0xe4, 0x3e, 0x9c, 0x7f,
-	0x80, 0x7d, 0x3e, 0x01, 0xf9, 0x86, 0xbd, 0x93, 0x2b, 0xb2, 0xb8, 0x54, 0x97, 0x92, 0x52, 0xad,
-	0xfe, 0x55, 0x49, 0xc8, 0x22, 0x9d, 0xaa, 0x6f, 0xef, 0x3a, 0xe8, 0x0d, 0x68, 0x08, 0x10, 0xce,
-	0x15, 0x61, 0x07, 0xfa, 0x26, 0x54, 0x09, 0xa5, 0x8c, 0x25, 0xda, 0x2b, 0x6f, 0xca, 0xd7, 0x14,
-	0xc1, 0xa9, 0x31, 0x78, 0xb4, 0x0e, 0x6d, 0xcf, 0xd7, 0x5d, 0x7f, 0x30, 0x71, 0x3c, 0x7a, 0xce,
-	0x94, 0x71, 0x9a, 0x2b, 0x17, 0xe3, 0x18, 0x88, 0x92, 0xdf, 0xf0, 0x46, 0x9b, 0x1c, 0x48, 0x9b,
-	0xa7, 0x83, 0x82, 0x26, 0xfa, 0x2e, 0xb4, 0xb0, 0x6d, 0x84, 0x38, 0x2a, 0x45, 0x70, 0x34, 0xb1,
-	0x6d, 0x08, 0x0c, 0xe1, 0xa9, 0x54, 0x8b, 0x9f, 0xca, 0xef, 0x2b, 0xd0, 0x4d, 0x1f, 0xcb, 0x69,
-	0x14, 0xf5, 0x5d, 0x36, 0x08, 0xb3, 0x63, 0xc9, 0x95, 0x6b, 0x71, 0x34, 0x1a, 0x1f, 0xa2, 0xfe,
-	0xa9, 0x02, 0xaf, 0x85, 0xe4, 0xd0, 0x4f, 0x2f, 0x8b, 0x47, 0xd0, 0x0d, 0xe8, 0x98, 0xf6, 0xd0,
-	0x9a, 0x1a, 0xf8, 0xb1, 0xfd, 0x19, 0xd6, 0x2d, 0x7f, 0xef, 0x88, 0x9e, 0x5c, 0x5d, 0x4b, 0xf5,
-	0xab, 0xff, 0x56, 0x82, 0xa5, 0x24, 0x5d, 0xa7, 0xd9, 0xa4, 0xff, 0x07, 0x55, 0xd3, 0xde, 0x75,
-	0x82, 0x3d, 0xba, 0x94, 0x23, 0x8a, 0x64, 0x2e, 0x06, 0x8c, 0x1c, 0x40, 0x81, 0xf2, 0x1a, 0xee,
-	0xe1, 0xe1, 0xfe, 0xc4, 0x31, 0xa9, 0x9a, 0x22, 0x28, 0xbe, 0x2b, 0x41, 0x21, 0xa7, 0xf8, 0xe6,
-	0x1a, 0xc3, 0xb1, 0x26, 0x50, 0x7c, 0x6a, 0xfb, 0xee, 0x91, 0xb6, 0x38, 0x4c, 0xf6, 0xf7, 0x86,
-	0xb0, 0x24, 0x07, 0x46, 0x1d, 0x28, 0xef, 0xe3, 0x23, 0xba, 0xe4, 0x86, 0x46, 0x7e, 0xa2, 0x3b,
-	0x50, 0x3d, 0xd0, 0xad, 0x29, 0xe6, 0x3a, 0x61, 0x06, 0xe7, 0x32, 0xd8, 0x8f, 0x4a, 0x1f, 0x2a,
-	0xea, 0x18, 0x5e, 0x7f, 0x80, 0xfd, 0xbe, 0xed, 0x61, 0xd7, 0x5f, 0x35, 0x6d, 0xcb, 0x19, 0x6d,
-	0xea, 0xfe, 0xde, 0x29, 0x94, 0x43, 0x4c, 0xce, 0x4b, 0x09, 0x39, 0x57, 0x7f, 0xac, 0xc0, 0x1b,
-	0xf2, 0xf9, 0xf8, 0x81, 0xf6, 0xa0, 0xbe, 0x6b, 0x62, 0xcb, 0x20, 0x5c, 0xa3, 0x50, 0xae, 0x11,
-	0x6d, 0xa2, 0x24, 0x26, 0x04, 0x98, 0x9f, 0x5b, 0x42, 0x49, 0x08, 0x9f, 0x6f, 0xcb, 0x77, 0x4d,
-	0x7b, 0xf4, 0xd0, 0xf4, 0x7c, 0x8d, 0xc1, 0x47, 0xb8, 0xa4, 0x5c, 0x5c, 0x38, 0x7f, 0x57, 0x81,
-	0x4b, 0x0f, 0xb0, 0xbf, 0x26, 0x6c, 0x0c, 0xf9, 0x6e, 0x7a, 0xbe, 0x39, 0xf4, 0x5e, 0xac, 0x0f,
-	0x58, 0xc0, 0xd9, 0x50, 0xff, 0x50, 0x81, 0xcb, 0x99, 0xc4, 0xf0, 0xad, 0xe3, 0x3a, 0x34, 0xb0,
-	0x30, 0x72, 0x1d, 0xfa, 0x0b, 0xf8, 0xe8, 0x09, 0x39, 0xfc, 0x4d, 0xdd, 0x74, 0x99, 0x0e, 0x3d,
-	0xa1, 0x45, 0xf9, 0x89, 0x02, 0x17, 0x1f, 0x60, 0x7f, 0x33, 0xb0, 0xaf, 0xaf, 0x70, 0x77, 0x08,
-	0x4c, 0xc4, 0xce, 0x07, 0x8e, 0x66, 0xac, 0x4f, 0xfd, 0x03, 0x76, 0x9c, 0x52, 0x7a, 0x5f, 0xc9,
-	0x06, 0x5e, 0xa2, 0x92, 0x10, 0x51, 0x11, 0x5c, 0xd8, 0xf9, 0xf6, 0xa9, 0xbf, 0x59, 0x85, 0xd6,
-	0x13, 0xae, 0x15, 0xa8, 0x05, 0x4d, 0xee, 0x84, 0x22, 0x77, 0x82, 0x22, 0xde, 0x94, 0xcc, 0xc1,
-	0x5a, 0x85, 0x79, 0x0f, 0xe3, 0xfd, 0x63, 0xda, 0xcb, 0x16, 0x19, 0x23, 0x8c, 0xdd, 0x43, 0x58,
-	0x9c, 0xda, 0xd4, 0x43, 0xc7, 0x06, 0x5f, 0x00, 0xdb, 0xf4, 0xd9, 0xca, 0x34, 0x3d, 0x10, 0x7d,
-	0xc6, 0x83, 0x80, 0x08, 0xae, 0x6a, 0x21, 0x5c, 0xc9, 0x61, 0xa8, 0x0f, 0x1d, 0xc3, 0x75, 0x26,
-	0x13, 0x6c, 0x0c, 0xbc, 0x00, 0x55, 0xad, 0x18, 0x2a, 0x3e, 0x4e, 0xa0, 0xba, 0x0d, 0x67, 0x93,
-	0x94, 0xf6, 0x0d, 0xe2, 0x17, 0x12, 0xce, 0x92, 0x7d, 0x42, 0xef, 0xc1, 0x62, 0x1a, 0xbe, 0x4e,
-	0xe1, 0xd3, 0x1f, 0xd0, 0xfb, 0x80, 0x12, 0xa4, 0x12, 0xf0, 0x06, 0x03, 0x8f, 0x13, 0xc3, 0xc1,
-	0x69, 0x70, 0x1a, 0x07, 0x07, 0x06, 0xce, 0xbf, 0x44, 0xc0, 0xfb, 0xc4, 0xba, 0xc6, 0xc0, 0xbd,
-	0x6e, 0xb3, 0xd8, 0x46, 0xc4, 0x91, 0x79, 0xea, 0xef, 0x28, 0xb0, 0xf4, 0x54, 0xf7, 0x87, 0x7b,
-	0xeb, 0x63, 0xce, 0xa0, 0xa7, 0x10, 0xf0, 0x8f, 0xa1, 0x71, 0xc0, 0x99, 0x31, 0xd0, 0xe2, 0x97,
-	0x25, 0x04, 0x45, 0xd9, 0x5e, 0x0b, 0x47, 0x90, 0x80, 0xe8, 0xdc, 0xfd, 0x48, 0x60, 0xf8, 0x0a,
-	0x54, 0xcd, 0x8c, 0x88, 0x56, 0x7d, 0x0e, 0xc0, 0x89, 0xdb, 0xf0, 0x46, 0x27, 0xa0, 0xeb, 0x43,
-	0x98, 0xe3, 0xd8, 0xb8, 0x2e, 0x99, 0x75, 0x60, 0x01, 0xb8, 0xfa, 0xa3, 0x1a, 0x34, 0x23, 0x1f,
-	0x50, 0x1b, 0x4a, 0x42, 0x49, 0x94, 0x24, 0xab, 0x2b, 0xcd, 0x8e, 0xa1, 0xca, 0xe9, 0x18, 0xea,
-	0x3a, 0xb4, 0x4d, 0x6a, 0xbc, 0x07, 0xfc, 0x54, 0xa8, 0xaf, 0xdc, 0xd0, 0xe6, 0x59, 0x2f, 0x67,
-	0x11, 0x74, 0x09, 0x9a, 0xf6, 0x74, 0x3c, 0x70, 0x76, 0x07, 0xae, 0x73, 0xe8, 0xf1, 0x60, 0xac,
-	0x61, 0x4f, 0xc7, 0xdf, 0xdb, 0xd5, 0x9c, 0x43, 0x2f, 0xf4, 0xf7, 0x6b, 0xc7, 0xf4, 0xf7, 0x2f,
-	0x41, 0x73, 0xac, 0x3f, 0x27, 0x58, 0x07, 0xf6, 0x74, 0x4c, 0xe3, 0xb4, 0xb2, 0xd6, 0x18, 0xeb,
-	0xcf, 0x35, 0xe7, 0xf0, 0xd1, 0x74, 0x8c, 0x96, 0xa1, 0x63, 0xe9, 0x9e, 0x3f, 0x88, 0x06, 0x7a,
-	0x75, 0x1a, 0xe8, 0xb5, 0x49, 0xff, 0xa7, 0x61, 0xb0, 0x97, 0x8e, 0x1c, 0x1a, 0x27, 0x8b, 0x1c,
-	0x8c, 0xb1, 0x15, 0xe2, 0x80, 0x42, 0x91, 0x83, 0x31, 0xb6, 0x04, 0x86, 0x0f, 0x61, 0x6e, 0x87,
-	0x3a, 0x42, 0x79, 0x22, 0x7a, 0x9f, 0xf8, 0x40, 0xcc, 0x5f, 0xd2, 0x02, 0x70, 0xf4, 0x6d, 0x68,
-	0x50, 0xfb, 0x43, 0xc7, 0xb6, 0x0a, 0x8d, 0x0d, 0x07, 0x90, 0xd1, 0x06, 0xb6, 0x7c, 0x9d, 0x8e,
-	0x9e, 0x2f, 0x36, 0x5a, 0x0c, 0x20, 0xfa, 0x71, 0xe8, 0x62, 0xdd, 0xc7, 0xc6, 0xea, 0xd1, 0x9a,
-	0x33, 0x9e, 0xe8, 0x94, 0x85, 0xba, 0x6d, 0xea, 0xc2, 0xcb, 0x3e, 0xa1, 0xb7, 0xa0, 0x3d, 0x14,
-	0xad, 0xfb, 0xae, 0x33, 0xee, 0x2e, 0x50, 0xe9, 0x49, 0xf4, 0xa2, 0x8b, 0x00, 0x81, 0x66, 0xd4,
-	0xfd, 0x6e, 0x87, 0x9e, 0x5d, 0x83, 0xf7, 0xdc, 0xa3, 0xd9, 0x1b, 0xd3, 0x1b, 0xb0, 0x3c, 0x89,
-	0x69, 0x8f, 0xba, 0x8b, 0x74, 0xc6, 0x66, 0x90, 0x58, 0x31, 0xed, 0x11, 0x3a, 0x0f, 0x73, 0xa6,
-	0x37, 0xd8, 0xd5, 0xf7, 0x71, 0x17, 0xd1, 0xaf, 0x35, 0xd3, 0xbb, 0xaf, 0xef, 0x63, 0xf5, 0x0b,
-	0x38, 0x17, 0xf2, 0x54, 0xe4, 0x10, 0xd3, 0xac, 0xa0, 0x9c, 0x80, 0x15, 0xf2, 0x3d, 0xdf, 0x9f,
-	0x57, 0x60, 0x69, 0x4b, 0x3f, 0xc0, 0x2f, 0xdf, 0xc9, 0x2e, 0xa4, 0xc7, 0x1e, 0xc2, 0x22, 0xf5,
-	0xab, 0x57, 0x22, 0xf4, 0xe4, 0x98, 0xf0, 0x28, 0x17, 0xa4, 0x07, 0xa2, 0xef, 0x10, 0xb7, 0x03,
-	0x0f, 0xf7, 0x37, 0x49, 0x8c, 0x12, 0x98, 0xef, 0x8b, 0x12, 0x3c, 0x6b, 0x02, 0x4a, 0x8b, 0x8e,
-	0x40, 0x9b, 0xb0, 0x10, 0x3f, 0x81, 0xc0, 0x70, 0xbf, 0x9d, 0x1b, 0xc0, 0x86, 0xbb, 0xaf, 0xb5,
-	0x63, 0x87, 0xe1, 0xa1, 0x2e, 0xcc, 0x71, 0xab, 0x4b, 0x95, 0x44, 0x5d, 0x0b, 0x9a, 0x68, 0x13,
-	0xce, 0xb2, 0x15, 0x6c, 0x71, 0x59, 0x60, 0x8b, 0xaf, 0x17, 0x5a, 0xbc, 0x6c, 0x68, 0x5c, 0x94,
-	0x1a, 0xc7, 0x15, 0xa5, 0x2e, 0xcc, 0x71, 0xf6, 0xa6, 0xda, 0xa3, 0xae, 0x05, 0x4d, 0x72, 0xcc,
-	0x21, 0xa3, 0x37, 0xe9, 0xb7, 0xb0, 0x43, 0xfd, 0x2d, 0x05, 0x20, 0xdc, 0xcf, 0x19, 0x09, 0x96,
-	0x6f, 0x41, 0x5d, 0x30, 0x77, 0xa1, 0x18, 0x51, 0x80, 0x27, 0x75, 0x79, 0x39, 0xa1, 0xcb, 0xd5,
-	0x7f, 0x52, 0xa0, 0xb5, 0x4e, 0x56, 0xf3, 0xd0, 0x19, 0x51, 0xcb, 0x73, 0x1d, 0xda, 0x2e, 0x1e,
-	0x3a, 0xae, 0x31, 0xc0, 0xb6, 0xef, 0x9a, 0x98, 0x05, 0xe7, 0x15, 0x6d, 0x9e, 0xf5, 0x7e, 0xca,
-	0x3a, 0x09, 0x18, 0x51, 0xcf, 0x9e, 0xaf, 0x8f, 0x27, 0x83, 0x5d, 0xa2, 0x10, 0x4a, 0x0c, 0x4c,
-	0xf4, 0x52, 0x7d, 0xf0, 0x26, 0xb4, 0x42, 0x30, 0xdf, 0xa1, 0xf3, 0x57, 0xb4, 0xa6, 0xe8, 0xdb,
-	0x76, 0xd0, 0x35, 0x68, 0xd3, 0xed, 0x1c, 0x58, 0xce, 0x68, 0x40, 0x42, 0x3e, 0x6e, 0x94, 0x5a,
-	0x06, 0x27, 0x8b, 0x1c, 0x53, 0x1c, 0xca, 0x33, 0xbf, 0xc0, 0xdc, 0x2c, 0x09, 0xa8, 0x2d, 0xf3,
-	0x0b, 0xac, 0xfe, 0x86, 0x02, 0xf3, 0xdc, 0x8a, 0x6d, 0x89, 0xe4, 0x37, 0xcd, 0x56, 0xb2, 0x70,
-	0x9b, 0xfe, 0x46, 0x1f, 0xc5, 0xf3, 0x55, 0xd7, 0xa4, 0xac, 0x4e, 0x91, 0x50, 0xdf, 0x29, 0x66,
-	0xc2, 0x8a, 0xc4, 0x7b, 0x5f, 0x92, 0x3d, 0xd5, 0x7d, 0xfd, 0x91, 0x63, 0xb0, 0xf4, 0x59, 0x17,
-	0xe6, 0x74, 0xc3, 0x70, 0xb1, 0xe7, 0x71, 0x3a, 0x82, 0x26, 0xf9, 0x72, 0x80, 0x5d, 0x2f, 0x38,
-	0xd8, 0xb2, 0x16, 0x34, 0xd1, 0xb7, 0xa1, 0x2e, 0x9c, 0x2d, 0x96, 0xa7, 0xb8, 0x92, 0x4d, 0x27,
-	0x8f, 0x4e, 0xc4, 0x08, 0xf5, 0xef, 0x4b, 0xd0, 0xe6, 0x92, 0xb6, 0xca, 0x0d, 0x4e, 0x3e, 0x8b,
-	0xad, 0x42, 0x6b, 0x37, 0xe4, 0xf0, 0xbc, 0xec, 0x4a, 0x54, 0x10, 0x62, 0x63, 0x66, 0xf1, 0x5a,
-	0xdc, 0xe4, 0x55, 0x4e, 0x65, 0xf2, 0xaa, 0xc7, 0x95, 0xd3, 0xb4, 0xeb, 0x53, 0x93, 0xb8, 0x3e,
-	0xea, 0x2f, 0x41, 0x33, 0x82, 0x80, 0xea, 0x21, 0x96, 0xc0, 0xe0, 0x3b, 0x16, 0x34, 0xd1, 0x9d,
-	0xd0, 0xf0, 0xb3, 0xad, 0xba, 0x20, 0xa1, 0x25, 0x61, 0xf3, 0xd5, 0x9f, 0x29, 0x50, 0xe3, 0x98,
-	0x2f, 0x43, 0x93, 0xcb, 0x17, 0x75, 0x85, 0x18, 0x76, 0xe0, 0x5d, 0xc4, 0x17, 0x7a, 0x71, 0x02,
-	0x76, 0x01, 0xea, 0x09, 0xd1, 0x9a, 0xe3, 0xca, 0x2f, 0xf8, 0x14, 0x91, 0x27, 0xf2, 0x89, 0x88,
-	0x12, 0x3a, 0x07, 0x55, 0xcb, 0x19, 0x89, 0xe2, 0x06, 0x6b, 0xa8, 0x5f, 0x29, 0x34, 0x17, 0xad,
-	0xe1, 0xa1, 0x73, 0x80, 0xdd, 0xa3, 0xd3, 0xa7, 0xf3, 0xee, 0x46, 0xd8, 0xbc, 0x60, 0x4c, 0x21,
-	0x06, 0xa0, 0xbb, 0xe1, 0x21, 0x94, 0x65, 0x51, 0x7f, 0xd4, 0xe0, 0x70, 0x26, 0x0d, 0x0f, 0xe3,
-	0x8f, 0x14, 0x9a, 0x98, 0x8c, 0x2f, 0xe5, 0xa4, 0x36, 0xfd, 0x85, 0xf8, 0xe7, 0xea, 0x3f, 0x2a,
-	0x70, 0x21, 0x63, 0x77, 0x9f, 0xac, 0xbc, 0x82, 0xfd, 0xfd, 0x08, 0xea, 0x22, 0x02, 0x2d, 0x17,
-	0x8a, 0x40, 0x05, 0xbc, 0xfa, 0x27, 0x2c, 0x3d, 0x2e, 0xd9, 0xde, 0x27, 0x2b, 0x2f, 0x69, 0x83,
-	0x93, 0x99, 0xa4, 0xb2, 0x24, 0x93, 0xf4, 0x73, 0x05, 0x7a, 0x61, 0xe6, 0xc6, 0x5b, 0x3d, 0x3a,
-	0x6d, 0x3d, 0xe5, 0xc5, 0x44, 0x66, 0xdf, 0x12, 0xa9, 0x7f, 0xa2, 0x17, 0x0b, 0xc5, 0x54, 0x41,
-	0xe2, 0xdf, 0xa6, 0x49, 0xe0, 0xf4, 0x82, 0x4e, 0x23, 0x95, 0xbd, 0xc8, 0xc1, 0xb3, 0xf4, 0x7f,
-	0x78, 0xb0, 0x3f, 0x63, 0x4c, 0x7a, 0x3f, 0x9e, 0xbe, 0x79, 0xd5, 0x1b, 0x18, 0x2d, 0x49, 0xec,
-	0xf1, 0x92, 0x44, 0x25, 0x51, 0x92, 0xe0, 0xfd, 0xea, 0x98, 0xb2, 0x40, 0x6a, 0x01, 0x2f, 0x6b,
-	0xc3, 0x7e, 0x5b, 0x81, 0x2e, 0x9f, 0x85, 0xce, 0x49, 0xc2, 0x2a, 0x0b, 0xfb, 0xd8, 0xf8, 0xba,
-	0x93, 0x0c, 0x7f, 0x5e, 0x82, 0x4e, 0xd4, 0xb1, 0xa1, 0xbe, 0xc9, 0x37, 0xa0, 0x4a, 0x73, 0x34,
-	0x9c, 0x82, 0x99, 0xda, 0x81, 0x41, 0x13, 0xcb, 0x48, 0x7d, 0xf6, 0x6d, 0x2f, 0x70, 0x5c, 0x78,
-	0x33, 0xf4, 0xae, 0xca, 0xc7, 0xf7, 0xae, 0xde, 0x80, 0x06, 0xb1, 0x5c, 0xce, 0x94, 0xe0, 0x65,
-	0x75, 0xe2, 0xb0, 0x03, 0x7d, 0x0c, 0x35, 0x76, 0xfb, 0x83, 0x97, 0xe9, 0xae, 0xc7, 0x51, 0xf3,
-	0x9b, 0x21, 0x91, 0x34, 0x3b, 0xed, 0xd0, 0xf8, 0x20, 0x72, 0x46, 0x13, 0xd7, 0x19, 0x51, 0x37,
-	0x8c, 0x18, 0xb5, 0xaa, 0x26, 0xda, 0xea, 0xff, 0x87, 0xa5, 0x30, 0xda, 0x65, 0x24, 0x9d, 0x94,
-	0xa1, 0xd5, 0x7f, 0x55, 0xe0, 0xec, 0xd6, 0x91, 0x3d, 0x4c, 0x8a, 0xc6, 0x12, 0xd4, 0x26, 0x96,
-	0x1e, 0x26, 0x7f, 0x79, 0x8b, 0x16, 0xd6, 0xd9, 0xdc, 0xd8, 0x20, 0x26, 0x9c, 0xed, 0x67, 0x53,
-	0xf4, 0x6d, 0x3b, 0x33, 0x3d, 0xab, 0xeb, 0x22, 0x3c, 0xc7, 0x06, 0x73, 0x16, 0x58, 0x72, 0x6b,
-	0x5e, 0xf4, 0x52, 0x67, 0xe1, 0x63, 0x00, 0xea, 0x4f, 0x0d, 0x8e, 0xe3, 0x43, 0xd1, 0x11, 0x0f,
-	0x89, 0xc5, 0xfc, 0x69, 0x09, 0xba, 0x91, 0x5d, 0xfa, 0xba, 0xdd, 0xcb, 0x8c, 0xd0, 0xaf, 0xfc,
-	0x82, 0x42, 0xbf, 0xca, 0xe9, 0x5d, 0xca, 0xaa, 0xcc, 0xa5, 0xfc, 0xb5, 0x32, 0xb4, 0xc3, 0x5d,
-	0xdb, 0xb4, 0x74, 0x3b, 0x93, 0x13, 0xb6, 0xa0, 0xed, 0xc5, 0x76, 0x95, 0xef, 0xd3, 0xbb, 0x32,
-	0x19, 0xca, 0x38, 0x08, 0x2d, 0x81, 0x02, 0x5d, 0xa4, 0x87, 0xee, 0xfa, 0x2c, 0x9d, 0xc6, 0xfc,
-	0xc3, 0x06, 0x13, 0x56, 0x73, 0x8c, 0xd1, 0x7b, 0x80, 0xb8, 0x84, 0x0d, 0x4c, 0x7b, 0xe0, 0xe1,
-	0xa1, 0x63, 0x1b, 0x4c, 0xf6, 0xaa, 0x5a, 0x87, 0x7f, 0xe9, 0xdb, 0x5b, 0xac, 0x1f, 0x7d, 0x03,
-	0x2a, 0xfe, 0xd1, 0x84, 0x39, 0x8b, 0x6d, 0xa9, 0xbb, 0x15, 0xd2, 0xb5, 0x7d, 0x34, 0xc1, 0x1a,
-	0x05, 0x0f, 0x2e, 0x00, 0xf9, 0xae, 0x7e, 0xc0, 0x3d, 0xef, 0x8a, 0x16, 0xe9, 0x21, 0xda, 0x24,
-	0xd8, 0xc3, 0x39, 0xe6, 0xa1, 0xf2, 0x26, 0xe3, 0xec, 0x40, 0xa0, 0x07, 0xbe, 0x6f, 0xd1, 0x84,
-	0x20, 0xe5, 0xec, 0xa0, 0x77, 0xdb, 0xb7, 0xc8, 0x22, 0x7d, 0xc7, 0xd7, 0x2d, 0x26, 0x1f, 0x0d,
-	0xae, 0x39, 0x48, 0x0f, 0x8d, 0x72, 0xff, 0x85, 0x68, 0x3e, 0x41, 0x98, 0x86, 0xbd, 0xa9, 0x95,
-	0x2d, 0x8f, 0xf9, 0xf9, 0x99, 0x59, 0xa2, 0xf8, 0x1d, 0x68, 0x72, 0xae, 0x38, 0x06, 0x57, 0x01,
-	0x1b, 0xf2, 0x30, 0x87, 0xcd, 0xab, 0x2f, 0x88, 0xcd, 0x6b, 0x27, 0xc8, 0x70, 0xc8, 0xcf, 0x46,
-	0xfd, 0xb1, 0x02, 0xaf, 0xa5, 0xb4, 0x66, 0xee, 0xd6, 0xe6, 0x47, 0xde, 0x5c, 0x9b, 0x26, 0x51,
-	0x72, 0xdb, 0x70, 0x17, 0x6a, 0x2e, 0xc5, 0xce, 0x8b, 0x5e, 0x57, 0x73, 0x99, 0x8f, 0x11, 0xa2,
-	0xf1, 0x21, 0xea, 0x1f, 0x2b, 0x70, 0x3e, 0x4d, 0xea, 0x29, 0x0c, 0xfe, 0x2a, 0xcc, 0x31, 0xd4,
-	0x81, 0x8c, 0x2e, 0xe7, 0xcb, 0x68, 0xb8, 0x39, 0x5a, 0x30, 0x50, 0xdd, 0x82, 0xa5, 0xc0, 0x2f,
-	0x08, 0xb7, 0x7e, 0x03, 0xfb, 0x7a, 0x4e, 0xdc, 0x79, 0x19, 0x9a, 0x2c, 0x80, 0x61, 0xf1, 0x1c,
-	0xab, 0x11, 0xc2, 0x8e, 0x48, 0xe7, 0xa9, 0xff, 0xa5, 0xc0, 0x39, 0x6a, 0x58, 0x93, 0x05, 0x9f,
-	0x22, 0x15, 0x48, 0x55, 0xdc, 0xf1, 0x7a, 0xa4, 0x8f, 0xf9, 0x3d, 0x94, 0x86, 0x16, 0xeb, 0x43,
-	0xfd, 0x74, 0xb6, 0x4f, 0x9a, 0x9f, 0x08, 0x4b, 0xae, 0xeb, 0xba, 0xaf, 0xd3, 0x8a, 0x6b, 0x32,
-	0xcd, 0x17, 0x1a, 0xf4, 0xca, 0x09, 0x0c, 0xba, 0xfa, 0x10, 0x5e, 0x4b, 0xac, 0xf4, 0x14, 0x27,
-	0xaa, 0xfe, 0xb5, 0x42, 0x8e, 0x23, 0x76, 0x9f, 0xe7, 0xe4, 0x4e, 0xed, 0x45, 0x51, 0x69, 0x1a,
-	0x98, 0x46, 0x52, 0x89, 0x18, 0xe8, 0x13, 0x68, 0xd8, 0xf8, 0x70, 0x10, 0xf5, 0x93, 0x0a, 0x78,
-	0xfc, 0x75, 0x1b, 0x1f, 0xd2, 0x5f, 0xea, 0x23, 0x38, 0x9f, 0x22, 0xf5, 0x34, 0x6b, 0xff, 0x07,
-	0x05, 0x2e, 0xac, 0xbb, 0xce, 0xe4, 0x89, 0xe9, 0xfa, 0x53, 0xdd, 0x8a, 0x17, 0xb3, 0x4f, 0xb0,
-	0xfc, 0x02, 0x77, 0x05, 0x3f, 0x4b, 0xc5, 0x96, 0xef, 0x49, 0x24, 0x28, 0x4d, 0x14, 0x5f, 0x74,
-	0xc4, 0xbf, 0xfe, 0x8f, 0xb2, 0x8c, 0x78, 0x0e, 0x37, 0xc3, 0x2f, 0x29, 0x12, 0x7c, 0x48, 0xb3,
-	0xed, 0xe5, 0x93, 0x66, 0xdb, 0x33, 0xd4, 0x7b, 0xe5, 0x05, 0xa9, 0xf7, 0x63, 0x27, 0xc6, 0xd6,
-	0x20, 0x5e, 0x09, 0xa1, 0xd6, 0xf9, 0xb8, 0xd5, 0x93, 0x8f, 0x01, 0xc2, 0x82, 0x00, 0xbf, 0x7f,
-	0x39, 0x03, 0x43, 0x64, 0x00, 0x39, 0x23, 0x61, 0x40, 0xb9, 0x7d, 0x8f, 0xa4, 0xa8, 0xbf, 0x0f,
-	0x3d, 0x19, 0x6f, 0x9e, 0x86, 0xdf, 0x7f, 0x5a, 0x02, 0xe8, 0x8b, 0xdb, 0xba, 0x27, 0xb3, 0x00,
-	0x57, 0x21, 0xe2, 0x83, 0x84, 0x52, 0x1e, 0xe5, 0x1d, 0x83, 0x08, 0x82, 0x88, 0x52, 0x09, 0x4c,
-	0x2a, 0x72, 0x35, 0x28, 0x9e, 0x88, 0xac, 0x30, 0x56, 0x48, 0x2a, 0xdd, 0xd7, 0xa1, 0xe1, 0x3a,
-	0x87, 0x03, 0x22, 0x5c, 0x46, 0x70, 0x1d, 0xd9, 0x75, 0x0e, 0x89, 0xc8, 0x19, 0xe8, 0x3c, 0xcc,
-	0xf9, 0xba, 0xb7, 0x4f, 0xf0, 0xb3, 0x64, 0x5d, 0x8d, 0x34, 0xfb, 0x06, 0x3a, 0x07, 0xd5, 0x5d,
-	0xd3, 0xc2, 0xec, 0xe6, 0x43, 0x43, 0x63, 0x0d, 0xf4, 0xcd, 0xe0, 0x06, 0x5d, 0xbd, 0xf0, 0x4d,
-	0x19, 0x0a, 0xaf, 0x7e, 0xa5, 0xc0, 0x42, 0xb8, 0x6b, 0x54, 0xed, 0x10, 0x4d, 0x46, 0xb5, 0xd8,
-	0x9a, 0x63, 0x30, 0x05, 0xd1, 0xce, 0xb0, 0x03, 0x6c, 0x20, 0xd3, 0x55, 0xe1, 0x90, 0xbc, 0xc0,
-	0x99, 0xac, 0x8b, 0x2c, 0xda, 0x34, 0x82, 0x54, 0x4e, 0xcd, 0x75, 0x0e, 0xfb, 0x86, 0xd8, 0x0d,
-	0x76, 0xd7, 0x98, 0x85, 0x89, 0x64, 0x37, 0xd6, 0xe8, 0x75, 0xe3, 0xab, 0x30, 0x8f, 0x5d, 0xd7,
-	0x71, 0x07, 0x63, 0xec, 0x79, 0xfa, 0x08, 0x73, 0xaf, 0xbc, 0x45, 0x3b, 0x37, 0x58, 0x9f, 0xfa,
-	0x67, 0x15, 0x68, 0x87, 0x4b, 0x09, 0x4a, 0xee, 0xa6, 0x11, 0x94, 0xdc, 0x4d, 0x72, 0x74, 0xe0,
-	0x32, 0x05, 0x28, 0x0e, 0x77, 0xb5, 0xd4, 0x55, 0xb4, 0x06, 0xef, 0xed, 0x1b, 0xc4, 0x18, 0x13,
-	0xd1, 0xb2, 0x1d, 0x03, 0x87, 0x87, 0x0b, 0x41, 0x17, 0x3f, 0xdb, 0x18, 0x8f, 0x54, 0x0a, 0xf0,
-	0x48, 0xb5, 0x00, 0x8f, 0xd4, 0x24, 0x3c, 0xb2, 0x04, 0xb5, 0x9d, 0xe9, 0x70, 0x1f, 0xfb, 0xdc,
-	0x4f, 0xe3, 0xad, 0x38, 0xef, 0xd4, 0x13, 0xbc, 0x23, 0x58, 0xa4, 0x11, 0x65, 0x91, 0xd7, 0xa1,
-	0xc1, 0xaa, 0xc0, 0x03, 0xdf, 0xa3, 0x75, 0xad, 0xb2, 0x56, 0x67, 0x1d, 0xdb, 0x1e, 0xfa, 0x30,
-	0x70, 0xe2, 0x9a, 0x54, 0x58, 0x54, 0x89, 0xae, 0x49, 0x70, 0x49, 0xe0, 0xc2, 0xbd, 0x0d, 0x0b,
-	0x91, 0xed, 0xa0, 0x96, 0xa1, 0x45, 0x49, 0x8d, 0xf8, 0xf8, 0xd4, 0x38, 0x5c, 0x87, 0x76, 0xb8,
-	0x25, 0x14, 0x6e, 0x9e, 0x85, 0x56, 0xa2, 0x97, 0x82, 0x09, 0x4e, 0x6e, 0x1f, 0x8f, 0x93, 0xd1,
-	0x05, 0xa8, 0xf3, 0x98, 0xc8, 0xeb, 0x2e, 0xc4, 0xd2, 0x17, 0xea, 0x0f, 0x01, 0x85, 0xd4, 0x9f,
-	0xce, 0x47, 0x4c, 0xb0, 0x47, 0x29, 0xc9, 0x1e, 0xea, 0xdf, 0x28, 0xb0, 0x18, 0x9d, 0xec, 0xa4,
-	0xe6, 0xf6, 0x13, 0x68, 0xb2, 0xca, 0xe2, 0x80, 0x08, 0xbe, 0xbc, 0x44, 0x98, 0x38, 0x17, 0x0d,
-	0xc2, 0xd7, 0x0a, 0x84, 0xbd, 0x0e, 0x1d, 0x77, 0xdf, 0xb4, 0x47, 0x03, 0x42, 0x99, 0xc8, 0x9c,
-	0xf2, 0xce, 0x47, 0xa4, 0x4f, 0xfd, 0x3d, 0x05, 0x2e, 0x3d, 0x9e, 0x18, 0xba, 0x8f, 0x23, 0x7e,
-	0xc7, 0x69, 0x2f, 0x0d, 0x8a, 0x5b, 0x7b, 0xa5, 0x9c, 0x13, 0x8c, 0xcc, 0xe7, 0xf1, 0x5b, 0x7b,
-	0xc4, 0x5b, 0xe3, 0xd4, 0xa4, 0xae, 0xd9, 0x9e, 0x9c, 0x9a, 0x1e, 0xd4, 0x0f, 0x38, 0xba, 0xe0,
-	0xfd, 0x45, 0xd0, 0x8e, 0xd5, 0x60, 0xcb, 0xc7, 0xaa, 0xc1, 0xaa, 0x1b, 0x70, 0x41, 0xc3, 0x1e,
-	0xb6, 0x8d, 0xd8, 0x42, 0x4e, 0x9c, 0x5f, 0x9a, 0x40, 0x4f, 0x86, 0xee, 0x34, 0x9c, 0xca, 0xdc,
-	0xd5, 0x81, 0x4b, 0xd0, 0xfa, 0x5c, 0x0f, 0x13, 0x2f, 0x89, 0xce, 0xe3, 0xab, 0x7f, 0x5b, 0x82,
-	0xf3, 0xf7, 0x0c, 0x83, 0xab, 0x70, 0xee, 0x80, 0xbd, 0x2c, 0xdf, 0x38, 0xe9, 0x3b, 0x96, 0xd3,
-	0xbe, 0xe3, 0x8b, 0x52, 0xab, 0xdc, 0xc0, 0xd8, 0xd3, 0x71, 0x60, 0x38, 0x5d, 0x76, 0x11, 0xe9,
-	0x2e, 0xaf, 0x54, 0x92, 0x18, 0x9e, 0x1a, 0xcf, 0xd9, 0x2e, 0x55, 0x3d, 0xc8, 0x93, 0xa9, 0x13,
-	0xe8, 0xa6, 0x37, 0xeb, 0x94, 0x7a, 0x24, 0xd8, 0x91, 0x89, 0xc3, 0xf2, 0xad, 0x2d, 0xe2, 0x3f,
-	0xd1, 0xae, 0x4d, 0xc7, 0x53, 0xff, 0xbb, 0x04, 0xdd, 0x2d, 0xfd, 0x00, 0xff, 0xdf, 0x39, 0xa0,
-	0xcf, 0xe1, 0x9c, 0xa7, 0x1f, 0xe0, 0x41, 0x24, 0x16, 0x1e, 0xb8, 0xf8, 0x19, 0x77, 0x3d, 0xdf,
-	0x91, 0x65, 0xc4, 0xa5, 0xd7, 0x77, 0xb4, 0x45, 0x2f, 0xd6, 0xaf, 0xe1, 0x67, 0xe8, 0x2d, 0x58,
-	0x88, 0xde, 0x0a, 0x23, 0xa4, 0xd5, 0xe9, 0x96, 0xcf, 0x47, 0x6e, 0x7e, 0xf5, 0x0d, 0xf5, 0x19,
-	0xbc, 0xf1, 0xd8, 0xf6, 0xb0, 0xdf, 0x0f, 0x6f, 0x2f, 0x9d, 0x32, 0x6a, 0xbc, 0x0c, 0xcd, 0x70,
-	0xe3, 0x53, 0x0f, 0x2f, 0x0c, 0x4f, 0x75, 0xa0, 0xb7, 0xa1, 0xbb, 0xfb, 0x41, 0x66, 0x79, 0x9d,
-	0x5d, 0x35, 0x79, 0x89, 0x13, 0xee, 0x8a, 0x4b, 0x57, 0x1a, 0xde, 0xc5, 0x2e, 0xb6, 0x87, 0xf8,
-	0xa1, 0x33, 0xdc, 0x27, 0xbe, 0x86, 0xcf, 0xde, 0xbe, 0x29, 0x11, 0x8f, 0x73, 0x3d, 0xf2, 0xb4,
-	0xad, 0x14, 0x7b, 0xda, 0x36, 0xe3, 0xa9, 0xa4, 0xfa, 0x93, 0x12, 0x2c, 0xdd, 0xb3, 0x7c, 0xec,
-	0x86, 0xc1, 0xfe, 0x71, 0xf2, 0x16, 0x61, 0x22, 0xa1, 0x74, 0x92, 0xca, 0x40, 0x81, 0xc2, 0xa1,
-	0x2c, 0xed, 0x51, 0x39, 0x61, 0xda, 0xe3, 0x1e, 0xc0, 0xc4, 0x75, 0x26, 0xd8, 0xf5, 0x4d, 0x1c,
-	0x44, 0x6c, 0x05, 0x7c, 0x97, 0xc8, 0x20, 0xf5, 0x73, 0xe8, 0x3c, 0x18, 0xae, 0x39, 0xf6, 0xae,
-	0xe9, 0x8e, 0x83, 0x8d, 0x4a, 0x09, 0x9d, 0x52, 0x40, 0xe8, 0x4a, 0x29, 0xa1, 0x53, 0x4d, 0x58,
-	0x8c, 0xe0, 0x3e, 0xa5, 0xe2, 0x1a, 0x0d, 0x07, 0xbb, 0xa6, 0x6d, 0xd2, 0xab, 0x5c, 0x25, 0xea,
-	0x7b, 0xc2, 0x68, 0x78, 0x9f, 0xf7, 0xdc, 0xf8, 0x44, 0xdc, 0x7a, 0xdd, 0x3e, 0x9a, 0x60, 0x34,
-	0x07, 0xe5, 0x47, 0xf8, 0xb0, 0x73, 0x06, 0x01, 0xd4, 0x1e, 0x39, 0xee, 0x58, 0xb7, 0x3a, 0x0a,
-	0x6a, 0xc2, 0x1c, 0x2f, 0xd5, 0x75, 0x4a, 0x68, 0x1e, 0x1a, 0x6b, 0x41, 0x49, 0xa3, 0x53, 0xbe,
-	0xf1, 0x17, 0x0a, 0x2c, 0xa6, 0x8a, 0x49, 0xa8, 0x0d, 0xf0, 0xd8, 0x1e, 0xf2, 0x2a, 0x5b, 0xe7,
-	0x0c, 0x6a, 0x41, 0x3d, 0xa8, 0xb9, 0x31, 0x7c, 0xdb, 0x0e, 0x85, 0xee, 0x94, 0x50, 0x07, 0x5a,
-	0x6c, 0xe0, 0x74, 0x38, 0xc4, 0x9e, 0xd7, 0x29, 0x8b, 0x9e, 0xfb, 0xba, 0x69, 0x4d, 0x5d, 0xdc,
-	0xa9, 0x90, 0x39, 0xb7, 0x1d, 0x0d, 0x5b, 0x58, 0xf7, 0x70, 0xa7, 0x8a, 0x10, 0xb4, 0x79, 0x23,
-	0x18, 0x54, 0x8b, 0xf4, 0x05, 0xc3, 0xe6, 0x6e, 0x3c, 0x8d, 0xa6, 0xfd, 0xe9, 0xf2, 0xce, 0xc3,
-	0xd9, 0xc7, 0xb6, 0x81, 0x77, 0x4d, 0x1b, 0x1b, 0xe1, 0xa7, 0xce, 0x19, 0x74, 0x16, 0x16, 0x36,
-	0xb0, 0x3b, 0xc2, 0x91, 0xce, 0x12, 0x5a, 0x84, 0xf9, 0x0d, 0xf3, 0x79, 0xa4, 0xab, 0xac, 0x56,
-	0xea, 0x4a, 0x47, 0x59, 0xf9, 0xf7, 0xab, 0xd0, 0x20, 0xbc, 0xb5, 0xe6, 0x38, 0xae, 0x81, 0x2c,
-	0x40, 0xf4, 0x6d, 0xc9, 0x78, 0xe2, 0xd8, 0xe2, 0x1d, 0x1a, 0xba, 0x99, 0xf0, 0x4d, 0x58, 0x23,
-	0x0d, 0xc8, 0x79, 0xa7, 0x77, 0x4d, 0x0a, 0x9f, 0x00, 0x56, 0xcf, 0xa0, 0x31, 0x9d, 0x6d, 0xdb,
-	0x1c, 0xe3, 0x6d, 0x73, 0xb8, 0x1f, 0xf8, 0x46, 0xb7, 0x33, 0x1e, 0xf3, 0xa4, 0x41, 0x83, 0xf9,
-	0xae, 0x4a, 0xe7, 0x63, 0x8f, 0x7f, 0x02, 0x9e, 0x53, 0xcf, 0xa0, 0x67, 0x70, 0xee, 0x01, 0x8e,
-	0x38, 0x9a, 0xc1, 0x84, 0x2b, 0xd9, 0x13, 0xa6, 0x80, 0x8f, 0x39, 0xe5, 0x43, 0xa8, 0x52, 0x76,
-	0x43, 0xb2, 0x4a, 0x68, 0xf4, 0x11, 0x79, 0xef, 0x4a, 0x36, 0x80, 0xc0, 0xf6, 0x43, 0x58, 0x48,
-	0x3c, 0x2f, 0x45, 0x32, 0xe3, 0x24, 0x7f, 0x28, 0xdc, 0xbb, 0x51, 0x04, 0x54, 0xcc, 0x35, 0x82,
-	0x76, 0xfc, 0x4d, 0x0a, 0x5a, 0x2e, 0xf0, 0xb2, 0x8d, 0xcd, 0xf4, 0x4e, 0xe1, 0x37, 0x70, 0x94,
-	0x09, 0x3a, 0xc9, 0x87, 0x8f, 0xe8, 0x46, 0x2e, 0x82, 0x38, 0xb3, 0xbd, 0x5b, 0x08, 0x56, 0x4c,
-	0x77, 0x44, 0x99, 0x20, 0xf5, 0xea, 0x2c, 0xc9, 0xe3, 0x01, 0x9a, 0xac, 0xe7, 0x70, 0xbd, 0x5b,
-	0x85, 0xe1, 0xc5, 0xd4, 0xbf, 0xce, 0xae, 0x3b, 0xc9, 0x5e, 0x6e, 0xa1, 0x0f, 0xe4, 0xe8, 0x72,
-	0x9e, 0x9c, 0xf5, 0x56, 0x8e, 0x33, 0x44, 0x10, 0xf1, 0xab, 0xf4, 0x9e, 0x92, 0xe4, 0xed, 0x53,
-	0x52, 0xee, 0x02, 0x7c, 0xd9, 0xcf, 0xba, 0x7a, 0x1f, 0x1c, 0x63, 0x84, 0x20, 0xc0, 0x49, 0xbe,
-	0x2c, 0x0d, 0xc4, 0xf0, 0xd6, 0x4c, 0xae, 0x39, 0x99, 0x0c, 0xfe, 0x00, 0x16, 0x12, 0xee, 0x1a,
-	0x2a, 0xee, 0xd2, 0xf5, 0xf2, 0x4c, 0x13, 0x13, 0xc9, 0xc4, 0xbd, 0x24, 0x94, 0xc1, 0xfd, 0x92,
-	0xbb, 0x4b, 0xbd, 0x1b, 0x45, 0x40, 0xc5, 0x42, 0x26, 0xb0, 0x98, 0xf8, 0xf8, 0x64, 0x05, 0xbd,
-	0x5b, 0x78, 0xb6, 0x27, 0x2b, 0xbd, 0xf7, 0x8a, 0xcf, 0xf7, 0x64, 0x45, 0x3d, 0x83, 0x3c, 0xaa,
-	0xa0, 0x13, 0x77, 0x5b, 0x50, 0x06, 0x16, 0xf9, 0x1d, 0x9e, 0xde, 0xfb, 0x05, 0xa1, 0xc5, 0x32,
-	0x0f, 0xe0, 0xac, 0xe4, 0x0a, 0x12, 0x7a, 0x3f, 0x97, 0x3d, 0x92, 0x77, 0xaf, 0x7a, 0x37, 0x8b,
-	0x82, 0x47, 0xcc, 0x43, 0x27, 0xa0, 0xeb, 0x9e, 0x65, 0x31, 0xe3, 0xff, 0x5e, 0x96, 0xe5, 0x8b,
-	0x81, 0x65, 0x2c, 0x35, 0x13, 0x5a, 0x4c, 0xf9, 0xcb, 0x80, 0xb6, 0xf6, 0x9c, 0x43, 0xea, 0x1e,
-	0x8d, 0xa6, 0xae, 0xce, 0x3c, 0xba, 0x2c, 0x03, 0x98, 0x06, 0xcd, 0x10, 0xc4, 0xdc, 0x11, 0x62,
-	0xf2, 0x01, 0xc0, 0x03, 0xec, 0x6f, 0x60, 0xdf, 0x25, 0xd2, 0xff, 0x56, 0x16, 0xed, 0x1c, 0x20,
-	0x98, 0xea, 0xed, 0x99, 0x70, 0xd1, 0x0d, 0xdd, 0xd0, 0xed, 0xa9, 0x6e, 0x45, 0x9e, 0x7e, 0xc8,
-	0x37, 0x34, 0x09, 0x96, 0xbf, 0xa1, 0x69, 0x68, 0x31, 0xe5, 0xa1, 0xf0, 0x5f, 0x22, 0x95, 0xd2,
-	0x7c, 0xff, 0x25, 0x7d, 0x4b, 0x27, 0xa9, 0xdb, 0x73, 0xe0, 0xc5, 0xc4, 0x5f, 0x2a, 0xf4, 0xe2,
-	0x5c, 0x02, 0xe0, 0xa9, 0xe9, 0xef, 0x6d, 0x5a, 0xba, 0xed, 0x15, 0x21, 0x81, 0x02, 0x1e, 0x83,
-	0x04, 0x0e, 0x2f, 0x48, 0x30, 0x60, 0x3e, 0x56, 0xc0, 0x44, 0xb2, 0x07, 0x13, 0xb2, 0x62, 0x6e,
-	0x6f, 0x79, 0x36, 0xa0, 0x98, 0x65, 0x0f, 0xe6, 0x03, 0x86, 0x66, 0x9b, 0xfb, 0x4e, 0x2e, 0xd3,
-	0xc7, 0xf6, 0xf5, 0x46, 0x11, 0x50, 0x31, 0x93, 0x07, 0x28, 0x5d, 0xa9, 0x41, 0xc5, 0xea, 0x7a,
-	0x79, 0xca, 0x27, 0xbb, 0xfc, 0xc3, 0xf4, 0x79, 0xa2, 0x16, 0x2a, 0x37, 0x16, 0xd2, 0xd2, 0xae,
-	0x54, 0x9f, 0x67, 0x94, 0x56, 0xd5, 0x33, 0xe8, 0x29, 0xd4, 0xf8, 0x5f, 0xc0, 0x5c, 0xcb, 0xcf,
-	0xae, 0x72, 0xec, 0xd7, 0x67, 0x40, 0x09, 0xc4, 0xfb, 0x70, 0x3e, 0x23, 0xb7, 0x2a, 0xf5, 0x33,
-	0xf2, 0xf3, 0xb0, 0xb3, 0x2c, 0xa0, 0x98, 0x2c, 0x95, 0x3a, 0xcd, 0x99, 0x2c, 0x2b, 0xcd, 0x3a,
-	0x6b, 0xb2, 0x01, 0x2c, 0xa6, 0x52, 0x53, 0x52, 0x13, 0x98, 0x95, 0xc0, 0x9a, 0x35, 0xc1, 0x08,
-	0x5e, 0x93, 0xa6, 0x61, 0xa4, 0xde, 0x49, 0x5e, 0xc2, 0x66, 0xd6, 0x44, 0x43, 0x38, 0x2b, 0x49,
-	0xbe, 0x48, 0xad, 0x5c, 0x76, 0x92, 0x66, 0xd6, 0x24, 0xbb, 0xd0, 0x5b, 0x75, 0x1d, 0xdd, 0x18,
-	0xea, 0x9e, 0x4f, 0x13, 0x22, 0x24, 0x54, 0x0c, 0xdc, 0x43, 0x79, 0xec, 0x20, 0x4d, 0x9b, 0xcc,
-	0x9a, 0x67, 0x07, 0x9a, 0xf4, 0x28, 0xd9, 0xdf, 0x74, 0x20, 0xb9, 0x8d, 0x88, 0x40, 0x64, 0x28,
-	0x1e, 0x19, 0xa0, 0x60, 0xea, 0x6d, 0x68, 0xae, 0xd1, 0xa2, 0x51, 0xdf, 0x36, 0xf0, 0xf3, 0xa4,
-	0xbd, 0xa2, 0x6f, 0x95, 0x6f, 0x46, 0x00, 0x0a, 0xef, 0xd0, 0x3c, 0xf5, 0xda, 0x0d, 0xfc, 0x9c,
-	0x9d, 0xf3, 0xb2, 0x0c, 0x6f, 0x0c, 0x24, 0x23, 0xca, 0x91, 0x42, 0x46, 0x2c, 0xfd, 0xb9, 0xa8,
-	0x2f, 0x2b, 0xa6, 0xbb, 0x95, 0x81, 0x24, 0x05, 0x19, 0xcc, 0x7a, 0xbb, 0xf8, 0x80, 0xa8, 0x65,
-	0x08, 0xe8, 0xea, 0xd3, 0x8a, 0xd5, 0xdb, 0x79, 0xa4, 0x47, 0x1d, 0xd4, 0xe5, 0xd9, 0x80, 0x62,
-	0x96, 0x4d, 0x68, 0x10, 0xee, 0x64, 0xc7, 0x73, 0x4d, 0x36, 0x50, 0x7c, 0x2e, 0x7e, 0x38, 0xeb,
-	0xd8, 0x1b, 0xba, 0xe6, 0x0e, 0x3f, 0x74, 0x29, 0x39, 0x31, 0x90, 0xdc, 0xc3, 0x49, 0x40, 0x0a,
-	0xca, 0x7f, 0x85, 0x86, 0x24, 0xb4, 0x77, 0x75, 0x6a, 0x5a, 0xc6, 0x26, 0xbf, 0xc8, 0x8b, 0x6e,
-	0xe7, 0x2d, 0x3f, 0x06, 0x9a, 0xe9, 0x89, 0xe5, 0x8c, 0x10, 0xf3, 0xff, 0x22, 0x34, 0x44, 0x8e,
-	0x0c, 0xc9, 0x6e, 0xa2, 0x25, 0xb3, 0x73, 0xbd, 0x6b, 0xf9, 0x40, 0x01, 0xe6, 0x95, 0xaf, 0x1a,
-	0x50, 0x0f, 0x1e, 0x8f, 0x7d, 0xcd, 0xc9, 0x9d, 0x57, 0x90, 0x6d, 0xf9, 0x01, 0x2c, 0x24, 0xfe,
-	0x9f, 0x40, 0xaa, 0xe3, 0xe4, 0xff, 0x61, 0x30, 0x8b, 0x19, 0x9f, 0xf2, 0xbf, 0xcf, 0x13, 0x61,
-	0xd0, 0xdb, 0x59, 0x19, 0x9b, 0x64, 0x04, 0x34, 0x03, 0xf1, 0xff, 0xee, 0x20, 0xe0, 0x11, 0x40,
-	0xc4, 0xfd, 0xcf, 0xbf, 0xe3, 0x4b, 0x3c, 0xda, 0x59, 0xbb, 0x35, 0x96, 0x7a, 0xf8, 0xef, 0x14,
-	0xb9, 0x2f, 0x99, 0xed, 0xa3, 0x65, 0xfb, 0xf5, 0x8f, 0xa1, 0x15, 0xbd, 0x7d, 0x8f, 0xa4, 0x7f,
-	0xd6, 0x96, 0xbe, 0x9e, 0x3f, 0x6b, 0x15, 0x1b, 0xc7, 0x74, 0xfd, 0x66, 0xa0, 0xf3, 0x00, 0xa5,
-	0x8b, 0xb8, 0x52, 0x57, 0x39, 0xb3, 0x74, 0x2c, 0x75, 0x95, 0xb3, 0x2b, 0xc3, 0x2c, 0x71, 0x97,
-	0xac, 0x4c, 0x4a, 0x13, 0x77, 0x19, 0xb5, 0x5e, 0x69, 0xe2, 0x2e, 0xab, 0xd4, 0xa9, 0x9e, 0x59,
-	0xbd, 0xf3, 0xf9, 0x07, 0x23, 0xd3, 0xdf, 0x9b, 0xee, 0x90, 0xd5, 0xdf, 0x62, 0x43, 0xdf, 0x37,
-	0x1d, 0xfe, 0xeb, 0x56, 0xc0, 0xee, 0xb7, 0x28, 0xb6, 0x5b, 0x04, 0xdb, 0x64, 0x67, 0xa7, 0x46,
-	0x5b, 0x77, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x3f, 0x9d, 0xc1, 0x3a, 0x54, 0x00, 0x00,
+	// 4935 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3c, 0x4b, 0x8c, 0x1b, 0x47,
+	0x76, 0x6a, 0xfe, 0x86, 0x7c, 0xe4, 0x70, 0x38, 0xa5, 0xf1, 0x88, 0xa2, 0x64, 0x49, 0x6e, 0xcb,
+	0xf6, 0x58, 0xb6, 0x46, 0xf2, 0x38, 0x9b, 0x78, 0xed, 0xb5, 0x77, 0x35, 0x33, 0x96, 0xcc, 0x8d,
+	0x46, 0x3b, 0xdb, 0x33, 0x92, 0x03, 0x6f, 0x00, 0xa2, 0x87, 0x5d, 0xe4, 0xb4, 0x87, 0xec, 0xa6,
+	0xba, 0x9b, 0xf3, 0x71, 0x80, 0xc4, 0xc8, 0x0f, 0xc8, 0x07, 0x49, 0x10, 0x24, 0x40, 0x72, 0x0b,
+	0x72, 0x08, 0x36, 0x9f, 0xdd, 0xcb, 0x26, 0x97, 0x5c, 0xf6, 0xba, 0x41, 0x0e, 0x8b, 0x1c, 0x93,
+	0x20, 0xd7, 0x20, 0x87, 0xdc, 0x82, 0xdc, 0x83, 0xfa, 0x74, 0xf5, 0xaf, 0xd8, 0xec, 0x21, 0x25,
+	0x0b, 0xc8, 0xde, 0x58, 0xd5, 0xaf, 0x5e, 0x55, 0xbd, 0x5f, 0xbd, 0x4f, 0x15, 0xa1, 0x61, 0xe8,
+	0x9e, 0xde, 0xe9, 0xda, 0xb6, 0x63, 0xac, 0x8f, 0x1c, 0xdb, 0xb3, 0xd1, 0xf2, 0xd0, 0x1c, 0x1c,
+	0x8f, 0x5d, 0xd6, 0x5a, 0x27, 0x9f, 0x5b, 0xb5, 0xae, 0x3d, 0x1c, 0xda, 0x16, 0xeb, 0x6a, 0xd5,
+	0x4d, 0xcb, 0xc3, 0x8e, 0xa5, 0x0f, 0x78, 0xbb, 0x16, 0x1e, 0xd0, 0xaa, 0xb9, 0xdd, 0x43, 0x3c,
+	0xd4, 0x79, 0xab, 0x32, 0x74, 0xfb, 0xfc, 0xe7, 0xb2, 0x69, 0x19, 0xf8, 0x34, 0x3c, 0x95, 0xba,
+	0x00, 0xc5, 0x8f, 0x87, 0x23, 0xef, 0x4c, 0xfd, 0x7b, 0x05, 0x6a, 0xf7, 0x07, 0x63, 0xf7, 0x50,
+	0xc3, 0x4f, 0xc7, 0xd8, 0xf5, 0xd0, 0x5d, 0x28, 0x1c, 0xe8, 0x2e, 0x6e, 0x2a, 0x37, 0x94, 0xb5,
+	0xea, 0xc6, 0xd5, 0xf5, 0xc8, 0x9a, 0xf8, 0x6a, 0x76, 0xdc, 0xfe, 0xa6, 0xee, 0x62, 0x8d, 0x42,
+	0x22, 0x04, 0x05, 0xe3, 0xa0, 0xbd, 0xdd, 0xcc, 0xdd, 0x50, 0xd6, 0xf2, 0x1a, 0xfd, 0x8d, 0xae,
+	0x01, 0xb8, 0xb8, 0x3f, 0xc4, 0x96, 0xd7, 0xde, 0x76, 0x9b, 0xf9, 0x1b, 0xf9, 0xb5, 0xbc, 0x16,
+	0xea, 0x41, 0x2a, 0xd4, 0xba, 0xf6, 0x60, 0x80, 0xbb, 0x9e, 0x69, 0x5b, 0xed, 0xed, 0x66, 0x81,
+	0x8e, 0x8d, 0xf4, 0xa1, 0x16, 0x94, 0x4d, 0xb7, 0x3d, 0x1c, 0xd9, 0x8e, 0xd7, 0x2c, 0xde, 0x50,
+	0xd6, 0xca, 0x9a, 0x68, 0xab, 0xff, 0xa9, 0xc0, 0x22, 0x5f, 0xb6, 0x3b, 0xb2, 0x2d, 0x17, 0xa3,
+	0x77, 0xa1, 0xe4, 0x7a, 0xba, 0x37, 0x76, 0xf9, 0xca, 0xaf, 0x48, 0x57, 0xbe, 0x47, 0x41, 0x34,
+	0x0e, 0x2a, 0x5d, 0x7a, 0x7c, 0x69, 0x79, 0xc9, 0xd2, 0xa2, 0xdb, 0x2b, 0x24, 0xb6, 0xb7, 0x06,
+	0x4b, 0x3d, 0xb2, 0xba, 0xbd, 0x00, 0xa8, 0x48, 0x81, 0xe2, 0xdd, 0x04, 0x93, 0x67, 0x0e, 0xf1,
+	0x77, 0x7a, 0x7b, 0x58, 0x1f, 0x34, 0x4b, 0x74, 0xae, 0x50, 0x8f, 0xfa, 0x2f, 0x0a, 0x34, 0x04,
+	0xb8, 0xcf, 0xa3, 0x15, 0x28, 0x76, 0xed, 0xb1, 0xe5, 0xd1, 0xad, 0x2e, 0x6a, 0xac, 0x81, 0x5e,
+	0x81, 0x5a, 0xf7, 0x50, 0xb7, 0x2c, 0x3c, 0xe8, 0x58, 0xfa, 0x10, 0xd3, 0x4d, 0x55, 0xb4, 0x2a,
+	0xef, 0x7b, 0xa4, 0x0f, 0x71, 0xa6, 0xbd, 0xdd, 0x80, 0xea, 0x48, 0x77, 0x3c, 0x33, 0xc2, 0x99,
+	0x70, 0x57, 0x1a, 0x63, 0xc8, 0x0c, 0x26, 0xfd, 0xb5, 0xaf, 0xbb, 0x47, 0xed, 0x6d, 0xbe, 0xa3,
+	0x48, 0x9f, 0xfa, 0x17, 0x0a, 0xac, 0xde, 0x73, 0x5d, 0xb3, 0x6f, 0x25, 0x76, 0xb6, 0x0a, 0x25,
+	0xcb, 0x36, 0x70, 0x7b, 0x9b, 0x6e, 0x2d, 0xaf, 0xf1, 0x16, 0xba, 0x02, 0x95, 0x11, 0xc6, 0x4e,
+	0xc7, 0xb1, 0x07, 0xfe, 0xc6, 0xca, 0xa4, 0x43, 0xb3, 0x07, 0x18, 0x7d, 0x17, 0x96, 0xdd, 0x18,
+	0x22, 0x26, 0x73, 0xd5, 0x8d, 0x57, 0xd7, 0x13, 0x3a, 0xb5, 0x1e, 0x9f, 0x54, 0x4b, 0x8e, 0x56,
+	0xbf, 0xcc, 0xc1, 0x45, 0x01, 0xc7, 0xd6, 0x4a, 0x7e, 0x13, 0xca, 0xbb, 0xb8, 0x2f, 0x96, 0xc7,
+	0x1a, 0x59, 0x28, 0x2f, 0x58, 0x96, 0x0f, 0xb3, 0x2c, 0x8b, 0x1a, 0xc4, 0xf8, 0x51, 0x4c, 0xf2,
+	0xe3, 0x3a, 0x54, 0xf1, 0xe9, 0xc8, 0x74, 0x70, 0x87, 0x08, 0x0e, 0x25, 0x79, 0x41, 0x03, 0xd6,
+	0xb5, 0x6f, 0x0e, 0xc3, 0xba, 0xb1, 0x90, 0x59, 0x37, 0xd4, 0xbf, 0x54, 0xe0, 0x52, 0x82, 0x4b,
+	0x5c, 0xd9, 0x34, 0x68, 0xd0, 0x9d, 0x07, 0x94, 0x21, 0x6a, 0x47, 0x08, 0xfe, 0x7a, 0x1a, 0xc1,
+	0x03, 0x70, 0x2d, 0x31, 0x3e, 0xb4, 0xc8, 0x5c, 0xf6, 0x45, 0x1e, 0xc1, 0xa5, 0x07, 0xd8, 0xe3,
+	0x13, 0x90, 0x6f, 0xd8, 0x9d, 0xdd, 0x90, 0x45, 0xb5, 0x3a, 0x17, 0xd7, 0x6a, 0xf5, 0xaf, 0x72,
+	0xd0, 0x08, 0x4f, 0xd5, 0xb6, 0x7a, 0x36, 0xba, 0x0a, 0x15, 0x01, 0xc2, 0xa5, 0x22, 0xe8, 0x40,
+	0xbf, 0x00, 0x45, 0xb2, 0x52, 0x26, 0x12, 0xf5, 0x8d, 0x57, 0xe4, 0x7b, 0x0a, 0xe1, 0xd4, 0x18,
+	0x3c, 0xda, 0x86, 0xba, 0xeb, 0xe9, 0x8e, 0xd7, 0x19, 0xd9, 0x2e, 0xe5, 0x33, 0x15, 0x9c, 0xea,
+	0xc6, 0xcb, 0x51, 0x0c, 0xc4, 0xc8, 0xef, 0xb8, 0xfd, 0x5d, 0x0e, 0xa4, 0x2d, 0xd2, 0x41, 0x7e,
+	0x13, 0x7d, 0x0b, 0x6a, 0xd8, 0x32, 0x02, 0x1c, 0x85, 0x2c, 0x38, 0xaa, 0xd8, 0x32, 0x04, 0x86,
+	0x80, 0x2b, 0xc5, 0xec, 0x5c, 0xf9, 0x7d, 0x05, 0x9a, 0x49, 0xb6, 0xcc, 0x63, 0xa8, 0x3f, 0x60,
+	0x83, 0x30, 0x63, 0x4b, 0xaa, 0x5e, 0x0b, 0xd6, 0x68, 0x7c, 0x88, 0xfa, 0xa7, 0x0a, 0xbc, 0x14,
+	0x2c, 0x87, 0x7e, 0x7a, 0x5e, 0x32, 0x82, 0x6e, 0x41, 0xc3, 0xb4, 0xba, 0x83, 0xb1, 0x81, 0x1f,
+	0x5b, 0x9f, 0x60, 0x7d, 0xe0, 0x1d, 0x9e, 0x51, 0xce, 0x95, 0xb5, 0x44, 0xbf, 0xfa, 0xaf, 0x39,
+	0x58, 0x8d, 0xaf, 0x6b, 0x1e, 0x22, 0xfd, 0x1c, 0x14, 0x4d, 0xab, 0x67, 0xfb, 0x34, 0xba, 0x96,
+	0xa2, 0x8a, 0x64, 0x2e, 0x06, 0x8c, 0x6c, 0x40, 0xbe, 0xf1, 0xea, 0x1e, 0xe2, 0xee, 0xd1, 0xc8,
+	0x36, 0xa9, 0x99, 0x22, 0x28, 0xbe, 0x25, 0x41, 0x21, 0x5f, 0xf1, 0xfa, 0x16, 0xc3, 0xb1, 0x25,
+	0x50, 0x7c, 0x6c, 0x79, 0xce, 0x99, 0xb6, 0xdc, 0x8d, 0xf7, 0xb7, 0xba, 0xb0, 0x2a, 0x07, 0x46,
+	0x0d, 0xc8, 0x1f, 0xe1, 0x33, 0xba, 0xe5, 0x8a, 0x46, 0x7e, 0xa2, 0x77, 0xa1, 0x78, 0xac, 0x0f,
+	0xc6, 0xb8, 0x99, 0xcb, 0x22, 0xb9, 0x0c, 0xf6, 0xfd, 0xdc, 0x7b, 0x8a, 0x3a, 0x84, 0x2b, 0x0f,
+	0xb0, 0xd7, 0xb6, 0x5c, 0xec, 0x78, 0x9b, 0xa6, 0x35, 0xb0, 0xfb, 0xbb, 0xba, 0x77, 0x38, 0x87,
+	0x71, 0x88, 0xe8, 0x79, 0x2e, 0xa6, 0xe7, 0xea, 0xf7, 0x15, 0xb8, 0x2a, 0x9f, 0x8f, 0x33, 0xb4,
+	0x05, 0xe5, 0x9e, 0x89, 0x07, 0x46, 0x7b, 0x9b, 0x59, 0xca, 0xbc, 0x26, 0xda, 0xc4, 0x48, 0x8c,
+	0x08, 0x30, 0xe7, 0x5b, 0xcc, 0x48, 0x08, 0x9f, 0x6f, 0xcf, 0x73, 0x4c, 0xab, 0xff, 0xd0, 0x74,
+	0x3d, 0x8d, 0xc1, 0x87, 0xa4, 0x24, 0x9f, 0x5d, 0x39, 0x7f, 0x57, 0x81, 0x6b, 0x0f, 0xb0, 0xb7,
+	0x25, 0xce, 0x18, 0xf2, 0xdd, 0x74, 0x3d, 0xb3, 0xeb, 0x3e, 0x5b, 0x1f, 0x30, 0x83, 0xb3, 0xa1,
+	0xfe, 0xa1, 0x02, 0xd7, 0x27, 0x2e, 0x86, 0x93, 0x8e, 0xdb, 0x50, 0xff, 0x84, 0x91, 0xdb, 0xd0,
+	0x5f, 0xc4, 0x67, 0x4f, 0x08, 0xf3, 0x77, 0x75, 0xd3, 0x61, 0x36, 0x74, 0xc6, 0x13, 0xe5, 0x07,
+	0x0a, 0xbc, 0xfc, 0x00, 0x7b, 0xbb, 0xfe, 0xf9, 0xfa, 0x02, 0xa9, 0x43, 0x60, 0x42, 0xe7, 0xbc,
+	0xef, 0x68, 0x46, 0xfa, 0xd4, 0x3f, 0x60, 0xec, 0x94, 0xae, 0xf7, 0x85, 0x10, 0xf0, 0x1a, 0x5c,
+	0x8d, 0x9a, 0x08, 0xae, 0xec, 0x9c, 0x7c, 0xea, 0x6f, 0x16, 0xa1, 0xf6, 0x84, 0x5b, 0x05, 0xf2,
+	0x39, 0x41, 0x09, 0x45, 0xee, 0x04, 0x85, 0xbc, 0x29, 0x99, 0x83, 0xb5, 0x09, 0x8b, 0x2e, 0xc6,
+	0x47, 0xe7, 0x3c, 0x2f, 0x6b, 0x64, 0x8c, 0xdf, 0x42, 0x0f, 0x61, 0x79, 0x6c, 0x51, 0x0f, 0x1d,
+	0x1b, 0x7c, 0x03, 0x8c, 0xe8, 0xd3, 0x8d, 0x69, 0x72, 0x20, 0xfa, 0x04, 0x96, 0x62, 0x5d, 0xcd,
+	0x62, 0x26, 0x5c, 0xf1, 0x61, 0xa8, 0x0d, 0x0d, 0xc3, 0xb1, 0x47, 0x23, 0x6c, 0x74, 0x5c, 0x1f,
+	0x55, 0x29, 0x1b, 0x2a, 0x3e, 0x4e, 0xa0, 0xba, 0x0b, 0x17, 0xe3, 0x2b, 0x6d, 0x1b, 0xc4, 0x2f,
+	0x24, 0x92, 0x25, 0xfb, 0x84, 0xde, 0x86, 0xe5, 0x24, 0x7c, 0x99, 0xc2, 0x27, 0x3f, 0xa0, 0xdb,
+	0x80, 0x62, 0x4b, 0x25, 0xe0, 0x15, 0x06, 0x1e, 0x5d, 0x0c, 0x07, 0xa7, 0xc1, 0x69, 0x14, 0x1c,
+	0x18, 0x38, 0xff, 0x12, 0x02, 0x6f, 0x43, 0x83, 0x77, 0x06, 0x84, 0xa8, 0x66, 0x23, 0x44, 0x14,
+	0x99, 0xab, 0xfe, 0x8e, 0x02, 0xab, 0x9f, 0xea, 0x5e, 0xf7, 0x70, 0x7b, 0xc8, 0x05, 0x74, 0x0e,
+	0x05, 0xff, 0x10, 0x2a, 0xc7, 0x5c, 0x18, 0x7d, 0x2b, 0x7e, 0x5d, 0xb2, 0xa0, 0xb0, 0xd8, 0x6b,
+	0xc1, 0x08, 0x12, 0x10, 0xad, 0xdc, 0x0f, 0x05, 0x86, 0x2f, 0xc0, 0xd4, 0x4c, 0x89, 0x68, 0xd5,
+	0x53, 0x00, 0xbe, 0xb8, 0x1d, 0xb7, 0x3f, 0xc3, 0xba, 0xde, 0x83, 0x05, 0x8e, 0x8d, 0xdb, 0x92,
+	0x69, 0x0c, 0xf3, 0xc1, 0xd5, 0xff, 0x2e, 0x41, 0x35, 0xf4, 0x01, 0xd5, 0x21, 0x27, 0x8c, 0x44,
+	0x4e, 0xb2, 0xbb, 0xdc, 0xf4, 0x18, 0x2a, 0x9f, 0x8c, 0xa1, 0x5e, 0x83, 0xba, 0x49, 0x0f, 0xef,
+	0x0e, 0xe7, 0x0a, 0xf5, 0x95, 0x2b, 0xda, 0x22, 0xeb, 0xe5, 0x22, 0x82, 0xae, 0x41, 0xd5, 0x1a,
+	0x0f, 0x3b, 0x76, 0xaf, 0xe3, 0xd8, 0x27, 0x2e, 0x0f, 0xc6, 0x2a, 0xd6, 0x78, 0xf8, 0x9d, 0x9e,
+	0x66, 0x9f, 0xb8, 0x81, 0xbf, 0x5f, 0x3a, 0xa7, 0xbf, 0x7f, 0x0d, 0xaa, 0x43, 0xfd, 0x94, 0x60,
+	0xed, 0x58, 0xe3, 0x21, 0x8d, 0xd3, 0xf2, 0x5a, 0x65, 0xa8, 0x9f, 0x6a, 0xf6, 0xc9, 0xa3, 0xf1,
+	0x10, 0xad, 0x41, 0x63, 0xa0, 0xbb, 0x5e, 0x27, 0x1c, 0xe8, 0x95, 0x69, 0xa0, 0x57, 0x27, 0xfd,
+	0x1f, 0x07, 0xc1, 0x5e, 0x32, 0x72, 0xa8, 0xcc, 0x16, 0x39, 0x18, 0xc3, 0x41, 0x80, 0x03, 0x32,
+	0x45, 0x0e, 0xc6, 0x70, 0x20, 0x30, 0xbc, 0x07, 0x0b, 0x07, 0xd4, 0x11, 0x4a, 0x53, 0xd1, 0xfb,
+	0xc4, 0x07, 0x62, 0xfe, 0x92, 0xe6, 0x83, 0xa3, 0x6f, 0x40, 0x85, 0x9e, 0x3f, 0x74, 0x6c, 0x2d,
+	0xd3, 0xd8, 0x60, 0x00, 0x19, 0x6d, 0xe0, 0x81, 0xa7, 0xd3, 0xd1, 0x8b, 0xd9, 0x46, 0x8b, 0x01,
+	0xc4, 0x3e, 0x76, 0x1d, 0xac, 0x7b, 0xd8, 0xd8, 0x3c, 0xdb, 0xb2, 0x87, 0x23, 0x9d, 0x8a, 0x50,
+	0xb3, 0x4e, 0x5d, 0x78, 0xd9, 0x27, 0xf4, 0x3a, 0xd4, 0xbb, 0xa2, 0x75, 0xdf, 0xb1, 0x87, 0xcd,
+	0x25, 0xaa, 0x3d, 0xb1, 0x5e, 0xf4, 0x32, 0x80, 0x6f, 0x19, 0x75, 0xaf, 0xd9, 0xa0, 0xbc, 0xab,
+	0xf0, 0x9e, 0x7b, 0x34, 0x7b, 0x63, 0xba, 0x1d, 0x96, 0x27, 0x31, 0xad, 0x7e, 0x73, 0x99, 0xce,
+	0x58, 0xf5, 0x13, 0x2b, 0xa6, 0xd5, 0x47, 0x97, 0x60, 0xc1, 0x74, 0x3b, 0x3d, 0xfd, 0x08, 0x37,
+	0x11, 0xfd, 0x5a, 0x32, 0xdd, 0xfb, 0xfa, 0x11, 0x46, 0xdf, 0x86, 0xa5, 0xee, 0x60, 0xec, 0x7a,
+	0x98, 0x38, 0x88, 0x1d, 0xe2, 0xd6, 0x37, 0x2f, 0x52, 0x7e, 0xbd, 0x22, 0xd9, 0xf8, 0x96, 0x80,
+	0xa4, 0x7a, 0x56, 0xef, 0x46, 0xda, 0xea, 0x17, 0xb0, 0x12, 0xc8, 0x67, 0x48, 0x20, 0x92, 0x62,
+	0xa5, 0xcc, 0x20, 0x56, 0xe9, 0x5e, 0xf4, 0x4f, 0x0b, 0xb0, 0xba, 0xa7, 0x1f, 0xe3, 0xe7, 0xef,
+	0xb0, 0x67, 0xb2, 0x89, 0x0f, 0x61, 0x99, 0xfa, 0xe8, 0x1b, 0xa1, 0xf5, 0x34, 0x0b, 0x99, 0x24,
+	0x2a, 0x39, 0x10, 0x7d, 0x93, 0xb8, 0x30, 0xb8, 0x7b, 0xb4, 0x6b, 0x9b, 0x81, 0x2b, 0xf0, 0xb2,
+	0x8c, 0x41, 0x02, 0x4a, 0x0b, 0x8f, 0x40, 0xbb, 0xb0, 0x14, 0xe5, 0x80, 0xef, 0x04, 0xbc, 0x91,
+	0x1a, 0x0c, 0x07, 0xd4, 0xd7, 0xea, 0x11, 0x66, 0xb8, 0xa8, 0x09, 0x0b, 0xfc, 0x04, 0xa7, 0x06,
+	0xa7, 0xac, 0xf9, 0x4d, 0xb4, 0x0b, 0x17, 0xd9, 0x0e, 0xf6, 0xb8, 0x5e, 0xb1, 0xcd, 0x97, 0x33,
+	0x6d, 0x5e, 0x36, 0x34, 0xaa, 0x96, 0x95, 0xf3, 0xaa, 0x65, 0x13, 0x16, 0xb8, 0xaa, 0x50, 0x4b,
+	0x54, 0xd6, 0xfc, 0x26, 0x61, 0x73, 0xa0, 0x34, 0x55, 0xfa, 0x2d, 0xe8, 0x50, 0x7f, 0x4b, 0x01,
+	0x08, 0xe8, 0x39, 0x25, 0x59, 0xf3, 0x75, 0x28, 0x0b, 0xe1, 0xce, 0x14, 0x6f, 0x0a, 0xf0, 0xf8,
+	0xb9, 0x90, 0x8f, 0x9d, 0x0b, 0xea, 0x3f, 0x2b, 0x50, 0xdb, 0x26, 0xbb, 0x79, 0x68, 0x53, 0x35,
+	0x23, 0xe7, 0x8d, 0x83, 0xbb, 0xb6, 0x63, 0x74, 0xb0, 0xe5, 0x39, 0x26, 0x66, 0x81, 0x7e, 0x41,
+	0x5b, 0x64, 0xbd, 0x1f, 0xb3, 0x4e, 0x02, 0x46, 0x4c, 0xbd, 0xeb, 0xe9, 0xc3, 0x51, 0xa7, 0x47,
+	0x8c, 0x4b, 0x8e, 0x81, 0x89, 0x5e, 0x6a, 0x5b, 0x5e, 0x81, 0x5a, 0x00, 0xe6, 0xd9, 0x74, 0xfe,
+	0x82, 0x56, 0x15, 0x7d, 0xfb, 0x36, 0xba, 0x09, 0x75, 0x4a, 0xce, 0xce, 0xc0, 0xee, 0x77, 0x48,
+	0xf8, 0xc8, 0x0f, 0xb8, 0x9a, 0xc1, 0x97, 0x45, 0xd8, 0x14, 0x85, 0x72, 0xcd, 0x2f, 0x30, 0x3f,
+	0xe2, 0x04, 0xd4, 0x9e, 0xf9, 0x05, 0x56, 0x7f, 0x43, 0x81, 0x45, 0x7e, 0x22, 0xee, 0x89, 0x44,
+	0x3a, 0xcd, 0x7c, 0xb2, 0xd0, 0x9d, 0xfe, 0x46, 0xef, 0x47, 0x73, 0x5f, 0x37, 0xa5, 0xa2, 0x4e,
+	0x91, 0x50, 0x3f, 0x2c, 0x72, 0x1c, 0x66, 0x89, 0x1d, 0xbf, 0x24, 0x34, 0xd5, 0x3d, 0xfd, 0x11,
+	0x49, 0x11, 0x13, 0x9a, 0x36, 0x61, 0x41, 0x37, 0x0c, 0x07, 0xbb, 0x2e, 0x5f, 0x87, 0xdf, 0x24,
+	0x5f, 0x8e, 0xb1, 0xe3, 0xfa, 0x8c, 0xcd, 0x6b, 0x7e, 0x13, 0x7d, 0x03, 0xca, 0xc2, 0x71, 0x63,
+	0x39, 0x8f, 0x1b, 0x93, 0xd7, 0xc9, 0x23, 0x1d, 0x31, 0x42, 0xfd, 0x87, 0x1c, 0xd4, 0xb9, 0xa6,
+	0x6d, 0xf2, 0xc3, 0x2b, 0x5d, 0xc4, 0x36, 0xa1, 0xd6, 0x0b, 0x24, 0x3c, 0x2d, 0x53, 0x13, 0x56,
+	0x84, 0xc8, 0x98, 0x69, 0xb2, 0x16, 0x3d, 0x3e, 0x0b, 0x73, 0x1d, 0x9f, 0xc5, 0xf3, 0xea, 0x69,
+	0xd2, 0x8d, 0x2a, 0x49, 0xdc, 0x28, 0xf5, 0x97, 0xa1, 0x1a, 0x42, 0x40, 0xed, 0x10, 0x4b, 0x86,
+	0x70, 0x8a, 0xf9, 0x4d, 0xf4, 0x6e, 0xe0, 0x44, 0x30, 0x52, 0x5d, 0x96, 0xac, 0x25, 0xe6, 0x3f,
+	0xa8, 0x3f, 0x56, 0xa0, 0xc4, 0x31, 0x93, 0xd4, 0x38, 0x53, 0x25, 0xea, 0x56, 0x31, 0xec, 0xc0,
+	0xbb, 0x88, 0x5f, 0xf5, 0xec, 0x14, 0xec, 0x32, 0x94, 0x63, 0xaa, 0xb5, 0xc0, 0x8d, 0x9f, 0xff,
+	0x29, 0xa4, 0x4f, 0x0b, 0x03, 0xa6, 0x4a, 0xa4, 0x2e, 0x30, 0xb0, 0xfb, 0xa2, 0x50, 0xc2, 0x1a,
+	0xea, 0x4f, 0x14, 0x9a, 0xd7, 0xd6, 0x70, 0xd7, 0x3e, 0xc6, 0xce, 0xd9, 0xfc, 0xa9, 0xc1, 0x0f,
+	0x42, 0x62, 0x9e, 0x31, 0x3e, 0x11, 0x03, 0xd0, 0x07, 0x01, 0x13, 0xf2, 0xb2, 0x0c, 0x42, 0xf8,
+	0xc0, 0xe1, 0x42, 0x1a, 0x30, 0xe3, 0x8f, 0x14, 0x58, 0x4d, 0x6c, 0x65, 0xd6, 0x33, 0xfd, 0x99,
+	0xf8, 0xfa, 0xea, 0x3f, 0x29, 0x70, 0x79, 0x02, 0x75, 0x9f, 0x6c, 0xbc, 0x00, 0xfa, 0xbe, 0x0f,
+	0x65, 0x11, 0xcd, 0xe6, 0x33, 0x45, 0xb3, 0x02, 0x5e, 0xfd, 0x13, 0x96, 0x6a, 0x97, 0x90, 0xf7,
+	0xc9, 0xc6, 0x73, 0x22, 0x70, 0x3c, 0x2b, 0x95, 0x97, 0x64, 0xa5, 0x7e, 0xaa, 0x40, 0x2b, 0xc8,
+	0x02, 0xb9, 0x9b, 0x67, 0xf3, 0xd6, 0x66, 0x9e, 0x4d, 0x94, 0xf7, 0x75, 0x51, 0x46, 0x20, 0x76,
+	0x31, 0x53, 0x7c, 0xc6, 0x07, 0xa8, 0x16, 0x4d, 0x28, 0x27, 0x37, 0x34, 0x8f, 0x56, 0xb6, 0x42,
+	0x8c, 0x67, 0xa5, 0x84, 0x80, 0xb1, 0x3f, 0x66, 0x42, 0x7a, 0x3f, 0x9a, 0x0a, 0x7a, 0xd1, 0x04,
+	0x0c, 0x97, 0x37, 0x0e, 0x79, 0x79, 0xa3, 0x10, 0x2b, 0x6f, 0xf0, 0x7e, 0x75, 0x08, 0x2d, 0xd9,
+	0x06, 0x9e, 0x17, 0xc1, 0x7e, 0x5b, 0x81, 0x26, 0x9f, 0x85, 0xce, 0x49, 0x42, 0xb4, 0x01, 0xf6,
+	0xb0, 0xf1, 0x55, 0x27, 0x2c, 0xfe, 0x2c, 0x07, 0x8d, 0xb0, 0x63, 0x43, 0xbe, 0xa2, 0xaf, 0x41,
+	0x91, 0xe6, 0x7b, 0xf8, 0x0a, 0xa6, 0x5a, 0x07, 0x06, 0x4d, 0x4e, 0x46, 0xea, 0xb3, 0xef, 0xbb,
+	0xbe, 0xe3, 0xc2, 0x9b, 0x81, 0x77, 0x95, 0x3f, 0xbf, 0x77, 0x75, 0x15, 0x2a, 0xe4, 0xe4, 0xb2,
+	0xc7, 0x04, 0x2f, 0xab, 0x39, 0x07, 0x1d, 0xe8, 0x43, 0x28, 0xb1, 0x9b, 0x24, 0xbc, 0xe4, 0xf7,
+	0x5a, 0x14, 0x35, 0xfb, 0xb6, 0x1e, 0x4a, 0xd9, 0xd3, 0x0e, 0x8d, 0x0f, 0x22, 0x3c, 0x1a, 0x39,
+	0x76, 0x9f, 0xba, 0x61, 0xe4, 0x50, 0x2b, 0x6a, 0xa2, 0xad, 0x7e, 0x1b, 0x56, 0x83, 0xc8, 0x99,
+	0x2d, 0x69, 0x56, 0x81, 0x56, 0xff, 0x8e, 0x94, 0xe8, 0xcf, 0xac, 0x6e, 0x5c, 0x35, 0x56, 0xa1,
+	0x34, 0x1a, 0xe8, 0x41, 0x22, 0x99, 0xb7, 0x68, 0x91, 0x9e, 0xcd, 0x8d, 0x0d, 0x72, 0x84, 0x33,
+	0x7a, 0x56, 0x45, 0xdf, 0xbe, 0x3d, 0xd5, 0xb3, 0x7a, 0x4d, 0x84, 0xfa, 0xd8, 0x60, 0xce, 0x02,
+	0x4b, 0x94, 0x2d, 0x8a, 0x5e, 0xea, 0x2c, 0x7c, 0x08, 0x40, 0xfd, 0xa9, 0xce, 0x79, 0x7c, 0x28,
+	0x3a, 0xe2, 0x21, 0xf1, 0xa1, 0xf6, 0xe1, 0x62, 0x28, 0x9a, 0x8f, 0x25, 0x7c, 0x65, 0x85, 0xcf,
+	0x80, 0xa4, 0x1a, 0x76, 0xc7, 0x03, 0x4f, 0x43, 0xc1, 0x78, 0x91, 0xef, 0xfc, 0x51, 0x0e, 0x9a,
+	0x21, 0xda, 0x7f, 0xd5, 0x4e, 0xeb, 0x84, 0x80, 0x32, 0xff, 0x8c, 0x02, 0xca, 0xc2, 0xfc, 0x8e,
+	0x6a, 0x51, 0xe6, 0xa8, 0xfe, 0x5b, 0x1e, 0xea, 0x01, 0xd5, 0x76, 0x07, 0xba, 0x35, 0x51, 0xbe,
+	0xf6, 0xa0, 0xee, 0x46, 0xa8, 0xca, 0xe9, 0xf4, 0x56, 0x2a, 0xc7, 0x62, 0x6e, 0x53, 0x0c, 0x05,
+	0x49, 0x1a, 0xb1, 0x98, 0x9f, 0x26, 0xfc, 0x98, 0xd7, 0x59, 0x61, 0x26, 0x80, 0xe4, 0xfa, 0xde,
+	0x06, 0xc4, 0xf5, 0xb6, 0x63, 0x5a, 0x1d, 0x17, 0x77, 0x6d, 0xcb, 0x60, 0x1a, 0x5d, 0xd4, 0x1a,
+	0xfc, 0x4b, 0xdb, 0xda, 0x63, 0xfd, 0xe8, 0x6b, 0x50, 0xf0, 0xce, 0x46, 0xcc, 0x05, 0xad, 0x6f,
+	0xbc, 0x92, 0xba, 0xae, 0xfd, 0xb3, 0x11, 0xd6, 0x28, 0xb8, 0x7f, 0x45, 0xc9, 0x73, 0xf4, 0x63,
+	0xee, 0xcf, 0x17, 0xb4, 0x50, 0x0f, 0xb1, 0x51, 0x3e, 0x0d, 0x17, 0x98, 0xdf, 0xcb, 0x9b, 0x4c,
+	0x5f, 0x7c, 0x33, 0xd1, 0xf1, 0xbc, 0x01, 0x4d, 0x59, 0x52, 0x7d, 0xf1, 0x7b, 0xf7, 0xbd, 0x01,
+	0xd9, 0xa4, 0x67, 0x7b, 0xfa, 0x80, 0x69, 0x5d, 0x85, 0xdb, 0x23, 0xd2, 0x43, 0xb5, 0xee, 0x36,
+	0x84, 0xe4, 0xb9, 0xe3, 0x07, 0x0a, 0x40, 0xc1, 0x96, 0x83, 0x2f, 0xf7, 0xd9, 0x07, 0x92, 0x29,
+	0x25, 0x99, 0x54, 0x4e, 0x48, 0x86, 0xb3, 0x4a, 0x81, 0xeb, 0x43, 0xfd, 0x94, 0xd3, 0x9b, 0x06,
+	0xe5, 0xff, 0x9b, 0x87, 0x46, 0x5c, 0x77, 0x26, 0xb2, 0x37, 0x3d, 0x9d, 0x34, 0xcd, 0x72, 0x7c,
+	0x13, 0xaa, 0x5c, 0xdc, 0xce, 0x21, 0xae, 0xc0, 0x86, 0x3c, 0x4c, 0xd1, 0x9f, 0xe2, 0x33, 0xd2,
+	0x9f, 0xd2, 0x0c, 0x09, 0x99, 0x09, 0x4c, 0x97, 0x24, 0x23, 0xcb, 0x33, 0x26, 0x23, 0x27, 0x99,
+	0xc2, 0xca, 0x7c, 0xa6, 0xf0, 0xfb, 0x0a, 0xbc, 0x94, 0x38, 0x86, 0x52, 0x99, 0x9f, 0x9e, 0xca,
+	0xe0, 0xc7, 0x53, 0x1c, 0x25, 0x3f, 0x6c, 0x3f, 0x80, 0x92, 0x43, 0xb1, 0xf3, 0x8a, 0x64, 0xa6,
+	0x65, 0xf3, 0x21, 0xea, 0x1f, 0x2b, 0x70, 0x29, 0xb9, 0xd4, 0x39, 0x3c, 0xa8, 0x4d, 0x58, 0x60,
+	0xa8, 0x7d, 0xf3, 0xb4, 0x96, 0x6e, 0x9e, 0x02, 0xe2, 0x68, 0xfe, 0x40, 0x75, 0x0f, 0x56, 0x7d,
+	0x47, 0x2b, 0x10, 0x8e, 0x1d, 0xec, 0xe9, 0x29, 0x81, 0xfc, 0x75, 0xa8, 0xb2, 0x88, 0x90, 0x05,
+	0xc8, 0xac, 0x80, 0x0b, 0x07, 0x22, 0x3f, 0xaa, 0xfe, 0x97, 0x02, 0x2b, 0xd4, 0x53, 0x89, 0x57,
+	0xe3, 0xb2, 0x94, 0x87, 0x55, 0xa8, 0x85, 0x6a, 0xc1, 0x6c, 0x6b, 0x15, 0x2d, 0xd2, 0x87, 0xda,
+	0xc9, 0xf4, 0xa9, 0x34, 0xe1, 0x13, 0xd4, 0xc3, 0x49, 0x72, 0x89, 0x96, 0xc3, 0xe3, 0x79, 0xd3,
+	0xc0, 0x43, 0x2a, 0xcc, 0xe0, 0x21, 0xa9, 0x0f, 0xe1, 0xa5, 0xd8, 0x4e, 0xe7, 0xe0, 0xa8, 0xfa,
+	0xd7, 0x0a, 0x61, 0x47, 0xe4, 0xb2, 0xd5, 0xec, 0x51, 0xc2, 0xcb, 0xa2, 0x0c, 0xd8, 0x31, 0x8d,
+	0xb8, 0x99, 0x33, 0xd0, 0x47, 0x50, 0xb1, 0xf0, 0x49, 0x27, 0xec, 0x78, 0x66, 0x08, 0xa1, 0xca,
+	0x16, 0x3e, 0xa1, 0xbf, 0xd4, 0x47, 0x70, 0x29, 0xb1, 0xd4, 0x79, 0xf6, 0xfe, 0x8f, 0x0a, 0x5c,
+	0xde, 0x76, 0xec, 0xd1, 0x13, 0xd3, 0xf1, 0xc6, 0xfa, 0x20, 0x7a, 0xd3, 0x60, 0x86, 0xed, 0x67,
+	0xb8, 0xc8, 0xf9, 0x49, 0x22, 0x58, 0x7f, 0x5b, 0xa2, 0x41, 0xc9, 0x45, 0xf9, 0x07, 0x4f, 0x10,
+	0xb0, 0xfc, 0x47, 0x1e, 0x2e, 0x4f, 0x84, 0x9b, 0xe2, 0x92, 0x65, 0x89, 0xe6, 0xa4, 0xe5, 0x8b,
+	0xfc, 0xac, 0xe5, 0x8b, 0x09, 0x07, 0x50, 0xe1, 0x19, 0x1d, 0x40, 0xe7, 0xce, 0x34, 0x6e, 0x41,
+	0xb4, 0xb4, 0xd4, 0x2c, 0x65, 0xc9, 0xd8, 0x47, 0xc7, 0x10, 0x4f, 0x3d, 0xa8, 0xb0, 0x34, 0x17,
+	0xb2, 0x60, 0x08, 0x0d, 0x20, 0x3c, 0x12, 0x47, 0x3c, 0x77, 0x6d, 0x82, 0x0e, 0xf5, 0xbb, 0xd0,
+	0x92, 0xc9, 0xe6, 0x3c, 0xf2, 0xfe, 0xa3, 0x1c, 0x40, 0x5b, 0x5c, 0xa5, 0x9e, 0xed, 0x04, 0x78,
+	0x15, 0x42, 0xee, 0x57, 0xa0, 0xe5, 0x61, 0xd9, 0x31, 0x88, 0x22, 0x88, 0xb0, 0x9f, 0xc0, 0x24,
+	0x52, 0x01, 0x06, 0xc5, 0x13, 0xd2, 0x15, 0x26, 0x0a, 0x71, 0xa3, 0x7b, 0x05, 0x2a, 0xa4, 0xa4,
+	0x4d, 0x94, 0xcb, 0xf0, 0xef, 0x8a, 0x3b, 0xf6, 0x09, 0x51, 0x39, 0x83, 0xd4, 0x33, 0x3d, 0xdd,
+	0x3d, 0x22, 0xf8, 0x59, 0xf6, 0xb3, 0x44, 0x9a, 0x6d, 0x83, 0x24, 0x45, 0x7b, 0xe6, 0x00, 0xb3,
+	0x6b, 0x29, 0x15, 0x8d, 0x35, 0x48, 0x6d, 0x9d, 0x5d, 0x6f, 0x2c, 0x67, 0xbe, 0xc6, 0x44, 0xe1,
+	0x49, 0x36, 0x75, 0x29, 0xa0, 0x1a, 0x35, 0x3b, 0xc4, 0x92, 0x51, 0x2b, 0xb6, 0x65, 0x1b, 0xcc,
+	0x40, 0xd4, 0x27, 0x9c, 0x03, 0x6c, 0x20, 0xb3, 0x55, 0xc1, 0x90, 0xb4, 0x4c, 0x04, 0xd9, 0x17,
+	0xd9, 0xb4, 0x69, 0xf8, 0xb9, 0xb1, 0x92, 0x63, 0x9f, 0xb4, 0x0d, 0x41, 0x0d, 0x76, 0x11, 0x9c,
+	0xc5, 0xdd, 0x84, 0x1a, 0x5b, 0xa4, 0x4d, 0xe8, 0x89, 0x1d, 0xc7, 0x76, 0x3a, 0x43, 0xec, 0xba,
+	0x7a, 0x1f, 0xf3, 0x80, 0xa4, 0x46, 0x3b, 0x77, 0x58, 0x9f, 0xfa, 0xc3, 0x02, 0xd4, 0x83, 0xad,
+	0xf8, 0xf7, 0x21, 0x4c, 0xc3, 0xbf, 0x0f, 0x61, 0x12, 0xd6, 0x81, 0xc3, 0x0c, 0xa0, 0x60, 0xee,
+	0x66, 0xae, 0xa9, 0x68, 0x15, 0xde, 0xdb, 0x36, 0xc8, 0x61, 0x4c, 0x54, 0xcb, 0xb2, 0x0d, 0x1c,
+	0x30, 0x17, 0xfc, 0x2e, 0xce, 0xdb, 0x88, 0x8c, 0x14, 0x32, 0xc8, 0x48, 0x31, 0x83, 0x8c, 0x94,
+	0x24, 0x32, 0xb2, 0x0a, 0xa5, 0x83, 0x71, 0xf7, 0x08, 0x7b, 0xdc, 0x93, 0xe4, 0xad, 0xa8, 0xec,
+	0x94, 0x63, 0xb2, 0x23, 0x44, 0xa4, 0x12, 0x16, 0x91, 0x2b, 0x50, 0x61, 0x25, 0xfa, 0x8e, 0xe7,
+	0xf2, 0x08, 0xa1, 0xcc, 0x3a, 0xf6, 0x5d, 0xf4, 0x9e, 0xef, 0xc4, 0x55, 0xa9, 0xb2, 0xa8, 0x12,
+	0x5b, 0x13, 0x93, 0x12, 0xdf, 0x85, 0x7b, 0x03, 0x96, 0x42, 0xe4, 0xa0, 0x27, 0x43, 0x8d, 0x2e,
+	0x35, 0x14, 0xde, 0xd0, 0xc3, 0xe1, 0x35, 0xa8, 0x07, 0x24, 0xa1, 0x70, 0x8b, 0x2c, 0xaa, 0x14,
+	0xbd, 0x14, 0x4c, 0x48, 0x72, 0xfd, 0x7c, 0x92, 0x4c, 0x0a, 0x09, 0x3c, 0x1c, 0x74, 0x9b, 0x4b,
+	0xd1, 0x7c, 0xd0, 0x4b, 0x50, 0xfa, 0xdc, 0x3e, 0x20, 0x7c, 0x68, 0xd0, 0x0f, 0xc5, 0xcf, 0xed,
+	0x83, 0xb6, 0xa1, 0x7e, 0x0e, 0x28, 0xd8, 0xd4, 0x7c, 0xae, 0x63, 0x4c, 0x6a, 0x72, 0x71, 0xa9,
+	0x51, 0xff, 0x46, 0x81, 0xe5, 0xf0, 0x64, 0xb3, 0x9e, 0xc2, 0x1f, 0x41, 0x95, 0x55, 0x70, 0x3b,
+	0xc4, 0x1e, 0xc8, 0x4b, 0xb1, 0x31, 0x76, 0x69, 0x10, 0xbc, 0x30, 0x21, 0x52, 0x77, 0x62, 0x3b,
+	0x47, 0x24, 0x64, 0x20, 0x2b, 0x13, 0x19, 0x6a, 0xde, 0x49, 0xea, 0x85, 0xae, 0xfa, 0x7b, 0x0a,
+	0x5c, 0x7b, 0x3c, 0x32, 0x74, 0x0f, 0x87, 0xdc, 0x91, 0x79, 0x2f, 0x7a, 0x8a, 0x9b, 0x96, 0xb9,
+	0x14, 0xc6, 0x86, 0xe6, 0x73, 0x99, 0x84, 0x51, 0x27, 0x8e, 0xaf, 0x26, 0x71, 0x35, 0x7a, 0xf6,
+	0xd5, 0xb4, 0xa0, 0x7c, 0xcc, 0xd1, 0xf9, 0x6f, 0x66, 0xfc, 0x76, 0xa4, 0xd6, 0x9d, 0x3f, 0x57,
+	0xad, 0x5b, 0xdd, 0x81, 0xcb, 0x1a, 0x76, 0xb1, 0x65, 0x44, 0x36, 0x32, 0x73, 0x1e, 0x6f, 0x04,
+	0x2d, 0x19, 0xba, 0x79, 0x24, 0x95, 0x79, 0xb1, 0x1d, 0x07, 0xbb, 0x2c, 0x7d, 0x9b, 0xe7, 0xce,
+	0x13, 0x9d, 0xc7, 0x53, 0xff, 0x36, 0x07, 0x97, 0xee, 0x19, 0x06, 0xb7, 0xec, 0xdc, 0x2f, 0x7b,
+	0x5e, 0x2e, 0x73, 0xdc, 0xa5, 0xcc, 0x27, 0x5d, 0xca, 0x67, 0x65, 0x6d, 0xf9, 0xb9, 0x43, 0x0a,
+	0x9d, 0xfc, 0x3c, 0x75, 0xd8, 0xe5, 0xb1, 0x0f, 0x78, 0x45, 0x98, 0x24, 0x1f, 0x9a, 0x0b, 0x99,
+	0x3c, 0xad, 0xb2, 0x9f, 0x8f, 0x54, 0x47, 0xd0, 0x4c, 0x12, 0x6b, 0x4e, 0x3b, 0xe2, 0x53, 0x64,
+	0x64, 0xb3, 0xbc, 0x76, 0x4d, 0x03, 0xde, 0xb5, 0x6b, 0xbb, 0xea, 0xff, 0xe4, 0xa0, 0x49, 0xae,
+	0x01, 0xfd, 0xec, 0x30, 0xe8, 0x33, 0x58, 0x71, 0xf5, 0x63, 0xdc, 0x09, 0x85, 0xc8, 0x1d, 0x07,
+	0x3f, 0xe5, 0x1e, 0xe9, 0x9b, 0xb2, 0xca, 0x83, 0xf4, 0x9a, 0x94, 0xb6, 0xec, 0x46, 0xfa, 0x35,
+	0xfc, 0x14, 0xbd, 0x0e, 0x4b, 0xe1, 0x9b, 0x7c, 0x1d, 0x93, 0x1d, 0xa6, 0x35, 0x6d, 0x31, 0x74,
+	0x5b, 0xaf, 0x6d, 0xa8, 0x4f, 0xe1, 0xea, 0x63, 0xcb, 0xc5, 0x5e, 0x3b, 0xb8, 0x71, 0x36, 0x67,
+	0x30, 0x79, 0x1d, 0xaa, 0x01, 0xe1, 0x13, 0x8f, 0x65, 0x0c, 0x57, 0xb5, 0xa1, 0xb5, 0xa3, 0x3b,
+	0x47, 0x9c, 0xc3, 0xee, 0x36, 0xbb, 0xd2, 0xf3, 0x1c, 0x27, 0xec, 0x89, 0xcb, 0x6d, 0x1a, 0xee,
+	0x61, 0x07, 0x5b, 0x5d, 0xfc, 0xd0, 0xee, 0x1e, 0x11, 0x17, 0xc4, 0x63, 0xef, 0x15, 0x95, 0x90,
+	0x23, 0xba, 0x1d, 0x7a, 0x8e, 0x98, 0x8b, 0x3c, 0x47, 0x9c, 0xf2, 0xbc, 0x55, 0xfd, 0x41, 0x0e,
+	0x56, 0xef, 0x0d, 0x3c, 0xec, 0x04, 0x39, 0x80, 0xf3, 0xa4, 0x33, 0x82, 0xfc, 0x42, 0x6e, 0x96,
+	0x0a, 0x4c, 0x86, 0x02, 0xad, 0x2c, 0x1b, 0x52, 0x98, 0x31, 0x1b, 0x72, 0x0f, 0x60, 0xe4, 0xd8,
+	0x23, 0xec, 0x78, 0x26, 0xf6, 0x03, 0xb9, 0x0c, 0x2e, 0x4d, 0x68, 0x90, 0xfa, 0x19, 0x34, 0x1e,
+	0x74, 0xb7, 0x6c, 0xab, 0x67, 0x3a, 0x43, 0x9f, 0x50, 0x09, 0xa5, 0x53, 0x32, 0x28, 0x5d, 0x2e,
+	0xa1, 0x74, 0xaa, 0x09, 0xcb, 0x21, 0xdc, 0x73, 0x1a, 0xae, 0x7e, 0xb7, 0xd3, 0x33, 0x2d, 0x93,
+	0x5e, 0x99, 0xcb, 0x51, 0x97, 0x14, 0xfa, 0xdd, 0xfb, 0xbc, 0x87, 0xdc, 0x75, 0xa8, 0x47, 0x33,
+	0x9a, 0x29, 0x19, 0xb1, 0x77, 0x20, 0x3f, 0x34, 0xfd, 0x8b, 0x66, 0xd7, 0xa5, 0x1c, 0xa6, 0xc4,
+	0xa2, 0x56, 0x59, 0x23, 0xb0, 0x74, 0x88, 0x7e, 0xda, 0xcc, 0x67, 0x1d, 0xa2, 0x9f, 0xaa, 0x3f,
+	0xcc, 0x43, 0xfd, 0xe3, 0xd3, 0xd4, 0x80, 0x21, 0x53, 0x40, 0xf8, 0x2a, 0x2c, 0x86, 0x09, 0x2d,
+	0x11, 0x2a, 0x83, 0x7a, 0xf2, 0x3d, 0xdb, 0x19, 0xea, 0x1e, 0xbf, 0x00, 0xc3, 0x5b, 0xa4, 0x7f,
+	0xe4, 0xe0, 0x9e, 0x79, 0xca, 0x63, 0x1a, 0xde, 0x22, 0xb7, 0xc6, 0xf0, 0xe9, 0xc8, 0xe1, 0x77,
+	0x84, 0xe8, 0x6f, 0xf4, 0xf3, 0xbe, 0x97, 0xbe, 0x90, 0x31, 0x28, 0x63, 0xe0, 0xc9, 0xf0, 0xa9,
+	0x9c, 0x0c, 0x9f, 0xe2, 0x06, 0xa2, 0x12, 0x37, 0x10, 0xe8, 0x2e, 0xac, 0x60, 0x4a, 0xad, 0xe0,
+	0x85, 0x41, 0xc7, 0x14, 0x2f, 0x12, 0x90, 0xff, 0x2d, 0xf4, 0x24, 0x21, 0x12, 0xd3, 0x55, 0x63,
+	0x31, 0x9d, 0x88, 0x52, 0x6a, 0x13, 0xa3, 0x94, 0xc5, 0x68, 0x94, 0x72, 0xeb, 0x23, 0x71, 0xdb,
+	0x9d, 0x94, 0x60, 0xd0, 0x02, 0xe4, 0x1f, 0xe1, 0x93, 0xc6, 0x05, 0x04, 0x50, 0x7a, 0x44, 0xa8,
+	0x39, 0x68, 0x28, 0xa8, 0x0a, 0x0b, 0xbc, 0xac, 0xde, 0xc8, 0xa1, 0x45, 0xa8, 0x6c, 0xf9, 0xe5,
+	0xc7, 0x46, 0xfe, 0xd6, 0x9f, 0x2b, 0xb0, 0x9c, 0x28, 0xfc, 0xa2, 0x3a, 0xc0, 0x63, 0xab, 0xcb,
+	0x2b, 0xe2, 0x8d, 0x0b, 0xa8, 0x06, 0x65, 0xbf, 0x3e, 0xce, 0xf0, 0xed, 0xdb, 0x14, 0xba, 0x91,
+	0x43, 0x0d, 0xa8, 0xb1, 0x81, 0xe3, 0x6e, 0x17, 0xbb, 0x6e, 0x23, 0x2f, 0x7a, 0xee, 0xeb, 0xe6,
+	0x60, 0xec, 0xe0, 0x46, 0x81, 0xcc, 0xb9, 0x6f, 0x6b, 0x78, 0x80, 0x75, 0x17, 0x37, 0x8a, 0x08,
+	0x41, 0x9d, 0x37, 0xfc, 0x41, 0xa5, 0x50, 0x9f, 0x3f, 0x6c, 0xe1, 0xd6, 0xd3, 0x70, 0x31, 0x8d,
+	0x6e, 0xef, 0x12, 0x5c, 0x7c, 0x6c, 0x19, 0xb8, 0x67, 0x5a, 0xd8, 0x08, 0x3e, 0x35, 0x2e, 0xa0,
+	0x8b, 0xb0, 0xb4, 0x83, 0x9d, 0x3e, 0x0e, 0x75, 0xe6, 0xd0, 0x32, 0x2c, 0xee, 0x98, 0xa7, 0xa1,
+	0xae, 0x3c, 0x6a, 0xc2, 0x4a, 0xa0, 0x71, 0xa1, 0x2f, 0x05, 0xb5, 0x50, 0x56, 0x1a, 0xca, 0xc6,
+	0xbf, 0xbf, 0x0a, 0x15, 0x62, 0xb9, 0xb6, 0x6c, 0xdb, 0x31, 0xd0, 0x00, 0x10, 0x7d, 0x6d, 0x36,
+	0x1c, 0xd9, 0x96, 0x78, 0x99, 0x8a, 0xd6, 0x63, 0x9e, 0x2f, 0x6b, 0x24, 0x01, 0xb9, 0x65, 0x6a,
+	0xdd, 0x94, 0xc2, 0xc7, 0x80, 0xd5, 0x0b, 0x68, 0x48, 0x67, 0x23, 0x85, 0xba, 0x7d, 0xb3, 0x7b,
+	0xe4, 0x7b, 0xde, 0x77, 0x27, 0x3c, 0xef, 0x4b, 0x82, 0xfa, 0xf3, 0xbd, 0x2a, 0x9d, 0x8f, 0x3d,
+	0x07, 0xf4, 0x2d, 0x9a, 0x7a, 0x01, 0x3d, 0x85, 0x95, 0x07, 0x38, 0x14, 0xc6, 0xf8, 0x13, 0x6e,
+	0x4c, 0x9e, 0x30, 0x01, 0x7c, 0xce, 0x29, 0x1f, 0x42, 0x91, 0x0a, 0x22, 0x92, 0xdd, 0x67, 0x08,
+	0xff, 0xad, 0x44, 0xeb, 0xc6, 0x64, 0x00, 0x81, 0xed, 0x73, 0x58, 0x8a, 0x3d, 0x38, 0x47, 0x32,
+	0xd7, 0x47, 0xfe, 0xd7, 0x01, 0xad, 0x5b, 0x59, 0x40, 0xc5, 0x5c, 0x7d, 0xa8, 0x47, 0x5f, 0xa9,
+	0xa1, 0xb5, 0x0c, 0x6f, 0x5d, 0xd9, 0x4c, 0x6f, 0x66, 0x7e, 0x15, 0x4b, 0x85, 0xa0, 0x11, 0x7f,
+	0x0a, 0x8d, 0x6e, 0xa5, 0x22, 0x88, 0x0a, 0xdb, 0x5b, 0x99, 0x60, 0xc5, 0x74, 0x67, 0xb0, 0x22,
+	0x7b, 0x87, 0x8a, 0xd6, 0xe5, 0x68, 0x26, 0x3d, 0x90, 0x6d, 0xdd, 0xc9, 0x0c, 0x2f, 0xa6, 0xfe,
+	0x75, 0x76, 0x69, 0x51, 0xf6, 0x96, 0x13, 0xbd, 0x23, 0x47, 0x97, 0xf2, 0x08, 0xb5, 0xb5, 0x71,
+	0x9e, 0x21, 0x62, 0x11, 0xbf, 0x06, 0xab, 0xf2, 0xd7, 0x90, 0xe8, 0xae, 0x1c, 0xdf, 0xe4, 0x87,
+	0x9e, 0xad, 0x77, 0xce, 0x31, 0x42, 0x2c, 0xc0, 0x8e, 0xbf, 0x35, 0xf7, 0xd5, 0xf0, 0xce, 0x54,
+	0xa9, 0x99, 0x4d, 0x07, 0xbf, 0x07, 0x4b, 0xb1, 0x60, 0x00, 0x65, 0x0f, 0x18, 0x5a, 0x69, 0x8e,
+	0x0f, 0x53, 0xc9, 0xd8, 0xed, 0x42, 0x34, 0x41, 0xfa, 0x25, 0x37, 0x10, 0x5b, 0xb7, 0xb2, 0x80,
+	0x8a, 0x8d, 0x8c, 0x60, 0x39, 0xf6, 0xf1, 0xc9, 0x06, 0x7a, 0x2b, 0xf3, 0x6c, 0x4f, 0x36, 0x5a,
+	0x6f, 0x67, 0x9f, 0xef, 0xc9, 0x86, 0x7a, 0x01, 0xb9, 0xd4, 0x40, 0xc7, 0x6e, 0xa8, 0xa1, 0x09,
+	0x58, 0xe4, 0x37, 0xf1, 0x5a, 0xb7, 0x33, 0x42, 0x8b, 0x6d, 0x1e, 0xc3, 0x45, 0xc9, 0x45, 0x42,
+	0x74, 0x3b, 0x55, 0x3c, 0xe2, 0x37, 0x28, 0x5b, 0xeb, 0x59, 0xc1, 0x43, 0xc7, 0x43, 0xc3, 0x5f,
+	0xd7, 0xbd, 0x01, 0xbd, 0xca, 0x8e, 0xe3, 0x5b, 0x0d, 0x4e, 0xbe, 0x08, 0xd8, 0x84, 0xad, 0x4e,
+	0x84, 0x16, 0x53, 0xfe, 0x0a, 0xa0, 0xbd, 0x43, 0xe2, 0x0b, 0x59, 0x3d, 0xb3, 0x3f, 0x76, 0x74,
+	0x16, 0x2f, 0x4c, 0x3a, 0x00, 0x93, 0xa0, 0x13, 0x14, 0x31, 0x75, 0x84, 0x98, 0xbc, 0x03, 0xf0,
+	0x00, 0x7b, 0x3b, 0xd8, 0x73, 0x88, 0xf6, 0xbf, 0x3e, 0x69, 0xed, 0x1c, 0xc0, 0x9f, 0xea, 0x8d,
+	0xa9, 0x70, 0x61, 0x82, 0xee, 0xe8, 0x16, 0x29, 0xed, 0x04, 0x8f, 0xc1, 0xe4, 0x04, 0x8d, 0x83,
+	0xa5, 0x13, 0x34, 0x09, 0x2d, 0xa6, 0x3c, 0x11, 0xfe, 0x4b, 0xa8, 0x3c, 0x9f, 0xee, 0xbf, 0x24,
+	0xef, 0xda, 0xb5, 0xee, 0x64, 0x86, 0x17, 0x13, 0x7f, 0xa9, 0xc0, 0x95, 0x24, 0xc0, 0xa7, 0xa6,
+	0x77, 0x48, 0xee, 0x44, 0xb9, 0x59, 0x96, 0x40, 0x01, 0xcf, 0xb1, 0x04, 0x0e, 0x2f, 0x96, 0x60,
+	0xc0, 0x62, 0xa4, 0x6a, 0x8e, 0x64, 0xcf, 0x9e, 0x64, 0x37, 0x08, 0x5a, 0x6b, 0xd3, 0x01, 0xc5,
+	0x2c, 0x87, 0xb0, 0xe8, 0x0b, 0x34, 0x23, 0xee, 0x9b, 0xa9, 0x42, 0x1f, 0xa1, 0xeb, 0xad, 0x2c,
+	0xa0, 0x62, 0x26, 0x17, 0x50, 0xb2, 0x3c, 0x88, 0xb2, 0x15, 0x93, 0xd3, 0x8c, 0xcf, 0xe4, 0x9a,
+	0x23, 0xb3, 0xe7, 0xb1, 0x02, 0xbc, 0xfc, 0xb0, 0x90, 0xde, 0x27, 0x68, 0xdd, 0xca, 0x02, 0x2a,
+	0xe6, 0xfa, 0x14, 0x4a, 0xfc, 0x4f, 0xa1, 0x6e, 0xa6, 0xe7, 0xee, 0x39, 0xf6, 0xd7, 0xa6, 0x40,
+	0x09, 0xc4, 0x47, 0x70, 0x69, 0x42, 0xe6, 0x5e, 0xea, 0x67, 0xa4, 0x67, 0xf9, 0xa7, 0x9d, 0x80,
+	0x62, 0xb2, 0x44, 0x62, 0x3e, 0x65, 0xb2, 0x49, 0x49, 0xfc, 0x69, 0x93, 0x75, 0x60, 0x39, 0x91,
+	0xf8, 0x94, 0x1e, 0x81, 0x93, 0xd2, 0xa3, 0xd3, 0x26, 0xe8, 0xc3, 0x4b, 0xd2, 0x24, 0x9f, 0xd4,
+	0x3b, 0x49, 0x4b, 0x07, 0x4e, 0x9b, 0xa8, 0x0b, 0x17, 0x25, 0xa9, 0x3d, 0xe9, 0x29, 0x37, 0x39,
+	0x05, 0x38, 0x6d, 0x92, 0x1e, 0xb4, 0x36, 0x1d, 0x5b, 0x37, 0xba, 0xba, 0xeb, 0xd1, 0x74, 0x1b,
+	0x36, 0x02, 0xf7, 0x50, 0x1e, 0x3b, 0x48, 0x93, 0x72, 0xd3, 0xe6, 0x39, 0x80, 0x2a, 0x65, 0x25,
+	0xfb, 0xe3, 0x1e, 0x24, 0x3f, 0x23, 0x42, 0x10, 0x13, 0x0c, 0x8f, 0x0c, 0x50, 0x08, 0xf5, 0x3e,
+	0x54, 0xb7, 0x68, 0x0e, 0xa0, 0x4d, 0xfe, 0xa8, 0x20, 0x7e, 0x5e, 0xd1, 0x7f, 0x2f, 0x58, 0x0f,
+	0x01, 0x64, 0xa6, 0xd0, 0x22, 0xf5, 0xda, 0x0d, 0x7c, 0xca, 0xf8, 0xbc, 0x26, 0xc3, 0x1b, 0x01,
+	0x99, 0x10, 0xe5, 0x48, 0x21, 0x43, 0x27, 0xfd, 0x4a, 0xd8, 0x97, 0x15, 0xd3, 0xdd, 0x99, 0x80,
+	0x24, 0x01, 0xe9, 0xcf, 0x7a, 0x37, 0xfb, 0x80, 0xf0, 0xc9, 0xe0, 0xaf, 0xab, 0x4d, 0xcb, 0xa4,
+	0x6f, 0xa4, 0x2d, 0x3d, 0xec, 0xa0, 0xae, 0x4d, 0x07, 0x14, 0xb3, 0xec, 0x42, 0x85, 0x48, 0x27,
+	0x63, 0xcf, 0x4d, 0xd9, 0x40, 0xf1, 0x39, 0x3b, 0x73, 0xb6, 0xb1, 0xdb, 0x75, 0xcc, 0x03, 0xce,
+	0x74, 0xe9, 0x72, 0x22, 0x20, 0xa9, 0xcc, 0x89, 0x41, 0x8a, 0x95, 0xff, 0x2a, 0x0d, 0x49, 0x68,
+	0xef, 0xe6, 0xd8, 0x1c, 0x18, 0xbb, 0xfc, 0x3a, 0x3e, 0xba, 0x9b, 0xb6, 0xfd, 0x08, 0xe8, 0x44,
+	0x4f, 0x2c, 0x65, 0x84, 0x98, 0xff, 0x97, 0xa0, 0x22, 0x32, 0xb0, 0x48, 0x76, 0xfd, 0x31, 0x9e,
+	0xfb, 0x6d, 0xdd, 0x4c, 0x07, 0xf2, 0x31, 0x6f, 0xfc, 0xa4, 0x02, 0x65, 0xff, 0x09, 0xe8, 0x57,
+	0x9c, 0xdc, 0x79, 0x01, 0xd9, 0x96, 0xef, 0xc1, 0x52, 0xec, 0x1f, 0x4b, 0xa4, 0x36, 0x4e, 0xfe,
+	0xaf, 0x26, 0xd3, 0x84, 0xf1, 0x53, 0xfe, 0x87, 0x9a, 0x22, 0x0c, 0x7a, 0x63, 0x52, 0xc6, 0x26,
+	0x1e, 0x01, 0x4d, 0x41, 0xfc, 0xff, 0x3b, 0x08, 0x78, 0x04, 0x10, 0x72, 0xff, 0xd3, 0xef, 0xd4,
+	0x13, 0x8f, 0x76, 0x1a, 0xb5, 0x86, 0x52, 0x0f, 0xff, 0xcd, 0x2c, 0x97, 0x74, 0x27, 0xfb, 0x68,
+	0x93, 0xfd, 0xfa, 0xc7, 0x50, 0x0b, 0xbf, 0xa1, 0x41, 0xd2, 0xbf, 0x6f, 0x4c, 0x3e, 0xb2, 0x99,
+	0xb6, 0x8b, 0x9d, 0x73, 0xba, 0x7e, 0x53, 0xd0, 0xb9, 0x80, 0x92, 0x57, 0x04, 0xa4, 0xae, 0xf2,
+	0xc4, 0x8b, 0x09, 0xad, 0xdb, 0x19, 0xa1, 0xc3, 0x89, 0xbb, 0x78, 0xdd, 0x5b, 0x9a, 0xb8, 0x9b,
+	0x70, 0x93, 0xa0, 0xf5, 0x56, 0x26, 0x58, 0x7f, 0xba, 0xcd, 0x77, 0x3f, 0x7b, 0xa7, 0x6f, 0x7a,
+	0x87, 0xe3, 0x03, 0xb2, 0xfb, 0x3b, 0x6c, 0xe8, 0x6d, 0xd3, 0xe6, 0xbf, 0xee, 0xf8, 0xe2, 0x7e,
+	0x87, 0x62, 0xbb, 0x43, 0xb0, 0x8d, 0x0e, 0x0e, 0x4a, 0xb4, 0xf5, 0xee, 0xff, 0x0d, 0x00, 0x77,
+	0xaf, 0xfa, 0xde, 0x4c, 0x58, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
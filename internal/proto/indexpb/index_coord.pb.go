@@ -213,24 +213,26 @@ func (m *FieldIndex) GetCreateTime() uint64 {
 }
 
 type SegmentIndex struct {
-	CollectionID         int64               `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionID          int64               `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	SegmentID            int64               `protobuf:"varint,3,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	NumRows              int64               `protobuf:"varint,4,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	IndexID              int64               `protobuf:"varint,5,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	BuildID              int64               `protobuf:"varint,6,opt,name=buildID,proto3" json:"buildID,omitempty"`
-	NodeID               int64               `protobuf:"varint,7,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
-	IndexVersion         int64               `protobuf:"varint,8,opt,name=index_version,json=indexVersion,proto3" json:"index_version,omitempty"`
-	State                commonpb.IndexState `protobuf:"varint,9,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
-	FailReason           string              `protobuf:"bytes,10,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
-	IndexFileKeys        []string            `protobuf:"bytes,11,rep,name=index_file_keys,json=indexFileKeys,proto3" json:"index_file_keys,omitempty"`
-	Deleted              bool                `protobuf:"varint,12,opt,name=deleted,proto3" json:"deleted,omitempty"`
-	CreateTime           uint64              `protobuf:"varint,13,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	SerializeSize        uint64              `protobuf:"varint,14,opt,name=serialize_size,json=serializeSize,proto3" json:"serialize_size,omitempty"`
-	WriteHandoff         bool                `protobuf:"varint,15,opt,name=write_handoff,json=writeHandoff,proto3" json:"write_handoff,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	CollectionID         int64                    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64                    `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	SegmentID            int64                    `protobuf:"varint,3,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	NumRows              int64                    `protobuf:"varint,4,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	IndexID              int64                    `protobuf:"varint,5,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	BuildID              int64                    `protobuf:"varint,6,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	NodeID               int64                    `protobuf:"varint,7,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	IndexVersion         int64                    `protobuf:"varint,8,opt,name=index_version,json=indexVersion,proto3" json:"index_version,omitempty"`
+	State                commonpb.IndexState      `protobuf:"varint,9,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
+	FailReason           string                   `protobuf:"bytes,10,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
+	IndexFileKeys        []string                 `protobuf:"bytes,11,rep,name=index_file_keys,json=indexFileKeys,proto3" json:"index_file_keys,omitempty"`
+	Deleted              bool                     `protobuf:"varint,12,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	CreateTime           uint64                   `protobuf:"varint,13,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	SerializeSize        uint64                   `protobuf:"varint,14,opt,name=serialize_size,json=serializeSize,proto3" json:"serialize_size,omitempty"`
+	WriteHandoff         bool                     `protobuf:"varint,15,opt,name=write_handoff,json=writeHandoff,proto3" json:"write_handoff,omitempty"`
+	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,16,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
+	RebuildJobID         int64                    `protobuf:"varint,17,opt,name=rebuild_jobID,json=rebuildJobID,proto3" json:"rebuild_jobID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
 func (m *SegmentIndex) Reset()         { *m = SegmentIndex{} }
@@ -363,6 +365,20 @@ func (m *SegmentIndex) GetWriteHandoff() bool {
 	return false
 }
 
+func (m *SegmentIndex) GetIndexParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.IndexParams
+	}
+	return nil
+}
+
+func (m *SegmentIndex) GetRebuildJobID() int64 {
+	if m != nil {
+		return m.RebuildJobID
+	}
+	return 0
+}
+
 type RegisterNodeRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Address              *commonpb.Address `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -1993,6 +2009,109 @@ func (m *GetJobStatsResponse) GetEnableDisk() bool {
 	return false
 }
 
+type IndexRebuildJob struct {
+	JobID                int64                    `protobuf:"varint,1,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	CollectionID         int64                    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	IndexID              int64                    `protobuf:"varint,3,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,4,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
+	State                commonpb.IndexState      `protobuf:"varint,5,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
+	FailReason           string                   `protobuf:"bytes,6,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
+	CreateTime           uint64                   `protobuf:"varint,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	RetiredBuildIDs      []int64                  `protobuf:"varint,8,rep,packed,name=retired_buildIDs,json=retiredBuildIDs,proto3" json:"retired_buildIDs,omitempty"`
+	LastRetireTime       int64                    `protobuf:"varint,9,opt,name=last_retire_time,json=lastRetireTime,proto3" json:"last_retire_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *IndexRebuildJob) Reset()         { *m = IndexRebuildJob{} }
+func (m *IndexRebuildJob) String() string { return proto.CompactTextString(m) }
+func (*IndexRebuildJob) ProtoMessage()    {}
+func (*IndexRebuildJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{29}
+}
+
+func (m *IndexRebuildJob) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexRebuildJob.Unmarshal(m, b)
+}
+func (m *IndexRebuildJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexRebuildJob.Marshal(b, m, deterministic)
+}
+func (m *IndexRebuildJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexRebuildJob.Merge(m, src)
+}
+func (m *IndexRebuildJob) XXX_Size() int {
+	return xxx_messageInfo_IndexRebuildJob.Size(m)
+}
+func (m *IndexRebuildJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexRebuildJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexRebuildJob proto.InternalMessageInfo
+
+func (m *IndexRebuildJob) GetJobID() int64 {
+	if m != nil {
+		return m.JobID
+	}
+	return 0
+}
+
+func (m *IndexRebuildJob) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *IndexRebuildJob) GetIndexID() int64 {
+	if m != nil {
+		return m.IndexID
+	}
+	return 0
+}
+
+func (m *IndexRebuildJob) GetIndexParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.IndexParams
+	}
+	return nil
+}
+
+func (m *IndexRebuildJob) GetState() commonpb.IndexState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.IndexState_IndexStateNone
+}
+
+func (m *IndexRebuildJob) GetFailReason() string {
+	if m != nil {
+		return m.FailReason
+	}
+	return ""
+}
+
+func (m *IndexRebuildJob) GetCreateTime() uint64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return uint64(0)
+}
+
+func (m *IndexRebuildJob) GetRetiredBuildIDs() []int64 {
+	if m != nil {
+		return m.RetiredBuildIDs
+	}
+	return nil
+}
+
+func (m *IndexRebuildJob) GetLastRetireTime() int64 {
+	if m != nil {
+		return m.LastRetireTime
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*IndexInfo)(nil), "milvus.proto.index.IndexInfo")
 	proto.RegisterType((*FieldIndex)(nil), "milvus.proto.index.FieldIndex")
@@ -2024,149 +2143,157 @@ func init() {
 	proto.RegisterType((*JobInfo)(nil), "milvus.proto.index.JobInfo")
 	proto.RegisterType((*GetJobStatsRequest)(nil), "milvus.proto.index.GetJobStatsRequest")
 	proto.RegisterType((*GetJobStatsResponse)(nil), "milvus.proto.index.GetJobStatsResponse")
+	proto.RegisterType((*IndexRebuildJob)(nil), "milvus.proto.index.IndexRebuildJob")
 }
 
 func init() { proto.RegisterFile("index_coord.proto", fileDescriptor_f9e019eb3fda53c2) }
 
 var fileDescriptor_f9e019eb3fda53c2 = []byte{
-	// 2184 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0xcd, 0x8e, 0xdb, 0xc8,
-	0xf1, 0x37, 0x45, 0xcd, 0x8c, 0x58, 0x94, 0xe6, 0xa3, 0xed, 0xfd, 0xff, 0x65, 0xd9, 0x8e, 0xc7,
-	0xf4, 0xda, 0x56, 0x02, 0xec, 0xd8, 0x99, 0xcd, 0x06, 0x9b, 0x20, 0x09, 0x30, 0x9e, 0x59, 0xdb,
-	0xb2, 0xd7, 0xc6, 0x84, 0x32, 0x16, 0xc8, 0x22, 0x80, 0x42, 0x89, 0xad, 0x99, 0xde, 0xa1, 0xd8,
-	0x32, 0xbb, 0x69, 0x7b, 0x1c, 0x20, 0xc8, 0x65, 0x0f, 0x59, 0x2c, 0x10, 0x20, 0x08, 0x92, 0x17,
-	0xc8, 0x69, 0x73, 0xc8, 0x3d, 0x97, 0xbc, 0x40, 0x4e, 0x79, 0x84, 0xbc, 0x44, 0xae, 0x41, 0x7f,
-	0x90, 0x22, 0x29, 0x6a, 0xa4, 0xf9, 0xc8, 0x25, 0xb9, 0xa9, 0x8b, 0xd5, 0x5f, 0x55, 0xbf, 0xaa,
-	0xfa, 0x55, 0x0b, 0x36, 0x48, 0xe8, 0xe3, 0xb7, 0xbd, 0x01, 0xa5, 0x91, 0xbf, 0x35, 0x8e, 0x28,
-	0xa7, 0x08, 0x8d, 0x48, 0xf0, 0x3a, 0x66, 0x6a, 0xb4, 0x25, 0xbf, 0xb7, 0xea, 0x03, 0x3a, 0x1a,
-	0xd1, 0x50, 0xc9, 0x5a, 0xab, 0x24, 0xe4, 0x38, 0x0a, 0xbd, 0x40, 0x8f, 0xeb, 0xd9, 0x19, 0xce,
-	0x5f, 0xaa, 0x60, 0x75, 0xc4, 0xac, 0x4e, 0x38, 0xa4, 0xc8, 0x81, 0xfa, 0x80, 0x06, 0x01, 0x1e,
-	0x70, 0x42, 0xc3, 0xce, 0x5e, 0xd3, 0xd8, 0x34, 0xda, 0xa6, 0x9b, 0x93, 0xa1, 0x26, 0xac, 0x0c,
-	0x09, 0x0e, 0xfc, 0xce, 0x5e, 0xb3, 0x22, 0x3f, 0x27, 0x43, 0x74, 0x03, 0x40, 0x1d, 0x30, 0xf4,
-	0x46, 0xb8, 0x69, 0x6e, 0x1a, 0x6d, 0xcb, 0xb5, 0xa4, 0xe4, 0x85, 0x37, 0xc2, 0x62, 0xa2, 0x1c,
-	0x74, 0xf6, 0x9a, 0x55, 0x35, 0x51, 0x0f, 0xd1, 0x43, 0xb0, 0xf9, 0xf1, 0x18, 0xf7, 0xc6, 0x5e,
-	0xe4, 0x8d, 0x58, 0x73, 0x69, 0xd3, 0x6c, 0xdb, 0xdb, 0xb7, 0xb6, 0x72, 0x57, 0xd3, 0x77, 0x7a,
-	0x86, 0x8f, 0x3f, 0xf3, 0x82, 0x18, 0xef, 0x7b, 0x24, 0x72, 0x41, 0xcc, 0xda, 0x97, 0x93, 0xd0,
-	0x1e, 0xd4, 0xd5, 0xe6, 0x7a, 0x91, 0xe5, 0x45, 0x17, 0xb1, 0xe5, 0x34, 0xbd, 0xca, 0x2d, 0xbd,
-	0x0a, 0xf6, 0x7b, 0x11, 0x7d, 0xc3, 0x9a, 0x2b, 0xf2, 0xa0, 0xb6, 0x96, 0xb9, 0xf4, 0x0d, 0x13,
-	0xb7, 0xe4, 0x94, 0x7b, 0x81, 0x52, 0xa8, 0x49, 0x05, 0x4b, 0x4a, 0xe4, 0xe7, 0x8f, 0x60, 0x89,
-	0x71, 0x8f, 0xe3, 0xa6, 0xb5, 0x69, 0xb4, 0x57, 0xb7, 0x6f, 0x96, 0x1e, 0x40, 0x5a, 0xbc, 0x2b,
-	0xd4, 0x5c, 0xa5, 0x8d, 0x3e, 0x82, 0xff, 0x57, 0xc7, 0x97, 0xc3, 0xde, 0xd0, 0x23, 0x41, 0x2f,
-	0xc2, 0x1e, 0xa3, 0x61, 0x13, 0xa4, 0x21, 0xaf, 0x90, 0x74, 0xce, 0x23, 0x8f, 0x04, 0xae, 0xfc,
-	0x86, 0x1c, 0x68, 0x10, 0xd6, 0xf3, 0x62, 0x4e, 0x7b, 0xf2, 0x7b, 0xd3, 0xde, 0x34, 0xda, 0x35,
-	0xd7, 0x26, 0x6c, 0x27, 0xe6, 0x54, 0x6e, 0x83, 0x9e, 0xc3, 0x46, 0xcc, 0x70, 0xd4, 0xcb, 0x99,
-	0xa7, 0xbe, 0xa8, 0x79, 0xd6, 0xc4, 0xdc, 0xce, 0xc4, 0x44, 0xce, 0x97, 0x06, 0xc0, 0x23, 0xe9,
-	0x71, 0xb9, 0xfa, 0x8f, 0x12, 0xa7, 0x93, 0x70, 0x48, 0x25, 0x60, 0xec, 0xed, 0x1b, 0x5b, 0xd3,
-	0xa8, 0xdc, 0x4a, 0x51, 0xa6, 0x31, 0x21, 0x01, 0xd7, 0x84, 0x15, 0x1f, 0x07, 0x98, 0x63, 0x5f,
-	0x82, 0xa9, 0xe6, 0x26, 0x43, 0x74, 0x13, 0xec, 0x41, 0x84, 0x85, 0x2d, 0x38, 0xd1, 0x68, 0xaa,
-	0xba, 0xa0, 0x44, 0x2f, 0xc9, 0x08, 0x3b, 0x5f, 0x56, 0xa1, 0xde, 0xc5, 0x07, 0x23, 0x1c, 0x72,
-	0x75, 0x92, 0x45, 0xc0, 0xbb, 0x09, 0xf6, 0xd8, 0x8b, 0x38, 0xd1, 0x2a, 0x0a, 0xc0, 0x59, 0x11,
-	0xba, 0x0e, 0x16, 0xd3, 0xab, 0xee, 0xc9, 0x5d, 0x4d, 0x77, 0x22, 0x40, 0x57, 0xa1, 0x16, 0xc6,
-	0x23, 0xe5, 0x7a, 0x0d, 0xe2, 0x30, 0x1e, 0x49, 0xc7, 0x67, 0xe0, 0xbd, 0x94, 0x87, 0x77, 0x13,
-	0x56, 0xfa, 0x31, 0x91, 0x11, 0xb3, 0xac, 0xbe, 0xe8, 0x21, 0xfa, 0x3f, 0x58, 0x0e, 0xa9, 0x8f,
-	0x3b, 0x7b, 0x1a, 0x68, 0x7a, 0x84, 0x6e, 0x43, 0x43, 0x19, 0xf5, 0x35, 0x8e, 0x18, 0xa1, 0xa1,
-	0x86, 0x99, 0xc2, 0xe6, 0x67, 0x4a, 0x76, 0x56, 0xa4, 0xdd, 0x04, 0x7b, 0x1a, 0x5d, 0x30, 0x9c,
-	0x60, 0xea, 0x2e, 0xac, 0xa9, 0xcd, 0x87, 0x24, 0xc0, 0xbd, 0x23, 0x7c, 0xcc, 0x9a, 0xf6, 0xa6,
-	0xd9, 0xb6, 0x5c, 0x75, 0xa6, 0x47, 0x24, 0xc0, 0xcf, 0xf0, 0x31, 0xcb, 0xfa, 0xae, 0x7e, 0xa2,
-	0xef, 0x1a, 0x45, 0xdf, 0xa1, 0x3b, 0xb0, 0xca, 0x70, 0x44, 0xbc, 0x80, 0xbc, 0xc3, 0x3d, 0x46,
-	0xde, 0xe1, 0xe6, 0xaa, 0xd4, 0x69, 0xa4, 0xd2, 0x2e, 0x79, 0x87, 0x85, 0x19, 0xde, 0x44, 0x84,
-	0xe3, 0xde, 0xa1, 0x17, 0xfa, 0x74, 0x38, 0x6c, 0xae, 0xc9, 0x7d, 0xea, 0x52, 0xf8, 0x44, 0xc9,
-	0x9c, 0x3f, 0x1a, 0x70, 0xd9, 0xc5, 0x07, 0x84, 0x71, 0x1c, 0xbd, 0xa0, 0x3e, 0x76, 0xf1, 0xab,
-	0x18, 0x33, 0x8e, 0x1e, 0x40, 0xb5, 0xef, 0x31, 0xac, 0x21, 0x79, 0xbd, 0xd4, 0x3a, 0xcf, 0xd9,
-	0xc1, 0x43, 0x8f, 0x61, 0x57, 0x6a, 0xa2, 0xef, 0xc3, 0x8a, 0xe7, 0xfb, 0x11, 0x66, 0x4c, 0x02,
-	0x63, 0xd6, 0xa4, 0x1d, 0xa5, 0xe3, 0x26, 0xca, 0x19, 0x2f, 0x9a, 0x59, 0x2f, 0x3a, 0xbf, 0x35,
-	0xe0, 0x4a, 0xfe, 0x64, 0x6c, 0x4c, 0x43, 0x86, 0xd1, 0x87, 0xb0, 0x2c, 0x7c, 0x11, 0x33, 0x7d,
-	0xb8, 0x6b, 0xa5, 0xfb, 0x74, 0xa5, 0x8a, 0xab, 0x55, 0x45, 0x92, 0x24, 0x21, 0xe1, 0x49, 0x00,
-	0xab, 0x13, 0xde, 0x2a, 0x46, 0x9a, 0x4e, 0xf5, 0x9d, 0x90, 0x70, 0x15, 0xaf, 0x2e, 0x90, 0xf4,
-	0xb7, 0xf3, 0x33, 0xb8, 0xf2, 0x18, 0xf3, 0x0c, 0x26, 0xb4, 0xad, 0x16, 0x09, 0x9d, 0x7c, 0x76,
-	0xaf, 0x14, 0xb2, 0xbb, 0xf3, 0x27, 0x03, 0xde, 0x2b, 0xac, 0x7d, 0x9e, 0xdb, 0xa6, 0xe0, 0xae,
-	0x9c, 0x07, 0xdc, 0x66, 0x11, 0xdc, 0xce, 0xaf, 0x0d, 0xb8, 0xf6, 0x18, 0xf3, 0x6c, 0xe2, 0xb8,
-	0x60, 0x4b, 0xa0, 0x6f, 0x01, 0xa4, 0x09, 0x83, 0x35, 0xcd, 0x4d, 0xb3, 0x6d, 0xba, 0x19, 0x89,
-	0xf3, 0x1b, 0x03, 0x36, 0xa6, 0xf6, 0xcf, 0xe7, 0x1d, 0xa3, 0x98, 0x77, 0xfe, 0x53, 0xe6, 0xf8,
-	0x9d, 0x01, 0xd7, 0xcb, 0xcd, 0x71, 0x1e, 0xe7, 0xfd, 0x58, 0x4d, 0xc2, 0x02, 0xa5, 0xa2, 0xcc,
-	0xdc, 0x29, 0xab, 0x07, 0xd3, 0x7b, 0xea, 0x49, 0xce, 0xd7, 0x26, 0xa0, 0x5d, 0x99, 0x2c, 0xe4,
-	0xc7, 0xd3, 0xb8, 0xe6, 0xcc, 0xe4, 0xa4, 0x40, 0x41, 0xaa, 0x17, 0x41, 0x41, 0x96, 0xce, 0x44,
-	0x41, 0xae, 0x83, 0x25, 0xb2, 0x26, 0xe3, 0xde, 0x68, 0x2c, 0xeb, 0x45, 0xd5, 0x9d, 0x08, 0xa6,
-	0x0b, 0xfe, 0xca, 0x82, 0x05, 0xbf, 0x76, 0xe6, 0x82, 0xff, 0x16, 0x2e, 0x27, 0x81, 0x2d, 0xcb,
-	0xf7, 0x29, 0xdc, 0x91, 0x0f, 0x85, 0x4a, 0x31, 0x14, 0xe6, 0x38, 0xc5, 0xf9, 0x57, 0x05, 0x36,
-	0x3a, 0x49, 0xcd, 0xd9, 0xf7, 0xf8, 0xa1, 0xe4, 0x0c, 0x27, 0x47, 0xca, 0x6c, 0x04, 0x64, 0x0a,
-	0xb4, 0x39, 0xb3, 0x40, 0x57, 0xf3, 0x05, 0x3a, 0x7f, 0xc0, 0xa5, 0x22, 0x6a, 0x2e, 0x86, 0x74,
-	0xb6, 0x61, 0x3d, 0x53, 0x70, 0xc7, 0x1e, 0x3f, 0x14, 0xc4, 0x53, 0x54, 0xdc, 0x55, 0x92, 0xbd,
-	0x3d, 0x43, 0xf7, 0x60, 0x2d, 0xad, 0x90, 0xbe, 0x2a, 0x9c, 0x35, 0x89, 0x90, 0x49, 0x39, 0xf5,
-	0x93, 0xca, 0x99, 0x27, 0x10, 0x56, 0x09, 0x81, 0xc8, 0x92, 0x19, 0xc8, 0x91, 0x19, 0xe7, 0xaf,
-	0x06, 0xd8, 0x69, 0x80, 0x2e, 0xd8, 0x18, 0xe4, 0xfc, 0x52, 0x29, 0xfa, 0xe5, 0x16, 0xd4, 0x71,
-	0xe8, 0xf5, 0x03, 0xac, 0x71, 0x6b, 0x2a, 0xdc, 0x2a, 0x99, 0xc2, 0xed, 0x23, 0xb0, 0x27, 0x54,
-	0x32, 0x89, 0xc1, 0x3b, 0x33, 0xb9, 0x64, 0x16, 0x14, 0x2e, 0xa4, 0x9c, 0x92, 0x39, 0x5f, 0x55,
-	0x26, 0x65, 0x4e, 0x21, 0xf6, 0x3c, 0xc9, 0xec, 0xe7, 0x50, 0xd7, 0xb7, 0x50, 0x14, 0x57, 0xa5,
-	0xb4, 0x1f, 0x94, 0x1d, 0xab, 0x6c, 0xd3, 0xad, 0x8c, 0x19, 0x3f, 0x09, 0x79, 0x74, 0xec, 0xda,
-	0x6c, 0x22, 0x69, 0xf5, 0x60, 0xbd, 0xa8, 0x80, 0xd6, 0xc1, 0x3c, 0xc2, 0xc7, 0xda, 0xc6, 0xe2,
-	0xa7, 0x48, 0xff, 0xaf, 0x05, 0x76, 0x74, 0xd5, 0xbf, 0x79, 0x62, 0x3e, 0x1d, 0x52, 0x57, 0x69,
-	0xff, 0xb0, 0xf2, 0xb1, 0xe1, 0xfc, 0xde, 0x80, 0xf5, 0xbd, 0x88, 0x8e, 0x4f, 0x9d, 0x4a, 0x1d,
-	0xa8, 0x67, 0x78, 0x71, 0x12, 0xbd, 0x39, 0xd9, 0xbc, 0xa4, 0x7a, 0x15, 0x6a, 0x7e, 0x44, 0xc7,
-	0x3d, 0x2f, 0x08, 0x64, 0x60, 0x09, 0x8a, 0x18, 0xd1, 0xf1, 0x4e, 0x10, 0x08, 0x26, 0xb2, 0x87,
-	0xd9, 0x20, 0x22, 0xfd, 0xd3, 0x27, 0xf9, 0x39, 0x4c, 0xe4, 0x6b, 0x03, 0xde, 0x2b, 0xac, 0x7d,
-	0x1e, 0xff, 0xff, 0x24, 0x8f, 0x4a, 0xe5, 0xfe, 0x39, 0x1d, 0x4e, 0x16, 0x8d, 0x9e, 0xac, 0xb0,
-	0xf2, 0xdb, 0x43, 0x91, 0x55, 0xf6, 0x23, 0x7a, 0x20, 0xf9, 0xe3, 0xc5, 0xdd, 0xf8, 0x0f, 0x06,
-	0xdc, 0x98, 0xb1, 0xc7, 0x79, 0x6e, 0x5e, 0x6c, 0x86, 0x2b, 0xf3, 0x9a, 0x61, 0xb3, 0xd0, 0x0c,
-	0x3b, 0x7f, 0xae, 0x40, 0xa3, 0xcb, 0x69, 0xe4, 0x1d, 0xe0, 0x5d, 0x1a, 0x0e, 0xc9, 0x81, 0x48,
-	0xb5, 0x09, 0xc7, 0x36, 0xe4, 0x35, 0x52, 0x16, 0x7d, 0x0b, 0xea, 0xde, 0x60, 0x80, 0x19, 0x13,
-	0x2d, 0x87, 0xce, 0x20, 0x96, 0x6b, 0x2b, 0xd9, 0x33, 0x21, 0x42, 0xdf, 0x81, 0x0d, 0x86, 0x07,
-	0x11, 0xe6, 0xbd, 0x89, 0xa6, 0x46, 0xdd, 0x9a, 0xfa, 0xb0, 0x93, 0x68, 0x0b, 0x52, 0x1e, 0x33,
-	0xdc, 0xed, 0x7e, 0xaa, 0x91, 0xa7, 0x47, 0x82, 0x12, 0xf5, 0xe3, 0xc1, 0x11, 0xe6, 0xd9, 0x94,
-	0x0e, 0x4a, 0x24, 0x41, 0x7b, 0x0d, 0xac, 0x88, 0x52, 0x2e, 0xf3, 0xb0, 0xac, 0xbf, 0x96, 0x5b,
-	0x13, 0x02, 0x91, 0x6a, 0xf4, 0xaa, 0x9d, 0x9d, 0xe7, 0xba, 0xee, 0xea, 0x91, 0xe8, 0x2b, 0x3b,
-	0x3b, 0xcf, 0x3f, 0x09, 0xfd, 0x31, 0x25, 0x21, 0x97, 0x49, 0xd9, 0x72, 0xb3, 0x22, 0x71, 0x3d,
-	0xa6, 0x2c, 0xd1, 0x13, 0x94, 0x41, 0x26, 0x64, 0xcb, 0xb5, 0xb5, 0xec, 0xe5, 0xf1, 0x18, 0x3b,
-	0xff, 0x34, 0x61, 0x5d, 0xf1, 0x9e, 0xa7, 0xb4, 0x9f, 0xc0, 0xe3, 0x3a, 0x58, 0x83, 0x20, 0x16,
-	0x2d, 0x84, 0xc6, 0x86, 0xe5, 0x4e, 0x04, 0xc2, 0x22, 0xd9, 0xd2, 0x11, 0xe1, 0x21, 0x79, 0xab,
-	0x2d, 0xb7, 0x36, 0xa9, 0x1d, 0x52, 0x9c, 0xad, 0x72, 0xe6, 0x54, 0x95, 0xf3, 0x3d, 0xee, 0xe9,
-	0xd2, 0x53, 0x95, 0xa5, 0xc7, 0x12, 0x12, 0x55, 0x75, 0xa6, 0x8a, 0xc9, 0x52, 0x49, 0x31, 0xc9,
-	0x54, 0xd7, 0xe5, 0x7c, 0x75, 0xcd, 0x83, 0x77, 0xa5, 0x98, 0x24, 0x9e, 0xc0, 0x6a, 0x62, 0x98,
-	0x81, 0xc4, 0x88, 0xb4, 0x5e, 0x49, 0x6b, 0x23, 0x93, 0x5c, 0x16, 0x4c, 0x6e, 0x83, 0xe5, 0xb0,
-	0x55, 0xac, 0xc6, 0xd6, 0x99, 0xaa, 0x71, 0x81, 0x09, 0xc2, 0x59, 0x98, 0x60, 0xb6, 0xb2, 0xda,
-	0xf9, 0xca, 0xfa, 0x29, 0xac, 0xff, 0x34, 0xc6, 0xd1, 0xf1, 0x53, 0xda, 0x67, 0x8b, 0xf9, 0xb8,
-	0x05, 0x35, 0xed, 0xa8, 0x24, 0x09, 0xa7, 0x63, 0xe7, 0x1f, 0x06, 0x34, 0x64, 0xd8, 0xbf, 0xf4,
-	0xd8, 0x51, 0xf2, 0xa2, 0x92, 0x78, 0xd9, 0xc8, 0x7b, 0xf9, 0x8c, 0x3d, 0x44, 0xc9, 0x73, 0x80,
-	0x59, 0xf6, 0x1c, 0x50, 0xc2, 0x4d, 0xaa, 0xa5, 0xdc, 0xa4, 0xd0, 0x94, 0x2c, 0x4d, 0x35, 0x25,
-	0xdf, 0x18, 0xb0, 0x91, 0xb1, 0xd1, 0x79, 0x52, 0x58, 0xce, 0xb2, 0x95, 0xa2, 0x65, 0x1f, 0xe6,
-	0x53, 0xbb, 0x59, 0xe6, 0xea, 0x4c, 0x6a, 0x4f, 0x6c, 0x9c, 0x4b, 0xef, 0xcf, 0x60, 0x4d, 0x94,
-	0xd7, 0x8b, 0x71, 0xe7, 0xdf, 0x0d, 0x58, 0x79, 0x4a, 0xfb, 0xd2, 0x91, 0x59, 0x0c, 0x19, 0xf9,
-	0xa7, 0xa6, 0x75, 0x30, 0x7d, 0x32, 0xd2, 0xf9, 0x58, 0xfc, 0x14, 0x31, 0xc6, 0xb8, 0x17, 0xf1,
-	0xc9, 0x63, 0x99, 0x20, 0x5f, 0x42, 0x22, 0xdf, 0x5b, 0xae, 0x42, 0x0d, 0x87, 0xbe, 0xfa, 0xa8,
-	0x19, 0x2e, 0x0e, 0x7d, 0xf9, 0xe9, 0x62, 0x9a, 0x96, 0x2b, 0xb0, 0x34, 0xa6, 0x93, 0x07, 0x2e,
-	0x35, 0x70, 0xae, 0x00, 0x7a, 0x8c, 0xf9, 0x53, 0xda, 0x17, 0x5e, 0x49, 0xcc, 0xe3, 0xfc, 0xad,
-	0x22, 0x1b, 0x8a, 0x89, 0xf8, 0x3c, 0x0e, 0x76, 0xa0, 0xa1, 0x0a, 0xd0, 0x17, 0xb4, 0xdf, 0x0b,
-	0xe3, 0xc4, 0x28, 0xb6, 0x14, 0x3e, 0xa5, 0xfd, 0x17, 0xf1, 0x08, 0x7d, 0x00, 0x97, 0x49, 0xd8,
-	0x1b, 0xeb, 0x9a, 0x98, 0x6a, 0x2a, 0x2b, 0xad, 0x93, 0x30, 0xa9, 0x96, 0x5a, 0xfd, 0x2e, 0xac,
-	0xe1, 0xf0, 0x55, 0x8c, 0x63, 0x9c, 0xaa, 0x2a, 0x9b, 0x35, 0xb4, 0x58, 0xeb, 0x89, 0xda, 0xe7,
-	0xb1, 0xa3, 0x1e, 0x0b, 0x28, 0x67, 0x3a, 0x27, 0x5a, 0x42, 0xd2, 0x15, 0x02, 0xf4, 0x31, 0x58,
-	0x62, 0xba, 0x82, 0x96, 0x6a, 0x0c, 0xae, 0x95, 0x41, 0x4b, 0xfb, 0xdb, 0xad, 0x7d, 0xa1, 0x7e,
-	0x30, 0x11, 0x20, 0x9a, 0x2a, 0xfb, 0x84, 0x1d, 0xe9, 0x4a, 0x03, 0x4a, 0xb4, 0x47, 0xd8, 0xd1,
-	0xf6, 0x57, 0x00, 0x20, 0x11, 0xb9, 0x4b, 0x69, 0xe4, 0xa3, 0x40, 0x9a, 0x79, 0x97, 0x8e, 0xc6,
-	0x34, 0xc4, 0x21, 0x97, 0xd1, 0xcb, 0xd0, 0x56, 0x7e, 0x33, 0x3d, 0x98, 0x56, 0xd4, 0x6e, 0x69,
-	0xbd, 0x5f, 0xaa, 0x5f, 0x50, 0x76, 0x2e, 0xa1, 0x57, 0x92, 0x5c, 0x8b, 0x21, 0x61, 0x9c, 0x0c,
-	0xd8, 0xee, 0xa1, 0x17, 0x86, 0x38, 0x40, 0xdb, 0x33, 0x9e, 0xa2, 0xca, 0x94, 0x93, 0x3d, 0x6f,
-	0x97, 0xee, 0xd9, 0xe5, 0x11, 0x09, 0x0f, 0x12, 0x5c, 0x38, 0x97, 0xd0, 0x4b, 0xb0, 0x33, 0xef,
-	0x01, 0xe8, 0x6e, 0x99, 0x19, 0xa7, 0x1f, 0x0c, 0x5a, 0x27, 0x01, 0xc8, 0xb9, 0x84, 0x86, 0xd0,
-	0xc8, 0x3d, 0x58, 0xa1, 0xf6, 0x49, 0x9c, 0x3e, 0xfb, 0x4a, 0xd4, 0xfa, 0xf6, 0x02, 0x9a, 0xe9,
-	0xe9, 0x7f, 0xa9, 0x0c, 0x36, 0xf5, 0xe2, 0x73, 0x7f, 0xc6, 0x22, 0xb3, 0xde, 0xa6, 0x5a, 0x0f,
-	0x16, 0x9f, 0x90, 0x6e, 0xee, 0x4f, 0x2e, 0xa9, 0xc0, 0x75, 0x6f, 0x7e, 0xe3, 0xa2, 0x76, 0x6b,
-	0x2f, 0xda, 0xe1, 0x38, 0x97, 0xd0, 0x3e, 0x58, 0x69, 0x8f, 0x81, 0xde, 0x2f, 0x9b, 0x58, 0x6c,
-	0x41, 0x16, 0x70, 0x4e, 0x8e, 0xc3, 0x97, 0x3b, 0xa7, 0xac, 0x85, 0x28, 0x77, 0x4e, 0x69, 0x43,
-	0xe0, 0x5c, 0x42, 0xbf, 0x9a, 0xbc, 0x5a, 0xe6, 0x98, 0x33, 0x7a, 0x70, 0xd2, 0xf5, 0xcb, 0x88,
-	0x7c, 0xeb, 0xbb, 0xa7, 0x98, 0x91, 0x01, 0x07, 0xea, 0x1e, 0xd2, 0x37, 0x8a, 0xc1, 0xc4, 0x91,
-	0x27, 0x08, 0x7f, 0xc9, 0xe6, 0x3a, 0x96, 0xa6, 0x55, 0x67, 0x6e, 0x7e, 0xc2, 0x8c, 0x74, 0xf3,
-	0x1e, 0xc0, 0x63, 0xcc, 0x9f, 0x63, 0x1e, 0x91, 0x01, 0x2b, 0x86, 0xd5, 0x24, 0x61, 0x68, 0x85,
-	0x64, 0xab, 0x7b, 0x73, 0xf5, 0xd2, 0x0d, 0xfa, 0x60, 0xef, 0x1e, 0xe2, 0xc1, 0xd1, 0x13, 0xec,
-	0x05, 0xfc, 0x10, 0x95, 0xcf, 0xcc, 0x68, 0xcc, 0xc0, 0x5e, 0x99, 0x62, 0xb2, 0xc7, 0xf6, 0x37,
-	0xcb, 0xfa, 0x1f, 0xcc, 0x17, 0xd4, 0xc7, 0xff, 0xfd, 0xb9, 0x70, 0x1f, 0xac, 0xb4, 0x47, 0x28,
-	0x0f, 0xb5, 0x62, 0x0b, 0x31, 0x2f, 0xd4, 0x3e, 0x07, 0x2b, 0x65, 0x5b, 0xe5, 0x2b, 0x16, 0x09,
-	0x6b, 0xeb, 0xce, 0x1c, 0xad, 0xf4, 0xb4, 0x2f, 0xa0, 0x96, 0xb0, 0x23, 0x74, 0x7b, 0x56, 0x5e,
-	0xc8, 0xae, 0x3c, 0xe7, 0xac, 0xbf, 0x00, 0x3b, 0x43, 0x1d, 0xca, 0x2b, 0xc1, 0x34, 0xe5, 0x68,
-	0xdd, 0x9b, 0xab, 0xf7, 0xbf, 0x11, 0x90, 0x0f, 0xbf, 0xf7, 0xf9, 0xf6, 0x01, 0xe1, 0x87, 0x71,
-	0x5f, 0x58, 0xf6, 0xbe, 0xd2, 0xfc, 0x80, 0x50, 0xfd, 0xeb, 0x7e, 0x72, 0xca, 0xfb, 0x72, 0xa5,
-	0xfb, 0xd2, 0x4e, 0xe3, 0x7e, 0x7f, 0x59, 0x0e, 0x3f, 0xfc, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x34, 0xde, 0x9f, 0x9a, 0x80, 0x20, 0x00, 0x00,
+	// 2290 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4f, 0x8f, 0x1b, 0x49,
+	0x15, 0x4f, 0xdb, 0x9e, 0x19, 0xf7, 0x6b, 0x7b, 0xfe, 0x54, 0xb2, 0xe0, 0x38, 0x09, 0x99, 0x74,
+	0x36, 0xc9, 0x04, 0x69, 0x27, 0x61, 0x96, 0x45, 0x0b, 0x02, 0xa4, 0xf9, 0xb3, 0x49, 0x9c, 0x6c,
+	0xa2, 0xa1, 0x1d, 0xad, 0xc4, 0x0a, 0xa9, 0x69, 0xbb, 0xcb, 0x33, 0x95, 0x69, 0x77, 0x39, 0x5d,
+	0xd5, 0x49, 0x26, 0x48, 0x88, 0x0b, 0x07, 0x56, 0x2b, 0x21, 0x21, 0x04, 0x5f, 0x80, 0xd3, 0x72,
+	0xe0, 0xce, 0x85, 0x2f, 0xc0, 0x89, 0x8f, 0xc0, 0x27, 0xe0, 0x86, 0xb8, 0xa1, 0xfa, 0xd3, 0xed,
+	0xee, 0x76, 0x7b, 0xec, 0xcc, 0x0c, 0x17, 0xf6, 0xe6, 0x7a, 0xfd, 0xea, 0xdf, 0x7b, 0xbf, 0xf7,
+	0xde, 0xef, 0x95, 0x61, 0x8d, 0x84, 0x3e, 0x7e, 0xe3, 0xf6, 0x29, 0x8d, 0xfc, 0xcd, 0x51, 0x44,
+	0x39, 0x45, 0x68, 0x48, 0x82, 0x57, 0x31, 0x53, 0xa3, 0x4d, 0xf9, 0xbd, 0xdd, 0xe8, 0xd3, 0xe1,
+	0x90, 0x86, 0x4a, 0xd6, 0x5e, 0x26, 0x21, 0xc7, 0x51, 0xe8, 0x05, 0x7a, 0xdc, 0xc8, 0xce, 0xb0,
+	0xff, 0x52, 0x03, 0xb3, 0x23, 0x66, 0x75, 0xc2, 0x01, 0x45, 0x36, 0x34, 0xfa, 0x34, 0x08, 0x70,
+	0x9f, 0x13, 0x1a, 0x76, 0xf6, 0x5a, 0xc6, 0xba, 0xb1, 0x51, 0x75, 0x72, 0x32, 0xd4, 0x82, 0xa5,
+	0x01, 0xc1, 0x81, 0xdf, 0xd9, 0x6b, 0x55, 0xe4, 0xe7, 0x64, 0x88, 0xae, 0x01, 0xa8, 0x03, 0x86,
+	0xde, 0x10, 0xb7, 0xaa, 0xeb, 0xc6, 0x86, 0xe9, 0x98, 0x52, 0xf2, 0xcc, 0x1b, 0x62, 0x31, 0x51,
+	0x0e, 0x3a, 0x7b, 0xad, 0x9a, 0x9a, 0xa8, 0x87, 0x68, 0x07, 0x2c, 0x7e, 0x3c, 0xc2, 0xee, 0xc8,
+	0x8b, 0xbc, 0x21, 0x6b, 0x2d, 0xac, 0x57, 0x37, 0xac, 0xad, 0x1b, 0x9b, 0xb9, 0xab, 0xe9, 0x3b,
+	0x3d, 0xc1, 0xc7, 0x9f, 0x79, 0x41, 0x8c, 0xf7, 0x3d, 0x12, 0x39, 0x20, 0x66, 0xed, 0xcb, 0x49,
+	0x68, 0x0f, 0x1a, 0x6a, 0x73, 0xbd, 0xc8, 0xe2, 0xbc, 0x8b, 0x58, 0x72, 0x9a, 0x5e, 0xe5, 0x86,
+	0x5e, 0x05, 0xfb, 0x6e, 0x44, 0x5f, 0xb3, 0xd6, 0x92, 0x3c, 0xa8, 0xa5, 0x65, 0x0e, 0x7d, 0xcd,
+	0xc4, 0x2d, 0x39, 0xe5, 0x5e, 0xa0, 0x14, 0xea, 0x52, 0xc1, 0x94, 0x12, 0xf9, 0xf9, 0x23, 0x58,
+	0x60, 0xdc, 0xe3, 0xb8, 0x65, 0xae, 0x1b, 0x1b, 0xcb, 0x5b, 0xd7, 0x4b, 0x0f, 0x20, 0x2d, 0xde,
+	0x15, 0x6a, 0x8e, 0xd2, 0x46, 0x1f, 0xc1, 0x37, 0xd5, 0xf1, 0xe5, 0xd0, 0x1d, 0x78, 0x24, 0x70,
+	0x23, 0xec, 0x31, 0x1a, 0xb6, 0x40, 0x1a, 0xf2, 0x12, 0x49, 0xe7, 0x3c, 0xf0, 0x48, 0xe0, 0xc8,
+	0x6f, 0xc8, 0x86, 0x26, 0x61, 0xae, 0x17, 0x73, 0xea, 0xca, 0xef, 0x2d, 0x6b, 0xdd, 0xd8, 0xa8,
+	0x3b, 0x16, 0x61, 0xdb, 0x31, 0xa7, 0x72, 0x1b, 0xf4, 0x14, 0xd6, 0x62, 0x86, 0x23, 0x37, 0x67,
+	0x9e, 0xc6, 0xbc, 0xe6, 0x59, 0x11, 0x73, 0x3b, 0x63, 0x13, 0xd9, 0xbf, 0x36, 0x00, 0x1e, 0x48,
+	0x8f, 0xcb, 0xd5, 0x7f, 0x98, 0x38, 0x9d, 0x84, 0x03, 0x2a, 0x01, 0x63, 0x6d, 0x5d, 0xdb, 0x9c,
+	0x44, 0xe5, 0x66, 0x8a, 0x32, 0x8d, 0x09, 0xf1, 0x53, 0x60, 0xc2, 0xc7, 0x01, 0xe6, 0xd8, 0x97,
+	0x60, 0xaa, 0x3b, 0xc9, 0x10, 0x5d, 0x07, 0xab, 0x1f, 0x61, 0x61, 0x0b, 0x4e, 0x34, 0x9a, 0x6a,
+	0x0e, 0x28, 0xd1, 0x73, 0x32, 0xc4, 0xf6, 0xbf, 0x6a, 0xd0, 0xe8, 0xe2, 0x83, 0x21, 0x0e, 0xb9,
+	0x3a, 0xc9, 0x3c, 0xe0, 0x5d, 0x07, 0x6b, 0xe4, 0x45, 0x9c, 0x68, 0x15, 0x05, 0xe0, 0xac, 0x08,
+	0x5d, 0x05, 0x93, 0xe9, 0x55, 0xf7, 0xe4, 0xae, 0x55, 0x67, 0x2c, 0x40, 0x97, 0xa1, 0x1e, 0xc6,
+	0x43, 0xe5, 0x7a, 0x0d, 0xe2, 0x30, 0x1e, 0x4a, 0xc7, 0x67, 0xe0, 0xbd, 0x90, 0x87, 0x77, 0x0b,
+	0x96, 0x7a, 0x31, 0x91, 0x11, 0xb3, 0xa8, 0xbe, 0xe8, 0x21, 0xfa, 0x06, 0x2c, 0x86, 0xd4, 0xc7,
+	0x9d, 0x3d, 0x0d, 0x34, 0x3d, 0x42, 0x37, 0xa1, 0xa9, 0x8c, 0xfa, 0x0a, 0x47, 0x8c, 0xd0, 0x50,
+	0xc3, 0x4c, 0x61, 0xf3, 0x33, 0x25, 0x3b, 0x2d, 0xd2, 0xae, 0x83, 0x35, 0x89, 0x2e, 0x18, 0x8c,
+	0x31, 0x75, 0x1b, 0x56, 0xd4, 0xe6, 0x03, 0x12, 0x60, 0xf7, 0x08, 0x1f, 0xb3, 0x96, 0xb5, 0x5e,
+	0xdd, 0x30, 0x1d, 0x75, 0xa6, 0x07, 0x24, 0xc0, 0x4f, 0xf0, 0x31, 0xcb, 0xfa, 0xae, 0x71, 0xa2,
+	0xef, 0x9a, 0x45, 0xdf, 0xa1, 0x5b, 0xb0, 0xcc, 0x70, 0x44, 0xbc, 0x80, 0xbc, 0xc5, 0x2e, 0x23,
+	0x6f, 0x71, 0x6b, 0x59, 0xea, 0x34, 0x53, 0x69, 0x97, 0xbc, 0xc5, 0xc2, 0x0c, 0xaf, 0x23, 0xc2,
+	0xb1, 0x7b, 0xe8, 0x85, 0x3e, 0x1d, 0x0c, 0x5a, 0x2b, 0x72, 0x9f, 0x86, 0x14, 0x3e, 0x52, 0xb2,
+	0x89, 0xc0, 0x5f, 0x3d, 0x55, 0xe0, 0xdf, 0x84, 0x66, 0x84, 0xa5, 0x5b, 0xdc, 0x17, 0xb4, 0xd7,
+	0xd9, 0x6b, 0xad, 0x29, 0x8b, 0x6b, 0xe1, 0x63, 0x21, 0xb3, 0xff, 0x68, 0xc0, 0x45, 0x07, 0x1f,
+	0x10, 0xc6, 0x71, 0xf4, 0x8c, 0xfa, 0xd8, 0xc1, 0x2f, 0x63, 0xcc, 0x38, 0xba, 0x0f, 0xb5, 0x9e,
+	0xc7, 0xb0, 0x46, 0xff, 0xd5, 0xd2, 0xad, 0x9f, 0xb2, 0x83, 0x1d, 0x8f, 0x61, 0x47, 0x6a, 0xa2,
+	0xef, 0xc1, 0x92, 0xe7, 0xfb, 0x11, 0x66, 0xac, 0x55, 0x39, 0x61, 0xd2, 0xb6, 0xd2, 0x71, 0x12,
+	0xe5, 0x0c, 0x60, 0xaa, 0x59, 0xc0, 0xd8, 0xbf, 0x35, 0xe0, 0x52, 0xfe, 0x64, 0x6c, 0x44, 0x43,
+	0x86, 0xd1, 0x87, 0xb0, 0x28, 0xdc, 0x1e, 0x33, 0x7d, 0xb8, 0x2b, 0xa5, 0xfb, 0x74, 0xa5, 0x8a,
+	0xa3, 0x55, 0x45, 0x3e, 0x26, 0x21, 0xe1, 0x89, 0x45, 0xd5, 0x09, 0x6f, 0x14, 0x83, 0x5a, 0x57,
+	0x95, 0x4e, 0x48, 0xb8, 0x32, 0xa2, 0x03, 0x24, 0xfd, 0x6d, 0xff, 0x14, 0x2e, 0x3d, 0xc4, 0x3c,
+	0x03, 0x3f, 0x6d, 0xab, 0x79, 0xa2, 0x34, 0x5f, 0x48, 0x2a, 0x85, 0x42, 0x62, 0xff, 0xc9, 0x80,
+	0xf7, 0x0a, 0x6b, 0x9f, 0xe5, 0xb6, 0x69, 0x1c, 0x55, 0xce, 0x12, 0x47, 0xd5, 0x62, 0x1c, 0xd9,
+	0xbf, 0x32, 0xe0, 0xca, 0x43, 0xcc, 0xb3, 0x39, 0xea, 0x9c, 0x2d, 0x81, 0xbe, 0x05, 0x90, 0xe6,
+	0x26, 0xd6, 0xaa, 0xae, 0x57, 0x37, 0xaa, 0x4e, 0x46, 0x62, 0xff, 0xc6, 0x80, 0xb5, 0x89, 0xfd,
+	0xf3, 0x29, 0xce, 0x28, 0xa6, 0xb8, 0xff, 0x95, 0x39, 0x7e, 0x67, 0xc0, 0xd5, 0x72, 0x73, 0x9c,
+	0xc5, 0x79, 0x3f, 0x52, 0x93, 0xb0, 0x40, 0xa9, 0x88, 0xfb, 0x5b, 0x65, 0xa5, 0x67, 0x72, 0x4f,
+	0x3d, 0xc9, 0xfe, 0xb2, 0x0a, 0x68, 0x57, 0xe6, 0x25, 0xf9, 0xf1, 0x5d, 0x5c, 0x73, 0x6a, 0x1e,
+	0x54, 0x60, 0x3b, 0xb5, 0xf3, 0x60, 0x3b, 0x0b, 0xa7, 0x4a, 0x7a, 0x57, 0xc1, 0x14, 0x09, 0x9a,
+	0x71, 0x6f, 0x38, 0x92, 0xa5, 0xa9, 0xe6, 0x8c, 0x05, 0x93, 0xdc, 0x62, 0x69, 0x4e, 0x6e, 0x51,
+	0x3f, 0x35, 0xb7, 0x78, 0x03, 0x17, 0x93, 0xc0, 0x96, 0x4c, 0xe1, 0x1d, 0xdc, 0x91, 0x0f, 0x85,
+	0x4a, 0x31, 0x14, 0x66, 0x38, 0xc5, 0xfe, 0x77, 0x05, 0xd6, 0x3a, 0x49, 0x79, 0xdb, 0xf7, 0xf8,
+	0xa1, 0xa4, 0x27, 0x27, 0x47, 0xca, 0x74, 0x04, 0x64, 0xb8, 0x40, 0x75, 0x2a, 0x17, 0xa8, 0xe5,
+	0xb9, 0x40, 0xfe, 0x80, 0x0b, 0x45, 0xd4, 0x9c, 0x0f, 0xbf, 0xdd, 0x80, 0xd5, 0x4c, 0x6d, 0x1f,
+	0x79, 0xfc, 0x50, 0x70, 0x5c, 0x51, 0xdc, 0x97, 0x49, 0xf6, 0xf6, 0x0c, 0xdd, 0x81, 0x95, 0xb4,
+	0x18, 0xfb, 0xaa, 0x46, 0xd7, 0x25, 0x42, 0xc6, 0x95, 0xdb, 0x4f, 0x8a, 0x74, 0x9e, 0xab, 0x98,
+	0x25, 0x5c, 0x25, 0xcb, 0x9b, 0x20, 0xc7, 0x9b, 0xec, 0xbf, 0x1a, 0x60, 0xa5, 0x01, 0x3a, 0x67,
+	0x0f, 0x92, 0xf3, 0x4b, 0xa5, 0xe8, 0x97, 0x1b, 0xd0, 0xc0, 0xa1, 0xd7, 0x0b, 0xb0, 0xc6, 0x6d,
+	0x55, 0xe1, 0x56, 0xc9, 0x14, 0x6e, 0x1f, 0x80, 0x35, 0x66, 0xad, 0x49, 0x0c, 0xde, 0x9a, 0x4a,
+	0x5b, 0xb3, 0xa0, 0x70, 0x20, 0xa5, 0xaf, 0xcc, 0xfe, 0xa2, 0x32, 0x2e, 0x73, 0xf2, 0xe3, 0x99,
+	0x92, 0xd9, 0xcf, 0xa0, 0xa1, 0x6f, 0xa1, 0xd8, 0xb4, 0x4a, 0x69, 0xdf, 0x2f, 0x3b, 0x56, 0xd9,
+	0xa6, 0x9b, 0x19, 0x33, 0x7e, 0x12, 0xf2, 0xe8, 0xd8, 0xb1, 0xd8, 0x58, 0xd2, 0x76, 0x61, 0xb5,
+	0xa8, 0x80, 0x56, 0xa1, 0x7a, 0x84, 0x8f, 0xb5, 0x8d, 0xc5, 0x4f, 0x91, 0xfe, 0x5f, 0x09, 0xec,
+	0xe8, 0xaa, 0x7f, 0xfd, 0xc4, 0x7c, 0x3a, 0xa0, 0x8e, 0xd2, 0xfe, 0x41, 0xe5, 0x63, 0xc3, 0xfe,
+	0xbd, 0x01, 0xab, 0x7b, 0x11, 0x1d, 0xbd, 0x73, 0x2a, 0xb5, 0xa1, 0x91, 0xa1, 0xe0, 0x49, 0xf4,
+	0xe6, 0x64, 0xb3, 0x92, 0xea, 0x65, 0xa8, 0xfb, 0x11, 0x1d, 0xb9, 0x5e, 0x10, 0xb4, 0x6a, 0x9a,
+	0x8d, 0x46, 0x74, 0xb4, 0x1d, 0x04, 0x82, 0x89, 0xec, 0x61, 0xd6, 0x8f, 0x48, 0xef, 0xdd, 0x93,
+	0xfc, 0x0c, 0x26, 0xf2, 0xa5, 0x01, 0xef, 0x15, 0xd6, 0x3e, 0x8b, 0xff, 0x7f, 0x9c, 0x47, 0xa5,
+	0x72, 0xff, 0x8c, 0x66, 0x2a, 0x8b, 0x46, 0x4f, 0x56, 0x58, 0xf9, 0x6d, 0x47, 0x64, 0x95, 0xfd,
+	0x88, 0x1e, 0x48, 0xfe, 0x78, 0x7e, 0x37, 0xfe, 0x83, 0x01, 0xd7, 0xa6, 0xec, 0x71, 0x96, 0x9b,
+	0x17, 0xfb, 0xee, 0xca, 0xac, 0xbe, 0xbb, 0x5a, 0xe8, 0xbb, 0xed, 0x3f, 0x57, 0xa0, 0xd9, 0xe5,
+	0x34, 0xf2, 0x0e, 0xf0, 0x2e, 0x0d, 0x07, 0xe4, 0x40, 0xa4, 0xda, 0x84, 0x63, 0x1b, 0xf2, 0x1a,
+	0xc9, 0x50, 0xec, 0xe6, 0xf5, 0xfb, 0x98, 0x31, 0xd1, 0xdd, 0xe8, 0x0c, 0x62, 0x3a, 0x96, 0x92,
+	0x3d, 0x11, 0x22, 0xf4, 0x6d, 0x58, 0x63, 0xb8, 0x1f, 0x61, 0xee, 0x8e, 0x35, 0x35, 0xea, 0x56,
+	0xd4, 0x87, 0xed, 0x44, 0x5b, 0x90, 0xf2, 0x98, 0xe1, 0x6e, 0xf7, 0x53, 0x8d, 0x3c, 0x3d, 0x12,
+	0x94, 0xa8, 0x17, 0xf7, 0x8f, 0x30, 0xcf, 0xa6, 0x74, 0x50, 0x22, 0x09, 0xda, 0x2b, 0x60, 0x46,
+	0x94, 0x72, 0x99, 0x87, 0x65, 0xfd, 0x35, 0x9d, 0xba, 0x10, 0x88, 0x54, 0xa3, 0x57, 0xed, 0x6c,
+	0x3f, 0xd5, 0x75, 0x57, 0x8f, 0x44, 0x0b, 0xdb, 0xd9, 0x7e, 0xfa, 0x49, 0xe8, 0x8f, 0x28, 0x09,
+	0xb9, 0x4c, 0xca, 0xa6, 0x93, 0x15, 0x89, 0xeb, 0x31, 0x65, 0x09, 0x57, 0x50, 0x06, 0x99, 0x90,
+	0x4d, 0xc7, 0xd2, 0xb2, 0xe7, 0xc7, 0x23, 0x6c, 0xff, 0xb3, 0x0a, 0xab, 0x8a, 0xf7, 0x3c, 0xa6,
+	0xbd, 0x04, 0x1e, 0x57, 0xc1, 0xec, 0x07, 0x31, 0xe3, 0x38, 0xd2, 0xd8, 0x30, 0x9d, 0xb1, 0x40,
+	0x58, 0x24, 0x5b, 0x3a, 0x22, 0x3c, 0x20, 0x6f, 0xb4, 0xe5, 0x56, 0xc6, 0xb5, 0x43, 0x8a, 0xb3,
+	0x55, 0xae, 0x3a, 0x51, 0xe5, 0x7c, 0x8f, 0x7b, 0xba, 0xf4, 0xd4, 0x64, 0xe9, 0x31, 0x85, 0x44,
+	0x55, 0x9d, 0x89, 0x62, 0xb2, 0x50, 0x52, 0x4c, 0x32, 0xd5, 0x75, 0x31, 0x5f, 0x5d, 0xf3, 0xe0,
+	0x5d, 0x2a, 0x26, 0x89, 0x47, 0xb0, 0x9c, 0x18, 0xa6, 0x2f, 0x31, 0x22, 0xad, 0x57, 0xd2, 0xda,
+	0xc8, 0x24, 0x97, 0x05, 0x93, 0xd3, 0x64, 0xd9, 0xe1, 0x44, 0x35, 0x36, 0x4f, 0x55, 0x8d, 0x0b,
+	0x4c, 0x10, 0x4e, 0xc3, 0x04, 0xb3, 0x95, 0xd5, 0xca, 0x57, 0xd6, 0x4f, 0x61, 0xf5, 0x27, 0x31,
+	0x8e, 0x8e, 0x1f, 0xd3, 0x1e, 0x9b, 0xcf, 0xc7, 0x6d, 0xa8, 0x6b, 0x47, 0x25, 0x49, 0x38, 0x1d,
+	0xdb, 0xff, 0x30, 0xa0, 0x29, 0xc3, 0xfe, 0xb9, 0xc7, 0x8e, 0x92, 0xc7, 0x9b, 0xc4, 0xcb, 0x46,
+	0xde, 0xcb, 0xa7, 0xec, 0x21, 0x4a, 0x5e, 0x1e, 0xaa, 0x65, 0x2f, 0x0f, 0x25, 0xdc, 0xa4, 0x56,
+	0xca, 0x4d, 0x0a, 0x4d, 0xc9, 0xc2, 0x44, 0x53, 0xf2, 0x95, 0x01, 0x6b, 0x19, 0x1b, 0x9d, 0x25,
+	0x85, 0xe5, 0x2c, 0x5b, 0x29, 0x5a, 0x76, 0x27, 0x9f, 0xda, 0xab, 0x65, 0xae, 0xce, 0xa4, 0xf6,
+	0xc4, 0xc6, 0xb9, 0xf4, 0xfe, 0x04, 0x56, 0x44, 0x79, 0x3d, 0x1f, 0x77, 0xfe, 0xdd, 0x80, 0x25,
+	0xf1, 0xaa, 0x21, 0x1c, 0x99, 0xc5, 0x90, 0x91, 0x7f, 0xd5, 0x5a, 0x85, 0xaa, 0x4f, 0x86, 0x3a,
+	0x1f, 0x8b, 0x9f, 0x22, 0xc6, 0x18, 0xf7, 0x22, 0x3e, 0x7e, 0x97, 0x13, 0xe4, 0x4b, 0x48, 0xe4,
+	0xd3, 0xce, 0x65, 0xa8, 0xe3, 0xd0, 0x57, 0x1f, 0x35, 0xc3, 0xc5, 0xa1, 0x2f, 0x3f, 0x9d, 0x4f,
+	0xd3, 0x72, 0x09, 0x16, 0x46, 0x74, 0xfc, 0x96, 0xa6, 0x06, 0xf6, 0x25, 0x40, 0x0f, 0x31, 0x7f,
+	0x4c, 0x7b, 0xc2, 0x2b, 0x89, 0x79, 0xec, 0xbf, 0x55, 0xe0, 0x62, 0x4e, 0x7c, 0x16, 0x07, 0xdb,
+	0xd0, 0x54, 0x05, 0xe8, 0x05, 0xed, 0xb9, 0x61, 0x9c, 0x18, 0xc5, 0x92, 0xc2, 0xc7, 0xb4, 0xf7,
+	0x2c, 0x1e, 0xa2, 0x0f, 0xe0, 0x22, 0x09, 0xdd, 0x91, 0xae, 0x89, 0xa9, 0xa6, 0xb2, 0xd2, 0x2a,
+	0x09, 0x93, 0x6a, 0xa9, 0xd5, 0x6f, 0xc3, 0x0a, 0x0e, 0x5f, 0xc6, 0x38, 0xc6, 0xa9, 0xaa, 0xb2,
+	0x59, 0x53, 0x8b, 0xb5, 0x9e, 0xa8, 0x7d, 0x1e, 0x3b, 0x72, 0x59, 0x40, 0x39, 0xd3, 0x39, 0xd1,
+	0x14, 0x92, 0xae, 0x10, 0xa0, 0x8f, 0xc1, 0x14, 0xd3, 0x15, 0xb4, 0x54, 0x63, 0x70, 0xa5, 0x0c,
+	0x5a, 0xda, 0xdf, 0x4e, 0xfd, 0x85, 0xfa, 0xc1, 0x44, 0x80, 0x68, 0xaa, 0xec, 0x13, 0x76, 0xa4,
+	0x2b, 0x0d, 0x28, 0xd1, 0x1e, 0x61, 0x47, 0xf6, 0x7f, 0x2a, 0xb0, 0xa2, 0x99, 0x4d, 0xf2, 0x10,
+	0x26, 0x3c, 0xa0, 0xde, 0xc8, 0x14, 0x56, 0xd4, 0x60, 0x82, 0x5c, 0x54, 0xca, 0x7b, 0xe6, 0x29,
+	0x7d, 0x51, 0x11, 0x1b, 0xb5, 0x53, 0x61, 0x23, 0xcd, 0x3b, 0x0b, 0x67, 0x79, 0xbb, 0x58, 0x9c,
+	0x78, 0x12, 0x2d, 0x3c, 0x68, 0x2e, 0x4d, 0x3c, 0x68, 0xde, 0x85, 0xd5, 0x08, 0x73, 0x12, 0x61,
+	0xdf, 0x4d, 0x23, 0xae, 0x2e, 0x23, 0x6e, 0x45, 0xcb, 0x77, 0xb4, 0x58, 0xb4, 0x60, 0x81, 0xc7,
+	0xb8, 0xab, 0xe4, 0x6a, 0x41, 0xd5, 0x32, 0x2d, 0x0b, 0xb9, 0x23, 0xc5, 0x62, 0xd1, 0xad, 0x2f,
+	0x00, 0x40, 0x1e, 0x76, 0x97, 0xd2, 0xc8, 0x47, 0x81, 0x84, 0xf8, 0x2e, 0x1d, 0x8e, 0x68, 0x88,
+	0x43, 0x2e, 0x6f, 0xc0, 0xd0, 0x66, 0xfe, 0x8e, 0x7a, 0x30, 0xa9, 0xa8, 0x43, 0xa2, 0xfd, 0x7e,
+	0xa9, 0x7e, 0x41, 0xd9, 0xbe, 0x80, 0x5e, 0xca, 0xc6, 0x46, 0x0c, 0x09, 0xe3, 0xa4, 0xcf, 0x76,
+	0x0f, 0xbd, 0x30, 0xc4, 0x01, 0xda, 0x9a, 0xf2, 0x0c, 0x58, 0xa6, 0x9c, 0xec, 0x79, 0xb3, 0x74,
+	0xcf, 0x2e, 0x8f, 0x48, 0x78, 0x90, 0xc4, 0xa4, 0x7d, 0x01, 0x3d, 0x07, 0x2b, 0xf3, 0x16, 0x83,
+	0x6e, 0x97, 0x41, 0x78, 0xf2, 0xb1, 0xa6, 0x7d, 0x52, 0xf0, 0xda, 0x17, 0xd0, 0x00, 0x9a, 0xb9,
+	0xc7, 0x42, 0xb4, 0x71, 0x52, 0x3f, 0x95, 0x7d, 0xa1, 0x6b, 0xdf, 0x9d, 0x43, 0x33, 0x3d, 0xfd,
+	0x2f, 0x94, 0xc1, 0x26, 0x5e, 0xdb, 0xee, 0x4d, 0x59, 0x64, 0xda, 0xbb, 0x60, 0xfb, 0xfe, 0xfc,
+	0x13, 0xd2, 0xcd, 0xfd, 0xf1, 0x25, 0x55, 0x60, 0xdf, 0x99, 0xdd, 0x34, 0xaa, 0xdd, 0x36, 0xe6,
+	0xed, 0x2e, 0xed, 0x0b, 0x68, 0x1f, 0xcc, 0xb4, 0xbf, 0x43, 0xef, 0x97, 0x4d, 0x2c, 0xb6, 0x7f,
+	0x73, 0x38, 0x27, 0xd7, 0x3f, 0x95, 0x3b, 0xa7, 0xac, 0x7d, 0x6b, 0xdf, 0x9d, 0x43, 0x33, 0x3d,
+	0xf9, 0x2f, 0xc7, 0x2f, 0xc6, 0xb9, 0xae, 0x05, 0xdd, 0x3f, 0xe9, 0xfa, 0x65, 0x4d, 0x54, 0xfb,
+	0x3b, 0xef, 0x30, 0x23, 0x03, 0x0e, 0xd4, 0x3d, 0xa4, 0xaf, 0x15, 0x7b, 0x8c, 0x23, 0x8f, 0x13,
+	0x1a, 0x96, 0x6c, 0xae, 0x63, 0x69, 0x52, 0x75, 0xea, 0xe6, 0x27, 0xcc, 0x48, 0x37, 0x77, 0x01,
+	0x1e, 0x62, 0xfe, 0x14, 0xf3, 0x88, 0xf4, 0x59, 0x31, 0xac, 0xc6, 0x09, 0x43, 0x2b, 0x24, 0x5b,
+	0xdd, 0x99, 0xa9, 0x97, 0x6e, 0xd0, 0x03, 0x6b, 0xf7, 0x10, 0xf7, 0x8f, 0x1e, 0x61, 0x2f, 0xe0,
+	0x87, 0xa8, 0x7c, 0x66, 0x46, 0x63, 0x0a, 0xf6, 0xca, 0x14, 0x93, 0x3d, 0xb6, 0xbe, 0x5a, 0xd4,
+	0x7f, 0x54, 0x8b, 0xbf, 0x37, 0xfe, 0xff, 0x73, 0xe1, 0x3e, 0x98, 0x69, 0x7f, 0x56, 0x1e, 0x6a,
+	0xc5, 0xf6, 0x6d, 0x56, 0xa8, 0x7d, 0x0e, 0x66, 0xca, 0x74, 0xcb, 0x57, 0x2c, 0x36, 0x0b, 0xed,
+	0x5b, 0x33, 0xb4, 0xd2, 0xd3, 0x3e, 0x83, 0x7a, 0xc2, 0x4c, 0xd1, 0xcd, 0x69, 0x79, 0x21, 0xbb,
+	0xf2, 0x8c, 0xb3, 0xfe, 0x1c, 0xac, 0x0c, 0x6d, 0x2b, 0xaf, 0x04, 0x93, 0x74, 0xaf, 0x7d, 0x67,
+	0xa6, 0xde, 0xd7, 0x23, 0x20, 0x77, 0xbe, 0xfb, 0xf9, 0xd6, 0x01, 0xe1, 0x87, 0x71, 0x4f, 0x58,
+	0xf6, 0x9e, 0xd2, 0xfc, 0x80, 0x50, 0xfd, 0xeb, 0x5e, 0x72, 0xca, 0x7b, 0x72, 0xa5, 0x7b, 0xd2,
+	0x4e, 0xa3, 0x5e, 0x6f, 0x51, 0x0e, 0x3f, 0xfc, 0xef, 0x00, 0xd4, 0xfd, 0xb4, 0xd5, 0x67, 0x22,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
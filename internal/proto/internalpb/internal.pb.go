@@ -908,12 +908,16 @@ type SearchResults struct {
 	ChannelIDsSearched       []string          `protobuf:"bytes,8,rep,name=channelIDs_searched,json=channelIDsSearched,proto3" json:"channelIDs_searched,omitempty"`
 	GlobalSealedSegmentIDs   []int64           `protobuf:"varint,9,rep,packed,name=global_sealed_segmentIDs,json=globalSealedSegmentIDs,proto3" json:"global_sealed_segmentIDs,omitempty"`
 	// schema.SearchResultsData inside
-	SlicedBlob           []byte   `protobuf:"bytes,10,opt,name=sliced_blob,json=slicedBlob,proto3" json:"sliced_blob,omitempty"`
-	SlicedNumCount       int64    `protobuf:"varint,11,opt,name=sliced_num_count,json=slicedNumCount,proto3" json:"sliced_num_count,omitempty"`
-	SlicedOffset         int64    `protobuf:"varint,12,opt,name=sliced_offset,json=slicedOffset,proto3" json:"sliced_offset,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	SlicedBlob     []byte `protobuf:"bytes,10,opt,name=sliced_blob,json=slicedBlob,proto3" json:"sliced_blob,omitempty"`
+	SlicedNumCount int64  `protobuf:"varint,11,opt,name=sliced_num_count,json=slicedNumCount,proto3" json:"sliced_num_count,omitempty"`
+	SlicedOffset   int64  `protobuf:"varint,12,opt,name=sliced_offset,json=slicedOffset,proto3" json:"sliced_offset,omitempty"`
+	// costs of the search in query node, which are printed in the slow query log
+	WaitTsafeMs          int64                `protobuf:"varint,13,opt,name=wait_tsafe_ms,json=waitTsafeMs,proto3" json:"wait_tsafe_ms,omitempty"`
+	ReduceMs             int64                `protobuf:"varint,14,opt,name=reduce_ms,json=reduceMs,proto3" json:"reduce_ms,omitempty"`
+	SegmentCosts         []*SegmentSearchCost `protobuf:"bytes,15,rep,name=segment_costs,json=segmentCosts,proto3" json:"segment_costs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *SearchResults) Reset()         { *m = SearchResults{} }
@@ -1025,6 +1029,27 @@ func (m *SearchResults) GetSlicedOffset() int64 {
 	return 0
 }
 
+func (m *SearchResults) GetWaitTsafeMs() int64 {
+	if m != nil {
+		return m.WaitTsafeMs
+	}
+	return 0
+}
+
+func (m *SearchResults) GetReduceMs() int64 {
+	if m != nil {
+		return m.ReduceMs
+	}
+	return 0
+}
+
+func (m *SearchResults) GetSegmentCosts() []*SegmentSearchCost {
+	if m != nil {
+		return m.SegmentCosts
+	}
+	return nil
+}
+
 type RetrieveRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	ReqID                int64             `protobuf:"varint,2,opt,name=reqID,proto3" json:"reqID,omitempty"`
@@ -1872,6 +1897,69 @@ func (m *Rate) GetR() float64 {
 	return 0
 }
 
+type SegmentSearchCost struct {
+	SegmentID            int64    `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	Growing              bool     `protobuf:"varint,2,opt,name=growing,proto3" json:"growing,omitempty"`
+	Indexed              bool     `protobuf:"varint,3,opt,name=indexed,proto3" json:"indexed,omitempty"`
+	CostMs               int64    `protobuf:"varint,4,opt,name=cost_ms,json=costMs,proto3" json:"cost_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentSearchCost) Reset()         { *m = SegmentSearchCost{} }
+func (m *SegmentSearchCost) String() string { return proto.CompactTextString(m) }
+func (*SegmentSearchCost) ProtoMessage()    {}
+func (*SegmentSearchCost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{27}
+}
+
+func (m *SegmentSearchCost) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentSearchCost.Unmarshal(m, b)
+}
+func (m *SegmentSearchCost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentSearchCost.Marshal(b, m, deterministic)
+}
+func (m *SegmentSearchCost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentSearchCost.Merge(m, src)
+}
+func (m *SegmentSearchCost) XXX_Size() int {
+	return xxx_messageInfo_SegmentSearchCost.Size(m)
+}
+func (m *SegmentSearchCost) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentSearchCost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentSearchCost proto.InternalMessageInfo
+
+func (m *SegmentSearchCost) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *SegmentSearchCost) GetGrowing() bool {
+	if m != nil {
+		return m.Growing
+	}
+	return false
+}
+
+func (m *SegmentSearchCost) GetIndexed() bool {
+	if m != nil {
+		return m.Indexed
+	}
+	return false
+}
+
+func (m *SegmentSearchCost) GetCostMs() int64 {
+	if m != nil {
+		return m.CostMs
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("milvus.proto.internal.RateType", RateType_name, RateType_value)
 	proto.RegisterType((*GetTimeTickChannelRequest)(nil), "milvus.proto.internal.GetTimeTickChannelRequest")
@@ -1901,123 +1989,131 @@ func init() {
 	proto.RegisterType((*ShowConfigurationsRequest)(nil), "milvus.proto.internal.ShowConfigurationsRequest")
 	proto.RegisterType((*ShowConfigurationsResponse)(nil), "milvus.proto.internal.ShowConfigurationsResponse")
 	proto.RegisterType((*Rate)(nil), "milvus.proto.internal.Rate")
+	proto.RegisterType((*SegmentSearchCost)(nil), "milvus.proto.internal.SegmentSearchCost")
 }
 
 func init() { proto.RegisterFile("internal.proto", fileDescriptor_41f4a519b878ee3b) }
 
 var fileDescriptor_41f4a519b878ee3b = []byte{
-	// 1804 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x6f, 0x1c, 0x49,
-	0xf5, 0xff, 0xf6, 0xf4, 0xfc, 0x7c, 0x33, 0xb6, 0xdb, 0x15, 0x27, 0xdf, 0x8e, 0xb3, 0xbb, 0xf1,
-	0x36, 0x48, 0x98, 0x45, 0x1b, 0x2f, 0x5e, 0xed, 0x86, 0x03, 0x02, 0xc5, 0xee, 0xac, 0x35, 0xda,
-	0x71, 0x70, 0x7a, 0xa2, 0x95, 0xe0, 0xd2, 0xaa, 0x99, 0x2e, 0x8f, 0x8b, 0x74, 0x77, 0xb5, 0xab,
-	0xaa, 0x63, 0x3b, 0x67, 0x6e, 0x48, 0xdc, 0xb8, 0x20, 0xc1, 0x19, 0x21, 0x71, 0xe6, 0xc8, 0xdf,
-	0xc4, 0x75, 0xc5, 0x01, 0xd5, 0x8f, 0x9e, 0x1f, 0xf6, 0xc4, 0xb2, 0x1d, 0x01, 0xcb, 0xad, 0xea,
-	0xf3, 0x5e, 0xbd, 0xaa, 0x7a, 0x3f, 0x3e, 0xfd, 0xaa, 0x61, 0x95, 0xe6, 0x92, 0xf0, 0x1c, 0xa7,
-	0x4f, 0x0a, 0xce, 0x24, 0x43, 0xf7, 0x33, 0x9a, 0xbe, 0x29, 0x85, 0x99, 0x3d, 0xa9, 0x84, 0x9b,
-	0xbd, 0x31, 0xcb, 0x32, 0x96, 0x1b, 0x78, 0xb3, 0x27, 0xc6, 0x27, 0x24, 0xc3, 0x66, 0x16, 0x3c,
-	0x82, 0x87, 0x07, 0x44, 0xbe, 0xa2, 0x19, 0x79, 0x45, 0xc7, 0xaf, 0xf7, 0x4f, 0x70, 0x9e, 0x93,
-	0x34, 0x22, 0xa7, 0x25, 0x11, 0x32, 0xf8, 0x10, 0x1e, 0x1d, 0x10, 0x39, 0x94, 0x58, 0x52, 0x21,
-	0xe9, 0x58, 0x5c, 0x12, 0xdf, 0x87, 0x7b, 0x07, 0x44, 0x86, 0xc9, 0x25, 0xf8, 0x1b, 0x68, 0xbf,
-	0x60, 0x09, 0xe9, 0xe7, 0xc7, 0x0c, 0x7d, 0x09, 0x2d, 0x9c, 0x24, 0x9c, 0x08, 0xe1, 0x3b, 0x5b,
-	0xce, 0x76, 0x77, 0xf7, 0x83, 0x27, 0x0b, 0x67, 0xb4, 0x27, 0x7b, 0x66, 0x74, 0xa2, 0x4a, 0x19,
-	0x21, 0xa8, 0x73, 0x96, 0x12, 0xbf, 0xb6, 0xe5, 0x6c, 0x77, 0x22, 0x3d, 0x0e, 0x7e, 0x0d, 0xd0,
-	0xcf, 0xa9, 0x3c, 0xc2, 0x1c, 0x67, 0x02, 0x3d, 0x80, 0x66, 0xae, 0x76, 0x09, 0xb5, 0x61, 0x37,
-	0xb2, 0x33, 0x14, 0x42, 0x4f, 0x48, 0xcc, 0x65, 0x5c, 0x68, 0x3d, 0xbf, 0xb6, 0xe5, 0x6e, 0x77,
-	0x77, 0x3f, 0x5e, 0xba, 0xed, 0xd7, 0xe4, 0xe2, 0x1b, 0x9c, 0x96, 0xe4, 0x08, 0x53, 0x1e, 0x75,
-	0xf5, 0x32, 0x63, 0x3d, 0xf8, 0x25, 0xc0, 0x50, 0x72, 0x9a, 0x4f, 0x06, 0x54, 0x48, 0xb5, 0xd7,
-	0x1b, 0xa5, 0xa7, 0x2e, 0xe1, 0x6e, 0x77, 0x22, 0x3b, 0x43, 0x9f, 0x43, 0x53, 0x48, 0x2c, 0x4b,
-	0xa1, 0xcf, 0xd9, 0xdd, 0x7d, 0xb4, 0x74, 0x97, 0xa1, 0x56, 0x89, 0xac, 0x6a, 0xf0, 0xd7, 0x1a,
-	0x6c, 0x2c, 0x78, 0xd5, 0xfa, 0x0d, 0x7d, 0x06, 0xf5, 0x11, 0x16, 0xe4, 0x5a, 0x47, 0x1d, 0x8a,
-	0xc9, 0x1e, 0x16, 0x24, 0xd2, 0x9a, 0xca, 0x4b, 0xc9, 0xa8, 0x1f, 0xea, 0xdd, 0xdd, 0x48, 0x8f,
-	0x51, 0x00, 0xbd, 0x31, 0x4b, 0x53, 0x32, 0x96, 0x94, 0xe5, 0xfd, 0xd0, 0x77, 0xb5, 0x6c, 0x01,
-	0x53, 0x3a, 0x05, 0xe6, 0x92, 0x9a, 0xa9, 0xf0, 0xeb, 0x5b, 0xae, 0xd2, 0x99, 0xc7, 0xd0, 0x0f,
-	0xc1, 0x93, 0x1c, 0xbf, 0x21, 0x69, 0x2c, 0x69, 0x46, 0x84, 0xc4, 0x59, 0xe1, 0x37, 0xb6, 0x9c,
-	0xed, 0x7a, 0xb4, 0x66, 0xf0, 0x57, 0x15, 0x8c, 0x76, 0xe0, 0xde, 0xa4, 0xc4, 0x1c, 0xe7, 0x92,
-	0x90, 0x39, 0xed, 0xa6, 0xd6, 0x46, 0x53, 0xd1, 0x6c, 0xc1, 0x8f, 0x60, 0x5d, 0xa9, 0xb1, 0x52,
-	0xce, 0xa9, 0xb7, 0xb4, 0xba, 0x67, 0x05, 0x53, 0xe5, 0xe0, 0x6f, 0x0e, 0xdc, 0xbf, 0xe4, 0x2f,
-	0x51, 0xb0, 0x5c, 0x90, 0x3b, 0x38, 0xec, 0x2e, 0x01, 0x43, 0x4f, 0xa1, 0xa1, 0x46, 0xc2, 0x77,
-	0x6f, 0x9a, 0x4a, 0x46, 0x3f, 0xf8, 0x93, 0x03, 0x68, 0x9f, 0x13, 0x2c, 0xc9, 0xb3, 0x94, 0xe2,
-	0xf7, 0x88, 0xf3, 0xff, 0x43, 0x2b, 0x19, 0xc5, 0x39, 0xce, 0xaa, 0x82, 0x68, 0x26, 0xa3, 0x17,
-	0x38, 0x23, 0xe8, 0x07, 0xb0, 0x36, 0x0b, 0xac, 0x51, 0x70, 0xb5, 0xc2, 0xea, 0x0c, 0xd6, 0x8a,
-	0x1b, 0xd0, 0xc0, 0xea, 0x0c, 0x7e, 0x5d, 0x8b, 0xcd, 0x24, 0x10, 0xe0, 0x85, 0x9c, 0x15, 0xff,
-	0xae, 0xd3, 0x4d, 0x37, 0x75, 0xe7, 0x37, 0xfd, 0xa3, 0x03, 0xeb, 0xcf, 0x52, 0x49, 0xf8, 0x77,
-	0xd4, 0x29, 0x7f, 0xaf, 0x55, 0x51, 0xeb, 0xe7, 0x09, 0x39, 0xff, 0x6f, 0x1e, 0xf0, 0x43, 0x80,
-	0x63, 0x4a, 0xd2, 0xc4, 0xe8, 0x98, 0x53, 0x76, 0x34, 0xa2, 0xc5, 0x55, 0xf9, 0x37, 0xae, 0x29,
-	0xff, 0xe6, 0x92, 0xf2, 0xf7, 0xa1, 0xa5, 0x8d, 0xf4, 0x43, 0x5d, 0x74, 0x6e, 0x54, 0x4d, 0x15,
-	0x79, 0x92, 0x73, 0xc9, 0x71, 0x45, 0x9e, 0xed, 0x1b, 0x93, 0xa7, 0x5e, 0x66, 0xc9, 0xf3, 0xdb,
-	0x3a, 0xac, 0x0c, 0x09, 0xe6, 0xe3, 0x93, 0xbb, 0x3b, 0x6f, 0x03, 0x1a, 0x9c, 0x9c, 0x4e, 0xb9,
-	0xcd, 0x4c, 0xa6, 0x37, 0x76, 0xaf, 0xb9, 0x71, 0xfd, 0x06, 0x84, 0xd7, 0x58, 0x42, 0x78, 0x1e,
-	0xb8, 0x89, 0x48, 0xb5, 0xc3, 0x3a, 0x91, 0x1a, 0x2a, 0x9a, 0x2a, 0x52, 0x3c, 0x26, 0x27, 0x2c,
-	0x4d, 0x08, 0x8f, 0x27, 0x9c, 0x95, 0x86, 0xa6, 0x7a, 0x91, 0x37, 0x27, 0x38, 0x50, 0x38, 0x7a,
-	0x0a, 0xed, 0x44, 0xa4, 0xb1, 0xbc, 0x28, 0x88, 0xdf, 0xde, 0x72, 0xb6, 0x57, 0xdf, 0x71, 0xcd,
-	0x50, 0xa4, 0xaf, 0x2e, 0x0a, 0x12, 0xb5, 0x12, 0x33, 0x40, 0x9f, 0xc1, 0x86, 0x20, 0x9c, 0xe2,
-	0x94, 0xbe, 0x25, 0x49, 0x4c, 0xce, 0x0b, 0x1e, 0x17, 0x29, 0xce, 0xfd, 0x8e, 0xde, 0x08, 0xcd,
-	0x64, 0xcf, 0xcf, 0x0b, 0x7e, 0x94, 0xe2, 0x1c, 0x6d, 0x83, 0xc7, 0x4a, 0x59, 0x94, 0x32, 0xd6,
-	0x71, 0x13, 0x31, 0x4d, 0x7c, 0xd0, 0x37, 0x5a, 0x35, 0xf8, 0x57, 0x1a, 0xee, 0x27, 0x4b, 0x49,
-	0xbc, 0x7b, 0x2b, 0x12, 0xef, 0xdd, 0x8e, 0xc4, 0x57, 0x96, 0x93, 0x38, 0x5a, 0x85, 0x5a, 0x7e,
-	0xea, 0xaf, 0xea, 0xd0, 0xd4, 0xf2, 0x53, 0x15, 0x48, 0xc9, 0x8a, 0xd7, 0xfe, 0x9a, 0x09, 0xa4,
-	0x1a, 0xa3, 0x8f, 0x00, 0x32, 0x22, 0x39, 0x1d, 0x2b, 0xb7, 0xf8, 0x9e, 0x8e, 0xc3, 0x1c, 0x82,
-	0xbe, 0x0f, 0x2b, 0x74, 0x92, 0x33, 0x4e, 0x0e, 0x38, 0x3b, 0xa3, 0xf9, 0xc4, 0x5f, 0xdf, 0x72,
-	0xb6, 0xdb, 0xd1, 0x22, 0x18, 0xfc, 0xd3, 0x9d, 0x25, 0x9f, 0x28, 0x53, 0x29, 0xfe, 0x53, 0x9f,
-	0x89, 0x69, 0xc6, 0xba, 0xf3, 0x19, 0xfb, 0x18, 0xba, 0xe6, 0x0a, 0x26, 0x33, 0xea, 0x57, 0x6e,
-	0xf5, 0x18, 0xba, 0x79, 0x99, 0xc5, 0xa7, 0x25, 0xe1, 0x94, 0x08, 0x5b, 0xcb, 0x90, 0x97, 0xd9,
-	0x4b, 0x83, 0xa0, 0x7b, 0xd0, 0x90, 0xac, 0x88, 0x5f, 0xdb, 0x52, 0x56, 0xbe, 0xfa, 0x1a, 0xfd,
-	0x14, 0x36, 0x05, 0xc1, 0x29, 0x49, 0x62, 0x41, 0x26, 0x19, 0xc9, 0x65, 0x3f, 0x14, 0xb1, 0xd0,
-	0xd7, 0x26, 0x89, 0xdf, 0xd2, 0xc9, 0xe0, 0x1b, 0x8d, 0xe1, 0x54, 0x61, 0x68, 0xe5, 0x2a, 0xd6,
-	0x63, 0xd3, 0xb3, 0x2d, 0x2c, 0x6b, 0xeb, 0xe6, 0x06, 0xcd, 0x44, 0xd3, 0x05, 0x3f, 0x01, 0x7f,
-	0x92, 0xb2, 0x11, 0x4e, 0xe3, 0x2b, 0xbb, 0xfa, 0x1d, 0xbd, 0xd9, 0x03, 0x23, 0x1f, 0x5e, 0xda,
-	0x52, 0x5d, 0x4f, 0xa4, 0x74, 0x4c, 0x92, 0x78, 0x94, 0xb2, 0x91, 0x0f, 0x3a, 0xa9, 0xc1, 0x40,
-	0x7b, 0x29, 0x1b, 0xa9, 0x64, 0xb6, 0x0a, 0xca, 0x0d, 0x63, 0x56, 0xe6, 0x52, 0xa7, 0xa8, 0x1b,
-	0xad, 0x1a, 0xfc, 0x45, 0x99, 0xed, 0x2b, 0x14, 0x7d, 0x0f, 0x56, 0xac, 0x26, 0x3b, 0x3e, 0x16,
-	0x44, 0xea, 0xdc, 0x74, 0xa3, 0x9e, 0x01, 0x7f, 0xa1, 0xb1, 0xe0, 0x1f, 0x2e, 0xac, 0x45, 0xca,
-	0xbb, 0xe4, 0x0d, 0xf9, 0x5f, 0x62, 0x9f, 0x77, 0xb1, 0x40, 0xf3, 0x56, 0x2c, 0xd0, 0xba, 0x31,
-	0x0b, 0xb4, 0x6f, 0xc5, 0x02, 0x9d, 0xdb, 0xb1, 0x00, 0xbc, 0x83, 0x05, 0x36, 0xa0, 0x91, 0xd2,
-	0x8c, 0x56, 0x01, 0x36, 0x93, 0xab, 0x75, 0xdd, 0x5b, 0x52, 0xd7, 0xe8, 0x21, 0xb4, 0xa9, 0xb0,
-	0xf9, 0xb1, 0xa2, 0x15, 0x5a, 0x54, 0xe8, 0xc4, 0x08, 0xfe, 0xbc, 0x10, 0xf3, 0xef, 0x40, 0xd1,
-	0x7f, 0x02, 0x2e, 0x4d, 0x4c, 0x5b, 0xd1, 0xdd, 0xf5, 0x17, 0xed, 0xd8, 0xd7, 0x57, 0x3f, 0x14,
-	0x91, 0x52, 0x42, 0x3f, 0x87, 0xae, 0x8d, 0x5f, 0x82, 0x25, 0xd6, 0xb9, 0xd1, 0xdd, 0xfd, 0x68,
-	0xe9, 0x1a, 0x1d, 0xd0, 0x10, 0x4b, 0x1c, 0x99, 0xb6, 0x40, 0xa8, 0x31, 0xfa, 0x19, 0x3c, 0xba,
-	0x4a, 0x05, 0xdc, 0xba, 0x23, 0xf1, 0x9b, 0x3a, 0x25, 0x1e, 0x5e, 0xe6, 0x82, 0xca, 0x5f, 0x09,
-	0xfa, 0x31, 0x6c, 0xcc, 0x91, 0xc1, 0x6c, 0x61, 0x4b, 0xb3, 0xc1, 0x1c, 0x51, 0xcc, 0x96, 0x5c,
-	0x47, 0x07, 0xed, 0xeb, 0xe8, 0x20, 0xf8, 0xd6, 0x81, 0xce, 0x80, 0xe1, 0x44, 0xb7, 0x56, 0x77,
-	0x08, 0xd2, 0x07, 0xd0, 0x99, 0xee, 0x65, 0x8b, 0x73, 0x06, 0x28, 0xe9, 0xb4, 0x3b, 0xb2, 0x2d,
-	0xd5, 0x5c, 0xbb, 0x34, 0xd7, 0xf6, 0xd4, 0x17, 0xdb, 0x9e, 0xc7, 0xd0, 0xa5, 0xea, 0x40, 0x71,
-	0x81, 0xe5, 0x89, 0xa9, 0xcf, 0x4e, 0x04, 0x1a, 0x3a, 0x52, 0x88, 0xea, 0x8b, 0x2a, 0x05, 0xdd,
-	0x17, 0x35, 0x6f, 0xdc, 0x17, 0x59, 0x23, 0xba, 0x2f, 0xfa, 0x8d, 0xa3, 0x5e, 0xb0, 0x09, 0x39,
-	0x57, 0x49, 0x74, 0xd5, 0xa8, 0x73, 0x17, 0xa3, 0x8a, 0x38, 0x14, 0x71, 0x72, 0x92, 0x62, 0x39,
-	0x8b, 0x84, 0xb0, 0xce, 0x41, 0x79, 0x99, 0x45, 0x46, 0x64, 0xa3, 0x20, 0x82, 0xdf, 0x39, 0x00,
-	0x3a, 0x95, 0xcc, 0x31, 0x2e, 0x33, 0x98, 0x73, 0x7d, 0xc7, 0x58, 0x5b, 0x74, 0xdd, 0x5e, 0xe5,
-	0xba, 0x6b, 0x9e, 0x48, 0xd3, 0xbf, 0x14, 0xb3, 0xcb, 0x5b, 0xef, 0xea, 0x71, 0xf0, 0x7b, 0x07,
-	0x7a, 0xf6, 0x74, 0xe6, 0x48, 0x0b, 0x51, 0x76, 0x2e, 0x47, 0x59, 0x7f, 0x52, 0x33, 0xc6, 0x2f,
-	0x62, 0x41, 0xdf, 0x12, 0x7b, 0x20, 0x30, 0xd0, 0x90, 0xbe, 0x25, 0x8a, 0x2a, 0xb4, 0x4b, 0xd8,
-	0x99, 0xb0, 0x75, 0xd9, 0x52, 0x6e, 0x60, 0x67, 0x42, 0xd1, 0x15, 0x27, 0x63, 0x92, 0xcb, 0xf4,
-	0x22, 0xce, 0x58, 0x42, 0x8f, 0x29, 0x49, 0x74, 0x36, 0xb4, 0x23, 0xaf, 0x12, 0x1c, 0x5a, 0x5c,
-	0xbd, 0x3c, 0x91, 0xfd, 0xb7, 0x51, 0xfd, 0x20, 0x39, 0x14, 0x93, 0x3b, 0x64, 0xad, 0x72, 0xb1,
-	0xb1, 0xa3, 0x12, 0xd1, 0xfc, 0x93, 0xe8, 0x44, 0x0b, 0x98, 0xea, 0x7e, 0xa6, 0x04, 0x6a, 0xfc,
-	0x58, 0x8f, 0xe6, 0x10, 0x75, 0xf2, 0x84, 0x1c, 0xe3, 0x32, 0x9d, 0x27, 0xda, 0xba, 0x21, 0x5a,
-	0x2b, 0x58, 0x78, 0x33, 0xaf, 0xee, 0x73, 0x92, 0x90, 0x5c, 0x52, 0x9c, 0xea, 0x3f, 0x31, 0x9b,
-	0xd0, 0x2e, 0x85, 0x0a, 0x43, 0x66, 0x4e, 0xde, 0x89, 0xa6, 0x73, 0xf4, 0x29, 0x20, 0x92, 0x8f,
-	0xf9, 0x45, 0xa1, 0x32, 0xa8, 0xc0, 0x42, 0x9c, 0x31, 0x9e, 0xd8, 0x47, 0xcb, 0xfa, 0x54, 0x72,
-	0x64, 0x05, 0xe8, 0x01, 0x34, 0x25, 0xc9, 0x71, 0x2e, 0x6d, 0x8d, 0xd9, 0x99, 0xa5, 0x68, 0x51,
-	0x16, 0x84, 0x5b, 0x9f, 0xb6, 0xa8, 0x18, 0xaa, 0xa9, 0x7a, 0xf2, 0x88, 0x13, 0xbc, 0xfb, 0xc5,
-	0x97, 0x33, 0xf3, 0x0d, 0xf3, 0xe4, 0x31, 0x70, 0x65, 0x3b, 0x78, 0x0e, 0xeb, 0x03, 0x2a, 0xe4,
-	0x11, 0x4b, 0xe9, 0xf8, 0xe2, 0xce, 0x1f, 0xf0, 0xe0, 0xb7, 0x0e, 0xa0, 0x79, 0x3b, 0xf6, 0x8f,
-	0xc1, 0x8c, 0xe3, 0x9d, 0x9b, 0x73, 0xfc, 0xc7, 0xd0, 0x2b, 0xb4, 0x99, 0x98, 0xe6, 0xc7, 0xac,
-	0x8a, 0x5e, 0xd7, 0x60, 0xca, 0xb7, 0x42, 0x3d, 0xd4, 0x94, 0x33, 0x63, 0xce, 0x52, 0x62, 0x82,
-	0xd7, 0x89, 0x3a, 0x0a, 0x89, 0x14, 0x10, 0x4c, 0xe0, 0xe1, 0xf0, 0x84, 0x9d, 0xed, 0xb3, 0xfc,
-	0x98, 0x4e, 0x4a, 0x8e, 0x55, 0x55, 0xbd, 0xc7, 0xcb, 0xd7, 0x87, 0x56, 0x81, 0xa5, 0xaa, 0x29,
-	0x1b, 0xa3, 0x6a, 0x1a, 0xfc, 0xc1, 0x81, 0xcd, 0x65, 0x3b, 0xbd, 0xcf, 0xf5, 0x0f, 0x60, 0x65,
-	0x6c, 0xcc, 0x19, 0x6b, 0x37, 0xff, 0xa3, 0xb6, 0xb8, 0x2e, 0x78, 0x0e, 0xf5, 0x08, 0x4b, 0x82,
-	0x76, 0xa0, 0xc6, 0xa5, 0x3e, 0xc1, 0xea, 0xee, 0xe3, 0x77, 0x30, 0x85, 0x52, 0xd4, 0xcf, 0xa4,
-	0x1a, 0x97, 0xa8, 0x07, 0x0e, 0xd7, 0x37, 0x75, 0x22, 0x87, 0x7f, 0xf2, 0x17, 0x07, 0xda, 0x95,
-	0x18, 0xad, 0xc3, 0x4a, 0x18, 0x0e, 0xf6, 0xa7, 0x5c, 0xe5, 0xfd, 0x1f, 0xf2, 0xa0, 0x17, 0x86,
-	0x83, 0xa3, 0xaa, 0xb9, 0xf2, 0x1c, 0xd4, 0x83, 0x76, 0x18, 0x0e, 0x34, 0xf9, 0x78, 0x35, 0x3b,
-	0xfb, 0x2a, 0x2d, 0xc5, 0x89, 0xe7, 0x4e, 0x0d, 0x64, 0x05, 0x36, 0x06, 0xea, 0x68, 0x05, 0x3a,
-	0xe1, 0xe1, 0xa0, 0x9f, 0x0b, 0xc2, 0xa5, 0xd7, 0xb0, 0xd3, 0x90, 0xa4, 0x44, 0x12, 0xaf, 0x89,
-	0xd6, 0xa0, 0x1b, 0x1e, 0x0e, 0xf6, 0xca, 0xf4, 0xb5, 0xfa, 0x8e, 0x79, 0x2d, 0x2d, 0x7f, 0x39,
-	0x30, 0xad, 0xb2, 0xd7, 0xd6, 0xe6, 0x5f, 0x0e, 0x54, 0xf3, 0x7e, 0xe1, 0x75, 0xf6, 0x9e, 0xfe,
-	0xea, 0x8b, 0x09, 0x95, 0x27, 0xe5, 0x48, 0x39, 0x68, 0xc7, 0xdc, 0xf5, 0x53, 0xca, 0xec, 0x68,
-	0xa7, 0xba, 0xef, 0x8e, 0xbe, 0xfe, 0x74, 0x5a, 0x8c, 0x46, 0x4d, 0x8d, 0x7c, 0xfe, 0xaf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x82, 0xcb, 0xf7, 0x4a, 0xe3, 0x15, 0x00, 0x00,
+	// 1909 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6f, 0x24, 0x47,
+	0x15, 0xa7, 0xa7, 0xe7, 0xf3, 0xcd, 0x8c, 0x3d, 0xae, 0xf5, 0x86, 0x5e, 0x3b, 0xc9, 0x3a, 0x0d,
+	0x12, 0x26, 0x28, 0x76, 0x70, 0x94, 0x2c, 0x07, 0x04, 0x5a, 0xbb, 0x37, 0xd6, 0x28, 0xf6, 0xe2,
+	0xed, 0xb1, 0x22, 0xc1, 0xa5, 0x55, 0x33, 0x5d, 0x1e, 0x17, 0xdb, 0xdd, 0xd5, 0xae, 0xaa, 0x5e,
+	0xdb, 0x7b, 0xe0, 0xc4, 0x0d, 0xc4, 0x8d, 0x0b, 0x12, 0x9c, 0x11, 0x12, 0x67, 0x8e, 0xfc, 0x4d,
+	0x5c, 0x73, 0x42, 0xf5, 0xd1, 0xf3, 0x61, 0xcf, 0x1a, 0xdb, 0x2b, 0x60, 0x73, 0xeb, 0xf7, 0x7b,
+	0xaf, 0x5e, 0x55, 0xbd, 0xf7, 0xea, 0xd7, 0xaf, 0x0a, 0x96, 0x68, 0x26, 0x09, 0xcf, 0x70, 0xb2,
+	0x95, 0x73, 0x26, 0x19, 0x7a, 0x98, 0xd2, 0xe4, 0x55, 0x21, 0x8c, 0xb4, 0x55, 0x2a, 0xd7, 0x3a,
+	0x23, 0x96, 0xa6, 0x2c, 0x33, 0xf0, 0x5a, 0x47, 0x8c, 0x4e, 0x49, 0x8a, 0x8d, 0xe4, 0xaf, 0xc3,
+	0xa3, 0x7d, 0x22, 0x8f, 0x69, 0x4a, 0x8e, 0xe9, 0xe8, 0xe5, 0xde, 0x29, 0xce, 0x32, 0x92, 0x84,
+	0xe4, 0xac, 0x20, 0x42, 0xfa, 0x1f, 0xc0, 0xfa, 0x3e, 0x91, 0x03, 0x89, 0x25, 0x15, 0x92, 0x8e,
+	0xc4, 0x15, 0xf5, 0x43, 0x78, 0xb0, 0x4f, 0x64, 0x10, 0x5f, 0x81, 0xbf, 0x86, 0xe6, 0x73, 0x16,
+	0x93, 0x7e, 0x76, 0xc2, 0xd0, 0x17, 0xd0, 0xc0, 0x71, 0xcc, 0x89, 0x10, 0x9e, 0xb3, 0xe1, 0x6c,
+	0xb6, 0x77, 0xde, 0xdf, 0x9a, 0x5b, 0xa3, 0x5d, 0xd9, 0x53, 0x63, 0x13, 0x96, 0xc6, 0x08, 0x41,
+	0x95, 0xb3, 0x84, 0x78, 0x95, 0x0d, 0x67, 0xb3, 0x15, 0xea, 0x6f, 0xff, 0xd7, 0x00, 0xfd, 0x8c,
+	0xca, 0x23, 0xcc, 0x71, 0x2a, 0xd0, 0x7b, 0x50, 0xcf, 0xd4, 0x2c, 0x81, 0x76, 0xec, 0x86, 0x56,
+	0x42, 0x01, 0x74, 0x84, 0xc4, 0x5c, 0x46, 0xb9, 0xb6, 0xf3, 0x2a, 0x1b, 0xee, 0x66, 0x7b, 0xe7,
+	0xa3, 0x85, 0xd3, 0x7e, 0x45, 0x2e, 0xbf, 0xc6, 0x49, 0x41, 0x8e, 0x30, 0xe5, 0x61, 0x5b, 0x0f,
+	0x33, 0xde, 0xfd, 0x5f, 0x02, 0x0c, 0x24, 0xa7, 0xd9, 0xf8, 0x80, 0x0a, 0xa9, 0xe6, 0x7a, 0xa5,
+	0xec, 0xd4, 0x26, 0xdc, 0xcd, 0x56, 0x68, 0x25, 0xf4, 0x19, 0xd4, 0x85, 0xc4, 0xb2, 0x10, 0x7a,
+	0x9d, 0xed, 0x9d, 0xf5, 0x85, 0xb3, 0x0c, 0xb4, 0x49, 0x68, 0x4d, 0xfd, 0xbf, 0x57, 0x60, 0x75,
+	0x2e, 0xaa, 0x36, 0x6e, 0xe8, 0x53, 0xa8, 0x0e, 0xb1, 0x20, 0x37, 0x06, 0xea, 0x50, 0x8c, 0x77,
+	0xb1, 0x20, 0xa1, 0xb6, 0x54, 0x51, 0x8a, 0x87, 0xfd, 0x40, 0xcf, 0xee, 0x86, 0xfa, 0x1b, 0xf9,
+	0xd0, 0x19, 0xb1, 0x24, 0x21, 0x23, 0x49, 0x59, 0xd6, 0x0f, 0x3c, 0x57, 0xeb, 0xe6, 0x30, 0x65,
+	0x93, 0x63, 0x2e, 0xa9, 0x11, 0x85, 0x57, 0xdd, 0x70, 0x95, 0xcd, 0x2c, 0x86, 0x7e, 0x08, 0x3d,
+	0xc9, 0xf1, 0x2b, 0x92, 0x44, 0x92, 0xa6, 0x44, 0x48, 0x9c, 0xe6, 0x5e, 0x6d, 0xc3, 0xd9, 0xac,
+	0x86, 0xcb, 0x06, 0x3f, 0x2e, 0x61, 0xb4, 0x0d, 0x0f, 0xc6, 0x05, 0xe6, 0x38, 0x93, 0x84, 0xcc,
+	0x58, 0xd7, 0xb5, 0x35, 0x9a, 0xa8, 0xa6, 0x03, 0x7e, 0x04, 0x2b, 0xca, 0x8c, 0x15, 0x72, 0xc6,
+	0xbc, 0xa1, 0xcd, 0x7b, 0x56, 0x31, 0x31, 0xf6, 0xff, 0xe1, 0xc0, 0xc3, 0x2b, 0xf1, 0x12, 0x39,
+	0xcb, 0x04, 0xb9, 0x47, 0xc0, 0xee, 0x93, 0x30, 0xf4, 0x04, 0x6a, 0xea, 0x4b, 0x78, 0xee, 0x6d,
+	0x4b, 0xc9, 0xd8, 0xfb, 0x7f, 0x71, 0x00, 0xed, 0x71, 0x82, 0x25, 0x79, 0x9a, 0x50, 0xfc, 0x16,
+	0x79, 0xfe, 0x2e, 0x34, 0xe2, 0x61, 0x94, 0xe1, 0xb4, 0x3c, 0x10, 0xf5, 0x78, 0xf8, 0x1c, 0xa7,
+	0x04, 0xfd, 0x00, 0x96, 0xa7, 0x89, 0x35, 0x06, 0xae, 0x36, 0x58, 0x9a, 0xc2, 0xda, 0x70, 0x15,
+	0x6a, 0x58, 0xad, 0xc1, 0xab, 0x6a, 0xb5, 0x11, 0x7c, 0x01, 0xbd, 0x80, 0xb3, 0xfc, 0xbf, 0xb5,
+	0xba, 0xc9, 0xa4, 0xee, 0xec, 0xa4, 0x7f, 0x76, 0x60, 0xe5, 0x69, 0x22, 0x09, 0x7f, 0x47, 0x83,
+	0xf2, 0xcf, 0x4a, 0x99, 0xb5, 0x7e, 0x16, 0x93, 0x8b, 0xff, 0xe7, 0x02, 0x3f, 0x00, 0x38, 0xa1,
+	0x24, 0x89, 0x8d, 0x8d, 0x59, 0x65, 0x4b, 0x23, 0x5a, 0x5d, 0x1e, 0xff, 0xda, 0x0d, 0xc7, 0xbf,
+	0xbe, 0xe0, 0xf8, 0x7b, 0xd0, 0xd0, 0x4e, 0xfa, 0x81, 0x3e, 0x74, 0x6e, 0x58, 0x8a, 0x8a, 0x3c,
+	0xc9, 0x85, 0xe4, 0xb8, 0x24, 0xcf, 0xe6, 0xad, 0xc9, 0x53, 0x0f, 0xb3, 0xe4, 0xf9, 0x4d, 0x15,
+	0xba, 0x03, 0x82, 0xf9, 0xe8, 0xf4, 0xfe, 0xc1, 0x5b, 0x85, 0x1a, 0x27, 0x67, 0x13, 0x6e, 0x33,
+	0xc2, 0x64, 0xc7, 0xee, 0x0d, 0x3b, 0xae, 0xde, 0x82, 0xf0, 0x6a, 0x0b, 0x08, 0xaf, 0x07, 0x6e,
+	0x2c, 0x12, 0x1d, 0xb0, 0x56, 0xa8, 0x3e, 0x15, 0x4d, 0xe5, 0x09, 0x1e, 0x91, 0x53, 0x96, 0xc4,
+	0x84, 0x47, 0x63, 0xce, 0x0a, 0x43, 0x53, 0x9d, 0xb0, 0x37, 0xa3, 0xd8, 0x57, 0x38, 0x7a, 0x02,
+	0xcd, 0x58, 0x24, 0x91, 0xbc, 0xcc, 0x89, 0xd7, 0xdc, 0x70, 0x36, 0x97, 0xde, 0xb0, 0xcd, 0x40,
+	0x24, 0xc7, 0x97, 0x39, 0x09, 0x1b, 0xb1, 0xf9, 0x40, 0x9f, 0xc2, 0xaa, 0x20, 0x9c, 0xe2, 0x84,
+	0xbe, 0x26, 0x71, 0x44, 0x2e, 0x72, 0x1e, 0xe5, 0x09, 0xce, 0xbc, 0x96, 0x9e, 0x08, 0x4d, 0x75,
+	0xcf, 0x2e, 0x72, 0x7e, 0x94, 0xe0, 0x0c, 0x6d, 0x42, 0x8f, 0x15, 0x32, 0x2f, 0x64, 0xa4, 0xf3,
+	0x26, 0x22, 0x1a, 0x7b, 0xa0, 0x77, 0xb4, 0x64, 0xf0, 0x2f, 0x35, 0xdc, 0x8f, 0x17, 0x92, 0x78,
+	0xfb, 0x4e, 0x24, 0xde, 0xb9, 0x1b, 0x89, 0x77, 0x17, 0x93, 0x38, 0x5a, 0x82, 0x4a, 0x76, 0xe6,
+	0x2d, 0xe9, 0xd4, 0x54, 0xb2, 0x33, 0x95, 0x48, 0xc9, 0xf2, 0x97, 0xde, 0xb2, 0x49, 0xa4, 0xfa,
+	0x46, 0x1f, 0x02, 0xa4, 0x44, 0x72, 0x3a, 0x52, 0x61, 0xf1, 0x7a, 0x3a, 0x0f, 0x33, 0x08, 0xfa,
+	0x3e, 0x74, 0xe9, 0x38, 0x63, 0x9c, 0xec, 0x73, 0x76, 0x4e, 0xb3, 0xb1, 0xb7, 0xb2, 0xe1, 0x6c,
+	0x36, 0xc3, 0x79, 0xd0, 0xff, 0x7d, 0x6d, 0x5a, 0x7c, 0xa2, 0x48, 0xa4, 0xf8, 0x5f, 0xfd, 0x26,
+	0x26, 0x15, 0xeb, 0xce, 0x56, 0xec, 0x63, 0x68, 0x9b, 0x2d, 0x98, 0xca, 0xa8, 0x5e, 0xdb, 0xd5,
+	0x63, 0x68, 0x67, 0x45, 0x1a, 0x9d, 0x15, 0x84, 0x53, 0x22, 0xec, 0x59, 0x86, 0xac, 0x48, 0x5f,
+	0x18, 0x04, 0x3d, 0x80, 0x9a, 0x64, 0x79, 0xf4, 0xd2, 0xab, 0x4f, 0x62, 0xf5, 0x15, 0xfa, 0x29,
+	0xac, 0x09, 0x82, 0x13, 0x12, 0x47, 0x82, 0x8c, 0x53, 0x92, 0xc9, 0x7e, 0x20, 0x22, 0xa1, 0xb7,
+	0x4d, 0x62, 0xaf, 0xa1, 0x8b, 0xc1, 0x33, 0x16, 0x83, 0x89, 0xc1, 0xc0, 0xea, 0x55, 0xae, 0x47,
+	0xa6, 0x67, 0x9b, 0x1b, 0xd6, 0xd4, 0xcd, 0x0d, 0x9a, 0xaa, 0x26, 0x03, 0x7e, 0x02, 0xde, 0x38,
+	0x61, 0x43, 0x9c, 0x44, 0xd7, 0x66, 0xf5, 0x5a, 0x7a, 0xb2, 0xf7, 0x8c, 0x7e, 0x70, 0x65, 0x4a,
+	0xb5, 0x3d, 0x91, 0xd0, 0x11, 0x89, 0xa3, 0x61, 0xc2, 0x86, 0x1e, 0xe8, 0xa2, 0x06, 0x03, 0xed,
+	0x26, 0x6c, 0xa8, 0x8a, 0xd9, 0x1a, 0xa8, 0x30, 0x8c, 0x58, 0x91, 0x49, 0x5d, 0xa2, 0x6e, 0xb8,
+	0x64, 0xf0, 0xe7, 0x45, 0xba, 0xa7, 0x50, 0xf4, 0x3d, 0xe8, 0x5a, 0x4b, 0x76, 0x72, 0x22, 0x88,
+	0xd4, 0xb5, 0xe9, 0x86, 0x1d, 0x03, 0xfe, 0x42, 0x63, 0xc8, 0x87, 0xee, 0x39, 0xa6, 0x32, 0x92,
+	0x02, 0x9f, 0x90, 0x28, 0x15, 0xba, 0x22, 0xdd, 0xb0, 0xad, 0xc0, 0x63, 0x85, 0x1d, 0x0a, 0xb4,
+	0x0e, 0x2d, 0x4e, 0xe2, 0x62, 0xa4, 0xf5, 0xa6, 0x26, 0x9b, 0x06, 0x38, 0x14, 0xe8, 0x10, 0xba,
+	0x76, 0x73, 0xd1, 0x88, 0x09, 0x29, 0xbc, 0x65, 0xcd, 0x81, 0x9b, 0x5b, 0x0b, 0x7b, 0xeb, 0x2d,
+	0xbb, 0x55, 0x13, 0xa9, 0x3d, 0x26, 0x64, 0xd8, 0xb1, 0xc3, 0x95, 0x20, 0xfc, 0x7f, 0xb9, 0xb0,
+	0x1c, 0xaa, 0x6c, 0x93, 0x57, 0xe4, 0xdb, 0xc4, 0x86, 0x6f, 0x62, 0xa5, 0xfa, 0x9d, 0x58, 0xa9,
+	0x71, 0x6b, 0x56, 0x6a, 0xde, 0x89, 0x95, 0x5a, 0x77, 0x63, 0x25, 0x78, 0x03, 0x2b, 0xad, 0x42,
+	0x2d, 0xa1, 0x29, 0x2d, 0x0b, 0xce, 0x08, 0xd7, 0x79, 0xa6, 0xb3, 0x80, 0x67, 0xd0, 0x23, 0x68,
+	0x52, 0x61, 0xeb, 0xb5, 0xab, 0x0d, 0x1a, 0x54, 0xe8, 0x42, 0xf5, 0xff, 0x3a, 0x97, 0xf3, 0x77,
+	0x80, 0x84, 0x3e, 0x06, 0x97, 0xc6, 0xa6, 0xcd, 0x69, 0xef, 0x78, 0xf3, 0x7e, 0xec, 0x6d, 0xb0,
+	0x1f, 0x88, 0x50, 0x19, 0xa1, 0x9f, 0x43, 0xdb, 0xe6, 0x2f, 0xc6, 0x12, 0xeb, 0xda, 0x68, 0xef,
+	0x7c, 0xb8, 0x70, 0x8c, 0x4e, 0x68, 0x80, 0x25, 0x0e, 0x4d, 0x9b, 0x22, 0xd4, 0x37, 0xfa, 0x19,
+	0xac, 0x5f, 0xa7, 0x26, 0x6e, 0xc3, 0x11, 0x7b, 0x75, 0x5d, 0x12, 0x8f, 0xae, 0x72, 0x53, 0x19,
+	0xaf, 0x18, 0xfd, 0x18, 0x56, 0x67, 0xc8, 0x69, 0x3a, 0xb0, 0xa1, 0xd9, 0x69, 0x86, 0xb8, 0xa6,
+	0x43, 0x6e, 0xa2, 0xa7, 0xe6, 0x4d, 0xf4, 0xe4, 0x7f, 0xe3, 0x40, 0xeb, 0x80, 0xe1, 0x58, 0xb7,
+	0x7a, 0xf7, 0x48, 0xd2, 0xfb, 0xd0, 0x9a, 0xcc, 0x65, 0x0f, 0xe7, 0x14, 0x50, 0xda, 0x49, 0xb7,
+	0x66, 0x5b, 0xbc, 0x29, 0x30, 0xdb, 0x86, 0x55, 0xe7, 0xdb, 0xb0, 0xc7, 0xd0, 0xa6, 0x6a, 0x41,
+	0x51, 0x8e, 0xe5, 0xa9, 0x39, 0x9f, 0xad, 0x10, 0x34, 0x74, 0xa4, 0x10, 0xd5, 0xa7, 0x95, 0x06,
+	0xba, 0x4f, 0xab, 0xdf, 0xba, 0x4f, 0xb3, 0x4e, 0x74, 0x9f, 0xf6, 0x5b, 0x47, 0xdd, 0xa8, 0x63,
+	0x72, 0xa1, 0x8a, 0xe8, 0xba, 0x53, 0xe7, 0x3e, 0x4e, 0x15, 0x71, 0x28, 0x22, 0xe7, 0x24, 0xc1,
+	0x72, 0x9a, 0x09, 0x61, 0x83, 0x83, 0xb2, 0x22, 0x0d, 0x8d, 0xca, 0x66, 0x41, 0xf8, 0x7f, 0x70,
+	0x00, 0x74, 0x29, 0x99, 0x65, 0x5c, 0x65, 0x30, 0xe7, 0xe6, 0x0e, 0xb6, 0x32, 0x1f, 0xba, 0xdd,
+	0x32, 0x74, 0x37, 0x5c, 0xd9, 0x26, 0xe4, 0x3d, 0xdd, 0xbc, 0x8d, 0xae, 0xfe, 0xf6, 0xff, 0xe8,
+	0x40, 0xa7, 0xe4, 0x75, 0xbd, 0xa4, 0xb9, 0x2c, 0x3b, 0x57, 0xb3, 0xac, 0x7f, 0xf1, 0x29, 0xe3,
+	0x97, 0x91, 0xa0, 0xaf, 0x89, 0x5d, 0x10, 0x18, 0x68, 0x40, 0x5f, 0x13, 0x45, 0x15, 0x3a, 0x24,
+	0xec, 0x5c, 0xd8, 0x73, 0xd9, 0x50, 0x61, 0x60, 0xe7, 0x42, 0xd1, 0x15, 0x27, 0x23, 0x92, 0xc9,
+	0xe4, 0x32, 0x4a, 0x59, 0x4c, 0x4f, 0x28, 0x89, 0x75, 0x35, 0x34, 0xc3, 0x5e, 0xa9, 0x38, 0xb4,
+	0xb8, 0xba, 0x09, 0x23, 0xfb, 0xd6, 0x52, 0x3e, 0xd8, 0x1c, 0x8a, 0xf1, 0x3d, 0xaa, 0x56, 0x85,
+	0xd8, 0xf8, 0x51, 0x85, 0x68, 0xde, 0x48, 0x5a, 0xe1, 0x1c, 0xa6, 0xba, 0xb1, 0x09, 0x81, 0x9a,
+	0x38, 0x56, 0xc3, 0x19, 0x44, 0xad, 0x3c, 0x26, 0x27, 0xb8, 0x48, 0x66, 0x89, 0xb6, 0x6a, 0x88,
+	0xd6, 0x2a, 0xe6, 0xee, 0xf0, 0x4b, 0x7b, 0x9c, 0xc4, 0x24, 0x93, 0x14, 0x27, 0xfa, 0x65, 0x68,
+	0x0d, 0x9a, 0x85, 0x50, 0x69, 0x48, 0xcd, 0xca, 0x5b, 0xe1, 0x44, 0x46, 0x9f, 0x00, 0x22, 0xd9,
+	0x88, 0x5f, 0xe6, 0xaa, 0x82, 0x72, 0x2c, 0xc4, 0x39, 0xe3, 0xb1, 0xbd, 0x44, 0xad, 0x4c, 0x34,
+	0x47, 0x56, 0xa1, 0x9e, 0x67, 0x24, 0xc9, 0x70, 0x26, 0xed, 0x19, 0xb3, 0x92, 0xa5, 0x68, 0x51,
+	0xe4, 0x84, 0xdb, 0x98, 0x36, 0xa8, 0x18, 0x28, 0x51, 0x5d, 0xc1, 0xc4, 0x29, 0xde, 0xf9, 0xfc,
+	0x8b, 0xa9, 0xfb, 0x9a, 0xb9, 0x82, 0x19, 0xb8, 0xf4, 0xed, 0x3f, 0x83, 0x15, 0xf5, 0x04, 0x74,
+	0xc4, 0x12, 0x3a, 0xba, 0xbc, 0xf7, 0x0f, 0xdc, 0xff, 0x9d, 0x03, 0x68, 0xd6, 0x8f, 0x7d, 0xc1,
+	0x98, 0x72, 0xbc, 0x73, 0x7b, 0x8e, 0xff, 0x08, 0x3a, 0xb9, 0x76, 0x13, 0xd1, 0xec, 0x84, 0x95,
+	0xd9, 0x6b, 0x1b, 0x4c, 0xc5, 0x56, 0xa8, 0x8b, 0xa3, 0x0a, 0x66, 0xc4, 0x59, 0x42, 0x4c, 0xf2,
+	0x5a, 0x61, 0x4b, 0x21, 0xa1, 0x02, 0xfc, 0x31, 0x3c, 0x1a, 0x9c, 0xb2, 0xf3, 0x3d, 0x96, 0x9d,
+	0xd0, 0x71, 0xc1, 0xb1, 0x3a, 0x55, 0x6f, 0x71, 0x13, 0xf7, 0xa0, 0x91, 0x63, 0xa9, 0xce, 0x94,
+	0xcd, 0x51, 0x29, 0xfa, 0x7f, 0x72, 0x60, 0x6d, 0xd1, 0x4c, 0x6f, 0xb3, 0xfd, 0x7d, 0xe8, 0x8e,
+	0x8c, 0x3b, 0xe3, 0xed, 0xf6, 0x2f, 0x7c, 0xf3, 0xe3, 0xfc, 0x67, 0x50, 0x0d, 0xb1, 0x24, 0x68,
+	0x1b, 0x2a, 0x5c, 0xea, 0x15, 0x2c, 0xed, 0x3c, 0x7e, 0x03, 0x53, 0x28, 0x43, 0x7d, 0x6d, 0xab,
+	0x70, 0x89, 0x3a, 0xe0, 0x70, 0xbd, 0x53, 0x27, 0x74, 0xb8, 0xff, 0x1b, 0x58, 0xb9, 0xd6, 0x04,
+	0xfe, 0x07, 0xc6, 0xf0, 0xa0, 0x31, 0xb6, 0xbd, 0x45, 0xc5, 0xd4, 0xa5, 0x15, 0x95, 0x46, 0x13,
+	0x11, 0x89, 0x3d, 0xd7, 0x56, 0xac, 0x11, 0xd5, 0x6b, 0x82, 0xea, 0x47, 0x55, 0xcb, 0x6a, 0xfe,
+	0x16, 0x75, 0x25, 0x1e, 0x8a, 0x8f, 0xff, 0xe6, 0x40, 0xb3, 0x5c, 0x1e, 0x5a, 0x81, 0x6e, 0x10,
+	0x1c, 0xec, 0x4d, 0xb8, 0xb2, 0xf7, 0x1d, 0xd4, 0x83, 0x4e, 0x10, 0x1c, 0x1c, 0x95, 0xcd, 0x5d,
+	0xcf, 0x41, 0x1d, 0x68, 0x06, 0xc1, 0x81, 0x26, 0xbf, 0x5e, 0xc5, 0x4a, 0x5f, 0x26, 0x85, 0x38,
+	0xed, 0xb9, 0x13, 0x07, 0x69, 0x8e, 0x8d, 0x83, 0x2a, 0xea, 0x42, 0x2b, 0x38, 0x3c, 0xe8, 0x67,
+	0x82, 0x70, 0xd9, 0xab, 0x59, 0x31, 0x20, 0x09, 0x91, 0xa4, 0x57, 0x47, 0xcb, 0xd0, 0x0e, 0x0e,
+	0x0f, 0x76, 0x8b, 0xe4, 0xa5, 0xfa, 0x8f, 0xf6, 0x1a, 0x5a, 0xff, 0xe2, 0xc0, 0xc4, 0xa2, 0xd7,
+	0xd4, 0xee, 0x5f, 0x1c, 0xa8, 0xcb, 0xcc, 0x65, 0xaf, 0xb5, 0xfb, 0xe4, 0x57, 0x9f, 0x8f, 0xa9,
+	0x3c, 0x2d, 0x86, 0x2a, 0x41, 0xdb, 0x26, 0xd6, 0x9f, 0x50, 0x66, 0xbf, 0xb6, 0xcb, 0x78, 0x6f,
+	0xeb, 0xf0, 0x4f, 0xc4, 0x7c, 0x38, 0xac, 0x6b, 0xe4, 0xb3, 0x7f, 0x0f, 0x00, 0x97, 0x7d, 0x9d,
+	0xa3, 0xf3, 0x16, 0x00, 0x00,
 }
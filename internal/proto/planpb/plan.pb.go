@@ -121,16 +121,24 @@ type UnaryExpr_UnaryOp int32
 const (
 	UnaryExpr_Invalid UnaryExpr_UnaryOp = 0
 	UnaryExpr_Not     UnaryExpr_UnaryOp = 1
+	// IsNull and IsNotNull check the validity of the column of child,
+	// which must be a column_expr of a nullable field.
+	UnaryExpr_IsNull    UnaryExpr_UnaryOp = 2
+	UnaryExpr_IsNotNull UnaryExpr_UnaryOp = 3
 )
 
 var UnaryExpr_UnaryOp_name = map[int32]string{
 	0: "Invalid",
 	1: "Not",
+	2: "IsNull",
+	3: "IsNotNull",
 }
 
 var UnaryExpr_UnaryOp_value = map[string]int32{
-	"Invalid": 0,
-	"Not":     1,
+	"Invalid":   0,
+	"Not":       1,
+	"IsNull":    2,
+	"IsNotNull": 3,
 }
 
 func (x UnaryExpr_UnaryOp) String() string {
@@ -1451,98 +1459,98 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 1477 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x3f, 0x73, 0xdb, 0xc6,
-	0x12, 0x27, 0x08, 0x52, 0x04, 0x96, 0x14, 0x05, 0xa3, 0x79, 0xb2, 0xfd, 0x6c, 0xe9, 0xe1, 0x79,
-	0x9e, 0xf5, 0xfc, 0xc6, 0xd2, 0xf8, 0xd9, 0xcf, 0x7e, 0x76, 0xc6, 0x89, 0xfe, 0x39, 0x22, 0x27,
-	0xb6, 0xa4, 0xc0, 0xb2, 0x8a, 0x34, 0x98, 0x23, 0x70, 0x12, 0x6f, 0x0c, 0xde, 0xc1, 0xc0, 0x81,
-	0xb6, 0xea, 0x74, 0xe9, 0xf2, 0x01, 0x52, 0xa7, 0x4f, 0x99, 0x26, 0x65, 0x9a, 0x14, 0x29, 0xd3,
-	0xe7, 0x23, 0xa4, 0x4b, 0x95, 0xb9, 0x3d, 0xf0, 0x9f, 0x86, 0xb4, 0xa8, 0x89, 0x67, 0xd2, 0x1d,
-	0xf6, 0x76, 0x7f, 0xb7, 0xfb, 0xdb, 0xbd, 0xbd, 0x05, 0x40, 0x12, 0x13, 0xbe, 0x9e, 0xa4, 0x42,
-	0x0a, 0xf7, 0x4a, 0x8f, 0xc5, 0xfd, 0x3c, 0xd3, 0x5f, 0xeb, 0x6a, 0xe3, 0x5a, 0x23, 0x0b, 0xbb,
-	0xb4, 0x47, 0xb4, 0xc8, 0xfb, 0xda, 0x80, 0xc6, 0x1e, 0xe5, 0x34, 0x65, 0xe1, 0x31, 0x89, 0x73,
-	0xea, 0x5e, 0x07, 0xab, 0x23, 0x44, 0x1c, 0xf4, 0x49, 0xbc, 0x6c, 0xac, 0x1a, 0x6b, 0x56, 0xab,
-	0xe4, 0xd7, 0x94, 0xe4, 0x98, 0xc4, 0xee, 0x0d, 0xb0, 0x19, 0x97, 0x0f, 0x1f, 0xe0, 0x6e, 0x79,
-	0xd5, 0x58, 0x33, 0x5b, 0x25, 0xdf, 0x42, 0x51, 0xb1, 0x7d, 0x12, 0x0b, 0x22, 0x71, 0xdb, 0x5c,
-	0x35, 0xd6, 0x0c, 0xb5, 0x8d, 0x22, 0xb5, 0xbd, 0x02, 0x90, 0xc9, 0x94, 0xf1, 0x53, 0xdc, 0xaf,
-	0xac, 0x1a, 0x6b, 0x76, 0xab, 0xe4, 0xdb, 0x5a, 0x76, 0x4c, 0xe2, 0xed, 0x2a, 0x98, 0x7d, 0x12,
-	0x7b, 0x5f, 0x19, 0x60, 0x7f, 0x9e, 0xd3, 0xf4, 0xac, 0xcd, 0x4f, 0x84, 0xeb, 0x42, 0x45, 0x8a,
-	0xe4, 0x35, 0x3a, 0x63, 0xfa, 0xb8, 0x76, 0x57, 0xa0, 0xde, 0xa3, 0x32, 0x65, 0x61, 0x20, 0xcf,
-	0x12, 0x8a, 0x47, 0xd9, 0x3e, 0x68, 0xd1, 0xd1, 0x59, 0x42, 0xdd, 0x7f, 0xc2, 0x62, 0x46, 0x49,
-	0x1a, 0x76, 0x83, 0x84, 0xa4, 0xa4, 0x97, 0xe9, 0xd3, 0xfc, 0x86, 0x16, 0x1e, 0xa2, 0x4c, 0x29,
-	0xa5, 0x22, 0xe7, 0x51, 0x10, 0xd1, 0x90, 0xf5, 0x48, 0xbc, 0x5c, 0xc5, 0x23, 0x1a, 0x28, 0xdc,
-	0xd5, 0x32, 0xef, 0x47, 0x03, 0x60, 0x47, 0xc4, 0x79, 0x8f, 0xa3, 0x37, 0x57, 0xc1, 0x3a, 0x61,
-	0x34, 0x8e, 0x02, 0x16, 0x15, 0x1e, 0xd5, 0xf0, 0xbb, 0x1d, 0xb9, 0x4f, 0xc0, 0x8e, 0x88, 0x24,
-	0xda, 0x25, 0x45, 0x4e, 0xf3, 0xbf, 0x37, 0xd6, 0x27, 0xf8, 0x2f, 0x98, 0xdf, 0x25, 0x92, 0x28,
-	0x2f, 0x7d, 0x2b, 0x2a, 0x56, 0xee, 0x2d, 0x68, 0xb2, 0x2c, 0x48, 0x52, 0xd6, 0x23, 0xe9, 0x59,
-	0xf0, 0x9a, 0x9e, 0x61, 0x4c, 0x96, 0xdf, 0x60, 0xd9, 0xa1, 0x16, 0x7e, 0x46, 0xcf, 0xdc, 0xeb,
-	0x60, 0xb3, 0x2c, 0x20, 0xb9, 0x14, 0xed, 0x5d, 0x8c, 0xc8, 0xf2, 0x2d, 0x96, 0x6d, 0xe1, 0xb7,
-	0xe2, 0x84, 0xd3, 0x4c, 0xd2, 0x28, 0x48, 0x88, 0xec, 0x2e, 0x57, 0x57, 0x4d, 0xc5, 0x89, 0x16,
-	0x1d, 0x12, 0xd9, 0xf5, 0x3e, 0x19, 0x04, 0xf2, 0xec, 0x5d, 0x92, 0xba, 0xf7, 0xa0, 0xc2, 0xf8,
-	0x89, 0xc0, 0x20, 0xea, 0xe7, 0x1d, 0xc5, 0x0a, 0x1a, 0x45, 0xed, 0xa3, 0xaa, 0xb7, 0x0d, 0x36,
-	0xd6, 0x08, 0xda, 0xff, 0x0f, 0xaa, 0x7d, 0xf5, 0x51, 0x00, 0xac, 0x4c, 0x01, 0x18, 0xaf, 0x2b,
-	0x5f, 0x6b, 0x7b, 0xdf, 0x19, 0xd0, 0x7c, 0xc5, 0x49, 0x7a, 0xe6, 0x13, 0x7e, 0xaa, 0x91, 0x3e,
-	0x86, 0x7a, 0x88, 0x47, 0x05, 0xf3, 0x3b, 0x04, 0xe1, 0x28, 0x25, 0xff, 0x86, 0xb2, 0x48, 0x0a,
-	0xc2, 0xaf, 0x4e, 0x31, 0x3b, 0x48, 0x90, 0xec, 0xb2, 0x48, 0x46, 0x4e, 0x9b, 0x97, 0x72, 0xfa,
-	0xdb, 0x32, 0x2c, 0x6d, 0xb3, 0x0f, 0xeb, 0xf5, 0x6d, 0x58, 0x8a, 0xc5, 0x5b, 0x9a, 0x06, 0x8c,
-	0x87, 0x71, 0x9e, 0xb1, 0xbe, 0xae, 0x19, 0xcb, 0x6f, 0xa2, 0xb8, 0x3d, 0x90, 0x2a, 0xc5, 0x3c,
-	0x49, 0x26, 0x14, 0x75, 0x6d, 0x34, 0x51, 0x3c, 0x52, 0xdc, 0x84, 0xba, 0x46, 0xd4, 0x21, 0x56,
-	0xe6, 0x0b, 0x11, 0xd0, 0x46, 0xdf, 0xfd, 0x4d, 0xa8, 0xeb, 0xa3, 0x34, 0x42, 0x75, 0x4e, 0x04,
-	0xb4, 0xc1, 0xb5, 0xf7, 0x93, 0x01, 0xf5, 0x1d, 0xd1, 0x4b, 0x48, 0xaa, 0x59, 0xda, 0x03, 0x27,
-	0xa6, 0x27, 0x32, 0xb8, 0x34, 0x55, 0x4d, 0x65, 0x36, 0x76, 0xef, 0xda, 0x70, 0x25, 0x65, 0xa7,
-	0xdd, 0x49, 0xa4, 0xf2, 0x3c, 0x48, 0x4b, 0x68, 0xb7, 0x73, 0xbe, 0x5e, 0xcc, 0x39, 0xea, 0xc5,
-	0xfb, 0xd2, 0x00, 0xeb, 0x88, 0xa6, 0xbd, 0x0f, 0x92, 0xf1, 0x47, 0xb0, 0x80, 0xbc, 0x66, 0xcb,
-	0xe5, 0x55, 0x73, 0x1e, 0x62, 0x0b, 0x75, 0xd5, 0xa3, 0x6d, 0xbc, 0x33, 0xe8, 0xc6, 0x03, 0x74,
-	0xdf, 0x40, 0xf7, 0x6f, 0x4d, 0x81, 0x18, 0x6a, 0xea, 0xd5, 0x41, 0x82, 0x95, 0x7f, 0x17, 0xaa,
-	0x61, 0x97, 0xc5, 0x51, 0xc1, 0xd9, 0xdf, 0xa6, 0x18, 0x2a, 0x1b, 0x5f, 0x6b, 0x79, 0x2b, 0x50,
-	0x2b, 0xac, 0xdd, 0x3a, 0xd4, 0xda, 0xbc, 0x4f, 0x62, 0x16, 0x39, 0x25, 0xb7, 0x06, 0xe6, 0xbe,
-	0x90, 0x8e, 0xe1, 0xfd, 0x62, 0x00, 0xe8, 0x2b, 0x81, 0x4e, 0x3d, 0x1c, 0x73, 0xea, 0x5f, 0x53,
-	0xb0, 0x47, 0xaa, 0xc5, 0xb2, 0x70, 0xeb, 0x3f, 0x50, 0x51, 0x89, 0xbe, 0xc8, 0x2b, 0x54, 0x52,
-	0x31, 0x60, 0x2e, 0x8b, 0xdb, 0x3b, 0x3b, 0x06, 0xd4, 0xf2, 0x1e, 0x82, 0x35, 0x38, 0x6b, 0x32,
-	0x88, 0x26, 0xc0, 0x73, 0x71, 0xca, 0x42, 0x12, 0x6f, 0xf1, 0xc8, 0x31, 0xdc, 0x45, 0xb0, 0x8b,
-	0xef, 0x83, 0xd4, 0x29, 0x7b, 0x3f, 0x1b, 0xb0, 0xa8, 0x0d, 0xb7, 0x52, 0x26, 0xbb, 0x07, 0xc9,
-	0x9f, 0xce, 0xfc, 0x63, 0xb0, 0x88, 0x82, 0x0a, 0x86, 0x7d, 0xea, 0xe6, 0x14, 0xe3, 0xe2, 0x34,
-	0x2c, 0xbe, 0x1a, 0x29, 0x8e, 0xde, 0x85, 0x45, 0x5d, 0xf7, 0x22, 0xa1, 0x29, 0xe1, 0xd1, 0xbc,
-	0x9d, 0xab, 0x81, 0x56, 0x07, 0xda, 0xc8, 0xfb, 0xc6, 0x18, 0x34, 0x30, 0x3c, 0x04, 0x53, 0x36,
-	0xa0, 0xde, 0xb8, 0x14, 0xf5, 0xe5, 0x79, 0xa8, 0x77, 0xd7, 0xc7, 0xae, 0xd8, 0x45, 0xa1, 0xaa,
-	0x7b, 0xf6, 0x43, 0x19, 0xae, 0x4d, 0x50, 0xfe, 0xac, 0x4f, 0xe2, 0x0f, 0xd7, 0x6b, 0xff, 0x6a,
-	0xfe, 0x8b, 0x96, 0x53, 0xb9, 0xd4, 0x13, 0x55, 0xbd, 0xd4, 0x13, 0xf5, 0x7b, 0x15, 0x2a, 0xc8,
-	0xd5, 0x13, 0xb0, 0x25, 0x4d, 0x7b, 0x01, 0x7d, 0x97, 0xa4, 0x05, 0x53, 0xd7, 0xa7, 0x60, 0x0c,
-	0xba, 0x9a, 0x1a, 0xd0, 0xe4, 0xa0, 0xc3, 0x3d, 0x05, 0xc8, 0x55, 0x12, 0xb4, 0xb1, 0x4e, 0xf5,
-	0xdf, 0xdf, 0xd7, 0x62, 0xd4, 0xf8, 0x96, 0x0f, 0x9b, 0xc0, 0x26, 0xd4, 0x3b, 0x6c, 0x64, 0x6f,
-	0xce, 0x4c, 0xd3, 0xa8, 0x1b, 0xb4, 0x4a, 0x3e, 0x74, 0x46, 0x6d, 0x64, 0x07, 0x1a, 0xa1, 0x7e,
-	0x3d, 0x34, 0x84, 0x7e, 0xc3, 0x6e, 0x4e, 0xcd, 0xf4, 0xf0, 0x91, 0x69, 0x95, 0xfc, 0x7a, 0x38,
-	0xf6, 0xe6, 0xbc, 0x00, 0x47, 0x47, 0x91, 0xaa, 0x02, 0xd2, 0x40, 0x9a, 0xcc, 0x7f, 0xcc, 0x8a,
-	0x65, 0x58, 0x6a, 0xad, 0x92, 0xdf, 0xcc, 0x27, 0x1f, 0xfa, 0x43, 0xb8, 0x52, 0x44, 0x35, 0x86,
-	0xb7, 0x80, 0x78, 0xde, 0xcc, 0xd8, 0xc6, 0x01, 0x97, 0x3a, 0xe7, 0x46, 0x07, 0x09, 0x2b, 0x05,
-	0xe2, 0xa0, 0x2a, 0x03, 0xda, 0x27, 0xf1, 0x38, 0x7e, 0x0d, 0xf1, 0xef, 0xce, 0xc4, 0x9f, 0x76,
-	0x4d, 0x5a, 0x25, 0xff, 0x5a, 0x67, 0xf6, 0x25, 0x1a, 0xc5, 0xa1, 0x4f, 0xc5, 0x73, 0xac, 0x0b,
-	0xe2, 0x18, 0xb6, 0x8b, 0x51, 0x1c, 0xa3, 0x0e, 0xf2, 0x14, 0x00, 0x8b, 0x4f, 0x43, 0xd9, 0x33,
-	0xcb, 0x65, 0x38, 0x34, 0xaa, 0x72, 0xe9, 0x0f, 0x27, 0xc8, 0xcd, 0xe1, 0xad, 0x46, 0x7b, 0xb8,
-	0xe0, 0x56, 0x0f, 0xca, 0x25, 0x1c, 0x7e, 0x6d, 0x2f, 0x40, 0x45, 0x99, 0x7a, 0xbf, 0x1a, 0x00,
-	0xc7, 0x34, 0x94, 0x22, 0xdd, 0xda, 0xdf, 0x7f, 0x59, 0x8c, 0xc9, 0xda, 0x5b, 0xfd, 0x0f, 0xa3,
-	0xc6, 0x64, 0x1d, 0xd0, 0xc4, 0x00, 0x5f, 0x9e, 0x1c, 0xe0, 0x1f, 0x01, 0x24, 0x29, 0x8d, 0x58,
-	0x48, 0x24, 0xcd, 0x2e, 0x7a, 0x64, 0xc6, 0x54, 0xdd, 0x8f, 0x00, 0xde, 0xa8, 0xff, 0x15, 0xdd,
-	0x9e, 0x2a, 0x33, 0x89, 0x18, 0xfe, 0xd4, 0xf8, 0xf6, 0x9b, 0xe1, 0xff, 0xcd, 0x6d, 0x58, 0x4a,
-	0x62, 0x12, 0xd2, 0xae, 0x88, 0x23, 0x9a, 0x06, 0x92, 0x9c, 0x62, 0xb5, 0xda, 0x7e, 0x73, 0x4c,
-	0x7c, 0x44, 0x4e, 0xbd, 0x10, 0x16, 0x11, 0xe0, 0x30, 0x26, 0x7c, 0x5f, 0x44, 0xf4, 0x9c, 0xbf,
-	0xc6, 0xfc, 0xfe, 0x5e, 0x05, 0x8b, 0x65, 0x41, 0x28, 0x72, 0x2e, 0x8b, 0xa1, 0xb3, 0xc6, 0xb2,
-	0x1d, 0xf5, 0xe9, 0xfd, 0x66, 0x80, 0x35, 0x3c, 0x60, 0x13, 0xea, 0x7d, 0xa4, 0x35, 0x20, 0x9c,
-	0x67, 0xef, 0xe9, 0xbb, 0x23, 0xf2, 0x55, 0x86, 0xb4, 0xcd, 0x16, 0xe7, 0x99, 0xfb, 0x78, 0xc2,
-	0xc5, 0xf7, 0x3f, 0x1e, 0xca, 0x74, 0xcc, 0xc9, 0xff, 0x43, 0x15, 0x49, 0x2a, 0xf8, 0x5c, 0x9d,
-	0xc5, 0xe7, 0xc0, 0xdb, 0x56, 0xc9, 0xd7, 0x06, 0xee, 0x1a, 0x38, 0x22, 0x97, 0x49, 0x2e, 0x83,
-	0x41, 0xa6, 0x55, 0x36, 0xcd, 0x35, 0xd3, 0x6f, 0x6a, 0xf9, 0xa7, 0x3a, 0xe1, 0x99, 0x2a, 0x20,
-	0x2e, 0x22, 0x7a, 0xe7, 0x7b, 0x03, 0x16, 0x74, 0x0f, 0x9e, 0x9c, 0x14, 0x96, 0xa0, 0xbe, 0x97,
-	0x52, 0x22, 0x69, 0x7a, 0xd4, 0x25, 0xdc, 0x31, 0x5c, 0x07, 0x1a, 0x85, 0xe0, 0xd9, 0x9b, 0x9c,
-	0xc4, 0x4e, 0xd9, 0x6d, 0x80, 0xf5, 0x9c, 0x66, 0x19, 0xee, 0x9b, 0x38, 0x4a, 0xd0, 0x2c, 0xd3,
-	0x9b, 0x15, 0xd7, 0x86, 0xaa, 0x5e, 0x56, 0x95, 0xde, 0xbe, 0x90, 0xfa, 0x6b, 0x41, 0x01, 0x1f,
-	0xa6, 0xf4, 0x84, 0xbd, 0x7b, 0x41, 0x64, 0xd8, 0x75, 0x6a, 0x0a, 0xf8, 0x50, 0x64, 0x72, 0x28,
-	0xb1, 0x94, 0xad, 0x5e, 0xda, 0x6a, 0x89, 0xf7, 0xd8, 0x01, 0x77, 0x01, 0xca, 0x6d, 0xee, 0xd4,
-	0x95, 0x68, 0x5f, 0xc8, 0x36, 0x77, 0x1a, 0x77, 0xf6, 0xa0, 0x3e, 0xf6, 0x74, 0xa9, 0x00, 0x5e,
-	0xf1, 0xd7, 0x5c, 0xbc, 0xe5, 0x7a, 0x5e, 0xdb, 0x8a, 0xd4, 0x8c, 0x53, 0x03, 0xf3, 0x65, 0xde,
-	0x71, 0xca, 0x6a, 0xf1, 0x22, 0x8f, 0x1d, 0x53, 0x2d, 0x76, 0x59, 0xdf, 0xa9, 0xa0, 0x44, 0x44,
-	0x4e, 0x75, 0xfb, 0xfe, 0x17, 0xf7, 0x4e, 0x99, 0xec, 0xe6, 0x9d, 0xf5, 0x50, 0xf4, 0x36, 0x34,
-	0xdd, 0x77, 0x99, 0x28, 0x56, 0x1b, 0x8c, 0x4b, 0x9a, 0x72, 0x12, 0x6f, 0x60, 0x06, 0x36, 0x54,
-	0x06, 0x92, 0x4e, 0x67, 0x01, 0xbf, 0xee, 0xff, 0x11, 0x00, 0x00, 0xff, 0xff, 0x80, 0xc6, 0x4c,
-	0xe0, 0x76, 0x10, 0x00, 0x00,
+	// 1481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xbd, 0x73, 0xdc, 0x54,
+	0x10, 0x3f, 0x9d, 0xee, 0x43, 0xda, 0x3b, 0x9f, 0x15, 0x35, 0x38, 0x09, 0x89, 0x8d, 0xc8, 0x80,
+	0x09, 0x13, 0x7b, 0x42, 0x42, 0x42, 0xc2, 0x00, 0xf1, 0x47, 0xf0, 0xdd, 0x90, 0x9c, 0x8d, 0xe2,
+	0xb8, 0xa0, 0xd1, 0xbc, 0x93, 0x9e, 0x7d, 0x6f, 0xa2, 0xd3, 0x53, 0xa4, 0xa7, 0x4b, 0xae, 0xa6,
+	0xa3, 0xe3, 0x0f, 0xa0, 0xa6, 0xa1, 0xa2, 0xa4, 0xa1, 0xa4, 0xa1, 0xa0, 0xa4, 0xe7, 0x4f, 0xa0,
+	0xa3, 0x62, 0xde, 0x3e, 0xdd, 0x97, 0xe7, 0xce, 0x3e, 0x0f, 0x99, 0xa1, 0xdb, 0x5d, 0xed, 0xee,
+	0xdb, 0xfd, 0xed, 0xbe, 0x7d, 0x2b, 0x80, 0x38, 0x24, 0xd1, 0x46, 0x9c, 0x70, 0xc1, 0xed, 0x4b,
+	0x3d, 0x16, 0xf6, 0xb3, 0x54, 0x71, 0x1b, 0xf2, 0xc3, 0x95, 0x7a, 0xea, 0x77, 0x69, 0x8f, 0x28,
+	0x91, 0xf3, 0xbd, 0x06, 0xf5, 0x3d, 0x1a, 0xd1, 0x84, 0xf9, 0x47, 0x24, 0xcc, 0xa8, 0x7d, 0x15,
+	0x8c, 0x0e, 0xe7, 0xa1, 0xd7, 0x27, 0xe1, 0x8a, 0xb6, 0xa6, 0xad, 0x1b, 0xcd, 0x82, 0x5b, 0x95,
+	0x92, 0x23, 0x12, 0xda, 0xd7, 0xc0, 0x64, 0x91, 0xb8, 0x77, 0x17, 0xbf, 0x16, 0xd7, 0xb4, 0x75,
+	0xbd, 0x59, 0x70, 0x0d, 0x14, 0xe5, 0x9f, 0x8f, 0x43, 0x4e, 0x04, 0x7e, 0xd6, 0xd7, 0xb4, 0x75,
+	0x4d, 0x7e, 0x46, 0x91, 0xfc, 0xbc, 0x0a, 0x90, 0x8a, 0x84, 0x45, 0x27, 0xf8, 0xbd, 0xb4, 0xa6,
+	0xad, 0x9b, 0xcd, 0x82, 0x6b, 0x2a, 0xd9, 0x11, 0x09, 0xb7, 0xcb, 0xa0, 0xf7, 0x49, 0xe8, 0x7c,
+	0xa7, 0x81, 0xf9, 0x75, 0x46, 0x93, 0x41, 0x2b, 0x3a, 0xe6, 0xb6, 0x0d, 0x25, 0xc1, 0xe3, 0x17,
+	0x18, 0x8c, 0xee, 0x22, 0x6d, 0xaf, 0x42, 0xad, 0x47, 0x45, 0xc2, 0x7c, 0x4f, 0x0c, 0x62, 0x8a,
+	0x47, 0x99, 0x2e, 0x28, 0xd1, 0xe1, 0x20, 0xa6, 0xf6, 0xbb, 0xb0, 0x94, 0x52, 0x92, 0xf8, 0x5d,
+	0x2f, 0x26, 0x09, 0xe9, 0xa5, 0xea, 0x34, 0xb7, 0xae, 0x84, 0x07, 0x28, 0x93, 0x4a, 0x09, 0xcf,
+	0xa2, 0xc0, 0x0b, 0xa8, 0xcf, 0x7a, 0x24, 0x5c, 0x29, 0xe3, 0x11, 0x75, 0x14, 0xee, 0x2a, 0x99,
+	0xf3, 0x9b, 0x06, 0xb0, 0xc3, 0xc3, 0xac, 0x17, 0x61, 0x34, 0x97, 0xc1, 0x38, 0x66, 0x34, 0x0c,
+	0x3c, 0x16, 0xe4, 0x11, 0x55, 0x91, 0x6f, 0x05, 0xf6, 0x43, 0x30, 0x03, 0x22, 0x88, 0x0a, 0x49,
+	0x82, 0xd3, 0xf8, 0xe8, 0xda, 0xc6, 0x14, 0xfe, 0x39, 0xf2, 0xbb, 0x44, 0x10, 0x19, 0xa5, 0x6b,
+	0x04, 0x39, 0x65, 0xdf, 0x80, 0x06, 0x4b, 0xbd, 0x38, 0x61, 0x3d, 0x92, 0x0c, 0xbc, 0x17, 0x74,
+	0x80, 0x39, 0x19, 0x6e, 0x9d, 0xa5, 0x07, 0x4a, 0xf8, 0x15, 0x1d, 0xd8, 0x57, 0xc1, 0x64, 0xa9,
+	0x47, 0x32, 0xc1, 0x5b, 0xbb, 0x98, 0x91, 0xe1, 0x1a, 0x2c, 0xdd, 0x42, 0x5e, 0x62, 0x12, 0xd1,
+	0x54, 0xd0, 0xc0, 0x8b, 0x89, 0xe8, 0xae, 0x94, 0xd7, 0x74, 0x89, 0x89, 0x12, 0x1d, 0x10, 0xd1,
+	0x75, 0xbe, 0x18, 0x26, 0xf2, 0xf8, 0x75, 0x9c, 0xd8, 0xb7, 0xa1, 0xc4, 0xa2, 0x63, 0x8e, 0x49,
+	0xd4, 0x4e, 0x07, 0x8a, 0x1d, 0x34, 0xce, 0xda, 0x45, 0x55, 0x67, 0x1b, 0x4c, 0xec, 0x11, 0xb4,
+	0xff, 0x18, 0xca, 0x7d, 0xc9, 0xe4, 0x0e, 0x56, 0x67, 0x38, 0x98, 0xec, 0x2b, 0x57, 0x69, 0x3b,
+	0x3f, 0x6b, 0xd0, 0x78, 0x1e, 0x91, 0x64, 0xe0, 0x92, 0xe8, 0x44, 0x79, 0xfa, 0x1c, 0x6a, 0x3e,
+	0x1e, 0xe5, 0x2d, 0x1e, 0x10, 0xf8, 0xe3, 0x92, 0x7c, 0x00, 0x45, 0x1e, 0xe7, 0x80, 0x5f, 0x9e,
+	0x61, 0xb6, 0x1f, 0x23, 0xd8, 0x45, 0x1e, 0x8f, 0x83, 0xd6, 0x2f, 0x14, 0xf4, 0x8f, 0x45, 0x58,
+	0xde, 0x66, 0x6f, 0x36, 0xea, 0xf7, 0x61, 0x39, 0xe4, 0xaf, 0x68, 0xe2, 0xb1, 0xc8, 0x0f, 0xb3,
+	0x94, 0xf5, 0x55, 0xcf, 0x18, 0x6e, 0x03, 0xc5, 0xad, 0xa1, 0x54, 0x2a, 0x66, 0x71, 0x3c, 0xa5,
+	0xa8, 0x7a, 0xa3, 0x81, 0xe2, 0xb1, 0xe2, 0x23, 0xa8, 0x29, 0x8f, 0x2a, 0xc5, 0xd2, 0x62, 0x29,
+	0x02, 0xda, 0x20, 0x2d, 0x3d, 0xa8, 0xa3, 0x94, 0x87, 0xf2, 0x82, 0x1e, 0xd0, 0x06, 0x69, 0xe7,
+	0x77, 0x0d, 0x6a, 0x3b, 0xbc, 0x17, 0x93, 0x44, 0xa1, 0xb4, 0x07, 0x56, 0x48, 0x8f, 0x85, 0x77,
+	0x61, 0xa8, 0x1a, 0xd2, 0x6c, 0xcc, 0xdb, 0x2d, 0xb8, 0x94, 0xb0, 0x93, 0xee, 0xb4, 0xa7, 0xe2,
+	0x22, 0x9e, 0x96, 0xd1, 0x6e, 0xe7, 0x74, 0xbf, 0xe8, 0x0b, 0xf4, 0x8b, 0xf3, 0xad, 0x06, 0xc6,
+	0x21, 0x4d, 0x7a, 0x6f, 0xa4, 0xe2, 0xf7, 0xa1, 0x82, 0xb8, 0xa6, 0x2b, 0xc5, 0x35, 0x7d, 0x11,
+	0x60, 0x73, 0x75, 0xe7, 0x27, 0x0d, 0x4c, 0xbc, 0x33, 0x18, 0xc6, 0x5d, 0x0c, 0x5f, 0xc3, 0xf0,
+	0x6f, 0xcc, 0x70, 0x31, 0xd2, 0x54, 0xd4, 0x7e, 0x8c, 0x9d, 0x7f, 0x0b, 0xca, 0x7e, 0x97, 0x85,
+	0x41, 0x8e, 0xd9, 0x5b, 0x33, 0x0c, 0xa5, 0x8d, 0xab, 0xb4, 0x9c, 0x87, 0x50, 0xcd, 0xad, 0xed,
+	0x1a, 0x54, 0x5b, 0x51, 0x9f, 0x84, 0x2c, 0xb0, 0x0a, 0x76, 0x15, 0xf4, 0x36, 0x17, 0x96, 0x66,
+	0x03, 0x54, 0x5a, 0x69, 0x3b, 0x0b, 0x43, 0xab, 0x68, 0x2f, 0x81, 0xd9, 0x4a, 0xdb, 0x5c, 0x20,
+	0xab, 0x3b, 0x7f, 0x6a, 0x00, 0xea, 0xb6, 0x60, 0xbc, 0xf7, 0x26, 0xe2, 0x7d, 0x6f, 0xc6, 0xb1,
+	0x63, 0xd5, 0x9c, 0xcc, 0x23, 0xfe, 0x10, 0x4a, 0xb2, 0x07, 0xce, 0x0b, 0x18, 0x95, 0x64, 0x7a,
+	0x58, 0xe6, 0x15, 0xfd, 0x6c, 0x6d, 0xa5, 0xe5, 0xdc, 0x03, 0x63, 0x9b, 0xcd, 0xca, 0xaf, 0x01,
+	0xf0, 0x84, 0x9f, 0x30, 0x9f, 0x84, 0x5b, 0x51, 0x60, 0x69, 0x32, 0xb5, 0x9c, 0xdf, 0x4f, 0xac,
+	0xa2, 0xf3, 0x87, 0x06, 0x4b, 0xca, 0x70, 0x2b, 0x61, 0xa2, 0xbb, 0x1f, 0xff, 0xe7, 0xa6, 0x78,
+	0x00, 0x06, 0x91, 0xae, 0xbc, 0xd1, 0x08, 0xbb, 0x3e, 0xc3, 0x38, 0x3f, 0x0d, 0xfb, 0xb2, 0x4a,
+	0xf2, 0xa3, 0x77, 0x61, 0x49, 0x5d, 0x09, 0x1e, 0xd3, 0x84, 0x44, 0xc1, 0xa2, 0x43, 0xad, 0x8e,
+	0x56, 0xfb, 0xca, 0xc8, 0xf9, 0x41, 0x1b, 0xce, 0x36, 0x3c, 0x04, 0x4b, 0x36, 0x84, 0x5e, 0xbb,
+	0x10, 0xf4, 0xc5, 0x45, 0xa0, 0xb7, 0x37, 0x26, 0x6e, 0xdf, 0x79, 0xa9, 0xca, 0x2b, 0xf8, 0x6b,
+	0x11, 0xae, 0x4c, 0x41, 0xfe, 0xb8, 0x4f, 0xc2, 0x37, 0x37, 0x86, 0xff, 0x6f, 0xfc, 0xf3, 0x69,
+	0x54, 0xba, 0xd0, 0xeb, 0x55, 0xbe, 0xd0, 0xeb, 0xf5, 0x4f, 0x19, 0x4a, 0x88, 0xd5, 0x43, 0x30,
+	0x05, 0x4d, 0x7a, 0x1e, 0x7d, 0x1d, 0x27, 0x39, 0x52, 0x57, 0x67, 0xf8, 0x18, 0x0e, 0x3c, 0xb9,
+	0xbb, 0x89, 0x9c, 0xb6, 0x3f, 0x03, 0xc8, 0x64, 0x11, 0x94, 0xb1, 0x2a, 0xf5, 0xdb, 0x67, 0x4d,
+	0x1f, 0xb9, 0xd9, 0x65, 0x43, 0x46, 0xbe, 0x2c, 0x1d, 0x36, 0xb6, 0xd7, 0xe7, 0x96, 0x69, 0x3c,
+	0x0d, 0x9a, 0x05, 0x17, 0x3a, 0x23, 0xce, 0xde, 0x81, 0xba, 0xaf, 0x1e, 0x16, 0xe5, 0x42, 0x3d,
+	0x6f, 0xd7, 0x67, 0x56, 0x7a, 0xf4, 0xfe, 0x34, 0x0b, 0x6e, 0xcd, 0x1f, 0xb3, 0xf6, 0x53, 0xb0,
+	0x54, 0x16, 0x89, 0x6c, 0x20, 0xe5, 0x48, 0x81, 0xf9, 0xce, 0xbc, 0x5c, 0x46, 0xad, 0xd6, 0x2c,
+	0xb8, 0x8d, 0x6c, 0x4a, 0x62, 0x1f, 0xc0, 0xa5, 0x0e, 0x3b, 0xed, 0xaf, 0x82, 0xfe, 0x9c, 0xb9,
+	0xb9, 0x4d, 0x3a, 0x5c, 0xee, 0x4c, 0x8b, 0x6c, 0x01, 0xab, 0xb9, 0xc7, 0x61, 0x57, 0x7a, 0xb4,
+	0x4f, 0xc2, 0x49, 0xff, 0x55, 0xf4, 0x7f, 0x6b, 0xae, 0xff, 0x59, 0xd7, 0xa4, 0x59, 0x70, 0xaf,
+	0x74, 0xe6, 0x5f, 0xa2, 0x71, 0x1e, 0xea, 0x54, 0x3c, 0xc7, 0x38, 0x27, 0x8f, 0xd1, 0xb8, 0x18,
+	0xe7, 0x31, 0x12, 0xc9, 0x76, 0xc1, 0xe6, 0x53, 0xae, 0xcc, 0xb9, 0xed, 0x32, 0xda, 0x27, 0x65,
+	0xbb, 0xf4, 0x87, 0x8c, 0x6c, 0x97, 0xfc, 0x56, 0xa3, 0x3d, 0x9c, 0x73, 0xab, 0x87, 0xed, 0xe2,
+	0x8f, 0xb8, 0xed, 0x0a, 0x94, 0xa4, 0xa9, 0xf3, 0x97, 0x06, 0x70, 0x44, 0x7d, 0xc1, 0x93, 0xad,
+	0x76, 0xfb, 0x59, 0xbe, 0x41, 0xab, 0x68, 0x57, 0xb4, 0xe1, 0x06, 0xad, 0x12, 0x9a, 0xda, 0xed,
+	0x8b, 0xd3, 0xbb, 0xfd, 0x7d, 0x80, 0x38, 0xa1, 0x01, 0xf3, 0x89, 0xa0, 0xe9, 0x79, 0x8f, 0xcc,
+	0x84, 0xaa, 0xfd, 0x29, 0xc0, 0x4b, 0xf9, 0x2b, 0xa3, 0xc6, 0x53, 0x69, 0x2e, 0x10, 0xa3, 0xff,
+	0x1d, 0xd7, 0x7c, 0x39, 0x24, 0xe5, 0xea, 0x17, 0x87, 0xc4, 0xa7, 0x5d, 0x1e, 0x06, 0x34, 0xf1,
+	0x04, 0x39, 0xc1, 0x6e, 0x35, 0xdd, 0xc6, 0x84, 0xf8, 0x90, 0x9c, 0x38, 0x3e, 0x2c, 0xa1, 0x83,
+	0x83, 0x90, 0x44, 0x6d, 0x1e, 0xd0, 0x53, 0xf1, 0x6a, 0x8b, 0xc7, 0x7b, 0x19, 0x0c, 0x96, 0x7a,
+	0x3e, 0xcf, 0x22, 0x91, 0xef, 0xa3, 0x55, 0x96, 0xee, 0x48, 0xd6, 0xf9, 0x5b, 0x03, 0x63, 0x74,
+	0xc0, 0x23, 0xa8, 0xf5, 0x11, 0x56, 0x8f, 0x44, 0x51, 0x7a, 0xc6, 0xdc, 0x1d, 0x83, 0x2f, 0x2b,
+	0xa4, 0x6c, 0xb6, 0xa2, 0x28, 0xb5, 0x1f, 0x4c, 0x85, 0x78, 0xf6, 0xe3, 0x21, 0x4d, 0x27, 0x82,
+	0xfc, 0x04, 0xca, 0x08, 0x52, 0x8e, 0xe7, 0xda, 0x3c, 0x3c, 0x87, 0xd1, 0x36, 0x0b, 0xae, 0x32,
+	0xb0, 0xd7, 0xc1, 0xe2, 0x99, 0x88, 0x33, 0xe1, 0x0d, 0x2b, 0x2d, 0xab, 0xa9, 0xaf, 0xeb, 0x6e,
+	0x43, 0xc9, 0xbf, 0x54, 0x05, 0x4f, 0x65, 0x03, 0x45, 0x3c, 0xa0, 0x37, 0x7f, 0xd1, 0xa0, 0xa2,
+	0x66, 0xf0, 0xf4, 0xa6, 0xb0, 0x0c, 0xb5, 0xbd, 0x84, 0x12, 0x41, 0x93, 0xc3, 0x2e, 0x89, 0x2c,
+	0xcd, 0xb6, 0xa0, 0x9e, 0x0b, 0x1e, 0xbf, 0xcc, 0x88, 0xdc, 0x8b, 0xea, 0x60, 0x3c, 0xa1, 0x69,
+	0x8a, 0xdf, 0x75, 0x5c, 0x25, 0x68, 0x9a, 0xaa, 0x8f, 0x25, 0xdb, 0x84, 0xb2, 0x22, 0xcb, 0x52,
+	0xaf, 0xcd, 0x85, 0xe2, 0x2a, 0xd2, 0xf1, 0x41, 0x42, 0x8f, 0xd9, 0xeb, 0xa7, 0x44, 0xf8, 0x5d,
+	0xab, 0x2a, 0x1d, 0x1f, 0xf0, 0x54, 0x8c, 0x24, 0x86, 0xb4, 0x55, 0xa4, 0x29, 0x49, 0xbc, 0xc7,
+	0x16, 0xd8, 0x15, 0x28, 0xb6, 0x22, 0xab, 0x26, 0x45, 0x6d, 0x2e, 0x5a, 0x91, 0x55, 0xbf, 0xb9,
+	0x07, 0xb5, 0x89, 0xa7, 0x4b, 0x26, 0xf0, 0x3c, 0x7a, 0x11, 0xf1, 0x57, 0x91, 0x5a, 0xe5, 0xb6,
+	0x02, 0xb9, 0xe3, 0x54, 0x41, 0x7f, 0x96, 0x75, 0xac, 0xa2, 0x24, 0x9e, 0x66, 0xa1, 0xa5, 0x4b,
+	0x62, 0x97, 0xf5, 0xad, 0x12, 0x4a, 0x78, 0x60, 0x95, 0xb7, 0xef, 0x7c, 0x73, 0xfb, 0x84, 0x89,
+	0x6e, 0xd6, 0xd9, 0xf0, 0x79, 0x6f, 0x53, 0xc1, 0x7d, 0x8b, 0xf1, 0x9c, 0xda, 0x64, 0x91, 0xa0,
+	0x49, 0x44, 0xc2, 0x4d, 0xac, 0xc0, 0xa6, 0xac, 0x40, 0xdc, 0xe9, 0x54, 0x90, 0xbb, 0xf3, 0xef,
+	0x00, 0x7d, 0xe2, 0xeb, 0x81, 0x91, 0x10, 0x00, 0x00,
 }
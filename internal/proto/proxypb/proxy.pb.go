@@ -314,58 +314,431 @@ func (m *SetRatesRequest) GetCodes() []commonpb.ErrorCode {
 	return nil
 }
 
+type ExplainRequest struct {
+	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionNames       []string                 `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	Expr                 string                   `protobuf:"bytes,5,opt,name=expr,proto3" json:"expr,omitempty"`
+	SearchParams         []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ExplainRequest) Reset()         { *m = ExplainRequest{} }
+func (m *ExplainRequest) String() string { return proto.CompactTextString(m) }
+func (*ExplainRequest) ProtoMessage()    {}
+func (*ExplainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{5}
+}
+
+func (m *ExplainRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExplainRequest.Unmarshal(m, b)
+}
+func (m *ExplainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExplainRequest.Marshal(b, m, deterministic)
+}
+func (m *ExplainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainRequest.Merge(m, src)
+}
+func (m *ExplainRequest) XXX_Size() int {
+	return xxx_messageInfo_ExplainRequest.Size(m)
+}
+func (m *ExplainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainRequest proto.InternalMessageInfo
+
+func (m *ExplainRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ExplainRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *ExplainRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *ExplainRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *ExplainRequest) GetExpr() string {
+	if m != nil {
+		return m.Expr
+	}
+	return ""
+}
+
+func (m *ExplainRequest) GetSearchParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.SearchParams
+	}
+	return nil
+}
+
+type ExplainResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ExprTree             string           `protobuf:"bytes,2,opt,name=expr_tree,json=exprTree,proto3" json:"expr_tree,omitempty"`
+	PartitionNames       []string         `protobuf:"bytes,3,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	Fields               []*ExplainField  `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	Shards               []*ExplainShard  `protobuf:"bytes,5,rep,name=shards,proto3" json:"shards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ExplainResponse) Reset()         { *m = ExplainResponse{} }
+func (m *ExplainResponse) String() string { return proto.CompactTextString(m) }
+func (*ExplainResponse) ProtoMessage()    {}
+func (*ExplainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{6}
+}
+
+func (m *ExplainResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExplainResponse.Unmarshal(m, b)
+}
+func (m *ExplainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExplainResponse.Marshal(b, m, deterministic)
+}
+func (m *ExplainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainResponse.Merge(m, src)
+}
+func (m *ExplainResponse) XXX_Size() int {
+	return xxx_messageInfo_ExplainResponse.Size(m)
+}
+func (m *ExplainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainResponse proto.InternalMessageInfo
+
+func (m *ExplainResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ExplainResponse) GetExprTree() string {
+	if m != nil {
+		return m.ExprTree
+	}
+	return ""
+}
+
+func (m *ExplainResponse) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *ExplainResponse) GetFields() []*ExplainField {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *ExplainResponse) GetShards() []*ExplainShard {
+	if m != nil {
+		return m.Shards
+	}
+	return nil
+}
+
+type ExplainField struct {
+	FieldID              int64    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	FieldName            string   `protobuf:"bytes,2,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	IndexName            string   `protobuf:"bytes,3,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexType            string   `protobuf:"bytes,4,opt,name=index_type,json=indexType,proto3" json:"index_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExplainField) Reset()         { *m = ExplainField{} }
+func (m *ExplainField) String() string { return proto.CompactTextString(m) }
+func (*ExplainField) ProtoMessage()    {}
+func (*ExplainField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{7}
+}
+
+func (m *ExplainField) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExplainField.Unmarshal(m, b)
+}
+func (m *ExplainField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExplainField.Marshal(b, m, deterministic)
+}
+func (m *ExplainField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainField.Merge(m, src)
+}
+func (m *ExplainField) XXX_Size() int {
+	return xxx_messageInfo_ExplainField.Size(m)
+}
+func (m *ExplainField) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainField.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainField proto.InternalMessageInfo
+
+func (m *ExplainField) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
+func (m *ExplainField) GetFieldName() string {
+	if m != nil {
+		return m.FieldName
+	}
+	return ""
+}
+
+func (m *ExplainField) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+func (m *ExplainField) GetIndexType() string {
+	if m != nil {
+		return m.IndexType
+	}
+	return ""
+}
+
+type ExplainShard struct {
+	Channel              string            `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	LeaderIDs            []int64           `protobuf:"varint,2,rep,packed,name=leaderIDs,proto3" json:"leaderIDs,omitempty"`
+	Segments             []*ExplainSegment `protobuf:"bytes,3,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ExplainShard) Reset()         { *m = ExplainShard{} }
+func (m *ExplainShard) String() string { return proto.CompactTextString(m) }
+func (*ExplainShard) ProtoMessage()    {}
+func (*ExplainShard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{8}
+}
+
+func (m *ExplainShard) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExplainShard.Unmarshal(m, b)
+}
+func (m *ExplainShard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExplainShard.Marshal(b, m, deterministic)
+}
+func (m *ExplainShard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainShard.Merge(m, src)
+}
+func (m *ExplainShard) XXX_Size() int {
+	return xxx_messageInfo_ExplainShard.Size(m)
+}
+func (m *ExplainShard) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainShard.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainShard proto.InternalMessageInfo
+
+func (m *ExplainShard) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ExplainShard) GetLeaderIDs() []int64 {
+	if m != nil {
+		return m.LeaderIDs
+	}
+	return nil
+}
+
+func (m *ExplainShard) GetSegments() []*ExplainSegment {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+type ExplainSegment struct {
+	SegmentID            int64    `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	PartitionID          int64    `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	NodeIDs              []int64  `protobuf:"varint,3,rep,packed,name=nodeIDs,proto3" json:"nodeIDs,omitempty"`
+	NumRows              int64    `protobuf:"varint,4,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	Pruned               bool     `protobuf:"varint,5,opt,name=pruned,proto3" json:"pruned,omitempty"`
+	EstimatedRows        int64    `protobuf:"varint,6,opt,name=estimated_rows,json=estimatedRows,proto3" json:"estimated_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExplainSegment) Reset()         { *m = ExplainSegment{} }
+func (m *ExplainSegment) String() string { return proto.CompactTextString(m) }
+func (*ExplainSegment) ProtoMessage()    {}
+func (*ExplainSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{9}
+}
+
+func (m *ExplainSegment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExplainSegment.Unmarshal(m, b)
+}
+func (m *ExplainSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExplainSegment.Marshal(b, m, deterministic)
+}
+func (m *ExplainSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainSegment.Merge(m, src)
+}
+func (m *ExplainSegment) XXX_Size() int {
+	return xxx_messageInfo_ExplainSegment.Size(m)
+}
+func (m *ExplainSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainSegment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainSegment proto.InternalMessageInfo
+
+func (m *ExplainSegment) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *ExplainSegment) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *ExplainSegment) GetNodeIDs() []int64 {
+	if m != nil {
+		return m.NodeIDs
+	}
+	return nil
+}
+
+func (m *ExplainSegment) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *ExplainSegment) GetPruned() bool {
+	if m != nil {
+		return m.Pruned
+	}
+	return false
+}
+
+func (m *ExplainSegment) GetEstimatedRows() int64 {
+	if m != nil {
+		return m.EstimatedRows
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*InvalidateCollMetaCacheRequest)(nil), "milvus.proto.proxy.InvalidateCollMetaCacheRequest")
 	proto.RegisterType((*InvalidateCredCacheRequest)(nil), "milvus.proto.proxy.InvalidateCredCacheRequest")
 	proto.RegisterType((*UpdateCredCacheRequest)(nil), "milvus.proto.proxy.UpdateCredCacheRequest")
 	proto.RegisterType((*RefreshPolicyInfoCacheRequest)(nil), "milvus.proto.proxy.RefreshPolicyInfoCacheRequest")
 	proto.RegisterType((*SetRatesRequest)(nil), "milvus.proto.proxy.SetRatesRequest")
+	proto.RegisterType((*ExplainRequest)(nil), "milvus.proto.proxy.ExplainRequest")
+	proto.RegisterType((*ExplainResponse)(nil), "milvus.proto.proxy.ExplainResponse")
+	proto.RegisterType((*ExplainField)(nil), "milvus.proto.proxy.ExplainField")
+	proto.RegisterType((*ExplainShard)(nil), "milvus.proto.proxy.ExplainShard")
+	proto.RegisterType((*ExplainSegment)(nil), "milvus.proto.proxy.ExplainSegment")
 }
 
 func init() { proto.RegisterFile("proxy.proto", fileDescriptor_700b50b08ed8dbaf) }
 
 var fileDescriptor_700b50b08ed8dbaf = []byte{
-	// 625 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x51, 0x4f, 0x13, 0x4d,
-	0x14, 0x65, 0x29, 0x2d, 0x7c, 0x97, 0x06, 0x92, 0x09, 0x1f, 0xd6, 0x22, 0xd8, 0x2c, 0x46, 0x1a,
-	0x12, 0x5b, 0xa9, 0x24, 0xbe, 0x53, 0x4c, 0x43, 0x0c, 0x04, 0xb7, 0xfa, 0xe2, 0x8b, 0x99, 0xdd,
-	0xbd, 0xd0, 0x21, 0xdb, 0x99, 0x65, 0x66, 0x16, 0xed, 0x93, 0x89, 0xff, 0xc8, 0x37, 0xff, 0x90,
-	0xff, 0xc3, 0xec, 0xce, 0x76, 0x61, 0xeb, 0x96, 0x8d, 0x12, 0xdf, 0x7a, 0x66, 0xcf, 0x9d, 0x73,
-	0xee, 0xed, 0xdc, 0x03, 0xab, 0xa1, 0x14, 0x5f, 0x26, 0x9d, 0x50, 0x0a, 0x2d, 0x08, 0x19, 0xb3,
-	0xe0, 0x26, 0x52, 0x06, 0x75, 0x92, 0x2f, 0xcd, 0xba, 0x27, 0xc6, 0x63, 0xc1, 0xcd, 0x59, 0x73,
-	0x8d, 0x71, 0x8d, 0x92, 0xd3, 0x20, 0xc5, 0xf5, 0xbb, 0x15, 0xf6, 0x0f, 0x0b, 0x76, 0x4e, 0xf8,
-	0x0d, 0x0d, 0x98, 0x4f, 0x35, 0xf6, 0x45, 0x10, 0x9c, 0xa2, 0xa6, 0x7d, 0xea, 0x8d, 0xd0, 0xc1,
-	0xeb, 0x08, 0x95, 0x26, 0x2f, 0x61, 0xc9, 0xa5, 0x0a, 0x1b, 0x56, 0xcb, 0x6a, 0xaf, 0xf6, 0x9e,
-	0x74, 0x72, 0x8a, 0xa9, 0xd4, 0xa9, 0xba, 0x3c, 0xa2, 0x0a, 0x9d, 0x84, 0x49, 0x1e, 0xc1, 0xb2,
-	0xef, 0x7e, 0xe2, 0x74, 0x8c, 0x8d, 0xc5, 0x96, 0xd5, 0xfe, 0xcf, 0xa9, 0xf9, 0xee, 0x19, 0x1d,
-	0x23, 0xd9, 0x83, 0x75, 0x4f, 0x04, 0x01, 0x7a, 0x9a, 0x09, 0x6e, 0x08, 0x95, 0x84, 0xb0, 0x76,
-	0x7b, 0x9c, 0x10, 0x6d, 0xa8, 0xdf, 0x9e, 0x9c, 0x1c, 0x37, 0x96, 0x5a, 0x56, 0xbb, 0xe2, 0xe4,
-	0xce, 0xec, 0x2b, 0x68, 0xde, 0x71, 0x2e, 0xd1, 0x7f, 0xa0, 0xeb, 0x26, 0xac, 0x44, 0x2a, 0x9e,
-	0x54, 0x66, 0x3b, 0xc3, 0xf6, 0x37, 0x0b, 0x36, 0x3f, 0x84, 0xff, 0x5e, 0x28, 0xfe, 0x16, 0x52,
-	0xa5, 0x3e, 0x0b, 0xe9, 0xa7, 0xa3, 0xc9, 0xb0, 0xfd, 0x15, 0xb6, 0x1d, 0xbc, 0x90, 0xa8, 0x46,
-	0xe7, 0x22, 0x60, 0xde, 0xe4, 0x84, 0x5f, 0x88, 0x07, 0x5a, 0xd9, 0x84, 0x9a, 0x08, 0xdf, 0x4f,
-	0x42, 0x63, 0xa4, 0xea, 0xa4, 0x88, 0x6c, 0x40, 0x55, 0x84, 0x6f, 0x71, 0x92, 0x7a, 0x30, 0xc0,
-	0xfe, 0x69, 0xc1, 0xfa, 0x10, 0xb5, 0x43, 0x35, 0xaa, 0xbf, 0xd7, 0x3c, 0x80, 0xaa, 0x8c, 0x6f,
-	0x68, 0x2c, 0xb6, 0x2a, 0xed, 0xd5, 0xde, 0x56, 0xbe, 0x24, 0x7b, 0xad, 0xb1, 0x8a, 0x63, 0x98,
-	0xe4, 0x35, 0xd4, 0x94, 0x4e, 0x6a, 0x2a, 0xad, 0x4a, 0x7b, 0xad, 0xf7, 0x34, 0x5f, 0x93, 0x82,
-	0x77, 0x91, 0xd0, 0x74, 0x18, 0xf3, 0x9c, 0x94, 0x4e, 0x0e, 0xa1, 0xea, 0x09, 0x1f, 0x55, 0x63,
-	0x29, 0xa9, 0xdb, 0x29, 0xb4, 0xf7, 0x46, 0x4a, 0x21, 0xfb, 0xc2, 0x47, 0xc7, 0x90, 0x7b, 0xdf,
-	0x97, 0xa1, 0x7a, 0x1e, 0xaf, 0x12, 0x09, 0x80, 0x0c, 0x50, 0xf7, 0xc5, 0x38, 0x14, 0x1c, 0xb9,
-	0x1e, 0x9a, 0x5b, 0x3b, 0x85, 0xf2, 0xbf, 0x13, 0xd3, 0x19, 0x35, 0x9f, 0x15, 0xf2, 0x67, 0xc8,
-	0xf6, 0x02, 0xb9, 0x86, 0x8d, 0x01, 0x26, 0x90, 0x29, 0xcd, 0x3c, 0xd5, 0x1f, 0x51, 0xce, 0x31,
-	0x20, 0xbd, 0x39, 0x23, 0x2a, 0x22, 0x4f, 0x35, 0x77, 0x0b, 0x35, 0x87, 0x5a, 0x32, 0x7e, 0xe9,
-	0xa0, 0x0a, 0x05, 0x57, 0x68, 0x2f, 0x10, 0x09, 0xdb, 0xf9, 0xf5, 0x37, 0xeb, 0x95, 0x85, 0xc0,
-	0xac, 0xb6, 0xc9, 0x9e, 0xfb, 0x13, 0xa3, 0xb9, 0x55, 0x38, 0xe6, 0xd8, 0x6a, 0x14, 0xb7, 0x49,
-	0xa1, 0x3e, 0x40, 0x7d, 0xec, 0x4f, 0xdb, 0xdb, 0x9f, 0xdf, 0x5e, 0x46, 0xfa, 0xc3, 0xb6, 0xae,
-	0xe0, 0x71, 0x3e, 0x1b, 0x90, 0x6b, 0x46, 0x03, 0xd3, 0x52, 0xa7, 0xa4, 0xa5, 0x99, 0x0d, 0x2f,
-	0x6b, 0xc7, 0x85, 0xff, 0x6f, 0xa3, 0xe1, 0xae, 0xce, 0x7e, 0x91, 0x4e, 0x71, 0x8a, 0x94, 0x69,
-	0x5c, 0xc1, 0x66, 0xf1, 0xea, 0x93, 0x83, 0x22, 0x91, 0x7b, 0x63, 0xa2, 0x4c, 0xcb, 0x87, 0xf5,
-	0x01, 0xea, 0xe4, 0xfd, 0x9f, 0xa2, 0x96, 0xcc, 0x53, 0xe4, 0xf9, 0xbc, 0x07, 0x9f, 0x12, 0xa6,
-	0x37, 0xef, 0x95, 0xf2, 0xb2, 0x7f, 0xe8, 0x0c, 0x56, 0xa6, 0x51, 0x42, 0x76, 0x8b, 0x7a, 0x98,
-	0x09, 0x9a, 0x12, 0xd7, 0x47, 0x87, 0x1f, 0x7b, 0x97, 0x4c, 0x8f, 0x22, 0x37, 0xfe, 0xd2, 0x35,
-	0xd4, 0x17, 0x4c, 0xa4, 0xbf, 0xba, 0xd3, 0x47, 0xd5, 0x4d, 0xaa, 0xbb, 0x89, 0x44, 0xe8, 0xba,
-	0xb5, 0x04, 0xbe, 0xfa, 0x15, 0x00, 0x00, 0xff, 0xff, 0x47, 0x77, 0x96, 0xe8, 0x54, 0x07, 0x00,
-	0x00,
+	// 972 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xc1, 0x6e, 0x1b, 0x37,
+	0x10, 0xb5, 0xbc, 0x96, 0x6c, 0x8d, 0x14, 0x19, 0x20, 0x52, 0x57, 0x91, 0xe3, 0x54, 0xdd, 0xb4,
+	0xb5, 0x10, 0xa0, 0x72, 0xa3, 0x04, 0x68, 0x4f, 0x3d, 0x44, 0x4a, 0x0c, 0x21, 0x70, 0xe0, 0x52,
+	0x71, 0x0f, 0xbd, 0x18, 0xd4, 0xee, 0xd8, 0x5a, 0x63, 0x97, 0xdc, 0x90, 0xdc, 0xd8, 0x3a, 0xb5,
+	0x28, 0xd0, 0x02, 0xfd, 0x9c, 0xde, 0xfa, 0x01, 0xfd, 0x95, 0xfe, 0x47, 0xb1, 0xe4, 0x6a, 0x25,
+	0x39, 0x6b, 0x0b, 0x4d, 0x50, 0xe4, 0xc6, 0x19, 0xbe, 0xe1, 0x7b, 0x33, 0x24, 0x87, 0x84, 0x5a,
+	0x2c, 0xc5, 0xd5, 0xb4, 0x1b, 0x4b, 0xa1, 0x05, 0x21, 0x51, 0x10, 0xbe, 0x4d, 0x94, 0xb5, 0xba,
+	0x66, 0xa6, 0x55, 0xf7, 0x44, 0x14, 0x09, 0x6e, 0x7d, 0xad, 0x46, 0xc0, 0x35, 0x4a, 0xce, 0xc2,
+	0xcc, 0xae, 0x2f, 0x46, 0xb8, 0x7f, 0x95, 0xe0, 0xc1, 0x90, 0xbf, 0x65, 0x61, 0xe0, 0x33, 0x8d,
+	0x7d, 0x11, 0x86, 0x47, 0xa8, 0x59, 0x9f, 0x79, 0x13, 0xa4, 0xf8, 0x26, 0x41, 0xa5, 0xc9, 0x37,
+	0xb0, 0x31, 0x66, 0x0a, 0x9b, 0xa5, 0x76, 0xa9, 0x53, 0xeb, 0xdd, 0xef, 0x2e, 0x31, 0x66, 0x54,
+	0x47, 0xea, 0xfc, 0x19, 0x53, 0x48, 0x0d, 0x92, 0x7c, 0x0a, 0x9b, 0xfe, 0xf8, 0x94, 0xb3, 0x08,
+	0x9b, 0xeb, 0xed, 0x52, 0xa7, 0x4a, 0x2b, 0xfe, 0xf8, 0x15, 0x8b, 0x90, 0xec, 0xc3, 0xb6, 0x27,
+	0xc2, 0x10, 0x3d, 0x1d, 0x08, 0x6e, 0x01, 0x8e, 0x01, 0x34, 0xe6, 0x6e, 0x03, 0x74, 0xa1, 0x3e,
+	0xf7, 0x0c, 0x07, 0xcd, 0x8d, 0x76, 0xa9, 0xe3, 0xd0, 0x25, 0x9f, 0x7b, 0x01, 0xad, 0x05, 0xe5,
+	0x12, 0xfd, 0x0f, 0x54, 0xdd, 0x82, 0xad, 0x44, 0xa1, 0x5c, 0x90, 0x9d, 0xdb, 0xee, 0xaf, 0x25,
+	0xd8, 0x39, 0x89, 0xff, 0x7f, 0xa2, 0x74, 0x2e, 0x66, 0x4a, 0x5d, 0x0a, 0xe9, 0x67, 0xa5, 0xc9,
+	0x6d, 0xf7, 0x67, 0xd8, 0xa3, 0x78, 0x26, 0x51, 0x4d, 0x8e, 0x45, 0x18, 0x78, 0xd3, 0x21, 0x3f,
+	0x13, 0x1f, 0x28, 0x65, 0x07, 0x2a, 0x22, 0x7e, 0x3d, 0x8d, 0xad, 0x90, 0x32, 0xcd, 0x2c, 0x72,
+	0x17, 0xca, 0x22, 0x7e, 0x89, 0xd3, 0x4c, 0x83, 0x35, 0xdc, 0x7f, 0x4a, 0xb0, 0x3d, 0x42, 0x4d,
+	0x99, 0x46, 0xf5, 0xfe, 0x9c, 0x8f, 0xa1, 0x2c, 0xd3, 0x15, 0x9a, 0xeb, 0x6d, 0xa7, 0x53, 0xeb,
+	0xed, 0x2e, 0x87, 0xe4, 0xa7, 0x35, 0x65, 0xa1, 0x16, 0x49, 0xbe, 0x85, 0x8a, 0xd2, 0x26, 0xc6,
+	0x69, 0x3b, 0x9d, 0x46, 0xef, 0xb3, 0xe5, 0x98, 0xcc, 0xf8, 0x21, 0x11, 0x9a, 0x8d, 0x52, 0x1c,
+	0xcd, 0xe0, 0xe4, 0x29, 0x94, 0x3d, 0xe1, 0xa3, 0x6a, 0x6e, 0x98, 0xb8, 0x07, 0x85, 0xf2, 0x9e,
+	0x4b, 0x29, 0x64, 0x5f, 0xf8, 0x48, 0x2d, 0xd8, 0xfd, 0x63, 0x1d, 0x1a, 0xcf, 0xaf, 0xe2, 0x90,
+	0x05, 0xfc, 0x63, 0x5e, 0x82, 0x7d, 0xd8, 0x8e, 0x99, 0xd4, 0x41, 0x8e, 0xb3, 0x69, 0x54, 0x69,
+	0x23, 0x77, 0xa7, 0x38, 0x45, 0x08, 0x6c, 0xe0, 0x55, 0x2c, 0x9b, 0x65, 0xb3, 0x8c, 0x19, 0x93,
+	0x17, 0x70, 0x47, 0x21, 0x93, 0xde, 0xe4, 0x34, 0x66, 0x92, 0x45, 0xaa, 0x59, 0x31, 0xd5, 0xfe,
+	0xbc, 0x50, 0xf9, 0x4b, 0x9c, 0xfe, 0xc8, 0xc2, 0x04, 0x8f, 0x59, 0x20, 0x69, 0xdd, 0xc6, 0x1d,
+	0x9b, 0x30, 0xf7, 0x97, 0x75, 0xd8, 0xce, 0x6b, 0xa1, 0x62, 0xc1, 0x15, 0x92, 0x27, 0x76, 0x3b,
+	0x12, 0x95, 0x95, 0x63, 0xb7, 0x70, 0xd1, 0x91, 0x81, 0xd0, 0x0c, 0x4a, 0x76, 0xa1, 0x9a, 0x0a,
+	0x3b, 0xd5, 0x12, 0xf3, 0x63, 0x9f, 0x3a, 0x5e, 0x4b, 0x2c, 0x4c, 0xd5, 0x29, 0x4c, 0xf5, 0x3b,
+	0xa8, 0x9c, 0x05, 0x18, 0xfa, 0xb6, 0x14, 0xb5, 0x5e, 0xbb, 0xfb, 0x6e, 0x03, 0xec, 0x66, 0x7a,
+	0x5f, 0xa4, 0x40, 0x9a, 0xe1, 0xd3, 0x48, 0x35, 0x61, 0xd2, 0x57, 0xcd, 0xf2, 0xca, 0xc8, 0x51,
+	0x0a, 0xa4, 0x19, 0xde, 0xfd, 0xad, 0x04, 0xf5, 0xc5, 0x25, 0x49, 0x13, 0x36, 0xcd, 0xa2, 0xc3,
+	0x81, 0x29, 0x80, 0x43, 0x67, 0x26, 0xd9, 0x03, 0x30, 0xc3, 0xc5, 0x7d, 0xaf, 0x1a, 0x8f, 0xd9,
+	0xd1, 0x3d, 0x80, 0x80, 0xfb, 0x78, 0xb5, 0xb8, 0xeb, 0x55, 0xe3, 0x59, 0x9e, 0xd6, 0xe9, 0x8d,
+	0xdc, 0x58, 0x98, 0x4e, 0x2f, 0xa5, 0xfb, 0xfb, 0x5c, 0x87, 0x11, 0x98, 0xea, 0xf0, 0x26, 0x8c,
+	0x73, 0x0c, 0x8d, 0x8e, 0x2a, 0x9d, 0x99, 0xe4, 0x3e, 0x54, 0x43, 0x64, 0x3e, 0xca, 0xe1, 0xc0,
+	0xde, 0x33, 0x87, 0xce, 0x1d, 0xe4, 0x7b, 0xd8, 0x52, 0x78, 0x1e, 0x21, 0xd7, 0xb6, 0xcc, 0xb5,
+	0x9e, 0x7b, 0x5b, 0x31, 0x2c, 0x94, 0xe6, 0x31, 0xee, 0xdf, 0x25, 0x68, 0x2c, 0x4f, 0xa6, 0x84,
+	0xd9, 0x74, 0x5e, 0x94, 0xb9, 0x83, 0xb4, 0xa1, 0x96, 0xef, 0xe3, 0x70, 0x60, 0xea, 0xe2, 0xd0,
+	0x45, 0x57, 0x9a, 0x0a, 0x17, 0x3e, 0x0e, 0x07, 0x56, 0x91, 0x43, 0x67, 0x26, 0xb9, 0x07, 0x5b,
+	0x3c, 0x89, 0x4e, 0xa5, 0xb8, 0x54, 0xd9, 0x33, 0xb0, 0xc9, 0x93, 0x88, 0x8a, 0x4b, 0x95, 0x76,
+	0xaf, 0x58, 0x26, 0x1c, 0x7d, 0x73, 0xf2, 0xb7, 0x68, 0x66, 0x91, 0x2f, 0xa1, 0x81, 0x4a, 0x07,
+	0x11, 0xd3, 0xe8, 0xdb, 0xc0, 0x8a, 0x09, 0xbc, 0x93, 0x7b, 0xd3, 0xf0, 0xde, 0x9f, 0x9b, 0x50,
+	0x3e, 0x4e, 0x33, 0x25, 0x21, 0x90, 0x43, 0xd4, 0x7d, 0x11, 0xc5, 0x82, 0x23, 0xd7, 0x23, 0xdb,
+	0x3c, 0xba, 0x85, 0x5d, 0xe6, 0x5d, 0x60, 0xd6, 0x23, 0x5a, 0x5f, 0x14, 0xe2, 0xaf, 0x81, 0xdd,
+	0x35, 0xf2, 0x06, 0xee, 0x1e, 0xa2, 0x31, 0x03, 0xa5, 0x03, 0x4f, 0xf5, 0xb3, 0x4d, 0xeb, 0xdd,
+	0xd0, 0x09, 0x8b, 0xc0, 0x33, 0xce, 0x87, 0x85, 0x9c, 0x23, 0x2d, 0x03, 0x7e, 0x3e, 0xbb, 0xaf,
+	0xee, 0x1a, 0x91, 0xb0, 0xb7, 0xfc, 0xca, 0xdb, 0x36, 0x93, 0xbf, 0xf5, 0xa4, 0x57, 0x74, 0x00,
+	0x6e, 0xff, 0x18, 0xb4, 0x6e, 0xbb, 0xf6, 0xee, 0x1a, 0x61, 0x50, 0x3f, 0x44, 0x3d, 0xf0, 0x67,
+	0xe9, 0x3d, 0xba, 0x39, 0xbd, 0x1c, 0xf4, 0x1f, 0xd3, 0xba, 0x80, 0x7b, 0xcb, 0x5f, 0x00, 0xe4,
+	0x3a, 0x60, 0xa1, 0x4d, 0xa9, 0xbb, 0x22, 0xa5, 0x6b, 0x0f, 0xf9, 0xaa, 0x74, 0xc6, 0xf0, 0xc9,
+	0x49, 0x5c, 0xc4, 0xf3, 0xa8, 0x88, 0xe7, 0x24, 0x7e, 0x1f, 0x8e, 0x0b, 0xd8, 0x29, 0x7e, 0xe1,
+	0xc9, 0xe3, 0x22, 0x92, 0x5b, 0x7f, 0x03, 0xab, 0xb8, 0x7c, 0xd8, 0x3e, 0x44, 0x6d, 0xce, 0xff,
+	0x11, 0x6a, 0x19, 0x78, 0x8a, 0x7c, 0x75, 0xd3, 0x81, 0xcf, 0x00, 0xb3, 0x95, 0xf7, 0x57, 0xe2,
+	0xf2, 0x1d, 0x7a, 0x05, 0x5b, 0xb3, 0x1f, 0x03, 0x79, 0x58, 0x94, 0xc3, 0xb5, 0xff, 0xc4, 0x0a,
+	0xd5, 0xcf, 0x9e, 0xfe, 0xd4, 0x3b, 0x0f, 0xf4, 0x24, 0x19, 0xa7, 0x33, 0x07, 0x16, 0xfa, 0x75,
+	0x20, 0xb2, 0xd1, 0xc1, 0xec, 0x50, 0x1d, 0x98, 0xe8, 0x03, 0x43, 0x11, 0x8f, 0xc7, 0x15, 0x63,
+	0x3e, 0xf9, 0x77, 0x00, 0x44, 0x49, 0xe7, 0x62, 0x3b, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
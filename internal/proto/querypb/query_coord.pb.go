@@ -33,18 +33,24 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 type LoadScope int32
 
 const (
-	LoadScope_Full  LoadScope = 0
-	LoadScope_Delta LoadScope = 1
+	LoadScope_Full        LoadScope = 0
+	LoadScope_Delta       LoadScope = 1
+	LoadScope_Index       LoadScope = 2
+	LoadScope_SwitchIndex LoadScope = 3
 )
 
 var LoadScope_name = map[int32]string{
 	0: "Full",
 	1: "Delta",
+	2: "Index",
+	3: "SwitchIndex",
 }
 
 var LoadScope_value = map[string]int32{
-	"Full":  0,
-	"Delta": 1,
+	"Full":        0,
+	"Delta":       1,
+	"Index":       2,
+	"SwitchIndex": 3,
 }
 
 func (x LoadScope) String() string {
@@ -244,7 +250,7 @@ func (SyncType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_aab7cc9a69ed26e8, []int{6}
 }
 
-//--------------------QueryCoord grpc request and response proto------------------
+// --------------------QueryCoord grpc request and response proto------------------
 type ShowCollectionsRequest struct {
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	// Not useful for now
@@ -1321,7 +1327,7 @@ func (m *SyncNewCreatedPartitionRequest) GetPartitionID() int64 {
 	return 0
 }
 
-//-----------------query node grpc request and response proto----------------
+// -----------------query node grpc request and response proto----------------
 type LoadMetaInfo struct {
 	LoadType             LoadType `protobuf:"varint,1,opt,name=load_type,json=loadType,proto3,enum=milvus.proto.query.LoadType" json:"load_type,omitempty"`
 	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
@@ -1570,24 +1576,25 @@ func (m *UnsubDmChannelRequest) GetChannelName() string {
 }
 
 type SegmentLoadInfo struct {
-	SegmentID            int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	PartitionID          int64                 `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	CollectionID         int64                 `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	DbID                 int64                 `protobuf:"varint,4,opt,name=dbID,proto3" json:"dbID,omitempty"`
-	FlushTime            int64                 `protobuf:"varint,5,opt,name=flush_time,json=flushTime,proto3" json:"flush_time,omitempty"`
-	BinlogPaths          []*datapb.FieldBinlog `protobuf:"bytes,6,rep,name=binlog_paths,json=binlogPaths,proto3" json:"binlog_paths,omitempty"`
-	NumOfRows            int64                 `protobuf:"varint,7,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
-	Statslogs            []*datapb.FieldBinlog `protobuf:"bytes,8,rep,name=statslogs,proto3" json:"statslogs,omitempty"`
-	Deltalogs            []*datapb.FieldBinlog `protobuf:"bytes,9,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
-	CompactionFrom       []int64               `protobuf:"varint,10,rep,packed,name=compactionFrom,proto3" json:"compactionFrom,omitempty"`
-	IndexInfos           []*FieldIndexInfo     `protobuf:"bytes,11,rep,name=index_infos,json=indexInfos,proto3" json:"index_infos,omitempty"`
-	SegmentSize          int64                 `protobuf:"varint,12,opt,name=segment_size,json=segmentSize,proto3" json:"segment_size,omitempty"`
-	InsertChannel        string                `protobuf:"bytes,13,opt,name=insert_channel,json=insertChannel,proto3" json:"insert_channel,omitempty"`
-	StartPosition        *msgpb.MsgPosition    `protobuf:"bytes,14,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
-	EndPosition          *msgpb.MsgPosition    `protobuf:"bytes,15,opt,name=end_position,json=endPosition,proto3" json:"end_position,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	SegmentID            int64                  `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	PartitionID          int64                  `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	CollectionID         int64                  `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	DbID                 int64                  `protobuf:"varint,4,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	FlushTime            int64                  `protobuf:"varint,5,opt,name=flush_time,json=flushTime,proto3" json:"flush_time,omitempty"`
+	BinlogPaths          []*datapb.FieldBinlog  `protobuf:"bytes,6,rep,name=binlog_paths,json=binlogPaths,proto3" json:"binlog_paths,omitempty"`
+	NumOfRows            int64                  `protobuf:"varint,7,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	Statslogs            []*datapb.FieldBinlog  `protobuf:"bytes,8,rep,name=statslogs,proto3" json:"statslogs,omitempty"`
+	Deltalogs            []*datapb.FieldBinlog  `protobuf:"bytes,9,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	CompactionFrom       []int64                `protobuf:"varint,10,rep,packed,name=compactionFrom,proto3" json:"compactionFrom,omitempty"`
+	IndexInfos           []*FieldIndexInfo      `protobuf:"bytes,11,rep,name=index_infos,json=indexInfos,proto3" json:"index_infos,omitempty"`
+	SegmentSize          int64                  `protobuf:"varint,12,opt,name=segment_size,json=segmentSize,proto3" json:"segment_size,omitempty"`
+	InsertChannel        string                 `protobuf:"bytes,13,opt,name=insert_channel,json=insertChannel,proto3" json:"insert_channel,omitempty"`
+	StartPosition        *msgpb.MsgPosition     `protobuf:"bytes,14,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
+	EndPosition          *msgpb.MsgPosition     `protobuf:"bytes,15,opt,name=end_position,json=endPosition,proto3" json:"end_position,omitempty"`
+	ClusteringInfo       *datapb.ClusteringInfo `protobuf:"bytes,16,opt,name=clustering_info,json=clusteringInfo,proto3" json:"clustering_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *SegmentLoadInfo) Reset()         { *m = SegmentLoadInfo{} }
@@ -1720,6 +1727,13 @@ func (m *SegmentLoadInfo) GetEndPosition() *msgpb.MsgPosition {
 	return nil
 }
 
+func (m *SegmentLoadInfo) GetClusteringInfo() *datapb.ClusteringInfo {
+	if m != nil {
+		return m.ClusteringInfo
+	}
+	return nil
+}
+
 type FieldIndexInfo struct {
 	FieldID int64 `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
 	// deprecated
@@ -2441,7 +2455,7 @@ func (m *GetLoadInfoResponse) GetPartitions() []int64 {
 	return nil
 }
 
-//----------------request auto triggered by QueryCoord-----------------
+// ----------------request auto triggered by QueryCoord-----------------
 type HandoffSegmentsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	SegmentInfos         []*SegmentInfo    `protobuf:"bytes,2,rep,name=segmentInfos,proto3" json:"segmentInfos,omitempty"`
@@ -3138,7 +3152,7 @@ func (m *UnsubscribeChannelInfo) GetCollectionChannels() []*UnsubscribeChannels
 	return nil
 }
 
-//---- synchronize messages proto between QueryCoord and QueryNode -----
+// ---- synchronize messages proto between QueryCoord and QueryNode -----
 type SegmentChangeInfo struct {
 	OnlineNodeID         int64          `protobuf:"varint,1,opt,name=online_nodeID,json=onlineNodeID,proto3" json:"online_nodeID,omitempty"`
 	OnlineSegments       []*SegmentInfo `protobuf:"bytes,2,rep,name=online_segments,json=onlineSegments,proto3" json:"online_segments,omitempty"`
@@ -3486,15 +3500,16 @@ func (m *SegmentDist) GetVersion() int64 {
 }
 
 type SegmentVersionInfo struct {
-	ID                   int64    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Collection           int64    `protobuf:"varint,2,opt,name=collection,proto3" json:"collection,omitempty"`
-	Partition            int64    `protobuf:"varint,3,opt,name=partition,proto3" json:"partition,omitempty"`
-	Channel              string   `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
-	Version              int64    `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
-	LastDeltaTimestamp   uint64   `protobuf:"varint,6,opt,name=last_delta_timestamp,json=lastDeltaTimestamp,proto3" json:"last_delta_timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ID                   int64             `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Collection           int64             `protobuf:"varint,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	Partition            int64             `protobuf:"varint,3,opt,name=partition,proto3" json:"partition,omitempty"`
+	Channel              string            `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	Version              int64             `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	LastDeltaTimestamp   uint64            `protobuf:"varint,6,opt,name=last_delta_timestamp,json=lastDeltaTimestamp,proto3" json:"last_delta_timestamp,omitempty"`
+	IndexInfo            []*FieldIndexInfo `protobuf:"bytes,7,rep,name=index_info,json=indexInfo,proto3" json:"index_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *SegmentVersionInfo) Reset()         { *m = SegmentVersionInfo{} }
@@ -3564,6 +3579,13 @@ func (m *SegmentVersionInfo) GetLastDeltaTimestamp() uint64 {
 	return 0
 }
 
+func (m *SegmentVersionInfo) GetIndexInfo() []*FieldIndexInfo {
+	if m != nil {
+		return m.IndexInfo
+	}
+	return nil
+}
+
 type ChannelVersionInfo struct {
 	Channel              string   `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
 	Collection           int64    `protobuf:"varint,2,opt,name=collection,proto3" json:"collection,omitempty"`
@@ -4396,6 +4418,61 @@ func (m *DeleteRequest) GetTimestamps() []uint64 {
 	return nil
 }
 
+type IndexReferences struct {
+	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	BuildIDs             []int64  `protobuf:"varint,2,rep,packed,name=buildIDs,proto3" json:"buildIDs,omitempty"`
+	UpdateTime           int64    `protobuf:"varint,3,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IndexReferences) Reset()         { *m = IndexReferences{} }
+func (m *IndexReferences) String() string { return proto.CompactTextString(m) }
+func (*IndexReferences) ProtoMessage()    {}
+func (*IndexReferences) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{58}
+}
+
+func (m *IndexReferences) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexReferences.Unmarshal(m, b)
+}
+func (m *IndexReferences) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexReferences.Marshal(b, m, deterministic)
+}
+func (m *IndexReferences) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexReferences.Merge(m, src)
+}
+func (m *IndexReferences) XXX_Size() int {
+	return xxx_messageInfo_IndexReferences.Size(m)
+}
+func (m *IndexReferences) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexReferences.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexReferences proto.InternalMessageInfo
+
+func (m *IndexReferences) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *IndexReferences) GetBuildIDs() []int64 {
+	if m != nil {
+		return m.BuildIDs
+	}
+	return nil
+}
+
+func (m *IndexReferences) GetUpdateTime() int64 {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("milvus.proto.query.LoadScope", LoadScope_name, LoadScope_value)
 	proto.RegisterEnum("milvus.proto.query.DataScope", DataScope_name, DataScope_value)
@@ -4473,298 +4550,304 @@ func init() {
 	proto.RegisterMapType((map[int64]int32)(nil), "milvus.proto.query.ResourceGroupInfo.NumLoadedReplicaEntry")
 	proto.RegisterMapType((map[int64]int32)(nil), "milvus.proto.query.ResourceGroupInfo.NumOutgoingNodeEntry")
 	proto.RegisterType((*DeleteRequest)(nil), "milvus.proto.query.DeleteRequest")
+	proto.RegisterType((*IndexReferences)(nil), "milvus.proto.query.IndexReferences")
 }
 
 func init() { proto.RegisterFile("query_coord.proto", fileDescriptor_aab7cc9a69ed26e8) }
 
 var fileDescriptor_aab7cc9a69ed26e8 = []byte{
-	// 4563 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0xcd, 0x6f, 0x1c, 0x47,
-	0x76, 0xb8, 0x7a, 0x3e, 0xc8, 0x99, 0x37, 0x9f, 0x2c, 0x92, 0xd2, 0xec, 0xac, 0x24, 0xd3, 0x2d,
-	0xcb, 0xe6, 0xd2, 0x36, 0xa9, 0xa5, 0x76, 0xbd, 0xda, 0xb5, 0x17, 0x5e, 0x89, 0x5c, 0xc9, 0x5c,
-	0xcb, 0x34, 0x7f, 0x4d, 0x49, 0xfb, 0x83, 0xe1, 0xdd, 0xd9, 0xe6, 0x74, 0x71, 0xd8, 0x50, 0x7f,
-	0x8c, 0xba, 0x7b, 0x48, 0xd3, 0x01, 0x72, 0xca, 0x25, 0x8b, 0x24, 0x48, 0x4e, 0xc9, 0x21, 0xc8,
-	0x21, 0x41, 0x80, 0x4d, 0x90, 0xdc, 0x92, 0x5b, 0x0e, 0x39, 0x25, 0x01, 0x82, 0x7c, 0x5c, 0x82,
-	0xfc, 0x03, 0xc9, 0x21, 0x40, 0x82, 0x20, 0x87, 0x45, 0xe0, 0x5b, 0x50, 0x5f, 0xdd, 0x5d, 0xdd,
-	0x35, 0x9c, 0x21, 0x47, 0x5a, 0xdb, 0x41, 0x6e, 0xd3, 0xaf, 0xab, 0xea, 0xbd, 0x7a, 0xdf, 0xef,
-	0x55, 0xd7, 0xc0, 0xc2, 0xb3, 0x11, 0x0e, 0x4e, 0x7b, 0x7d, 0xdf, 0x0f, 0xac, 0xf5, 0x61, 0xe0,
-	0x47, 0x3e, 0x42, 0xae, 0xed, 0x1c, 0x8f, 0x42, 0xf6, 0xb4, 0x4e, 0xdf, 0x77, 0xeb, 0x7d, 0xdf,
-	0x75, 0x7d, 0x8f, 0xc1, 0xba, 0xf5, 0xf4, 0x88, 0x6e, 0xd3, 0xf6, 0x22, 0x1c, 0x78, 0xa6, 0x23,
-	0xde, 0x86, 0xfd, 0x23, 0xec, 0x9a, 0xfc, 0xa9, 0xea, 0x86, 0x03, 0xfe, 0xb3, 0x6d, 0x99, 0x91,
-	0x99, 0x46, 0xa5, 0xff, 0x8a, 0x06, 0x97, 0xf7, 0x8f, 0xfc, 0x93, 0x2d, 0xdf, 0x71, 0x70, 0x3f,
-	0xb2, 0x7d, 0x2f, 0x34, 0xf0, 0xb3, 0x11, 0x0e, 0x23, 0x74, 0x0b, 0x4a, 0x07, 0x66, 0x88, 0x3b,
-	0xda, 0x8a, 0xb6, 0x5a, 0xdb, 0xbc, 0xba, 0x2e, 0x11, 0xc5, 0xa9, 0xf9, 0x20, 0x1c, 0xdc, 0x33,
-	0x43, 0x6c, 0xd0, 0x91, 0x08, 0x41, 0xc9, 0x3a, 0xd8, 0xd9, 0xee, 0x14, 0x56, 0xb4, 0xd5, 0xa2,
-	0x41, 0x7f, 0xa3, 0x57, 0xa0, 0xd1, 0x8f, 0xd7, 0xde, 0xd9, 0x0e, 0x3b, 0xc5, 0x95, 0xe2, 0x6a,
-	0xd1, 0x90, 0x81, 0xfa, 0x4f, 0x0b, 0x70, 0x25, 0x47, 0x46, 0x38, 0xf4, 0xbd, 0x10, 0xa3, 0xdb,
-	0x30, 0x17, 0x46, 0x66, 0x34, 0x0a, 0x39, 0x25, 0x5f, 0x55, 0x52, 0xb2, 0x4f, 0x87, 0x18, 0x7c,
-	0x68, 0x1e, 0x6d, 0x41, 0x81, 0x16, 0x7d, 0x1d, 0x96, 0x6c, 0xef, 0x03, 0xec, 0xfa, 0xc1, 0x69,
-	0x6f, 0x88, 0x83, 0x3e, 0xf6, 0x22, 0x73, 0x80, 0x05, 0x8d, 0x8b, 0xe2, 0xdd, 0x5e, 0xf2, 0x0a,
-	0xbd, 0x05, 0x57, 0x98, 0xc0, 0x42, 0x1c, 0x1c, 0xdb, 0x7d, 0xdc, 0x33, 0x8f, 0x4d, 0xdb, 0x31,
-	0x0f, 0x1c, 0xdc, 0x29, 0xad, 0x14, 0x57, 0x2b, 0xc6, 0x32, 0x7d, 0xbd, 0xcf, 0xde, 0xde, 0x15,
-	0x2f, 0xd1, 0xd7, 0xa0, 0x1d, 0xe0, 0xc3, 0x00, 0x87, 0x47, 0xbd, 0x61, 0xe0, 0x0f, 0x02, 0x1c,
-	0x86, 0x9d, 0x32, 0x45, 0xd3, 0xe2, 0xf0, 0x3d, 0x0e, 0xd6, 0xff, 0x50, 0x83, 0x65, 0xc2, 0x8c,
-	0x3d, 0x33, 0x88, 0xec, 0x17, 0x20, 0x12, 0x1d, 0xea, 0x69, 0x36, 0x74, 0x8a, 0xf4, 0x9d, 0x04,
-	0x23, 0x63, 0x86, 0x02, 0x3d, 0x61, 0x5f, 0x89, 0x92, 0x2a, 0xc1, 0xf4, 0x7f, 0xe0, 0xba, 0x93,
-	0xa6, 0x73, 0x16, 0x99, 0x65, 0x71, 0x16, 0xf2, 0x38, 0x2f, 0x22, 0x31, 0x15, 0xe7, 0x4b, 0x6a,
-	0xce, 0xff, 0x5d, 0x11, 0x96, 0x1f, 0xfa, 0xa6, 0x95, 0xa8, 0xe1, 0x2f, 0x9e, 0xf3, 0xdf, 0x85,
-	0x39, 0x66, 0xbe, 0x9d, 0x12, 0xc5, 0x75, 0x53, 0xc6, 0xc5, 0x4d, 0x3b, 0xa1, 0x70, 0x9f, 0x02,
-	0x0c, 0x3e, 0x09, 0xdd, 0x84, 0x66, 0x80, 0x87, 0x8e, 0xdd, 0x37, 0x7b, 0xde, 0xc8, 0x3d, 0xc0,
-	0x41, 0xa7, 0xbc, 0xa2, 0xad, 0x96, 0x8d, 0x06, 0x87, 0xee, 0x52, 0x20, 0xfa, 0x09, 0x34, 0x0e,
-	0x6d, 0xec, 0x58, 0x3d, 0xdb, 0xb3, 0xf0, 0x27, 0x3b, 0xdb, 0x9d, 0xb9, 0x95, 0xe2, 0x6a, 0x6d,
-	0xf3, 0xed, 0xf5, 0xbc, 0xeb, 0x59, 0x57, 0x72, 0x64, 0xfd, 0x3e, 0x99, 0xbe, 0xc3, 0x66, 0x7f,
-	0xdf, 0x8b, 0x82, 0x53, 0xa3, 0x7e, 0x98, 0x02, 0xa1, 0x0e, 0xcc, 0x73, 0xf6, 0x76, 0xe6, 0x57,
-	0xb4, 0xd5, 0x8a, 0x21, 0x1e, 0xd1, 0x6b, 0xd0, 0x0a, 0x70, 0xe8, 0x8f, 0x82, 0x3e, 0xee, 0x0d,
-	0x02, 0x7f, 0x34, 0x0c, 0x3b, 0x95, 0x95, 0xe2, 0x6a, 0xd5, 0x68, 0x0a, 0xf0, 0x03, 0x0a, 0xed,
-	0xbe, 0x0b, 0x0b, 0x39, 0x2c, 0xa8, 0x0d, 0xc5, 0xa7, 0xf8, 0x94, 0x0a, 0xa2, 0x68, 0x90, 0x9f,
-	0x68, 0x09, 0xca, 0xc7, 0xa6, 0x33, 0xc2, 0x9c, 0xd5, 0xec, 0xe1, 0x3b, 0x85, 0x3b, 0x9a, 0xfe,
-	0xbb, 0x1a, 0x74, 0x0c, 0xec, 0x60, 0x33, 0xc4, 0x9f, 0xa7, 0x48, 0x2f, 0xc3, 0x9c, 0xe7, 0x5b,
-	0x78, 0x67, 0x9b, 0x8a, 0xb4, 0x68, 0xf0, 0x27, 0xfd, 0x33, 0x0d, 0x96, 0x1e, 0xe0, 0x88, 0x98,
-	0x81, 0x1d, 0x46, 0x76, 0x3f, 0xb6, 0xf3, 0xef, 0x42, 0x31, 0xc0, 0xcf, 0x38, 0x65, 0xaf, 0xcb,
-	0x94, 0xc5, 0xbe, 0x5e, 0x35, 0xd3, 0x20, 0xf3, 0xd0, 0xcb, 0x50, 0xb7, 0x5c, 0xa7, 0xd7, 0x3f,
-	0x32, 0x3d, 0x0f, 0x3b, 0xcc, 0x90, 0xaa, 0x46, 0xcd, 0x72, 0x9d, 0x2d, 0x0e, 0x42, 0xd7, 0x01,
-	0x42, 0x3c, 0x70, 0xb1, 0x17, 0x25, 0x3e, 0x39, 0x05, 0x41, 0x6b, 0xb0, 0x70, 0x18, 0xf8, 0x6e,
-	0x2f, 0x3c, 0x32, 0x03, 0xab, 0xe7, 0x60, 0xd3, 0xc2, 0x01, 0xa5, 0xbe, 0x62, 0xb4, 0xc8, 0x8b,
-	0x7d, 0x02, 0x7f, 0x48, 0xc1, 0xe8, 0x36, 0x94, 0xc3, 0xbe, 0x3f, 0xc4, 0x54, 0xd3, 0x9a, 0x9b,
-	0xd7, 0x54, 0x3a, 0xb4, 0x6d, 0x46, 0xe6, 0x3e, 0x19, 0x64, 0xb0, 0xb1, 0xfa, 0x7f, 0x71, 0x53,
-	0xfb, 0x82, 0x3b, 0xb9, 0x94, 0x39, 0x96, 0x9f, 0x8f, 0x39, 0xce, 0x4d, 0x65, 0x8e, 0xf3, 0x67,
-	0x9b, 0x63, 0x8e, 0x6b, 0xe7, 0x31, 0xc7, 0xca, 0x44, 0x73, 0xac, 0xbe, 0x18, 0x73, 0xfc, 0xcb,
-	0xc4, 0x1c, 0xbf, 0xe8, 0x62, 0x4f, 0x4c, 0xb6, 0x2c, 0x99, 0xec, 0x1f, 0x69, 0xf0, 0x95, 0x07,
-	0x38, 0x8a, 0xc9, 0x27, 0x16, 0x88, 0xbf, 0xa0, 0xf1, 0xf9, 0x4f, 0x35, 0xe8, 0xaa, 0x68, 0x9d,
-	0x25, 0x46, 0x7f, 0x04, 0x97, 0x63, 0x1c, 0x3d, 0x0b, 0x87, 0xfd, 0xc0, 0x1e, 0x52, 0x31, 0x52,
-	0x27, 0x53, 0xdb, 0xbc, 0xa1, 0xd2, 0xd8, 0x2c, 0x05, 0xcb, 0xf1, 0x12, 0xdb, 0xa9, 0x15, 0xf4,
-	0x5f, 0xd7, 0x60, 0x99, 0x38, 0x35, 0xee, 0x85, 0xbc, 0x43, 0xff, 0xe2, 0x7c, 0x95, 0xfd, 0x5b,
-	0x21, 0xe7, 0xdf, 0xa6, 0xe0, 0x31, 0xcd, 0x8d, 0xb3, 0xf4, 0xcc, 0xc2, 0xbb, 0x6f, 0x42, 0xd9,
-	0xf6, 0x0e, 0x7d, 0xc1, 0xaa, 0x97, 0x54, 0xac, 0x4a, 0x23, 0x63, 0xa3, 0x75, 0x8f, 0x51, 0x91,
-	0x38, 0xdc, 0x19, 0xd4, 0x2d, 0xbb, 0xed, 0x82, 0x62, 0xdb, 0xbf, 0xa6, 0xc1, 0x95, 0x1c, 0xc2,
-	0x59, 0xf6, 0xfd, 0x0e, 0xcc, 0xd1, 0x30, 0x22, 0x36, 0xfe, 0x8a, 0x72, 0xe3, 0x29, 0x74, 0x0f,
-	0xed, 0x30, 0x32, 0xf8, 0x1c, 0xdd, 0x87, 0x76, 0xf6, 0x1d, 0x09, 0x70, 0x3c, 0xb8, 0xf5, 0x3c,
-	0xd3, 0x65, 0x0c, 0xa8, 0x1a, 0x35, 0x0e, 0xdb, 0x35, 0x5d, 0x8c, 0xbe, 0x02, 0x15, 0x62, 0xb2,
-	0x3d, 0xdb, 0x12, 0xe2, 0x9f, 0xa7, 0x26, 0x6c, 0x85, 0xe8, 0x1a, 0x00, 0x7d, 0x65, 0x5a, 0x56,
-	0xc0, 0x62, 0x5f, 0xd5, 0xa8, 0x12, 0xc8, 0x5d, 0x02, 0xd0, 0x7f, 0x47, 0x83, 0xeb, 0xfb, 0xa7,
-	0x5e, 0x7f, 0x17, 0x9f, 0x6c, 0x05, 0xd8, 0x8c, 0x70, 0xe2, 0x6d, 0x5f, 0x28, 0xe3, 0xd1, 0x0a,
-	0xd4, 0x52, 0xf6, 0xcb, 0x55, 0x32, 0x0d, 0xd2, 0x7f, 0x4b, 0x83, 0x3a, 0x71, 0xff, 0x1f, 0xe0,
-	0xc8, 0x24, 0x2a, 0x82, 0xbe, 0x0d, 0x55, 0xc7, 0x37, 0xad, 0x5e, 0x74, 0x3a, 0x64, 0xd4, 0x34,
-	0xb3, 0xd4, 0x24, 0x31, 0xe3, 0xd1, 0xe9, 0x10, 0x1b, 0x15, 0x87, 0xff, 0x9a, 0x8a, 0xa2, 0xac,
-	0x97, 0x29, 0x2a, 0xbc, 0xcc, 0x5f, 0x95, 0xe1, 0xf2, 0x0f, 0xcd, 0xa8, 0x7f, 0xb4, 0xed, 0x8a,
-	0xec, 0xe2, 0xe2, 0x6c, 0x4a, 0xdc, 0x6e, 0x21, 0xed, 0x76, 0x9f, 0x9b, 0x5b, 0x8f, 0x4d, 0xb0,
-	0xac, 0x32, 0x41, 0x52, 0x1d, 0xaf, 0x3f, 0xe1, 0x5a, 0x94, 0x32, 0xc1, 0x54, 0x12, 0x30, 0x77,
-	0x91, 0x24, 0x60, 0x0b, 0x1a, 0xf8, 0x93, 0xbe, 0x33, 0x22, 0xea, 0x48, 0xb1, 0xb3, 0xe8, 0x7e,
-	0x5d, 0x81, 0x3d, 0x6d, 0xff, 0x75, 0x3e, 0x69, 0x87, 0xd3, 0xc0, 0x44, 0xed, 0xe2, 0xc8, 0xa4,
-	0x21, 0xbc, 0xb6, 0xb9, 0x32, 0x4e, 0xd4, 0x42, 0x3f, 0x98, 0xb8, 0xc9, 0x13, 0xba, 0x0a, 0x55,
-	0x9e, 0x72, 0xec, 0x6c, 0x77, 0xaa, 0x94, 0x7d, 0x09, 0x00, 0x99, 0xd0, 0xe0, 0xce, 0x91, 0x53,
-	0x08, 0x94, 0xc2, 0x77, 0x54, 0x08, 0xd4, 0xc2, 0x4e, 0x53, 0x1e, 0xf2, 0x04, 0x24, 0x4c, 0x81,
-	0x48, 0x45, 0xee, 0x1f, 0x1e, 0x3a, 0xb6, 0x87, 0x77, 0x99, 0x84, 0x6b, 0x94, 0x08, 0x19, 0x48,
-	0xd2, 0x94, 0x63, 0x1c, 0x84, 0xb6, 0xef, 0x75, 0xea, 0xf4, 0xbd, 0x78, 0xec, 0xf6, 0x60, 0x21,
-	0x87, 0x42, 0x91, 0x7d, 0x7c, 0x23, 0x9d, 0x7d, 0x4c, 0xe6, 0x71, 0x2a, 0x3b, 0xf9, 0x99, 0x06,
-	0xcb, 0x8f, 0xbd, 0x70, 0x74, 0x10, 0xef, 0xed, 0xf3, 0xd1, 0xe3, 0xac, 0x73, 0x2b, 0xe5, 0x9c,
-	0x1b, 0xb1, 0xb9, 0x16, 0xdf, 0x05, 0x11, 0x37, 0x75, 0x05, 0x57, 0xa1, 0x1a, 0xc7, 0x37, 0xce,
-	0x90, 0x04, 0x90, 0xf5, 0x2d, 0x85, 0x9c, 0x6f, 0x99, 0x8a, 0x34, 0x91, 0xad, 0x94, 0x52, 0xd9,
-	0xca, 0x35, 0x80, 0x43, 0x67, 0x14, 0x1e, 0xf5, 0x22, 0xdb, 0xc5, 0x3c, 0x5b, 0xaa, 0x52, 0xc8,
-	0x23, 0xdb, 0xc5, 0xe8, 0x2e, 0xd4, 0x0f, 0x6c, 0xcf, 0xf1, 0x07, 0xbd, 0xa1, 0x19, 0x1d, 0x85,
-	0xbc, 0xce, 0x54, 0x89, 0x85, 0xe6, 0x96, 0xf7, 0xe8, 0x58, 0xa3, 0xc6, 0xe6, 0xec, 0x91, 0x29,
-	0xe8, 0x3a, 0xd4, 0xbc, 0x91, 0xdb, 0xf3, 0x0f, 0x7b, 0x81, 0x7f, 0x12, 0xd2, 0x6a, 0xb2, 0x68,
-	0x54, 0xbd, 0x91, 0xfb, 0xe1, 0xa1, 0xe1, 0x9f, 0x90, 0xf8, 0x52, 0x25, 0x91, 0x26, 0x74, 0xfc,
-	0x01, 0xab, 0x24, 0x27, 0xaf, 0x9f, 0x4c, 0x20, 0xb3, 0x2d, 0xec, 0x44, 0x26, 0x9d, 0x5d, 0x9d,
-	0x6e, 0x76, 0x3c, 0x01, 0xbd, 0x0a, 0xcd, 0xbe, 0xef, 0x0e, 0x4d, 0xca, 0xa1, 0xfb, 0x81, 0xef,
-	0x52, 0xcb, 0x29, 0x1a, 0x19, 0x28, 0xda, 0x82, 0x1a, 0x4d, 0xed, 0xb9, 0x79, 0xd5, 0x28, 0x1e,
-	0x5d, 0x65, 0x5e, 0xa9, 0x14, 0x9b, 0x28, 0x28, 0xd8, 0xe2, 0x67, 0x48, 0x34, 0x43, 0x58, 0x69,
-	0x68, 0x7f, 0x8a, 0xb9, 0x85, 0xd4, 0x38, 0x6c, 0xdf, 0xfe, 0x14, 0x93, 0x7a, 0xc3, 0xf6, 0x42,
-	0x1c, 0x44, 0xa2, 0xfa, 0xeb, 0x34, 0xa8, 0xfa, 0x34, 0x18, 0x94, 0x2b, 0x36, 0xda, 0x86, 0x66,
-	0x18, 0x99, 0x41, 0xd4, 0x1b, 0xfa, 0x21, 0x55, 0x80, 0x4e, 0x93, 0xea, 0x76, 0xa6, 0x76, 0x73,
-	0xc3, 0x01, 0x51, 0xec, 0x3d, 0x3e, 0xc8, 0x68, 0xd0, 0x49, 0xe2, 0x11, 0x7d, 0x0f, 0xea, 0xd8,
-	0xb3, 0x92, 0x35, 0x5a, 0xd3, 0xac, 0x51, 0xc3, 0x9e, 0x25, 0x1e, 0xf4, 0xff, 0x2c, 0x40, 0x53,
-	0xde, 0x30, 0xf1, 0x00, 0xac, 0x70, 0x11, 0x5a, 0x2c, 0x1e, 0xc9, 0xf6, 0xb1, 0x67, 0x1e, 0x38,
-	0x98, 0x55, 0x49, 0x54, 0x89, 0x2b, 0x64, 0x3d, 0x02, 0xa3, 0x0b, 0x10, 0x65, 0x64, 0x6c, 0xa6,
-	0x96, 0x53, 0xa4, 0x5b, 0xaf, 0x52, 0x08, 0x4d, 0x0a, 0x3a, 0x30, 0x2f, 0x0a, 0x2c, 0xa6, 0xc2,
-	0xe2, 0x91, 0xbc, 0x39, 0x18, 0xd9, 0x14, 0x2b, 0x53, 0x61, 0xf1, 0x88, 0xb6, 0xa1, 0xce, 0x96,
-	0x1c, 0x9a, 0x81, 0xe9, 0x0a, 0x05, 0x7e, 0x59, 0xe9, 0x04, 0xde, 0xc7, 0xa7, 0x4f, 0x88, 0x3f,
-	0xd9, 0x33, 0xed, 0xc0, 0x60, 0x02, 0xdf, 0xa3, 0xb3, 0xd0, 0x2a, 0xb4, 0xd9, 0x2a, 0x87, 0xb6,
-	0x83, 0xb9, 0x29, 0xcc, 0xb3, 0x2a, 0x8b, 0xc2, 0xef, 0xdb, 0x0e, 0x66, 0xda, 0x1e, 0x6f, 0x81,
-	0x8a, 0xb8, 0xc2, 0x94, 0x9d, 0x42, 0xa8, 0x80, 0x6f, 0x40, 0x83, 0xbd, 0x16, 0x6e, 0x92, 0xf9,
-	0x72, 0x46, 0xe3, 0x13, 0x06, 0xa3, 0xc9, 0xcf, 0xc8, 0x65, 0xe6, 0x02, 0x6c, 0x3b, 0xde, 0xc8,
-	0x25, 0xc6, 0xa2, 0xff, 0x6d, 0x09, 0x16, 0x89, 0xcf, 0xe0, 0xee, 0x63, 0x86, 0x58, 0x7d, 0x0d,
-	0xc0, 0x0a, 0xa3, 0x9e, 0xe4, 0xe7, 0xaa, 0x56, 0x18, 0x71, 0x4f, 0xfe, 0x6d, 0x11, 0x6a, 0x8b,
-	0xe3, 0x0b, 0x83, 0x8c, 0x0f, 0xcb, 0x87, 0xdb, 0x0b, 0xb5, 0xc0, 0x6e, 0x40, 0x83, 0x97, 0xb3,
-	0x52, 0x09, 0x57, 0x67, 0xc0, 0x5d, 0xb5, 0x27, 0x9e, 0x53, 0xb6, 0xe2, 0x52, 0x21, 0x77, 0x7e,
-	0xb6, 0x90, 0x5b, 0xc9, 0x86, 0xdc, 0xfb, 0xd0, 0xa2, 0x6e, 0x24, 0x36, 0x1f, 0xe1, 0x7d, 0x26,
-	0xd8, 0x4f, 0x93, 0xce, 0x12, 0x8f, 0x61, 0x3a, 0x62, 0x82, 0x14, 0x31, 0x09, 0x1f, 0x3c, 0x8c,
-	0xad, 0x5e, 0x14, 0x98, 0x5e, 0x78, 0x88, 0x03, 0x1a, 0x71, 0x2b, 0x46, 0x9d, 0x00, 0x1f, 0x71,
-	0x18, 0x7a, 0x07, 0x80, 0xee, 0x91, 0x75, 0x70, 0xea, 0xe3, 0x3b, 0x38, 0x54, 0x69, 0x68, 0x07,
-	0x87, 0x32, 0x85, 0xfe, 0xd4, 0xff, 0xbe, 0x00, 0x97, 0x79, 0x45, 0x3f, 0xbb, 0x42, 0x8d, 0x0b,
-	0x9a, 0x22, 0xea, 0x14, 0xcf, 0xa8, 0x91, 0x4b, 0x53, 0x24, 0x84, 0x65, 0x45, 0x42, 0x28, 0xd7,
-	0x89, 0x73, 0xb9, 0x3a, 0x31, 0xee, 0x6d, 0xcd, 0x4f, 0xdf, 0xdb, 0x42, 0x4b, 0x50, 0xa6, 0xc5,
-	0x0b, 0x15, 0x7a, 0xd5, 0x60, 0x0f, 0x53, 0x89, 0x43, 0xff, 0xed, 0x02, 0x34, 0xf6, 0xb1, 0x19,
-	0xf4, 0x8f, 0x04, 0x1f, 0xdf, 0x4a, 0xf7, 0x02, 0x5f, 0x19, 0xd3, 0x0b, 0x94, 0xa6, 0x7c, 0x69,
-	0x9a, 0x80, 0x04, 0x41, 0xe4, 0x47, 0x66, 0x4c, 0x65, 0xcf, 0x1b, 0xb9, 0xbc, 0x41, 0xd6, 0xa2,
-	0x2f, 0x38, 0xa9, 0xbb, 0x23, 0x57, 0xff, 0x77, 0x0d, 0xea, 0xff, 0x8f, 0x2c, 0x23, 0x18, 0x73,
-	0x27, 0xcd, 0x98, 0x57, 0xc7, 0x30, 0xc6, 0xc0, 0x51, 0x60, 0xe3, 0x63, 0xfc, 0xa5, 0xeb, 0x8f,
-	0xfe, 0xb5, 0x06, 0x5d, 0x52, 0x85, 0x1a, 0xcc, 0x61, 0xcc, 0x6e, 0x5d, 0x37, 0xa0, 0x71, 0x2c,
-	0xe5, 0x95, 0x05, 0xaa, 0x9c, 0xf5, 0xe3, 0x74, 0xd5, 0x6c, 0x40, 0x5b, 0xb4, 0x2b, 0xf9, 0x66,
-	0x85, 0xff, 0x7e, 0x4d, 0x45, 0x75, 0x86, 0x38, 0xea, 0xff, 0x5a, 0x81, 0x0c, 0xd4, 0x7f, 0x43,
-	0x83, 0x45, 0xc5, 0x40, 0x74, 0x05, 0xe6, 0x79, 0x85, 0xce, 0x03, 0x3d, 0xb3, 0x77, 0x8b, 0x88,
-	0x27, 0xe9, 0x31, 0xd9, 0x56, 0x3e, 0x59, 0xb5, 0xd0, 0x4b, 0x50, 0x8b, 0xeb, 0x15, 0x2b, 0x27,
-	0x1f, 0x2b, 0x44, 0x5d, 0xa8, 0x70, 0x37, 0x28, 0x0a, 0xc1, 0xf8, 0x59, 0x7f, 0x0a, 0xe8, 0x01,
-	0x4e, 0x82, 0xce, 0x2c, 0x1c, 0x4d, 0xfc, 0x4d, 0x42, 0x68, 0xda, 0x09, 0x59, 0xfa, 0xbf, 0x68,
-	0xb0, 0x28, 0x61, 0x9b, 0xa5, 0x93, 0x92, 0x04, 0xc6, 0xc2, 0x45, 0x02, 0xa3, 0xd4, 0x2d, 0x28,
-	0x9e, 0xab, 0x5b, 0x70, 0x1d, 0x20, 0xe6, 0xbf, 0xe0, 0x68, 0x0a, 0xa2, 0xff, 0x85, 0x06, 0x97,
-	0xdf, 0x33, 0x3d, 0xcb, 0x3f, 0x3c, 0x9c, 0x5d, 0x55, 0xb7, 0x40, 0x2a, 0x1d, 0xa7, 0xed, 0x97,
-	0xc9, 0xf5, 0xe6, 0xeb, 0xb0, 0x10, 0xb0, 0xc8, 0x64, 0xc9, 0xba, 0x5c, 0x34, 0xda, 0xe2, 0x45,
-	0xac, 0xa3, 0x7f, 0x52, 0x00, 0x44, 0x76, 0x7d, 0xcf, 0x74, 0x4c, 0xaf, 0x8f, 0x2f, 0x4e, 0xfa,
-	0x4d, 0x68, 0x4a, 0xb9, 0x47, 0x7c, 0xf0, 0x9c, 0x4e, 0x3e, 0x42, 0xf4, 0x3e, 0x34, 0x0f, 0x18,
-	0xaa, 0x5e, 0x80, 0xcd, 0xd0, 0xf7, 0xb8, 0x38, 0x94, 0xad, 0xb1, 0x47, 0x81, 0x3d, 0x18, 0xe0,
-	0x60, 0xcb, 0xf7, 0x2c, 0x9e, 0x86, 0x1f, 0x08, 0x32, 0xc9, 0x54, 0x62, 0x0c, 0x49, 0x22, 0x16,
-	0x0b, 0x27, 0xce, 0xc4, 0x28, 0x2b, 0x42, 0x6c, 0x3a, 0x09, 0x23, 0x92, 0x68, 0xd8, 0x66, 0x2f,
-	0xf6, 0xc7, 0x77, 0x46, 0x15, 0x89, 0x91, 0xfe, 0x67, 0x1a, 0xa0, 0xb8, 0x4a, 0xa6, 0xfd, 0x00,
-	0x6a, 0xd1, 0xd9, 0xa9, 0x9a, 0x22, 0x28, 0x5f, 0x85, 0xaa, 0x25, 0x66, 0x72, 0x17, 0x94, 0x00,
-	0x68, 0x8c, 0xa4, 0x44, 0xf7, 0x88, 0xe6, 0x61, 0x4b, 0x54, 0xa1, 0x0c, 0xf8, 0x90, 0xc2, 0xe4,
-	0xbc, 0xaa, 0x94, 0xcd, 0xab, 0xd2, 0x8d, 0xbf, 0xb2, 0xd4, 0xf8, 0xd3, 0x7f, 0x56, 0x80, 0x36,
-	0x0d, 0x21, 0x5b, 0x49, 0x8b, 0x67, 0x2a, 0xa2, 0x6f, 0x40, 0x83, 0x7f, 0xa5, 0x21, 0x11, 0x5e,
-	0x7f, 0x96, 0x5a, 0x0c, 0xdd, 0x82, 0x25, 0x36, 0x28, 0xc0, 0xe1, 0xc8, 0x49, 0x0a, 0x30, 0x56,
-	0x85, 0xa0, 0x67, 0x2c, 0x76, 0x91, 0x57, 0x62, 0xc6, 0x63, 0xb8, 0x3c, 0x70, 0xfc, 0x03, 0xd3,
-	0xe9, 0xc9, 0xe2, 0x61, 0x32, 0x9c, 0x42, 0xe3, 0x97, 0xd8, 0xf4, 0xfd, 0xb4, 0x0c, 0x43, 0x74,
-	0x0f, 0x1a, 0x21, 0xc6, 0x4f, 0x93, 0xba, 0xac, 0x3c, 0x4d, 0x5d, 0x56, 0x27, 0x73, 0xe2, 0xc2,
-	0xec, 0xf7, 0x34, 0x68, 0x65, 0xda, 0xf6, 0xd9, 0x1e, 0x82, 0x96, 0xef, 0x21, 0xdc, 0x81, 0x32,
-	0xf1, 0x54, 0x2c, 0xb6, 0x34, 0xd5, 0xf5, 0xad, 0xbc, 0xaa, 0xc1, 0x26, 0xa0, 0x0d, 0x58, 0x54,
-	0x9c, 0xeb, 0x73, 0xf1, 0xa3, 0xfc, 0xb1, 0xbe, 0xfe, 0xf3, 0x12, 0xd4, 0x52, 0xac, 0x98, 0xd0,
-	0xfe, 0x78, 0x2e, 0xed, 0xd7, 0x71, 0xe7, 0xb8, 0x44, 0xe5, 0x5c, 0xec, 0xb2, 0x82, 0x8d, 0x57,
-	0x8f, 0x2e, 0x76, 0x69, 0xb9, 0x96, 0xae, 0xc4, 0xe6, 0xa4, 0x4a, 0x2c, 0x53, 0xab, 0xce, 0x9f,
-	0x51, 0xab, 0x56, 0xe4, 0x5a, 0x55, 0x32, 0xa1, 0x6a, 0xd6, 0x84, 0xa6, 0xed, 0x48, 0xdc, 0x82,
-	0xc5, 0x3e, 0x6b, 0x6f, 0xdf, 0x3b, 0xdd, 0x8a, 0x5f, 0xf1, 0xa4, 0x54, 0xf5, 0x0a, 0xdd, 0x4f,
-	0x9a, 0x84, 0x4c, 0xca, 0xac, 0x5a, 0x50, 0x97, 0xc2, 0x5c, 0x36, 0x4c, 0xc8, 0xc2, 0x33, 0xd3,
-	0xa7, 0x6c, 0x2f, 0xa4, 0x71, 0xa1, 0x5e, 0xc8, 0x4b, 0x50, 0x13, 0x99, 0x0a, 0xb1, 0xf4, 0x26,
-	0x73, 0x7a, 0xc2, 0x0d, 0x58, 0xa1, 0xe4, 0x07, 0x5a, 0xf2, 0x01, 0x40, 0xb6, 0x91, 0xd0, 0xce,
-	0x37, 0x12, 0xae, 0xc0, 0xbc, 0x1d, 0xf6, 0x0e, 0xcd, 0xa7, 0xb8, 0xb3, 0x40, 0xdf, 0xce, 0xd9,
-	0xe1, 0x7d, 0xf3, 0x29, 0xd6, 0xff, 0xb1, 0x08, 0xcd, 0x24, 0xc0, 0x4e, 0xed, 0x41, 0xa6, 0xf9,
-	0xb6, 0x65, 0x17, 0xda, 0x49, 0xde, 0x43, 0x39, 0x7c, 0x66, 0xf1, 0x9c, 0x3d, 0x55, 0x6b, 0x0d,
-	0x33, 0xf6, 0x2a, 0x85, 0xfb, 0xd2, 0xb9, 0xc2, 0xfd, 0x8c, 0xa7, 0xde, 0xb7, 0x61, 0x39, 0x8e,
-	0xbd, 0xd2, 0xb6, 0x59, 0x81, 0xb5, 0x24, 0x5e, 0xee, 0xa5, 0xb7, 0x3f, 0xc6, 0x05, 0xcc, 0x8f,
-	0x73, 0x01, 0x59, 0x15, 0xa8, 0xe4, 0x54, 0x20, 0x7f, 0xf8, 0x5e, 0x55, 0x1c, 0xbe, 0xeb, 0x8f,
-	0x61, 0x91, 0xf6, 0x7d, 0xc3, 0x7e, 0x60, 0x1f, 0xe0, 0xb8, 0x04, 0x98, 0x46, 0xac, 0x5d, 0xa8,
-	0x64, 0xaa, 0x88, 0xf8, 0x59, 0xff, 0xa9, 0x06, 0x97, 0xf3, 0xeb, 0x52, 0x8d, 0x49, 0x1c, 0x89,
-	0x26, 0x39, 0x92, 0xff, 0x0f, 0x8b, 0xa9, 0x8c, 0x52, 0x5a, 0x79, 0x4c, 0x06, 0xae, 0x20, 0xdc,
-	0x40, 0xc9, 0x1a, 0x02, 0xa6, 0xff, 0x5c, 0x8b, 0xdb, 0xe7, 0x04, 0x36, 0xa0, 0x87, 0x0a, 0x24,
-	0xae, 0xf9, 0x9e, 0x63, 0x7b, 0x71, 0xa7, 0x84, 0xef, 0x91, 0x01, 0x79, 0xa7, 0xe4, 0x3d, 0x68,
-	0xf1, 0x41, 0x71, 0x78, 0x9a, 0x32, 0x21, 0x6b, 0xb2, 0x79, 0x71, 0x60, 0xba, 0x09, 0x4d, 0xde,
-	0xed, 0x17, 0xf8, 0x8a, 0xaa, 0x33, 0x80, 0x1f, 0x40, 0x5b, 0x0c, 0x3b, 0x6f, 0x40, 0x6c, 0xf1,
-	0x89, 0x71, 0x62, 0xf7, 0xab, 0x1a, 0x74, 0xe4, 0xf0, 0x98, 0xda, 0xfe, 0xf9, 0xd3, 0xbb, 0xb7,
-	0xe5, 0x23, 0xdc, 0x9b, 0x67, 0xd0, 0x93, 0xe0, 0x11, 0x07, 0xb9, 0xbf, 0x59, 0xa0, 0xe7, 0xf1,
-	0xa4, 0xd4, 0xdb, 0xb6, 0xc3, 0x28, 0xb0, 0x0f, 0x46, 0xb3, 0x1d, 0x2a, 0x9a, 0x50, 0xeb, 0x1f,
-	0xe1, 0xfe, 0xd3, 0xa1, 0x6f, 0x27, 0x52, 0x79, 0x57, 0x45, 0xd3, 0x78, 0xb4, 0xeb, 0x5b, 0xc9,
-	0x0a, 0xec, 0xd8, 0x26, 0xbd, 0x66, 0xf7, 0x47, 0xd0, 0xce, 0x0e, 0x48, 0x1f, 0xba, 0x54, 0xd9,
-	0xa1, 0xcb, 0x6d, 0xf9, 0xd0, 0x65, 0x42, 0xa6, 0x91, 0x3a, 0x73, 0xf9, 0xf3, 0x02, 0x7c, 0x55,
-	0x49, 0xdb, 0x2c, 0x55, 0xd2, 0xb8, 0x3e, 0xd2, 0x3d, 0xa8, 0x64, 0x8a, 0xda, 0x57, 0xcf, 0x90,
-	0x1f, 0xef, 0xa5, 0xb2, 0x9e, 0x5e, 0x98, 0xe4, 0x56, 0x89, 0xc1, 0x97, 0xc6, 0xaf, 0xc1, 0xed,
-	0x4e, 0x5a, 0x43, 0xcc, 0x43, 0x77, 0xa1, 0xce, 0x1a, 0x06, 0xbd, 0x63, 0x1b, 0x9f, 0x88, 0xb3,
-	0xc8, 0xeb, 0x4a, 0xd7, 0x4c, 0xc7, 0x3d, 0xb1, 0xf1, 0x89, 0x51, 0x73, 0xe2, 0xdf, 0xa1, 0xfe,
-	0x1f, 0x45, 0x80, 0xe4, 0x1d, 0xa9, 0xce, 0x12, 0x9b, 0xe7, 0x46, 0x9c, 0x82, 0x90, 0x5c, 0x42,
-	0xce, 0x5c, 0xc5, 0x23, 0x32, 0x92, 0x23, 0x05, 0xcb, 0x0e, 0x23, 0xce, 0x97, 0x8d, 0xb3, 0x69,
-	0x11, 0x2c, 0x22, 0x22, 0xe3, 0x3a, 0x13, 0x26, 0x10, 0xf4, 0x26, 0xa0, 0x41, 0xe0, 0x9f, 0xd8,
-	0xde, 0x20, 0x5d, 0x6f, 0xb0, 0xb2, 0x64, 0x81, 0xbf, 0x49, 0x15, 0x1c, 0x3f, 0x86, 0x76, 0x66,
-	0xb8, 0x60, 0xc9, 0xed, 0x09, 0x64, 0x3c, 0x90, 0xd6, 0xe2, 0xea, 0xdb, 0x92, 0x31, 0x84, 0xdd,
-	0x1e, 0xb4, 0xb3, 0xf4, 0x2a, 0xce, 0x0d, 0xbf, 0x29, 0xab, 0xf0, 0x59, 0x9e, 0x86, 0x2c, 0x93,
-	0x52, 0xe2, 0xae, 0x09, 0x4b, 0x2a, 0x4a, 0x14, 0x48, 0x2e, 0x6c, 0x27, 0xef, 0xc6, 0xc9, 0x2e,
-	0xe5, 0xf0, 0xb8, 0xf8, 0x91, 0xea, 0x05, 0x17, 0xa4, 0x5e, 0xb0, 0xfe, 0x37, 0x1a, 0xa0, 0xbc,
-	0x62, 0xa3, 0x26, 0x14, 0xe2, 0x45, 0x0a, 0x3b, 0xdb, 0x19, 0x45, 0x2a, 0xe4, 0x14, 0xe9, 0x2a,
-	0x54, 0xe3, 0x78, 0xce, 0x9d, 0x77, 0x02, 0x48, 0xab, 0x59, 0x49, 0x56, 0xb3, 0x14, 0x61, 0x65,
-	0xb9, 0x49, 0x7d, 0x0b, 0x96, 0x1c, 0x33, 0x8c, 0x7a, 0xac, 0x17, 0x1e, 0xd9, 0x2e, 0x0e, 0x23,
-	0xd3, 0x1d, 0xd2, 0x64, 0xb9, 0x64, 0x20, 0xf2, 0x6e, 0x9b, 0xbc, 0x7a, 0x24, 0xde, 0xe8, 0x47,
-	0x80, 0xf2, 0xe6, 0x95, 0xc6, 0xad, 0xc9, 0xb8, 0x27, 0xed, 0x29, 0x45, 0x5b, 0x51, 0x66, 0xda,
-	0x1f, 0x14, 0x01, 0x25, 0x39, 0x4e, 0x7c, 0xd2, 0x3a, 0x4d, 0x62, 0xb0, 0x01, 0x8b, 0xf9, 0x0c,
-	0x48, 0xa4, 0x7d, 0x28, 0x97, 0xff, 0xa8, 0x72, 0x95, 0xa2, 0xea, 0x43, 0xc1, 0xb7, 0x62, 0x87,
-	0xc8, 0x12, 0xba, 0xeb, 0x63, 0x5b, 0xf5, 0xb2, 0x4f, 0xfc, 0x51, 0xf6, 0x03, 0x43, 0x66, 0x61,
-	0x77, 0x94, 0xce, 0x2b, 0xb7, 0xe5, 0x89, 0x5f, 0x17, 0x4a, 0xa9, 0xe6, 0xdc, 0x79, 0x52, 0xcd,
-	0xd9, 0xbf, 0x2a, 0xfc, 0xe7, 0x02, 0x2c, 0xc4, 0x8c, 0x3c, 0x97, 0x90, 0x26, 0x1f, 0x8a, 0xbf,
-	0x60, 0xa9, 0x7c, 0xac, 0x96, 0xca, 0xb7, 0xce, 0x4c, 0xf7, 0xa7, 0x15, 0xca, 0xec, 0x9c, 0xfd,
-	0x14, 0xe6, 0x79, 0xe3, 0x36, 0xe7, 0x28, 0xa6, 0x29, 0xa8, 0x97, 0xa0, 0x4c, 0xfc, 0x92, 0xe8,
-	0xba, 0xb1, 0x07, 0xc6, 0xd2, 0xf4, 0xe7, 0xa6, 0xdc, 0x57, 0x34, 0xa4, 0xaf, 0x4d, 0xf5, 0x7f,
-	0xd3, 0x00, 0xf6, 0x4f, 0xbd, 0xfe, 0x5d, 0x66, 0xa4, 0xb7, 0xa0, 0x34, 0xe9, 0x1b, 0x27, 0x32,
-	0x9a, 0xea, 0x16, 0x1d, 0x39, 0x85, 0x70, 0xa5, 0x96, 0x41, 0x31, 0xdb, 0x32, 0x18, 0x57, 0xec,
-	0x8f, 0x77, 0x65, 0xdf, 0x82, 0x12, 0x49, 0xf4, 0xf8, 0x37, 0x42, 0x53, 0x1d, 0x78, 0xd2, 0x09,
-	0xfa, 0x67, 0x05, 0xb8, 0x42, 0xa8, 0x7f, 0x3e, 0x59, 0xe1, 0x34, 0xa2, 0x49, 0x79, 0xcb, 0xa2,
-	0xec, 0x2d, 0xef, 0xc0, 0x3c, 0x2b, 0xf7, 0x45, 0x7e, 0x73, 0x7d, 0x1c, 0xaf, 0x99, 0x64, 0x0c,
-	0x31, 0x7c, 0xd6, 0x9a, 0x51, 0x3a, 0x6c, 0x9d, 0x9b, 0xed, 0xb0, 0x75, 0x3e, 0xdb, 0x14, 0x4c,
-	0x09, 0xad, 0x22, 0xfb, 0xf8, 0xc7, 0xd0, 0x30, 0xd2, 0x8a, 0x87, 0x10, 0x94, 0x52, 0xdf, 0x14,
-	0xd2, 0xdf, 0xb4, 0xcc, 0x33, 0x87, 0x66, 0xdf, 0x8e, 0x4e, 0x29, 0x3b, 0xcb, 0x46, 0xfc, 0xac,
-	0xd6, 0x72, 0xfd, 0xbf, 0x35, 0xb8, 0x2c, 0x0e, 0xf5, 0xb8, 0x0d, 0x5d, 0x5c, 0xa2, 0x9b, 0xb0,
-	0xcc, 0x0d, 0x26, 0x63, 0x39, 0x2c, 0x99, 0x5b, 0x64, 0x30, 0x79, 0x1b, 0x9b, 0xb0, 0x1c, 0x99,
-	0xc1, 0x00, 0x47, 0xd9, 0x39, 0x4c, 0xde, 0x8b, 0xec, 0xa5, 0x3c, 0x67, 0x9a, 0x43, 0xd5, 0x97,
-	0xd8, 0xc7, 0x38, 0x9c, 0xb5, 0xdc, 0x04, 0xc0, 0x1b, 0xb9, 0x7c, 0x97, 0xfa, 0x09, 0x5c, 0x65,
-	0x5f, 0xf5, 0x1e, 0xc8, 0x14, 0xcd, 0xd4, 0x53, 0x57, 0xee, 0x3b, 0xe3, 0x31, 0x7e, 0x5f, 0x83,
-	0x6b, 0x63, 0x30, 0xcf, 0x52, 0x4d, 0x3c, 0x54, 0x62, 0x1f, 0x53, 0xfb, 0x49, 0x78, 0xa9, 0x86,
-	0x66, 0x88, 0xfc, 0xac, 0x04, 0x0b, 0xb9, 0x41, 0xe7, 0xd6, 0xb9, 0x37, 0x00, 0x11, 0x21, 0xc4,
-	0x57, 0xcf, 0x68, 0x39, 0xcd, 0x43, 0x53, 0xdb, 0x1b, 0xb9, 0xf1, 0xb5, 0x33, 0x52, 0x51, 0x23,
-	0x9b, 0x8d, 0x66, 0x1d, 0xf5, 0x58, 0x72, 0xa5, 0xf1, 0x37, 0x0c, 0x72, 0x04, 0xae, 0xef, 0x8e,
-	0x5c, 0xd6, 0x7c, 0xe7, 0x52, 0x66, 0xe1, 0x86, 0xa0, 0x92, 0xc0, 0xe8, 0x10, 0x16, 0xe8, 0xa7,
-	0x5a, 0xa3, 0x68, 0xe0, 0x93, 0x84, 0x9e, 0xd2, 0xc5, 0x82, 0xda, 0x77, 0xa6, 0xc6, 0xf4, 0x21,
-	0x9f, 0x4d, 0x88, 0xe7, 0x39, 0xbd, 0x27, 0x43, 0x05, 0x1e, 0xdb, 0xeb, 0xfb, 0x6e, 0x8c, 0x67,
-	0xee, 0x9c, 0x78, 0x76, 0xf8, 0x6c, 0x19, 0x4f, 0x1a, 0xda, 0xdd, 0x82, 0x65, 0xe5, 0xd6, 0x27,
-	0x85, 0xd1, 0x72, 0xba, 0x3e, 0xb8, 0x07, 0x4b, 0xaa, 0x5d, 0x5d, 0x60, 0x8d, 0x1c, 0xc5, 0xe7,
-	0x59, 0x43, 0xff, 0xe3, 0x02, 0x34, 0xb6, 0xb1, 0x83, 0x23, 0xfc, 0x62, 0xcf, 0x3c, 0x73, 0x07,
-	0xb8, 0xc5, 0xfc, 0x01, 0x6e, 0xee, 0x34, 0xba, 0xa4, 0x38, 0x8d, 0xbe, 0x16, 0x1f, 0xc2, 0x93,
-	0x55, 0xca, 0x72, 0x84, 0xb6, 0xd0, 0xdb, 0x50, 0x1f, 0x06, 0xb6, 0x6b, 0x06, 0xa7, 0xbd, 0xa7,
-	0xf8, 0x34, 0xe4, 0x41, 0xa3, 0xa3, 0x0c, 0x3b, 0x3b, 0xdb, 0xa1, 0x51, 0xe3, 0xa3, 0xdf, 0xc7,
-	0xa7, 0xf4, 0x80, 0x3f, 0x2e, 0x36, 0xd8, 0xa7, 0x58, 0x25, 0x23, 0x05, 0x59, 0x5b, 0x81, 0x6a,
-	0xfc, 0xc5, 0x0b, 0xaa, 0x40, 0xe9, 0xfe, 0xc8, 0x71, 0xda, 0x97, 0x50, 0x15, 0xca, 0xb4, 0x1c,
-	0x69, 0x6b, 0x6b, 0xdf, 0x83, 0x6a, 0x7c, 0x6a, 0x8f, 0x6a, 0x30, 0xff, 0xd8, 0x7b, 0xdf, 0xf3,
-	0x4f, 0xbc, 0xf6, 0x25, 0x34, 0x0f, 0xc5, 0xbb, 0x8e, 0xd3, 0xd6, 0x50, 0x03, 0xaa, 0xfb, 0x51,
-	0x80, 0x4d, 0x22, 0xb3, 0x76, 0x01, 0x35, 0x01, 0xde, 0xb3, 0xc3, 0xc8, 0x0f, 0xec, 0xbe, 0xe9,
-	0xb4, 0x8b, 0x6b, 0x9f, 0x42, 0x53, 0x6e, 0xe2, 0xa2, 0x3a, 0x54, 0x76, 0xfd, 0xe8, 0xfb, 0x9f,
-	0xd8, 0x61, 0xd4, 0xbe, 0x44, 0xc6, 0xef, 0xfa, 0xd1, 0x5e, 0x80, 0x43, 0xec, 0x45, 0x6d, 0x0d,
-	0x01, 0xcc, 0x7d, 0xe8, 0x6d, 0xdb, 0xe1, 0xd3, 0x76, 0x01, 0x2d, 0xf2, 0xf3, 0x19, 0xd3, 0xd9,
-	0xe1, 0x9d, 0xd1, 0x76, 0x91, 0x4c, 0x8f, 0x9f, 0x4a, 0xa8, 0x0d, 0xf5, 0x78, 0xc8, 0x83, 0xbd,
-	0xc7, 0xed, 0x32, 0xa1, 0x9e, 0xfd, 0x9c, 0x5b, 0xb3, 0xa0, 0x9d, 0x3d, 0x57, 0x24, 0x6b, 0xb2,
-	0x4d, 0xc4, 0xa0, 0xf6, 0x25, 0xb2, 0x33, 0x7e, 0xb0, 0xdb, 0xd6, 0x50, 0x0b, 0x6a, 0xa9, 0x63,
-	0xd2, 0x76, 0x81, 0x00, 0x1e, 0x04, 0xc3, 0x3e, 0x57, 0x28, 0x46, 0x02, 0xd1, 0xce, 0x6d, 0xc2,
-	0x89, 0xd2, 0xda, 0x3d, 0xa8, 0x88, 0x94, 0x9f, 0x0c, 0xe5, 0x2c, 0x22, 0x8f, 0xed, 0x4b, 0x68,
-	0x01, 0x1a, 0xd2, 0x5d, 0xa6, 0xb6, 0x86, 0x10, 0x34, 0xe5, 0xdb, 0x86, 0xed, 0xc2, 0xda, 0x26,
-	0x40, 0x92, 0x3a, 0x13, 0x72, 0x76, 0xbc, 0x63, 0xd3, 0xb1, 0x2d, 0x46, 0x1b, 0x79, 0x45, 0xb8,
-	0x4b, 0xb9, 0xc3, 0x0c, 0xb5, 0x5d, 0x58, 0x5b, 0x83, 0x8a, 0x48, 0x07, 0x09, 0xdc, 0xc0, 0xae,
-	0x7f, 0x8c, 0x99, 0x64, 0xf6, 0x31, 0x61, 0x65, 0x15, 0xca, 0x77, 0x5d, 0xec, 0x59, 0xed, 0xc2,
-	0xe6, 0xbf, 0x2e, 0x02, 0xb0, 0x53, 0x41, 0xdf, 0x0f, 0x2c, 0xe4, 0xd0, 0xaf, 0x03, 0xb6, 0x7c,
-	0x77, 0xe8, 0x7b, 0xe2, 0xc8, 0x22, 0x44, 0xeb, 0x99, 0x52, 0x9d, 0x3d, 0xe4, 0x07, 0x72, 0x46,
-	0x74, 0x5f, 0x51, 0x8e, 0xcf, 0x0c, 0xd6, 0x2f, 0x21, 0x97, 0x62, 0x23, 0xc5, 0xed, 0x23, 0xbb,
-	0xff, 0x34, 0x3e, 0x4a, 0x1c, 0x7f, 0xe5, 0x2f, 0x33, 0x54, 0xe0, 0xbb, 0xa1, 0xc4, 0xb7, 0x1f,
-	0x05, 0xb6, 0x37, 0x10, 0xf1, 0x4f, 0xbf, 0x84, 0x9e, 0x65, 0x2e, 0x1c, 0x0a, 0x84, 0x9b, 0xd3,
-	0xdc, 0x31, 0xbc, 0x18, 0x4a, 0x07, 0x5a, 0x99, 0x9b, 0xdd, 0x68, 0x4d, 0x7d, 0x01, 0x44, 0x75,
-	0x0b, 0xbd, 0xfb, 0xfa, 0x54, 0x63, 0x63, 0x6c, 0x36, 0x34, 0xe5, 0x2b, 0xc9, 0xe8, 0x6b, 0xe3,
-	0x16, 0xc8, 0x5d, 0x41, 0xeb, 0xae, 0x4d, 0x33, 0x34, 0x46, 0xf5, 0x11, 0xd3, 0xd5, 0x49, 0xa8,
-	0x94, 0xd7, 0xf5, 0xba, 0x67, 0xa5, 0x1e, 0xfa, 0x25, 0xf4, 0x13, 0x92, 0x25, 0x64, 0x2e, 0xca,
-	0xa1, 0x37, 0xd4, 0x91, 0x4d, 0x7d, 0x9f, 0x6e, 0x12, 0x86, 0x8f, 0xb2, 0x96, 0x36, 0x9e, 0xfa,
-	0xdc, 0xd5, 0xd9, 0xe9, 0xa9, 0x4f, 0x2d, 0x7f, 0x16, 0xf5, 0xe7, 0xc6, 0xe0, 0xb0, 0x82, 0x49,
-	0x71, 0x45, 0x27, 0xab, 0xca, 0x49, 0xbd, 0x32, 0xfe, 0x3e, 0xcf, 0x24, 0x6c, 0x23, 0x6a, 0xa4,
-	0xd9, 0xe3, 0xf0, 0x37, 0xc7, 0x34, 0xda, 0xd5, 0x77, 0x03, 0xbb, 0xeb, 0xd3, 0x0e, 0x4f, 0xeb,
-	0xb2, 0x7c, 0xfd, 0x4c, 0x2d, 0x22, 0xe5, 0x95, 0x39, 0xb5, 0x2e, 0xab, 0x6f, 0xb3, 0xe9, 0x97,
-	0xd0, 0x23, 0xc9, 0xaf, 0xa3, 0x57, 0xc7, 0xa9, 0x82, 0xfc, 0x7d, 0xcc, 0x24, 0xbe, 0xfd, 0x12,
-	0x20, 0x66, 0xa9, 0xde, 0xa1, 0x3d, 0x18, 0x05, 0x26, 0x53, 0xe3, 0x71, 0xce, 0x2d, 0x3f, 0x54,
-	0xa0, 0xf9, 0xfa, 0x39, 0x66, 0xc4, 0x5b, 0xea, 0x01, 0x3c, 0xc0, 0xd1, 0x07, 0x38, 0x0a, 0xec,
-	0x7e, 0x98, 0xdd, 0x51, 0xe2, 0xbf, 0xf9, 0x00, 0x81, 0xea, 0xb5, 0x89, 0xe3, 0x62, 0x04, 0x07,
-	0x50, 0x7b, 0x40, 0x2a, 0x28, 0x9a, 0x15, 0x86, 0x68, 0xec, 0x4c, 0x31, 0x42, 0xa0, 0x58, 0x9d,
-	0x3c, 0x30, 0xed, 0x3c, 0x33, 0x57, 0xf1, 0xd0, 0x58, 0xc1, 0xe6, 0x2f, 0x08, 0xaa, 0x9d, 0xe7,
-	0x98, 0xbb, 0x7d, 0x6c, 0x47, 0xf4, 0xb0, 0xe7, 0x3d, 0x6c, 0x3a, 0xd1, 0xd1, 0x98, 0x1d, 0xa5,
-	0x46, 0x9c, 0xbd, 0x23, 0x69, 0x60, 0x8c, 0x03, 0xc3, 0x22, 0xb3, 0x42, 0xb9, 0xf4, 0xdc, 0x50,
-	0x2f, 0x91, 0x1f, 0x39, 0xa5, 0xea, 0x99, 0xb0, 0xb0, 0x1d, 0xf8, 0x43, 0x19, 0xc9, 0x9b, 0x4a,
-	0x24, 0xb9, 0x71, 0x53, 0xa2, 0xf8, 0x21, 0xd4, 0x45, 0x85, 0x4f, 0x6b, 0x12, 0x35, 0x17, 0xd2,
-	0x43, 0xa6, 0x5c, 0xf8, 0x63, 0x68, 0x65, 0x5a, 0x07, 0x6a, 0xa1, 0xab, 0xfb, 0x0b, 0x93, 0x56,
-	0x3f, 0x01, 0x44, 0xef, 0x57, 0x4a, 0x77, 0xbb, 0xc7, 0xe4, 0x37, 0xf9, 0x81, 0x02, 0xc9, 0xc6,
-	0xd4, 0xe3, 0x63, 0xc9, 0xff, 0x32, 0x2c, 0x2b, 0xcb, 0xf3, 0xac, 0x43, 0xe0, 0x1f, 0xc4, 0x9e,
-	0xd1, 0x43, 0xc8, 0x3a, 0x84, 0x33, 0x67, 0x08, 0xfc, 0x9b, 0xff, 0xd4, 0x82, 0x2a, 0xcd, 0xf3,
-	0xa8, 0xb4, 0xfe, 0x2f, 0xcd, 0x7b, 0xbe, 0x69, 0xde, 0xc7, 0xd0, 0xca, 0x5c, 0x0c, 0x54, 0x2b,
-	0xad, 0xfa, 0xf6, 0xe0, 0x14, 0xd9, 0x8a, 0x7c, 0x35, 0x4f, 0x1d, 0x0a, 0x95, 0xd7, 0xf7, 0x26,
-	0xad, 0xfd, 0x84, 0xdd, 0xa9, 0x8d, 0xbf, 0x52, 0x78, 0x6d, 0x6c, 0xf3, 0x5e, 0xfe, 0xb0, 0xf5,
-	0xf3, 0xcf, 0x82, 0xbe, 0xdc, 0x19, 0xe8, 0xc7, 0xd0, 0xca, 0x5c, 0x1d, 0x51, 0x6b, 0x8c, 0xfa,
-	0x7e, 0xc9, 0xa4, 0xd5, 0x7f, 0x81, 0xc9, 0x93, 0x05, 0x8b, 0x8a, 0x2f, 0xf5, 0xd1, 0xfa, 0xb8,
-	0x44, 0x54, 0xfd, 0x49, 0xff, 0xe4, 0x0d, 0x35, 0x24, 0x33, 0xcd, 0xc6, 0x9b, 0x84, 0xc8, 0xec,
-	0x9f, 0xc2, 0x74, 0xdf, 0x98, 0xee, 0x1f, 0x64, 0xe2, 0x0d, 0xed, 0xc3, 0x1c, 0xbb, 0x50, 0x82,
-	0x5e, 0x56, 0x1f, 0x62, 0xa4, 0x2e, 0x9b, 0x74, 0x27, 0x5d, 0x49, 0x09, 0x47, 0x4e, 0x14, 0xd2,
-	0x45, 0xcb, 0xd4, 0xfb, 0x22, 0x65, 0x57, 0x3f, 0x7d, 0xb3, 0xa3, 0x3b, 0xf9, 0x32, 0x87, 0x58,
-	0xf4, 0x7f, 0x77, 0x86, 0xf9, 0x09, 0xbd, 0x3a, 0x90, 0xfd, 0x38, 0x06, 0xad, 0x9f, 0xef, 0x0b,
-	0x9f, 0xee, 0xc6, 0xd4, 0xe3, 0x63, 0xcc, 0x3f, 0x86, 0x76, 0xf6, 0x40, 0x0a, 0xbd, 0x3e, 0x4e,
-	0x9f, 0x55, 0x38, 0x27, 0x28, 0xf3, 0x0f, 0x60, 0x8e, 0x75, 0x22, 0xd5, 0x1a, 0x26, 0x75, 0x29,
-	0x27, 0xac, 0x75, 0xef, 0x1b, 0x1f, 0x6d, 0x0e, 0xec, 0xe8, 0x68, 0x74, 0x40, 0xde, 0x6c, 0xb0,
-	0xa1, 0x6f, 0xda, 0x3e, 0xff, 0xb5, 0x21, 0x64, 0xb9, 0x41, 0x67, 0x6f, 0x50, 0x04, 0xc3, 0x83,
-	0x83, 0x39, 0xfa, 0x78, 0xfb, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xae, 0xfb, 0x09, 0x88, 0x7f,
-	0x4f, 0x00, 0x00,
+	// 4642 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4b, 0x6f, 0x1c, 0x47,
+	0x7a, 0xea, 0x79, 0x71, 0xe6, 0x9b, 0x57, 0xb3, 0x48, 0x4a, 0xb3, 0xb3, 0x92, 0x4c, 0xb7, 0x2c,
+	0x9b, 0x4b, 0xd9, 0xa4, 0x4c, 0xed, 0x7a, 0xb5, 0x6b, 0x2f, 0xbc, 0x12, 0xb9, 0x92, 0x69, 0x4b,
+	0x34, 0xd3, 0x94, 0xb4, 0x81, 0xe1, 0xdd, 0xd9, 0xe6, 0x74, 0x71, 0xd8, 0x50, 0x4f, 0xf7, 0xa8,
+	0xbb, 0x87, 0x34, 0x1d, 0x20, 0xa7, 0x5c, 0xb2, 0x79, 0x20, 0xc9, 0x25, 0x39, 0x04, 0x39, 0x24,
+	0x08, 0xb0, 0x09, 0x92, 0x5b, 0x72, 0xcb, 0x21, 0xa7, 0xe4, 0x10, 0xe4, 0x71, 0x09, 0xf2, 0x07,
+	0x92, 0x43, 0x80, 0x04, 0x41, 0x0e, 0x8b, 0xc0, 0xb7, 0xa0, 0x1e, 0xfd, 0xa8, 0xee, 0x1a, 0x4e,
+	0x93, 0x23, 0xf9, 0x11, 0xe4, 0x36, 0xfd, 0xd5, 0xe3, 0xfb, 0xea, 0xab, 0xef, 0xfb, 0xea, 0x7b,
+	0x54, 0x0d, 0xcc, 0x3f, 0x1b, 0x63, 0xef, 0xa4, 0xd7, 0x77, 0x5d, 0xcf, 0x5c, 0x1b, 0x79, 0x6e,
+	0xe0, 0x22, 0x34, 0xb4, 0xec, 0xa3, 0xb1, 0xcf, 0xbe, 0xd6, 0x68, 0x7b, 0xb7, 0xd1, 0x77, 0x87,
+	0x43, 0xd7, 0x61, 0xb0, 0x6e, 0x23, 0xd9, 0xa3, 0xdb, 0xb2, 0x9c, 0x00, 0x7b, 0x8e, 0x61, 0x87,
+	0xad, 0x7e, 0xff, 0x10, 0x0f, 0x0d, 0xfe, 0x55, 0x1b, 0xfa, 0x03, 0xfe, 0x53, 0x35, 0x8d, 0xc0,
+	0x48, 0xa2, 0xd2, 0x7e, 0x45, 0x81, 0x8b, 0x7b, 0x87, 0xee, 0xf1, 0xa6, 0x6b, 0xdb, 0xb8, 0x1f,
+	0x58, 0xae, 0xe3, 0xeb, 0xf8, 0xd9, 0x18, 0xfb, 0x01, 0xba, 0x09, 0xa5, 0x7d, 0xc3, 0xc7, 0x1d,
+	0x65, 0x59, 0x59, 0xa9, 0x6f, 0x5c, 0x5e, 0x13, 0x88, 0xe2, 0xd4, 0x3c, 0xf4, 0x07, 0x77, 0x0d,
+	0x1f, 0xeb, 0xb4, 0x27, 0x42, 0x50, 0x32, 0xf7, 0xb7, 0xb7, 0x3a, 0x85, 0x65, 0x65, 0xa5, 0xa8,
+	0xd3, 0xdf, 0xe8, 0x15, 0x68, 0xf6, 0xa3, 0xb9, 0xb7, 0xb7, 0xfc, 0x4e, 0x71, 0xb9, 0xb8, 0x52,
+	0xd4, 0x45, 0xa0, 0xf6, 0xd3, 0x02, 0x5c, 0xca, 0x90, 0xe1, 0x8f, 0x5c, 0xc7, 0xc7, 0xe8, 0x16,
+	0x54, 0xfc, 0xc0, 0x08, 0xc6, 0x3e, 0xa7, 0xe4, 0xeb, 0x52, 0x4a, 0xf6, 0x68, 0x17, 0x9d, 0x77,
+	0xcd, 0xa2, 0x2d, 0x48, 0xd0, 0xa2, 0x37, 0x61, 0xd1, 0x72, 0x1e, 0xe2, 0xa1, 0xeb, 0x9d, 0xf4,
+	0x46, 0xd8, 0xeb, 0x63, 0x27, 0x30, 0x06, 0x38, 0xa4, 0x71, 0x21, 0x6c, 0xdb, 0x8d, 0x9b, 0xd0,
+	0x5b, 0x70, 0x89, 0x6d, 0x98, 0x8f, 0xbd, 0x23, 0xab, 0x8f, 0x7b, 0xc6, 0x91, 0x61, 0xd9, 0xc6,
+	0xbe, 0x8d, 0x3b, 0xa5, 0xe5, 0xe2, 0x4a, 0x55, 0x5f, 0xa2, 0xcd, 0x7b, 0xac, 0xf5, 0x4e, 0xd8,
+	0x88, 0xbe, 0x01, 0xaa, 0x87, 0x0f, 0x3c, 0xec, 0x1f, 0xf6, 0x46, 0x9e, 0x3b, 0xf0, 0xb0, 0xef,
+	0x77, 0xca, 0x14, 0x4d, 0x9b, 0xc3, 0x77, 0x39, 0x58, 0xfb, 0x63, 0x05, 0x96, 0x08, 0x33, 0x76,
+	0x0d, 0x2f, 0xb0, 0x5e, 0xc0, 0x96, 0x68, 0xd0, 0x48, 0xb2, 0xa1, 0x53, 0xa4, 0x6d, 0x02, 0x8c,
+	0xf4, 0x19, 0x85, 0xe8, 0x09, 0xfb, 0x4a, 0x94, 0x54, 0x01, 0xa6, 0xfd, 0x23, 0x97, 0x9d, 0x24,
+	0x9d, 0xb3, 0xec, 0x59, 0x1a, 0x67, 0x21, 0x8b, 0xf3, 0x3c, 0x3b, 0x26, 0xe3, 0x7c, 0x49, 0xce,
+	0xf9, 0xbf, 0x2f, 0xc2, 0xd2, 0x03, 0xd7, 0x30, 0x63, 0x31, 0xfc, 0xfc, 0x39, 0xff, 0x3d, 0xa8,
+	0x30, 0xf5, 0xed, 0x94, 0x28, 0xae, 0xeb, 0x22, 0x2e, 0xd6, 0xb6, 0x16, 0x53, 0xb8, 0x47, 0x01,
+	0x3a, 0x1f, 0x84, 0xae, 0x43, 0xcb, 0xc3, 0x23, 0xdb, 0xea, 0x1b, 0x3d, 0x67, 0x3c, 0xdc, 0xc7,
+	0x5e, 0xa7, 0xbc, 0xac, 0xac, 0x94, 0xf5, 0x26, 0x87, 0xee, 0x50, 0x20, 0xfa, 0x09, 0x34, 0x0f,
+	0x2c, 0x6c, 0x9b, 0x3d, 0xcb, 0x31, 0xf1, 0x27, 0xdb, 0x5b, 0x9d, 0xca, 0x72, 0x71, 0xa5, 0xbe,
+	0xf1, 0xf6, 0x5a, 0xd6, 0xf4, 0xac, 0x49, 0x39, 0xb2, 0x76, 0x8f, 0x0c, 0xdf, 0x66, 0xa3, 0x7f,
+	0xe0, 0x04, 0xde, 0x89, 0xde, 0x38, 0x48, 0x80, 0x50, 0x07, 0xe6, 0x38, 0x7b, 0x3b, 0x73, 0xcb,
+	0xca, 0x4a, 0x55, 0x0f, 0x3f, 0xd1, 0x6b, 0xd0, 0xf6, 0xb0, 0xef, 0x8e, 0xbd, 0x3e, 0xee, 0x0d,
+	0x3c, 0x77, 0x3c, 0xf2, 0x3b, 0xd5, 0xe5, 0xe2, 0x4a, 0x4d, 0x6f, 0x85, 0xe0, 0xfb, 0x14, 0xda,
+	0x7d, 0x17, 0xe6, 0x33, 0x58, 0x90, 0x0a, 0xc5, 0xa7, 0xf8, 0x84, 0x6e, 0x44, 0x51, 0x27, 0x3f,
+	0xd1, 0x22, 0x94, 0x8f, 0x0c, 0x7b, 0x8c, 0x39, 0xab, 0xd9, 0xc7, 0x77, 0x0b, 0xb7, 0x15, 0xed,
+	0xf7, 0x15, 0xe8, 0xe8, 0xd8, 0xc6, 0x86, 0x8f, 0xbf, 0xc8, 0x2d, 0xbd, 0x08, 0x15, 0xc7, 0x35,
+	0xf1, 0xf6, 0x16, 0xdd, 0xd2, 0xa2, 0xce, 0xbf, 0xb4, 0xcf, 0x14, 0x58, 0xbc, 0x8f, 0x03, 0xa2,
+	0x06, 0x96, 0x1f, 0x58, 0xfd, 0x48, 0xcf, 0xbf, 0x07, 0x45, 0x0f, 0x3f, 0xe3, 0x94, 0xdd, 0x10,
+	0x29, 0x8b, 0x6c, 0xbd, 0x6c, 0xa4, 0x4e, 0xc6, 0xa1, 0x97, 0xa1, 0x61, 0x0e, 0xed, 0x5e, 0xff,
+	0xd0, 0x70, 0x1c, 0x6c, 0x33, 0x45, 0xaa, 0xe9, 0x75, 0x73, 0x68, 0x6f, 0x72, 0x10, 0xba, 0x0a,
+	0xe0, 0xe3, 0xc1, 0x10, 0x3b, 0x41, 0x6c, 0x93, 0x13, 0x10, 0xb4, 0x0a, 0xf3, 0x07, 0x9e, 0x3b,
+	0xec, 0xf9, 0x87, 0x86, 0x67, 0xf6, 0x6c, 0x6c, 0x98, 0xd8, 0xa3, 0xd4, 0x57, 0xf5, 0x36, 0x69,
+	0xd8, 0x23, 0xf0, 0x07, 0x14, 0x8c, 0x6e, 0x41, 0xd9, 0xef, 0xbb, 0x23, 0x4c, 0x25, 0xad, 0xb5,
+	0x71, 0x45, 0x26, 0x43, 0x5b, 0x46, 0x60, 0xec, 0x91, 0x4e, 0x3a, 0xeb, 0xab, 0xfd, 0x37, 0x57,
+	0xb5, 0x2f, 0xb9, 0x91, 0x4b, 0xa8, 0x63, 0xf9, 0xf9, 0xa8, 0x63, 0x25, 0x97, 0x3a, 0xce, 0x9d,
+	0xae, 0x8e, 0x19, 0xae, 0x9d, 0x45, 0x1d, 0xab, 0x53, 0xd5, 0xb1, 0xf6, 0x62, 0xd4, 0xf1, 0xaf,
+	0x63, 0x75, 0xfc, 0xb2, 0x6f, 0x7b, 0xac, 0xb2, 0x65, 0x41, 0x65, 0xff, 0x44, 0x81, 0xaf, 0xdd,
+	0xc7, 0x41, 0x44, 0x3e, 0xd1, 0x40, 0xfc, 0x25, 0x3d, 0x9f, 0xff, 0x5c, 0x81, 0xae, 0x8c, 0xd6,
+	0x59, 0xce, 0xe8, 0x8f, 0xe0, 0x62, 0x84, 0xa3, 0x67, 0x62, 0xbf, 0xef, 0x59, 0x23, 0xf2, 0x9b,
+	0x19, 0x99, 0xfa, 0xc6, 0x35, 0x99, 0xc4, 0xa6, 0x29, 0x58, 0x8a, 0xa6, 0xd8, 0x4a, 0xcc, 0xa0,
+	0xfd, 0x86, 0x02, 0x4b, 0xc4, 0xa8, 0x71, 0x2b, 0xe4, 0x1c, 0xb8, 0xe7, 0xe7, 0xab, 0x68, 0xdf,
+	0x0a, 0x19, 0xfb, 0x96, 0x83, 0xc7, 0xd4, 0x37, 0x4e, 0xd3, 0x33, 0x0b, 0xef, 0xbe, 0x05, 0x65,
+	0xcb, 0x39, 0x70, 0x43, 0x56, 0xbd, 0x24, 0x63, 0x55, 0x12, 0x19, 0xeb, 0xad, 0x39, 0x8c, 0x8a,
+	0xd8, 0xe0, 0xce, 0x20, 0x6e, 0xe9, 0x65, 0x17, 0x24, 0xcb, 0xfe, 0x75, 0x05, 0x2e, 0x65, 0x10,
+	0xce, 0xb2, 0xee, 0x77, 0xa0, 0x42, 0x8f, 0x91, 0x70, 0xe1, 0xaf, 0x48, 0x17, 0x9e, 0x40, 0xf7,
+	0xc0, 0xf2, 0x03, 0x9d, 0x8f, 0xd1, 0x5c, 0x50, 0xd3, 0x6d, 0xe4, 0x80, 0xe3, 0x87, 0x5b, 0xcf,
+	0x31, 0x86, 0x8c, 0x01, 0x35, 0xbd, 0xce, 0x61, 0x3b, 0xc6, 0x10, 0xa3, 0xaf, 0x41, 0x95, 0xa8,
+	0x6c, 0xcf, 0x32, 0xc3, 0xed, 0x9f, 0xa3, 0x2a, 0x6c, 0xfa, 0xe8, 0x0a, 0x00, 0x6d, 0x32, 0x4c,
+	0xd3, 0x63, 0x67, 0x5f, 0x4d, 0xaf, 0x11, 0xc8, 0x1d, 0x02, 0xd0, 0x7e, 0x4f, 0x81, 0xab, 0x7b,
+	0x27, 0x4e, 0x7f, 0x07, 0x1f, 0x6f, 0x7a, 0xd8, 0x08, 0x70, 0x6c, 0x6d, 0x5f, 0x28, 0xe3, 0xd1,
+	0x32, 0xd4, 0x13, 0xfa, 0xcb, 0x45, 0x32, 0x09, 0xd2, 0x7e, 0x5b, 0x81, 0x06, 0x31, 0xff, 0x0f,
+	0x71, 0x60, 0x10, 0x11, 0x41, 0xdf, 0x81, 0x9a, 0xed, 0x1a, 0x66, 0x2f, 0x38, 0x19, 0x31, 0x6a,
+	0x5a, 0x1b, 0x97, 0x65, 0xdc, 0x25, 0x83, 0x1e, 0x9d, 0x8c, 0xb0, 0x5e, 0xb5, 0xf9, 0xaf, 0x5c,
+	0x14, 0xa5, 0xad, 0x4c, 0x51, 0x62, 0x65, 0xfe, 0xa6, 0x0c, 0x17, 0x7f, 0x68, 0x04, 0xfd, 0xc3,
+	0xad, 0x61, 0xe8, 0x5d, 0x9c, 0x9f, 0x4d, 0xb1, 0xd9, 0x2d, 0x24, 0xcd, 0xee, 0x73, 0x33, 0xeb,
+	0x91, 0x0a, 0x96, 0x65, 0x2a, 0x48, 0xa2, 0xe3, 0xb5, 0x27, 0x5c, 0x8a, 0x12, 0x2a, 0x98, 0x70,
+	0x02, 0x2a, 0xe7, 0x71, 0x02, 0x36, 0xa1, 0x89, 0x3f, 0xe9, 0xdb, 0x63, 0x22, 0x8e, 0x14, 0x3b,
+	0x3b, 0xdd, 0xaf, 0x4a, 0xb0, 0x27, 0xf5, 0xbf, 0xc1, 0x07, 0x6d, 0x73, 0x1a, 0xd8, 0x56, 0x0f,
+	0x71, 0x60, 0xd0, 0x23, 0xbc, 0xbe, 0xb1, 0x3c, 0x69, 0xab, 0x43, 0xf9, 0x60, 0xdb, 0x4d, 0xbe,
+	0xd0, 0x65, 0xa8, 0x71, 0x97, 0x63, 0x7b, 0xab, 0x53, 0xa3, 0xec, 0x8b, 0x01, 0xc8, 0x80, 0x26,
+	0x37, 0x8e, 0x9c, 0x42, 0xa0, 0x14, 0xbe, 0x23, 0x43, 0x20, 0xdf, 0xec, 0x24, 0xe5, 0x3e, 0x77,
+	0x40, 0xfc, 0x04, 0x88, 0x44, 0xe4, 0xee, 0xc1, 0x81, 0x6d, 0x39, 0x78, 0x87, 0xed, 0x70, 0x9d,
+	0x12, 0x21, 0x02, 0x89, 0x9b, 0x72, 0x84, 0x3d, 0xdf, 0x72, 0x9d, 0x4e, 0x83, 0xb6, 0x87, 0x9f,
+	0xdd, 0x1e, 0xcc, 0x67, 0x50, 0x48, 0xbc, 0x8f, 0x6f, 0x26, 0xbd, 0x8f, 0xe9, 0x3c, 0x4e, 0x78,
+	0x27, 0x3f, 0x53, 0x60, 0xe9, 0xb1, 0xe3, 0x8f, 0xf7, 0xa3, 0xb5, 0x7d, 0x31, 0x72, 0x9c, 0x36,
+	0x6e, 0xa5, 0x8c, 0x71, 0xd3, 0x7e, 0xa7, 0x02, 0x6d, 0xbe, 0x0a, 0xb2, 0xdd, 0xd4, 0x14, 0x5c,
+	0x86, 0x5a, 0x74, 0xbe, 0x71, 0x86, 0xc4, 0x80, 0xb4, 0x6d, 0x29, 0x64, 0x6c, 0x4b, 0x2e, 0xd2,
+	0x42, 0x6f, 0xa5, 0x94, 0xf0, 0x56, 0xae, 0x00, 0x1c, 0xd8, 0x63, 0xff, 0xb0, 0x17, 0x58, 0x43,
+	0xcc, 0xbd, 0xa5, 0x1a, 0x85, 0x3c, 0xb2, 0x86, 0x18, 0xdd, 0x81, 0xc6, 0xbe, 0xe5, 0xd8, 0xee,
+	0xa0, 0x37, 0x32, 0x82, 0x43, 0x9f, 0xc7, 0x99, 0xb2, 0x6d, 0xa1, 0xbe, 0xe5, 0x5d, 0xda, 0x57,
+	0xaf, 0xb3, 0x31, 0xbb, 0x64, 0x08, 0xba, 0x0a, 0x75, 0x67, 0x3c, 0xec, 0xb9, 0x07, 0x3d, 0xcf,
+	0x3d, 0xf6, 0x69, 0x34, 0x59, 0xd4, 0x6b, 0xce, 0x78, 0xf8, 0xe1, 0x81, 0xee, 0x1e, 0x93, 0xf3,
+	0xa5, 0x46, 0x4e, 0x1a, 0xdf, 0x76, 0x07, 0x2c, 0x92, 0x9c, 0x3e, 0x7f, 0x3c, 0x80, 0x8c, 0x36,
+	0xb1, 0x1d, 0x18, 0x74, 0x74, 0x2d, 0xdf, 0xe8, 0x68, 0x00, 0x7a, 0x15, 0x5a, 0x7d, 0x77, 0x38,
+	0x32, 0x28, 0x87, 0xee, 0x79, 0xee, 0x90, 0x6a, 0x4e, 0x51, 0x4f, 0x41, 0xd1, 0x26, 0xd4, 0xa9,
+	0x6b, 0xcf, 0xd5, 0xab, 0x4e, 0xf1, 0x68, 0x32, 0xf5, 0x4a, 0xb8, 0xd8, 0x44, 0x40, 0xc1, 0x0a,
+	0x7f, 0xfa, 0x44, 0x32, 0x42, 0x2d, 0xf5, 0xad, 0x4f, 0x31, 0xd7, 0x90, 0x3a, 0x87, 0xed, 0x59,
+	0x9f, 0x62, 0x12, 0x6f, 0x58, 0x8e, 0x8f, 0xbd, 0x20, 0x8c, 0xfe, 0x3a, 0x4d, 0x2a, 0x3e, 0x4d,
+	0x06, 0xe5, 0x82, 0x8d, 0xb6, 0xa0, 0xe5, 0x07, 0x86, 0x17, 0xf4, 0x46, 0xae, 0x4f, 0x05, 0xa0,
+	0xd3, 0xa2, 0xb2, 0x9d, 0x8a, 0xdd, 0x48, 0xe6, 0xf0, 0xa1, 0x3f, 0xd8, 0xe5, 0x9d, 0xf4, 0x26,
+	0x1d, 0x14, 0x7e, 0xa2, 0xef, 0x43, 0x03, 0x3b, 0x66, 0x3c, 0x47, 0x3b, 0xcf, 0x1c, 0x75, 0xec,
+	0x98, 0xd1, 0x0c, 0xef, 0x43, 0xbb, 0x6f, 0x8f, 0xfd, 0x00, 0x7b, 0x96, 0x33, 0xa0, 0xbc, 0xe9,
+	0xa8, 0x74, 0x92, 0x97, 0x25, 0x5b, 0xb0, 0x19, 0xf5, 0xa4, 0x9c, 0x69, 0xf5, 0x85, 0x6f, 0xed,
+	0xbf, 0x0a, 0xd0, 0x12, 0x99, 0x47, 0xac, 0x09, 0x0b, 0x82, 0x42, 0x8d, 0x08, 0x3f, 0x09, 0x2b,
+	0xb1, 0x43, 0x12, 0x73, 0x2c, 0xe2, 0xa2, 0x0a, 0x51, 0xd5, 0xeb, 0x0c, 0x46, 0x27, 0x20, 0x82,
+	0xcd, 0xb6, 0x8c, 0x6a, 0x61, 0x91, 0xb2, 0xb1, 0x46, 0x21, 0xd4, 0xc1, 0xe8, 0xc0, 0x5c, 0x18,
+	0xac, 0x31, 0x75, 0x08, 0x3f, 0x49, 0xcb, 0xfe, 0xd8, 0xa2, 0x58, 0x99, 0x3a, 0x84, 0x9f, 0x68,
+	0x0b, 0x1a, 0x6c, 0xca, 0x91, 0xe1, 0x19, 0xc3, 0x50, 0x19, 0x5e, 0x96, 0x1a, 0x94, 0x0f, 0xf0,
+	0xc9, 0x13, 0x62, 0x9b, 0x76, 0x0d, 0xcb, 0xd3, 0x99, 0xf0, 0xec, 0xd2, 0x51, 0x68, 0x05, 0x54,
+	0x36, 0xcb, 0x81, 0x65, 0x63, 0xae, 0x56, 0x73, 0x2c, 0x62, 0xa3, 0xf0, 0x7b, 0x96, 0x8d, 0x99,
+	0xe6, 0x44, 0x4b, 0xa0, 0xe2, 0x52, 0x65, 0x8a, 0x43, 0x21, 0x54, 0x58, 0xae, 0x41, 0x93, 0x35,
+	0x87, 0x26, 0x97, 0x9d, 0x0b, 0x8c, 0xc6, 0x27, 0x0c, 0x46, 0x1d, 0xa9, 0xf1, 0x90, 0xa9, 0x1e,
+	0xb0, 0xe5, 0x38, 0xe3, 0x21, 0x51, 0x3c, 0xed, 0xef, 0x4a, 0xb0, 0x40, 0xec, 0x0f, 0x37, 0x45,
+	0x33, 0x9c, 0xfb, 0x57, 0x00, 0x4c, 0x3f, 0xe8, 0x09, 0x36, 0xb3, 0x66, 0xfa, 0x01, 0x3f, 0x15,
+	0xbe, 0x13, 0x1e, 0xdb, 0xc5, 0xc9, 0x41, 0x46, 0xca, 0x1e, 0x66, 0x8f, 0xee, 0x73, 0xa5, 0xd3,
+	0xae, 0x41, 0x93, 0x87, 0xc6, 0x42, 0x38, 0xd8, 0x60, 0xc0, 0x1d, 0xb9, 0x55, 0xaf, 0x48, 0xd3,
+	0x7a, 0x89, 0xe3, 0x7b, 0x6e, 0xb6, 0xe3, 0xbb, 0x9a, 0x3e, 0xbe, 0xef, 0x41, 0x9b, 0x9a, 0xa4,
+	0x48, 0x15, 0x43, 0x4b, 0x36, 0x45, 0x17, 0x5b, 0x74, 0x54, 0xf8, 0xe9, 0x27, 0x4f, 0x5f, 0x10,
+	0x4e, 0x5f, 0xc2, 0x07, 0x07, 0x63, 0xb3, 0x17, 0x78, 0x86, 0xe3, 0x1f, 0x60, 0x8f, 0x9e, 0xde,
+	0x55, 0xbd, 0x41, 0x80, 0x8f, 0x38, 0x0c, 0xbd, 0x03, 0x40, 0xd7, 0xc8, 0xb2, 0x41, 0x8d, 0xc9,
+	0xd9, 0x20, 0x2a, 0x34, 0xa4, 0x93, 0x5e, 0xb3, 0xc3, 0x9f, 0xda, 0x3f, 0x14, 0xe0, 0x22, 0xcf,
+	0x0e, 0xcc, 0x2e, 0x50, 0x93, 0x0e, 0xe0, 0xf0, 0x04, 0x2b, 0x9e, 0x12, 0x6f, 0x97, 0x72, 0x38,
+	0x97, 0x65, 0x89, 0x73, 0x29, 0xc6, 0x9c, 0x95, 0x4c, 0xcc, 0x19, 0xe5, 0xc9, 0xe6, 0xf2, 0xe7,
+	0xc9, 0x48, 0x36, 0x85, 0x06, 0x42, 0x74, 0xd3, 0x6b, 0x3a, 0xfb, 0xc8, 0xb5, 0x1d, 0xda, 0xef,
+	0x16, 0xa0, 0xb9, 0x87, 0x0d, 0xaf, 0x7f, 0x18, 0xf2, 0xf1, 0xad, 0x64, 0x5e, 0xf1, 0x95, 0x09,
+	0x79, 0x45, 0x61, 0xc8, 0x57, 0x26, 0xa1, 0x48, 0x10, 0x04, 0x6e, 0x60, 0x44, 0x54, 0x92, 0x7c,
+	0x1b, 0x4f, 0xb6, 0xb5, 0x69, 0x03, 0x27, 0x75, 0x67, 0x3c, 0xd4, 0xfe, 0x43, 0x81, 0xc6, 0x2f,
+	0x90, 0x69, 0x42, 0xc6, 0xdc, 0x4e, 0x32, 0xe6, 0xd5, 0x09, 0x8c, 0xd1, 0x71, 0xe0, 0x59, 0xf8,
+	0x08, 0x7f, 0xe5, 0x72, 0xad, 0x7f, 0xab, 0x40, 0x97, 0x44, 0xb4, 0x3a, 0x33, 0x18, 0xb3, 0x6b,
+	0xd7, 0x35, 0x68, 0x1e, 0x09, 0x3e, 0x6a, 0x81, 0x0a, 0x67, 0xe3, 0x28, 0x19, 0x81, 0xeb, 0xa4,
+	0xee, 0xc2, 0x52, 0x9f, 0x7c, 0xb1, 0xa1, 0xfd, 0x7e, 0x4d, 0x46, 0x75, 0x8a, 0x38, 0x6a, 0xff,
+	0xda, 0x9e, 0x08, 0xd4, 0x7e, 0x53, 0x81, 0x05, 0x49, 0x47, 0x74, 0x09, 0xe6, 0x78, 0xb4, 0xdf,
+	0x51, 0x12, 0xfa, 0x6e, 0x92, 0xed, 0x89, 0xf3, 0x55, 0x96, 0x99, 0x75, 0x7c, 0x4d, 0xf4, 0x12,
+	0xd4, 0xa3, 0xd8, 0xc7, 0xcc, 0xec, 0x8f, 0xe9, 0xa3, 0x2e, 0x54, 0xb9, 0x19, 0x0c, 0x83, 0xca,
+	0xe8, 0x5b, 0x7b, 0x0a, 0xe8, 0x3e, 0x8e, 0x0f, 0x9d, 0x59, 0x38, 0x1a, 0xdb, 0x9b, 0x98, 0xd0,
+	0xa4, 0x11, 0x32, 0xb5, 0x7f, 0x55, 0x60, 0x41, 0xc0, 0x36, 0x4b, 0x56, 0x26, 0x3e, 0x18, 0x0b,
+	0xe7, 0x39, 0x18, 0x85, 0xcc, 0x43, 0xf1, 0x4c, 0x99, 0x87, 0xab, 0x00, 0x11, 0xff, 0x43, 0x8e,
+	0x26, 0x20, 0xda, 0x5f, 0x29, 0x70, 0xf1, 0x3d, 0xc3, 0x31, 0xdd, 0x83, 0x83, 0xd9, 0x45, 0x75,
+	0x13, 0x84, 0x30, 0x34, 0x6f, 0xee, 0x4d, 0x18, 0x84, 0x6e, 0xc0, 0xbc, 0xc7, 0x4e, 0x26, 0x53,
+	0x94, 0xe5, 0xa2, 0xae, 0x86, 0x0d, 0x91, 0x8c, 0xfe, 0x59, 0x01, 0x10, 0x59, 0xf5, 0x5d, 0xc3,
+	0x36, 0x9c, 0x3e, 0x3e, 0x3f, 0xe9, 0xd7, 0xa1, 0x25, 0xf8, 0x1e, 0x51, 0x11, 0x3b, 0xe9, 0x7c,
+	0xf8, 0xe8, 0x03, 0x68, 0xed, 0x33, 0x54, 0x3d, 0x0f, 0x1b, 0xbe, 0xeb, 0xf0, 0xed, 0x90, 0xa6,
+	0xd9, 0x1e, 0x79, 0xd6, 0x60, 0x80, 0xbd, 0x4d, 0xd7, 0x31, 0xb9, 0x4b, 0xbf, 0x1f, 0x92, 0x49,
+	0x86, 0x12, 0x65, 0x88, 0x1d, 0xb1, 0x68, 0x73, 0x22, 0x4f, 0x8c, 0xb2, 0xc2, 0xc7, 0x86, 0x1d,
+	0x33, 0x22, 0x3e, 0x0d, 0x55, 0xd6, 0xb0, 0x37, 0x39, 0xcb, 0x2a, 0x71, 0x8c, 0xb4, 0xbf, 0x50,
+	0x00, 0x45, 0x11, 0x37, 0xcd, 0x2d, 0x50, 0x8d, 0x4e, 0x0f, 0x55, 0xb2, 0x43, 0x89, 0x53, 0x64,
+	0x86, 0x23, 0xb9, 0x09, 0x8a, 0x01, 0xf4, 0x8c, 0xa4, 0x44, 0xf7, 0x88, 0xe4, 0x61, 0x33, 0x8c,
+	0x68, 0x19, 0xf0, 0x01, 0x85, 0x89, 0x7e, 0x55, 0x29, 0xed, 0x57, 0x25, 0x93, 0x88, 0x65, 0x21,
+	0x89, 0xa8, 0xfd, 0xac, 0x00, 0x2a, 0x3d, 0x42, 0x36, 0xe3, 0x74, 0x51, 0x2e, 0xa2, 0xaf, 0x41,
+	0x93, 0xdf, 0xf8, 0x10, 0x08, 0x6f, 0x3c, 0x4b, 0x4c, 0x86, 0x6e, 0xc2, 0x22, 0xeb, 0xe4, 0x61,
+	0x7f, 0x6c, 0xc7, 0xc1, 0x1c, 0x8b, 0x42, 0xd0, 0x33, 0x76, 0x76, 0x91, 0xa6, 0x70, 0xc4, 0x63,
+	0xb8, 0x38, 0xb0, 0xdd, 0x7d, 0xc3, 0xee, 0x89, 0xdb, 0xc3, 0xf6, 0x30, 0x87, 0xc4, 0x2f, 0xb2,
+	0xe1, 0x7b, 0xc9, 0x3d, 0xf4, 0xd1, 0x5d, 0x92, 0x18, 0xc2, 0x4f, 0xe3, 0x18, 0xaf, 0x9c, 0x27,
+	0xc6, 0x6b, 0x90, 0x31, 0xe1, 0x97, 0xf6, 0x07, 0x0a, 0xb4, 0x53, 0x25, 0x80, 0x74, 0x3e, 0x42,
+	0xc9, 0xe6, 0x23, 0x6e, 0x43, 0x99, 0x58, 0x2a, 0x76, 0xb6, 0xb4, 0xe4, 0xb1, 0xb2, 0x38, 0xab,
+	0xce, 0x06, 0xa0, 0x75, 0x58, 0x90, 0xdc, 0x11, 0xe0, 0xdb, 0x8f, 0xb2, 0x57, 0x04, 0xb4, 0x9f,
+	0x97, 0xa0, 0x9e, 0x60, 0xc5, 0x94, 0x54, 0xca, 0x73, 0x49, 0xe5, 0x4e, 0xaa, 0x09, 0x13, 0x91,
+	0x1b, 0xe2, 0x21, 0x0b, 0xd8, 0x78, 0xf4, 0x38, 0xc4, 0x43, 0x1a, 0xae, 0x25, 0x23, 0xb1, 0x8a,
+	0x10, 0x89, 0xa5, 0x62, 0xd5, 0xb9, 0x53, 0x62, 0xd5, 0xaa, 0x18, 0xab, 0x0a, 0x2a, 0x54, 0x4b,
+	0xab, 0x50, 0xde, 0xec, 0xc6, 0x4d, 0x58, 0xe8, 0xb3, 0x54, 0xf9, 0xdd, 0x93, 0xcd, 0xa8, 0x89,
+	0x3b, 0xa5, 0xb2, 0x26, 0x74, 0x2f, 0x4e, 0x38, 0xb2, 0x5d, 0x66, 0xd1, 0x82, 0x3c, 0x14, 0xe6,
+	0x7b, 0xc3, 0x36, 0xb9, 0xe1, 0x27, 0xbe, 0xd2, 0x79, 0x95, 0xe6, 0xb9, 0xf2, 0x2a, 0x2f, 0x41,
+	0x3d, 0xf4, 0x54, 0x88, 0xa6, 0xb7, 0x98, 0xd1, 0xe3, 0x20, 0xe2, 0x01, 0x24, 0xed, 0x40, 0x5b,
+	0x2c, 0x26, 0xa4, 0x13, 0x09, 0x6a, 0x36, 0x91, 0x70, 0x09, 0xe6, 0x2c, 0xbf, 0x77, 0x60, 0x3c,
+	0xc5, 0x9d, 0x79, 0xda, 0x5a, 0xb1, 0xfc, 0x7b, 0xc6, 0x53, 0xac, 0xfd, 0x53, 0x11, 0x5a, 0xf1,
+	0x01, 0x9b, 0xdb, 0x82, 0xe4, 0xb9, 0x27, 0xb3, 0x03, 0x6a, 0xf4, 0xcd, 0x38, 0x7c, 0x6a, 0xf0,
+	0x9c, 0xae, 0xd0, 0xb5, 0x47, 0x29, 0x7d, 0x15, 0x8e, 0xfb, 0xd2, 0x99, 0x8e, 0xfb, 0x19, 0x2b,
+	0xe8, 0xb7, 0x60, 0x29, 0x3a, 0x7b, 0x85, 0x65, 0xb3, 0x00, 0x6b, 0x31, 0x6c, 0xdc, 0x4d, 0x2e,
+	0x7f, 0x82, 0x09, 0x98, 0x9b, 0x64, 0x02, 0xd2, 0x22, 0x50, 0xcd, 0x88, 0x40, 0xb6, 0x90, 0x5f,
+	0x93, 0x14, 0xf2, 0xb5, 0xc7, 0xb0, 0x40, 0x73, 0xc8, 0xa4, 0xac, 0xb9, 0x8f, 0xa3, 0x10, 0x20,
+	0xcf, 0xb6, 0x76, 0xa1, 0x9a, 0x8a, 0x22, 0xa2, 0x6f, 0xed, 0xa7, 0x0a, 0x5c, 0xcc, 0xce, 0x4b,
+	0x25, 0x26, 0x36, 0x24, 0x8a, 0x60, 0x48, 0x7e, 0x11, 0x16, 0x12, 0x1e, 0xa5, 0x30, 0xf3, 0x04,
+	0x0f, 0x5c, 0x42, 0xb8, 0x8e, 0xe2, 0x39, 0x42, 0x98, 0xf6, 0x73, 0x25, 0x4a, 0xc5, 0x13, 0xd8,
+	0x80, 0x16, 0x28, 0xc8, 0xb9, 0xe6, 0x3a, 0xb6, 0xe5, 0xe0, 0x9e, 0x40, 0x4e, 0x83, 0x01, 0x79,
+	0xa6, 0xe4, 0x3d, 0x68, 0xf3, 0x4e, 0xd1, 0xf1, 0x94, 0xd3, 0x21, 0x6b, 0xb1, 0x71, 0xd1, 0xc1,
+	0x74, 0x1d, 0x5a, 0xbc, 0x72, 0x10, 0xe2, 0x2b, 0xca, 0xea, 0x09, 0xef, 0x83, 0x1a, 0x76, 0x3b,
+	0xeb, 0x81, 0xd8, 0xe6, 0x03, 0x23, 0xc7, 0xee, 0x57, 0x15, 0xe8, 0x88, 0xc7, 0x63, 0x62, 0xf9,
+	0x67, 0x77, 0xef, 0xde, 0x16, 0xcb, 0xc1, 0xd7, 0x4f, 0xa1, 0x27, 0xc6, 0x13, 0x16, 0x85, 0x7f,
+	0xab, 0x40, 0x6b, 0xfb, 0x24, 0xd4, 0xdb, 0xb2, 0xfc, 0xc0, 0xb3, 0xf6, 0xc7, 0xb3, 0x15, 0x28,
+	0x0d, 0xa8, 0xf7, 0x0f, 0x71, 0xff, 0xe9, 0xc8, 0xb5, 0xe2, 0x5d, 0x79, 0x57, 0x46, 0xd3, 0x64,
+	0xb4, 0x6b, 0x9b, 0xf1, 0x0c, 0xac, 0x04, 0x94, 0x9c, 0xb3, 0xfb, 0x23, 0x50, 0xd3, 0x1d, 0x92,
+	0x05, 0x9c, 0x1a, 0x2b, 0xe0, 0xdc, 0x12, 0x0b, 0x38, 0x53, 0x3c, 0x8d, 0x44, 0xfd, 0xe6, 0x2f,
+	0x0b, 0xf0, 0x75, 0x29, 0x6d, 0xb3, 0x44, 0x49, 0x93, 0xf2, 0x48, 0x77, 0xa1, 0x9a, 0x0a, 0x6a,
+	0x5f, 0x3d, 0x65, 0xff, 0x78, 0x2e, 0x95, 0xe5, 0xf4, 0xfc, 0xd8, 0xb7, 0x8a, 0x15, 0xbe, 0x34,
+	0x79, 0x0e, 0xae, 0x77, 0xc2, 0x1c, 0xe1, 0x38, 0x52, 0x5e, 0x61, 0x09, 0x83, 0xde, 0x91, 0x85,
+	0x8f, 0xc3, 0xba, 0xe6, 0x55, 0xa9, 0x69, 0xa6, 0xfd, 0x9e, 0x58, 0xf8, 0x58, 0xaf, 0xdb, 0xd1,
+	0x6f, 0x5f, 0xfb, 0xcf, 0x22, 0x40, 0xdc, 0x46, 0xa2, 0xb3, 0x58, 0xe7, 0xb9, 0x12, 0x27, 0x20,
+	0xc4, 0x97, 0x10, 0x3d, 0xd7, 0xf0, 0x13, 0xe9, 0x71, 0x79, 0xc2, 0xb4, 0xfc, 0x80, 0xf3, 0x65,
+	0xfd, 0x74, 0x5a, 0x42, 0x16, 0x91, 0x2d, 0xe3, 0x32, 0xe3, 0xc7, 0x10, 0xf4, 0x06, 0xa0, 0x81,
+	0xe7, 0x1e, 0x93, 0xea, 0x40, 0x22, 0xde, 0x60, 0x61, 0xc9, 0x3c, 0x6f, 0x49, 0x04, 0x1c, 0x3f,
+	0x06, 0x35, 0xd5, 0x3d, 0x64, 0xc9, 0xad, 0x29, 0x64, 0xdc, 0x17, 0xe6, 0xe2, 0xe2, 0xdb, 0x16,
+	0x31, 0xf8, 0xdd, 0x1e, 0xa8, 0x69, 0x7a, 0x25, 0x35, 0xc8, 0x6f, 0x89, 0x22, 0x7c, 0x9a, 0xa5,
+	0x21, 0xd3, 0x24, 0x84, 0xb8, 0x6b, 0xc0, 0xa2, 0x8c, 0x12, 0x09, 0x92, 0x73, 0xeb, 0xc9, 0xbb,
+	0x50, 0x4f, 0x20, 0x9f, 0x78, 0x7e, 0x24, 0x72, 0xc1, 0x05, 0x21, 0x17, 0xac, 0xfd, 0x5a, 0x01,
+	0x50, 0x56, 0xb0, 0x51, 0x0b, 0x0a, 0xd1, 0x24, 0x85, 0xed, 0xad, 0x94, 0x20, 0x15, 0x32, 0x82,
+	0x74, 0x19, 0x6a, 0xd1, 0x79, 0xce, 0x8d, 0x77, 0x0c, 0x48, 0x8a, 0x59, 0x49, 0x14, 0xb3, 0x04,
+	0x61, 0x65, 0x31, 0x49, 0x7d, 0x13, 0x16, 0x6d, 0xc3, 0x0f, 0x7a, 0x2c, 0x17, 0x1e, 0x58, 0x43,
+	0xec, 0x07, 0xc6, 0x70, 0x44, 0x9d, 0xe5, 0x92, 0x8e, 0x48, 0xdb, 0x16, 0x69, 0x7a, 0x14, 0xb6,
+	0xa0, 0x3b, 0xa1, 0xdf, 0x4c, 0x4b, 0x4f, 0x73, 0xb9, 0xbd, 0xc7, 0x5a, 0xe4, 0x3d, 0x6a, 0x87,
+	0x80, 0xb2, 0x1a, 0x9a, 0x24, 0x5f, 0x11, 0xc9, 0x9f, 0xc6, 0x96, 0xc4, 0xf2, 0x8a, 0x22, 0xdf,
+	0xff, 0xa8, 0x08, 0x28, 0x76, 0x93, 0xa2, 0xc2, 0x6f, 0x1e, 0xdf, 0x62, 0x1d, 0x16, 0xb2, 0x4e,
+	0x54, 0xe8, 0x39, 0xa2, 0x8c, 0x0b, 0x25, 0x73, 0x77, 0x8a, 0xb2, 0x7b, 0x8b, 0x6f, 0x45, 0x36,
+	0x95, 0xf9, 0x84, 0x57, 0x27, 0x66, 0xfb, 0x45, 0xb3, 0xfa, 0xa3, 0xf4, 0x7d, 0x47, 0xa6, 0xa4,
+	0xb7, 0xa5, 0xf6, 0x2f, 0xb3, 0xe4, 0xa9, 0x97, 0x1d, 0x05, 0x6f, 0xb5, 0x72, 0x16, 0x6f, 0x75,
+	0xf6, 0x4b, 0x8e, 0xff, 0x52, 0x80, 0xf9, 0x88, 0x91, 0x67, 0xda, 0xa4, 0xe9, 0x35, 0xfa, 0x17,
+	0xbc, 0x2b, 0x1f, 0xcb, 0x77, 0xe5, 0xdb, 0xa7, 0x46, 0x0c, 0x79, 0x37, 0x65, 0x76, 0xce, 0x7e,
+	0x0a, 0x73, 0x3c, 0xf7, 0x9b, 0xb1, 0x35, 0x79, 0x62, 0xf2, 0x45, 0x28, 0x13, 0xd3, 0x16, 0x26,
+	0xee, 0xd8, 0x07, 0x63, 0x69, 0xf2, 0xf6, 0x2b, 0x37, 0x37, 0x4d, 0xe1, 0xf2, 0xab, 0xf6, 0xef,
+	0x0a, 0x00, 0x49, 0xa1, 0xdf, 0x61, 0x4a, 0x7a, 0x13, 0x4a, 0xd3, 0xae, 0x5c, 0x91, 0xde, 0x54,
+	0xb6, 0x68, 0xcf, 0x1c, 0x9b, 0x2b, 0x64, 0x1d, 0x8a, 0xe9, 0xac, 0xc3, 0xa4, 0x7c, 0xc1, 0x64,
+	0x6b, 0xf8, 0x6d, 0x28, 0x51, 0xab, 0xc6, 0xae, 0x2c, 0xe5, 0xaa, 0x99, 0xd2, 0x01, 0xda, 0x67,
+	0xe4, 0x31, 0xce, 0x89, 0xd3, 0x7f, 0x3e, 0x8e, 0x65, 0x9e, 0xad, 0x49, 0x58, 0xcb, 0xa2, 0x68,
+	0x2d, 0x6f, 0xc3, 0x1c, 0xcb, 0x18, 0x84, 0x2e, 0xd2, 0xd5, 0x49, 0xbc, 0x66, 0x3b, 0xa3, 0x87,
+	0xdd, 0x67, 0x0d, 0x3b, 0x85, 0x7a, 0x6d, 0x65, 0xb6, 0x7a, 0xed, 0x5c, 0x3a, 0xaf, 0x98, 0xd8,
+	0xb4, 0xaa, 0x68, 0xe3, 0x1f, 0x43, 0x53, 0x4f, 0x0a, 0x1e, 0x29, 0x58, 0x26, 0xae, 0x38, 0xd2,
+	0xdf, 0x34, 0x52, 0x34, 0x46, 0x46, 0xdf, 0x0a, 0x4e, 0x28, 0x3b, 0xcb, 0x7a, 0xf4, 0x2d, 0x97,
+	0x72, 0xed, 0x7f, 0x14, 0xb8, 0x18, 0xd6, 0x05, 0xb9, 0x0e, 0x9d, 0x7f, 0x47, 0x37, 0x60, 0x89,
+	0x2b, 0x4c, 0x4a, 0x73, 0x98, 0x3f, 0xb8, 0xc0, 0x60, 0xe2, 0x32, 0x36, 0x60, 0x29, 0x30, 0xbc,
+	0x01, 0x0e, 0xd2, 0x63, 0xd8, 0x7e, 0x2f, 0xb0, 0x46, 0x71, 0x4c, 0x9e, 0xba, 0xec, 0x4b, 0xec,
+	0x6e, 0x10, 0x67, 0x2d, 0x57, 0x01, 0x20, 0x69, 0x31, 0x06, 0xd1, 0x8e, 0xe1, 0x32, 0xbb, 0x64,
+	0xbc, 0x2f, 0x52, 0x34, 0x53, 0x5a, 0x5e, 0xba, 0xee, 0x94, 0xc5, 0xf8, 0x43, 0x05, 0xae, 0x4c,
+	0xc0, 0x3c, 0x4b, 0x40, 0xf2, 0x40, 0x8a, 0x7d, 0x42, 0xf8, 0x28, 0xe0, 0xa5, 0x12, 0x9a, 0x22,
+	0xf2, 0xb3, 0x12, 0xcc, 0x67, 0x3a, 0x9d, 0x59, 0xe6, 0x5e, 0x07, 0x44, 0x36, 0x21, 0x7a, 0x09,
+	0x47, 0x23, 0x72, 0x7e, 0x34, 0xa9, 0xce, 0x78, 0x18, 0xbd, 0x82, 0x23, 0x41, 0x39, 0xb2, 0x58,
+	0x6f, 0x96, 0x94, 0x8f, 0x76, 0xae, 0x34, 0xf9, 0xc1, 0x43, 0x86, 0xc0, 0xb5, 0x9d, 0xf1, 0x90,
+	0xe5, 0xef, 0xf9, 0x2e, 0xb3, 0xe3, 0x46, 0x75, 0x52, 0x60, 0x74, 0x00, 0xf3, 0x04, 0x95, 0x3b,
+	0x0e, 0x06, 0x2e, 0x89, 0x09, 0x28, 0x5d, 0xec, 0x50, 0xfb, 0x6e, 0x6e, 0x4c, 0x1f, 0xf2, 0xd1,
+	0x84, 0x78, 0x1e, 0x16, 0x38, 0x22, 0x34, 0xc4, 0x63, 0x39, 0x7d, 0x77, 0x18, 0xe1, 0xa9, 0x9c,
+	0x11, 0xcf, 0x36, 0x1f, 0x2d, 0xe2, 0x49, 0x42, 0xbb, 0x9b, 0xb0, 0x24, 0x5d, 0xfa, 0xb4, 0x63,
+	0xb4, 0x9c, 0x0c, 0x31, 0xee, 0xc2, 0xa2, 0x6c, 0x55, 0xe7, 0x98, 0x23, 0x43, 0xf1, 0x59, 0xe6,
+	0xd0, 0xfe, 0xb4, 0x00, 0xcd, 0x2d, 0x6c, 0xe3, 0x00, 0xbf, 0xd8, 0xb2, 0x69, 0xa6, 0x06, 0x5c,
+	0xcc, 0xd6, 0x80, 0x33, 0x05, 0xed, 0x92, 0xa4, 0xa0, 0x7d, 0x25, 0xaa, 0xe3, 0x93, 0x59, 0xca,
+	0xe2, 0x09, 0x6d, 0xa2, 0xb7, 0xa1, 0x31, 0xf2, 0xac, 0xa1, 0xe1, 0x9d, 0xf4, 0x9e, 0xe2, 0x13,
+	0x9f, 0x1f, 0x1a, 0x1d, 0xe9, 0xb1, 0xb3, 0xbd, 0xe5, 0xeb, 0x75, 0xde, 0xfb, 0x03, 0x7c, 0x42,
+	0xef, 0x08, 0x44, 0xf1, 0x0a, 0xbb, 0xcd, 0x55, 0xd2, 0x13, 0x10, 0xcd, 0x83, 0x36, 0xf5, 0x9b,
+	0x74, 0x7c, 0x80, 0x3d, 0xec, 0xf4, 0x71, 0xee, 0x9c, 0x22, 0xbf, 0x7b, 0x16, 0x3a, 0xfb, 0xd1,
+	0x37, 0x31, 0x9d, 0xe3, 0x91, 0x69, 0x04, 0x98, 0xdd, 0xdc, 0x64, 0x5c, 0x01, 0x06, 0x22, 0x11,
+	0xd2, 0xea, 0x3b, 0x50, 0x8b, 0x2e, 0xea, 0xa0, 0x2a, 0x94, 0xee, 0x8d, 0x6d, 0x5b, 0xbd, 0x80,
+	0x6a, 0x50, 0xa6, 0x51, 0x94, 0xaa, 0x90, 0x9f, 0x94, 0x2a, 0xb5, 0x80, 0xda, 0x50, 0xdf, 0x3b,
+	0xb6, 0x68, 0xf5, 0x8e, 0x00, 0x8a, 0xab, 0xdf, 0x87, 0x5a, 0x74, 0x11, 0x01, 0xd5, 0x61, 0xee,
+	0xb1, 0xf3, 0x81, 0xe3, 0x1e, 0x3b, 0xea, 0x05, 0x34, 0x07, 0xc5, 0x3b, 0xb6, 0xad, 0x2a, 0xa8,
+	0x09, 0xb5, 0xbd, 0xc0, 0xc3, 0x06, 0x91, 0x21, 0xb5, 0x80, 0x5a, 0x00, 0xef, 0x59, 0x7e, 0xe0,
+	0x7a, 0x56, 0xdf, 0xb0, 0xd5, 0xe2, 0xea, 0xa7, 0xd0, 0x12, 0xf3, 0xd2, 0xa8, 0x01, 0xd5, 0x1d,
+	0x37, 0xf8, 0xc1, 0x27, 0x96, 0x1f, 0xa8, 0x17, 0x48, 0xff, 0x1d, 0x37, 0xd8, 0xf5, 0xb0, 0x8f,
+	0x9d, 0x40, 0x55, 0x10, 0x40, 0xe5, 0x43, 0x67, 0xcb, 0xf2, 0x9f, 0xaa, 0x05, 0xb4, 0xc0, 0x4b,
+	0x4e, 0x86, 0xbd, 0xcd, 0x93, 0xbd, 0x6a, 0x91, 0x0c, 0x8f, 0xbe, 0x4a, 0x48, 0x85, 0x46, 0xd4,
+	0xe5, 0xfe, 0xee, 0x63, 0xb5, 0xcc, 0x96, 0x43, 0x7e, 0x56, 0x56, 0x4d, 0x50, 0xd3, 0xa5, 0x52,
+	0x32, 0x27, 0x5b, 0x44, 0x04, 0x52, 0x2f, 0x90, 0x95, 0xf1, 0x5a, 0xb5, 0xaa, 0x10, 0x26, 0x24,
+	0x2a, 0xbf, 0x8c, 0x2b, 0xf7, 0xbd, 0x51, 0x9f, 0x0b, 0x38, 0x23, 0x81, 0x68, 0xcb, 0x16, 0xe1,
+	0x44, 0x69, 0xf5, 0x2e, 0x54, 0xc3, 0x10, 0x84, 0x74, 0xe5, 0x2c, 0x22, 0x9f, 0xea, 0x05, 0x34,
+	0x0f, 0x4d, 0xe1, 0xa9, 0x97, 0xaa, 0x20, 0x04, 0x2d, 0xf1, 0x31, 0xa6, 0x5a, 0x58, 0xdd, 0x00,
+	0x88, 0x5d, 0x79, 0x42, 0xce, 0xb6, 0x73, 0x64, 0xd8, 0x96, 0xc9, 0x68, 0x23, 0x4d, 0x84, 0xbb,
+	0x94, 0x3b, 0xcc, 0x70, 0xa8, 0x85, 0xd5, 0x55, 0xa8, 0x86, 0xee, 0x29, 0x81, 0xeb, 0x78, 0xe8,
+	0x1e, 0x61, 0xb6, 0x33, 0x7b, 0x38, 0x60, 0x1b, 0x7b, 0x67, 0x88, 0x1d, 0x53, 0x2d, 0x6c, 0xfc,
+	0xdb, 0x02, 0x00, 0x2b, 0x74, 0xba, 0xae, 0x67, 0x22, 0x9b, 0x5e, 0x78, 0x20, 0x95, 0x1c, 0xd7,
+	0x09, 0xab, 0x30, 0x3e, 0x5a, 0x4b, 0x65, 0x1f, 0xd8, 0x47, 0xb6, 0x23, 0x67, 0x44, 0xf7, 0x15,
+	0x69, 0xff, 0x54, 0x67, 0xed, 0x02, 0x1a, 0x52, 0x6c, 0x44, 0x1a, 0x1f, 0x59, 0xfd, 0xa7, 0x51,
+	0x75, 0x74, 0xf2, 0x8b, 0xc8, 0x54, 0xd7, 0x10, 0xdf, 0x35, 0x29, 0xbe, 0xbd, 0x80, 0x5c, 0x1e,
+	0x0d, 0xcf, 0x63, 0xed, 0x02, 0x7a, 0x96, 0x7a, 0x8f, 0x19, 0x22, 0xdc, 0xc8, 0xf3, 0x04, 0xf3,
+	0x7c, 0x28, 0x6d, 0x68, 0xa7, 0x1e, 0xbe, 0xa3, 0x55, 0xf9, 0xfb, 0x18, 0xd9, 0x23, 0xfd, 0xee,
+	0x8d, 0x5c, 0x7d, 0x23, 0x6c, 0x16, 0xb4, 0xc4, 0x17, 0xdb, 0xe8, 0x1b, 0x93, 0x26, 0xc8, 0xbc,
+	0xd0, 0xeb, 0xae, 0xe6, 0xe9, 0x1a, 0xa1, 0xfa, 0x88, 0xc9, 0xea, 0x34, 0x54, 0xd2, 0xd7, 0x8c,
+	0xdd, 0xd3, 0x5c, 0x21, 0xed, 0x02, 0xfa, 0x09, 0xf1, 0x5a, 0x52, 0xef, 0x08, 0xd1, 0xeb, 0xf2,
+	0x93, 0x56, 0xfe, 0xdc, 0x70, 0x1a, 0x86, 0x8f, 0xd2, 0x9a, 0x36, 0x99, 0xfa, 0xcc, 0xcb, 0xe2,
+	0xfc, 0xd4, 0x27, 0xa6, 0x3f, 0x8d, 0xfa, 0x33, 0x63, 0xb0, 0xe1, 0xd2, 0x84, 0x17, 0x4c, 0x68,
+	0x43, 0x86, 0xe7, 0xf4, 0xe7, 0x4e, 0xd3, 0xb0, 0x8d, 0xa9, 0x92, 0xa6, 0x2b, 0xfc, 0x6f, 0x4c,
+	0xa8, 0x1d, 0xc8, 0x9f, 0x4e, 0x76, 0xd7, 0xf2, 0x76, 0x4f, 0xca, 0xb2, 0xf8, 0x3a, 0x4f, 0xbe,
+	0x45, 0xd2, 0x17, 0x85, 0xdd, 0xd5, 0x3c, 0x5d, 0x23, 0x54, 0x8f, 0x04, 0xbb, 0x8e, 0x5e, 0x9d,
+	0x24, 0x0a, 0xe2, 0x95, 0x9f, 0x69, 0x7c, 0xfb, 0x25, 0x40, 0x4c, 0x53, 0x9d, 0x03, 0x6b, 0x30,
+	0xf6, 0x0c, 0x26, 0xc6, 0x93, 0x8c, 0x5b, 0xb6, 0x6b, 0x88, 0xe6, 0xcd, 0x33, 0x8c, 0x88, 0x96,
+	0xd4, 0x03, 0xb8, 0x8f, 0x83, 0x87, 0x38, 0xf0, 0xac, 0xbe, 0x9f, 0x5e, 0x51, 0x6c, 0xbf, 0x79,
+	0x87, 0x10, 0xd5, 0x6b, 0x53, 0xfb, 0x45, 0x08, 0xf6, 0xa1, 0x7e, 0x1f, 0x07, 0xdc, 0x4b, 0xf5,
+	0xd1, 0xc4, 0x91, 0x61, 0x8f, 0x10, 0xc5, 0xca, 0xf4, 0x8e, 0x49, 0xe3, 0x99, 0x7a, 0xa9, 0x88,
+	0x26, 0x6e, 0x6c, 0xf6, 0xfd, 0x64, 0xf7, 0x46, 0xae, 0xbe, 0xc9, 0x15, 0xd1, 0xfa, 0xd5, 0x7b,
+	0xd8, 0xb0, 0x83, 0xc3, 0x09, 0x2b, 0x4a, 0xf4, 0x38, 0x7d, 0x45, 0x42, 0xc7, 0x08, 0x07, 0x86,
+	0x05, 0xa6, 0x85, 0x62, 0x28, 0xbc, 0x2e, 0x9f, 0x22, 0xdb, 0x33, 0xa7, 0xe8, 0x19, 0x30, 0xbf,
+	0xe5, 0xb9, 0x23, 0x11, 0xc9, 0x1b, 0x52, 0x24, 0x99, 0x7e, 0x39, 0x51, 0xfc, 0x10, 0x1a, 0x61,
+	0xc6, 0x81, 0xc6, 0x48, 0x72, 0x2e, 0x24, 0xbb, 0xe4, 0x9c, 0xf8, 0x63, 0x68, 0xa7, 0x52, 0x19,
+	0xf2, 0x4d, 0x97, 0xe7, 0x3b, 0xa6, 0xcd, 0x7e, 0x0c, 0x88, 0x3e, 0x3f, 0x4d, 0xae, 0x78, 0x92,
+	0x7f, 0x93, 0xed, 0x18, 0x22, 0x59, 0xcf, 0xdd, 0x3f, 0xda, 0xf9, 0x5f, 0x86, 0x25, 0x69, 0xba,
+	0x00, 0xdd, 0x94, 0x2d, 0xee, 0xb4, 0x9c, 0x46, 0xf7, 0xcd, 0x33, 0x8c, 0x08, 0xf1, 0x6f, 0xfc,
+	0x73, 0x1b, 0x6a, 0xd4, 0xcf, 0xa3, 0xbb, 0xf5, 0xff, 0x6e, 0xde, 0xf3, 0x75, 0xf3, 0x3e, 0x86,
+	0x76, 0xea, 0xdd, 0xa4, 0x5c, 0x68, 0xe5, 0x8f, 0x2b, 0x73, 0x78, 0x2b, 0xe2, 0xcb, 0x45, 0xf9,
+	0x51, 0x28, 0x7d, 0xdd, 0x38, 0x6d, 0xee, 0x27, 0xec, 0xc9, 0x71, 0x74, 0xf1, 0xe2, 0xb5, 0x89,
+	0xc5, 0x04, 0xf1, 0xae, 0xee, 0x17, 0xef, 0x05, 0x7d, 0xb5, 0x3d, 0xd0, 0x8f, 0xa1, 0x9d, 0x7a,
+	0x0d, 0x23, 0x97, 0x18, 0xf9, 0x93, 0x99, 0x69, 0xb3, 0x7f, 0x8e, 0xce, 0x93, 0x09, 0x0b, 0x92,
+	0xc7, 0x07, 0x68, 0x6d, 0x92, 0x23, 0x2a, 0x7f, 0xa5, 0x30, 0x7d, 0x41, 0x4d, 0x41, 0x4d, 0xd1,
+	0x8a, 0x6c, 0x7e, 0xd9, 0x7f, 0xe6, 0x74, 0x5f, 0xcf, 0xf7, 0x07, 0x3b, 0xd1, 0x82, 0xf6, 0xa0,
+	0xc2, 0xde, 0xc8, 0xa0, 0x97, 0xa5, 0x6b, 0x48, 0xbe, 0x9f, 0xe9, 0x4e, 0x7b, 0x65, 0xe3, 0x8f,
+	0xed, 0xc0, 0xa7, 0x93, 0x96, 0xa9, 0xf5, 0x45, 0xd2, 0x2a, 0x43, 0xf2, 0xb1, 0x4a, 0x77, 0xfa,
+	0xfb, 0x94, 0x70, 0xd2, 0xff, 0xdb, 0x1e, 0xe6, 0x27, 0xf4, 0x35, 0x44, 0xfa, 0xbe, 0x0f, 0x5a,
+	0x3b, 0xdb, 0xa5, 0xa5, 0xee, 0x7a, 0xee, 0xfe, 0x11, 0xe6, 0x1f, 0x83, 0x9a, 0x2e, 0x90, 0xa1,
+	0x1b, 0x93, 0xe4, 0x59, 0x86, 0x73, 0x8a, 0x30, 0xbf, 0x0f, 0x15, 0x96, 0x19, 0x95, 0x4b, 0x98,
+	0x90, 0x35, 0x9d, 0x32, 0xd7, 0xdd, 0x6f, 0x7e, 0xb4, 0x31, 0xb0, 0x82, 0xc3, 0xf1, 0x3e, 0x69,
+	0x59, 0x67, 0x5d, 0xdf, 0xb0, 0x5c, 0xfe, 0x6b, 0x3d, 0xdc, 0xcb, 0x75, 0x3a, 0x7a, 0x9d, 0x22,
+	0x18, 0xed, 0xef, 0x57, 0xe8, 0xe7, 0xad, 0xff, 0x1d, 0x00, 0xa1, 0x13, 0x65, 0x83, 0x9e, 0x50,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -19,10 +19,13 @@ package accesslog
 import (
 	"context"
 	"fmt"
+	"math/rand"
 	"path"
+	"strings"
 	"sync"
 	"sync/atomic"
 
+	"github.com/cockroachdb/errors"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"go.uber.org/zap"
@@ -33,9 +36,12 @@ import (
 
 const (
 	clientRequestIDKey = "client_request_id"
+
+	outputFormatText = "text"
+	outputFormatJSON = "json"
 )
 
-var _globalL, _globalW atomic.Value
+var _globalL, _globalW, _globalF atomic.Value
 var once sync.Once
 
 func A() *zap.Logger {
@@ -45,6 +51,47 @@ func W() *RotateLogger {
 	return _globalW.Load().(*RotateLogger)
 }
 
+// accessFormat is how the access info is printed.
+type accessFormat struct {
+	// print the default access info if formatter is nil.
+	formatter *Formatter
+	json      bool
+	sampler   *Sampler
+}
+
+func getAccessFormat() *accessFormat {
+	format, ok := _globalF.Load().(*accessFormat)
+	if !ok || format == nil {
+		return &accessFormat{}
+	}
+	return format
+}
+
+func newAccessFormat(logCfg *paramtable.AccessLogConfig) (*accessFormat, error) {
+	format := &accessFormat{}
+	switch outputFormat := strings.ToLower(logCfg.OutputFormat.GetValue()); outputFormat {
+	case "", outputFormatText:
+	case outputFormatJSON:
+		format.json = true
+	default:
+		return nil, errors.Newf("unknown access log output format %s", outputFormat)
+	}
+
+	var err error
+	if template := logCfg.Formatter.GetValue(); len(template) > 0 {
+		format.formatter, err = NewFormatter(template)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	format.sampler, err = NewSampler(logCfg.SampleRates.GetValue(), rand.Float64)
+	if err != nil {
+		return nil, err
+	}
+	return format, nil
+}
+
 func SetupAccseeLog(logCfg *paramtable.AccessLogConfig, minioCfg *paramtable.MinioConfig) {
 	once.Do(func() {
 		_, err := InitAccessLogger(logCfg, minioCfg)
@@ -62,6 +109,11 @@ func InitAccessLogger(logCfg *paramtable.AccessLogConfig, minioCfg *paramtable.M
 		return nil, nil
 	}
 
+	format, err := newAccessFormat(logCfg)
+	if err != nil {
+		return nil, err
+	}
+
 	var writeSyncer zapcore.WriteSyncer
 	if len(logCfg.Filename.GetValue()) > 0 {
 		lg, err = NewRotateLogger(logCfg, minioCfg)
@@ -80,12 +132,16 @@ func InitAccessLogger(logCfg *paramtable.AccessLogConfig, minioCfg *paramtable.M
 	}
 
 	encoder := NewAccessEncoder()
+	if format.json {
+		encoder = NewAccessJSONEncoder()
+	}
 
 	logger := zap.New(zapcore.NewCore(encoder, writeSyncer, zapcore.DebugLevel))
 	logger.Info("Access log start successful")
 
 	_globalL.Store(logger)
 	_globalW.Store(lg)
+	_globalF.Store(format)
 	return lg, nil
 }
 
@@ -103,11 +159,57 @@ func NewAccessEncoder() zapcore.Encoder {
 	return log.NewTextEncoder(&encoderConfig, false, false)
 }
 
-func PrintAccessInfo(ctx context.Context, resp interface{}, err error, rpcInfo *grpc.UnaryServerInfo, timeCost int64) bool {
+// NewAccessJSONEncoder returns the encoder printing one json object per access info.
+func NewAccessJSONEncoder() zapcore.Encoder {
+	encoderConfig := zapcore.EncoderConfig{
+		TimeKey:        "ts",
+		NameKey:        "logger",
+		FunctionKey:    zapcore.OmitKey,
+		MessageKey:     "msg",
+		StacktraceKey:  "stacktrace",
+		LineEnding:     zapcore.DefaultLineEnding,
+		EncodeTime:     log.DefaultTimeEncoder,
+		EncodeDuration: zapcore.SecondsDurationEncoder,
+	}
+	return zapcore.NewJSONEncoder(encoderConfig)
+}
+
+func PrintAccessInfo(ctx context.Context, req interface{}, resp interface{}, err error, rpcInfo *grpc.UnaryServerInfo, timeCost int64) bool {
 	if _globalL.Load() == nil {
 		return false
 	}
 
+	//get method name of grpc
+	_, methodName := path.Split(rpcInfo.FullMethod)
+
+	info := &accessInfo{
+		ctx:        ctx,
+		req:        req,
+		resp:       resp,
+		err:        err,
+		methodName: methodName,
+		timeCost:   timeCost,
+	}
+	format := getAccessFormat()
+	if !format.sampler.Sample(info) {
+		return false
+	}
+
+	if format.formatter == nil {
+		return printDefaultAccessInfo(info)
+	}
+	if format.json {
+		A().Info("", format.formatter.Fields(info)...)
+	} else {
+		A().Info(format.formatter.Format(info))
+	}
+	return true
+}
+
+// printDefaultAccessInfo prints the address, method, trace ID, error code and response size of the request.
+func printDefaultAccessInfo(info *accessInfo) bool {
+	ctx, resp, err, methodName, timeCost := info.ctx, info.resp, info.err, info.methodName, info.timeCost
+
 	fields := []zap.Field{
 		//format time cost of task
 		zap.String("timeCost", fmt.Sprintf("%d ms", timeCost)),
@@ -143,9 +245,6 @@ func PrintAccessInfo(ctx context.Context, resp interface{}, err error, rpcInfo *
 		Status = "TaskFailed"
 	}
 
-	//get method name of grpc
-	_, methodName := path.Split(rpcInfo.FullMethod)
-
 	A().Info(fmt.Sprintf("%v: %s-%s", Status, getAccessAddr(ctx), methodName), fields...)
 	return true
 }
@@ -59,7 +59,7 @@ func TestAccessLogger_NotEnable(t *testing.T) {
 	}
 
 	rpcInfo := &grpc.UnaryServerInfo{Server: nil, FullMethod: "testMethod"}
-	ok := PrintAccessInfo(ctx, resp, nil, rpcInfo, 0)
+	ok := PrintAccessInfo(ctx, nil, resp, nil, rpcInfo, 0)
 	assert.False(t, ok)
 }
 
@@ -92,7 +92,7 @@ func TestAccessLogger_Basic(t *testing.T) {
 	}
 
 	rpcInfo := &grpc.UnaryServerInfo{Server: nil, FullMethod: "testMethod"}
-	ok := PrintAccessInfo(ctx, resp, nil, rpcInfo, 0)
+	ok := PrintAccessInfo(ctx, nil, resp, nil, rpcInfo, 0)
 	assert.True(t, ok)
 }
 
@@ -123,7 +123,7 @@ func TestAccessLogger_Stdout(t *testing.T) {
 	}
 
 	rpcInfo := &grpc.UnaryServerInfo{Server: nil, FullMethod: "testMethod"}
-	ok := PrintAccessInfo(ctx, resp, nil, rpcInfo, 0)
+	ok := PrintAccessInfo(ctx, nil, resp, nil, rpcInfo, 0)
 	assert.True(t, ok)
 }
 func TestAccessLogger_WithMinio(t *testing.T) {
@@ -158,7 +158,7 @@ func TestAccessLogger_WithMinio(t *testing.T) {
 	}
 
 	rpcInfo := &grpc.UnaryServerInfo{Server: nil, FullMethod: "testMethod"}
-	ok := PrintAccessInfo(ctx, resp, nil, rpcInfo, 0)
+	ok := PrintAccessInfo(ctx, nil, resp, nil, rpcInfo, 0)
 	assert.True(t, ok)
 
 	W().Rotate()
@@ -190,10 +190,10 @@ func TestAccessLogger_Error(t *testing.T) {
 		})
 
 	rpcInfo := &grpc.UnaryServerInfo{Server: nil, FullMethod: "testMethod"}
-	ok := PrintAccessInfo(ctx, nil, nil, rpcInfo, 0)
+	ok := PrintAccessInfo(ctx, nil, nil, nil, rpcInfo, 0)
 	assert.False(t, ok)
 
 	ctx = metadata.AppendToOutgoingContext(ctx, clientRequestIDKey, "test")
-	ok = PrintAccessInfo(ctx, nil, nil, rpcInfo, 0)
+	ok = PrintAccessInfo(ctx, nil, nil, nil, rpcInfo, 0)
 	assert.False(t, ok)
 }
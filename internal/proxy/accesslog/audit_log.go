@@ -0,0 +1,455 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+const (
+	AuditCategoryDDL        = "ddl"
+	AuditCategoryRBAC       = "rbac"
+	AuditCategoryCredential = "credential"
+	AuditCategoryImport     = "import"
+	AuditCategoryDML        = "dml"
+)
+
+var _globalAudit atomic.Value
+var auditOnce sync.Once
+
+func auditLogger() *AuditLogger {
+	logger, _ := _globalAudit.Load().(*AuditLogger)
+	return logger
+}
+
+// AuditEvent is the record of an operation which changes the schema, the privileges or the data.
+// The events are chained by the hashes, so any modification or removal of an event breaks the chain.
+type AuditEvent struct {
+	Seq        int64             `json:"seq"`
+	Time       string            `json:"time"`
+	User       string            `json:"user"`
+	Address    string            `json:"address"`
+	TraceID    string            `json:"trace_id"`
+	Category   string            `json:"category"`
+	Method     string            `json:"method"`
+	Database   string            `json:"database,omitempty"`
+	Collection string            `json:"collection,omitempty"`
+	Partition  string            `json:"partition,omitempty"`
+	Params     map[string]string `json:"params,omitempty"`
+	// Affected is the number of the deleted or upserted rows.
+	Affected  *int64 `json:"affected,omitempty"`
+	Status    string `json:"status"`
+	ErrorCode int    `json:"error_code"`
+	Reason    string `json:"reason,omitempty"`
+	PrevHash  string `json:"prev_hash"`
+	Hash      string `json:"hash"`
+}
+
+func (e *AuditEvent) computeHash() (string, error) {
+	event := *e
+	event.Hash = ""
+	data, err := json.Marshal(&event)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// AuditLogger writes the audit events as json lines and chains them by the hashes.
+type AuditLogger struct {
+	mu       sync.Mutex
+	writer   io.Writer
+	seq      int64
+	prevHash string
+}
+
+// NewAuditLogger creates an AuditLogger which continues the chain after the last event,
+// last is nil for a new chain.
+func NewAuditLogger(writer io.Writer, last *AuditEvent) *AuditLogger {
+	l := &AuditLogger{writer: writer}
+	if last != nil {
+		l.seq = last.Seq
+		l.prevHash = last.Hash
+	}
+	return l
+}
+
+func (l *AuditLogger) Log(event *AuditEvent) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	event.Seq = l.seq + 1
+	event.PrevHash = l.prevHash
+	hash, err := event.computeHash()
+	if err != nil {
+		return err
+	}
+	event.Hash = hash
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if _, err := l.writer.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	l.seq = event.Seq
+	l.prevHash = event.Hash
+	return nil
+}
+
+func SetupAuditLog(logCfg *paramtable.AccessLogConfig, minioCfg *paramtable.MinioConfig) {
+	auditOnce.Do(func() {
+		_, err := InitAuditLogger(logCfg, minioCfg)
+		if err != nil {
+			log.Fatal("initialize audit logger error", zap.Error(err))
+		}
+	})
+}
+
+// InitAuditLogger initializes the audit logger for proxy, the file is rotated and uploaded in the same way as the access log.
+func InitAuditLogger(logCfg *paramtable.AccessLogConfig, minioCfg *paramtable.MinioConfig) (*RotateLogger, error) {
+	if !logCfg.Enable.GetAsBool() {
+		return nil, nil
+	}
+
+	if len(logCfg.Filename.GetValue()) == 0 {
+		stdout, _, err := zap.Open([]string{"stdout"}...)
+		if err != nil {
+			return nil, err
+		}
+		_globalAudit.Store(NewAuditLogger(stdout, nil))
+		return nil, nil
+	}
+
+	lg, err := NewRotateLogger(logCfg, minioCfg)
+	if err != nil {
+		return nil, err
+	}
+	// continue the chain of the audit log left by the last run.
+	last, err := lastAuditEventOf(lg)
+	if err != nil {
+		log.Warn("failed to read the last audit event, start a new chain", zap.Error(err))
+	}
+	_globalAudit.Store(NewAuditLogger(zapcore.AddSync(lg), last))
+	log.Info("Audit log start successful", zap.String("file", lg.filename()))
+	return lg, nil
+}
+
+// lastAuditEventOf returns the last event in the current file, or in the latest sealed file if the current one is empty.
+func lastAuditEventOf(lg *RotateLogger) (*AuditEvent, error) {
+	if _, err := os.Stat(lg.dir()); os.IsNotExist(err) {
+		return nil, nil
+	}
+	backups, err := lg.oldLogFiles()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(backups, func(i, j int) bool { return backups[i].timestamp.After(backups[j].timestamp) })
+	files := []string{lg.filename()}
+	for _, backup := range backups {
+		files = append(files, path.Join(lg.dir(), backup.fileName))
+	}
+	for _, file := range files {
+		last, err := lastAuditEvent(file)
+		if err != nil || last != nil {
+			return last, err
+		}
+	}
+	return nil, nil
+}
+
+func lastAuditEvent(filename string) (*AuditEvent, error) {
+	file, err := os.Open(filename)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var last *AuditEvent
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), megabyte)
+	for scanner.Scan() {
+		event := &AuditEvent{}
+		if err := json.Unmarshal(scanner.Bytes(), event); err != nil {
+			return nil, err
+		}
+		last = event
+	}
+	return last, scanner.Err()
+}
+
+// VerifyAuditLog checks the hashes and the sequence of the audit events read from r.
+// The first event must follow prevHash unless prevHash is empty, the hash of the last event is returned,
+// so the rotated files can be verified one by one in order.
+func VerifyAuditLog(r io.Reader, prevHash string) (string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), megabyte)
+	var seq int64
+	checkPrev := prevHash != ""
+	for line := 1; scanner.Scan(); line++ {
+		event := &AuditEvent{}
+		if err := json.Unmarshal(scanner.Bytes(), event); err != nil {
+			return "", errors.Wrapf(err, "line %d is not an audit event", line)
+		}
+		if checkPrev && event.PrevHash != prevHash {
+			return "", fmt.Errorf("line %d: chain is broken, prev hash %s, expected %s", line, event.PrevHash, prevHash)
+		}
+		if seq > 0 && event.Seq != seq+1 {
+			return "", fmt.Errorf("line %d: sequence %d doesn't follow %d", line, event.Seq, seq)
+		}
+		hash, err := event.computeHash()
+		if err != nil {
+			return "", err
+		}
+		if hash != event.Hash {
+			return "", fmt.Errorf("line %d: hash mismatch, the event is modified", line)
+		}
+		checkPrev = true
+		prevHash = event.Hash
+		seq = event.Seq
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return prevHash, nil
+}
+
+func UnaryAuditLoggerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	PrintAuditInfo(ctx, req, resp, err, info)
+	return resp, err
+}
+
+// PrintAuditInfo records the request in the audit log if it's an audited operation.
+func PrintAuditInfo(ctx context.Context, req interface{}, resp interface{}, err error, rpcInfo *grpc.UnaryServerInfo) bool {
+	_, method := path.Split(rpcInfo.FullMethod)
+	return printAuditEvent(ctx, method, getCurUser(ctx), getAccessAddr(ctx), req, resp, err)
+}
+
+// PrintRestAuditInfo records the request served by the RESTful api of proxy, which isn't passed through
+// the grpc interceptors, so the user and the address are given by the http server.
+func PrintRestAuditInfo(ctx context.Context, method string, user string, addr string, req interface{}, resp interface{}, err error) bool {
+	return printAuditEvent(ctx, method, user, addr, req, resp, err)
+}
+
+func printAuditEvent(ctx context.Context, method string, user string, addr string, req interface{}, resp interface{}, err error) bool {
+	logger := auditLogger()
+	if logger == nil {
+		return false
+	}
+	event, ok := newAuditEvent(req)
+	if !ok {
+		return false
+	}
+
+	event.Method = method
+	event.Time = time.Now().Format(time.RFC3339Nano)
+	event.User = user
+	event.Address = addr
+	event.TraceID, _ = getTraceID(ctx)
+	fillAuditResult(event, req, resp, err)
+
+	if err := logger.Log(event); err != nil {
+		log.Warn("audit log print failed", zap.String("method", event.Method), zap.Error(err))
+		return false
+	}
+	return true
+}
+
+func fillAuditResult(event *AuditEvent, req interface{}, resp interface{}, err error) {
+	var status *commonpb.Status
+	switch r := resp.(type) {
+	case *commonpb.Status:
+		status = r
+	case BaseResponse:
+		status = r.GetStatus()
+	}
+	event.ErrorCode = int(status.GetErrorCode())
+	event.Reason = status.GetReason()
+
+	event.Status = getGrpcStatus(err)
+	if err != nil {
+		event.Reason = err.Error()
+	} else if event.ErrorCode > 0 {
+		event.Status = "TaskFailed"
+	}
+
+	var affected int64
+	switch req.(type) {
+	case *milvuspb.DeleteRequest:
+		result, _ := resp.(*milvuspb.MutationResult)
+		affected = result.GetDeleteCnt()
+		event.Affected = &affected
+	case *milvuspb.UpsertRequest:
+		result, _ := resp.(*milvuspb.MutationResult)
+		affected = result.GetUpsertCnt()
+		event.Affected = &affected
+	case *milvuspb.ImportRequest:
+		result, _ := resp.(*milvuspb.ImportResponse)
+		if tasks := result.GetTasks(); len(tasks) > 0 {
+			event.Params["tasks"] = joinInt64s(tasks)
+		}
+	}
+}
+
+// newAuditEvent returns the event of the request without the context and the result,
+// false is returned if the request isn't audited.
+func newAuditEvent(req interface{}) (*AuditEvent, bool) {
+	event := &AuditEvent{Params: make(map[string]string)}
+	switch r := req.(type) {
+	// DDL
+	case *milvuspb.CreateCollectionRequest:
+		event.Category = AuditCategoryDDL
+		event.Database, event.Collection = r.GetDbName(), r.GetCollectionName()
+		event.Params["shards_num"] = strconv.Itoa(int(r.GetShardsNum()))
+		event.Params["consistency_level"] = r.GetConsistencyLevel().String()
+	case *milvuspb.DropCollectionRequest:
+		event.Category = AuditCategoryDDL
+		event.Database, event.Collection = r.GetDbName(), r.GetCollectionName()
+	case *milvuspb.AlterCollectionRequest:
+		event.Category = AuditCategoryDDL
+		event.Database, event.Collection = r.GetDbName(), r.GetCollectionName()
+		for _, kv := range r.GetProperties() {
+			event.Params[kv.GetKey()] = kv.GetValue()
+		}
+	case *milvuspb.RenameCollectionRequest:
+		event.Category = AuditCategoryDDL
+		event.Database, event.Collection = r.GetDb(), r.GetOldName()
+		event.Params["new_name"] = r.GetNewName()
+	case *milvuspb.CreatePartitionRequest:
+		event.Category = AuditCategoryDDL
+		event.Database, event.Collection, event.Partition = r.GetDbName(), r.GetCollectionName(), r.GetPartitionName()
+	case *milvuspb.DropPartitionRequest:
+		event.Category = AuditCategoryDDL
+		event.Database, event.Collection, event.Partition = r.GetDbName(), r.GetCollectionName(), r.GetPartitionName()
+	case *milvuspb.CreateIndexRequest:
+		event.Category = AuditCategoryDDL
+		event.Database, event.Collection = r.GetDbName(), r.GetCollectionName()
+		event.Params["field_name"] = r.GetFieldName()
+		event.Params["index_name"] = r.GetIndexName()
+		for _, kv := range r.GetExtraParams() {
+			event.Params[kv.GetKey()] = kv.GetValue()
+		}
+	case *milvuspb.DropIndexRequest:
+		event.Category = AuditCategoryDDL
+		event.Database, event.Collection = r.GetDbName(), r.GetCollectionName()
+		event.Params["field_name"] = r.GetFieldName()
+		event.Params["index_name"] = r.GetIndexName()
+	case *milvuspb.CreateAliasRequest:
+		event.Category = AuditCategoryDDL
+		event.Database, event.Collection = r.GetDbName(), r.GetCollectionName()
+		event.Params["alias"] = r.GetAlias()
+	case *milvuspb.AlterAliasRequest:
+		event.Category = AuditCategoryDDL
+		event.Database, event.Collection = r.GetDbName(), r.GetCollectionName()
+		event.Params["alias"] = r.GetAlias()
+	case *milvuspb.DropAliasRequest:
+		event.Category = AuditCategoryDDL
+		event.Database = r.GetDbName()
+		event.Params["alias"] = r.GetAlias()
+
+	// RBAC
+	case *milvuspb.CreateRoleRequest:
+		event.Category = AuditCategoryRBAC
+		event.Params["role"] = r.GetEntity().GetName()
+	case *milvuspb.DropRoleRequest:
+		event.Category = AuditCategoryRBAC
+		event.Params["role"] = r.GetRoleName()
+	case *milvuspb.OperateUserRoleRequest:
+		event.Category = AuditCategoryRBAC
+		event.Params["username"] = r.GetUsername()
+		event.Params["role"] = r.GetRoleName()
+		event.Params["type"] = r.GetType().String()
+	case *milvuspb.OperatePrivilegeRequest:
+		event.Category = AuditCategoryRBAC
+		entity := r.GetEntity()
+		event.Params["role"] = entity.GetRole().GetName()
+		event.Params["object"] = entity.GetObject().GetName()
+		event.Params["object_name"] = entity.GetObjectName()
+		event.Params["privilege"] = entity.GetGrantor().GetPrivilege().GetName()
+		event.Params["grantor"] = entity.GetGrantor().GetUser().GetName()
+		event.Params["type"] = r.GetType().String()
+
+	// Credential, the passwords are never recorded.
+	case *milvuspb.CreateCredentialRequest:
+		event.Category = AuditCategoryCredential
+		event.Params["username"] = r.GetUsername()
+	case *milvuspb.UpdateCredentialRequest:
+		event.Category = AuditCategoryCredential
+		event.Params["username"] = r.GetUsername()
+	case *milvuspb.DeleteCredentialRequest:
+		event.Category = AuditCategoryCredential
+		event.Params["username"] = r.GetUsername()
+
+	// Import
+	case *milvuspb.ImportRequest:
+		event.Category = AuditCategoryImport
+		event.Collection, event.Partition = r.GetCollectionName(), r.GetPartitionName()
+		event.Params["files"] = strings.Join(r.GetFiles(), ",")
+		event.Params["row_based"] = strconv.FormatBool(r.GetRowBased())
+
+	// DML
+	case *milvuspb.DeleteRequest:
+		event.Category = AuditCategoryDML
+		event.Database, event.Collection, event.Partition = r.GetDbName(), r.GetCollectionName(), r.GetPartitionName()
+		event.Params["expr"] = r.GetExpr()
+	case *milvuspb.UpsertRequest:
+		event.Category = AuditCategoryDML
+		event.Database, event.Collection, event.Partition = r.GetDbName(), r.GetCollectionName(), r.GetPartitionName()
+		event.Params["num_rows"] = strconv.FormatUint(uint64(r.GetNumRows()), 10)
+
+	default:
+		return nil, false
+	}
+	return event, true
+}
+
+func joinInt64s(ids []int64) string {
+	strs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		strs = append(strs, strconv.FormatInt(id, 10))
+	}
+	return strings.Join(strs, ",")
+}
@@ -0,0 +1,206 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func readAuditEvents(t *testing.T, data []byte) []*AuditEvent {
+	var events []*AuditEvent
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		event := &AuditEvent{}
+		require.NoError(t, json.Unmarshal([]byte(line), event))
+		events = append(events, event)
+	}
+	return events
+}
+
+func TestAuditLogger_HashChain(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewAuditLogger(buf, nil)
+	for _, method := range []string{"CreateCollection", "CreateIndex", "DropCollection"} {
+		assert.NoError(t, logger.Log(&AuditEvent{Category: AuditCategoryDDL, Method: method, Collection: "coll"}))
+	}
+	data := buf.Bytes()
+
+	last, err := VerifyAuditLog(bytes.NewReader(data), "")
+	assert.NoError(t, err)
+	events := readAuditEvents(t, data)
+	assert.Equal(t, 3, len(events))
+	assert.Equal(t, "", events[0].PrevHash)
+	assert.Equal(t, events[0].Hash, events[1].PrevHash)
+	assert.Equal(t, int64(3), events[2].Seq)
+	assert.Equal(t, events[2].Hash, last)
+
+	// continue the chain in another file.
+	next := &bytes.Buffer{}
+	logger = NewAuditLogger(next, events[2])
+	assert.NoError(t, logger.Log(&AuditEvent{Category: AuditCategoryRBAC, Method: "DropRole"}))
+	_, err = VerifyAuditLog(bytes.NewReader(next.Bytes()), last)
+	assert.NoError(t, err)
+	_, err = VerifyAuditLog(bytes.NewReader(next.Bytes()), events[1].Hash)
+	assert.Error(t, err)
+
+	// modified event
+	tampered := bytes.Replace(data, []byte(`"method":"CreateIndex"`), []byte(`"method":"DescribeIndex"`), 1)
+	_, err = VerifyAuditLog(bytes.NewReader(tampered), "")
+	assert.Error(t, err)
+
+	// removed event
+	lines := strings.SplitAfter(string(data), "\n")
+	_, err = VerifyAuditLog(strings.NewReader(lines[0]+lines[2]), "")
+	assert.Error(t, err)
+}
+
+func TestPrintAuditInfo(t *testing.T) {
+	buf := &bytes.Buffer{}
+	_globalAudit.Store(NewAuditLogger(buf, nil))
+	defer _globalAudit.Store((*AuditLogger)(nil))
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.IPAddr{IP: net.IPv4(0, 0, 0, 0)}})
+	token := crypto.Base64Encode("root" + util.CredentialSeperator + "Milvus")
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(strings.ToLower(util.HeaderAuthorize), token))
+
+	ok := PrintAuditInfo(ctx,
+		&milvuspb.DeleteRequest{CollectionName: "coll", Expr: "pk in [1, 2]"},
+		&milvuspb.MutationResult{Status: &commonpb.Status{}, DeleteCnt: 2},
+		nil, &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/Delete"})
+	assert.True(t, ok)
+
+	ok = PrintAuditInfo(ctx,
+		&milvuspb.CreateCredentialRequest{Username: "user", Password: "secret"},
+		&commonpb.Status{ErrorCode: commonpb.ErrorCode_CreateCredentialFailure, Reason: "exists"},
+		nil, &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/CreateCredential"})
+	assert.True(t, ok)
+
+	ok = PrintAuditInfo(ctx,
+		&milvuspb.OperatePrivilegeRequest{
+			Entity: &milvuspb.GrantEntity{
+				Role:       &milvuspb.RoleEntity{Name: "role"},
+				Object:     &milvuspb.ObjectEntity{Name: "Collection"},
+				ObjectName: "coll",
+				Grantor:    &milvuspb.GrantorEntity{Privilege: &milvuspb.PrivilegeEntity{Name: "Insert"}},
+			},
+			Type: milvuspb.OperatePrivilegeType_Grant,
+		},
+		&commonpb.Status{},
+		nil, &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/OperatePrivilege"})
+	assert.True(t, ok)
+
+	// not audited
+	ok = PrintAuditInfo(ctx,
+		&milvuspb.SearchRequest{CollectionName: "coll"},
+		&milvuspb.SearchResults{Status: &commonpb.Status{}},
+		nil, &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/Search"})
+	assert.False(t, ok)
+
+	_, err := VerifyAuditLog(bytes.NewReader(buf.Bytes()), "")
+	assert.NoError(t, err)
+	assert.NotContains(t, buf.String(), "secret")
+
+	events := readAuditEvents(t, buf.Bytes())
+	require.Equal(t, 3, len(events))
+
+	assert.Equal(t, AuditCategoryDML, events[0].Category)
+	assert.Equal(t, "Delete", events[0].Method)
+	assert.Equal(t, "root", events[0].User)
+	assert.Equal(t, "pk in [1, 2]", events[0].Params["expr"])
+	assert.Equal(t, int64(2), *events[0].Affected)
+	assert.Equal(t, "OK", events[0].Status)
+
+	assert.Equal(t, AuditCategoryCredential, events[1].Category)
+	assert.Equal(t, "user", events[1].Params["username"])
+	assert.Equal(t, "TaskFailed", events[1].Status)
+	assert.Equal(t, "exists", events[1].Reason)
+	assert.Nil(t, events[1].Affected)
+
+	assert.Equal(t, AuditCategoryRBAC, events[2].Category)
+	assert.Equal(t, "Insert", events[2].Params["privilege"])
+	assert.Equal(t, "Grant", events[2].Params["type"])
+}
+
+func TestPrintRestAuditInfo(t *testing.T) {
+	buf := &bytes.Buffer{}
+	_globalAudit.Store(NewAuditLogger(buf, nil))
+	defer _globalAudit.Store((*AuditLogger)(nil))
+
+	ok := PrintRestAuditInfo(context.Background(), "DropCollection", "root", "http-127.0.0.1:1234",
+		&milvuspb.DropCollectionRequest{CollectionName: "coll"}, &commonpb.Status{}, nil)
+	assert.True(t, ok)
+
+	// not audited
+	ok = PrintRestAuditInfo(context.Background(), "HasCollection", "root", "http-127.0.0.1:1234",
+		&milvuspb.HasCollectionRequest{CollectionName: "coll"}, &milvuspb.BoolResponse{Status: &commonpb.Status{}}, nil)
+	assert.False(t, ok)
+
+	events := readAuditEvents(t, buf.Bytes())
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, AuditCategoryDDL, events[0].Category)
+	assert.Equal(t, "DropCollection", events[0].Method)
+	assert.Equal(t, "root", events[0].User)
+	assert.Equal(t, "http-127.0.0.1:1234", events[0].Address)
+	assert.Equal(t, "coll", events[0].Collection)
+}
+
+func TestAuditLogger_Resume(t *testing.T) {
+	var Params paramtable.ComponentParam
+	Params.Init()
+	testPath := "/tmp/audit_log_test"
+	defer os.RemoveAll(testPath)
+	Params.Save(Params.ProxyCfg.AuditLog.Enable.Key, "true")
+	Params.Save(Params.ProxyCfg.AuditLog.LocalPath.Key, testPath)
+	defer _globalAudit.Store((*AuditLogger)(nil))
+
+	logger, err := InitAuditLogger(&Params.ProxyCfg.AuditLog, &Params.MinioCfg)
+	require.NoError(t, err)
+	assert.NoError(t, auditLogger().Log(&AuditEvent{Category: AuditCategoryDDL, Method: "CreateCollection"}))
+	assert.NoError(t, logger.Rotate())
+	logger.Close()
+
+	// the chain continues from the sealed file after restart.
+	logger, err = InitAuditLogger(&Params.ProxyCfg.AuditLog, &Params.MinioCfg)
+	require.NoError(t, err)
+	defer logger.Close()
+	assert.NoError(t, auditLogger().Log(&AuditEvent{Category: AuditCategoryDDL, Method: "DropCollection"}))
+
+	data, err := os.ReadFile(path.Join(testPath, Params.ProxyCfg.AuditLog.Filename.GetValue()))
+	require.NoError(t, err)
+	events := readAuditEvents(t, data)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, int64(2), events[0].Seq)
+	assert.NotEmpty(t, events[0].PrevHash)
+}
@@ -0,0 +1,133 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+)
+
+var fieldPattern = regexp.MustCompile(`\$[a-z_]+`)
+
+// Formatter formats the access info with a template like "$user $method_name $collection $time_cost",
+// each $field in the template is replaced by the value of the field.
+type Formatter struct {
+	template string
+	fields   []string
+	base     []string
+}
+
+// NewFormatter parses the template and returns an error if there is any unknown field.
+func NewFormatter(template string) (*Formatter, error) {
+	f := &Formatter{template: template}
+	locs := fieldPattern.FindAllStringIndex(template, -1)
+	start := 0
+	for _, loc := range locs {
+		field := template[loc[0]+1 : loc[1]]
+		if _, ok := fieldGetters[field]; !ok {
+			return nil, errors.Newf("unknown access log field %s", template[loc[0]:loc[1]])
+		}
+		f.base = append(f.base, template[start:loc[0]])
+		f.fields = append(f.fields, field)
+		start = loc[1]
+	}
+	f.base = append(f.base, template[start:])
+	return f, nil
+}
+
+// Format returns the text line of the access info.
+func (f *Formatter) Format(info *accessInfo) string {
+	var sb strings.Builder
+	for i, field := range f.fields {
+		sb.WriteString(f.base[i])
+		sb.WriteString(fieldGetters[field](info))
+	}
+	sb.WriteString(f.base[len(f.base)-1])
+	return sb.String()
+}
+
+// Fields returns the fields of the access info, which are used by the json output.
+func (f *Formatter) Fields(info *accessInfo) []zap.Field {
+	fields := make([]zap.Field, 0, len(f.fields))
+	for _, field := range f.fields {
+		fields = append(fields, zap.String(field, fieldGetters[field](info)))
+	}
+	return fields
+}
+
+// Sampler decides whether the access info of a method should be printed.
+type Sampler struct {
+	defaultRate float64
+	rates       map[string]float64
+	random      func() float64
+}
+
+// NewSampler creates a sampler from the method name (in lower case) to the sample rate,
+// the rate of "default" is used by the methods not in the map.
+func NewSampler(rates map[string]string, random func() float64) (*Sampler, error) {
+	s := &Sampler{defaultRate: 1, rates: make(map[string]float64), random: random}
+	for method, value := range rates {
+		rate, err := strconv.ParseFloat(value, 64)
+		if err != nil || rate < 0 || rate > 1 {
+			return nil, errors.Newf("invalid access log sample rate %s of %s", value, method)
+		}
+		if strings.ToLower(method) == "default" {
+			s.defaultRate = rate
+			continue
+		}
+		s.rates[strings.ToLower(method)] = rate
+	}
+	return s, nil
+}
+
+// Sample returns true if the access info should be printed, the failed requests are always printed.
+func (s *Sampler) Sample(info *accessInfo) bool {
+	if s == nil || info.failed() {
+		return true
+	}
+	rate, ok := s.rates[strings.ToLower(info.methodName)]
+	if !ok {
+		rate = s.defaultRate
+	}
+	if rate >= 1 {
+		return true
+	}
+	return s.random() < rate
+}
+
+// accessInfo is the information of a request to be printed.
+type accessInfo struct {
+	ctx        context.Context
+	req        interface{}
+	resp       interface{}
+	err        error
+	methodName string
+	timeCost   int64
+}
+
+func (i *accessInfo) failed() bool {
+	if i.err != nil {
+		return true
+	}
+	errCode, ok := getErrCode(i.resp)
+	return ok && errCode > 0
+}
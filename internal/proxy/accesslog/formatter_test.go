@@ -0,0 +1,149 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func newTestAccessContext() context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.IPAddr{IP: net.IPv4(0, 0, 0, 0)}})
+	token := crypto.Base64Encode("root" + util.CredentialSeperator + "Milvus")
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(strings.ToLower(util.HeaderAuthorize), token))
+	return metadata.AppendToOutgoingContext(ctx, clientRequestIDKey, "test")
+}
+
+func TestFormatter(t *testing.T) {
+	_, err := NewFormatter("$user $unknown")
+	assert.Error(t, err)
+
+	formatter, err := NewFormatter("[$user] $method_name: $collection $partition $nq $topk $expr $output_fields $time_cost")
+	require.NoError(t, err)
+
+	info := &accessInfo{
+		ctx: newTestAccessContext(),
+		req: &milvuspb.SearchRequest{
+			CollectionName: "coll",
+			PartitionNames: []string{"p1", "p2"},
+			Dsl:            "age > 10",
+			Nq:             2,
+			OutputFields:   []string{"age", "name"},
+			SearchParams:   []*commonpb.KeyValuePair{{Key: common.TopKKey, Value: "10"}},
+		},
+		resp:       &milvuspb.SearchResults{Status: &commonpb.Status{}},
+		methodName: "Search",
+		timeCost:   5,
+	}
+	assert.Equal(t, "[root] Search: coll [p1 p2] 2 10 age > 10 age,name 5ms", formatter.Format(info))
+
+	info.req = &milvuspb.DeleteRequest{CollectionName: "coll", PartitionName: "p1", Expr: "pk in [1]"}
+	info.methodName = "Delete"
+	assert.Equal(t, "[root] Delete: coll p1 Unknown Unknown pk in [1] Unknown 5ms", formatter.Format(info))
+
+	formatter, err = NewFormatter("$user $method $collection $nq $topk $expr $latency")
+	require.NoError(t, err)
+	assert.Equal(t, "root Delete coll Unknown Unknown pk in [1] 5ms", formatter.Format(info))
+
+	formatter, err = NewFormatter("$method_status $error_code $error_msg")
+	require.NoError(t, err)
+	info.resp = &milvuspb.MutationResult{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "failed"}}
+	assert.Equal(t, "TaskFailed 1 failed", formatter.Format(info))
+}
+
+func TestSampler(t *testing.T) {
+	_, err := NewSampler(map[string]string{"search": "2"}, nil)
+	assert.Error(t, err)
+	_, err = NewSampler(map[string]string{"search": "x"}, nil)
+	assert.Error(t, err)
+
+	random := 0.5
+	sampler, err := NewSampler(map[string]string{"search": "0.1", "default": "0.6"}, func() float64 { return random })
+	require.NoError(t, err)
+
+	succeed := &milvuspb.SearchResults{Status: &commonpb.Status{}}
+	failed := &milvuspb.SearchResults{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}}
+	assert.False(t, sampler.Sample(&accessInfo{methodName: "Search", resp: succeed}))
+	assert.True(t, sampler.Sample(&accessInfo{methodName: "Search", resp: failed}))
+	assert.True(t, sampler.Sample(&accessInfo{methodName: "Query", resp: succeed}))
+	random = 0.05
+	assert.True(t, sampler.Sample(&accessInfo{methodName: "Search", resp: succeed}))
+}
+
+func TestAccessLogger_JSONFormat(t *testing.T) {
+	var Params paramtable.ComponentParam
+	Params.Init()
+	testPath := "/tmp/accesstest_json"
+	defer os.RemoveAll(testPath)
+	defer _globalF.Store(&accessFormat{})
+	Params.Save(Params.ProxyCfg.AccessLog.LocalPath.Key, testPath)
+	Params.Save(Params.ProxyCfg.AccessLog.OutputFormat.Key, "json")
+	Params.Save(Params.ProxyCfg.AccessLog.Formatter.Key, "$user $method_name $collection $nq")
+	Params.ProxyCfg.AccessLog.SampleRates.GetFunc = func() map[string]string {
+		return map[string]string{"query": "0"}
+	}
+
+	logger, err := InitAccessLogger(&Params.ProxyCfg.AccessLog, &Params.MinioCfg)
+	require.NoError(t, err)
+	defer logger.Close()
+
+	ctx := newTestAccessContext()
+	ok := PrintAccessInfo(ctx,
+		&milvuspb.SearchRequest{CollectionName: "coll", Nq: 3},
+		&milvuspb.SearchResults{Status: &commonpb.Status{}},
+		nil, &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/Search"}, 0)
+	assert.True(t, ok)
+	ok = PrintAccessInfo(ctx,
+		&milvuspb.QueryRequest{CollectionName: "coll"},
+		&milvuspb.QueryResults{Status: &commonpb.Status{}},
+		nil, &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/Query"}, 0)
+	assert.False(t, ok)
+
+	data, err := os.ReadFile(path.Join(testPath, Params.ProxyCfg.AccessLog.Filename.GetValue()))
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Equal(t, 2, len(lines))
+
+	fields := make(map[string]interface{})
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &fields))
+	assert.Equal(t, "root", fields["user"])
+	assert.Equal(t, "Search", fields["method_name"])
+	assert.Equal(t, "coll", fields["collection"])
+	assert.Equal(t, "3", fields["nq"])
+
+	Params.Save(Params.ProxyCfg.AccessLog.OutputFormat.Key, "xml")
+	_, err = newAccessFormat(&Params.ProxyCfg.AccessLog)
+	assert.Error(t, err)
+}
@@ -0,0 +1,198 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+)
+
+const unknownField = "Unknown"
+
+// queryLimitKey is the key of limit in the query params.
+const queryLimitKey = "limit"
+
+type fieldGetter func(info *accessInfo) string
+
+// fieldGetters maps the field names in the access log template to their getters,
+// $method and $latency are the short names of $method_name and $time_cost.
+var fieldGetters = map[string]fieldGetter{
+	"time_now":      getTimeNow,
+	"time_cost":     getTimeCost,
+	"latency":       getTimeCost,
+	"user":          getUser,
+	"user_addr":     getUserAddr,
+	"trace_id":      getTraceIDField,
+	"method_name":   getMethodName,
+	"method":        getMethodName,
+	"method_status": getMethodStatus,
+	"error_code":    getErrorCode,
+	"error_msg":     getErrorMsg,
+	"response_size": getResponseSizeField,
+	"database":      getDatabase,
+	"collection":    getCollection,
+	"partition":     getPartition,
+	"nq":            getNq,
+	"topk":          getTopK,
+	"expr":          getExpr,
+	"output_fields": getOutputFields,
+}
+
+func getTimeNow(info *accessInfo) string {
+	return time.Now().Format("2006/01/02 15:04:05.000 -07:00")
+}
+
+func getTimeCost(info *accessInfo) string {
+	return fmt.Sprintf("%dms", info.timeCost)
+}
+
+func getUser(info *accessInfo) string {
+	user := getCurUser(info.ctx)
+	if user == "" {
+		return unknownField
+	}
+	return user
+}
+
+func getUserAddr(info *accessInfo) string {
+	return getAccessAddr(info.ctx)
+}
+
+func getTraceIDField(info *accessInfo) string {
+	traceID, ok := getTraceID(info.ctx)
+	if !ok {
+		return unknownField
+	}
+	return traceID
+}
+
+func getMethodName(info *accessInfo) string {
+	return info.methodName
+}
+
+func getMethodStatus(info *accessInfo) string {
+	status := getGrpcStatus(info.err)
+	if errCode, ok := getErrCode(info.resp); status == "OK" && ok && errCode > 0 {
+		status = "TaskFailed"
+	}
+	return status
+}
+
+func getErrorCode(info *accessInfo) string {
+	errCode, ok := getErrCode(info.resp)
+	if !ok {
+		// unknown error code
+		errCode = -1
+	}
+	return strconv.Itoa(errCode)
+}
+
+func getErrorMsg(info *accessInfo) string {
+	if info.err != nil {
+		return info.err.Error()
+	}
+	if baseResp, ok := info.resp.(BaseResponse); ok {
+		return baseResp.GetStatus().GetReason()
+	}
+	return ""
+}
+
+func getResponseSizeField(info *accessInfo) string {
+	size, ok := getResponseSize(info.resp)
+	if !ok {
+		return unknownField
+	}
+	return strconv.Itoa(size)
+}
+
+func getDatabase(info *accessInfo) string {
+	if req, ok := info.req.(interface{ GetDbName() string }); ok && req.GetDbName() != "" {
+		return req.GetDbName()
+	}
+	return unknownField
+}
+
+func getCollection(info *accessInfo) string {
+	if req, ok := info.req.(interface{ GetCollectionName() string }); ok && req.GetCollectionName() != "" {
+		return req.GetCollectionName()
+	}
+	return unknownField
+}
+
+func getPartition(info *accessInfo) string {
+	switch req := info.req.(type) {
+	case interface{ GetPartitionName() string }:
+		if req.GetPartitionName() != "" {
+			return req.GetPartitionName()
+		}
+	case interface{ GetPartitionNames() []string }:
+		if len(req.GetPartitionNames()) > 0 {
+			return fmt.Sprint(req.GetPartitionNames())
+		}
+	}
+	return unknownField
+}
+
+func getNq(info *accessInfo) string {
+	if req, ok := info.req.(*milvuspb.SearchRequest); ok {
+		return strconv.FormatInt(req.GetNq(), 10)
+	}
+	return unknownField
+}
+
+func getTopK(info *accessInfo) string {
+	switch req := info.req.(type) {
+	case *milvuspb.SearchRequest:
+		if topk, err := funcutil.GetAttrByKeyFromRepeatedKV(common.TopKKey, req.GetSearchParams()); err == nil {
+			return topk
+		}
+	case *milvuspb.QueryRequest:
+		if limit, err := funcutil.GetAttrByKeyFromRepeatedKV(queryLimitKey, req.GetQueryParams()); err == nil {
+			return limit
+		}
+	}
+	return unknownField
+}
+
+func getExpr(info *accessInfo) string {
+	var expr string
+	switch req := info.req.(type) {
+	case *milvuspb.SearchRequest:
+		expr = req.GetDsl()
+	case *milvuspb.QueryRequest:
+		expr = req.GetExpr()
+	case *milvuspb.DeleteRequest:
+		expr = req.GetExpr()
+	}
+	if expr == "" {
+		return unknownField
+	}
+	return expr
+}
+
+func getOutputFields(info *accessInfo) string {
+	if req, ok := info.req.(interface{ GetOutputFields() []string }); ok && len(req.GetOutputFields()) > 0 {
+		return strings.Join(req.GetOutputFields(), ",")
+	}
+	return unknownField
+}
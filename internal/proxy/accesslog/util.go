@@ -26,6 +26,8 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -41,7 +43,7 @@ type BaseResponse interface {
 func UnaryAccessLoggerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	starttime := time.Now()
 	resp, err := handler(ctx, req)
-	PrintAccessInfo(ctx, resp, err, info, time.Since(starttime).Milliseconds())
+	PrintAccessInfo(ctx, req, resp, err, info, time.Since(starttime).Milliseconds())
 	return resp, err
 }
 
@@ -71,6 +73,27 @@ func getAccessAddr(ctx context.Context) string {
 	return fmt.Sprintf("%s-%s", ip.Addr.Network(), ip.Addr.String())
 }
 
+// getCurUser returns the user in the authorization of the request, or empty if the authorization is disabled.
+func getCurUser(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	authorization := md[strings.ToLower(util.HeaderAuthorize)]
+	if len(authorization) < 1 {
+		return ""
+	}
+	rawToken, err := crypto.Base64Decode(authorization[0])
+	if err != nil {
+		return ""
+	}
+	secrets := strings.SplitN(rawToken, util.CredentialSeperator, 2)
+	if len(secrets) < 2 {
+		return ""
+	}
+	return secrets[0]
+}
+
 func getTraceID(ctx context.Context) (id string, ok bool) {
 	meta, ok := metadata.FromOutgoingContext(ctx)
 	if ok {
@@ -0,0 +1,299 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/clustering"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// explainPlan creates the search plan if the anns field is in the search params, otherwise the retrieve plan.
+func explainPlan(schema *schemapb.CollectionSchema, req *proxypb.ExplainRequest) (*planpb.PlanNode, error) {
+	annsField, err := funcutil.GetAttrByKeyFromRepeatedKV(AnnsFieldKey, req.GetSearchParams())
+	if err != nil {
+		return planparserv2.CreateRetrievePlan(schema, req.GetExpr())
+	}
+	queryInfo, _, err := parseSearchInfo(req.GetSearchParams())
+	if err != nil {
+		return nil, err
+	}
+	return planparserv2.CreateSearchPlan(schema, req.GetExpr(), annsField, queryInfo)
+}
+
+// planPredicates returns the filter expression of the plan, nil if there is no filter.
+func planPredicates(plan *planpb.PlanNode) *planpb.Expr {
+	switch node := plan.GetNode().(type) {
+	case *planpb.PlanNode_VectorAnns:
+		return node.VectorAnns.GetPredicates()
+	case *planpb.PlanNode_Query:
+		return node.Query.GetPredicates()
+	case *planpb.PlanNode_Predicates:
+		return node.Predicates
+	}
+	return nil
+}
+
+// collectExprFields collects the IDs of the fields referred by the expression.
+func collectExprFields(expr *planpb.Expr, fieldIDs typeutil.Set[int64]) {
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_TermExpr:
+		fieldIDs.Insert(e.TermExpr.GetColumnInfo().GetFieldId())
+	case *planpb.Expr_UnaryExpr:
+		collectExprFields(e.UnaryExpr.GetChild(), fieldIDs)
+	case *planpb.Expr_BinaryExpr:
+		collectExprFields(e.BinaryExpr.GetLeft(), fieldIDs)
+		collectExprFields(e.BinaryExpr.GetRight(), fieldIDs)
+	case *planpb.Expr_CompareExpr:
+		fieldIDs.Insert(e.CompareExpr.GetLeftColumnInfo().GetFieldId(), e.CompareExpr.GetRightColumnInfo().GetFieldId())
+	case *planpb.Expr_UnaryRangeExpr:
+		fieldIDs.Insert(e.UnaryRangeExpr.GetColumnInfo().GetFieldId())
+	case *planpb.Expr_BinaryRangeExpr:
+		fieldIDs.Insert(e.BinaryRangeExpr.GetColumnInfo().GetFieldId())
+	case *planpb.Expr_BinaryArithOpEvalRangeExpr:
+		fieldIDs.Insert(e.BinaryArithOpEvalRangeExpr.GetColumnInfo().GetFieldId())
+	case *planpb.Expr_BinaryArithExpr:
+		collectExprFields(e.BinaryArithExpr.GetLeft(), fieldIDs)
+		collectExprFields(e.BinaryArithExpr.GetRight(), fieldIDs)
+	case *planpb.Expr_ColumnExpr:
+		fieldIDs.Insert(e.ColumnExpr.GetInfo().GetFieldId())
+	}
+}
+
+// explainPartitions returns the IDs and the names of the partitions to search, all the partitions if none is specified.
+func explainPartitions(ctx context.Context, collectionName string, partitionNames []string) ([]int64, []string, error) {
+	partitionsMap, err := globalMetaCache.GetPartitions(ctx, collectionName)
+	if err != nil {
+		return nil, nil, err
+	}
+	partitionIDs, err := getPartitionIDs(ctx, collectionName, partitionNames)
+	if err != nil {
+		return nil, nil, err
+	}
+	searched := typeutil.NewSet(partitionIDs...)
+	ids := make([]int64, 0, len(partitionsMap))
+	names := make([]string, 0, len(partitionsMap))
+	for name, id := range partitionsMap {
+		if len(partitionNames) == 0 || searched.Contain(id) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		ids = append(ids, partitionsMap[name])
+	}
+	return ids, names, nil
+}
+
+// explainFields returns the fields of the plan with their indexes.
+func (node *Proxy) explainFields(ctx context.Context, collectionID int64, schema *schemapb.CollectionSchema, plan *planpb.PlanNode) ([]*proxypb.ExplainField, error) {
+	fieldIDs := typeutil.NewSet[int64]()
+	if vectorAnns := plan.GetVectorAnns(); vectorAnns != nil {
+		fieldIDs.Insert(vectorAnns.GetFieldId())
+	}
+	collectExprFields(planPredicates(plan), fieldIDs)
+
+	resp, err := node.dataCoord.DescribeIndex(ctx, &indexpb.DescribeIndexRequest{CollectionID: collectionID})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success &&
+		resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_IndexNotExist {
+		return nil, errors.New(resp.GetStatus().GetReason())
+	}
+	indexes := make(map[int64]*indexpb.IndexInfo)
+	for _, info := range resp.GetIndexInfos() {
+		indexes[info.GetFieldID()] = info
+	}
+
+	fields := make([]*proxypb.ExplainField, 0, fieldIDs.Len())
+	for _, field := range schema.GetFields() {
+		if !fieldIDs.Contain(field.GetFieldID()) {
+			continue
+		}
+		explainField := &proxypb.ExplainField{
+			FieldID:   field.GetFieldID(),
+			FieldName: field.GetName(),
+		}
+		if index, ok := indexes[field.GetFieldID()]; ok {
+			explainField.IndexName = index.GetIndexName()
+			explainField.IndexType, _ = funcutil.GetAttrByKeyFromRepeatedKV(common.IndexTypeKey, index.GetIndexParams())
+		}
+		fields = append(fields, explainField)
+	}
+	return fields, nil
+}
+
+// explainShards returns the loaded sealed segments of the partitions per shard,
+// the segments are pruned and the rows are estimated by the clustering info of the segments.
+func (node *Proxy) explainShards(ctx context.Context, collectionName string, collectionID int64, partitionIDs []int64, predicates *planpb.Expr) ([]*proxypb.ExplainShard, error) {
+	leaders, err := globalMetaCache.GetShards(ctx, true, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	shards := make(map[string]*proxypb.ExplainShard, len(leaders))
+	for channel, nodes := range leaders {
+		shard := &proxypb.ExplainShard{Channel: channel}
+		for _, node := range nodes {
+			shard.LeaderIDs = append(shard.LeaderIDs, node.nodeID)
+		}
+		shards[channel] = shard
+	}
+
+	infoResp, err := node.queryCoord.GetSegmentInfo(ctx, &querypb.GetSegmentInfoRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_SegmentInfo),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if infoResp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(infoResp.GetStatus().GetReason())
+	}
+	searched := typeutil.NewSet(partitionIDs...)
+	infos := make([]*querypb.SegmentInfo, 0, len(infoResp.GetInfos()))
+	segmentIDs := make([]int64, 0, len(infoResp.GetInfos()))
+	for _, info := range infoResp.GetInfos() {
+		if searched.Contain(info.GetPartitionID()) {
+			infos = append(infos, info)
+			segmentIDs = append(segmentIDs, info.GetSegmentID())
+		}
+	}
+
+	clusteringInfos := make(map[int64]*datapb.ClusteringInfo)
+	if predicates != nil && len(segmentIDs) > 0 {
+		segmentResp, err := node.dataCoord.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_SegmentInfo),
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			SegmentIDs:       segmentIDs,
+			IncludeUnHealthy: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if segmentResp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return nil, errors.New(segmentResp.GetStatus().GetReason())
+		}
+		for _, info := range segmentResp.GetInfos() {
+			clusteringInfos[info.GetID()] = info.GetClusteringInfo()
+		}
+	}
+
+	for _, info := range infos {
+		shard, ok := shards[info.GetDmChannel()]
+		if !ok {
+			shard = &proxypb.ExplainShard{Channel: info.GetDmChannel()}
+			shards[info.GetDmChannel()] = shard
+		}
+		shard.Segments = append(shard.Segments, explainSegment(info, clusteringInfos[info.GetSegmentID()], predicates))
+	}
+
+	ret := make([]*proxypb.ExplainShard, 0, len(shards))
+	for _, shard := range shards {
+		sort.Slice(shard.Segments, func(i, j int) bool {
+			return shard.Segments[i].GetSegmentID() < shard.Segments[j].GetSegmentID()
+		})
+		ret = append(ret, shard)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].GetChannel() < ret[j].GetChannel() })
+	return ret, nil
+}
+
+// explainSegment prunes the segment and estimates the matched rows by the clustering info,
+// all the rows are estimated if there is no filter or the clustering info isn't on the filtered fields.
+func explainSegment(info *querypb.SegmentInfo, clusteringInfo *datapb.ClusteringInfo, predicates *planpb.Expr) *proxypb.ExplainSegment {
+	segment := &proxypb.ExplainSegment{
+		SegmentID:     info.GetSegmentID(),
+		PartitionID:   info.GetPartitionID(),
+		NodeIDs:       info.GetNodeIds(),
+		NumRows:       info.GetNumRows(),
+		EstimatedRows: info.GetNumRows(),
+	}
+	if len(segment.NodeIDs) == 0 {
+		segment.NodeIDs = []int64{info.GetNodeID()}
+	}
+	if predicates == nil || clusteringInfo == nil {
+		return segment
+	}
+	if !clustering.MayMatch(predicates, clusteringInfo) {
+		segment.Pruned = true
+		segment.EstimatedRows = 0
+		return segment
+	}
+	segment.EstimatedRows = int64(math.Round(float64(info.GetNumRows()) * clustering.Selectivity(predicates, clusteringInfo)))
+	return segment
+}
+
+// explain builds the plan of the request and collects the fields and the segments the plan hits.
+func (node *Proxy) explain(ctx context.Context, req *proxypb.ExplainRequest) (*proxypb.ExplainResponse, error) {
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetCollectionName())
+	if err != nil {
+		return nil, err
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, req.GetCollectionName())
+	if err != nil {
+		return nil, err
+	}
+	plan, err := explainPlan(schema, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create plan: %w", err)
+	}
+	predicates := planPredicates(plan)
+
+	partitionIDs, partitionNames, err := explainPartitions(ctx, req.GetCollectionName(), req.GetPartitionNames())
+	if err != nil {
+		return nil, err
+	}
+	fields, err := node.explainFields(ctx, collectionID, schema, plan)
+	if err != nil {
+		return nil, err
+	}
+	shards, err := node.explainShards(ctx, req.GetCollectionName(), collectionID, partitionIDs, predicates)
+	if err != nil {
+		return nil, err
+	}
+	return &proxypb.ExplainResponse{
+		Status:         &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		ExprTree:       planparserv2.ExprString(predicates),
+		PartitionNames: partitionNames,
+		Fields:         fields,
+		Shards:         shards,
+	}, nil
+}
@@ -0,0 +1,229 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func newExplainTestSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "explain",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64},
+			{FieldID: 102, Name: "name", DataType: schemapb.DataType_VarChar},
+			{
+				FieldID: 103, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "8"}},
+			},
+		},
+	}
+}
+
+func newAgeClusteringInfo(min, max int64) *datapb.ClusteringInfo {
+	return &datapb.ClusteringInfo{
+		FieldID: 101,
+		Min:     &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: min}},
+		Max:     &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: max}},
+	}
+}
+
+func TestExplainPlan(t *testing.T) {
+	schema := newExplainTestSchema()
+
+	plan, err := explainPlan(schema, &proxypb.ExplainRequest{Expr: `age > 10 and name == "a"`})
+	require.NoError(t, err)
+	assert.NotNil(t, plan.GetQuery())
+	fieldIDs := typeutil.NewSet[int64]()
+	collectExprFields(planPredicates(plan), fieldIDs)
+	assert.ElementsMatch(t, []int64{101, 102}, fieldIDs.Collect())
+
+	plan, err = explainPlan(schema, &proxypb.ExplainRequest{
+		Expr: "pk in [1, 2]",
+		SearchParams: []*commonpb.KeyValuePair{
+			{Key: AnnsFieldKey, Value: "vec"},
+			{Key: TopKKey, Value: "10"},
+			{Key: common.MetricTypeKey, Value: "L2"},
+			{Key: SearchParamsKey, Value: `{"nprobe": 10}`},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(103), plan.GetVectorAnns().GetFieldId())
+	assert.NotNil(t, planPredicates(plan).GetTermExpr())
+
+	// no topk
+	_, err = explainPlan(schema, &proxypb.ExplainRequest{
+		SearchParams: []*commonpb.KeyValuePair{{Key: AnnsFieldKey, Value: "vec"}},
+	})
+	assert.Error(t, err)
+
+	_, err = explainPlan(schema, &proxypb.ExplainRequest{Expr: "not_exist > 1"})
+	assert.Error(t, err)
+}
+
+func TestExplainSegment(t *testing.T) {
+	plan, err := explainPlan(newExplainTestSchema(), &proxypb.ExplainRequest{Expr: "age > 14"})
+	require.NoError(t, err)
+	predicates := planPredicates(plan)
+	info := &querypb.SegmentInfo{SegmentID: 1, PartitionID: 10, NodeID: 3, NumRows: 100}
+
+	segment := explainSegment(info, newAgeClusteringInfo(10, 19), predicates)
+	assert.False(t, segment.GetPruned())
+	assert.Equal(t, int64(50), segment.GetEstimatedRows())
+	assert.Equal(t, []int64{3}, segment.GetNodeIDs())
+
+	segment = explainSegment(info, newAgeClusteringInfo(0, 9), predicates)
+	assert.True(t, segment.GetPruned())
+	assert.Equal(t, int64(0), segment.GetEstimatedRows())
+
+	segment = explainSegment(info, nil, predicates)
+	assert.False(t, segment.GetPruned())
+	assert.Equal(t, int64(100), segment.GetEstimatedRows())
+
+	segment = explainSegment(info, newAgeClusteringInfo(0, 9), nil)
+	assert.False(t, segment.GetPruned())
+	assert.Equal(t, int64(100), segment.GetEstimatedRows())
+}
+
+func TestProxy_Explain(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	successStatus := &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+
+	cache := newMockCache()
+	cache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		return 1, nil
+	})
+	cache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+		return newExplainTestSchema(), nil
+	})
+	cache.setGetPartitionsFunc(func(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error) {
+		return map[string]typeutil.UniqueID{"_default": 10, "p1": 11}, nil
+	})
+	cache.setGetShardsFunc(func(ctx context.Context, withCache bool, collectionName string) (map[string][]nodeInfo, error) {
+		return map[string][]nodeInfo{
+			"dml_0": {{nodeID: 1}},
+			"dml_1": {{nodeID: 2}},
+		}, nil
+	})
+	globalMetaCache = cache
+
+	qc := &types.MockQueryCoord{}
+	qc.EXPECT().GetSegmentInfo(mock.Anything, mock.Anything).Return(&querypb.GetSegmentInfoResponse{
+		Status: successStatus,
+		Infos: []*querypb.SegmentInfo{
+			{SegmentID: 1, PartitionID: 10, DmChannel: "dml_0", NumRows: 100, NodeIds: []int64{1}},
+			{SegmentID: 2, PartitionID: 10, DmChannel: "dml_0", NumRows: 100, NodeIds: []int64{1}},
+			{SegmentID: 3, PartitionID: 11, DmChannel: "dml_1", NumRows: 100, NodeIds: []int64{2}},
+		},
+	}, nil)
+	dc := mocks.NewDataCoord(t)
+	dc.EXPECT().DescribeIndex(mock.Anything, mock.Anything).Return(&indexpb.DescribeIndexResponse{
+		Status: successStatus,
+		IndexInfos: []*indexpb.IndexInfo{{
+			FieldID:     101,
+			IndexName:   "age_index",
+			IndexParams: []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: "STL_SORT"}},
+		}},
+	}, nil)
+	dc.EXPECT().GetSegmentInfo(mock.Anything, mock.Anything).Return(&datapb.GetSegmentInfoResponse{
+		Status: successStatus,
+		Infos: []*datapb.SegmentInfo{
+			{ID: 1, ClusteringInfo: newAgeClusteringInfo(10, 19)},
+			{ID: 2, ClusteringInfo: newAgeClusteringInfo(0, 9)},
+		},
+	}, nil)
+
+	node := &Proxy{queryCoord: qc, dataCoord: dc}
+	node.stateCode.Store(commonpb.StateCode_Healthy)
+
+	t.Run("explain", func(t *testing.T) {
+		resp, err := node.Explain(ctx, &proxypb.ExplainRequest{
+			CollectionName: "explain",
+			PartitionNames: []string{"_default"},
+			Expr:           "age > 14",
+		})
+		require.NoError(t, err)
+		require.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Contains(t, resp.GetExprTree(), "GreaterThan")
+		assert.Equal(t, []string{"_default"}, resp.GetPartitionNames())
+
+		require.Equal(t, 1, len(resp.GetFields()))
+		assert.Equal(t, "age", resp.GetFields()[0].GetFieldName())
+		assert.Equal(t, "age_index", resp.GetFields()[0].GetIndexName())
+		assert.Equal(t, "STL_SORT", resp.GetFields()[0].GetIndexType())
+
+		require.Equal(t, 2, len(resp.GetShards()))
+		assert.Equal(t, "dml_0", resp.GetShards()[0].GetChannel())
+		assert.Equal(t, []int64{1}, resp.GetShards()[0].GetLeaderIDs())
+		segments := resp.GetShards()[0].GetSegments()
+		require.Equal(t, 2, len(segments))
+		assert.False(t, segments[0].GetPruned())
+		assert.Equal(t, int64(50), segments[0].GetEstimatedRows())
+		assert.True(t, segments[1].GetPruned())
+		// partition p1 isn't searched
+		assert.Empty(t, resp.GetShards()[1].GetSegments())
+	})
+
+	t.Run("invalid expr", func(t *testing.T) {
+		resp, err := node.Explain(ctx, &proxypb.ExplainRequest{
+			CollectionName: "explain",
+			Expr:           "not_exist > 1",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("collection not found", func(t *testing.T) {
+		cache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+			return 0, errors.New("collection not found")
+		})
+		defer cache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+			return 1, nil
+		})
+		resp, err := node.Explain(ctx, &proxypb.ExplainRequest{CollectionName: "explain"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("unhealthy", func(t *testing.T) {
+		node.stateCode.Store(commonpb.StateCode_Abnormal)
+		defer node.stateCode.Store(commonpb.StateCode_Healthy)
+		resp, err := node.Explain(ctx, &proxypb.ExplainRequest{CollectionName: "explain"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+}
@@ -60,7 +60,6 @@ import (
 )
 
 const moduleName = "Proxy"
-const SlowReadSpan = time.Second * 5
 
 // UpdateStateCode updates the state code of Proxy.
 func (node *Proxy) UpdateStateCode(code commonpb.StateCode) {
@@ -2444,8 +2443,8 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 
 	defer func() {
 		span := tr.ElapseSpan()
-		if span >= SlowReadSpan {
-			log.Info(rpcSlow(method), zap.Duration("duration", span))
+		if span >= Params.ProxyCfg.SlowQuerySpanInSeconds.GetAsDuration(time.Second) {
+			log.Info(rpcSlow(method), append([]zap.Field{zap.Duration("duration", span)}, qt.slowQueryFields()...)...)
 		}
 	}()
 
@@ -2630,7 +2629,7 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 
 	defer func() {
 		span := tr.ElapseSpan()
-		if span >= SlowReadSpan {
+		if span >= Params.ProxyCfg.SlowQuerySpanInSeconds.GetAsDuration(time.Second) {
 			log.Info(
 				rpcSlow(method),
 				zap.String("expr", request.Expr),
@@ -3251,6 +3250,44 @@ func (node *Proxy) GetQuerySegmentInfo(ctx context.Context, req *milvuspb.GetQue
 	return resp, nil
 }
 
+// Explain returns the plan of a search or query, the fields and indexes it uses and the segments it hits per shard,
+// the search or query isn't executed.
+func (node *Proxy) Explain(ctx context.Context, req *proxypb.ExplainRequest) (*proxypb.ExplainResponse, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-Explain")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", req.GetDbName()),
+		zap.String("collection", req.GetCollectionName()),
+		zap.Strings("partitions", req.GetPartitionNames()))
+
+	if !node.checkHealthy() {
+		return &proxypb.ExplainResponse{Status: unhealthyStatus()}, nil
+	}
+
+	method := "Explain"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
+
+	resp, err := node.explain(ctx, req)
+	if err != nil {
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		log.Warn("Failed to explain", zap.String("expr", req.GetExpr()), zap.Error(err))
+		return &proxypb.ExplainResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return resp, nil
+}
+
 // Dummy handles dummy request
 func (node *Proxy) Dummy(ctx context.Context, req *milvuspb.DummyRequest) (*milvuspb.DummyResponse, error) {
 	failedResponse := &milvuspb.DummyResponse{
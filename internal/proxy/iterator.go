@@ -0,0 +1,267 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/distance"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	radiusKey      = "radius"
+	rangeFilterKey = "range_filter"
+)
+
+// iteratorToken is the state of a query or search iterator between two batches.
+// It is handed to the client as an opaque string signed by the proxy, and sent back to fetch the next batch.
+//
+// For query iterators the primary keys hold the max primary key returned so far, which is used as cursor.
+// For search iterators the primary keys hold the entities already returned at distance Bound.
+type iteratorToken struct {
+	CollectionID int64    `json:"collection_id"`
+	Timestamp    uint64   `json:"timestamp"`
+	Bound        *float32 `json:"bound,omitempty"`
+	IntPKs       []int64  `json:"int_pks,omitempty"`
+	StrPKs       []string `json:"str_pks,omitempty"`
+}
+
+var (
+	iteratorTokenSecretOnce sync.Once
+	iteratorTokenSecret     []byte
+)
+
+// getIteratorTokenSecret returns the secret to sign iterator tokens with,
+// a random one is generated if it is not configured.
+func getIteratorTokenSecret() []byte {
+	iteratorTokenSecretOnce.Do(func() {
+		secret := paramtable.Get().ProxyCfg.IteratorTokenSecret.GetValue()
+		if secret != "" {
+			iteratorTokenSecret = []byte(secret)
+			return
+		}
+		iteratorTokenSecret = make([]byte, 32)
+		if _, err := rand.Read(iteratorTokenSecret); err != nil {
+			panic(fmt.Sprintf("failed to generate iterator token secret: %s", err.Error()))
+		}
+	})
+	return iteratorTokenSecret
+}
+
+func signIteratorToken(payload string) string {
+	mac := hmac.New(sha256.New, getIteratorTokenSecret())
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (t *iteratorToken) encode() (string, error) {
+	bs, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	payload := base64.RawURLEncoding.EncodeToString(bs)
+	return payload + "." + signIteratorToken(payload), nil
+}
+
+func decodeIteratorToken(s string) (*iteratorToken, error) {
+	payload, signature, ok := strings.Cut(s, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(signIteratorToken(payload))) {
+		return nil, fmt.Errorf("invalid %s: signature mismatch", IteratorTokenKey)
+	}
+	bs, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", IteratorTokenKey, err)
+	}
+	token := &iteratorToken{}
+	if err := json.Unmarshal(bs, token); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", IteratorTokenKey, err)
+	}
+	return token, nil
+}
+
+func (t *iteratorToken) ids() *schemapb.IDs {
+	if len(t.StrPKs) > 0 {
+		return &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: t.StrPKs}}}
+	}
+	return &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: t.IntPKs}}}
+}
+
+func (t *iteratorToken) appendPK(pk interface{}) {
+	switch v := pk.(type) {
+	case int64:
+		t.IntPKs = append(t.IntPKs, v)
+	case string:
+		t.StrPKs = append(t.StrPKs, v)
+	}
+}
+
+func (t *iteratorToken) numPKs() int {
+	return len(t.IntPKs) + len(t.StrPKs)
+}
+
+// parseIteratorParams returns whether the request asks for an iterator, and the token to resume from if any.
+func parseIteratorParams(kvs []*commonpb.KeyValuePair, collectionID int64) (bool, *iteratorToken, error) {
+	tokenStr, err := funcutil.GetAttrByKeyFromRepeatedKV(IteratorTokenKey, kvs)
+	if err == nil && tokenStr != "" {
+		token, err := decodeIteratorToken(tokenStr)
+		if err != nil {
+			return false, nil, err
+		}
+		if token.CollectionID != collectionID {
+			return false, nil, fmt.Errorf("%s does not belong to collection %d", IteratorTokenKey, collectionID)
+		}
+		return true, token, nil
+	}
+
+	iteratorStr, err := funcutil.GetAttrByKeyFromRepeatedKV(IteratorKey, kvs)
+	if err != nil {
+		return false, nil, nil
+	}
+	iterating, err := strconv.ParseBool(iteratorStr)
+	if err != nil {
+		return false, nil, fmt.Errorf("%s [%s] is invalid", IteratorKey, iteratorStr)
+	}
+	return iterating, nil, nil
+}
+
+// andExpr combines the user expression with the expression generated by the iterator.
+func andExpr(expr string, iteratorExpr string) string {
+	if expr == "" {
+		return iteratorExpr
+	}
+	return "(" + expr + ") && (" + iteratorExpr + ")"
+}
+
+// queryIteratorExpr returns the expression selecting entities after the cursor of a query iterator.
+func queryIteratorExpr(pkField *schemapb.FieldSchema, token *iteratorToken) string {
+	if len(token.StrPKs) > 0 {
+		return pkField.GetName() + " > " + quoteStringLiteral(token.StrPKs[len(token.StrPKs)-1])
+	}
+	return fmt.Sprintf("%s > %d", pkField.GetName(), token.IntPKs[len(token.IntPKs)-1])
+}
+
+// searchIteratorExpr returns the expression excluding entities already returned at the distance bound.
+func searchIteratorExpr(pkField *schemapb.FieldSchema, token *iteratorToken) string {
+	return "not (" + IDs2Expr(pkField.GetName(), token.ids()) + ")"
+}
+
+// nextQueryIteratorToken returns the token of the next batch, or nil if the iteration is done.
+func nextQueryIteratorToken(result *schemapb.FieldData, collectionID int64, ts uint64, limit int64) *iteratorToken {
+	size := typeutil.GetDataSize(result)
+	if size == 0 || int64(size) < limit {
+		return nil
+	}
+	token := &iteratorToken{
+		CollectionID: collectionID,
+		Timestamp:    ts,
+	}
+	// take the max primary key of the batch rather than the last one,
+	// so that the cursor does not rely on the order of the results
+	maxPK := typeutil.GetData(result, 0)
+	for i := 1; i < size; i++ {
+		if pk := typeutil.GetData(result, i); typeutil.ComparePK(maxPK, pk) {
+			maxPK = pk
+		}
+	}
+	token.appendPK(maxPK)
+	return token
+}
+
+// nextSearchIteratorToken returns the token of the next batch, or nil if the iteration is done.
+// The distance of the last returned entity becomes the new bound, all entities at the bound are remembered
+// so that they are excluded from the next batch.
+func nextSearchIteratorToken(result *schemapb.SearchResultData, prev *iteratorToken, collectionID int64, ts uint64, limit int64) *iteratorToken {
+	size := len(result.GetScores())
+	if size == 0 || int64(size) < limit {
+		return nil
+	}
+	bound := result.GetScores()[size-1]
+	token := &iteratorToken{
+		CollectionID: collectionID,
+		Timestamp:    ts,
+		Bound:        &bound,
+	}
+	if prev != nil && prev.Bound != nil && *prev.Bound == bound {
+		token.IntPKs = prev.IntPKs
+		token.StrPKs = prev.StrPKs
+	}
+	for i := 0; i < size; i++ {
+		if result.GetScores()[i] == bound {
+			token.appendPK(typeutil.GetPK(result.GetIds(), int64(i)))
+		}
+	}
+	return token
+}
+
+// withIteratorBound returns the search params restricting results to distances not better than the bound.
+func withIteratorBound(searchParams string, metricType string, bound float32) (string, error) {
+	params := make(map[string]interface{})
+	if searchParams != "" {
+		if err := json.Unmarshal([]byte(searchParams), &params); err != nil {
+			return "", fmt.Errorf("%s [%s] is invalid, %w", SearchParamsKey, searchParams, err)
+		}
+	}
+	params[rangeFilterKey] = bound
+	if _, ok := params[radiusKey]; !ok {
+		if distance.PositivelyRelated(metricType) {
+			params[radiusKey] = -math.MaxFloat32
+		} else {
+			params[radiusKey] = math.MaxFloat32
+		}
+	}
+	bs, err := json.Marshal(params)
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+// setIteratorToken returns the token to the client through the response header,
+// which the http server forwards to the http response header.
+func setIteratorToken(ctx context.Context, token *iteratorToken) {
+	if token == nil {
+		return
+	}
+	tokenStr, err := token.encode()
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to encode iterator token", zap.Error(err))
+		return
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs(IteratorTokenKey, tokenStr)); err != nil {
+		log.Ctx(ctx).Warn("failed to return iterator token", zap.Error(err))
+	}
+}
@@ -0,0 +1,246 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/distance"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func TestIteratorToken(t *testing.T) {
+	bound := float32(0.25)
+	token := &iteratorToken{
+		CollectionID: 1,
+		Timestamp:    100,
+		Bound:        &bound,
+		StrPKs:       []string{"a", "b"},
+	}
+	s, err := token.encode()
+	require.NoError(t, err)
+
+	decoded, err := decodeIteratorToken(s)
+	require.NoError(t, err)
+	assert.Equal(t, token, decoded)
+	assert.Equal(t, []string{"a", "b"}, decoded.ids().GetStrId().GetData())
+
+	_, err = decodeIteratorToken("not a token")
+	assert.Error(t, err)
+
+	// tokens modified by the client are rejected
+	payload, signature, _ := strings.Cut(s, ".")
+	forged := &iteratorToken{CollectionID: 1, Timestamp: 200, Bound: &bound}
+	bs, err := json.Marshal(forged)
+	require.NoError(t, err)
+	_, err = decodeIteratorToken(base64.RawURLEncoding.EncodeToString(bs) + "." + signature)
+	assert.Error(t, err)
+	_, err = decodeIteratorToken(payload)
+	assert.Error(t, err)
+}
+
+func TestParseIteratorParams(t *testing.T) {
+	token := &iteratorToken{CollectionID: 1, Timestamp: 100, IntPKs: []int64{10}}
+	tokenStr, err := token.encode()
+	require.NoError(t, err)
+
+	t.Run("not iterating", func(t *testing.T) {
+		iterating, token, err := parseIteratorParams([]*commonpb.KeyValuePair{{Key: LimitKey, Value: "10"}}, 1)
+		assert.NoError(t, err)
+		assert.False(t, iterating)
+		assert.Nil(t, token)
+	})
+
+	t.Run("first batch", func(t *testing.T) {
+		iterating, token, err := parseIteratorParams([]*commonpb.KeyValuePair{{Key: IteratorKey, Value: "true"}}, 1)
+		assert.NoError(t, err)
+		assert.True(t, iterating)
+		assert.Nil(t, token)
+
+		_, _, err = parseIteratorParams([]*commonpb.KeyValuePair{{Key: IteratorKey, Value: "yes please"}}, 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("next batch", func(t *testing.T) {
+		iterating, decoded, err := parseIteratorParams([]*commonpb.KeyValuePair{{Key: IteratorTokenKey, Value: tokenStr}}, 1)
+		assert.NoError(t, err)
+		assert.True(t, iterating)
+		assert.Equal(t, token, decoded)
+
+		_, _, err = parseIteratorParams([]*commonpb.KeyValuePair{{Key: IteratorTokenKey, Value: tokenStr}}, 2)
+		assert.Error(t, err)
+	})
+}
+
+func TestIteratorExpr(t *testing.T) {
+	intPK := &schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true}
+	strPK := &schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_VarChar, IsPrimaryKey: true}
+
+	assert.Equal(t, "pk > 20", queryIteratorExpr(intPK, &iteratorToken{IntPKs: []int64{20}}))
+	assert.Equal(t, "pk > \"b\"", queryIteratorExpr(strPK, &iteratorToken{StrPKs: []string{"b"}}))
+	assert.Equal(t, "not (pk in [ 1, 2 ])", searchIteratorExpr(intPK, &iteratorToken{IntPKs: []int64{1, 2}}))
+
+	// string primary keys are escaped the way the plan parser unquotes them
+	schema := &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{{FieldID: 100, Name: "pk", DataType: schemapb.DataType_VarChar, IsPrimaryKey: true}}}
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	require.NoError(t, err)
+	strPKs := []string{`a"b`, `c\d`, "e\nf", "中文"}
+	for _, pk := range strPKs {
+		expr, err := planparserv2.ParseExpr(helper, queryIteratorExpr(strPK, &iteratorToken{StrPKs: []string{pk}}))
+		require.NoError(t, err)
+		assert.Equal(t, pk, expr.GetUnaryRangeExpr().GetValue().GetStringVal())
+	}
+	expr, err := planparserv2.ParseExpr(helper, searchIteratorExpr(strPK, &iteratorToken{StrPKs: strPKs}))
+	require.NoError(t, err)
+	values := lo.Map(expr.GetUnaryExpr().GetChild().GetTermExpr().GetValues(), func(v *planpb.GenericValue, _ int) string {
+		return v.GetStringVal()
+	})
+	assert.Equal(t, strPKs, values)
+
+	assert.Equal(t, "pk > 20", andExpr("", "pk > 20"))
+	assert.Equal(t, "(a < 3) && (pk > 20)", andExpr("a < 3", "pk > 20"))
+}
+
+func TestNextQueryIteratorToken(t *testing.T) {
+	pkData := &schemapb.FieldData{
+		Type: schemapb.DataType_Int64,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{3, 8, 5}}},
+			},
+		},
+	}
+
+	// the cursor is the max primary key of the batch whatever the order is
+	token := nextQueryIteratorToken(pkData, 1, 100, 3)
+	require.NotNil(t, token)
+	assert.Equal(t, int64(1), token.CollectionID)
+	assert.Equal(t, uint64(100), token.Timestamp)
+	assert.Equal(t, []int64{8}, token.IntPKs)
+
+	// less entities than the limit means the iteration is done
+	assert.Nil(t, nextQueryIteratorToken(pkData, 1, 100, 4))
+}
+
+func TestQueryIteratorOutOfOrder(t *testing.T) {
+	// the primary keys are inserted out of order across several segments
+	segments := [][]int64{
+		{17, 3, 25, 9, 0, 14, 21, 6},
+		{28, 1, 12, 19, 7, 24, 4},
+		{10, 22, 2, 15, 27, 5, 18, 11, 26, 8, 20, 13, 23, 16, 29},
+	}
+	retrieve := func(cursor *iteratorToken) []*internalpb.RetrieveResults {
+		results := make([]*internalpb.RetrieveResults, 0, len(segments))
+		for _, pks := range segments {
+			selected := lo.Filter(pks, func(pk int64, _ int) bool {
+				return cursor == nil || pk > cursor.IntPKs[0]
+			})
+			results = append(results, &internalpb.RetrieveResults{
+				Ids: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: selected}}},
+				FieldsData: []*schemapb.FieldData{{
+					Type:    schemapb.DataType_Int64,
+					FieldId: 100,
+					Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: selected}},
+					}},
+				}},
+			})
+		}
+		return results
+	}
+
+	var (
+		got   []int64
+		token *iteratorToken
+		limit = int64(7)
+	)
+	for i := 0; i < 10; i++ {
+		ret, err := reduceRetrieveResults(context.Background(), retrieve(token), &queryParams{limit: limit})
+		require.NoError(t, err)
+		got = append(got, ret.GetFieldsData()[0].GetScalars().GetLongData().GetData()...)
+		token = nextQueryIteratorToken(ret.GetFieldsData()[0], 1, 100, limit)
+		if token == nil {
+			break
+		}
+	}
+	assert.Nil(t, token)
+	expected := make([]int64, 30)
+	for i := range expected {
+		expected[i] = int64(i)
+	}
+	assert.Equal(t, expected, got)
+}
+
+func TestNextSearchIteratorToken(t *testing.T) {
+	result := &schemapb.SearchResultData{
+		Scores: []float32{0.1, 0.2, 0.2},
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3}}},
+		},
+	}
+
+	token := nextSearchIteratorToken(result, nil, 1, 100, 3)
+	require.NotNil(t, token)
+	assert.Equal(t, float32(0.2), *token.Bound)
+	assert.Equal(t, []int64{2, 3}, token.IntPKs)
+
+	// entities at the same bound accumulate across batches
+	next := &schemapb.SearchResultData{
+		Scores: []float32{0.2, 0.2, 0.2},
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{4, 5, 6}}},
+		},
+	}
+	token = nextSearchIteratorToken(next, token, 1, 100, 3)
+	require.NotNil(t, token)
+	assert.Equal(t, []int64{2, 3, 4, 5, 6}, token.IntPKs)
+
+	assert.Nil(t, nextSearchIteratorToken(result, nil, 1, 100, 4))
+}
+
+func TestWithIteratorBound(t *testing.T) {
+	params, err := withIteratorBound(`{"nprobe": 10}`, distance.L2, 0.5)
+	require.NoError(t, err)
+	m := make(map[string]interface{})
+	require.NoError(t, json.Unmarshal([]byte(params), &m))
+	assert.Equal(t, float64(10), m["nprobe"])
+	assert.Equal(t, 0.5, m[rangeFilterKey])
+	assert.Greater(t, m[radiusKey], 0.5)
+
+	params, err = withIteratorBound(`{"radius": 0.1}`, distance.IP, 0.5)
+	require.NoError(t, err)
+	m = make(map[string]interface{})
+	require.NoError(t, json.Unmarshal([]byte(params), &m))
+	assert.Equal(t, 0.1, m[radiusKey])
+	assert.Equal(t, 0.5, m[rangeFilterKey])
+
+	_, err = withIteratorBound("{", distance.L2, 0.5)
+	assert.Error(t, err)
+}
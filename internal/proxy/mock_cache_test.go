@@ -13,6 +13,8 @@ type getCollectionSchemaFunc func(ctx context.Context, collectionName string) (*
 type getCollectionInfoFunc func(ctx context.Context, collectionName string) (*collectionInfo, error)
 type getUserRoleFunc func(username string) []string
 type getPartitionIDFunc func(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error)
+type getPartitionsFunc func(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error)
+type getShardsFunc func(ctx context.Context, withCache bool, collectionName string) (map[string][]nodeInfo, error)
 
 type mockCache struct {
 	Cache
@@ -22,6 +24,8 @@ type mockCache struct {
 	getInfoFunc        getCollectionInfoFunc
 	getUserRoleFunc    getUserRoleFunc
 	getPartitionIDFunc getPartitionIDFunc
+	getPartitionsFunc  getPartitionsFunc
+	getShardsFunc      getShardsFunc
 }
 
 func (m *mockCache) GetCollectionID(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
@@ -62,6 +66,20 @@ func (m *mockCache) GetPartitionID(ctx context.Context, collectionName string, p
 	return 0, nil
 }
 
+func (m *mockCache) GetPartitions(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error) {
+	if m.getPartitionsFunc != nil {
+		return m.getPartitionsFunc(ctx, collectionName)
+	}
+	return nil, nil
+}
+
+func (m *mockCache) GetShards(ctx context.Context, withCache bool, collectionName string) (map[string][]nodeInfo, error) {
+	if m.getShardsFunc != nil {
+		return m.getShardsFunc(ctx, withCache, collectionName)
+	}
+	return nil, nil
+}
+
 func (m *mockCache) GetUserRole(username string) []string {
 	if m.getUserRoleFunc != nil {
 		return m.getUserRoleFunc(username)
@@ -85,6 +103,14 @@ func (m *mockCache) setGetPartitionIDFunc(f getPartitionIDFunc) {
 	m.getPartitionIDFunc = f
 }
 
+func (m *mockCache) setGetPartitionsFunc(f getPartitionsFunc) {
+	m.getPartitionsFunc = f
+}
+
+func (m *mockCache) setGetShardsFunc(f getShardsFunc) {
+	m.getShardsFunc = f
+}
+
 func newMockCache() *mockCache {
 	return &mockCache{}
 }
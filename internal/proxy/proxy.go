@@ -189,6 +189,9 @@ func (node *Proxy) Init() error {
 	accesslog.SetupAccseeLog(&Params.ProxyCfg.AccessLog, &Params.MinioCfg)
 	log.Debug("init access log for Proxy done")
 
+	accesslog.SetupAuditLog(&Params.ProxyCfg.AuditLog, &Params.MinioCfg)
+	log.Debug("init audit log for Proxy done")
+
 	err := node.initRateCollector()
 	if err != nil {
 		return err
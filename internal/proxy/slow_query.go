@@ -0,0 +1,122 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+// maxSlowSegmentNum is the max number of the slowest segments of each shard printed in the slow query log.
+const maxSlowSegmentNum = 10
+
+// shardSearchCost is the cost of searching on a shard leader, which is printed in the slow query log.
+type shardSearchCost struct {
+	nodeID       int64
+	channels     []string
+	latency      time.Duration
+	waitTsafeMs  int64
+	reduceMs     int64
+	segmentCosts []*internalpb.SegmentSearchCost
+}
+
+func newShardSearchCost(nodeID int64, channels []string, latency time.Duration, result *internalpb.SearchResults) *shardSearchCost {
+	return &shardSearchCost{
+		nodeID:       nodeID,
+		channels:     channels,
+		latency:      latency,
+		waitTsafeMs:  result.GetWaitTsafeMs(),
+		reduceMs:     result.GetReduceMs(),
+		segmentCosts: result.GetSegmentCosts(),
+	}
+}
+
+// String returns the delegator latency, wait tsafe time, reduce time and the slowest segments of the shard,
+// e.g. "node=1 channels=[ch] latency=3010ms waitTsafe=5ms reduce=2ms segments(sealed=4 growing=1 bruteForce=1)=[1(sealed,indexed):2900ms ...]"
+func (c *shardSearchCost) String() string {
+	var sealed, growing, bruteForce int
+	for _, cost := range c.segmentCosts {
+		if cost.GetGrowing() {
+			growing++
+		} else {
+			sealed++
+		}
+		if !cost.GetIndexed() {
+			bruteForce++
+		}
+	}
+
+	costs := make([]*internalpb.SegmentSearchCost, len(c.segmentCosts))
+	copy(costs, c.segmentCosts)
+	sort.SliceStable(costs, func(i, j int) bool {
+		return costs[i].GetCostMs() > costs[j].GetCostMs()
+	})
+	if len(costs) > maxSlowSegmentNum {
+		costs = costs[:maxSlowSegmentNum]
+	}
+	segments := make([]string, 0, len(costs))
+	for _, cost := range costs {
+		segType, method := "sealed", "indexed"
+		if cost.GetGrowing() {
+			segType = "growing"
+		}
+		if !cost.GetIndexed() {
+			method = "bruteForce"
+		}
+		segments = append(segments, fmt.Sprintf("%d(%s,%s):%dms", cost.GetSegmentID(), segType, method, cost.GetCostMs()))
+	}
+
+	return fmt.Sprintf("node=%d channels=%v latency=%dms waitTsafe=%dms reduce=%dms segments(sealed=%d growing=%d bruteForce=%d)=[%s]",
+		c.nodeID, c.channels, c.latency.Milliseconds(), c.waitTsafeMs, c.reduceMs,
+		sealed, growing, bruteForce, strings.Join(segments, " "))
+}
+
+func (t *searchTask) recordShardCost(cost *shardSearchCost) {
+	t.shardCostsMu.Lock()
+	defer t.shardCostsMu.Unlock()
+	t.shardCosts = append(t.shardCosts, cost)
+}
+
+// slowQueryFields returns the request, the chosen partitions and the cost of each stage of the search.
+func (t *searchTask) slowQueryFields() []zap.Field {
+	t.shardCostsMu.Lock()
+	shards := make([]string, 0, len(t.shardCosts))
+	for _, cost := range t.shardCosts {
+		shards = append(shards, cost.String())
+	}
+	t.shardCostsMu.Unlock()
+
+	return []zap.Field{
+		zap.String("collection", t.request.GetCollectionName()),
+		zap.Strings("partitions", t.request.GetPartitionNames()),
+		zap.Int64s("partitionIDs", t.GetPartitionIDs()),
+		zap.String("expr", t.request.GetDsl()),
+		zap.Int64("nq", t.GetNq()),
+		zap.Int64("topk", t.GetTopk()),
+		zap.Any("params", t.request.GetSearchParams()),
+		zap.Strings("outputFields", t.request.GetOutputFields()),
+		zap.Uint64("guaranteeTimestamp", t.GetGuaranteeTimestamp()),
+		zap.Strings("shards", shards),
+		zap.Duration("reduce", t.reduceCost),
+	}
+}
@@ -0,0 +1,79 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+func TestShardSearchCost(t *testing.T) {
+	result := &internalpb.SearchResults{
+		WaitTsafeMs: 5,
+		ReduceMs:    2,
+	}
+	for i := 0; i < maxSlowSegmentNum+2; i++ {
+		result.SegmentCosts = append(result.SegmentCosts, &internalpb.SegmentSearchCost{
+			SegmentID: int64(i),
+			Growing:   i == 0,
+			Indexed:   i > 1,
+			CostMs:    int64(i * 10),
+		})
+	}
+
+	cost := newShardSearchCost(1, []string{"ch"}, 3*time.Second, result)
+	str := cost.String()
+	assert.Contains(t, str, "node=1 channels=[ch] latency=3000ms waitTsafe=5ms reduce=2ms")
+	assert.Contains(t, str, "segments(sealed=11 growing=1 bruteForce=2)")
+	assert.Contains(t, str, fmt.Sprintf("[%d(sealed,indexed):%dms", maxSlowSegmentNum+1, (maxSlowSegmentNum+1)*10))
+	// only the slowest segments are printed
+	assert.NotContains(t, str, "0(growing,bruteForce)")
+
+	// failed to search the shard
+	cost = newShardSearchCost(2, []string{"ch"}, time.Second, nil)
+	assert.Contains(t, cost.String(), "segments(sealed=0 growing=0 bruteForce=0)=[]")
+}
+
+func TestSearchTask_SlowQueryFields(t *testing.T) {
+	task := &searchTask{
+		SearchRequest: &internalpb.SearchRequest{
+			Nq:           2,
+			Topk:         10,
+			PartitionIDs: []int64{1},
+		},
+		request: &milvuspb.SearchRequest{
+			CollectionName: "coll",
+			PartitionNames: []string{"p1"},
+			Dsl:            "age > 10",
+		},
+	}
+	task.recordShardCost(newShardSearchCost(1, []string{"ch"}, time.Second, &internalpb.SearchResults{}))
+	fields := task.slowQueryFields()
+	keys := make(map[string]struct{})
+	for _, field := range fields {
+		keys[field.Key] = struct{}{}
+	}
+	for _, key := range []string{"collection", "partitions", "partitionIDs", "expr", "nq", "topk", "params", "shards", "reduce"} {
+		assert.Contains(t, keys, key)
+	}
+}
@@ -51,6 +51,8 @@ const (
 	RoundDecimalKey  = "round_decimal"
 	OffsetKey        = "offset"
 	LimitKey         = "limit"
+	IteratorKey      = "iterator"
+	IteratorTokenKey = "iterator_token"
 
 	InsertTaskName                = "InsertTask"
 	CreateCollectionTaskName      = "CreateCollectionTask"
@@ -200,6 +202,11 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	// validate default value and nullability
+	if err := validateDefaultValue(cct.schema); err != nil {
+		return err
+	}
+
 	for _, field := range cct.schema.Fields {
 		// validate field name
 		if err := validateFieldName(field.Name); err != nil {
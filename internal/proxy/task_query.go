@@ -63,6 +63,9 @@ type queryTask struct {
 	shardMgr         *shardClientMgr
 
 	plan *planpb.PlanNode
+
+	iterating     bool
+	iteratorToken *iteratorToken
 }
 
 type queryParams struct {
@@ -308,6 +311,17 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	t.queryParams = queryParams
 	t.RetrieveRequest.Limit = queryParams.limit + queryParams.offset
 
+	t.iterating, t.iteratorToken, err = parseIteratorParams(t.request.GetQueryParams(), t.CollectionID)
+	if err != nil {
+		return err
+	}
+	if t.iterating && queryParams.limit == typeutil.Unlimited {
+		return fmt.Errorf("%s must be set when iterating", LimitKey)
+	}
+	if t.iterating && queryParams.offset != 0 {
+		return fmt.Errorf("%s is not allowed when iterating", OffsetKey)
+	}
+
 	loaded, err := checkIfLoaded(ctx, t.qc, collectionName, t.RetrieveRequest.GetPartitionIDs())
 	if err != nil {
 		return fmt.Errorf("checkIfLoaded failed when query, collection:%v, partitions:%v, err = %s", collectionName, t.request.GetPartitionNames(), err)
@@ -329,10 +343,22 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 		t.request.Expr = IDs2Expr(pkField, t.ids)
 	}
 
+	if t.iteratorToken != nil {
+		pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+		if err != nil {
+			return err
+		}
+		t.request.Expr = andExpr(t.request.GetExpr(), queryIteratorExpr(pkField, t.iteratorToken))
+	}
+
 	if err := t.createPlan(ctx); err != nil {
 		return err
 	}
 
+	if t.iterating && t.plan.GetQuery().GetIsCount() {
+		return fmt.Errorf("count entities with iterator is not allowed")
+	}
+
 	// count with pagination
 	if t.plan.GetQuery().GetIsCount() && t.queryParams.limit != typeutil.Unlimited {
 		return fmt.Errorf("count entities with pagination is not allowed")
@@ -352,6 +378,10 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	} else {
 		t.TravelTimestamp = t.request.TravelTimestamp
 	}
+	// all batches of an iterator read the same snapshot
+	if t.iteratorToken != nil {
+		t.TravelTimestamp = t.iteratorToken.Timestamp
+	}
 
 	err = validateTravelTimestamp(t.TravelTimestamp, t.BeginTs())
 	if err != nil {
@@ -360,6 +390,9 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 
 	guaranteeTs := t.request.GetGuaranteeTimestamp()
 	t.GuaranteeTimestamp = parseGuaranteeTs(guaranteeTs, t.BeginTs())
+	if t.iteratorToken != nil {
+		t.GuaranteeTimestamp = t.iteratorToken.Timestamp
+	}
 
 	deadline, ok := t.TraceCtx().Deadline()
 	if ok {
@@ -447,6 +480,17 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 	}
 	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.QueryLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
 
+	if t.iterating {
+		pkField, err := typeutil.GetPrimaryFieldSchema(t.schema)
+		if err != nil {
+			return err
+		}
+		pkData, err := typeutil.GetPrimaryFieldData(t.result.GetFieldsData(), pkField)
+		if err == nil {
+			setIteratorToken(ctx, nextQueryIteratorToken(pkData, t.CollectionID, t.TravelTimestamp, t.queryParams.limit))
+		}
+	}
+
 	log.Debug("Query PostExecute done")
 	return nil
 }
@@ -486,6 +530,14 @@ func (t *queryTask) queryShard(ctx context.Context, nodeID int64, qn types.Query
 	return nil
 }
 
+// stringLiteralEscaper escapes the characters a string literal of the plan parser can not contain as they are.
+var stringLiteralEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`)
+
+// quoteStringLiteral returns str as a string literal of a boolean expression.
+func quoteStringLiteral(str string) string {
+	return `"` + stringLiteralEscaper.Replace(str) + `"`
+}
+
 // IDs2Expr converts ids slices to bool expresion with specified field name
 func IDs2Expr(fieldName string, ids *schemapb.IDs) string {
 	var idsStr string
@@ -494,7 +546,7 @@ func IDs2Expr(fieldName string, ids *schemapb.IDs) string {
 		idsStr = strings.Trim(strings.Join(strings.Fields(fmt.Sprint(ids.GetIntId().GetData())), ", "), "[]")
 	case *schemapb.IDs_StrId:
 		strs := lo.Map(ids.GetStrId().GetData(), func(str string, _ int) string {
-			return fmt.Sprintf("\"%s\"", str)
+			return quoteStringLiteral(str)
 		})
 		idsStr = strings.Trim(strings.Join(strs, ", "), "[]")
 	}
@@ -517,6 +569,8 @@ func reduceRetrieveResults(ctx context.Context, retrieveResults []*internalpb.Re
 		if r == nil || len(r.GetFieldsData()) == 0 || size == 0 {
 			continue
 		}
+		// the merge below takes the rows in primary key order only if every result is sorted
+		r.Ids, r.FieldsData, _ = typeutil.SortByPK(r.GetIds(), r.GetFieldsData())
 		validRetrieveResults = append(validRetrieveResults, r)
 		loopEnd += size
 	}
@@ -567,7 +621,52 @@ func reduceRetrieveResults(ctx context.Context, retrieveResults []*internalpb.Re
 	return ret, nil
 }
 
+// fillMissingRetrieveFields fills the output fields which are nullable or default-valued but absent in
+// retrieve results, which happens if the results come from segments loaded before the fields were added.
+// The fields data of retrieve results are in the order of outputFieldsID.
+func fillMissingRetrieveFields(retrieveResults []*internalpb.RetrieveResults, outputFieldsID []int64, schema *schemapb.CollectionSchema) error {
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range retrieveResults {
+		size := typeutil.GetSizeOfIDs(r.GetIds())
+		if size == 0 || len(r.GetFieldsData()) == 0 || len(r.GetFieldsData()) >= len(outputFieldsID) {
+			continue
+		}
+
+		aligned := make([]*schemapb.FieldData, 0, len(outputFieldsID))
+		cursor := 0
+		for _, fieldID := range outputFieldsID {
+			if cursor < len(r.GetFieldsData()) && r.GetFieldsData()[cursor].GetFieldId() == fieldID {
+				aligned = append(aligned, r.GetFieldsData()[cursor])
+				cursor++
+				continue
+			}
+			field, err := helper.GetFieldFromID(fieldID)
+			if err != nil {
+				return err
+			}
+			if !typeutil.IsFieldFillable(field) {
+				return fmt.Errorf("field %s is absent in retrieve results", field.GetName())
+			}
+			fieldData, err := typeutil.GenDefaultFieldData(field, size)
+			if err != nil {
+				return err
+			}
+			aligned = append(aligned, fieldData)
+		}
+		r.FieldsData = aligned
+	}
+	return nil
+}
+
 func reduceRetrieveResultsAndFillIfEmpty(ctx context.Context, retrieveResults []*internalpb.RetrieveResults, queryParams *queryParams, outputFieldsID []int64, schema *schemapb.CollectionSchema) (*milvuspb.QueryResults, error) {
+	if err := fillMissingRetrieveFields(retrieveResults, outputFieldsID, schema); err != nil {
+		return nil, fmt.Errorf("failed to fill retrieve results: %s", err.Error())
+	}
+
 	result, err := reduceRetrieveResults(ctx, retrieveResults, queryParams)
 	if err != nil {
 		return nil, err
@@ -894,3 +894,40 @@ func TestQueryTask_IDs2Expr(t *testing.T) {
 	expectStrExpr := "pk in [ \"a\", \"b\", \"c\" ]"
 	assert.Equal(t, expectStrExpr, strExpr)
 }
+
+func TestTaskQuery_fillMissingRetrieveFields(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "required", DataType: schemapb.DataType_Int64},
+			{
+				FieldID:      102,
+				Name:         "added",
+				DataType:     schemapb.DataType_Int64,
+				DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 9}},
+			},
+		},
+	}
+	ids := &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2}}}}
+	newResult := func(fieldsData ...*schemapb.FieldData) *internalpb.RetrieveResults {
+		return &internalpb.RetrieveResults{Ids: ids, FieldsData: fieldsData}
+	}
+	pk := getFieldData("pk", 100, schemapb.DataType_Int64, []int64{1, 2}, 1)
+	required := getFieldData("required", 101, schemapb.DataType_Int64, []int64{3, 4}, 1)
+	added := getFieldData("added", 102, schemapb.DataType_Int64, []int64{5, 6}, 1)
+
+	t.Run("fill absent field", func(t *testing.T) {
+		full := newResult(pk, required, added)
+		old := newResult(pk, required)
+		err := fillMissingRetrieveFields([]*internalpb.RetrieveResults{full, old}, []int64{100, 101, 102}, schema)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(full.GetFieldsData()))
+		assert.Equal(t, 3, len(old.GetFieldsData()))
+		assert.Equal(t, []int64{9, 9}, old.GetFieldsData()[2].GetScalars().GetLongData().GetData())
+	})
+
+	t.Run("absent field not fillable", func(t *testing.T) {
+		err := fillMissingRetrieveFields([]*internalpb.RetrieveResults{newResult(pk, added)}, []int64{100, 101, 102}, schema)
+		assert.Error(t, err)
+	})
+}
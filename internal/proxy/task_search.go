@@ -6,6 +6,8 @@ import (
 	"math"
 	"regexp"
 	"strconv"
+	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/golang/protobuf/proto"
@@ -68,6 +70,14 @@ type searchTask struct {
 
 	qc   types.QueryCoord
 	node types.ProxyComponent
+
+	iterating     bool
+	iteratorToken *iteratorToken
+
+	// costs for the slow query log
+	shardCostsMu sync.Mutex
+	shardCosts   []*shardSearchCost
+	reduceCost   time.Duration
 }
 
 func getPartitionIDs(ctx context.Context, collectionName string, partitionNames []string) (partitionIDs []UniqueID, err error) {
@@ -294,6 +304,30 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 		}
 		t.offset = offset
 
+		t.iterating, t.iteratorToken, err = parseIteratorParams(t.request.GetSearchParams(), collID)
+		if err != nil {
+			return err
+		}
+		if t.iterating && offset != 0 {
+			return fmt.Errorf("%s is not allowed when iterating", OffsetKey)
+		}
+		if t.iterating && nq != 1 {
+			return fmt.Errorf("%s [%d] is invalid, only one vector can be searched when iterating", NQKey, nq)
+		}
+		if t.iteratorToken != nil && t.iteratorToken.Bound != nil {
+			queryInfo.SearchParams, err = withIteratorBound(queryInfo.GetSearchParams(), queryInfo.GetMetricType(), *t.iteratorToken.Bound)
+			if err != nil {
+				return err
+			}
+			if t.iteratorToken.numPKs() > 0 {
+				pkField, err := typeutil.GetPrimaryFieldSchema(t.schema)
+				if err != nil {
+					return err
+				}
+				t.request.Dsl = andExpr(t.request.GetDsl(), searchIteratorExpr(pkField, t.iteratorToken))
+			}
+		}
+
 		plan, err := planparserv2.CreateSearchPlan(t.schema, t.request.Dsl, annsField, queryInfo)
 		if err != nil {
 			log.Ctx(ctx).Warn("failed to create query plan", zap.Error(err),
@@ -334,6 +368,10 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	if travelTimestamp == 0 {
 		travelTimestamp = t.BeginTs()
 	}
+	// all batches of an iterator read the same snapshot
+	if t.iteratorToken != nil {
+		travelTimestamp = t.iteratorToken.Timestamp
+	}
 	err = validateTravelTimestamp(travelTimestamp, t.BeginTs())
 	if err != nil {
 		return err
@@ -342,6 +380,9 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 
 	guaranteeTs := t.request.GetGuaranteeTimestamp()
 	guaranteeTs = parseGuaranteeTs(guaranteeTs, t.BeginTs())
+	if t.iteratorToken != nil {
+		guaranteeTs = t.iteratorToken.Timestamp
+	}
 	t.SearchRequest.GuaranteeTimestamp = guaranteeTs
 
 	if deadline, ok := t.TraceCtx().Deadline(); ok {
@@ -450,11 +491,16 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 		return err
 	}
 
-	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.SearchLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
+	t.reduceCost = tr.RecordSpan()
+	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.SearchLabel).Observe(float64(t.reduceCost.Milliseconds()))
 
 	t.result.CollectionName = t.collectionName
 	t.fillInFieldInfo()
 
+	if t.iterating {
+		setIteratorToken(ctx, nextSearchIteratorToken(t.result.GetResults(), t.iteratorToken, t.GetCollectionID(), t.GetTravelTimestamp(), Topk))
+	}
+
 	if t.requery {
 		err = t.Requery()
 		if err != nil {
@@ -486,7 +532,9 @@ func (t *searchTask) searchShard(ctx context.Context, nodeID int64, qn types.Que
 	var result *internalpb.SearchResults
 	var err error
 
+	start := time.Now()
 	result, err = qn.Search(ctx, req)
+	t.recordShardCost(newShardSearchCost(nodeID, channelIDs, time.Since(start), result))
 	if err != nil {
 		log.Warn("QueryNode search return error", zap.Error(err))
 		globalMetaCache.DeprecateShardCache(t.collectionName)
@@ -536,6 +584,10 @@ func (t *searchTask) Requery() error {
 	}
 	ids := t.result.GetResults().GetIds()
 	expr := IDs2Expr(pkField.GetName(), ids)
+	// requery fetches exactly the found entities, it must not be turned into a query iterator
+	queryParams := lo.Filter(t.request.GetSearchParams(), func(kv *commonpb.KeyValuePair, _ int) bool {
+		return kv.GetKey() != IteratorKey && kv.GetKey() != IteratorTokenKey
+	})
 
 	queryReq := &milvuspb.QueryRequest{
 		Base: &commonpb.MsgBase{
@@ -545,9 +597,9 @@ func (t *searchTask) Requery() error {
 		Expr:               expr,
 		OutputFields:       t.request.GetOutputFields(),
 		PartitionNames:     t.request.GetPartitionNames(),
-		TravelTimestamp:    t.request.GetTravelTimestamp(),
-		GuaranteeTimestamp: t.request.GetGuaranteeTimestamp(),
-		QueryParams:        t.request.GetSearchParams(),
+		TravelTimestamp:    t.SearchRequest.GetTravelTimestamp(),
+		GuaranteeTimestamp: t.SearchRequest.GetGuaranteeTimestamp(),
+		QueryParams:        queryParams,
 	}
 	queryResult, err := t.node.Query(t.ctx, queryReq)
 	if err != nil {
@@ -299,6 +299,29 @@ func validateFieldType(schema *schemapb.CollectionSchema) error {
 	return nil
 }
 
+// validateDefaultValue checks the default value and nullability of fields.
+func validateDefaultValue(schema *schemapb.CollectionSchema) error {
+	for _, field := range schema.GetFields() {
+		if !typeutil.IsFieldFillable(field) {
+			continue
+		}
+		if field.GetIsPrimaryKey() {
+			return fmt.Errorf("primary field can not be nullable or have default value, field name = %s", field.GetName())
+		}
+		// only the scalar types which have default values could be nullable
+		if _, err := typeutil.GetDefaultValue(field); err != nil {
+			return err
+		}
+		if field.GetDataType() == schemapb.DataType_VarChar {
+			maxLength, err := GetMaxLength(field)
+			if err == nil && int64(len(field.GetDefaultValue().GetStringData())) > maxLength {
+				return fmt.Errorf("the length of default value exceeds max_length (%d), field name = %s", maxLength, field.GetName())
+			}
+		}
+	}
+	return nil
+}
+
 // ValidateFieldAutoID call after validatePrimaryKey
 func ValidateFieldAutoID(coll *schemapb.CollectionSchema) error {
 	var idx = -1
@@ -553,7 +576,7 @@ func autoGenPrimaryFieldData(fieldSchema *schemapb.FieldSchema, data interface{}
 
 // fillFieldIDBySchema set fieldID to fieldData according FieldSchemas
 func fillFieldIDBySchema(columns []*schemapb.FieldData, schema *schemapb.CollectionSchema) error {
-	if len(columns) != len(schema.GetFields()) {
+	if len(columns) > len(schema.GetFields()) {
 		return fmt.Errorf("len(columns) mismatch the len(fields), len(columns): %d, len(fields): %d",
 			len(columns), len(schema.GetFields()))
 	}
@@ -566,11 +589,21 @@ func fillFieldIDBySchema(columns []*schemapb.FieldData, schema *schemapb.Collect
 		if fieldSchema, ok := fieldName2Schema[fieldData.FieldName]; ok {
 			fieldData.FieldId = fieldSchema.FieldID
 			fieldData.Type = fieldSchema.DataType
+			delete(fieldName2Schema, fieldData.FieldName)
 		} else {
 			return fmt.Errorf("fieldName %v not exist in collection schema", fieldData.FieldName)
 		}
 	}
 
+	// null is set by column, the absent column of a nullable field means all its rows are null,
+	// a column with some null rows is not supported since FieldData carries no per-row validity
+	for _, field := range fieldName2Schema {
+		if !typeutil.IsFieldNullable(field) {
+			return fmt.Errorf("the column of field %s is absent, only the columns of nullable fields could be omitted, len(columns): %d, len(fields): %d",
+				field.GetName(), len(columns), len(schema.GetFields()))
+		}
+	}
+
 	return nil
 }
 
@@ -901,10 +934,12 @@ func isPartitionLoaded(ctx context.Context, qc types.QueryCoord, collID int64, p
 	return false, nil
 }
 
+// checkLengthOfFieldsData checks whether insertMsg passes the columns of all the fields but the auto id and nullable ones,
+// the columns of nullable fields could be omitted to set them null for all the rows.
 func checkLengthOfFieldsData(schema *schemapb.CollectionSchema, insertMsg *msgstream.InsertMsg) error {
 	neededFieldsNum := 0
 	for _, field := range schema.Fields {
-		if !field.AutoID {
+		if !field.AutoID && !typeutil.IsFieldNullable(field) {
 			neededFieldsNum++
 		}
 	}
@@ -916,6 +951,28 @@ func checkLengthOfFieldsData(schema *schemapb.CollectionSchema, insertMsg *msgst
 	return nil
 }
 
+// fillMissingFieldsData appends the columns of default-valued fields which are absent in insertMsg,
+// so that data written by clients unaware of newly added fields could still be inserted.
+// The absent columns of nullable fields without default value are left absent, which means null.
+func fillMissingFieldsData(schema *schemapb.CollectionSchema, insertMsg *msgstream.InsertMsg) error {
+	passed := make(map[string]struct{}, len(insertMsg.GetFieldsData()))
+	for _, fieldData := range insertMsg.GetFieldsData() {
+		passed[fieldData.GetFieldName()] = struct{}{}
+	}
+
+	for _, field := range schema.GetFields() {
+		if _, ok := passed[field.GetName()]; ok || field.GetAutoID() || field.GetDefaultValue() == nil {
+			continue
+		}
+		fieldData, err := typeutil.GenDefaultFieldData(field, int(insertMsg.NRows()))
+		if err != nil {
+			return err
+		}
+		insertMsg.FieldsData = append(insertMsg.FieldsData, fieldData)
+	}
+	return nil
+}
+
 func checkPrimaryFieldData(schema *schemapb.CollectionSchema, result *milvuspb.MutationResult, insertMsg *msgstream.InsertMsg, inInsert bool) (*schemapb.IDs, error) {
 	rowNums := uint32(insertMsg.NRows())
 	// TODO(dragondriver): in fact, NumRows is not trustable, we should check all input fields
@@ -923,6 +980,10 @@ func checkPrimaryFieldData(schema *schemapb.CollectionSchema, result *milvuspb.M
 		return nil, merr.WrapErrParameterInvalid("invalid num_rows", fmt.Sprint(rowNums), "num_rows should be greater than 0")
 	}
 
+	if err := fillMissingFieldsData(schema, insertMsg); err != nil {
+		return nil, err
+	}
+
 	if err := checkLengthOfFieldsData(schema, insertMsg); err != nil {
 		return nil, err
 	}
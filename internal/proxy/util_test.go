@@ -38,6 +38,8 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/crypto"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -622,6 +624,24 @@ func TestFillFieldIDBySchema(t *testing.T) {
 	assert.Equal(t, "TestFillFieldIDBySchema", columns[0].FieldName)
 	assert.Equal(t, schemapb.DataType_Int64, columns[0].Type)
 	assert.Equal(t, int64(1), columns[0].FieldId)
+
+	// the column of nullable field could be absent
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		Name:       "nullable",
+		DataType:   schemapb.DataType_Int64,
+		FieldID:    2,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.FieldNullableKey, Value: "true"}},
+	})
+	assert.NoError(t, fillFieldIDBySchema(columns, schema))
+
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		Name:     "required",
+		DataType: schemapb.DataType_Int64,
+		FieldID:  3,
+	})
+	err := fillFieldIDBySchema(columns, schema)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "the column of field required is absent")
 }
 
 func TestValidateUsername(t *testing.T) {
@@ -1584,3 +1604,78 @@ func Test_UpsertTaskCheckPrimaryFieldData(t *testing.T) {
 	_, err = checkPrimaryFieldData(case6.schema, case6.result, case6.insertMsg, false)
 	assert.NotEqual(t, nil, err)
 }
+
+func Test_fillMissingFieldsData(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{Name: "pk", FieldID: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{Name: "count", FieldID: 101, DataType: schemapb.DataType_Int64,
+				DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 7}}},
+			{Name: "tag", FieldID: 102, DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "max_length", Value: "8"}, {Key: common.FieldNullableKey, Value: "true"}}},
+		},
+	}
+	newInsertMsg := func() *msgstream.InsertMsg {
+		return &msgstream.InsertMsg{
+			InsertRequest: msgpb.InsertRequest{
+				Version: msgpb.InsertDataVersion_ColumnBased,
+				NumRows: 2,
+				FieldsData: []*schemapb.FieldData{
+					newScalarFieldData(&schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_Int64}, "pk", 2),
+				},
+			},
+		}
+	}
+
+	t.Run("fill missing fields", func(t *testing.T) {
+		insertMsg := newInsertMsg()
+		err := fillMissingFieldsData(schema, insertMsg)
+		assert.NoError(t, err)
+		// the nullable field is left absent
+		assert.Equal(t, 2, len(insertMsg.GetFieldsData()))
+		assert.Equal(t, "count", insertMsg.GetFieldsData()[1].GetFieldName())
+		assert.Equal(t, []int64{7, 7}, insertMsg.GetFieldsData()[1].GetScalars().GetLongData().GetData())
+		assert.NoError(t, checkLengthOfFieldsData(schema, insertMsg))
+		assert.NoError(t, fillFieldIDBySchema(insertMsg.GetFieldsData(), schema))
+	})
+
+	t.Run("passed fields are kept", func(t *testing.T) {
+		insertMsg := newInsertMsg()
+		count := newScalarFieldData(&schemapb.FieldSchema{Name: "count", DataType: schemapb.DataType_Int64}, "count", 2)
+		insertMsg.FieldsData = append(insertMsg.FieldsData, count)
+		err := fillMissingFieldsData(schema, insertMsg)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(insertMsg.GetFieldsData()))
+		assert.Same(t, count, insertMsg.GetFieldsData()[1])
+	})
+}
+
+func TestValidateDefaultValue(t *testing.T) {
+	newSchema := func(field *schemapb.FieldSchema) *schemapb.CollectionSchema {
+		return &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{field}}
+	}
+	nullable := []*commonpb.KeyValuePair{{Key: common.FieldNullableKey, Value: "true"}}
+
+	assert.NoError(t, validateDefaultValue(newSchema(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64})))
+	assert.NoError(t, validateDefaultValue(newSchema(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64, TypeParams: nullable})))
+	assert.NoError(t, validateDefaultValue(newSchema(&schemapb.FieldSchema{
+		Name:         "f",
+		DataType:     schemapb.DataType_VarChar,
+		TypeParams:   []*commonpb.KeyValuePair{{Key: "max_length", Value: "2"}},
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "ab"}},
+	})))
+
+	assert.Error(t, validateDefaultValue(newSchema(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64, IsPrimaryKey: true, TypeParams: nullable})))
+	assert.Error(t, validateDefaultValue(newSchema(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_FloatVector, TypeParams: nullable})))
+	assert.Error(t, validateDefaultValue(newSchema(&schemapb.FieldSchema{
+		Name:         "f",
+		DataType:     schemapb.DataType_Int64,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "a"}},
+	})))
+	assert.Error(t, validateDefaultValue(newSchema(&schemapb.FieldSchema{
+		Name:         "f",
+		DataType:     schemapb.DataType_VarChar,
+		TypeParams:   []*commonpb.KeyValuePair{{Key: "max_length", Value: "2"}},
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "abc"}},
+	})))
+}
@@ -39,7 +39,7 @@ type CheckerController struct {
 	meta      *meta.Meta
 	dist      *meta.DistributionManager
 	targetMgr *meta.TargetManager
-	broker    *meta.CoordinatorBroker
+	broker    meta.Broker
 	nodeMgr   *session.NodeManager
 	balancer  balance.Balance
 
@@ -55,7 +55,8 @@ func NewCheckerController(
 	targetMgr *meta.TargetManager,
 	balancer balance.Balance,
 	nodeMgr *session.NodeManager,
-	scheduler task.Scheduler) *CheckerController {
+	scheduler task.Scheduler,
+	broker meta.Broker) *CheckerController {
 
 	// CheckerController runs checkers with the order,
 	// the former checker has higher priority
@@ -63,6 +64,7 @@ func NewCheckerController(
 		NewChannelChecker(meta, dist, targetMgr, balancer),
 		NewSegmentChecker(meta, dist, targetMgr, balancer, nodeMgr),
 		NewBalanceChecker(balancer),
+		NewIndexChecker(meta, dist, targetMgr, broker),
 	}
 	for i, checker := range checkers {
 		checker.SetID(int64(i + 1))
@@ -74,6 +76,7 @@ func NewCheckerController(
 		meta:      meta,
 		dist:      dist,
 		targetMgr: targetMgr,
+		broker:    broker,
 		scheduler: scheduler,
 		checkers:  checkers,
 	}
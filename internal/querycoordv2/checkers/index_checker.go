@@ -0,0 +1,147 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkers
+
+import (
+	"context"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/task"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// IndexChecker finds the loaded segments whose indexes have been rebuilt,
+// the index of such a segment is switched to the serving build of DataCoord.
+type IndexChecker struct {
+	baseChecker
+	meta      *meta.Meta
+	dist      *meta.DistributionManager
+	targetMgr *meta.TargetManager
+	broker    meta.Broker
+
+	lastCheck time.Time
+}
+
+func NewIndexChecker(
+	meta *meta.Meta,
+	dist *meta.DistributionManager,
+	targetMgr *meta.TargetManager,
+	broker meta.Broker,
+) *IndexChecker {
+	return &IndexChecker{
+		meta:      meta,
+		dist:      dist,
+		targetMgr: targetMgr,
+		broker:    broker,
+	}
+}
+
+func (c *IndexChecker) Description() string {
+	return "IndexChecker checks the loaded segments whose indexes are outdated"
+}
+
+func (c *IndexChecker) Check(ctx context.Context) []task.Task {
+	// fetching index infos is expensive, don't do it in every round
+	if time.Since(c.lastCheck) < Params.QueryCoordCfg.IndexCheckInterval.GetAsDuration(time.Second) {
+		return nil
+	}
+	c.lastCheck = time.Now()
+
+	tasks := make([]task.Task, 0)
+	for _, collectionID := range c.meta.CollectionManager.GetAll() {
+		tasks = append(tasks, c.checkCollection(ctx, collectionID)...)
+	}
+	task.SetPriority(task.TaskPriorityLow, tasks...)
+	task.SetReason("segment index outdated", tasks...)
+	return tasks
+}
+
+func (c *IndexChecker) checkCollection(ctx context.Context, collectionID int64) []task.Task {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID))
+
+	// only the segments in current target are serving,
+	// the others will be loaded with the latest indexes
+	targets := c.targetMgr.GetHistoricalSegmentsByCollection(collectionID, meta.CurrentTarget)
+	loaded := lo.Filter(c.dist.SegmentDistManager.GetByCollection(collectionID), func(segment *meta.Segment, _ int) bool {
+		_, ok := targets[segment.GetID()]
+		return ok && len(segment.IndexInfo) > 0
+	})
+	if len(loaded) == 0 {
+		return nil
+	}
+
+	segmentIDs := lo.Uniq(lo.Map(loaded, func(segment *meta.Segment, _ int) int64 { return segment.GetID() }))
+	serving, err := c.broker.GetIndexInfos(ctx, collectionID, segmentIDs)
+	if err != nil {
+		log.Warn("failed to get index infos of segments", zap.Error(err))
+		return nil
+	}
+
+	ret := make([]task.Task, 0)
+	for _, segment := range loaded {
+		if !isIndexOutdated(segment, serving[segment.GetID()]) {
+			continue
+		}
+		replica := c.meta.ReplicaManager.GetByCollectionAndNode(collectionID, segment.Node)
+		if replica == nil {
+			continue
+		}
+
+		action := task.NewSegmentAction(segment.Node, task.ActionTypeUpdate, segment.GetInsertChannel(), segment.GetID())
+		t, err := task.NewSegmentTask(
+			ctx,
+			Params.QueryCoordCfg.SegmentTaskTimeout.GetAsDuration(time.Millisecond),
+			c.ID(),
+			collectionID,
+			replica.GetID(),
+			action,
+		)
+		if err != nil {
+			log.Warn("create segment update task failed",
+				zap.Int64("segmentID", segment.GetID()),
+				zap.Int64("node", segment.Node),
+				zap.Error(err),
+			)
+			continue
+		}
+		ret = append(ret, t)
+	}
+	return ret
+}
+
+// isIndexOutdated returns true if any field of the segment is loaded
+// with an index build which is not the serving one of DataCoord.
+// The fields loaded without index are handled by the index loading of querynode.
+func isIndexOutdated(segment *meta.Segment, serving []*querypb.FieldIndexInfo) bool {
+	servingBuilds := make(map[int64]int64, len(serving))
+	for _, info := range serving {
+		servingBuilds[info.GetFieldID()] = info.GetBuildID()
+	}
+	for _, info := range segment.IndexInfo {
+		buildID, ok := servingBuilds[info.GetFieldID()]
+		if ok && info.GetBuildID() != buildID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/task"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+)
+
+type IndexCheckerSuite struct {
+	suite.Suite
+	kv      *etcdkv.EtcdKV
+	checker *IndexChecker
+	broker  *meta.MockBroker
+}
+
+func (suite *IndexCheckerSuite) SetupSuite() {
+	Params.Init()
+}
+
+func (suite *IndexCheckerSuite) SetupTest() {
+	var err error
+	config := GenerateEtcdConfig()
+	cli, err := etcd.GetEtcdClient(
+		config.UseEmbedEtcd.GetAsBool(),
+		config.EtcdUseSSL.GetAsBool(),
+		config.Endpoints.GetAsStrings(),
+		config.EtcdTLSCert.GetValue(),
+		config.EtcdTLSKey.GetValue(),
+		config.EtcdTLSCACert.GetValue(),
+		config.EtcdTLSMinVersion.GetValue())
+	suite.Require().NoError(err)
+	suite.kv = etcdkv.NewEtcdKV(cli, config.MetaRootPath.GetValue())
+
+	// meta
+	store := meta.NewMetaStore(suite.kv)
+	idAllocator := RandomIncrementIDAllocator()
+	metaInfo := meta.NewMeta(idAllocator, store, session.NewNodeManager())
+	distManager := meta.NewDistributionManager()
+	suite.broker = meta.NewMockBroker(suite.T())
+	targetManager := meta.NewTargetManager(suite.broker, metaInfo)
+
+	suite.checker = NewIndexChecker(metaInfo, distManager, targetManager, suite.broker)
+}
+
+func (suite *IndexCheckerSuite) TearDownTest() {
+	suite.kv.Close()
+}
+
+func (suite *IndexCheckerSuite) TestCheck() {
+	checker := suite.checker
+	checker.meta.CollectionManager.PutCollection(utils.CreateTestCollection(1, 1))
+	checker.meta.ReplicaManager.Put(utils.CreateTestReplica(1, 1, []int64{1, 2}))
+
+	// set target
+	segments := []*datapb.SegmentInfo{
+		utils.CreateTestSegmentInfo(1, 1, 1, "test-insert-channel"),
+		utils.CreateTestSegmentInfo(1, 1, 2, "test-insert-channel"),
+	}
+	suite.broker.EXPECT().GetPartitions(mock.Anything, int64(1)).Return([]int64{1}, nil).Maybe()
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, int64(1)).Return(nil, segments, nil)
+	checker.targetMgr.UpdateCollectionNextTargetWithPartitions(int64(1), int64(1))
+	checker.targetMgr.UpdateCollectionCurrentTarget(int64(1), int64(1))
+
+	// set dist, segment 1 is loaded with outdated index
+	outdated := utils.CreateTestSegment(1, 1, 1, 1, 1, "test-insert-channel")
+	outdated.IndexInfo = []*querypb.FieldIndexInfo{{FieldID: 101, BuildID: 1000}}
+	latest := utils.CreateTestSegment(1, 1, 2, 2, 1, "test-insert-channel")
+	latest.IndexInfo = []*querypb.FieldIndexInfo{{FieldID: 101, BuildID: 2001}}
+	checker.dist.SegmentDistManager.Update(1, outdated)
+	checker.dist.SegmentDistManager.Update(2, latest)
+
+	suite.broker.EXPECT().GetIndexInfos(mock.Anything, int64(1), mock.Anything).Return(map[int64][]*querypb.FieldIndexInfo{
+		1: {{FieldID: 101, BuildID: 1001}},
+		2: {{FieldID: 101, BuildID: 2001}},
+	}, nil).Once()
+
+	tasks := checker.Check(context.TODO())
+	suite.Require().Len(tasks, 1)
+	suite.Len(tasks[0].Actions(), 1)
+	action, ok := tasks[0].Actions()[0].(*task.SegmentAction)
+	suite.True(ok)
+	suite.EqualValues(1, tasks[0].ReplicaID())
+	suite.Equal(task.ActionTypeUpdate, action.Type())
+	suite.EqualValues(1, action.SegmentID())
+	suite.EqualValues(1, action.Node())
+	suite.Equal(task.TaskPriorityLow, tasks[0].Priority())
+
+	// not checked again within the interval
+	suite.Empty(checker.Check(context.TODO()))
+	checker.lastCheck = time.Time{}
+	suite.broker.EXPECT().GetIndexInfos(mock.Anything, int64(1), mock.Anything).Return(map[int64][]*querypb.FieldIndexInfo{
+		1: {{FieldID: 101, BuildID: 1000}},
+		2: {{FieldID: 101, BuildID: 2001}},
+	}, nil).Once()
+	suite.Empty(checker.Check(context.TODO()))
+}
+
+func (suite *IndexCheckerSuite) TestIsIndexOutdated() {
+	segment := utils.CreateTestSegment(1, 1, 1, 1, 1, "test-insert-channel")
+	segment.IndexInfo = []*querypb.FieldIndexInfo{{FieldID: 101, BuildID: 1000}}
+
+	suite.False(isIndexOutdated(segment, nil))
+	suite.False(isIndexOutdated(segment, []*querypb.FieldIndexInfo{{FieldID: 101, BuildID: 1000}}))
+	suite.False(isIndexOutdated(segment, []*querypb.FieldIndexInfo{{FieldID: 102, BuildID: 1001}}))
+	suite.True(isIndexOutdated(segment, []*querypb.FieldIndexInfo{{FieldID: 101, BuildID: 1001}}))
+}
+
+func TestIndexChecker(t *testing.T) {
+	suite.Run(t, new(IndexCheckerSuite))
+}
@@ -124,6 +124,7 @@ func (dh *distHandler) updateSegmentsDistribution(resp *querypb.GetDataDistribut
 				Node:               resp.GetNodeID(),
 				Version:            s.GetVersion(),
 				LastDeltaTimestamp: s.GetLastDeltaTimestamp(),
+				IndexInfo:          s.GetIndexInfo(),
 			}
 		} else {
 			segment = &meta.Segment{
@@ -131,6 +132,7 @@ func (dh *distHandler) updateSegmentsDistribution(resp *querypb.GetDataDistribut
 				Node:               resp.GetNodeID(),
 				Version:            s.GetVersion(),
 				LastDeltaTimestamp: s.GetLastDeltaTimestamp(),
+				IndexInfo:          s.GetIndexInfo(),
 			}
 		}
 		updates = append(updates, segment)
@@ -19,9 +19,11 @@ package meta
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
@@ -31,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -47,6 +50,7 @@ type Broker interface {
 	GetRecoveryInfo(ctx context.Context, collectionID UniqueID, partitionID UniqueID) ([]*datapb.VchannelInfo, []*datapb.SegmentBinlogs, error)
 	GetSegmentInfo(ctx context.Context, segmentID ...UniqueID) (*datapb.GetSegmentInfoResponse, error)
 	GetIndexInfo(ctx context.Context, collectionID UniqueID, segmentID UniqueID) ([]*querypb.FieldIndexInfo, error)
+	GetIndexInfos(ctx context.Context, collectionID UniqueID, segmentIDs []UniqueID) (map[UniqueID][]*querypb.FieldIndexInfo, error)
 	GetRecoveryInfoV2(ctx context.Context, collectionID UniqueID, partitionIDs ...UniqueID) ([]*datapb.VchannelInfo, []*datapb.SegmentInfo, error)
 }
 
@@ -83,7 +87,57 @@ func (broker *CoordinatorBroker) GetCollectionSchema(ctx context.Context, collec
 		log.Error("failed to get collection schema", zap.Int64("collectionID", collectionID), zap.Error(err))
 		return nil, err
 	}
-	return resp.GetSchema(), nil
+	return withPkIndexType(withCollectionTTL(resp.GetSchema(), resp.GetProperties()), resp.GetProperties()), nil
+}
+
+// withCollectionTTL attaches the collection TTL to the timestamp field of the schema,
+// so that QueryNodes could filter the expired entities while searching and querying.
+func withCollectionTTL(schema *schemapb.CollectionSchema, properties []*commonpb.KeyValuePair) *schemapb.CollectionSchema {
+	var ttl string
+	for _, kv := range properties {
+		if kv.GetKey() == common.CollectionTTLConfigKey {
+			ttl = kv.GetValue()
+		}
+	}
+	if seconds, err := strconv.ParseInt(ttl, 10, 64); err != nil || seconds <= 0 {
+		return schema
+	}
+
+	schema = proto.Clone(schema).(*schemapb.CollectionSchema)
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() == common.TimeStampField {
+			field.TypeParams = append(field.TypeParams, &commonpb.KeyValuePair{
+				Key:   common.CollectionTTLConfigKey,
+				Value: ttl,
+			})
+		}
+	}
+	return schema
+}
+
+// withPkIndexType attaches the pk index type of the collection to the primary key field of the schema,
+// so that QueryNodes could build the pk index of the sealed segments in the specified type.
+func withPkIndexType(schema *schemapb.CollectionSchema, properties []*commonpb.KeyValuePair) *schemapb.CollectionSchema {
+	var indexType string
+	for _, kv := range properties {
+		if kv.GetKey() == common.CollectionPkIndexTypeKey {
+			indexType = kv.GetValue()
+		}
+	}
+	if indexType == "" {
+		return schema
+	}
+
+	schema = proto.Clone(schema).(*schemapb.CollectionSchema)
+	for _, field := range schema.GetFields() {
+		if field.GetIsPrimaryKey() {
+			field.TypeParams = append(field.TypeParams, &commonpb.KeyValuePair{
+				Key:   common.CollectionPkIndexTypeKey,
+				Value: indexType,
+			})
+		}
+	}
+	return schema
 }
 
 func (broker *CoordinatorBroker) GetPartitions(ctx context.Context, collectionID UniqueID) ([]UniqueID, error) {
@@ -208,8 +262,41 @@ func (broker *CoordinatorBroker) GetIndexInfo(ctx context.Context, collectionID
 		return nil, merr.WrapErrIndexNotFound()
 	}
 
-	indexes := make([]*querypb.FieldIndexInfo, 0)
-	for _, info := range segmentInfo.GetIndexInfos() {
+	return packFieldIndexInfos(segmentInfo.GetIndexInfos()), nil
+}
+
+// GetIndexInfos returns the serving indexes of the segments, the segments without index are absent.
+func (broker *CoordinatorBroker) GetIndexInfos(ctx context.Context, collectionID UniqueID, segmentIDs []UniqueID) (map[UniqueID][]*querypb.FieldIndexInfo, error) {
+	ctx, cancel := context.WithTimeout(ctx, brokerRPCTimeout)
+	defer cancel()
+
+	resp, err := broker.dataCoord.GetIndexInfos(ctx, &indexpb.GetIndexInfoRequest{
+		CollectionID: collectionID,
+		SegmentIDs:   segmentIDs,
+	})
+	if err == nil && resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		err = merr.Error(resp.GetStatus())
+	}
+	if err != nil {
+		log.Warn("failed to get segment index infos",
+			zap.Int64("collection", collectionID),
+			zap.Int("segmentNum", len(segmentIDs)),
+			zap.Error(err))
+		return nil, err
+	}
+
+	ret := make(map[UniqueID][]*querypb.FieldIndexInfo, len(resp.GetSegmentInfo()))
+	for segmentID, segmentInfo := range resp.GetSegmentInfo() {
+		if len(segmentInfo.GetIndexInfos()) > 0 {
+			ret[segmentID] = packFieldIndexInfos(segmentInfo.GetIndexInfos())
+		}
+	}
+	return ret, nil
+}
+
+func packFieldIndexInfos(infos []*indexpb.IndexFilePathInfo) []*querypb.FieldIndexInfo {
+	indexes := make([]*querypb.FieldIndexInfo, 0, len(infos))
+	for _, info := range infos {
 		indexes = append(indexes, &querypb.FieldIndexInfo{
 			FieldID:        info.GetFieldID(),
 			EnableIndex:    true,
@@ -223,6 +310,5 @@ func (broker *CoordinatorBroker) GetIndexInfo(ctx context.Context, collectionID
 			NumRows:        info.GetNumRows(),
 		})
 	}
-
-	return indexes, nil
+	return indexes
 }
@@ -29,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/common"
 )
 
 func TestCoordinatorBroker_GetCollectionSchema(t *testing.T) {
@@ -73,6 +74,56 @@ func TestCoordinatorBroker_GetCollectionSchema(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, "test_schema", schema.GetName())
 	})
+
+	t.Run("with collection ttl", func(t *testing.T) {
+		rootCoord := mocks.NewRootCoord(t)
+		rootCoord.On("DescribeCollectionInternal",
+			mock.Anything,
+			mock.Anything,
+		).Return(&milvuspb.DescribeCollectionResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Schema: &schemapb.CollectionSchema{
+				Name: "test_schema",
+				Fields: []*schemapb.FieldSchema{
+					{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+					{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64},
+				},
+			},
+			Properties: []*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "60"}},
+		}, nil)
+		ctx := context.Background()
+		broker := &CoordinatorBroker{rootCoord: rootCoord}
+		schema, err := broker.GetCollectionSchema(ctx, 100)
+		assert.NoError(t, err)
+		assert.Equal(t, []*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "60"}},
+			schema.GetFields()[0].GetTypeParams())
+		assert.Empty(t, schema.GetFields()[1].GetTypeParams())
+	})
+
+	t.Run("with pk index type", func(t *testing.T) {
+		rootCoord := mocks.NewRootCoord(t)
+		rootCoord.On("DescribeCollectionInternal",
+			mock.Anything,
+			mock.Anything,
+		).Return(&milvuspb.DescribeCollectionResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Schema: &schemapb.CollectionSchema{
+				Name: "test_schema",
+				Fields: []*schemapb.FieldSchema{
+					{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+					{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				},
+			},
+			Properties: []*commonpb.KeyValuePair{{Key: common.CollectionPkIndexTypeKey, Value: "xor_filter"}},
+		}, nil)
+		ctx := context.Background()
+		broker := &CoordinatorBroker{rootCoord: rootCoord}
+		schema, err := broker.GetCollectionSchema(ctx, 100)
+		assert.NoError(t, err)
+		assert.Empty(t, schema.GetFields()[0].GetTypeParams())
+		assert.Equal(t, []*commonpb.KeyValuePair{{Key: common.CollectionPkIndexTypeKey, Value: "xor_filter"}},
+			schema.GetFields()[1].GetTypeParams())
+	})
 }
 
 func TestCoordinatorBroker_GetRecoveryInfo(t *testing.T) {
@@ -122,6 +122,54 @@ func (_c *MockBroker_GetIndexInfo_Call) Return(_a0 []*querypb.FieldIndexInfo, _a
 	return _c
 }
 
+// GetIndexInfos provides a mock function with given fields: ctx, collectionID, segmentIDs
+func (_m *MockBroker) GetIndexInfos(ctx context.Context, collectionID int64, segmentIDs []int64) (map[int64][]*querypb.FieldIndexInfo, error) {
+	ret := _m.Called(ctx, collectionID, segmentIDs)
+
+	var r0 map[int64][]*querypb.FieldIndexInfo
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) map[int64][]*querypb.FieldIndexInfo); ok {
+		r0 = rf(ctx, collectionID, segmentIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64][]*querypb.FieldIndexInfo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []int64) error); ok {
+		r1 = rf(ctx, collectionID, segmentIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBroker_GetIndexInfos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexInfos'
+type MockBroker_GetIndexInfos_Call struct {
+	*mock.Call
+}
+
+// GetIndexInfos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+//   - segmentIDs []int64
+func (_e *MockBroker_Expecter) GetIndexInfos(ctx interface{}, collectionID interface{}, segmentIDs interface{}) *MockBroker_GetIndexInfos_Call {
+	return &MockBroker_GetIndexInfos_Call{Call: _e.mock.On("GetIndexInfos", ctx, collectionID, segmentIDs)}
+}
+
+func (_c *MockBroker_GetIndexInfos_Call) Run(run func(ctx context.Context, collectionID int64, segmentIDs []int64)) *MockBroker_GetIndexInfos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockBroker_GetIndexInfos_Call) Return(_a0 map[int64][]*querypb.FieldIndexInfo, _a1 error) *MockBroker_GetIndexInfos_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // GetPartitions provides a mock function with given fields: ctx, collectionID
 func (_m *MockBroker) GetPartitions(ctx context.Context, collectionID int64) ([]int64, error) {
 	ret := _m.Called(ctx, collectionID)
@@ -22,7 +22,6 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/golang/protobuf/proto"
 	"github.com/samber/lo"
-	clientv3 "go.etcd.io/etcd/client/v3"
 
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus/internal/kv"
@@ -43,7 +42,7 @@ const (
 	ResourceGroupPrefix      = "queryCoord-ResourceGroup"
 )
 
-type WatchStoreChan = clientv3.WatchChan
+type WatchStoreChan = kv.WatchChan
 
 // Store is used to save and get from object storage.
 type Store interface {
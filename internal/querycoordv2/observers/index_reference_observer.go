@@ -0,0 +1,123 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// IndexReferenceStore persists the index builds referenced by querynodes
+type IndexReferenceStore interface {
+	SaveIndexReferences(refs *querypb.IndexReferences) error
+	GetIndexReferences() (map[int64]*querypb.IndexReferences, error)
+	RemoveIndexReferences(collectionID int64) error
+}
+
+// IndexReferenceObserver saves the index builds loaded by querynodes periodically,
+// DataCoord keeps the retired builds from recycling until they are no longer referenced.
+type IndexReferenceObserver struct {
+	wg      sync.WaitGroup
+	closeCh chan struct{}
+	meta    *meta.Meta
+	dist    *meta.DistributionManager
+	store   IndexReferenceStore
+
+	stopOnce sync.Once
+}
+
+func NewIndexReferenceObserver(meta *meta.Meta, dist *meta.DistributionManager, store IndexReferenceStore) *IndexReferenceObserver {
+	return &IndexReferenceObserver{
+		closeCh: make(chan struct{}),
+		meta:    meta,
+		dist:    dist,
+		store:   store,
+	}
+}
+
+func (ob *IndexReferenceObserver) Start(ctx context.Context) {
+	ob.wg.Add(1)
+	go func() {
+		defer ob.wg.Done()
+		ticker := time.NewTicker(Params.QueryCoordCfg.IndexReferenceUpdateInterval.GetAsDuration(time.Second))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ob.closeCh:
+				log.Info("stop index reference observer")
+				return
+			case <-ctx.Done():
+				log.Info("stop index reference observer due to ctx done")
+				return
+			case <-ticker.C:
+				ob.observe()
+			}
+		}
+	}()
+}
+
+func (ob *IndexReferenceObserver) Stop() {
+	ob.stopOnce.Do(func() {
+		close(ob.closeCh)
+		ob.wg.Wait()
+	})
+}
+
+func (ob *IndexReferenceObserver) observe() {
+	collections := typeutil.NewUniqueSet(ob.meta.CollectionManager.GetAll()...)
+	for collectionID := range collections {
+		buildIDs := typeutil.NewUniqueSet()
+		for _, segment := range ob.dist.SegmentDistManager.GetByCollection(collectionID) {
+			for _, info := range segment.IndexInfo {
+				buildIDs.Insert(info.GetBuildID())
+			}
+		}
+
+		err := ob.store.SaveIndexReferences(&querypb.IndexReferences{
+			CollectionID: collectionID,
+			BuildIDs:     buildIDs.Collect(),
+			UpdateTime:   time.Now().UnixMilli(),
+		})
+		if err != nil {
+			log.Warn("failed to save index references", zap.Int64("collectionID", collectionID), zap.Error(err))
+		}
+	}
+
+	// the references of released collections are useless
+	refs, err := ob.store.GetIndexReferences()
+	if err != nil {
+		log.Warn("failed to get index references", zap.Error(err))
+		return
+	}
+	for collectionID := range refs {
+		if collections.Contain(collectionID) {
+			continue
+		}
+		if err := ob.store.RemoveIndexReferences(collectionID); err != nil {
+			log.Warn("failed to remove index references", zap.Int64("collectionID", collectionID), zap.Error(err))
+		}
+	}
+}
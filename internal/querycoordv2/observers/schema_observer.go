@@ -0,0 +1,150 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// SchemaObserver pushes the schemas of the loaded collections to querynodes once they are altered,
+// the collection properties like ttl are attached to the schema, and could be altered without reloading the collection.
+type SchemaObserver struct {
+	wg      sync.WaitGroup
+	closeCh chan struct{}
+	meta    *meta.Meta
+	broker  meta.Broker
+	cluster session.Cluster
+
+	// collectionID -> the schema pushed to querynodes
+	schemas  map[int64]*schemapb.CollectionSchema
+	stopOnce sync.Once
+}
+
+func NewSchemaObserver(meta *meta.Meta, broker meta.Broker, cluster session.Cluster) *SchemaObserver {
+	return &SchemaObserver{
+		closeCh: make(chan struct{}),
+		meta:    meta,
+		broker:  broker,
+		cluster: cluster,
+		schemas: make(map[int64]*schemapb.CollectionSchema),
+	}
+}
+
+func (ob *SchemaObserver) Start(ctx context.Context) {
+	ob.wg.Add(1)
+	go func() {
+		defer ob.wg.Done()
+		ticker := time.NewTicker(Params.QueryCoordCfg.SchemaUpdateInterval.GetAsDuration(time.Second))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ob.closeCh:
+				log.Info("stop schema observer")
+				return
+			case <-ctx.Done():
+				log.Info("stop schema observer due to ctx done")
+				return
+			case <-ticker.C:
+				ob.observe(ctx)
+			}
+		}
+	}()
+}
+
+func (ob *SchemaObserver) Stop() {
+	ob.stopOnce.Do(func() {
+		close(ob.closeCh)
+		ob.wg.Wait()
+	})
+}
+
+func (ob *SchemaObserver) observe(ctx context.Context) {
+	collections := typeutil.NewUniqueSet(ob.meta.CollectionManager.GetAll()...)
+	for collectionID := range ob.schemas {
+		if !collections.Contain(collectionID) {
+			delete(ob.schemas, collectionID)
+		}
+	}
+
+	for collectionID := range collections {
+		log := log.With(zap.Int64("collectionID", collectionID))
+		schema, err := ob.broker.GetCollectionSchema(ctx, collectionID)
+		if err != nil {
+			log.Warn("failed to get collection schema", zap.Error(err))
+			continue
+		}
+		// the schema is pushed once the observer starts,
+		// as it doesn't know whether the collection is altered before
+		if pushed, ok := ob.schemas[collectionID]; ok && proto.Equal(pushed, schema) {
+			continue
+		}
+		if ob.push(ctx, collectionID, schema) {
+			ob.schemas[collectionID] = schema
+			log.Info("collection schema pushed to querynodes")
+		}
+	}
+}
+
+// push sends the schema to all querynodes of the collection, returns false if any of them fails.
+func (ob *SchemaObserver) push(ctx context.Context, collectionID int64, schema *schemapb.CollectionSchema) bool {
+	// LoadPartitions without partitions only updates the schema of the loaded collection
+	req := &querypb.LoadPartitionsRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_LoadPartitions),
+		),
+		CollectionID: collectionID,
+		Schema:       schema,
+	}
+	succeeded := true
+	for _, replica := range ob.meta.ReplicaManager.GetByCollection(collectionID) {
+		for _, node := range replica.GetNodes() {
+			status, err := ob.cluster.LoadPartitions(ctx, node, req)
+			if err == nil {
+				err = merr.Error(status)
+			}
+			// the node gets the schema while loading the collection later
+			if errors.Is(err, merr.ErrCollectionNotLoaded) {
+				continue
+			}
+			if err != nil {
+				log.Warn("failed to push collection schema",
+					zap.Int64("collectionID", collectionID),
+					zap.Int64("nodeID", node),
+					zap.Error(err))
+				succeeded = false
+			}
+		}
+	}
+	return succeeded
+}
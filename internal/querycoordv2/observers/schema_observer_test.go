@@ -0,0 +1,127 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+type SchemaObserverSuite struct {
+	suite.Suite
+
+	meta     *meta.Meta
+	broker   *meta.MockBroker
+	cluster  *session.MockCluster
+	observer *SchemaObserver
+}
+
+func (suite *SchemaObserverSuite) SetupSuite() {
+	Params.Init()
+}
+
+func (suite *SchemaObserverSuite) SetupTest() {
+	store := meta.NewMockStore(suite.T())
+	store.EXPECT().SaveCollection(mock.Anything).Return(nil).Maybe()
+	store.EXPECT().SaveReplica(mock.Anything).Return(nil).Maybe()
+	store.EXPECT().ReleaseCollection(mock.Anything).Return(nil).Maybe()
+	suite.meta = meta.NewMeta(RandomIncrementIDAllocator(), store, session.NewNodeManager())
+	suite.broker = meta.NewMockBroker(suite.T())
+	suite.cluster = session.NewMockCluster(suite.T())
+	suite.observer = NewSchemaObserver(suite.meta, suite.broker, suite.cluster)
+
+	suite.meta.CollectionManager.PutCollection(utils.CreateTestCollection(1, 1))
+	suite.meta.ReplicaManager.Put(utils.CreateTestReplica(1, 1, []int64{1, 2}))
+}
+
+func (suite *SchemaObserverSuite) expectPush(schema *schemapb.CollectionSchema, statuses map[int64]*commonpb.Status) {
+	for node, status := range statuses {
+		suite.cluster.EXPECT().LoadPartitions(mock.Anything, node, mock.MatchedBy(func(req *querypb.LoadPartitionsRequest) bool {
+			return req.GetCollectionID() == 1 && len(req.GetPartitionIDs()) == 0 && proto.Equal(req.GetSchema(), schema)
+		})).Return(status, nil).Once()
+	}
+}
+
+func (suite *SchemaObserverSuite) TestPushAlteredSchema() {
+	ctx := context.Background()
+	schema := utils.CreateTestSchema()
+	suite.broker.EXPECT().GetCollectionSchema(mock.Anything, int64(1)).Return(schema, nil).Twice()
+
+	// the schema is pushed once the observer starts,
+	// the node without the collection gets the schema while loading it
+	suite.expectPush(schema, map[int64]*commonpb.Status{
+		1: merr.Status(nil),
+		2: merr.Status(merr.WrapErrCollectionNotLoaded(1)),
+	})
+	suite.observer.observe(ctx)
+	// the same schema is not pushed again
+	suite.observer.observe(ctx)
+
+	// the collection ttl is altered
+	altered := proto.Clone(schema).(*schemapb.CollectionSchema)
+	altered.Fields = append(altered.Fields, &schemapb.FieldSchema{
+		FieldID:  common.TimeStampField,
+		Name:     common.TimeStampFieldName,
+		DataType: schemapb.DataType_Int64,
+		TypeParams: []*commonpb.KeyValuePair{
+			{Key: common.CollectionTTLConfigKey, Value: "10"},
+		},
+	})
+	suite.broker.EXPECT().GetCollectionSchema(mock.Anything, int64(1)).Return(altered, nil).Twice()
+	suite.expectPush(altered, map[int64]*commonpb.Status{
+		1: merr.Status(errors.New("mock error")),
+		2: merr.Status(nil),
+	})
+	suite.observer.observe(ctx)
+	// the push is retried until all nodes succeed
+	suite.expectPush(altered, map[int64]*commonpb.Status{
+		1: merr.Status(nil),
+		2: merr.Status(nil),
+	})
+	suite.observer.observe(ctx)
+	suite.Same(altered, suite.observer.schemas[1])
+
+	// the released collection is forgotten
+	suite.meta.CollectionManager.RemoveCollection(1)
+	suite.observer.observe(ctx)
+	suite.Empty(suite.observer.schemas)
+}
+
+func (suite *SchemaObserverSuite) TestGetSchemaFailed() {
+	suite.broker.EXPECT().GetCollectionSchema(mock.Anything, int64(1)).Return(nil, errors.New("mock error"))
+	suite.observer.observe(context.Background())
+	suite.Empty(suite.observer.schemas)
+}
+
+func TestSchemaObserver(t *testing.T) {
+	suite.Run(t, new(SchemaObserverSuite))
+}
@@ -35,6 +35,10 @@ import (
 	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/metastore/db/dao"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbcore"
+	dbquerycoord "github.com/milvus-io/milvus/internal/metastore/db/querycoord"
+	querycoordcatalog "github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
 	"github.com/milvus-io/milvus/internal/querycoordv2/balance"
 	"github.com/milvus-io/milvus/internal/querycoordv2/checkers"
 	"github.com/milvus-io/milvus/internal/querycoordv2/dist"
@@ -51,6 +55,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
@@ -107,6 +112,9 @@ type Server struct {
 	replicaObserver    *observers.ReplicaObserver
 	resourceObserver   *observers.ResourceObserver
 
+	indexReferenceObserver *observers.IndexReferenceObserver
+	schemaObserver         *observers.SchemaObserver
+
 	balancer    balance.Balance
 	balancerMap map[string]balance.Balance
 
@@ -277,6 +285,7 @@ func (s *Server) initQueryCoord() error {
 		s.balancer,
 		s.nodeMgr,
 		s.taskScheduler,
+		s.broker,
 	)
 
 	// Init observers
@@ -293,7 +302,20 @@ func (s *Server) initMeta() error {
 	record := timerecord.NewTimeRecorder("querycoord")
 
 	log.Info("init meta")
-	s.store = meta.NewMetaStore(s.kv)
+	switch Params.MetaStoreCfg.MetaStoreType.GetValue() {
+	case util.MetaStoreTypeEtcd:
+		s.store = meta.NewMetaStore(s.kv)
+	case util.MetaStoreTypeMysql:
+		// connect to database
+		err := dbcore.Connect(&Params.DBCfg)
+		if err != nil {
+			log.Error("failed to connect to meta database", zap.Error(err))
+			return err
+		}
+		s.store = dbquerycoord.NewTableCatalog(dbcore.NewTxImpl(), dao.NewMetaDomain())
+	default:
+		return fmt.Errorf("not supported meta store: %s", Params.MetaStoreCfg.MetaStoreType.GetValue())
+	}
 	s.meta = meta.NewMeta(s.idAllocator, s.store, s.nodeMgr)
 
 	s.broker = meta.NewCoordinatorBroker(
@@ -362,6 +384,18 @@ func (s *Server) initObserver() {
 	)
 
 	s.resourceObserver = observers.NewResourceObserver(s.meta)
+
+	s.indexReferenceObserver = observers.NewIndexReferenceObserver(
+		s.meta,
+		s.dist,
+		querycoordcatalog.NewCatalog(s.kv),
+	)
+
+	s.schemaObserver = observers.NewSchemaObserver(
+		s.meta,
+		s.broker,
+		s.cluster,
+	)
 }
 
 func (s *Server) afterStart() {
@@ -426,6 +460,8 @@ func (s *Server) startServerLoop() {
 	s.targetObserver.Start(s.ctx)
 	s.replicaObserver.Start(s.ctx)
 	s.resourceObserver.Start(s.ctx)
+	s.indexReferenceObserver.Start(s.ctx)
+	s.schemaObserver.Start(s.ctx)
 }
 
 func (s *Server) Stop() error {
@@ -475,6 +511,12 @@ func (s *Server) Stop() error {
 	if s.resourceObserver != nil {
 		s.resourceObserver.Stop()
 	}
+	if s.indexReferenceObserver != nil {
+		s.indexReferenceObserver.Stop()
+	}
+	if s.schemaObserver != nil {
+		s.schemaObserver.Stop()
+	}
 
 	s.wg.Wait()
 	log.Info("QueryCoord stop successfully")
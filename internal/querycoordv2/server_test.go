@@ -504,6 +504,7 @@ func (suite *ServerSuite) hackServer() {
 		suite.server.balancer,
 		suite.server.nodeMgr,
 		suite.server.taskScheduler,
+		suite.server.broker,
 	)
 	suite.server.targetObserver = observers.NewTargetObserver(
 		suite.server.meta,
@@ -31,6 +31,8 @@ type ActionType = int32
 const (
 	ActionTypeGrow ActionType = iota + 1
 	ActionTypeReduce
+	// ActionTypeUpdate replaces the indexes of the segment loaded by the node
+	ActionTypeUpdate
 )
 
 type Action interface {
@@ -72,6 +74,10 @@ type SegmentAction struct {
 	scope     querypb.DataScope
 
 	isReleaseCommitted atomic.Bool
+
+	// the index builds loaded by the update action, set before it's committed
+	updatedBuildIDs   []int64
+	isUpdateCommitted atomic.Bool
 }
 
 func NewSegmentAction(nodeID UniqueID, typ ActionType, shard string, segmentID UniqueID) *SegmentAction {
@@ -118,6 +124,22 @@ func (action *SegmentAction) IsFinished(distMgr *meta.DistributionManager) bool
 			return true
 		}
 		return action.isReleaseCommitted.Load()
+	} else if action.Type() == ActionTypeUpdate {
+		if !action.isUpdateCommitted.Load() {
+			return false
+		}
+		// wait for the distribution to show the indexes loaded
+		for _, segment := range distMgr.SegmentDistManager.GetByNode(action.Node()) {
+			if segment.GetID() == action.SegmentID() {
+				loaded := NewUniqueSet()
+				for _, info := range segment.IndexInfo {
+					loaded.Insert(info.GetBuildID())
+				}
+				return loaded.Contain(action.updatedBuildIDs...)
+			}
+		}
+		// the segment has been released
+		return true
 	}
 
 	return true
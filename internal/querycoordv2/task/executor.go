@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/samber/lo"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
@@ -218,6 +219,9 @@ func (ex *Executor) executeSegmentAction(task *SegmentTask, step int) {
 
 	case ActionTypeReduce:
 		ex.releaseSegment(task, step)
+
+	case ActionTypeUpdate:
+		ex.updateSegment(task, step)
 	}
 }
 
@@ -352,6 +356,94 @@ func (ex *Executor) releaseSegment(task *SegmentTask, step int) {
 	log.Info("release segment done", zap.Int64("taskID", task.ID()), zap.Duration("time taken", elapsed))
 }
 
+// updateSegment loads the serving indexes of the segment into a pending copy,
+// the querynode switches to the copy once it's ready,
+// the segment keeps serving with the old indexes meanwhile
+func (ex *Executor) updateSegment(task *SegmentTask, step int) {
+	defer ex.removeTask(task, step)
+	startTs := time.Now()
+	action := task.Actions()[step].(*SegmentAction)
+	if action.isUpdateCommitted.Load() {
+		return
+	}
+
+	log := log.With(
+		zap.Int64("taskID", task.ID()),
+		zap.Int64("collectionID", task.CollectionID()),
+		zap.Int64("replicaID", task.ReplicaID()),
+		zap.Int64("segmentID", task.segmentID),
+		zap.Int64("node", action.Node()),
+		zap.Int64("source", task.SourceID()),
+	)
+
+	var err error
+	defer func() {
+		if err != nil {
+			task.Cancel(err)
+		}
+	}()
+
+	ctx := task.Context()
+	schema, err := ex.broker.GetCollectionSchema(ctx, task.CollectionID())
+	if err != nil {
+		log.Warn("failed to get schema of collection", zap.Error(err))
+		return
+	}
+	partitions, err := utils.GetPartitions(ex.meta.CollectionManager, task.CollectionID())
+	if err != nil {
+		log.Warn("failed to get partitions of collection", zap.Error(err))
+		return
+	}
+	loadMeta := packLoadMeta(
+		ex.meta.GetLoadType(task.CollectionID()),
+		task.CollectionID(),
+		partitions...,
+	)
+	resp, err := ex.broker.GetSegmentInfo(ctx, task.SegmentID())
+	if err != nil || len(resp.GetInfos()) == 0 {
+		if err == nil {
+			err = merr.WrapErrSegmentNotFound(task.SegmentID())
+		}
+		log.Warn("failed to get segment info from DataCoord", zap.Error(err))
+		return
+	}
+	segment := resp.GetInfos()[0]
+	indexes, err := ex.broker.GetIndexInfo(ctx, task.CollectionID(), segment.GetID())
+	if err != nil {
+		log.Warn("failed to get index of segment", zap.Error(err))
+		return
+	}
+	loadInfo := utils.PackSegmentLoadInfo(segment, indexes)
+
+	leader, ok := getShardLeader(ex.meta.ReplicaManager, ex.dist, task.CollectionID(), action.Node(), segment.GetInsertChannel())
+	if !ok {
+		err = merr.WrapErrChannelNotFound(segment.GetInsertChannel(), "shard delegator not found")
+		log.Warn("no shard leader for the segment to execute updating", zap.Error(err))
+		return
+	}
+	log = log.With(zap.Int64("shardLeader", leader))
+
+	req := packLoadSegmentRequest(task, action, schema, loadMeta, loadInfo, resp)
+	req.LoadScope = querypb.LoadScope_Index
+
+	log.Info("update segment index...")
+	status, err := ex.cluster.LoadSegments(ctx, leader, req)
+	if err == nil && !merr.Ok(status) {
+		err = merr.Error(status)
+	}
+	if err != nil {
+		log.Warn("failed to update segment index", zap.Error(err))
+		return
+	}
+
+	action.updatedBuildIDs = lo.Map(indexes, func(info *querypb.FieldIndexInfo, _ int) int64 {
+		return info.GetBuildID()
+	})
+	action.isUpdateCommitted.Store(true)
+	elapsed := time.Since(startTs)
+	log.Info("update segment index done", zap.Duration("elapsed", elapsed))
+}
+
 func (ex *Executor) executeDmChannelAction(task *ChannelTask, step int) {
 	switch task.Actions()[step].Type() {
 	case ActionTypeGrow:
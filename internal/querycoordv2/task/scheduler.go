@@ -23,6 +23,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/samber/lo"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
@@ -42,6 +43,7 @@ const (
 	TaskTypeGrow Type = iota + 1
 	TaskTypeReduce
 	TaskTypeMove
+	TaskTypeUpdate
 )
 
 type Type = int32
@@ -431,7 +433,7 @@ func (scheduler *taskScheduler) GetNodeSegmentCntDelta(nodeID int64) int {
 			segment := scheduler.targetMgr.GetHistoricalSegment(task.CollectionID(), segmentAction.SegmentID(), meta.NextTarget)
 			if action.Type() == ActionTypeGrow {
 				delta += int(segment.GetNumOfRows())
-			} else {
+			} else if action.Type() == ActionTypeReduce {
 				delta -= int(segment.GetNumOfRows())
 			}
 		}
@@ -511,7 +513,7 @@ func (scheduler *taskScheduler) isRelated(task Task, node int64) bool {
 		if task, ok := task.(*SegmentTask); ok {
 			taskType := GetTaskType(task)
 			var segment *datapb.SegmentInfo
-			if taskType == TaskTypeMove {
+			if taskType == TaskTypeMove || taskType == TaskTypeUpdate {
 				segment = scheduler.targetMgr.GetHistoricalSegment(task.CollectionID(), task.SegmentID(), meta.CurrentTarget)
 			} else {
 				segment = scheduler.targetMgr.GetHistoricalSegment(task.CollectionID(), task.SegmentID(), meta.NextTarget)
@@ -737,6 +739,19 @@ func (scheduler *taskScheduler) checkSegmentTaskStale(task *SegmentTask) error {
 		case ActionTypeReduce:
 			// Do nothing here,
 			// the task should succeeded if the segment not exists
+
+		case ActionTypeUpdate:
+			if scheduler.targetMgr.GetHistoricalSegment(task.CollectionID(), task.SegmentID(), meta.CurrentTarget) == nil {
+				log.Warn("task stale due to the segment to update not exists in current target",
+					zap.Int64("segment", task.segmentID))
+				return merr.WrapErrSegmentReduplicate(task.SegmentID(), "current target doesn't contain this segment")
+			}
+			segments := scheduler.distMgr.SegmentDistManager.GetByNode(action.Node())
+			if !lo.ContainsBy(segments, func(segment *meta.Segment) bool { return segment.GetID() == task.SegmentID() }) {
+				log.Warn("task stale due to the segment to update not loaded on the node",
+					zap.Int64("segment", task.segmentID), zap.Int64("node", action.Node()))
+				return merr.WrapErrSegmentNotFound(task.SegmentID())
+			}
 		}
 	}
 	return nil
@@ -67,15 +67,18 @@ func SetReason(reason string, tasks ...Task) {
 }
 
 // GetTaskType returns the task's type,
-// for now, only 3 types;
+// for now, only 4 types;
 // - only 1 grow action -> Grow
 // - only 1 reduce action -> Reduce
 // - 1 grow action, and ends with 1 reduce action -> Move
+// - only 1 update action -> Update
 func GetTaskType(task Task) Type {
 	if len(task.Actions()) > 1 {
 		return TaskTypeMove
 	} else if task.Actions()[0].Type() == ActionTypeGrow {
 		return TaskTypeGrow
+	} else if task.Actions()[0].Type() == ActionTypeUpdate {
+		return TaskTypeUpdate
 	} else {
 		return TaskTypeReduce
 	}
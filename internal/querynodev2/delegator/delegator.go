@@ -42,6 +42,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/timerecord"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 )
 
@@ -213,11 +214,13 @@ func (sd *shardDelegator) Search(ctx context.Context, req *querypb.SearchRequest
 	}
 
 	// wait tsafe
+	waitTr := timerecord.NewTimeRecorder("waitTSafe")
 	err := sd.waitTSafe(ctx, req.Req.GuaranteeTimestamp)
 	if err != nil {
 		log.Warn("delegator search failed to wait tsafe", zap.Error(err))
 		return nil, err
 	}
+	waitTSafeCost := waitTr.ElapseSpan()
 
 	sealed, growing, version := sd.distribution.GetCurrent(req.GetReq().GetPartitionIDs()...)
 	defer sd.distribution.FinishUsage(version)
@@ -238,6 +241,9 @@ func (sd *shardDelegator) Search(ctx context.Context, req *querypb.SearchRequest
 		log.Warn("Delegator search failed", zap.Error(err))
 		return nil, err
 	}
+	for _, result := range results {
+		result.WaitTsafeMs = waitTSafeCost.Milliseconds()
+	}
 
 	log.Info("Delegator search done")
 
@@ -537,6 +543,8 @@ func (sd *shardDelegator) Close() {
 	sd.lifetime.SetState(stopped)
 	sd.lifetime.Close()
 	sd.wg.Wait()
+	// release the pk index stats of the candidates
+	sd.pkOracle.Remove()
 }
 
 // NewShardDelegator creates a new ShardDelegator instance with all fields initialized.
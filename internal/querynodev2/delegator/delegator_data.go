@@ -55,6 +55,7 @@ type InsertData struct {
 	PrimaryKeys   []storage.PrimaryKey
 	Timestamps    []uint64
 	InsertRecord  *segcorepb.InsertRecord
+	ValidData     map[int64][]bool
 	StartPosition *msgpb.MsgPosition
 	PartitionID   int64
 }
@@ -108,7 +109,7 @@ func (sd *shardDelegator) ProcessInsert(insertRecords map[int64]*InsertData) {
 			growing = sd.newGrowing(segmentID, insertData)
 		}
 
-		err := growing.Insert(insertData.RowIDs, insertData.Timestamps, insertData.InsertRecord)
+		err := growing.Insert(insertData.RowIDs, insertData.Timestamps, insertData.InsertRecord, insertData.ValidData)
 		if err != nil {
 			log.Error("failed to insert data into growing segment",
 				zap.Int64("segmentID", segmentID),
@@ -325,13 +326,17 @@ func (sd *shardDelegator) LoadSegments(ctx context.Context, req *querypb.LoadSeg
 		return err
 	}
 
+	if req.GetLoadScope() == querypb.LoadScope_Index {
+		return sd.loadIndex(ctx, req, worker)
+	}
+
 	// load bloom filter only when candidate not exists
 	infos := lo.Filter(req.GetInfos(), func(info *querypb.SegmentLoadInfo, _ int) bool {
 		return !sd.pkOracle.Exists(pkoracle.NewCandidateKey(info.GetSegmentID(), info.GetPartitionID(), commonpb.SegmentState_Sealed), targetNodeID)
 	})
-	candidates, err := sd.loader.LoadBloomFilterSet(ctx, req.GetCollectionID(), req.GetVersion(), infos...)
+	candidates, err := sd.loadCandidates(ctx, req.GetCollectionID(), req.GetVersion(), infos...)
 	if err != nil {
-		log.Warn("failed to load bloom filter set for segment", zap.Error(err))
+		log.Warn("failed to load pk index for segment", zap.Error(err))
 		return err
 	}
 
@@ -389,13 +394,51 @@ func (sd *shardDelegator) LoadSegments(ctx context.Context, req *querypb.LoadSeg
 	return nil
 }
 
-func (sd *shardDelegator) loadStreamDelete(ctx context.Context, candidates []*pkoracle.BloomFilterSet, infos []*querypb.SegmentLoadInfo,
+// loadCandidates loads the pk index of the sealed segments with the pk index type of the collection.
+func (sd *shardDelegator) loadCandidates(ctx context.Context, collectionID int64, version int64, infos ...*querypb.SegmentLoadInfo) ([]pkoracle.Candidate, error) {
+	if pkoracle.IndexTypeOf(sd.collection.Schema()) == pkoracle.XorFilterType {
+		indexes, err := sd.loader.LoadPkIndex(ctx, collectionID, version, infos...)
+		if err != nil {
+			return nil, err
+		}
+		return lo.Map(indexes, func(index *pkoracle.PkIndex, _ int) pkoracle.Candidate { return index }), nil
+	}
+
+	bfs, err := sd.loader.LoadBloomFilterSet(ctx, collectionID, version, infos...)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(bfs, func(bf *pkoracle.BloomFilterSet, _ int) pkoracle.Candidate { return bf }), nil
+}
+
+func (sd *shardDelegator) loadStreamDelete(ctx context.Context, candidates []pkoracle.Candidate, infos []*querypb.SegmentLoadInfo,
 	targetNodeID int64, worker cluster.Worker) error {
-	log := sd.getLogger(ctx)
 	sd.deleteMut.Lock()
 	defer sd.deleteMut.Unlock()
 
-	idCandidates := lo.SliceToMap(candidates, func(candidate *pkoracle.BloomFilterSet) (int64, *pkoracle.BloomFilterSet) {
+	err := sd.forwardStreamDelete(ctx, candidates, infos, targetNodeID, worker)
+	if err != nil {
+		return err
+	}
+
+	// add candidate after load success
+	for _, candidate := range candidates {
+		sd.getLogger(ctx).Info("register sealed segment pk index into pko candidates",
+			zap.Int64("segmentID", candidate.ID()),
+		)
+		sd.pkOracle.Register(candidate, targetNodeID)
+	}
+
+	return nil
+}
+
+// forwardStreamDelete forwards the deletes after the end position of segments to the worker,
+// the caller must hold the deleteMut.
+func (sd *shardDelegator) forwardStreamDelete(ctx context.Context, candidates []pkoracle.Candidate, infos []*querypb.SegmentLoadInfo,
+	targetNodeID int64, worker cluster.Worker) error {
+	log := sd.getLogger(ctx)
+
+	idCandidates := lo.SliceToMap(candidates, func(candidate pkoracle.Candidate) (int64, pkoracle.Candidate) {
 		return candidate.ID(), candidate
 	})
 
@@ -452,18 +495,54 @@ func (sd *shardDelegator) loadStreamDelete(ctx context.Context, candidates []*pk
 		}
 	}
 
-	// add candidate after load success
-	for _, candidate := range candidates {
-		log.Info("register sealed segment bfs into pko candidates",
-			zap.Int64("segmentID", candidate.ID()),
-		)
-		sd.pkOracle.Register(candidate, targetNodeID)
+	return nil
+}
+
+// loadIndex makes the worker load copies of the segments with new indexes,
+// and switch to the copies after forwarding the deletes,
+// the distribution is not changed since the segments keep serving on the same worker.
+func (sd *shardDelegator) loadIndex(ctx context.Context, req *querypb.LoadSegmentsRequest, worker cluster.Worker) error {
+	log := sd.getLogger(ctx).With(
+		zap.Int64("workID", req.GetDstNodeID()),
+		zap.Int64s("segments", lo.Map(req.GetInfos(), func(info *querypb.SegmentLoadInfo, _ int) int64 { return info.GetSegmentID() })),
+	)
+
+	candidates, err := sd.loadCandidates(ctx, req.GetCollectionID(), req.GetVersion(), req.GetInfos()...)
+	if err != nil {
+		log.Warn("failed to load pk index for segment", zap.Error(err))
+		return err
+	}
+
+	req.Base.TargetID = req.GetDstNodeID()
+	log.Info("worker loads segment indexes...")
+	err = worker.LoadSegments(ctx, req)
+	if err != nil {
+		log.Warn("worker failed to load segment indexes", zap.Error(err))
+		return err
 	}
 
+	// block the deletes until the copies are switched in,
+	// otherwise the copies may miss some deletes
+	sd.deleteMut.Lock()
+	defer sd.deleteMut.Unlock()
+
+	err = sd.forwardStreamDelete(ctx, candidates, req.GetInfos(), req.GetDstNodeID(), worker)
+	if err != nil {
+		log.Warn("forward stream delete failed", zap.Error(err))
+		return err
+	}
+
+	req.LoadScope = querypb.LoadScope_SwitchIndex
+	err = worker.LoadSegments(ctx, req)
+	if err != nil {
+		log.Warn("worker failed to switch segment indexes", zap.Error(err))
+		return err
+	}
+	log.Info("segment indexes switched")
 	return nil
 }
 
-func (sd *shardDelegator) readDeleteFromMsgstream(ctx context.Context, position *msgpb.MsgPosition, safeTs uint64, candidate *pkoracle.BloomFilterSet) (*storage.DeleteData, error) {
+func (sd *shardDelegator) readDeleteFromMsgstream(ctx context.Context, position *msgpb.MsgPosition, safeTs uint64, candidate pkoracle.Candidate) (*storage.DeleteData, error) {
 
 	log := sd.getLogger(ctx).With(
 		zap.String("channel", position.ChannelName),
@@ -520,6 +520,59 @@ func (s *DelegatorDataSuite) TestLoadSegments() {
 
 		s.Error(err)
 	})
+
+	s.Run("load_index", func() {
+		defer func() {
+			s.workerManager.ExpectedCalls = nil
+			s.loader.ExpectedCalls = nil
+		}()
+
+		s.loader.EXPECT().LoadBloomFilterSet(mock.Anything, s.collectionID, mock.AnythingOfType("int64"), mock.Anything).
+			Call.Return(func(ctx context.Context, collectionID int64, version int64, infos ...*querypb.SegmentLoadInfo) []*pkoracle.BloomFilterSet {
+			return lo.Map(infos, func(info *querypb.SegmentLoadInfo, _ int) *pkoracle.BloomFilterSet {
+				return pkoracle.NewBloomFilterSet(info.GetSegmentID(), info.GetPartitionID(), commonpb.SegmentState_Sealed)
+			})
+		}, func(ctx context.Context, collectionID int64, version int64, infos ...*querypb.SegmentLoadInfo) error {
+			return nil
+		})
+
+		workers := make(map[int64]*cluster.MockWorker)
+		worker1 := &cluster.MockWorker{}
+		workers[1] = worker1
+
+		scopes := make([]querypb.LoadScope, 0)
+		worker1.EXPECT().LoadSegments(mock.Anything, mock.AnythingOfType("*querypb.LoadSegmentsRequest")).
+			Run(func(_ context.Context, req *querypb.LoadSegmentsRequest) {
+				scopes = append(scopes, req.GetLoadScope())
+			}).Return(nil)
+		s.workerManager.EXPECT().GetWorker(mock.AnythingOfType("int64")).Call.Return(func(nodeID int64) cluster.Worker {
+			return workers[nodeID]
+		}, nil)
+
+		before, _ := s.delegator.GetSegmentInfo()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		err := s.delegator.LoadSegments(ctx, &querypb.LoadSegmentsRequest{
+			Base:         commonpbutil.NewMsgBase(),
+			DstNodeID:    1,
+			CollectionID: s.collectionID,
+			LoadScope:    querypb.LoadScope_Index,
+			Infos: []*querypb.SegmentLoadInfo{
+				{
+					SegmentID:     100,
+					PartitionID:   500,
+					StartPosition: &msgpb.MsgPosition{Timestamp: 20000},
+					EndPosition:   &msgpb.MsgPosition{Timestamp: 20000},
+				},
+			},
+		})
+
+		s.NoError(err)
+		s.Equal([]querypb.LoadScope{querypb.LoadScope_Index, querypb.LoadScope_SwitchIndex}, scopes)
+		// the distribution keeps unchanged
+		after, _ := s.delegator.GetSegmentInfo()
+		s.Equal(before, after)
+	})
 }
 
 func (s *DelegatorDataSuite) TestReleaseSegment() {
@@ -106,6 +106,92 @@ func (node *QueryNode) loadDeltaLogs(ctx context.Context, req *querypb.LoadSegme
 	return util.SuccessStatus()
 }
 
+// loadIndex loads copies of the sealed segments with the indexes in request,
+// the copies are pending until switchIndex swaps them in,
+// the serving segments are not affected meanwhile.
+func (node *QueryNode) loadIndex(ctx context.Context, req *querypb.LoadSegmentsRequest) *commonpb.Status {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+	)
+
+	for _, info := range req.GetInfos() {
+		log := log.With(zap.Int64("segmentID", info.GetSegmentID()))
+		node.removePendingSegment(info.GetSegmentID())
+
+		serving := node.manager.Segment.GetSealed(info.GetSegmentID())
+		if serving == nil {
+			err := merr.WrapErrSegmentNotFound(info.GetSegmentID())
+			log.Warn("failed to load index, segment not loaded", zap.Error(err))
+			return merr.Status(err)
+		}
+		if isIndexLoaded(serving, info.GetIndexInfos()) {
+			log.Info("indexes already loaded, skip it")
+			continue
+		}
+
+		log.Info("start to load segment copy with new indexes...")
+		loaded, err := node.loader.Load(ctx,
+			req.GetCollectionID(),
+			segments.SegmentTypeSealed,
+			serving.Version(),
+			info,
+		)
+		if err != nil {
+			log.Warn("failed to load segment copy with new indexes", zap.Error(err))
+			return util.WrapStatus(commonpb.ErrorCode_UnexpectedError, "failed to load index", err)
+		}
+		node.pendingSegments.Insert(info.GetSegmentID(), loaded[0])
+		log.Info("segment copy with new indexes loaded")
+	}
+
+	return util.SuccessStatus()
+}
+
+// switchIndex swaps in the pending copies loaded by loadIndex
+func (node *QueryNode) switchIndex(ctx context.Context, req *querypb.LoadSegmentsRequest) *commonpb.Status {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+	)
+
+	for _, info := range req.GetInfos() {
+		log := log.With(zap.Int64("segmentID", info.GetSegmentID()))
+		pending, ok := node.pendingSegments.GetAndRemove(info.GetSegmentID())
+		if !ok {
+			// the indexes have been loaded already
+			continue
+		}
+		if !node.manager.Segment.Replace(pending) {
+			segments.DeleteSegment(pending.(*segments.LocalSegment))
+			err := merr.WrapErrSegmentNotFound(info.GetSegmentID())
+			log.Warn("failed to switch index, segment released", zap.Error(err))
+			return merr.Status(err)
+		}
+		log.Info("segment index switched")
+	}
+
+	return util.SuccessStatus()
+}
+
+func (node *QueryNode) removePendingSegment(segmentID int64) {
+	if pending, ok := node.pendingSegments.GetAndRemove(segmentID); ok {
+		segments.DeleteSegment(pending.(*segments.LocalSegment))
+	}
+}
+
+// isIndexLoaded returns whether the segment has loaded all the given index builds
+func isIndexLoaded(segment segments.Segment, indexes []*querypb.FieldIndexInfo) bool {
+	loaded := make(map[int64]int64)
+	for _, index := range segment.Indexes() {
+		loaded[index.IndexInfo.GetFieldID()] = index.IndexInfo.GetBuildID()
+	}
+	for _, index := range indexes {
+		if buildID, ok := loaded[index.GetFieldID()]; !ok || buildID != index.GetBuildID() {
+			return false
+		}
+	}
+	return true
+}
+
 func (node *QueryNode) queryChannel(ctx context.Context, req *querypb.QueryRequest, channel string) (*internalpb.RetrieveResults, error) {
 	metrics.QueryNodeSQCount.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.QueryLabel, metrics.TotalLabel).Inc()
 	failRet := WrapRetrieveResult(commonpb.ErrorCode_UnexpectedError, "")
@@ -29,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querynodev2/cluster"
 	"github.com/milvus-io/milvus/internal/querynodev2/segments"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 var _ cluster.Worker = &LocalWorker{}
@@ -45,6 +46,13 @@ func NewLocalWorker(node *QueryNode) *LocalWorker {
 
 func (w *LocalWorker) LoadSegments(ctx context.Context, req *querypb.LoadSegmentsRequest) error {
 	log := log.Ctx(ctx)
+	switch req.GetLoadScope() {
+	case querypb.LoadScope_Index:
+		return merr.Error(w.node.loadIndex(ctx, req))
+	case querypb.LoadScope_SwitchIndex:
+		return merr.Error(w.node.switchIndex(ctx, req))
+	}
+
 	log.Info("start to load segments...")
 	loaded, err := w.node.loader.Load(ctx,
 		req.GetCollectionID(),
@@ -67,6 +75,9 @@ func (w *LocalWorker) ReleaseSegments(ctx context.Context, req *querypb.ReleaseS
 	log.Info("start to release segments")
 	for _, id := range req.GetSegmentIDs() {
 		w.node.manager.Segment.Remove(id, req.GetScope())
+		if req.GetScope() != querypb.DataScope_Streaming {
+			w.node.removePendingSegment(id)
+		}
 	}
 	return nil
 }
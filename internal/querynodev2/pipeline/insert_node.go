@@ -51,6 +51,7 @@ func (iNode *insertNode) addInsertData(insertDatas map[UniqueID]*delegator.Inser
 		iData = &delegator.InsertData{
 			PartitionID:  msg.PartitionID,
 			InsertRecord: insertRecord,
+			ValidData:    storage.GetInsertMsgValidData(collection.Schema(), msg),
 			StartPosition: &msgpb.MsgPosition{
 				Timestamp:   msg.BeginTs(),
 				ChannelName: msg.GetShardName(),
@@ -59,6 +60,8 @@ func (iNode *insertNode) addInsertData(insertDatas map[UniqueID]*delegator.Inser
 		insertDatas[msg.SegmentID] = iData
 	} else {
 		typeutil.MergeFieldData(iData.InsertRecord.FieldsData, insertRecord.FieldsData)
+		iData.ValidData = storage.MergeValidData(iData.ValidData, int(iData.InsertRecord.NumRows),
+			storage.GetInsertMsgValidData(collection.Schema(), msg), int(insertRecord.NumRows))
 		iData.InsertRecord.NumRows += insertRecord.NumRows
 	}
 
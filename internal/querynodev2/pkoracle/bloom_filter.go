@@ -17,6 +17,7 @@
 package pkoracle
 
 import (
+	"math"
 	"sync"
 
 	bloom "github.com/bits-and-blooms/bloom/v3"
@@ -29,7 +30,10 @@ import (
 	"github.com/milvus-io/milvus/pkg/log"
 )
 
-var _ Candidate = (*BloomFilterSet)(nil)
+var (
+	_ Candidate  = (*BloomFilterSet)(nil)
+	_ IndexStats = (*BloomFilterSet)(nil)
+)
 
 // BloomFilterSet is one implementation of Candidate with bloom filter in statslog.
 type BloomFilterSet struct {
@@ -39,6 +43,8 @@ type BloomFilterSet struct {
 	segType      commonpb.SegmentState
 	currentStat  *storage.PkStatistics
 	historyStats []*storage.PkStatistics
+	// the number of rows in historyStats, used to estimate the false positive rate
+	historyRowNum int64
 }
 
 // MayPkExist returns whether any bloom filters returns positive.
@@ -110,6 +116,52 @@ func (s *BloomFilterSet) AddHistoricalStats(stats *storage.PkStatistics) {
 	s.historyStats = append(s.historyStats, stats)
 }
 
+// SetHistoricalRowNum sets the number of rows in the historical stats.
+func (s *BloomFilterSet) SetHistoricalRowNum(rowNum int64) {
+	s.statsMutex.Lock()
+	defer s.statsMutex.Unlock()
+
+	s.historyRowNum = rowNum
+}
+
+// IndexType implements IndexStats.
+func (s *BloomFilterSet) IndexType() string {
+	return BloomFilterType
+}
+
+// MemorySize implements IndexStats, only the bloom filters are counted.
+func (s *BloomFilterSet) MemorySize() int64 {
+	s.statsMutex.RLock()
+	defer s.statsMutex.RUnlock()
+
+	var bits uint
+	if s.currentStat != nil {
+		bits += s.currentStat.PkFilter.Cap()
+	}
+	for _, historyStat := range s.historyStats {
+		bits += historyStat.PkFilter.Cap()
+	}
+	return int64(bits / 8)
+}
+
+// FalsePositiveRate implements IndexStats,
+// which assumes the historical rows are evenly distributed among the historical bloom filters.
+func (s *BloomFilterSet) FalsePositiveRate() float64 {
+	s.statsMutex.RLock()
+	defer s.statsMutex.RUnlock()
+
+	if len(s.historyStats) == 0 || s.historyRowNum <= 0 {
+		return 0
+	}
+	n := float64(s.historyRowNum) / float64(len(s.historyStats))
+	negative := 1.0
+	for _, historyStat := range s.historyStats {
+		m, k := float64(historyStat.PkFilter.Cap()), float64(historyStat.PkFilter.K())
+		negative *= 1 - math.Pow(1-math.Exp(-k*n/m), k)
+	}
+	return 1 - negative
+}
+
 // initCurrentStat initialize currentStats if nil.
 // Note: invoker shall acquire statsMutex lock first.
 func (s *BloomFilterSet) initCurrentStat() {
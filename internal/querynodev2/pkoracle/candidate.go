@@ -36,6 +36,9 @@ type Candidate interface {
 type candidateWithWorker struct {
 	Candidate
 	workerID int64
+	// the IndexStats when registered
+	memorySize        int64
+	falsePositiveRate float64
 }
 
 // CandidateFilter filter type for candidate.
@@ -0,0 +1,74 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkoracle
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+var globalIndexStats = newIndexStatsRecorder()
+
+// indexStatsRecorder sums up the IndexStats of the candidates registered in all the pkOracles of this node,
+// and reports them by pk index type.
+type indexStatsRecorder struct {
+	mu                   sync.Mutex
+	count                map[string]int
+	memorySize           map[string]int64
+	falsePositiveRateSum map[string]float64
+}
+
+func newIndexStatsRecorder() *indexStatsRecorder {
+	return &indexStatsRecorder{
+		count:                make(map[string]int),
+		memorySize:           make(map[string]int64),
+		falsePositiveRateSum: make(map[string]float64),
+	}
+}
+
+func (r *indexStatsRecorder) add(candidate candidateWithWorker) {
+	r.update(candidate, 1)
+}
+
+func (r *indexStatsRecorder) remove(candidate candidateWithWorker) {
+	r.update(candidate, -1)
+}
+
+func (r *indexStatsRecorder) update(candidate candidateWithWorker, sign int) {
+	stats, ok := candidate.Candidate.(IndexStats)
+	if !ok {
+		return
+	}
+	indexType := stats.IndexType()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.count[indexType] += sign
+	r.memorySize[indexType] += int64(sign) * candidate.memorySize
+	r.falsePositiveRateSum[indexType] += float64(sign) * candidate.falsePositiveRate
+
+	var falsePositiveRate float64
+	if r.count[indexType] > 0 {
+		falsePositiveRate = r.falsePositiveRateSum[indexType] / float64(r.count[indexType])
+	}
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	metrics.QueryNodePkIndexMemorySize.WithLabelValues(nodeID, indexType).Set(float64(r.memorySize[indexType]))
+	metrics.QueryNodePkIndexFalsePositiveRate.WithLabelValues(nodeID, indexType).Set(falsePositiveRate)
+}
@@ -0,0 +1,228 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkoracle
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unsafe"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// BloomFilterType indexes the pks of the sealed segments by the bloom filters in statslogs.
+	BloomFilterType = "bloom_filter"
+	// XorFilterType indexes the pks of the sealed segments by the pk ranges in statslogs and the xor filters of the pks.
+	XorFilterType = "xor_filter"
+)
+
+// IndexTypeOf returns the pk index type of the collection,
+// which is the collection property attached to the pk field, or the query node config if not set.
+func IndexTypeOf(schema *schemapb.CollectionSchema) string {
+	indexType := paramtable.Get().QueryNodeCfg.PkIndexType.GetValue()
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err == nil {
+		for _, kv := range pkField.GetTypeParams() {
+			if kv.GetKey() == common.CollectionPkIndexTypeKey {
+				indexType = kv.GetValue()
+			}
+		}
+	}
+	if strings.ToLower(indexType) == XorFilterType {
+		return XorFilterType
+	}
+	return BloomFilterType
+}
+
+// IndexStats is implemented by the candidates which hold the pk index of a segment,
+// the pkOracle reports the memory size and the false positive rate of them.
+type IndexStats interface {
+	// IndexType returns the pk index type.
+	IndexType() string
+	// MemorySize returns the memory size of the pk index in bytes.
+	MemorySize() int64
+	// FalsePositiveRate returns the estimated false positive rate of MayPkExist for the pks within the range of the segment.
+	FalsePositiveRate() float64
+}
+
+var (
+	_ Candidate  = (*PkIndex)(nil)
+	_ IndexStats = (*PkIndex)(nil)
+)
+
+type pkRange struct {
+	min storage.PrimaryKey
+	max storage.PrimaryKey
+}
+
+// PkIndex is one implementation of Candidate with the sorted pk ranges and the xor filter of a sealed segment,
+// with 8-bit fingerprints it takes less memory than the bloom filters in statslog and has a lower false positive rate.
+type PkIndex struct {
+	segmentID   int64
+	partitionID int64
+	segType     commonpb.SegmentState
+	// sorted and not overlapped
+	ranges []pkRange
+	filter pkFilter
+}
+
+// MayPkExist returns whether the pk is within the pk ranges and the xor filter returns positive.
+func (p *PkIndex) MayPkExist(pk storage.PrimaryKey) bool {
+	i := sort.Search(len(p.ranges), func(i int) bool {
+		return p.ranges[i].max.GE(pk)
+	})
+	if i == len(p.ranges) || p.ranges[i].min.GT(pk) {
+		return false
+	}
+	return p.filter.contains(hashPk(pk))
+}
+
+// ID implements Candidate.
+func (p *PkIndex) ID() int64 {
+	return p.segmentID
+}
+
+// Partition implements Candidate.
+func (p *PkIndex) Partition() int64 {
+	return p.partitionID
+}
+
+// Type implements Candidate.
+func (p *PkIndex) Type() commonpb.SegmentState {
+	return p.segType
+}
+
+// IndexType implements IndexStats.
+func (p *PkIndex) IndexType() string {
+	return XorFilterType
+}
+
+// MemorySize implements IndexStats.
+func (p *PkIndex) MemorySize() int64 {
+	size := int64(unsafe.Sizeof(*p)) + p.filter.size()
+	for _, r := range p.ranges {
+		size += int64(unsafe.Sizeof(r)) + r.min.Size() + r.max.Size()
+	}
+	return size
+}
+
+// FalsePositiveRate implements IndexStats.
+func (p *PkIndex) FalsePositiveRate() float64 {
+	return p.filter.falsePositiveRate()
+}
+
+// hashPk returns the key of the pk in the xor filter.
+func hashPk(pk storage.PrimaryKey) uint64 {
+	switch pk := pk.(type) {
+	case *storage.Int64PrimaryKey:
+		return uint64(pk.Value)
+	case *storage.VarCharPrimaryKey:
+		return hashString(pk.Value)
+	default:
+		panic(fmt.Sprintf("unsupported pk type %T", pk))
+	}
+}
+
+// hashString is the 64-bit FNV-1a hash, the xor filter mixes it with the seed again.
+func hashString(s string) uint64 {
+	hash := uint64(14695981039346656037)
+	for i := 0; i < len(s); i++ {
+		hash ^= uint64(s[i])
+		hash *= 1099511628211
+	}
+	return hash
+}
+
+// mergeRanges sorts the ranges by the min pk and merges the overlapped ones.
+func mergeRanges(ranges []pkRange) []pkRange {
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].min.LT(ranges[j].min)
+	})
+	merged := make([]pkRange, 0, len(ranges))
+	for _, r := range ranges {
+		last := len(merged) - 1
+		if last >= 0 && r.min.LE(merged[last].max) {
+			if r.max.GT(merged[last].max) {
+				merged[last].max = r.max
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// NewPkIndex builds the PkIndex of a sealed segment with the pk ranges in its statslogs and all its pks,
+// the range of the pks is used if there are no statslogs. The fingerprints of the xor filter are 8 or 16 bits.
+func NewPkIndex(segmentID int64, partitionID int64, segType commonpb.SegmentState,
+	stats []*storage.PkStatistics, pks storage.FieldData, fingerprintBits int) (*PkIndex, error) {
+	var keys []uint64
+	var dataRange *pkRange
+	switch data := pks.(type) {
+	case *storage.Int64FieldData:
+		keys = make([]uint64, 0, len(data.Data))
+		for _, v := range data.Data {
+			keys = append(keys, uint64(v))
+		}
+		if len(data.Data) > 0 {
+			min, max := lo.Min(data.Data), lo.Max(data.Data)
+			dataRange = &pkRange{min: storage.NewInt64PrimaryKey(min), max: storage.NewInt64PrimaryKey(max)}
+		}
+	case *storage.StringFieldData:
+		keys = make([]uint64, 0, len(data.Data))
+		for _, v := range data.Data {
+			keys = append(keys, hashString(v))
+		}
+		if len(data.Data) > 0 {
+			min, max := lo.Min(data.Data), lo.Max(data.Data)
+			dataRange = &pkRange{min: storage.NewVarCharPrimaryKey(min), max: storage.NewVarCharPrimaryKey(max)}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported pk field data type %T", pks)
+	}
+
+	ranges := make([]pkRange, 0, len(stats))
+	for _, stat := range stats {
+		if stat.MinPK != nil && stat.MaxPK != nil {
+			ranges = append(ranges, pkRange{min: stat.MinPK, max: stat.MaxPK})
+		}
+	}
+	if len(ranges) == 0 && dataRange != nil {
+		ranges = append(ranges, *dataRange)
+	}
+
+	filter, err := newPkFilter(keys, fingerprintBits)
+	if err != nil {
+		return nil, err
+	}
+	return &PkIndex{
+		segmentID:   segmentID,
+		partitionID: partitionID,
+		segType:     segType,
+		ranges:      mergeRanges(ranges),
+		filter:      filter,
+	}, nil
+}
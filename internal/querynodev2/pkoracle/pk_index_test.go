@@ -0,0 +1,177 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkoracle
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	bloom "github.com/bits-and-blooms/bloom/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestXorFilter(t *testing.T) {
+	keys := make([]uint64, 0, 100000)
+	exists := make(map[uint64]struct{})
+	for i := 0; i < 100000; i++ {
+		key := rand.Uint64()
+		keys = append(keys, key, key)
+		exists[key] = struct{}{}
+	}
+
+	for _, c := range []struct {
+		bits              int
+		maxFalsePositive  int
+		maxBytesPerKey    float64
+		falsePositiveRate float64
+	}{
+		{bits: 8, maxFalsePositive: 600, maxBytesPerKey: 1.3, falsePositiveRate: 1.0 / 256},
+		{bits: 16, maxFalsePositive: 20, maxBytesPerKey: 2.5, falsePositiveRate: 1.0 / 65536},
+	} {
+		filter, err := newPkFilter(append([]uint64{}, keys...), c.bits)
+		require.NoError(t, err)
+		for _, key := range keys {
+			assert.True(t, filter.contains(key))
+		}
+
+		falsePositive := 0
+		for i := 0; i < 100000; i++ {
+			key := rand.Uint64()
+			if _, ok := exists[key]; !ok && filter.contains(key) {
+				falsePositive++
+			}
+		}
+		assert.Less(t, falsePositive, c.maxFalsePositive, c.bits)
+		assert.Less(t, float64(filter.size())/float64(len(exists)), c.maxBytesPerKey, c.bits)
+		assert.Equal(t, c.falsePositiveRate, filter.falsePositiveRate())
+	}
+
+	_, err := newPkFilter(keys, 32)
+	assert.Error(t, err)
+
+	empty := newXorFilter[uint8](nil)
+	assert.False(t, empty.contains(0))
+	assert.Equal(t, float64(0), empty.falsePositiveRate())
+}
+
+func TestPkIndex(t *testing.T) {
+	t.Run("int64 pk", func(t *testing.T) {
+		pks := &storage.Int64FieldData{Data: []int64{1, 3, 5, 100, 102, 104}}
+		stats := []*storage.PkStatistics{
+			{MinPK: storage.NewInt64PrimaryKey(100), MaxPK: storage.NewInt64PrimaryKey(104)},
+			{MinPK: storage.NewInt64PrimaryKey(1), MaxPK: storage.NewInt64PrimaryKey(5)},
+			{MinPK: storage.NewInt64PrimaryKey(3), MaxPK: storage.NewInt64PrimaryKey(4)},
+		}
+		index, err := NewPkIndex(1, 2, commonpb.SegmentState_Sealed, stats, pks, 16)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), index.ID())
+		assert.Equal(t, int64(2), index.Partition())
+		assert.Equal(t, commonpb.SegmentState_Sealed, index.Type())
+		assert.Equal(t, 2, len(index.ranges))
+
+		for _, pk := range pks.Data {
+			assert.True(t, index.MayPkExist(storage.NewInt64PrimaryKey(pk)))
+		}
+		assert.False(t, index.MayPkExist(storage.NewInt64PrimaryKey(0)))
+		assert.False(t, index.MayPkExist(storage.NewInt64PrimaryKey(50)))
+		assert.False(t, index.MayPkExist(storage.NewInt64PrimaryKey(105)))
+
+		assert.Equal(t, XorFilterType, index.IndexType())
+		assert.Greater(t, index.MemorySize(), int64(0))
+		assert.Equal(t, 1.0/65536, index.FalsePositiveRate())
+	})
+
+	t.Run("varchar pk without stats", func(t *testing.T) {
+		pks := &storage.StringFieldData{}
+		for i := 0; i < 1000; i++ {
+			pks.Data = append(pks.Data, fmt.Sprintf("pk_%04d", i))
+		}
+		index, err := NewPkIndex(1, 2, commonpb.SegmentState_Sealed, nil, pks, 8)
+		require.NoError(t, err)
+		assert.Equal(t, 1, len(index.ranges))
+		for _, pk := range pks.Data {
+			assert.True(t, index.MayPkExist(storage.NewVarCharPrimaryKey(pk)))
+		}
+		assert.False(t, index.MayPkExist(storage.NewVarCharPrimaryKey("a")))
+		assert.False(t, index.MayPkExist(storage.NewVarCharPrimaryKey("pk_9999")))
+	})
+
+	t.Run("unsupported pk", func(t *testing.T) {
+		_, err := NewPkIndex(1, 2, commonpb.SegmentState_Sealed, nil, &storage.FloatFieldData{}, 8)
+		assert.Error(t, err)
+	})
+}
+
+func TestIndexTypeOf(t *testing.T) {
+	paramtable.Init()
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+		},
+	}
+	assert.Equal(t, BloomFilterType, IndexTypeOf(schema))
+
+	schema.Fields[0].TypeParams = []*commonpb.KeyValuePair{{Key: common.CollectionPkIndexTypeKey, Value: "XOR_FILTER"}}
+	assert.Equal(t, XorFilterType, IndexTypeOf(schema))
+
+	schema.Fields[0].TypeParams = []*commonpb.KeyValuePair{{Key: common.CollectionPkIndexTypeKey, Value: "unknown"}}
+	assert.Equal(t, BloomFilterType, IndexTypeOf(schema))
+}
+
+func TestBloomFilterSetStats(t *testing.T) {
+	bfs := NewBloomFilterSet(1, 2, commonpb.SegmentState_Sealed)
+	assert.Equal(t, BloomFilterType, bfs.IndexType())
+	assert.Equal(t, float64(0), bfs.FalsePositiveRate())
+
+	bfs.AddHistoricalStats(&storage.PkStatistics{PkFilter: bloom.NewWithEstimates(10000, 0.01)})
+	bfs.SetHistoricalRowNum(10000)
+	assert.Greater(t, bfs.MemorySize(), int64(0))
+	assert.InDelta(t, 0.01, bfs.FalsePositiveRate(), 0.005)
+}
+
+func TestPkOracleIndexStats(t *testing.T) {
+	paramtable.Init()
+	pko := NewPkOracle()
+	index, err := NewPkIndex(1, 2, commonpb.SegmentState_Sealed, nil, &storage.Int64FieldData{Data: []int64{1, 2, 3}}, 8)
+	require.NoError(t, err)
+
+	pko.Register(index, 1)
+	globalIndexStats.mu.Lock()
+	assert.Equal(t, 1, globalIndexStats.count[XorFilterType])
+	assert.Equal(t, index.MemorySize(), globalIndexStats.memorySize[XorFilterType])
+	globalIndexStats.mu.Unlock()
+
+	// register again replaces the stats
+	pko.Register(index, 1)
+	globalIndexStats.mu.Lock()
+	assert.Equal(t, 1, globalIndexStats.count[XorFilterType])
+	globalIndexStats.mu.Unlock()
+
+	assert.NoError(t, pko.Remove())
+	globalIndexStats.mu.Lock()
+	assert.Equal(t, 0, globalIndexStats.count[XorFilterType])
+	assert.Equal(t, int64(0), globalIndexStats.memorySize[XorFilterType])
+	globalIndexStats.mu.Unlock()
+}
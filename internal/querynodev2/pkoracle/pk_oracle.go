@@ -67,15 +67,25 @@ func (pko *pkOracle) candidateKey(candidate Candidate, workerID int64) string {
 
 // Register register candidate
 func (pko *pkOracle) Register(candidate Candidate, workerID int64) error {
-	pko.candidates.Insert(pko.candidateKey(candidate, workerID), candidateWithWorker{
+	key := pko.candidateKey(candidate, workerID)
+	if old, ok := pko.candidates.Get(key); ok {
+		globalIndexStats.remove(old)
+	}
+	registered := candidateWithWorker{
 		Candidate: candidate,
 		workerID:  workerID,
-	})
+	}
+	if stats, ok := candidate.(IndexStats); ok {
+		registered.memorySize = stats.MemorySize()
+		registered.falsePositiveRate = stats.FalsePositiveRate()
+	}
+	pko.candidates.Insert(key, registered)
+	globalIndexStats.add(registered)
 
 	return nil
 }
 
-// Remove removes candidate from pko.
+// Remove removes candidate from pko, all the candidates are removed if no filter provided.
 func (pko *pkOracle) Remove(filters ...CandidateFilter) error {
 	pko.candidates.Range(func(key string, candidate candidateWithWorker) bool {
 		for _, filter := range filters {
@@ -83,7 +93,9 @@ func (pko *pkOracle) Remove(filters ...CandidateFilter) error {
 				return true
 			}
 		}
-		pko.candidates.GetAndRemove(pko.candidateKey(candidate, candidate.workerID))
+		if removed, ok := pko.candidates.GetAndRemove(pko.candidateKey(candidate, candidate.workerID)); ok {
+			globalIndexStats.remove(removed)
+		}
 
 		return true
 	})
@@ -0,0 +1,215 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkoracle
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"sort"
+	"unsafe"
+)
+
+// pkFilter is the filter of the pks of a segment.
+type pkFilter interface {
+	// contains returns whether the key may be in the filter.
+	contains(key uint64) bool
+	// size returns the memory size of the filter in bytes.
+	size() int64
+	// falsePositiveRate returns the false positive rate of contains.
+	falsePositiveRate() float64
+}
+
+// fingerprint is the fingerprint type of the xor filters.
+// The 8-bit fingerprints take about 1.23 bytes per key with the false positive rate 1/256,
+// while the bloom filters in statslogs take about 1.38 bytes per key with the false positive rate 0.005.
+// The 16-bit fingerprints take about 2.46 bytes per key with the false positive rate 1/65536.
+type fingerprint interface {
+	~uint8 | ~uint16
+}
+
+// xorFilter is the static xor filter,
+// see "Xor Filters: Faster and Smaller Than Bloom and Cuckoo Filters" by Graf and Lemire.
+type xorFilter[T fingerprint] struct {
+	seed         uint64
+	blockLength  uint32
+	fingerprints []T
+}
+
+// newPkFilter builds the xor filter of the keys with the fingerprints of 8 or 16 bits,
+// the keys are sorted and deduplicated in place.
+func newPkFilter(keys []uint64, fingerprintBits int) (pkFilter, error) {
+	switch fingerprintBits {
+	case 8:
+		return newXorFilter[uint8](keys), nil
+	case 16:
+		return newXorFilter[uint16](keys), nil
+	default:
+		return nil, fmt.Errorf("unsupported xor filter fingerprint bits %d", fingerprintBits)
+	}
+}
+
+type xorSet struct {
+	xorMask uint64
+	count   uint32
+}
+
+type keyIndex struct {
+	hash  uint64
+	index uint32
+}
+
+func murmur64(h uint64) uint64 {
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return h
+}
+
+func splitMix64(seed *uint64) uint64 {
+	*seed += 0x9e3779b97f4a7c15
+	z := *seed
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+func reduce(hash, n uint32) uint32 {
+	return uint32((uint64(hash) * uint64(n)) >> 32)
+}
+
+func xorFingerprint[T fingerprint](hash uint64) T {
+	return T(hash ^ (hash >> 32))
+}
+
+func (f *xorFilter[T]) locations(hash uint64) (uint32, uint32, uint32) {
+	h0 := reduce(uint32(hash), f.blockLength)
+	h1 := reduce(uint32(bits.RotateLeft64(hash, 21)), f.blockLength) + f.blockLength
+	h2 := reduce(uint32(bits.RotateLeft64(hash, 42)), f.blockLength) + 2*f.blockLength
+	return h0, h1, h2
+}
+
+func (f *xorFilter[T]) contains(key uint64) bool {
+	if len(f.fingerprints) == 0 {
+		return false
+	}
+	hash := murmur64(key + f.seed)
+	h0, h1, h2 := f.locations(hash)
+	return xorFingerprint[T](hash) == f.fingerprints[h0]^f.fingerprints[h1]^f.fingerprints[h2]
+}
+
+func (f *xorFilter[T]) size() int64 {
+	var fp T
+	return int64(unsafe.Sizeof(*f)) + int64(len(f.fingerprints))*int64(unsafe.Sizeof(fp))
+}
+
+func (f *xorFilter[T]) falsePositiveRate() float64 {
+	if len(f.fingerprints) == 0 {
+		return 0
+	}
+	var fp T
+	return 1 / math.Exp2(float64(unsafe.Sizeof(fp)*8))
+}
+
+// newXorFilter builds the xor filter of the keys, the keys are sorted and deduplicated in place.
+func newXorFilter[T fingerprint](keys []uint64) *xorFilter[T] {
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	n := 0
+	for i := range keys {
+		if i == 0 || keys[i] != keys[i-1] {
+			keys[n] = keys[i]
+			n++
+		}
+	}
+	keys = keys[:n]
+
+	filter := &xorFilter[T]{}
+	if n == 0 {
+		return filter
+	}
+	capacity := 32 + uint32(math.Ceil(1.23*float64(n)))
+	capacity = capacity / 3 * 3
+	filter.blockLength = capacity / 3
+	filter.fingerprints = make([]T, capacity)
+
+	sets := make([]xorSet, capacity)
+	queue := make([]keyIndex, 0, capacity)
+	stack := make([]keyIndex, 0, n)
+	rngSeed := uint64(1)
+	for {
+		filter.seed = splitMix64(&rngSeed)
+		for _, key := range keys {
+			hash := murmur64(key + filter.seed)
+			h0, h1, h2 := filter.locations(hash)
+			for _, h := range [3]uint32{h0, h1, h2} {
+				sets[h].xorMask ^= hash
+				sets[h].count++
+			}
+		}
+
+		// peel the locations which hold only one key
+		for i := range sets {
+			if sets[i].count == 1 {
+				queue = append(queue, keyIndex{hash: sets[i].xorMask, index: uint32(i)})
+			}
+		}
+		for len(queue) > 0 {
+			ki := queue[len(queue)-1]
+			queue = queue[:len(queue)-1]
+			if sets[ki.index].count == 0 {
+				continue
+			}
+			stack = append(stack, ki)
+			h0, h1, h2 := filter.locations(ki.hash)
+			for _, h := range [3]uint32{h0, h1, h2} {
+				sets[h].xorMask ^= ki.hash
+				sets[h].count--
+				if sets[h].count == 1 {
+					queue = append(queue, keyIndex{hash: sets[h].xorMask, index: h})
+				}
+			}
+		}
+		if len(stack) == n {
+			break
+		}
+
+		// retry with another seed, which rarely happens
+		for i := range sets {
+			sets[i] = xorSet{}
+		}
+		queue = queue[:0]
+		stack = stack[:0]
+	}
+
+	for i := len(stack) - 1; i >= 0; i-- {
+		ki := stack[i]
+		h0, h1, h2 := filter.locations(ki.hash)
+		fp := xorFingerprint[T](ki.hash)
+		switch ki.index {
+		case h0:
+			fp ^= filter.fingerprints[h1] ^ filter.fingerprints[h2]
+		case h1:
+			fp ^= filter.fingerprints[h0] ^ filter.fingerprints[h2]
+		default:
+			fp ^= filter.fingerprints[h0] ^ filter.fingerprints[h1]
+		}
+		filter.fingerprints[ki.index] = fp
+	}
+	return filter
+}
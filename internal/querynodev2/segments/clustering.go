@@ -0,0 +1,39 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/util/clustering"
+)
+
+// pruneByClustering returns the segments which may contain entities matching the predicates,
+// the segments whose clustering range can't satisfy the predicates are skipped.
+func pruneByClustering(manager *Manager, segType SegmentType, predicates *planpb.Expr, segIDs []int64) []int64 {
+	if predicates == nil {
+		return segIDs
+	}
+	ret := make([]int64, 0, len(segIDs))
+	for _, segID := range segIDs {
+		segment, _ := manager.Segment.GetWithType(segID, segType).(*LocalSegment)
+		if segment != nil && !clustering.MayMatch(predicates, segment.ClusteringInfo()) {
+			continue
+		}
+		ret = append(ret, segID)
+	}
+	return ret
+}
@@ -29,9 +29,13 @@ import (
 	"unsafe"
 
 	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -62,7 +66,14 @@ func (m *collectionManager) Put(collectionID int64, schema *schemapb.CollectionS
 	m.mut.Lock()
 	defer m.mut.Unlock()
 
-	if _, ok := m.collections[collectionID]; ok {
+	if collection, ok := m.collections[collectionID]; ok {
+		// the schema changes if a field is added or the collection ttl is altered after the collection loaded
+		if isNewerSchema(collection.Schema(), schema) || isAlteredSchema(collection.Schema(), schema) {
+			collection.UpdateSchema(schema)
+			log.Info("collection schema updated", zap.Int64("collectionID", collectionID),
+				zap.Int("fieldNum", len(schema.GetFields())),
+				zap.String("ttl", getCollectionTTL(schema)))
+		}
 		return
 	}
 
@@ -71,9 +82,47 @@ func (m *collectionManager) Put(collectionID int64, schema *schemapb.CollectionS
 	m.collections[collectionID] = collection
 }
 
+// isNewerSchema returns true if the schema has fields not in the old one,
+// the fields are only appended to the schema, so the schema with more fields is newer.
+func isNewerSchema(old, schema *schemapb.CollectionSchema) bool {
+	if old == nil {
+		return schema != nil
+	}
+	fields := typeutil.NewSet[int64]()
+	for _, field := range old.GetFields() {
+		fields.Insert(field.GetFieldID())
+	}
+	for _, field := range schema.GetFields() {
+		if !fields.Contain(field.GetFieldID()) {
+			return true
+		}
+	}
+	return false
+}
+
+// isAlteredSchema returns true if the schema has the same fields as the old one but a different collection ttl,
+// the ttl is attached to the schema from the collection properties, which could be altered at any time.
+func isAlteredSchema(old, schema *schemapb.CollectionSchema) bool {
+	if old == nil || isNewerSchema(schema, old) || isNewerSchema(old, schema) {
+		return false
+	}
+	return getCollectionTTL(old) != getCollectionTTL(schema)
+}
+
+// getCollectionTTL returns the collection ttl attached to the timestamp field of the schema, empty if not set.
+func getCollectionTTL(schema *schemapb.CollectionSchema) string {
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() == common.TimeStampField {
+			ttl, _ := funcutil.GetAttrByKeyFromRepeatedKV(common.CollectionTTLConfigKey, field.GetTypeParams())
+			return ttl
+		}
+	}
+	return ""
+}
+
 // Collection is a wrapper of the underlying C-structure C.CCollection
 type Collection struct {
-	mu            sync.RWMutex // protects colllectionPtr
+	mu            sync.RWMutex // protects colllectionPtr and schema
 	collectionPtr C.CCollection
 	id            int64
 	partitions    *typeutil.ConcurrentSet[int64]
@@ -88,9 +137,29 @@ func (c *Collection) ID() int64 {
 
 // Schema returns the schema of collection
 func (c *Collection) Schema() *schemapb.CollectionSchema {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.schema
 }
 
+// UpdateSchema replaces the schema of collection,
+// the segments created before keep the old fields,
+// but the collection ttl applies to them through the plans created after.
+func (c *Collection) UpdateSchema(schema *schemapb.CollectionSchema) {
+	/*
+		void
+		UpdateSchema(CCollection collection, const char* schema_proto_blob);
+	*/
+	schemaBlob := proto.MarshalTextString(schema)
+	cSchemaBlob := C.CString(schemaBlob)
+	defer C.free(unsafe.Pointer(cSchemaBlob))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	C.UpdateSchema(c.collectionPtr, cSchemaBlob)
+	c.schema = schema
+}
+
 // getPartitionIDs return partitionIDs of collection
 func (c *Collection) GetPartitions() []int64 {
 	return c.partitions.Collect()
@@ -0,0 +1,103 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestCollectionManager_PutNewerSchema(t *testing.T) {
+	paramtable.Init()
+	manager := NewCollectionManager()
+	schema := GenTestCollectionSchema("schema-update", schemapb.DataType_Int64)
+	loadMeta := &querypb.LoadMetaInfo{LoadType: querypb.LoadType_LoadCollection, PartitionIDs: []int64{10}}
+	manager.Put(1, schema, loadMeta)
+	collection := manager.Get(1)
+	defer DeleteCollection(collection)
+
+	// the same schema doesn't change anything
+	manager.Put(1, proto.Clone(schema).(*schemapb.CollectionSchema), loadMeta)
+	assert.Same(t, collection, manager.Get(1))
+	assert.Same(t, schema, collection.Schema())
+
+	// a field is added
+	newSchema := proto.Clone(schema).(*schemapb.CollectionSchema)
+	newSchema.Fields = append(newSchema.Fields, &schemapb.FieldSchema{
+		FieldID:  1000,
+		Name:     "added",
+		DataType: schemapb.DataType_Int64,
+	})
+	manager.Put(1, newSchema, loadMeta)
+	assert.Same(t, collection, manager.Get(1))
+	assert.Same(t, newSchema, collection.Schema())
+
+	// the old schema doesn't replace the newer one
+	manager.Put(1, schema, loadMeta)
+	assert.Same(t, newSchema, collection.Schema())
+
+	// the segments created after the update have the added field
+	segment, err := NewSegment(collection, 1, 10, 1, "dml", SegmentTypeGrowing, 0, nil, nil)
+	assert.NoError(t, err)
+	DeleteSegment(segment)
+}
+
+// withCollectionTTL returns a copy of schema with the timestamp field carrying the collection ttl.
+func withCollectionTTL(schema *schemapb.CollectionSchema, ttl string) *schemapb.CollectionSchema {
+	schema = proto.Clone(schema).(*schemapb.CollectionSchema)
+	field := &schemapb.FieldSchema{
+		FieldID:  common.TimeStampField,
+		Name:     common.TimeStampFieldName,
+		DataType: schemapb.DataType_Int64,
+	}
+	if ttl != "" {
+		field.TypeParams = []*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: ttl}}
+	}
+	schema.Fields = append(schema.Fields, field)
+	return schema
+}
+
+func TestIsAlteredSchema(t *testing.T) {
+	schema := GenTestCollectionSchema("schema-alter", schemapb.DataType_Int64)
+	old := withCollectionTTL(schema, "")
+	altered := withCollectionTTL(schema, "10")
+	assert.Equal(t, "", getCollectionTTL(old))
+	assert.Equal(t, "10", getCollectionTTL(altered))
+
+	assert.True(t, isAlteredSchema(old, altered))
+	assert.True(t, isAlteredSchema(altered, old))
+	assert.False(t, isAlteredSchema(altered, proto.Clone(altered).(*schemapb.CollectionSchema)))
+	assert.False(t, isAlteredSchema(nil, altered))
+
+	// the schema with fields added is newer rather than altered
+	added := proto.Clone(old).(*schemapb.CollectionSchema)
+	added.Fields = append(added.Fields, &schemapb.FieldSchema{
+		FieldID:  1000,
+		Name:     "added",
+		DataType: schemapb.DataType_Int64,
+	})
+	assert.False(t, isAlteredSchema(altered, added))
+	assert.False(t, isAlteredSchema(added, altered))
+}
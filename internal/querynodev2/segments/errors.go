@@ -29,6 +29,9 @@ var (
 	ErrSegmentNotFound    = errors.New("SegmentNotFound")
 	ErrFieldNotFound      = errors.New("FieldNotFound")
 	ErrSegmentReleased    = errors.New("SegmentReleased")
+
+	// Loader related errors
+	ErrLazyLoadOverloaded = errors.New("LazyLoadOverloaded")
 )
 
 func WrapSegmentNotFound(segmentID int64) error {
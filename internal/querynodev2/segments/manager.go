@@ -94,6 +94,10 @@ type SegmentManager interface {
 	// will not decrease the ref count if the given segment not exists
 	Remove(segmentID UniqueID, scope querypb.DataScope)
 	RemoveBy(filters ...SegmentFilter)
+	// Replace replaces the sealed segment with the same ID by the given one,
+	// the replaced segment is released,
+	// returns false if there is no such sealed segment
+	Replace(segment Segment) bool
 }
 
 var _ SegmentManager = (*segmentManager)(nil)
@@ -131,23 +135,7 @@ func (mgr *segmentManager) Put(segmentType SegmentType, segments ...Segment) {
 		if _, ok := targetMap[segment.ID()]; ok {
 			continue
 		}
-		targetMap[segment.ID()] = segment
-		metrics.QueryNodeNumSegments.WithLabelValues(
-			fmt.Sprint(paramtable.GetNodeID()),
-			fmt.Sprint(segment.Collection()),
-			fmt.Sprint(segment.Partition()),
-			segment.Type().String(),
-			fmt.Sprint(len(segment.Indexes())),
-		).Inc()
-		if segment.RowNum() > 0 {
-			metrics.QueryNodeNumEntities.WithLabelValues(
-				fmt.Sprint(paramtable.GetNodeID()),
-				fmt.Sprint(segment.Collection()),
-				fmt.Sprint(segment.Partition()),
-				segment.Type().String(),
-				fmt.Sprint(len(segment.Indexes())),
-			).Add(float64(segment.RowNum()))
-		}
+		add(segment, targetMap)
 	}
 	mgr.updateMetric()
 }
@@ -265,6 +253,19 @@ func (mgr *segmentManager) RemoveBy(filters ...SegmentFilter) {
 	mgr.updateMetric()
 }
 
+func (mgr *segmentManager) Replace(segment Segment) bool {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	if _, ok := mgr.sealedSegments[segment.ID()]; !ok {
+		return false
+	}
+	remove(segment.ID(), mgr.sealedSegments)
+	add(segment, mgr.sealedSegments)
+	mgr.updateMetric()
+	return true
+}
+
 func (mgr *segmentManager) updateMetric() {
 	// update collection and partiation metric
 	var collections, partiations = make(Set[int64]), make(Set[int64])
@@ -280,6 +281,26 @@ func (mgr *segmentManager) updateMetric() {
 	metrics.QueryNodeNumPartitions.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Set(float64(partiations.Len()))
 }
 
+func add(segment Segment, container map[int64]Segment) {
+	container[segment.ID()] = segment
+	metrics.QueryNodeNumSegments.WithLabelValues(
+		fmt.Sprint(paramtable.GetNodeID()),
+		fmt.Sprint(segment.Collection()),
+		fmt.Sprint(segment.Partition()),
+		segment.Type().String(),
+		fmt.Sprint(len(segment.Indexes())),
+	).Inc()
+	if segment.RowNum() > 0 {
+		metrics.QueryNodeNumEntities.WithLabelValues(
+			fmt.Sprint(paramtable.GetNodeID()),
+			fmt.Sprint(segment.Collection()),
+			fmt.Sprint(segment.Partition()),
+			segment.Type().String(),
+			fmt.Sprint(len(segment.Indexes())),
+		).Add(float64(segment.RowNum()))
+	}
+}
+
 func remove(segmentID int64, container map[int64]Segment) {
 	segment, ok := container[segmentID]
 	if !ok {
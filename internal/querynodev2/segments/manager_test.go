@@ -104,6 +104,31 @@ func (s *ManagerSuite) TestRemoveBy() {
 	}
 }
 
+func (s *ManagerSuite) TestReplace() {
+	for i, id := range s.segmentIDs {
+		segment, err := NewSegment(
+			NewCollection(s.collectionIDs[i], GenTestCollectionSchema("manager-suite", schemapb.DataType_Int64), querypb.LoadType_LoadCollection),
+			id,
+			s.partitionIDs[i],
+			s.collectionIDs[i],
+			s.channels[i],
+			SegmentTypeSealed,
+			0,
+			nil,
+			nil,
+		)
+		s.Require().NoError(err)
+
+		isSealed := s.types[i] == SegmentTypeSealed
+		s.Equal(isSealed, s.mgr.Replace(segment))
+		if isSealed {
+			s.Same(segment, s.mgr.GetSealed(id))
+		} else {
+			DeleteSegment(segment)
+		}
+	}
+}
+
 func TestManager(t *testing.T) {
 	suite.Run(t, new(ManagerSuite))
 }
@@ -195,6 +195,69 @@ func (_c *MockLoader_LoadDeltaLogs_Call) Return(_a0 error) *MockLoader_LoadDelta
 	return _c
 }
 
+// LoadPkIndex provides a mock function with given fields: ctx, collectionID, version, infos
+func (_m *MockLoader) LoadPkIndex(ctx context.Context, collectionID int64, version int64, infos ...*querypb.SegmentLoadInfo) ([]*pkoracle.PkIndex, error) {
+	_va := make([]interface{}, len(infos))
+	for _i := range infos {
+		_va[_i] = infos[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, collectionID, version)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []*pkoracle.PkIndex
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, ...*querypb.SegmentLoadInfo) []*pkoracle.PkIndex); ok {
+		r0 = rf(ctx, collectionID, version, infos...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*pkoracle.PkIndex)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, ...*querypb.SegmentLoadInfo) error); ok {
+		r1 = rf(ctx, collectionID, version, infos...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLoader_LoadPkIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadPkIndex'
+type MockLoader_LoadPkIndex_Call struct {
+	*mock.Call
+}
+
+// LoadPkIndex is a helper method to define mock.On call
+//  - ctx context.Context
+//  - collectionID int64
+//  - version int64
+//  - infos ...*querypb.SegmentLoadInfo
+func (_e *MockLoader_Expecter) LoadPkIndex(ctx interface{}, collectionID interface{}, version interface{}, infos ...interface{}) *MockLoader_LoadPkIndex_Call {
+	return &MockLoader_LoadPkIndex_Call{Call: _e.mock.On("LoadPkIndex",
+		append([]interface{}{ctx, collectionID, version}, infos...)...)}
+}
+
+func (_c *MockLoader_LoadPkIndex_Call) Run(run func(ctx context.Context, collectionID int64, version int64, infos ...*querypb.SegmentLoadInfo)) *MockLoader_LoadPkIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]*querypb.SegmentLoadInfo, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(*querypb.SegmentLoadInfo)
+			}
+		}
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockLoader_LoadPkIndex_Call) Return(_a0 []*pkoracle.PkIndex, _a1 error) *MockLoader_LoadPkIndex_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 type mockConstructorTestingTNewMockLoader interface {
 	mock.TestingT
 	Cleanup(func())
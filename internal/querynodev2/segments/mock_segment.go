@@ -279,13 +279,13 @@ func (_c *MockSegment_Indexes_Call) Return(_a0 []*IndexedFieldInfo) *MockSegment
 	return _c
 }
 
-// Insert provides a mock function with given fields: rowIDs, timestamps, record
-func (_m *MockSegment) Insert(rowIDs []int64, timestamps []uint64, record *segcorepb.InsertRecord) error {
-	ret := _m.Called(rowIDs, timestamps, record)
+// Insert provides a mock function with given fields: rowIDs, timestamps, record, validData
+func (_m *MockSegment) Insert(rowIDs []int64, timestamps []uint64, record *segcorepb.InsertRecord, validData map[int64][]bool) error {
+	ret := _m.Called(rowIDs, timestamps, record, validData)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func([]int64, []uint64, *segcorepb.InsertRecord) error); ok {
-		r0 = rf(rowIDs, timestamps, record)
+	if rf, ok := ret.Get(0).(func([]int64, []uint64, *segcorepb.InsertRecord, map[int64][]bool) error); ok {
+		r0 = rf(rowIDs, timestamps, record, validData)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -302,13 +302,14 @@ type MockSegment_Insert_Call struct {
 //  - rowIDs []int64
 //  - timestamps []uint64
 //  - record *segcorepb.InsertRecord
-func (_e *MockSegment_Expecter) Insert(rowIDs interface{}, timestamps interface{}, record interface{}) *MockSegment_Insert_Call {
-	return &MockSegment_Insert_Call{Call: _e.mock.On("Insert", rowIDs, timestamps, record)}
+//  - validData map[int64][]bool
+func (_e *MockSegment_Expecter) Insert(rowIDs interface{}, timestamps interface{}, record interface{}, validData interface{}) *MockSegment_Insert_Call {
+	return &MockSegment_Insert_Call{Call: _e.mock.On("Insert", rowIDs, timestamps, record, validData)}
 }
 
-func (_c *MockSegment_Insert_Call) Run(run func(rowIDs []int64, timestamps []uint64, record *segcorepb.InsertRecord)) *MockSegment_Insert_Call {
+func (_c *MockSegment_Insert_Call) Run(run func(rowIDs []int64, timestamps []uint64, record *segcorepb.InsertRecord, validData map[int64][]bool)) *MockSegment_Insert_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].([]int64), args[1].([]uint64), args[2].(*segcorepb.InsertRecord))
+		run(args[0].([]int64), args[1].([]uint64), args[2].(*segcorepb.InsertRecord), args[3].(map[int64][]bool))
 	})
 	return _c
 }
@@ -358,3 +358,40 @@ func NewMockSegmentManager(t mockConstructorTestingTNewMockSegmentManager) *Mock
 
 	return mock
 }
+
+// Replace provides a mock function with given fields: segment
+func (_m *MockSegmentManager) Replace(segment Segment) bool {
+	ret := _m.Called(segment)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(Segment) bool); ok {
+		r0 = rf(segment)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockSegmentManager_Replace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Replace'
+type MockSegmentManager_Replace_Call struct {
+	*mock.Call
+}
+
+// Replace is a helper method to define mock.On call
+//   - segment Segment
+func (_e *MockSegmentManager_Expecter) Replace(segment interface{}) *MockSegmentManager_Replace_Call {
+	return &MockSegmentManager_Replace_Call{Call: _e.mock.On("Replace", segment)}
+}
+
+func (_c *MockSegmentManager_Replace_Call) Run(run func(segment Segment)) *MockSegmentManager_Replace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(Segment))
+	})
+	return _c
+}
+
+func (_c *MockSegmentManager_Replace_Call) Return(_a0 bool) *MockSegmentManager_Replace_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/cockroachdb/errors"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	. "github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -49,6 +50,8 @@ func createSearchPlan(col *Collection, dsl string) (*SearchPlan, error) {
 
 	cDsl := C.CString(dsl)
 	defer C.free(unsafe.Pointer(cDsl))
+	col.mu.RLock()
+	defer col.mu.RUnlock()
 	var cPlan C.CSearchPlan
 	status := C.CreateSearchPlan(col.collectionPtr, cDsl, &cPlan)
 
@@ -65,6 +68,8 @@ func createSearchPlanByExpr(col *Collection, expr []byte) (*SearchPlan, error) {
 	if col.collectionPtr == nil {
 		return nil, errors.New("nil collection ptr, collectionID = " + fmt.Sprintln(col.id))
 	}
+	col.mu.RLock()
+	defer col.mu.RUnlock()
 	var cPlan C.CSearchPlan
 	status := C.CreateSearchPlanByExpr(col.collectionPtr, unsafe.Pointer(&expr[0]), (C.int64_t)(len(expr)), &cPlan)
 
@@ -99,17 +104,33 @@ type SearchRequest struct {
 	timestamp         Timestamp
 	msgID             UniqueID
 	searchFieldID     UniqueID
+	// fields filtered or output by the request, nil means unknown
+	fieldIDs []int64
+	// filter of the request, used to prune the clustered segments
+	predicates *planpb.Expr
 }
 
 func NewSearchRequest(collection *Collection, req *querypb.SearchRequest, placeholderGrp []byte) (*SearchRequest, error) {
 	var err error
 	var plan *SearchPlan
+	var fieldIDs []int64
+	var predicates *planpb.Expr
 	if req.Req.GetDslType() == commonpb.DslType_BoolExprV1 {
 		expr := req.Req.SerializedExprPlan
 		plan, err = createSearchPlanByExpr(collection, expr)
 		if err != nil {
 			return nil, err
 		}
+		fieldIDs, err = fieldsOfPlan(expr)
+		if err != nil {
+			plan.delete()
+			return nil, err
+		}
+		predicates, err = predicatesOfPlan(expr)
+		if err != nil {
+			plan.delete()
+			return nil, err
+		}
 	} else {
 		dsl := req.Req.GetDsl()
 		plan, err = createSearchPlan(collection, dsl)
@@ -146,6 +167,8 @@ func NewSearchRequest(collection *Collection, req *querypb.SearchRequest, placeh
 		timestamp:         req.Req.GetTravelTimestamp(),
 		msgID:             req.GetReq().GetBase().GetMsgID(),
 		searchFieldID:     int64(fieldID),
+		fieldIDs:          fieldIDs,
+		predicates:        predicates,
 	}
 
 	return ret, nil
@@ -188,17 +211,28 @@ func parseSearchRequest(plan *SearchPlan, searchRequestBlob []byte) (*SearchRequ
 type RetrievePlan struct {
 	cRetrievePlan C.CRetrievePlan
 	Timestamp     Timestamp
-	msgID         UniqueID // only used to debug.
+	msgID         UniqueID     // only used to debug.
+	fieldIDs      []int64      // fields filtered or output by the plan.
+	predicates    *planpb.Expr // filter of the plan, used to prune the clustered segments.
 }
 
 func NewRetrievePlan(col *Collection, expr []byte, timestamp Timestamp, msgID UniqueID) (*RetrievePlan, error) {
+	fieldIDs, err := fieldsOfPlan(expr)
+	if err != nil {
+		return nil, err
+	}
+	predicates, err := predicatesOfPlan(expr)
+	if err != nil {
+		return nil, err
+	}
+
 	col.mu.RLock()
 	defer col.mu.RUnlock()
 
 	var cPlan C.CRetrievePlan
 	status := C.CreateRetrievePlanByExpr(col.collectionPtr, unsafe.Pointer(&expr[0]), (C.int64_t)(len(expr)), &cPlan)
 
-	err := HandleCStatus(&status, "Create retrieve plan by expr failed")
+	err = HandleCStatus(&status, "Create retrieve plan by expr failed")
 	if err != nil {
 		return nil, err
 	}
@@ -207,6 +241,8 @@ func NewRetrievePlan(col *Collection, expr []byte, timestamp Timestamp, msgID Un
 		cRetrievePlan: cPlan,
 		Timestamp:     timestamp,
 		msgID:         msgID,
+		fieldIDs:      fieldIDs,
+		predicates:    predicates,
 	}
 	return newPlan, nil
 }
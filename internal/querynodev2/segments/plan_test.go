@@ -147,6 +147,58 @@ func (suite *PlanSuite) TestPlanNewSearchRequest() {
 	searchReq.Delete()
 }
 
+func (suite *PlanSuite) TestFieldsOfPlan() {
+	column := func(fieldID int64) *planpb.Expr {
+		return &planpb.Expr{Expr: &planpb.Expr_ColumnExpr{ColumnExpr: &planpb.ColumnExpr{Info: &planpb.ColumnInfo{FieldId: fieldID}}}}
+	}
+	planNode := &planpb.PlanNode{
+		Node: &planpb.PlanNode_VectorAnns{
+			VectorAnns: &planpb.VectorANNS{
+				FieldId: 101,
+				Predicates: &planpb.Expr{
+					Expr: &planpb.Expr_BinaryExpr{
+						BinaryExpr: &planpb.BinaryExpr{
+							Op: planpb.BinaryExpr_LogicalAnd,
+							Left: &planpb.Expr{
+								Expr: &planpb.Expr_TermExpr{TermExpr: &planpb.TermExpr{ColumnInfo: &planpb.ColumnInfo{FieldId: 102}}},
+							},
+							Right: &planpb.Expr{
+								Expr: &planpb.Expr_CompareExpr{CompareExpr: &planpb.CompareExpr{
+									LeftColumnInfo:  &planpb.ColumnInfo{FieldId: 103},
+									RightColumnInfo: &planpb.ColumnInfo{FieldId: 104},
+								}},
+							},
+						},
+					},
+				},
+			},
+		},
+		OutputFieldIds: []int64{105},
+	}
+	expr, err := proto.Marshal(planNode)
+	suite.Require().NoError(err)
+	fieldIDs, err := fieldsOfPlan(expr)
+	suite.NoError(err)
+	suite.ElementsMatch([]int64{101, 102, 103, 104, 105}, fieldIDs)
+
+	planNode = &planpb.PlanNode{
+		Node: &planpb.PlanNode_Predicates{
+			Predicates: &planpb.Expr{Expr: &planpb.Expr_BinaryArithExpr{BinaryArithExpr: &planpb.BinaryArithExpr{
+				Left:  column(106),
+				Right: column(107),
+			}}},
+		},
+	}
+	expr, err = proto.Marshal(planNode)
+	suite.Require().NoError(err)
+	fieldIDs, err = fieldsOfPlan(expr)
+	suite.NoError(err)
+	suite.ElementsMatch([]int64{106, 107}, fieldIDs)
+
+	_, err = fieldsOfPlan([]byte("not a plan"))
+	suite.Error(err)
+}
+
 func TestPlan(t *testing.T) {
 	suite.Run(t, new(PlanSuite))
 }
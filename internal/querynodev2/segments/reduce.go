@@ -25,6 +25,8 @@ package segments
 import "C"
 import (
 	"fmt"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 )
 
 type SliceInfo struct {
@@ -35,6 +37,8 @@ type SliceInfo struct {
 // SearchResult contains a pointer to the search result in C++ memory
 type SearchResult struct {
 	cSearchResult C.CSearchResult
+	// cost of searching the segment, for the slow query log
+	cost *internalpb.SegmentSearchCost
 }
 
 // searchResultDataBlobs is the CSearchResultsDataBlobs in C++
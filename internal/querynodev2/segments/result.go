@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
@@ -31,6 +32,8 @@ import (
 	typeutil2 "github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/timerecord"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -40,6 +43,7 @@ var _ typeutil.ResultWithID = &segcorepb.RetrieveResults{}
 
 func ReduceSearchResults(ctx context.Context, results []*internalpb.SearchResults, nq int64, topk int64, metricType string) (*internalpb.SearchResults, error) {
 	log := log.Ctx(ctx)
+	tr := timerecord.NewTimeRecorder("reduceSearchResults")
 
 	searchResultData, err := DecodeSearchResults(results)
 	if err != nil {
@@ -65,10 +69,24 @@ func ReduceSearchResults(ctx context.Context, results []*internalpb.SearchResult
 		log.Warn("shard leader encode search result errors", zap.Error(err))
 		return nil, err
 	}
+	mergeSearchCosts(searchResults, results, tr.ElapseSpan())
 
 	return searchResults, nil
 }
 
+// mergeSearchCosts sets the costs of the reduced result,
+// the results are searched in parallel so the max wait tsafe and reduce time are taken,
+// and only the slowest segments are kept.
+func mergeSearchCosts(ret *internalpb.SearchResults, results []*internalpb.SearchResults, reduceCost time.Duration) {
+	for _, result := range results {
+		ret.WaitTsafeMs = funcutil.Max(ret.WaitTsafeMs, result.GetWaitTsafeMs())
+		ret.ReduceMs = funcutil.Max(ret.ReduceMs, result.GetReduceMs())
+		ret.SegmentCosts = append(ret.SegmentCosts, result.GetSegmentCosts()...)
+	}
+	ret.SegmentCosts = slowestSegments(ret.SegmentCosts)
+	ret.ReduceMs += reduceCost.Milliseconds()
+}
+
 func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.SearchResultData, nq int64, topk int64) (*schemapb.SearchResultData, error) {
 	log := log.Ctx(ctx)
 
@@ -231,6 +249,7 @@ func MergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 		if r == nil || len(r.GetFieldsData()) == 0 || size == 0 {
 			continue
 		}
+		sortInternalRetrieveResult(r)
 		validRetrieveResults = append(validRetrieveResults, r)
 		loopEnd += size
 	}
@@ -277,6 +296,25 @@ func MergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 	return ret, nil
 }
 
+// sortInternalRetrieveResult sorts the rows of r by primary key,
+// the merge only takes the rows in primary key order if every result is sorted.
+func sortInternalRetrieveResult(r *internalpb.RetrieveResults) {
+	r.Ids, r.FieldsData, _ = typeutil.SortByPK(r.GetIds(), r.GetFieldsData())
+}
+
+// sortSegcoreRetrieveResult sorts the rows and the offsets of r by primary key.
+func sortSegcoreRetrieveResult(r *segcorepb.RetrieveResults) {
+	var order []int
+	r.Ids, r.FieldsData, order = typeutil.SortByPK(r.GetIds(), r.GetFieldsData())
+	if order != nil && len(r.GetOffset()) == len(order) {
+		offsets := make([]int64, len(order))
+		for i, idx := range order {
+			offsets[i] = r.Offset[idx]
+		}
+		r.Offset = offsets
+	}
+}
+
 func getTS(i *internalpb.RetrieveResults, idx int64) uint64 {
 	if i.FieldsData == nil {
 		return 0
@@ -312,6 +350,7 @@ func MergeSegcoreRetrieveResults(ctx context.Context, retrieveResults []*segcore
 			log.Debug("filter out invalid retrieve result")
 			continue
 		}
+		sortSegcoreRetrieveResult(r)
 		validRetrieveResults = append(validRetrieveResults, r)
 		loopEnd += size
 	}
@@ -21,6 +21,7 @@ import (
 	"math"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -28,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -35,6 +37,10 @@ type ResultSuite struct {
 	suite.Suite
 }
 
+func (suite *ResultSuite) SetupSuite() {
+	paramtable.Init()
+}
+
 func (suite *ResultSuite) TestResult_MergeSegcoreRetrieveResults() {
 	const (
 		Dim                  = 8
@@ -197,6 +203,37 @@ func (suite *ResultSuite) TestResult_MergeSegcoreRetrieveResults() {
 			suite.NoError(err)
 		})
 
+		suite.Run("test unsorted", func() {
+			u1 := &segcorepb.RetrieveResults{
+				Ids: &schemapb.IDs{
+					IdField: &schemapb.IDs_IntId{
+						IntId: &schemapb.LongArray{
+							Data: []int64{5, 1, 3},
+						},
+					},
+				},
+				Offset:     []int64{0, 1, 2},
+				FieldsData: []*schemapb.FieldData{genFieldData(Int64FieldName, Int64FieldID, schemapb.DataType_Int64, []int64{55, 11, 33}, 1)},
+			}
+			u2 := &segcorepb.RetrieveResults{
+				Ids: &schemapb.IDs{
+					IdField: &schemapb.IDs_IntId{
+						IntId: &schemapb.LongArray{
+							Data: []int64{4, 6, 2},
+						},
+					},
+				},
+				Offset:     []int64{0, 1, 2},
+				FieldsData: []*schemapb.FieldData{genFieldData(Int64FieldName, Int64FieldID, schemapb.DataType_Int64, []int64{44, 66, 22}, 1)},
+			}
+
+			// the limit keeps the smallest primary keys even if the results are not sorted
+			result, err := MergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{u1, u2}, 4)
+			suite.NoError(err)
+			suite.Equal([]int64{1, 2, 3, 4}, result.GetIds().GetIntId().GetData())
+			suite.Equal([]int64{11, 22, 33, 44}, result.GetFieldsData()[0].GetScalars().GetLongData().Data)
+			suite.Equal([]int64{1, 2, 0}, u1.GetOffset())
+		})
 	})
 }
 
@@ -574,6 +611,34 @@ func (suite *ResultSuite) TestSort() {
 	suite.Equal([]byte{2, 3, 4, 5, 6, 7, 8, 9}, result.FieldsData[7].GetVectors().GetBinaryVector())
 }
 
+func (suite *ResultSuite) TestMergeSearchCosts() {
+	results := []*internalpb.SearchResults{
+		{
+			WaitTsafeMs:  10,
+			ReduceMs:     2,
+			SegmentCosts: []*internalpb.SegmentSearchCost{{SegmentID: 1, Indexed: true, CostMs: 100}},
+		},
+		{
+			WaitTsafeMs:  5,
+			ReduceMs:     3,
+			SegmentCosts: []*internalpb.SegmentSearchCost{{SegmentID: 2, Growing: true, CostMs: 20}},
+		},
+	}
+	ret := &internalpb.SearchResults{}
+	mergeSearchCosts(ret, results, 4*time.Millisecond)
+	suite.Equal(int64(10), ret.GetWaitTsafeMs())
+	suite.Equal(int64(7), ret.GetReduceMs())
+	suite.Equal(2, len(ret.GetSegmentCosts()))
+
+	// only the slowest segments are kept.
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.MaxSlowSegmentNum.Key, "1")
+	defer paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.MaxSlowSegmentNum.Key)
+	ret = &internalpb.SearchResults{}
+	mergeSearchCosts(ret, results, 4*time.Millisecond)
+	suite.Equal(1, len(ret.GetSegmentCosts()))
+	suite.Equal(int64(1), ret.GetSegmentCosts()[0].GetSegmentID())
+}
+
 func TestResult(t *testing.T) {
 	suite.Run(t, new(ResultSuite))
 }
@@ -34,6 +34,9 @@ func retrieveOnSegments(ctx context.Context, manager *Manager, segType SegmentTy
 		if segment == nil {
 			continue
 		}
+		if err := segment.LoadLazyFields(ctx, plan.fieldIDs); err != nil {
+			return nil, err
+		}
 		result, err := segment.Retrieve(ctx, plan)
 		if err != nil {
 			return nil, err
@@ -57,6 +60,7 @@ func RetrieveHistorical(ctx context.Context, manager *Manager, plan *RetrievePla
 		return retrieveResults, retrieveSegmentIDs, retrievePartIDs, err
 	}
 
+	retrieveSegmentIDs = pruneByClustering(manager, SegmentTypeSealed, plan.predicates, retrieveSegmentIDs)
 	retrieveResults, err = retrieveOnSegments(ctx, manager, SegmentTypeSealed, plan, retrieveSegmentIDs, vcm)
 	return retrieveResults, retrievePartIDs, retrieveSegmentIDs, err
 }
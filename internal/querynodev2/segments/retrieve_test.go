@@ -19,6 +19,7 @@ package segments
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -26,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 )
 
 type RetrieveSuite struct {
@@ -103,7 +105,7 @@ func (suite *RetrieveSuite) SetupTest() {
 	suite.Require().NoError(err)
 	insertRecord, err = storage.TransferInsertMsgToInsertRecord(suite.collection.Schema(), insertMsg)
 	suite.Require().NoError(err)
-	err = suite.growing.Insert(insertMsg.RowIDs, insertMsg.Timestamps, insertRecord)
+	err = suite.growing.Insert(insertMsg.RowIDs, insertMsg.Timestamps, insertRecord, nil)
 	suite.Require().NoError(err)
 
 	suite.manager.Segment.Put(SegmentTypeSealed, suite.sealed)
@@ -142,6 +144,34 @@ func (suite *RetrieveSuite) TestRetrieveGrowing() {
 	suite.Len(res[0].Offset, 3)
 }
 
+func (suite *RetrieveSuite) TestRetrieveWithAlteredTTL() {
+	retrieve := func() int {
+		expr, err := genSimpleRetrievePlanExpr(suite.collection.Schema())
+		suite.Require().NoError(err)
+		// the entities are inserted with tiny timestamps, so they have expired by now
+		plan, err := NewRetrievePlan(suite.collection, expr, tsoutil.ComposeTSByTime(time.Now(), 0), 100)
+		suite.Require().NoError(err)
+		defer plan.Delete()
+
+		res, _, _, err := RetrieveHistorical(context.TODO(), suite.manager, plan,
+			suite.collectionID,
+			[]int64{suite.partitionID},
+			[]int64{suite.sealed.ID()},
+			nil)
+		suite.Require().NoError(err)
+		return len(res[0].GetOffset())
+	}
+
+	schema := suite.collection.Schema()
+	suite.manager.Collection.Put(suite.collectionID, withCollectionTTL(schema, ""), nil)
+	suite.Equal(3, retrieve())
+
+	// the altered ttl applies to the loaded segment without reloading it
+	suite.manager.Collection.Put(suite.collectionID, withCollectionTTL(schema, "1"), nil)
+	suite.Equal("1", getCollectionTTL(suite.collection.Schema()))
+	suite.Equal(0, retrieve())
+}
+
 func TestRetrieve(t *testing.T) {
 	suite.Run(t, new(RetrieveSuite))
 }
@@ -19,11 +19,13 @@ package segments
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -60,19 +62,33 @@ func searchSegments(ctx context.Context, manager *Manager, segType SegmentType,
 				return
 			}
 
-			if !seg.ExistIndex(searchReq.searchFieldID) {
+			indexed := seg.ExistIndex(searchReq.searchFieldID)
+			if !indexed {
 				mu.Lock()
 				segmentsWithoutIndex = append(segmentsWithoutIndex, segID)
 				mu.Unlock()
 			}
+			if err := seg.LoadLazyFields(ctx, searchReq.fieldIDs); err != nil {
+				errs[i] = err
+				return
+			}
 			// record search time
 			tr := timerecord.NewTimeRecorder("searchOnSegments")
 			searchResult, err := seg.Search(ctx, searchReq)
+			cost := tr.ElapseSpan()
 			errs[i] = err
+			if searchResult != nil {
+				searchResult.cost = &internalpb.SegmentSearchCost{
+					SegmentID: segID,
+					Growing:   segType == SegmentTypeGrowing,
+					Indexed:   indexed,
+					CostMs:    cost.Milliseconds(),
+				}
+			}
 			resultCh <- searchResult
 			// update metrics
 			metrics.QueryNodeSQSegmentLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
-				metrics.SearchLabel, searchLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
+				metrics.SearchLabel, searchLabel).Observe(float64(cost.Milliseconds()))
 		}(segID, i)
 	}
 	wg.Wait()
@@ -97,6 +113,32 @@ func searchSegments(ctx context.Context, manager *Manager, segType SegmentType,
 	return searchResults, nil
 }
 
+// SearchCosts returns the costs of searching the slowest segments of the results.
+func SearchCosts(results []*SearchResult) []*internalpb.SegmentSearchCost {
+	costs := make([]*internalpb.SegmentSearchCost, 0, len(results))
+	for _, result := range results {
+		if result != nil && result.cost != nil {
+			costs = append(costs, result.cost)
+		}
+	}
+	return slowestSegments(costs)
+}
+
+// slowestSegments keeps the costs of at most queryNode.maxSlowSegmentNum slowest segments.
+func slowestSegments(costs []*internalpb.SegmentSearchCost) []*internalpb.SegmentSearchCost {
+	limit := paramtable.Get().QueryNodeCfg.MaxSlowSegmentNum.GetAsInt()
+	if limit < 0 {
+		limit = 0
+	}
+	if len(costs) <= limit {
+		return costs
+	}
+	sort.SliceStable(costs, func(i, j int) bool {
+		return costs[i].GetCostMs() > costs[j].GetCostMs()
+	})
+	return costs[:limit]
+}
+
 // search will search on the historical segments the target segments in historical.
 // if segIDs is not specified, it will search on all the historical segments speficied by partIDs.
 // if segIDs is specified, it will only search on the segments specified by the segIDs.
@@ -110,6 +152,7 @@ func SearchHistorical(ctx context.Context, manager *Manager, searchReq *SearchRe
 	if err != nil {
 		return searchResults, searchSegmentIDs, searchPartIDs, err
 	}
+	searchSegmentIDs = pruneByClustering(manager, SegmentTypeSealed, searchReq.predicates, searchSegmentIDs)
 	searchResults, err = searchSegments(ctx, manager, SegmentTypeSealed, searchReq, searchSegmentIDs)
 	return searchResults, searchPartIDs, searchSegmentIDs, err
 }
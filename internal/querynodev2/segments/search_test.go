@@ -99,7 +99,7 @@ func (suite *SearchSuite) SetupTest() {
 	suite.Require().NoError(err)
 	insertRecord, err = storage.TransferInsertMsgToInsertRecord(suite.collection.Schema(), insertMsg)
 	suite.Require().NoError(err)
-	suite.growing.Insert(insertMsg.RowIDs, insertMsg.Timestamps, insertRecord)
+	suite.growing.Insert(insertMsg.RowIDs, insertMsg.Timestamps, insertRecord, nil)
 
 	suite.manager.Segment.Put(SegmentTypeSealed, suite.sealed)
 	suite.manager.Segment.Put(SegmentTypeGrowing, suite.growing)
@@ -95,7 +95,7 @@ type Segment interface {
 	Indexes() []*IndexedFieldInfo
 
 	// Modification related
-	Insert(rowIDs []int64, timestamps []typeutil.Timestamp, record *segcorepb.InsertRecord) error
+	Insert(rowIDs []int64, timestamps []typeutil.Timestamp, record *segcorepb.InsertRecord, validData map[int64][]bool) error
 	Delete(primaryKeys []storage.PrimaryKey, timestamps []typeutil.Timestamp) error
 	LastDeltaTimestamp() uint64
 
@@ -180,6 +180,20 @@ type LocalSegment struct {
 	row                int64
 	lastDeltaTimestamp *atomic.Uint64
 	fieldIndexes       *typeutil.ConcurrentMap[int64, *IndexedFieldInfo]
+
+	// unpinFiles releases the files of the segment pinned in disk cache
+	unpinFiles func()
+	// mmapDirPath overrides the configured mmap folder, set if the segment is loaded onto disk cache
+	mmapDirPath string
+
+	// clusteringInfo is the range of the clustering field, nil if the segment is not clustered
+	clusteringInfo *datapb.ClusteringInfo
+
+	// lazyFields are the fields of sealed segment not loaded yet,
+	// loaded by loadLazyField the first time a request needs them
+	lazyMut       sync.Mutex
+	lazyFields    *typeutil.ConcurrentMap[int64, *datapb.FieldBinlog]
+	loadLazyField func(ctx context.Context, field *datapb.FieldBinlog) error
 }
 
 func NewSegment(collection *Collection,
@@ -197,6 +211,8 @@ func NewSegment(collection *Collection,
 		NewSegment(CCollection collection, uint64_t segment_id, SegmentType seg_type);
 	*/
 	var segmentPtr C.CSegmentInterface
+	collection.mu.RLock()
+	defer collection.mu.RUnlock()
 	switch segmentType {
 	case SegmentTypeSealed:
 		segmentPtr = C.NewSegment(collection.collectionPtr, C.Sealed, C.int64_t(segmentID))
@@ -217,6 +233,7 @@ func NewSegment(collection *Collection,
 		ptr:                segmentPtr,
 		lastDeltaTimestamp: atomic.NewUint64(endPosition.GetTimestamp()),
 		fieldIndexes:       typeutil.NewConcurrentMap[int64, *IndexedFieldInfo](),
+		lazyFields:         typeutil.NewConcurrentMap[int64, *datapb.FieldBinlog](),
 	}
 
 	return segment, nil
@@ -298,6 +315,11 @@ func (s *LocalSegment) Indexes() []*IndexedFieldInfo {
 	return result
 }
 
+// ClusteringInfo returns the range of clustering field of the segment.
+func (s *LocalSegment) ClusteringInfo() *datapb.ClusteringInfo {
+	return s.clusteringInfo
+}
+
 func (s *LocalSegment) Type() SegmentType {
 	return s.typ
 }
@@ -309,10 +331,13 @@ func DeleteSegment(segment *LocalSegment) {
 	*/
 	// wait all read ops finished
 	var ptr C.CSegmentInterface
+	var unpinFiles func()
 
 	segment.mut.Lock()
 	ptr = segment.ptr
 	segment.ptr = nil
+	unpinFiles = segment.unpinFiles
+	segment.unpinFiles = nil
 	segment.mut.Unlock()
 
 	if ptr == nil {
@@ -320,6 +345,9 @@ func DeleteSegment(segment *LocalSegment) {
 	}
 
 	C.DeleteSegment(ptr)
+	if unpinFiles != nil {
+		unpinFiles()
+	}
 	log.Info("delete segment from memory",
 		zap.Int64("collectionID", segment.collectionID),
 		zap.Int64("partitionID", segment.partitionID),
@@ -546,7 +574,9 @@ func (s *LocalSegment) preDelete(numOfRecords int) int64 {
 	return int64(offset)
 }
 
-func (s *LocalSegment) Insert(rowIDs []int64, timestamps []typeutil.Timestamp, record *segcorepb.InsertRecord) error {
+// Insert inserts the rows into the growing segment,
+// validData is the validity of the nullable fields which have null values in the rows.
+func (s *LocalSegment) Insert(rowIDs []int64, timestamps []typeutil.Timestamp, record *segcorepb.InsertRecord, validData map[int64][]bool) error {
 	if s.Type() != SegmentTypeGrowing {
 		return fmt.Errorf("unexpected segmentType when segmentInsert, segmentType = %s", s.typ.String())
 	}
@@ -558,6 +588,12 @@ func (s *LocalSegment) Insert(rowIDs []int64, timestamps []typeutil.Timestamp, r
 		return WrapSegmentReleased(s.segmentID)
 	}
 
+	for fieldID, fieldValidData := range validData {
+		if len(fieldValidData) != len(rowIDs) {
+			return fmt.Errorf("valid data of field %d has %d rows, but %d rows inserted", fieldID, len(fieldValidData), len(rowIDs))
+		}
+	}
+
 	offset, err := s.preInsert(len(rowIDs))
 	if err != nil {
 		return err
@@ -576,6 +612,22 @@ func (s *LocalSegment) Insert(rowIDs []int64, timestamps []typeutil.Timestamp, r
 
 	var status C.CStatus
 
+	// the validity must be inserted before the rows, which are visible once inserted
+	for fieldID, fieldValidData := range validData {
+		GetPool().Submit(func() (any, error) {
+			status = C.InsertValidData(s.ptr,
+				cOffset,
+				cNumOfRows,
+				C.int64_t(fieldID),
+				(*C.bool)(unsafe.Pointer(&fieldValidData[0])),
+			)
+			return nil, nil
+		}).Await()
+		if err := HandleCStatus(&status, "InsertValidData failed"); err != nil {
+			return err
+		}
+	}
+
 	GetPool().Submit(func() (any, error) {
 		status = C.Insert(s.ptr,
 			cOffset,
@@ -707,7 +759,10 @@ func (s *LocalSegment) LoadField(rowCount int64, data *schemapb.FieldData) error
 	}
 
 	var mmapDirPath *C.char = nil
-	path := paramtable.Get().QueryNodeCfg.MmapDirPath.GetValue()
+	path := s.mmapDirPath
+	if len(path) == 0 {
+		path = paramtable.Get().QueryNodeCfg.MmapDirPath.GetValue()
+	}
 	if len(path) > 0 {
 		mmapDirPath = C.CString(path)
 		defer C.free(unsafe.Pointer(mmapDirPath))
@@ -738,6 +793,84 @@ func (s *LocalSegment) LoadField(rowCount int64, data *schemapb.FieldData) error
 	return nil
 }
 
+// LoadFieldValidData loads the validity of the nullable field, validData[i] is false if the i-th row is null.
+func (s *LocalSegment) LoadFieldValidData(fieldID int64, validData []bool) error {
+	/*
+		CStatus
+		LoadFieldValidData(CSegmentInterface c_segment, int64_t field_id, int64_t row_count, const bool* valid_data);
+	*/
+	if s.Type() != SegmentTypeSealed {
+		return fmt.Errorf("segmentLoadFieldValidData failed, illegal segment type=%s, segmentID=%d",
+			s.Type().String(),
+			s.ID(),
+		)
+	}
+	if len(validData) == 0 {
+		return nil
+	}
+	s.mut.RLock()
+	defer s.mut.RUnlock()
+
+	if s.ptr == nil {
+		return WrapSegmentReleased(s.segmentID)
+	}
+
+	var status C.CStatus
+	GetPool().Submit(func() (any, error) {
+		status = C.LoadFieldValidData(s.ptr,
+			C.int64_t(fieldID),
+			C.int64_t(len(validData)),
+			(*C.bool)(unsafe.Pointer(&validData[0])),
+		)
+		return nil, nil
+	}).Await()
+	return HandleCStatus(&status, "LoadFieldValidData failed")
+}
+
+// LoadLazyFields loads the lazy fields in fieldIDs, all lazy fields are loaded if fieldIDs is nil.
+// The fields already loaded are skipped.
+func (s *LocalSegment) LoadLazyFields(ctx context.Context, fieldIDs []int64) error {
+	if s.lazyFields.Len() == 0 {
+		return nil
+	}
+	if fieldIDs == nil {
+		s.lazyFields.Range(func(fieldID int64, _ *datapb.FieldBinlog) bool {
+			fieldIDs = append(fieldIDs, fieldID)
+			return true
+		})
+	}
+
+	s.lazyMut.Lock()
+	defer s.lazyMut.Unlock()
+
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	for _, fieldID := range fieldIDs {
+		field, ok := s.lazyFields.Get(fieldID)
+		if !ok {
+			continue
+		}
+		tr := timerecord.NewTimeRecorder("lazyLoadField")
+		if err := s.loadLazyField(ctx, field); err != nil {
+			metrics.QueryNodeLazyLoadFieldCount.WithLabelValues(nodeID, metrics.FailLabel).Inc()
+			log.Ctx(ctx).Warn("failed to load lazy field",
+				zap.Int64("collectionID", s.Collection()),
+				zap.Int64("segmentID", s.ID()),
+				zap.Int64("fieldID", fieldID),
+				zap.Error(err))
+			return err
+		}
+		s.lazyFields.GetAndRemove(fieldID)
+		metrics.QueryNodeLazyLoadFieldCount.WithLabelValues(nodeID, metrics.SuccessLabel).Inc()
+		metrics.QueryNodeLazyLoadFieldLatency.WithLabelValues(nodeID).Observe(float64(tr.ElapseSpan().Milliseconds()))
+		log.Ctx(ctx).Info("lazy field loaded",
+			zap.Int64("collectionID", s.Collection()),
+			zap.Int64("segmentID", s.ID()),
+			zap.Int64("fieldID", fieldID),
+			zap.Duration("elapse", tr.ElapseSpan()))
+	}
+	return nil
+}
+
 func (s *LocalSegment) LoadDeltaData(deltaData *storage.DeleteData) error {
 	pks, tss := deltaData.Pks, deltaData.Tss
 	rowNum := deltaData.RowCount
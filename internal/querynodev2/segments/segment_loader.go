@@ -23,6 +23,8 @@ import (
 	"runtime"
 	"runtime/debug"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -68,6 +70,9 @@ type Loader interface {
 
 	// LoadRemote loads needed binlogs/statslog for RemoteSegment.
 	LoadBloomFilterSet(ctx context.Context, collectionID int64, version int64, infos ...*querypb.SegmentLoadInfo) ([]*pkoracle.BloomFilterSet, error)
+
+	// LoadPkIndex loads the pk ranges in statslog and the pk binlogs, and builds the xor filter pk index for RemoteSegment.
+	LoadPkIndex(ctx context.Context, collectionID int64, version int64, infos ...*querypb.SegmentLoadInfo) ([]*pkoracle.PkIndex, error)
 }
 
 func NewLoader(
@@ -107,6 +112,10 @@ type segmentLoader struct {
 	manager CollectionManager
 	cm      storage.ChunkManager
 	ioPool  *conc.Pool[*storage.Blob]
+
+	mut sync.Mutex
+	// committedMemSize is the memory reserved by the lazy fields being loaded
+	committedMemSize uint64
 }
 
 var _ Loader = (*segmentLoader)(nil)
@@ -145,15 +154,14 @@ func (loader *segmentLoader) Load(ctx context.Context,
 	log.Info("start loading...", zap.Int("segmentNum", segmentNum))
 
 	// Check memory limit
-	var (
-		concurrencyLevel = funcutil.Min(runtime.GOMAXPROCS(0), len(infos))
-		err              error
-	)
-	for ; concurrencyLevel > 1; concurrencyLevel /= 2 {
-		err = loader.checkSegmentSize(collectionID, infos, concurrencyLevel)
-		if err == nil {
-			break
-		}
+	concurrencyLevel, err := loader.checkLoadSize(collectionID, infos, false)
+	// the sealed segments are loaded onto disk cache if memory is not enough
+	diskCache, ok := loader.cm.(*storage.DiskCacheChunkManager)
+	loadOntoDisk := false
+	if err != nil && ok && segmentType == SegmentTypeSealed {
+		log.Info("memory is not enough, try to load segments onto disk cache", zap.Error(err))
+		concurrencyLevel, err = loader.checkLoadSize(collectionID, infos, true)
+		loadOntoDisk = err == nil
 	}
 	if err != nil {
 		log.Warn("load failed, OOM if loaded", zap.Error(err))
@@ -201,6 +209,10 @@ func (loader *segmentLoader) Load(ctx context.Context,
 			clearAll()
 			return nil, err
 		}
+		segment.clusteringInfo = info.GetClusteringInfo()
+		if loadOntoDisk {
+			segment.mmapDirPath = diskCache.MmapDir()
+		}
 
 		newSegments[segmentID] = segment
 	}
@@ -221,6 +233,9 @@ func (loader *segmentLoader) Load(ctx context.Context,
 			)
 			return err
 		}
+		if segment.Type() == SegmentTypeSealed {
+			loader.pinSegmentFiles(segment, loadInfo)
+		}
 		loadedSegments.Insert(segment)
 
 		metrics.QueryNodeLoadSegmentLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Observe(float64(tr.ElapseSpan().Milliseconds()))
@@ -289,6 +304,7 @@ func (loader *segmentLoader) LoadBloomFilterSet(ctx context.Context, collectionI
 		partitionID := loadInfo.PartitionID
 		segmentID := loadInfo.SegmentID
 		bfs := pkoracle.NewBloomFilterSet(segmentID, partitionID, commonpb.SegmentState_Sealed)
+		bfs.SetHistoricalRowNum(loadInfo.GetNumOfRows())
 
 		log.Info("loading bloom filter for remote...")
 		pkStatsBinlogs := loader.filterPKStatsBinlogs(loadInfo.Statslogs, pkField.GetFieldID())
@@ -316,6 +332,63 @@ func (loader *segmentLoader) LoadBloomFilterSet(ctx context.Context, collectionI
 	return loadedBfs.Collect(), nil
 }
 
+func (loader *segmentLoader) LoadPkIndex(ctx context.Context, collectionID int64, version int64, infos ...*querypb.SegmentLoadInfo) ([]*pkoracle.PkIndex, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", collectionID),
+		zap.Int64s("segmentIDs", lo.Map(infos, func(info *querypb.SegmentLoadInfo, _ int) int64 {
+			return info.SegmentID
+		})),
+	)
+
+	segmentNum := len(infos)
+	if segmentNum == 0 {
+		log.Info("no segment to load")
+		return nil, nil
+	}
+
+	collection := loader.manager.Get(collectionID)
+	if collection == nil {
+		err := WrapCollectionNotFound(collectionID)
+		log.Warn("failed to get collection while loading segment", zap.Error(err))
+		return nil, err
+	}
+	pkField := GetPkField(collection.Schema())
+
+	// each segment holds all its pks in memory while building the xor filter
+	concurrencyLevel := funcutil.Min(runtime.GOMAXPROCS(0), segmentNum)
+	log.Info("start loading pk index for remote...", zap.Int("segmentNum", segmentNum),
+		zap.Int("concurrencyLevel", concurrencyLevel))
+
+	loadedIndexes := NewConcurrentSet[*pkoracle.PkIndex]()
+	loadRemoteFunc := func(idx int) error {
+		loadInfo := infos[idx]
+		partitionID := loadInfo.PartitionID
+		segmentID := loadInfo.SegmentID
+
+		pkIndex, err := loader.loadPkIndex(ctx, pkField.GetFieldID(), loadInfo)
+		if err != nil {
+			log.Warn("load remote segment pk index failed",
+				zap.Int64("partitionID", partitionID),
+				zap.Int64("segmentID", segmentID),
+				zap.Error(err),
+			)
+			return err
+		}
+		loadedIndexes.Insert(pkIndex)
+
+		return nil
+	}
+
+	err := funcutil.ProcessFuncParallel(segmentNum, concurrencyLevel, loadRemoteFunc, "loadPkIndexFunc")
+	if err != nil {
+		// no partial success here
+		log.Warn("failed to load remote segment", zap.Error(err))
+		return nil, err
+	}
+
+	return loadedIndexes.Collect(), nil
+}
+
 func (loader *segmentLoader) loadSegment(ctx context.Context,
 	segment *LocalSegment,
 	loadInfo *querypb.SegmentLoadInfo,
@@ -368,8 +441,14 @@ func (loader *segmentLoader) loadSegment(ctx context.Context,
 			}
 		}
 
+		var lazyFieldBinlogs []*datapb.FieldBinlog
+		if paramtable.Get().QueryNodeCfg.LazyLoadEnabled.GetAsBool() {
+			fieldBinlogs, lazyFieldBinlogs = splitLazyFields(collection.Schema(), fieldBinlogs)
+		}
+
 		log.Info("load fields...",
 			zap.Int64s("indexedFields", lo.Keys(indexedFieldInfos)),
+			zap.Int64s("lazyFields", lo.Map(lazyFieldBinlogs, func(field *datapb.FieldBinlog, _ int) int64 { return field.GetFieldID() })),
 		)
 		if err := loader.loadFieldsIndex(ctx, segment, indexedFieldInfos); err != nil {
 			return err
@@ -377,8 +456,17 @@ func (loader *segmentLoader) loadSegment(ctx context.Context,
 		if err := loader.loadSealedSegmentFields(ctx, segment, fieldBinlogs, loadInfo); err != nil {
 			return err
 		}
+		for _, field := range lazyFieldBinlogs {
+			segment.lazyFields.Insert(field.GetFieldID(), field)
+		}
+		segment.loadLazyField = func(ctx context.Context, field *datapb.FieldBinlog) error {
+			return loader.loadLazyField(ctx, segment, field, loadInfo)
+		}
+		if err := loader.loadDefaultFields(segment, collection.Schema(), loadInfo); err != nil {
+			return err
+		}
 	} else {
-		if err := loader.loadGrowingSegmentFields(ctx, segment, loadInfo.BinlogPaths); err != nil {
+		if err := loader.loadGrowingSegmentFields(ctx, segment, collection.Schema(), loadInfo.BinlogPaths); err != nil {
 			return err
 		}
 	}
@@ -397,6 +485,38 @@ func (loader *segmentLoader) loadSegment(ctx context.Context,
 	return loader.LoadDeltaLogs(ctx, segment, loadInfo.Deltalogs)
 }
 
+// pinSegmentFiles keeps the files of the loaded segment in the disk cache until the segment is released,
+// the files can be read again from local disk.
+// The index files are loaded by segcore with its own chunk manager, they are never in the disk cache.
+func (loader *segmentLoader) pinSegmentFiles(segment *LocalSegment, loadInfo *querypb.SegmentLoadInfo) {
+	diskCache, ok := loader.cm.(*storage.DiskCacheChunkManager)
+	if !ok {
+		return
+	}
+
+	filePaths := make([]string, 0)
+	for _, fieldBinlog := range loadInfo.GetBinlogPaths() {
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			filePaths = append(filePaths, binlog.GetLogPath())
+		}
+	}
+	for _, statsLog := range loadInfo.GetStatslogs() {
+		for _, binlog := range statsLog.GetBinlogs() {
+			filePaths = append(filePaths, binlog.GetLogPath())
+		}
+	}
+	for _, deltaLog := range loadInfo.GetDeltalogs() {
+		for _, binlog := range deltaLog.GetBinlogs() {
+			filePaths = append(filePaths, binlog.GetLogPath())
+		}
+	}
+
+	diskCache.Pin(filePaths...)
+	segment.unpinFiles = func() {
+		diskCache.Unpin(filePaths...)
+	}
+}
+
 func (loader *segmentLoader) filterPKStatsBinlogs(fieldBinlogs []*datapb.FieldBinlog, pkFieldID int64) []string {
 	result := make([]string, 0)
 	for _, fieldBinlog := range fieldBinlogs {
@@ -409,7 +529,7 @@ func (loader *segmentLoader) filterPKStatsBinlogs(fieldBinlogs []*datapb.FieldBi
 	return result
 }
 
-func (loader *segmentLoader) loadGrowingSegmentFields(ctx context.Context, segment *LocalSegment, fieldBinlogs []*datapb.FieldBinlog) error {
+func (loader *segmentLoader) loadGrowingSegmentFields(ctx context.Context, segment *LocalSegment, schema *schemapb.CollectionSchema, fieldBinlogs []*datapb.FieldBinlog) error {
 	if len(fieldBinlogs) <= 0 {
 		return nil
 	}
@@ -445,6 +565,12 @@ func (loader *segmentLoader) loadGrowingSegmentFields(ctx context.Context, segme
 		return err
 	}
 
+	// fill the fields added after the segment was written
+	if err := storage.FillDefaultFieldData(schema, insertData); err != nil {
+		log.Warn("failed to fill default field data", zap.Int64("segment", segment.segmentID), zap.Error(err))
+		return err
+	}
+
 	switch segmentType {
 	case SegmentTypeGrowing:
 		tsData, ok := insertData.Data[common.TimeStampField]
@@ -491,6 +617,117 @@ func (loader *segmentLoader) loadSealedSegmentFields(ctx context.Context, segmen
 	return nil
 }
 
+// splitLazyFields splits the fields of sealed segment to the ones loaded eagerly and the ones loaded on demand.
+// The system fields, primary key, vector fields and the configured eager fields are loaded eagerly.
+func splitLazyFields(schema *schemapb.CollectionSchema, fields []*datapb.FieldBinlog) (eager, lazy []*datapb.FieldBinlog) {
+	eagerFields := NewSet[string]()
+	for _, name := range paramtable.Get().QueryNodeCfg.LazyLoadEagerFields.GetAsStrings() {
+		if name = strings.TrimSpace(name); name != "" {
+			eagerFields.Insert(name)
+		}
+	}
+	fieldSchemas := lo.SliceToMap(schema.GetFields(), func(field *schemapb.FieldSchema) (int64, *schemapb.FieldSchema) {
+		return field.GetFieldID(), field
+	})
+
+	for _, field := range fields {
+		fieldSchema, ok := fieldSchemas[field.GetFieldID()]
+		if !ok || common.IsSystemField(field.GetFieldID()) || fieldSchema.GetIsPrimaryKey() ||
+			IsVectorType(fieldSchema.GetDataType()) || eagerFields.Contain(fieldSchema.GetName()) {
+			eager = append(eager, field)
+		} else {
+			lazy = append(lazy, field)
+		}
+	}
+	return eager, lazy
+}
+
+// loadDefaultFields loads the fields added after the sealed segment was written,
+// all rows of which are the default value of the field.
+func (loader *segmentLoader) loadDefaultFields(segment *LocalSegment, schema *schemapb.CollectionSchema, loadInfo *querypb.SegmentLoadInfo) error {
+	loaded := make(map[int64]struct{}, len(loadInfo.GetBinlogPaths()))
+	for _, fieldBinlog := range loadInfo.GetBinlogPaths() {
+		loaded[fieldBinlog.GetFieldID()] = struct{}{}
+	}
+
+	for _, field := range schema.GetFields() {
+		if _, ok := loaded[field.GetFieldID()]; ok || !IsFieldFillable(field) {
+			continue
+		}
+		fieldData, err := GenDefaultFieldData(field, int(loadInfo.GetNumOfRows()))
+		if err != nil {
+			return err
+		}
+		if err := segment.LoadField(loadInfo.GetNumOfRows(), fieldData); err != nil {
+			return err
+		}
+		// all rows are null if the nullable field has no default value
+		if IsFieldNullable(field) && field.GetDefaultValue() == nil {
+			if err := segment.LoadFieldValidData(field.GetFieldID(), make([]bool, loadInfo.GetNumOfRows())); err != nil {
+				return err
+			}
+		}
+		log.Info("load default field done",
+			zap.Int64("collection", segment.collectionID),
+			zap.Int64("segment", segment.segmentID),
+			zap.Int64("field", field.GetFieldID()))
+	}
+	return nil
+}
+
+// loadLazyField loads the lazy field of sealed segment on first use,
+// the memory is reserved before loading so that it fails instead of OOM if the memory is not enough.
+func (loader *segmentLoader) loadLazyField(ctx context.Context, segment *LocalSegment, field *datapb.FieldBinlog, loadInfo *querypb.SegmentLoadInfo) error {
+	memSize := uint64(float64(getFieldSizeFromFieldBinlog(field)) * paramtable.Get().QueryNodeCfg.LoadMemoryUsageFactor.GetAsFloat())
+	if err := loader.requestMemory(memSize); err != nil {
+		log.Ctx(ctx).Warn("no enough memory to load lazy field",
+			zap.Int64("segmentID", segment.ID()),
+			zap.Int64("fieldID", field.GetFieldID()),
+			zap.Error(err))
+		return err
+	}
+	defer loader.freeMemory(memSize)
+
+	return loader.loadSealedField(ctx, segment, field, loadInfo)
+}
+
+// requestMemory reserves the memory to load, it fails if the used memory would exceed the threshold.
+func (loader *segmentLoader) requestMemory(memSize uint64) error {
+	loader.mut.Lock()
+	defer loader.mut.Unlock()
+
+	usedMem := hardware.GetUsedMemoryCount()
+	totalMem := hardware.GetMemoryCount()
+	if usedMem == 0 || totalMem == 0 {
+		return errors.New("get memory failed when request memory to load")
+	}
+	threshold := uint64(float64(totalMem) * paramtable.Get().QueryNodeCfg.OverloadedMemoryThresholdPercentage.GetAsFloat())
+	if usedMem+loader.committedMemSize+memSize > threshold {
+		return fmt.Errorf("%w, OOM if load, memSize = %v MB, usedMem = %v MB, committedMem = %v MB, totalMem = %v MB, thresholdFactor = %f",
+			ErrLazyLoadOverloaded,
+			memSize/1024/1024,
+			usedMem/1024/1024,
+			loader.committedMemSize/1024/1024,
+			totalMem/1024/1024,
+			paramtable.Get().QueryNodeCfg.OverloadedMemoryThresholdPercentage.GetAsFloat())
+	}
+	loader.committedMemSize += memSize
+	return nil
+}
+
+// freeMemory releases the memory reserved by requestMemory.
+func (loader *segmentLoader) freeMemory(memSize uint64) {
+	loader.mut.Lock()
+	defer loader.mut.Unlock()
+	loader.committedMemSize -= memSize
+}
+
+func (loader *segmentLoader) getCommittedMemSize() uint64 {
+	loader.mut.Lock()
+	defer loader.mut.Unlock()
+	return loader.committedMemSize
+}
+
 // async load field of sealed segment
 func (loader *segmentLoader) loadSealedField(ctx context.Context, segment *LocalSegment, field *datapb.FieldBinlog, loadInfo *querypb.SegmentLoadInfo) error {
 	iCodec := storage.InsertCodec{}
@@ -687,7 +924,7 @@ func (loader *segmentLoader) insertIntoSegment(segment *LocalSegment,
 	segment.bloomFilterSet.UpdateBloomFilter(pks)
 
 	// 2. do insert
-	err = segment.Insert(rowIDs, timestamps, insertRecord)
+	err = segment.Insert(rowIDs, timestamps, insertRecord, storage.GetValidData(insertData))
 	if err != nil {
 		return err
 	}
@@ -708,6 +945,11 @@ func (loader *segmentLoader) loadSealedSegments(segment *LocalSegment, insertDat
 			return err
 		}
 	}
+	for fieldID, validData := range storage.GetValidData(insertData) {
+		if err := segment.LoadFieldValidData(fieldID, validData); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -721,10 +963,25 @@ func (loader *segmentLoader) loadBloomFilter(ctx context.Context, segmentID int6
 	}
 
 	startTs := time.Now()
-	values, err := loader.cm.MultiRead(ctx, binlogPaths)
+	stats, err := loader.loadPkStats(ctx, binlogPaths)
 	if err != nil {
+		log.Warn("failed to load pk stats", zap.Error(err))
 		return err
 	}
+	var size uint
+	for _, stat := range stats {
+		size += stat.PkFilter.Cap()
+		bfs.AddHistoricalStats(stat)
+	}
+	log.Info("Successfully load pk stats", zap.Duration("time", time.Since(startTs)), zap.Uint("size", size))
+	return nil
+}
+
+func (loader *segmentLoader) loadPkStats(ctx context.Context, binlogPaths []string) ([]*storage.PkStatistics, error) {
+	values, err := loader.cm.MultiRead(ctx, binlogPaths)
+	if err != nil {
+		return nil, err
+	}
 	blobs := make([]*storage.Blob, 0)
 	for i := 0; i < len(values); i++ {
 		blobs = append(blobs, &storage.Blob{Value: values[i]})
@@ -732,21 +989,75 @@ func (loader *segmentLoader) loadBloomFilter(ctx context.Context, segmentID int6
 
 	stats, err := storage.DeserializeStats(blobs)
 	if err != nil {
-		log.Warn("failed to deserialize stats", zap.Error(err))
-		return err
+		return nil, err
 	}
-	var size uint
-	for _, stat := range stats {
-		pkStat := &storage.PkStatistics{
+	return lo.Map(stats, func(stat *storage.PrimaryKeyStats, _ int) *storage.PkStatistics {
+		return &storage.PkStatistics{
 			PkFilter: stat.BF,
 			MinPK:    stat.MinPk,
 			MaxPK:    stat.MaxPk,
 		}
-		size += stat.BF.Cap()
-		bfs.AddHistoricalStats(pkStat)
+	}), nil
+}
+
+// loadPkIndex builds the pk index with the pk ranges in statslogs and the pk binlogs,
+// the bloom filters in statslogs are dropped after loaded.
+func (loader *segmentLoader) loadPkIndex(ctx context.Context, pkFieldID int64, loadInfo *querypb.SegmentLoadInfo) (*pkoracle.PkIndex, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("segmentID", loadInfo.GetSegmentID()),
+	)
+
+	startTs := time.Now()
+	var stats []*storage.PkStatistics
+	pkStatsBinlogs := loader.filterPKStatsBinlogs(loadInfo.GetStatslogs(), pkFieldID)
+	if len(pkStatsBinlogs) > 0 {
+		var err error
+		stats, err = loader.loadPkStats(ctx, pkStatsBinlogs)
+		if err != nil {
+			log.Warn("failed to load pk stats", zap.Error(err))
+			return nil, err
+		}
 	}
-	log.Info("Successfully load pk stats", zap.Duration("time", time.Since(startTs)), zap.Uint("size", size))
-	return nil
+
+	iCodec := storage.InsertCodec{}
+	insertData := storage.InsertData{
+		Data: make(map[int64]storage.FieldData),
+	}
+	for _, fieldBinlog := range loadInfo.GetBinlogPaths() {
+		if fieldBinlog.GetFieldID() != pkFieldID {
+			continue
+		}
+		futures := loader.loadFieldBinlogsAsync(ctx, fieldBinlog)
+		err := conc.AwaitAll(futures...)
+		if err != nil {
+			return nil, err
+		}
+		blobs := lo.Map(futures, func(future *conc.Future[*storage.Blob], _ int) *storage.Blob {
+			return future.Value()
+		})
+		_, _, _, err = iCodec.DeserializeInto(blobs, int(loadInfo.GetNumOfRows()), &insertData)
+		if err != nil {
+			log.Warn("failed to deserialize pk binlogs", zap.Error(err))
+			return nil, err
+		}
+	}
+	pks, ok := insertData.Data[pkFieldID]
+	if !ok {
+		err := fmt.Errorf("no binlog of pk field %d, segmentID = %d", pkFieldID, loadInfo.GetSegmentID())
+		log.Warn("failed to load pk binlogs", zap.Error(err))
+		return nil, err
+	}
+
+	pkIndex, err := pkoracle.NewPkIndex(loadInfo.GetSegmentID(), loadInfo.GetPartitionID(), commonpb.SegmentState_Sealed,
+		stats, pks, paramtable.Get().QueryNodeCfg.PkIndexXorFingerprintBits.GetAsInt())
+	if err != nil {
+		return nil, err
+	}
+	log.Info("Successfully load pk index",
+		zap.Duration("time", time.Since(startTs)),
+		zap.Int64("size", pkIndex.MemorySize()),
+	)
+	return pkIndex, nil
 }
 
 func (loader *segmentLoader) LoadDeltaLogs(ctx context.Context, segment *LocalSegment, deltaLogs []*datapb.FieldBinlog) error {
@@ -804,7 +1115,24 @@ func GetStorageSizeByIndexInfo(indexInfo *querypb.FieldIndexInfo) (uint64, uint6
 	return uint64(indexInfo.IndexSize), 0, nil
 }
 
-func (loader *segmentLoader) checkSegmentSize(collectionID UniqueID, segmentLoadInfos []*querypb.SegmentLoadInfo, concurrency int) error {
+// checkLoadSize returns the max concurrency level to load the segments without OOM.
+func (loader *segmentLoader) checkLoadSize(collectionID UniqueID, segmentLoadInfos []*querypb.SegmentLoadInfo, onDisk bool) (int, error) {
+	var (
+		concurrencyLevel = funcutil.Min(runtime.GOMAXPROCS(0), len(segmentLoadInfos))
+		err              error
+	)
+	for ; concurrencyLevel > 1; concurrencyLevel /= 2 {
+		err = loader.checkSegmentSize(collectionID, segmentLoadInfos, concurrencyLevel, onDisk)
+		if err == nil {
+			break
+		}
+	}
+	return concurrencyLevel, err
+}
+
+// checkSegmentSize checks the memory and disk usage after loading the segments.
+// The raw data of the fields without index are on disk if onDisk, they are only in memory while loading.
+func (loader *segmentLoader) checkSegmentSize(collectionID UniqueID, segmentLoadInfos []*querypb.SegmentLoadInfo, concurrency int, onDisk bool) error {
 	usedMem := hardware.GetUsedMemoryCount()
 	totalMem := hardware.GetMemoryCount()
 	if len(segmentLoadInfos) < concurrency {
@@ -814,6 +1142,8 @@ func (loader *segmentLoader) checkSegmentSize(collectionID UniqueID, segmentLoad
 	if usedMem == 0 || totalMem == 0 {
 		return fmt.Errorf("get memory failed when checkSegmentSize, collectionID = %d", collectionID)
 	}
+	// the memory reserved by the lazy fields being loaded is not in use yet
+	usedMem += loader.getCommittedMemSize()
 
 	usedMemAfterLoad := usedMem
 	maxSegmentSize := uint64(0)
@@ -826,6 +1156,7 @@ func (loader *segmentLoader) checkSegmentSize(collectionID UniqueID, segmentLoad
 
 	for _, loadInfo := range segmentLoadInfos {
 		oldUsedMem := usedMemAfterLoad
+		diskDataSize := uint64(0)
 		vecFieldID2IndexInfo := make(map[int64]*querypb.FieldIndexInfo)
 		for _, fieldIndexInfo := range loadInfo.IndexInfos {
 			if fieldIndexInfo.EnableIndex {
@@ -846,10 +1177,13 @@ func (loader *segmentLoader) checkSegmentSize(collectionID UniqueID, segmentLoad
 				}
 				usedMemAfterLoad += neededMemSize
 				usedLocalSizeAfterLoad += neededDiskSize
+			} else if onDisk {
+				diskDataSize += uint64(getFieldSizeFromFieldBinlog(fieldBinlog))
 			} else {
 				usedMemAfterLoad += uint64(getFieldSizeFromFieldBinlog(fieldBinlog))
 			}
 		}
+		usedLocalSizeAfterLoad += diskDataSize
 
 		// get size of state data
 		for _, fieldBinlog := range loadInfo.Statslogs {
@@ -861,8 +1195,8 @@ func (loader *segmentLoader) checkSegmentSize(collectionID UniqueID, segmentLoad
 			usedMemAfterLoad += uint64(getFieldSizeFromFieldBinlog(fieldBinlog))
 		}
 
-		if usedMemAfterLoad-oldUsedMem > maxSegmentSize {
-			maxSegmentSize = usedMemAfterLoad - oldUsedMem
+		if usedMemAfterLoad-oldUsedMem+diskDataSize > maxSegmentSize {
+			maxSegmentSize = usedMemAfterLoad - oldUsedMem + diskDataSize
 		}
 	}
 
@@ -876,6 +1210,7 @@ func (loader *segmentLoader) checkSegmentSize(collectionID UniqueID, segmentLoad
 	log.Info("predict memory and disk usage while loading (in MiB)",
 		zap.Int64("collectionID", collectionID),
 		zap.Int("concurrency", concurrency),
+		zap.Bool("onDisk", onDisk),
 		zap.Uint64("memUsage", toMB(memLoadingUsage)),
 		zap.Uint64("memUsageAfterLoad", toMB(usedMemAfterLoad)),
 		zap.Uint64("diskUsageAfterLoad", toMB(usedLocalSizeAfterLoad)))
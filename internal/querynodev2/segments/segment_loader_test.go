@@ -25,9 +25,11 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/hardware"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -352,6 +354,47 @@ func (suite *SegmentLoaderSuite) TestLoadWithMmap() {
 	suite.NoError(err)
 }
 
+func (suite *SegmentLoaderSuite) TestRequestMemory() {
+	loader := suite.loader.(*segmentLoader)
+	totalMem := hardware.GetMemoryCount()
+
+	suite.NoError(loader.requestMemory(1024))
+	suite.EqualValues(1024, loader.getCommittedMemSize())
+
+	// the lazy field can't be loaded if it exceeds the memory threshold
+	err := loader.requestMemory(totalMem)
+	suite.ErrorIs(err, ErrLazyLoadOverloaded)
+	suite.EqualValues(1024, loader.getCommittedMemSize())
+
+	loader.freeMemory(1024)
+	suite.EqualValues(0, loader.getCommittedMemSize())
+}
+
+func (suite *SegmentLoaderSuite) TestCheckSegmentSizeOnDisk() {
+	loader := suite.loader.(*segmentLoader)
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.DiskCapacityLimit.Key, "1048576")
+	defer paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.DiskCapacityLimit.Key)
+
+	// the raw data fits in memory only while loading
+	totalMem := hardware.GetMemoryCount()
+	usedMem := hardware.GetUsedMemoryCount()
+	threshold := float64(totalMem) * paramtable.Get().QueryNodeCfg.OverloadedMemoryThresholdPercentage.GetAsFloat()
+	factor := paramtable.Get().QueryNodeCfg.LoadMemoryUsageFactor.GetAsFloat()
+	size := int64((threshold - float64(usedMem)) / (factor + 0.5))
+	loadInfos := []*querypb.SegmentLoadInfo{{
+		SegmentID:    suite.segmentID,
+		PartitionID:  suite.partitionID,
+		CollectionID: suite.collectionID,
+		BinlogPaths: []*datapb.FieldBinlog{{
+			FieldID: 101,
+			Binlogs: []*datapb.Binlog{{LogSize: size}},
+		}},
+	}}
+
+	suite.Error(loader.checkSegmentSize(suite.collectionID, loadInfos, 1, false))
+	suite.NoError(loader.checkSegmentSize(suite.collectionID, loadInfos, 1, true))
+}
+
 func TestSegmentLoader(t *testing.T) {
 	suite.Run(t, &SegmentLoaderSuite{})
 }
@@ -10,8 +10,10 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -282,3 +284,57 @@ func fillFieldData(ctx context.Context, vcm storage.ChunkManager, dataPath strin
 		return fmt.Errorf("invalid data type: %s", fieldData.Type.String())
 	}
 }
+
+// fieldsOfPlan returns the IDs of the fields a serialized plan filters on or outputs.
+func fieldsOfPlan(serializedPlan []byte) ([]int64, error) {
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(serializedPlan, plan); err != nil {
+		return nil, err
+	}
+	fieldIDs := typeutil.NewSet(plan.GetOutputFieldIds()...)
+	if vectorAnns := plan.GetVectorAnns(); vectorAnns != nil {
+		fieldIDs.Insert(vectorAnns.GetFieldId())
+	}
+	collectColumns(proto.MessageReflect(plan), fieldIDs)
+	return fieldIDs.Collect(), nil
+}
+
+// predicatesOfPlan returns the filter expression of a serialized plan, nil if there is no filter.
+func predicatesOfPlan(serializedPlan []byte) (*planpb.Expr, error) {
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(serializedPlan, plan); err != nil {
+		return nil, err
+	}
+	switch node := plan.GetNode().(type) {
+	case *planpb.PlanNode_VectorAnns:
+		return node.VectorAnns.GetPredicates(), nil
+	case *planpb.PlanNode_Query:
+		return node.Query.GetPredicates(), nil
+	case *planpb.PlanNode_Predicates:
+		return node.Predicates, nil
+	}
+	return nil, nil
+}
+
+var columnInfoName = protoreflect.FullName(proto.MessageName(&planpb.ColumnInfo{}))
+
+// collectColumns collects the field IDs of all the columns referred by the expressions of msg.
+func collectColumns(msg protoreflect.Message, fieldIDs typeutil.Set[int64]) {
+	if msg.Descriptor().FullName() == columnInfoName {
+		fieldIDs.Insert(msg.Get(msg.Descriptor().Fields().ByName("field_id")).Int())
+		return
+	}
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap() || fd.Message() == nil:
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				collectColumns(list.Get(i).Message(), fieldIDs)
+			}
+		default:
+			collectColumns(v.Message(), fieldIDs)
+		}
+		return true
+	})
+}
@@ -97,6 +97,8 @@ type QueryNode struct {
 	pipelineManager     pipeline.Manager
 	subscribingChannels *typeutil.ConcurrentSet[string]
 	delegators          *typeutil.ConcurrentMap[string, delegator.ShardDelegator]
+	// the copies of sealed segments with new indexes, waiting to be switched in
+	pendingSegments *typeutil.ConcurrentMap[int64, segments.Segment]
 
 	// segment loader
 	loader segments.Loader
@@ -267,6 +269,22 @@ func (node *QueryNode) Init() error {
 			initError = err
 			return
 		}
+		if paramtable.Get().QueryNodeCfg.DiskCacheEnabled.GetAsBool() {
+			cacheDir := paramtable.Get().QueryNodeCfg.DiskCacheDirPath.GetValue()
+			if cacheDir == "" {
+				cacheDir = path.Join(paramtable.Get().LocalStorageCfg.Path.GetValue(), "disk_cache")
+			}
+			node.vectorStorage, err = storage.NewDiskCacheChunkManager(node.ctx,
+				node.vectorStorage,
+				cacheDir,
+				paramtable.Get().QueryNodeCfg.DiskCacheCapacity.GetAsInt64(),
+			)
+			if err != nil {
+				log.Error("QueryNode init disk cache failed", zap.Error(err))
+				initError = err
+				return
+			}
+		}
 
 		node.etcdKV = etcdkv.NewEtcdKV(node.etcdCli, paramtable.Get().EtcdCfg.MetaRootPath.GetValue())
 		log.Info("queryNode try to connect etcd success", zap.String("MetaRootPath", paramtable.Get().EtcdCfg.MetaRootPath.GetValue()))
@@ -299,6 +317,7 @@ func (node *QueryNode) Init() error {
 			return cluster.NewRemoteWorker(client), nil
 		})
 		node.delegators = typeutil.NewConcurrentMap[string, delegator.ShardDelegator]()
+		node.pendingSegments = typeutil.NewConcurrentMap[int64, segments.Segment]()
 		node.subscribingChannels = typeutil.NewConcurrentSet[string]()
 		node.manager = segments.NewManager()
 		node.loader = segments.NewLoader(node.manager.Collection, node.vectorStorage)
@@ -355,6 +355,10 @@ func (node *QueryNode) LoadPartitions(ctx context.Context, req *querypb.LoadPart
 		return merr.Status(merr.WrapErrCollectionNotLoaded(req.GetCollectionID(), "failed to load partitions")), nil
 	}
 	collection.AddPartition(req.GetPartitionIDs()...)
+	// QueryCoord pushes the schema of the loaded collection when the collection properties are altered
+	if req.GetSchema() != nil {
+		node.manager.Collection.Put(req.GetCollectionID(), req.GetSchema(), nil)
+	}
 
 	return merr.Status(nil), nil
 }
@@ -412,6 +416,13 @@ func (node *QueryNode) LoadSegments(ctx context.Context, req *querypb.LoadSegmen
 		return util.SuccessStatus(), nil
 	}
 
+	switch req.GetLoadScope() {
+	case querypb.LoadScope_Index:
+		return node.loadIndex(ctx, req), nil
+	case querypb.LoadScope_SwitchIndex:
+		return node.switchIndex(ctx, req), nil
+	}
+
 	// Actual load segment
 	log.Info("start to load segments...")
 	loaded, err := node.loader.Load(ctx,
@@ -506,6 +517,9 @@ func (node *QueryNode) ReleaseSegments(ctx context.Context, req *querypb.Release
 	log.Info("start to release segments")
 	for _, id := range req.GetSegmentIDs() {
 		node.manager.Segment.Remove(id, req.GetScope())
+		if req.GetScope() != querypb.DataScope_Streaming {
+			node.removePendingSegment(id)
+		}
 	}
 
 	return util.SuccessStatus(), nil
@@ -892,6 +906,9 @@ func (node *QueryNode) GetDataDistribution(ctx context.Context, req *querypb.Get
 			Channel:            s.Shard(),
 			Version:            s.Version(),
 			LastDeltaTimestamp: s.LastDeltaTimestamp(),
+			IndexInfo: lo.Map(s.Indexes(), func(info *segments.IndexedFieldInfo, _ int) *querypb.FieldIndexInfo {
+				return info.IndexInfo
+			}),
 		})
 	}
 
@@ -1069,6 +1086,10 @@ func (node *QueryNode) Delete(ctx context.Context, req *querypb.DeleteRequest) (
 	)
 
 	segments := node.manager.Segment.GetBy(segments.WithID(req.GetSegmentId()))
+	// the pending copy with new indexes shall not miss any delete
+	if pending, ok := node.pendingSegments.Get(req.GetSegmentId()); ok {
+		segments = append(segments, pending)
+	}
 	if len(segments) == 0 {
 		err := merr.WrapErrSegmentNotFound(req.GetSegmentId())
 		log.Warn("segment not found for delete")
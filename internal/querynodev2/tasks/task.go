@@ -138,6 +138,8 @@ func (t *SearchTask) Execute() error {
 		return err
 	}
 	defer segments.DeleteSearchResultDataBlobs(blobs)
+	costs := segments.SearchCosts(results)
+	reduceCost := tr.ElapseSpan()
 
 	for i := range t.originNqs {
 		blob, err := segments.GetSearchResultDataBlob(blobs, i)
@@ -159,7 +161,7 @@ func (t *SearchTask) Execute() error {
 		metrics.QueryNodeReduceLatency.WithLabelValues(
 			fmt.Sprint(paramtable.GetNodeID()),
 			metrics.SearchLabel).
-			Observe(float64(tr.ElapseSpan().Milliseconds()))
+			Observe(float64(reduceCost.Milliseconds()))
 
 		task.result = &internalpb.SearchResults{
 			Status:         util.WrapStatus(commonpb.ErrorCode_Success, ""),
@@ -169,6 +171,8 @@ func (t *SearchTask) Execute() error {
 			SlicedBlob:     bs,
 			SlicedOffset:   1,
 			SlicedNumCount: 1,
+			ReduceMs:       reduceCost.Milliseconds(),
+			SegmentCosts:   costs,
 		}
 	}
 	return nil
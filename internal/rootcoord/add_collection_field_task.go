@@ -0,0 +1,220 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+	"go.uber.org/zap"
+)
+
+// isAddFieldRequest checks whether an alter collection request asks to add a field.
+func isAddFieldRequest(req *milvuspb.AlterCollectionRequest) bool {
+	for _, kv := range req.GetProperties() {
+		if kv.GetKey() == common.CollectionAddFieldKey {
+			return true
+		}
+	}
+	return false
+}
+
+// getSchemaVersion returns the schema version recorded in the collection properties, 0 if not recorded.
+func getSchemaVersion(properties []*commonpb.KeyValuePair) (int64, error) {
+	for _, kv := range properties {
+		if kv.GetKey() == common.CollectionSchemaVersionKey {
+			return strconv.ParseInt(kv.GetValue(), 10, 64)
+		}
+	}
+	return 0, nil
+}
+
+// setSchemaVersion returns a copy of properties with the schema version set to version.
+func setSchemaVersion(properties []*commonpb.KeyValuePair, version int64) []*commonpb.KeyValuePair {
+	ret := make([]*commonpb.KeyValuePair, 0, len(properties)+1)
+	for _, kv := range properties {
+		if kv.GetKey() != common.CollectionSchemaVersionKey {
+			ret = append(ret, &commonpb.KeyValuePair{Key: kv.GetKey(), Value: kv.GetValue()})
+		}
+	}
+	return append(ret, &commonpb.KeyValuePair{
+		Key:   common.CollectionSchemaVersionKey,
+		Value: strconv.FormatInt(version, 10),
+	})
+}
+
+type addCollectionFieldTask struct {
+	baseTask
+	Req *milvuspb.AlterCollectionRequest
+
+	field   *schemapb.FieldSchema
+	compact bool
+}
+
+func (a *addCollectionFieldTask) Prepare(ctx context.Context) error {
+	if a.Req.GetCollectionName() == "" {
+		return fmt.Errorf("add collection field failed, collection name does not exists")
+	}
+
+	for _, kv := range a.Req.GetProperties() {
+		switch kv.GetKey() {
+		case common.CollectionAddFieldKey:
+			field := &schemapb.FieldSchema{}
+			if err := jsonpb.UnmarshalString(kv.GetValue(), field); err != nil {
+				return fmt.Errorf("failed to parse the field to add, err: %w", err)
+			}
+			a.field = field
+		case common.CollectionAddFieldCompactKey:
+			compact, err := strconv.ParseBool(kv.GetValue())
+			if err != nil {
+				return fmt.Errorf("invalid value of %s: %s", common.CollectionAddFieldCompactKey, kv.GetValue())
+			}
+			a.compact = compact
+		default:
+			return fmt.Errorf("altering property %s is not allowed when adding a field", kv.GetKey())
+		}
+	}
+
+	return a.validateField()
+}
+
+func (a *addCollectionFieldTask) validateField() error {
+	field := a.field
+	if field == nil {
+		return fmt.Errorf("no field to add")
+	}
+	if strings.TrimSpace(field.GetName()) == "" {
+		return fmt.Errorf("field name should not be empty")
+	}
+	if field.GetName() == RowIDFieldName || field.GetName() == TimeStampFieldName {
+		return fmt.Errorf("cannot add system field: %s", field.GetName())
+	}
+	if field.GetIsPrimaryKey() || field.GetAutoID() {
+		return fmt.Errorf("cannot add primary key or auto id field: %s", field.GetName())
+	}
+
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32,
+		schemapb.DataType_Int64, schemapb.DataType_Float, schemapb.DataType_Double:
+	case schemapb.DataType_VarChar:
+		maxLengthStr, err := funcutil.GetAttrByKeyFromRepeatedKV("max_length", field.GetTypeParams())
+		if err != nil {
+			return fmt.Errorf("the max_length was not specified, field: %s", field.GetName())
+		}
+		maxLength, err := strconv.Atoi(maxLengthStr)
+		if err != nil || maxLength <= 0 {
+			return fmt.Errorf("invalid max_length %s, field: %s", maxLengthStr, field.GetName())
+		}
+		if len(field.GetDefaultValue().GetStringData()) > maxLength {
+			return fmt.Errorf("the length of default value exceeds max_length (%d), field: %s", maxLength, field.GetName())
+		}
+	default:
+		return fmt.Errorf("only scalar field can be added, field: %s, type: %s", field.GetName(), field.GetDataType().String())
+	}
+
+	if !typeutil.IsFieldFillable(field) {
+		return fmt.Errorf("the added field must be nullable or have a default value, field: %s", field.GetName())
+	}
+	return typeutil.ValidateDefaultValue(field)
+}
+
+func (a *addCollectionFieldTask) Execute(ctx context.Context) error {
+	oldColl, err := a.core.meta.GetCollectionByName(ctx, a.Req.GetCollectionName(), a.ts)
+	if err != nil {
+		log.Warn("get collection failed during adding collection field",
+			zap.String("collectionName", a.Req.GetCollectionName()), zap.Uint64("ts", a.ts))
+		return err
+	}
+
+	maxFieldID := int64(StartOfUserFieldID - 1)
+	for _, field := range oldColl.Fields {
+		if field.Name == a.field.GetName() {
+			return fmt.Errorf("field %s already exists in collection %s", field.Name, oldColl.Name)
+		}
+		if field.FieldID > maxFieldID {
+			maxFieldID = field.FieldID
+		}
+	}
+
+	version, err := getSchemaVersion(oldColl.Properties)
+	if err != nil {
+		return fmt.Errorf("invalid schema version of collection %s, err: %w", oldColl.Name, err)
+	}
+
+	newField := model.UnmarshalFieldModel(a.field)
+	newField.FieldID = maxFieldID + 1
+	newField.State = schemapb.FieldState_FieldCreated
+
+	newColl := oldColl.Clone()
+	newColl.Fields = append(newColl.Fields, newField)
+	newColl.Properties = setSchemaVersion(oldColl.Properties, version+1)
+
+	log.Info("add collection field",
+		zap.String("collectionName", oldColl.Name),
+		zap.Int64("collectionID", oldColl.CollectionID),
+		zap.String("fieldName", newField.Name),
+		zap.Int64("fieldID", newField.FieldID),
+		zap.Int64("schemaVersion", version+1))
+
+	ts := a.GetTs()
+	redoTask := newBaseRedoTask(a.core.stepExecutor)
+	redoTask.AddSyncStep(&addCollectionFieldStep{
+		baseStep: baseStep{core: a.core},
+		oldColl:  oldColl,
+		newColl:  newColl,
+		ts:       ts,
+	})
+
+	redoTask.AddSyncStep(&expireCacheStep{
+		baseStep:        baseStep{core: a.core},
+		collectionNames: append([]string{oldColl.Name}, a.core.meta.ListAliasesByID(oldColl.CollectionID)...),
+		collectionID:    oldColl.CollectionID,
+		ts:              ts,
+	})
+
+	redoTask.AddSyncStep(&BroadcastAlteredCollectionStep{
+		baseStep: baseStep{core: a.core},
+		req: &milvuspb.AlterCollectionRequest{
+			Base:           a.Req.GetBase(),
+			DbName:         a.Req.GetDbName(),
+			CollectionName: oldColl.Name,
+			CollectionID:   oldColl.CollectionID,
+			Properties:     newColl.Properties,
+		},
+		core: a.core,
+	})
+
+	if a.compact {
+		redoTask.AddAsyncStep(&manualCompactionStep{
+			baseStep:     baseStep{core: a.core},
+			collectionID: oldColl.CollectionID,
+		})
+	}
+
+	return redoTask.Execute(ctx)
+}
@@ -0,0 +1,252 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func newAddFieldRequest(t *testing.T, field *schemapb.FieldSchema, extra ...*commonpb.KeyValuePair) *milvuspb.AlterCollectionRequest {
+	value, err := (&jsonpb.Marshaler{}).MarshalToString(field)
+	require.NoError(t, err)
+	return &milvuspb.AlterCollectionRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+		CollectionName: "cn",
+		Properties:     append([]*commonpb.KeyValuePair{{Key: common.CollectionAddFieldKey, Value: value}}, extra...),
+	}
+}
+
+func Test_addCollectionFieldTask_Prepare(t *testing.T) {
+	defaultInt64 := &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 1}}
+
+	t.Run("is add field request", func(t *testing.T) {
+		assert.False(t, isAddFieldRequest(&milvuspb.AlterCollectionRequest{}))
+		req := newAddFieldRequest(t, &schemapb.FieldSchema{Name: "f"})
+		assert.True(t, isAddFieldRequest(req))
+	})
+
+	t.Run("empty collection name", func(t *testing.T) {
+		req := newAddFieldRequest(t, &schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64, DefaultValue: defaultInt64})
+		req.CollectionName = ""
+		task := &addCollectionFieldTask{Req: req}
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("invalid field json", func(t *testing.T) {
+		task := &addCollectionFieldTask{Req: &milvuspb.AlterCollectionRequest{
+			CollectionName: "cn",
+			Properties:     []*commonpb.KeyValuePair{{Key: common.CollectionAddFieldKey, Value: "{"}},
+		}}
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("other properties", func(t *testing.T) {
+		req := newAddFieldRequest(t, &schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64, DefaultValue: defaultInt64},
+			&commonpb.KeyValuePair{Key: common.CollectionTTLConfigKey, Value: "10"})
+		task := &addCollectionFieldTask{Req: req}
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("invalid compact flag", func(t *testing.T) {
+		req := newAddFieldRequest(t, &schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64, DefaultValue: defaultInt64},
+			&commonpb.KeyValuePair{Key: common.CollectionAddFieldCompactKey, Value: "yes?"})
+		task := &addCollectionFieldTask{Req: req}
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("invalid fields", func(t *testing.T) {
+		fields := []*schemapb.FieldSchema{
+			{Name: "", DataType: schemapb.DataType_Int64, DefaultValue: defaultInt64},
+			{Name: RowIDFieldName, DataType: schemapb.DataType_Int64, DefaultValue: defaultInt64},
+			{Name: "f", DataType: schemapb.DataType_Int64, DefaultValue: defaultInt64, IsPrimaryKey: true},
+			{Name: "f", DataType: schemapb.DataType_Int64, DefaultValue: defaultInt64, AutoID: true},
+			{Name: "f", DataType: schemapb.DataType_FloatVector},
+			{Name: "f", DataType: schemapb.DataType_Int64},
+			{Name: "f", DataType: schemapb.DataType_Int64, DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_BoolData{BoolData: true}}},
+			{Name: "f", DataType: schemapb.DataType_VarChar, DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "a"}}},
+			{
+				Name:         "f",
+				DataType:     schemapb.DataType_VarChar,
+				TypeParams:   []*commonpb.KeyValuePair{{Key: "max_length", Value: "1"}},
+				DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "ab"}},
+			},
+		}
+		for _, field := range fields {
+			task := &addCollectionFieldTask{Req: newAddFieldRequest(t, field)}
+			assert.Error(t, task.Prepare(context.Background()), field.String())
+		}
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		req := newAddFieldRequest(t, &schemapb.FieldSchema{
+			Name:       "f",
+			DataType:   schemapb.DataType_VarChar,
+			TypeParams: []*commonpb.KeyValuePair{{Key: "max_length", Value: "8"}, {Key: common.FieldNullableKey, Value: "true"}},
+		}, &commonpb.KeyValuePair{Key: common.CollectionAddFieldCompactKey, Value: "true"})
+		task := &addCollectionFieldTask{Req: req}
+		assert.NoError(t, task.Prepare(context.Background()))
+		assert.Equal(t, "f", task.field.GetName())
+		assert.True(t, task.compact)
+	})
+}
+
+func Test_addCollectionFieldTask_Execute(t *testing.T) {
+	newField := &schemapb.FieldSchema{
+		Name:         "f",
+		DataType:     schemapb.DataType_Int64,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 1}},
+	}
+	getCollection := func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		return &model.Collection{
+			CollectionID: 1,
+			Name:         "cn",
+			Fields: []*model.Field{
+				{FieldID: RowIDField, Name: RowIDFieldName},
+				{FieldID: TimeStampField, Name: TimeStampFieldName},
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true},
+				{FieldID: 101, Name: "vec"},
+			},
+			Properties: []*commonpb.KeyValuePair{
+				{Key: common.CollectionTTLConfigKey, Value: "10"},
+				{Key: common.CollectionSchemaVersionKey, Value: "1"},
+			},
+		}, nil
+	}
+	listAliases := func(collID UniqueID) []string {
+		return []string{"alias"}
+	}
+
+	t.Run("failed to get collection", func(t *testing.T) {
+		core := newTestCore(withInvalidMeta())
+		task := &addCollectionFieldTask{baseTask: baseTask{core: core}, Req: newAddFieldRequest(t, newField)}
+		assert.NoError(t, task.Prepare(context.Background()))
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("field already exists", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = getCollection
+		core := newTestCore(withMeta(meta))
+		field := &schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_Int64, DefaultValue: newField.DefaultValue}
+		task := &addCollectionFieldTask{baseTask: baseTask{core: core}, Req: newAddFieldRequest(t, field)}
+		assert.NoError(t, task.Prepare(context.Background()))
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("add step failed", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = getCollection
+		meta.ListAliasesByIDFunc = listAliases
+		meta.AddCollectionFieldFunc = func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error {
+			return errors.New("mock")
+		}
+		core := newTestCore(withMeta(meta))
+		task := &addCollectionFieldTask{baseTask: baseTask{core: core}, Req: newAddFieldRequest(t, newField)}
+		assert.NoError(t, task.Prepare(context.Background()))
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("add successfully", func(t *testing.T) {
+		var added *model.Collection
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = getCollection
+		meta.ListAliasesByIDFunc = listAliases
+		meta.AddCollectionFieldFunc = func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error {
+			added = newColl
+			return nil
+		}
+
+		var broadcast *milvuspb.AlterCollectionRequest
+		compacted := make(chan UniqueID, 1)
+		broker := newMockBroker()
+		broker.BroadcastAlteredCollectionFunc = func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error {
+			broadcast = req
+			return nil
+		}
+		broker.ManualCompactionFunc = func(ctx context.Context, collectionID UniqueID) error {
+			compacted <- collectionID
+			return nil
+		}
+
+		core := newTestCore(withValidProxyManager(), withMeta(meta), withBroker(broker))
+		stepExecutor := newMockStepExecutor()
+		stepExecutor.AddStepsFunc = func(s *stepStack) {
+			for _, step := range s.steps {
+				_, err := step.Execute(context.Background())
+				assert.NoError(t, err)
+			}
+		}
+		core.stepExecutor = stepExecutor
+		task := &addCollectionFieldTask{
+			baseTask: baseTask{core: core},
+			Req: newAddFieldRequest(t, newField,
+				&commonpb.KeyValuePair{Key: common.CollectionAddFieldCompactKey, Value: "true"}),
+		}
+		assert.NoError(t, task.Prepare(context.Background()))
+		assert.NoError(t, task.Execute(context.Background()))
+
+		require.NotNil(t, added)
+		assert.Equal(t, 5, len(added.Fields))
+		field := added.Fields[4]
+		assert.Equal(t, int64(102), field.FieldID)
+		assert.Equal(t, "f", field.Name)
+		assert.Equal(t, int64(1), field.DefaultValue.GetLongData())
+
+		version, err := getSchemaVersion(added.Properties)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), version)
+		assert.Equal(t, 2, len(added.Properties))
+
+		require.NotNil(t, broadcast)
+		assert.Equal(t, int64(1), broadcast.GetCollectionID())
+		assert.Equal(t, added.Properties, broadcast.GetProperties())
+
+		assert.Equal(t, UniqueID(1), <-compacted)
+	})
+}
+
+func Test_schemaVersion(t *testing.T) {
+	version, err := getSchemaVersion(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), version)
+
+	properties := setSchemaVersion([]*commonpb.KeyValuePair{{Key: "k", Value: "v"}}, 3)
+	version, err = getSchemaVersion(properties)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), version)
+
+	properties = setSchemaVersion(properties, 4)
+	assert.Equal(t, 2, len(properties))
+	version, err = getSchemaVersion(properties)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), version)
+
+	_, err = getSchemaVersion([]*commonpb.KeyValuePair{{Key: common.CollectionSchemaVersionKey, Value: "x"}})
+	assert.Error(t, err)
+}
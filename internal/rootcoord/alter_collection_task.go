@@ -19,12 +19,15 @@ package rootcoord
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/cockroachdb/errors"
 
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 )
 
@@ -38,6 +41,24 @@ func (a *alterCollectionTask) Prepare(ctx context.Context) error {
 		return fmt.Errorf("alter collection failed, collection name does not exists")
 	}
 
+	return checkSegmentPolicyProperties(a.Req.GetProperties())
+}
+
+// checkSegmentPolicyProperties checks the collection properties overriding the segment policies are positive numbers.
+func checkSegmentPolicyProperties(properties []*commonpb.KeyValuePair) error {
+	for _, kv := range properties {
+		switch kv.GetKey() {
+		case common.CollectionSegmentMaxSizeKey,
+			common.CollectionSegmentMaxLifetimeKey,
+			common.CollectionSegmentMaxIdleTimeKey,
+			common.CollectionSyncPeriodKey:
+			v, err := strconv.ParseFloat(kv.GetValue(), 64)
+			if err != nil || v <= 0 {
+				return fmt.Errorf("alter collection failed, the value of property %s must be a positive number, but got '%s'",
+					kv.GetKey(), kv.GetValue())
+			}
+		}
+	}
 	return nil
 }
 
@@ -56,6 +77,10 @@ func (a *alterCollectionTask) Execute(ctx context.Context) error {
 
 	newColl := oldColl.Clone()
 	newColl.Properties = a.Req.GetProperties()
+	// schema version is maintained by rootcoord, it should survive the replacement of properties.
+	if version, err := getSchemaVersion(oldColl.Properties); err == nil && version > 0 {
+		newColl.Properties = setSchemaVersion(newColl.Properties, version)
+	}
 
 	ts := a.GetTs()
 	redoTask := newBaseRedoTask(a.core.stepExecutor)
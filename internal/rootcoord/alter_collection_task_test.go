@@ -49,6 +49,40 @@ func Test_alterCollectionTask_Prepare(t *testing.T) {
 		err := task.Prepare(context.Background())
 		assert.NoError(t, err)
 	})
+
+	t.Run("invalid segment policy properties", func(t *testing.T) {
+		for _, key := range []string{
+			common.CollectionSegmentMaxSizeKey,
+			common.CollectionSegmentMaxLifetimeKey,
+			common.CollectionSegmentMaxIdleTimeKey,
+			common.CollectionSyncPeriodKey,
+		} {
+			for _, value := range []string{"invalid", "0", "-1"} {
+				task := &alterCollectionTask{
+					Req: &milvuspb.AlterCollectionRequest{
+						Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+						CollectionName: "cn",
+						Properties:     []*commonpb.KeyValuePair{{Key: key, Value: value}},
+					},
+				}
+				err := task.Prepare(context.Background())
+				assert.Error(t, err, "key: %s, value: %s", key, value)
+			}
+		}
+
+		task := &alterCollectionTask{
+			Req: &milvuspb.AlterCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+				CollectionName: "cn",
+				Properties: []*commonpb.KeyValuePair{
+					{Key: common.CollectionSegmentMaxSizeKey, Value: "512"},
+					{Key: common.CollectionSyncPeriodKey, Value: "0.5"},
+				},
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.NoError(t, err)
+	})
 }
 
 func Test_alterCollectionTask_Execute(t *testing.T) {
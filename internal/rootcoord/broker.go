@@ -65,6 +65,7 @@ type Broker interface {
 	DescribeIndex(ctx context.Context, colID UniqueID) (*indexpb.DescribeIndexResponse, error)
 
 	BroadcastAlteredCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) error
+	ManualCompaction(ctx context.Context, collectionID UniqueID) error
 }
 
 type ServerBroker struct {
@@ -294,6 +295,24 @@ func (b *ServerBroker) BroadcastAlteredCollection(ctx context.Context, req *milv
 	return nil
 }
 
+func (b *ServerBroker) ManualCompaction(ctx context.Context, collectionID UniqueID) error {
+	log := log.Ctx(ctx).With(zap.Int64("collection", collectionID))
+	log.Info("triggering manual compaction")
+	resp, err := b.s.dataCoord.ManualCompaction(ctx, &milvuspb.ManualCompactionRequest{
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return fmt.Errorf("manual compaction failed, reason: %s", resp.GetStatus().GetReason())
+	}
+
+	log.Info("done to trigger manual compaction", zap.Int64("compactionID", resp.GetCompactionID()))
+	return nil
+}
+
 func (b *ServerBroker) DescribeIndex(ctx context.Context, colID UniqueID) (*indexpb.DescribeIndexResponse, error) {
 	return b.s.dataCoord.DescribeIndex(ctx, &indexpb.DescribeIndexRequest{
 		CollectionID: colID,
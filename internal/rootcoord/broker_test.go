@@ -360,3 +360,26 @@ func TestServerBroker_GcConfirm(t *testing.T) {
 		assert.True(t, broker.GcConfirm(context.Background(), 100, 10000))
 	})
 }
+
+func TestServerBroker_ManualCompaction(t *testing.T) {
+	t.Run("failed to execute", func(t *testing.T) {
+		c := newTestCore(withInvalidDataCoord())
+		b := newServerBroker(c)
+		err := b.ManualCompaction(context.Background(), 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("non success error code on execute", func(t *testing.T) {
+		c := newTestCore(withFailedDataCoord())
+		b := newServerBroker(c)
+		err := b.ManualCompaction(context.Background(), 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		c := newTestCore(withValidDataCoord())
+		b := newServerBroker(c)
+		err := b.ManualCompaction(context.Background(), 1)
+		assert.NoError(t, err)
+	})
+}
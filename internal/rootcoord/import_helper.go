@@ -35,6 +35,7 @@ type GetSegmentStatesFunc func(ctx context.Context, req *datapb.GetSegmentStates
 type DescribeIndexFunc func(ctx context.Context, colID UniqueID) (*indexpb.DescribeIndexResponse, error)
 type GetSegmentIndexStateFunc func(ctx context.Context, collID UniqueID, indexName string, segIDs []UniqueID) ([]*indexpb.SegmentIndexState, error)
 type UnsetIsImportingStateFunc func(context.Context, *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error)
+type DiscoverImportFilesFunc func(ctx context.Context, prefix string) ([][]string, error)
 
 type ImportFactory interface {
 	NewGetCollectionNameFunc() GetCollectionNameFunc
@@ -44,6 +45,7 @@ type ImportFactory interface {
 	NewDescribeIndexFunc() DescribeIndexFunc
 	NewGetSegmentIndexStateFunc() GetSegmentIndexStateFunc
 	NewUnsetIsImportingStateFunc() UnsetIsImportingStateFunc
+	NewDiscoverImportFilesFunc() DiscoverImportFilesFunc
 }
 
 type ImportFactoryImpl struct {
@@ -78,6 +80,10 @@ func (f ImportFactoryImpl) NewUnsetIsImportingStateFunc() UnsetIsImportingStateF
 	return UnsetIsImportingStateWithCore(f.c)
 }
 
+func (f ImportFactoryImpl) NewDiscoverImportFilesFunc() DiscoverImportFilesFunc {
+	return DiscoverImportFilesWithCore(f.c)
+}
+
 func NewImportFactory(c *Core) ImportFactory {
 	return &ImportFactoryImpl{c: c}
 }
@@ -134,3 +140,14 @@ func UnsetIsImportingStateWithCore(c *Core) UnsetIsImportingStateFunc {
 		return c.broker.UnsetIsImportingState(ctx, req)
 	}
 }
+
+func DiscoverImportFilesWithCore(c *Core) DiscoverImportFilesFunc {
+	return func(ctx context.Context, prefix string) ([][]string, error) {
+		cm, err := c.factory.NewPersistentStorageChunkManager(ctx)
+		if err != nil {
+			log.Error("Core failed to create chunk manager to discover import files", zap.Error(err))
+			return nil, err
+		}
+		return discoverImportFiles(ctx, cm, prefix)
+	}
+}
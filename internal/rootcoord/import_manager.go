@@ -37,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/util/importutil"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -80,6 +81,7 @@ type importManager struct {
 	getCollectionName         func(collID, partitionID typeutil.UniqueID) (string, string, error)
 	callGetSegmentStates      func(ctx context.Context, req *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error)
 	callUnsetIsImportingState func(context.Context, *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error)
+	callDiscoverImportFiles   func(ctx context.Context, prefix string) ([][]string, error)
 }
 
 // newImportManager helper function to create a importManager
@@ -88,7 +90,8 @@ func newImportManager(ctx context.Context, client kv.TxnKV,
 	importService func(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error),
 	getSegmentStates func(ctx context.Context, req *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error),
 	getCollectionName func(collID, partitionID typeutil.UniqueID) (string, string, error),
-	unsetIsImportingState func(context.Context, *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error)) *importManager {
+	unsetIsImportingState func(context.Context, *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error),
+	discoverImportFiles func(ctx context.Context, prefix string) ([][]string, error)) *importManager {
 	mgr := &importManager{
 		ctx:                       ctx,
 		taskStore:                 client,
@@ -104,6 +107,7 @@ func newImportManager(ctx context.Context, client kv.TxnKV,
 		callGetSegmentStates:      getSegmentStates,
 		getCollectionName:         getCollectionName,
 		callUnsetIsImportingState: unsetIsImportingState,
+		callDiscoverImportFiles:   discoverImportFiles,
 	}
 	return mgr
 }
@@ -397,7 +401,10 @@ func (m *importManager) importJob(ctx context.Context, req *milvuspb.ImportReque
 		}
 	}
 
-	if req == nil || len(req.Files) == 0 {
+	options := funcutil.KeyValuePair2Map(req.GetOptions())
+	prefix, isManifest := options[importutil.Prefix]
+	retryJob, isRetry := options[importutil.RetryJob]
+	if req == nil || (len(req.GetFiles()) == 0 && !isManifest && !isRetry) {
 		return returnErrorFunc("import request is empty")
 	}
 
@@ -405,6 +412,37 @@ func (m *importManager) importJob(ctx context.Context, req *milvuspb.ImportReque
 		return returnErrorFunc("import service is not available")
 	}
 
+	// files of each task, for a manifest import job, the files are discovered under the prefix,
+	// or picked from the failed tasks of the job to retry.
+	var (
+		taskFiles [][]string
+		jobID     int64
+		err       error
+	)
+	switch {
+	case len(req.GetFiles()) > 0:
+	case isRetry:
+		if jobID, err = strconv.ParseInt(retryJob, 10, 64); err != nil {
+			return returnErrorFunc(fmt.Sprintf("invalid import job ID '%s' to retry", retryJob))
+		}
+		if taskFiles, err = m.failedFilesOfJob(jobID, cID); err != nil {
+			return returnErrorFunc(err.Error())
+		}
+	case isManifest:
+		if m.callDiscoverImportFiles == nil {
+			return returnErrorFunc("import file discovery is not available")
+		}
+		if taskFiles, err = m.callDiscoverImportFiles(ctx, prefix); err != nil {
+			return returnErrorFunc(err.Error())
+		}
+		if jobID, _, err = m.idAllocator(1); err != nil {
+			log.Error("failed to allocate ID for import job", zap.Error(err))
+			return returnErrorFunc(err.Error())
+		}
+	default:
+		return returnErrorFunc("import request is empty")
+	}
+
 	resp := &milvuspb.ImportResponse{
 		Status: &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_Success,
@@ -415,77 +453,66 @@ func (m *importManager) importJob(ctx context.Context, req *milvuspb.ImportReque
 	log.Debug("receive import job",
 		zap.String("collection name", req.GetCollectionName()),
 		zap.Int64("collection ID", cID),
-		zap.Int64("partition ID", pID))
-	err := func() error {
+		zap.Int64("partition ID", pID),
+		zap.Int64("job ID", jobID))
+	err = func() error {
 		m.pendingLock.Lock()
 		defer m.pendingLock.Unlock()
 
 		capacity := cap(m.pendingTasks)
 		length := len(m.pendingTasks)
+		// file number of each group in the task, nil if the task is not batched
+		var taskGroups [][]int
 
-		isRowBased, err := m.isRowbased(req.GetFiles())
-		if err != nil {
-			return err
-		}
-
-		taskCount := 1
-		if isRowBased {
-			taskCount = len(req.Files)
+		if len(req.GetFiles()) > 0 {
+			isRowBased, err := m.isRowbased(req.GetFiles())
+			if err != nil {
+				return err
+			}
+			if isRowBased {
+				// For row-based importing, each file makes a task.
+				for _, file := range req.GetFiles() {
+					taskFiles = append(taskFiles, []string{file})
+				}
+			} else {
+				// for column-based, all files is a task
+				taskFiles = [][]string{req.GetFiles()}
+			}
+		} else {
+			rowBased := make([]bool, 0, len(taskFiles))
+			for _, files := range taskFiles {
+				isRowBased, err := m.isRowbased(files)
+				if err != nil {
+					return err
+				}
+				rowBased = append(rowBased, isRowBased)
+			}
+			// the file groups of a manifest import job are batched into fewer tasks if the task queue has no room
+			if capacity-length < len(taskFiles) {
+				taskFiles, taskGroups = batchImportFiles(taskFiles, rowBased, capacity-length)
+			}
 		}
 
 		// task queue size has a limit, return error if import request contains too many data files, and skip entire job
-		if capacity-length < taskCount {
-			err := fmt.Errorf("import task queue max size is %v, currently there are %v tasks is pending. Not able to execute this request with %v tasks", capacity, length, taskCount)
+		if capacity-length < len(taskFiles) {
+			err := fmt.Errorf("import task queue max size is %v, currently there are %v tasks is pending. Not able to execute this request with %v tasks", capacity, length, len(taskFiles))
 			log.Error(err.Error())
 			return err
 		}
 
 		// convert import request to import tasks
-		if isRowBased {
-			// For row-based importing, each file makes a task.
-			taskList := make([]int64, len(req.Files))
-			for i := 0; i < len(req.Files); i++ {
-				tID, _, err := m.idAllocator(1)
-				if err != nil {
-					log.Error("failed to allocate ID for import task", zap.Error(err))
-					return err
-				}
-				newTask := &datapb.ImportTaskInfo{
-					Id:           tID,
-					CollectionId: cID,
-					PartitionId:  pID,
-					ChannelNames: req.ChannelNames,
-					Files:        []string{req.GetFiles()[i]},
-					CreateTs:     time.Now().Unix(),
-					State: &datapb.ImportTaskState{
-						StateCode: commonpb.ImportState_ImportPending,
-					},
-					Infos: req.Options,
-				}
-
-				// Here no need to check error returned by setCollectionPartitionName(),
-				// since here we always return task list to client no matter something missed.
-				// We make the method setCollectionPartitionName() returns error
-				// because we need to make sure coverage all the code branch in unittest case.
-				_ = m.setCollectionPartitionName(cID, pID, newTask)
-				resp.Tasks = append(resp.Tasks, newTask.GetId())
-				taskList[i] = newTask.GetId()
-				log.Info("new task created as pending task",
-					zap.Int64("task ID", newTask.GetId()))
-				if err := m.persistTaskInfo(newTask); err != nil {
-					log.Error("failed to update import task",
-						zap.Int64("task ID", newTask.GetId()),
-						zap.Error(err))
-					return err
-				}
-				m.pendingTasks = append(m.pendingTasks, newTask)
+		taskList := make([]int64, 0, len(taskFiles))
+		for i, files := range taskFiles {
+			infos := req.GetOptions()
+			if i < len(taskGroups) && len(taskGroups[i]) > 1 {
+				infos = append(append([]*commonpb.KeyValuePair{}, infos...), &commonpb.KeyValuePair{
+					Key:   importutil.FileGroups,
+					Value: strings.Join(lo.Map(taskGroups[i], func(n int, _ int) string { return strconv.Itoa(n) }), ","),
+				})
 			}
-			log.Info("row-based import request processed", zap.Any("task IDs", taskList))
-		} else {
-			// TODO: Merge duplicated code :(
-			// for column-based, all files is a task
 			tID, _, err := m.idAllocator(1)
 			if err != nil {
+				log.Error("failed to allocate ID for import task", zap.Error(err))
 				return err
 			}
 			newTask := &datapb.ImportTaskInfo{
@@ -493,19 +520,22 @@ func (m *importManager) importJob(ctx context.Context, req *milvuspb.ImportReque
 				CollectionId: cID,
 				PartitionId:  pID,
 				ChannelNames: req.ChannelNames,
-				Files:        req.GetFiles(),
+				Files:        files,
 				CreateTs:     time.Now().Unix(),
 				State: &datapb.ImportTaskState{
 					StateCode: commonpb.ImportState_ImportPending,
 				},
-				Infos: req.Options,
+				Infos: infos,
+				JobId: jobID,
 			}
+
 			// Here no need to check error returned by setCollectionPartitionName(),
 			// since here we always return task list to client no matter something missed.
 			// We make the method setCollectionPartitionName() returns error
 			// because we need to make sure coverage all the code branch in unittest case.
 			_ = m.setCollectionPartitionName(cID, pID, newTask)
 			resp.Tasks = append(resp.Tasks, newTask.GetId())
+			taskList = append(taskList, newTask.GetId())
 			log.Info("new task created as pending task",
 				zap.Int64("task ID", newTask.GetId()))
 			if err := m.persistTaskInfo(newTask); err != nil {
@@ -515,9 +545,8 @@ func (m *importManager) importJob(ctx context.Context, req *milvuspb.ImportReque
 				return err
 			}
 			m.pendingTasks = append(m.pendingTasks, newTask)
-			log.Info("column-based import request processed",
-				zap.Int64("task ID", newTask.GetId()))
 		}
+		log.Info("import request processed", zap.Int64("job ID", jobID), zap.Int64s("task IDs", taskList))
 		return nil
 	}()
 	if err != nil {
@@ -529,6 +558,114 @@ func (m *importManager) importJob(ctx context.Context, req *milvuspb.ImportReque
 	return resp
 }
 
+// batchImportFiles batches the file groups into at most taskNum tasks, the row-based and column-based groups are
+// never batched together. It returns the files of each task and the file number of each group in the task.
+// The groups are not batched if taskNum is not enough for the kinds of groups.
+func batchImportFiles(groups [][]string, rowBased []bool, taskNum int) ([][]string, [][]int) {
+	var rowGroups, columnGroups [][]string
+	for i, files := range groups {
+		if rowBased[i] {
+			rowGroups = append(rowGroups, files)
+		} else {
+			columnGroups = append(columnGroups, files)
+		}
+	}
+	rowTaskNum := 0
+	if len(rowGroups) > 0 {
+		rowTaskNum = funcutil.Max(1, taskNum*len(rowGroups)/len(groups))
+		if len(columnGroups) > 0 && rowTaskNum >= taskNum {
+			rowTaskNum = taskNum - 1
+		}
+		if rowTaskNum == 0 {
+			return groups, nil
+		}
+	}
+	if len(columnGroups) > 0 && taskNum-rowTaskNum <= 0 {
+		return groups, nil
+	}
+
+	taskFiles := make([][]string, 0, taskNum)
+	taskGroups := make([][]int, 0, taskNum)
+	batch := func(groups [][]string, n int) {
+		// the groups are spread evenly into n tasks
+		for i := 0; i < n; i++ {
+			files := make([]string, 0)
+			sizes := make([]int, 0)
+			for _, group := range groups[len(groups)*i/n : len(groups)*(i+1)/n] {
+				files = append(files, group...)
+				sizes = append(sizes, len(group))
+			}
+			if len(sizes) > 0 {
+				taskFiles = append(taskFiles, files)
+				taskGroups = append(taskGroups, sizes)
+			}
+		}
+	}
+	batch(rowGroups, rowTaskNum)
+	batch(columnGroups, taskNum-rowTaskNum)
+	return taskFiles, taskGroups
+}
+
+// failedFilesOfJob returns the files of the failed tasks of a manifest import job, a file is retried only if its latest
+// task failed.
+func (m *importManager) failedFilesOfJob(jobID int64, colID int64) ([][]string, error) {
+	tasks, err := m.loadFromTaskStore(false)
+	if err != nil {
+		return nil, err
+	}
+	latest := make(map[string]*datapb.ImportTaskInfo)
+	groupFiles := make(map[string][]string)
+	for _, task := range tasks {
+		if task.GetJobId() != jobID {
+			continue
+		}
+		if task.GetCollectionId() != colID {
+			return nil, fmt.Errorf("import job %d doesn't belong to collection %d", jobID, colID)
+		}
+		fileGroups, err := importutil.ParseFileGroups(task.GetInfos())
+		if err != nil {
+			return nil, err
+		}
+		groups, err := importutil.SplitFileGroups(task.GetFiles(), fileGroups)
+		if err != nil {
+			return nil, err
+		}
+		// the batched groups are retried separately
+		for _, files := range groups {
+			key := strings.Join(files, ",")
+			if t, ok := latest[key]; !ok || task.GetId() > t.GetId() {
+				latest[key] = task
+				groupFiles[key] = files
+			}
+		}
+	}
+	if len(latest) == 0 {
+		return nil, fmt.Errorf("import job %d not found", jobID)
+	}
+
+	failed := make([]string, 0)
+	for key, task := range latest {
+		if task.GetState().GetStateCode() == commonpb.ImportState_ImportFailed ||
+			task.GetState().GetStateCode() == commonpb.ImportState_ImportFailedAndCleaned {
+			failed = append(failed, key)
+		}
+	}
+	if len(failed) == 0 {
+		return nil, fmt.Errorf("import job %d has no failed file to retry", jobID)
+	}
+	sort.Slice(failed, func(i, j int) bool {
+		if latest[failed[i]].GetId() != latest[failed[j]].GetId() {
+			return latest[failed[i]].GetId() < latest[failed[j]].GetId()
+		}
+		return failed[i] < failed[j]
+	})
+	files := make([][]string, 0, len(failed))
+	for _, key := range failed {
+		files = append(files, groupFiles[key])
+	}
+	return files, nil
+}
+
 // updateTaskInfo updates the task's state in in-memory working tasks list and in task store, given ImportResult
 // result. It returns the ImportTaskInfo of the given task.
 func (m *importManager) updateTaskInfo(ir *rootcoordpb.ImportResult) (*datapb.ImportTaskInfo, error) {
@@ -716,6 +853,9 @@ func (m *importManager) copyTaskInfo(input *datapb.ImportTaskInfo, output *milvu
 	output.Infos = append(output.Infos, &commonpb.KeyValuePair{Key: importutil.Files, Value: strings.Join(input.GetFiles(), ",")})
 	output.Infos = append(output.Infos, &commonpb.KeyValuePair{Key: importutil.CollectionName, Value: input.GetCollectionName()})
 	output.Infos = append(output.Infos, &commonpb.KeyValuePair{Key: importutil.PartitionName, Value: input.GetPartitionName()})
+	if input.GetJobId() != 0 {
+		output.Infos = append(output.Infos, &commonpb.KeyValuePair{Key: importutil.JobID, Value: strconv.FormatInt(input.GetJobId(), 10)})
+	}
 	output.Infos = append(output.Infos, &commonpb.KeyValuePair{
 		Key:   importutil.FailedReason,
 		Value: input.GetState().GetErrorMessage(),
@@ -1061,6 +1201,7 @@ func cloneImportTaskInfo(taskInfo *datapb.ImportTaskInfo) *datapb.ImportTaskInfo
 		PartitionName:  taskInfo.GetPartitionName(),
 		Infos:          taskInfo.GetInfos(),
 		StartTs:        taskInfo.GetStartTs(),
+		JobId:          taskInfo.GetJobId(),
 	}
 	return cloned
 }
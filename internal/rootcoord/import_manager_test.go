@@ -18,6 +18,7 @@ package rootcoord
 
 import (
 	"context"
+	"strconv"
 	"strings"
 	"sync"
 	"testing"
@@ -27,6 +28,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
@@ -35,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	importutil2 "github.com/milvus-io/milvus/internal/util/importutil"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -115,7 +118,7 @@ func TestImportManager_NewImportManager(t *testing.T) {
 		defer wg.Done()
 		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 		defer cancel()
-		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil)
+		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil, nil)
 		assert.NotNil(t, mgr)
 
 		// there are 2 tasks read from store, one is pending, the other is persisted.
@@ -147,7 +150,7 @@ func TestImportManager_NewImportManager(t *testing.T) {
 		defer wg.Done()
 		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Nanosecond)
 		defer cancel()
-		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil)
+		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil, nil)
 		assert.NotNil(t, mgr)
 		mgr.init(context.TODO())
 		var wgLoop sync.WaitGroup
@@ -166,7 +169,7 @@ func TestImportManager_NewImportManager(t *testing.T) {
 
 		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Nanosecond)
 		defer cancel()
-		mgr := newImportManager(ctx, mockTxnKV, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil)
+		mgr := newImportManager(ctx, mockTxnKV, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil, nil)
 		assert.NotNil(t, mgr)
 		assert.Panics(t, func() {
 			mgr.init(context.TODO())
@@ -183,7 +186,7 @@ func TestImportManager_NewImportManager(t *testing.T) {
 
 		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Nanosecond)
 		defer cancel()
-		mgr := newImportManager(ctx, mockTxnKV, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil)
+		mgr := newImportManager(ctx, mockTxnKV, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil, nil)
 		assert.NotNil(t, mgr)
 		mgr.init(context.TODO())
 	})
@@ -197,7 +200,7 @@ func TestImportManager_NewImportManager(t *testing.T) {
 
 		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Nanosecond)
 		defer cancel()
-		mgr := newImportManager(ctx, mockTxnKV, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil)
+		mgr := newImportManager(ctx, mockTxnKV, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil, nil)
 		assert.NotNil(t, mgr)
 		mgr.init(context.TODO())
 		func() {
@@ -217,7 +220,7 @@ func TestImportManager_NewImportManager(t *testing.T) {
 		defer wg.Done()
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 		defer cancel()
-		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil)
+		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil, nil)
 		assert.NotNil(t, mgr)
 		mgr.init(ctx)
 		var wgLoop sync.WaitGroup
@@ -275,7 +278,7 @@ func TestImportManager_TestSetImportTaskState(t *testing.T) {
 		defer wg.Done()
 		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 		defer cancel()
-		mgr := newImportManager(ctx, mockKv, idAlloc, nil, nil, nil, nil)
+		mgr := newImportManager(ctx, mockKv, idAlloc, nil, nil, nil, nil, nil)
 		assert.NotNil(t, mgr)
 		_, err := mgr.loadFromTaskStore(true)
 		assert.NoError(t, err)
@@ -372,7 +375,7 @@ func TestImportManager_TestEtcdCleanUp(t *testing.T) {
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
-	mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil)
+	mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil, nil)
 	assert.NotNil(t, mgr)
 	_, err = mgr.loadFromTaskStore(true)
 	assert.NoError(t, err)
@@ -477,7 +480,7 @@ func TestImportManager_TestFlipTaskStateLoop(t *testing.T) {
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 		defer cancel()
 		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn,
-			callGetSegmentStates, nil, callUnsetIsImportingState)
+			callGetSegmentStates, nil, callUnsetIsImportingState, nil)
 		assert.NotNil(t, mgr)
 		var wgLoop sync.WaitGroup
 		wgLoop.Add(1)
@@ -492,7 +495,7 @@ func TestImportManager_TestFlipTaskStateLoop(t *testing.T) {
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 		defer cancel()
 		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn,
-			callGetSegmentStates, nil, callUnsetIsImportingState)
+			callGetSegmentStates, nil, callUnsetIsImportingState, nil)
 		assert.NotNil(t, mgr)
 		var wgLoop sync.WaitGroup
 		wgLoop.Add(1)
@@ -507,7 +510,7 @@ func TestImportManager_TestFlipTaskStateLoop(t *testing.T) {
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 		defer cancel()
 		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn,
-			callGetSegmentStates, nil, callUnsetIsImportingState)
+			callGetSegmentStates, nil, callUnsetIsImportingState, nil)
 		assert.NotNil(t, mgr)
 		var wgLoop sync.WaitGroup
 		wgLoop.Add(1)
@@ -542,7 +545,7 @@ func TestImportManager_ImportJob(t *testing.T) {
 		}, nil
 	}
 	// nil request
-	mgr := newImportManager(context.TODO(), mockKv, idAlloc, nil, callGetSegmentStates, nil, nil)
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, nil, callGetSegmentStates, nil, nil, nil)
 	resp := mgr.importJob(context.TODO(), nil, colID, 0)
 	assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 
@@ -571,7 +574,7 @@ func TestImportManager_ImportJob(t *testing.T) {
 	// row-based case, task count equal to file count
 	// since the importServiceFunc return error, tasks will be kept in pending list
 	rowReq.Files = []string{"f1.json"}
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil, nil)
 	resp = mgr.importJob(context.TODO(), rowReq, colID, 0)
 	assert.Equal(t, len(rowReq.Files), len(mgr.pendingTasks))
 	assert.Equal(t, 0, len(mgr.workingTasks))
@@ -584,7 +587,7 @@ func TestImportManager_ImportJob(t *testing.T) {
 
 	// column-based case, one quest one task
 	// since the importServiceFunc return error, tasks will be kept in pending list
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil, nil)
 	resp = mgr.importJob(context.TODO(), colReq, colID, 0)
 	assert.Equal(t, 1, len(mgr.pendingTasks))
 	assert.Equal(t, 0, len(mgr.workingTasks))
@@ -598,13 +601,13 @@ func TestImportManager_ImportJob(t *testing.T) {
 	}
 
 	// row-based case, since the importServiceFunc return success, tasks will be sent to working list
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil, nil)
 	resp = mgr.importJob(context.TODO(), rowReq, colID, 0)
 	assert.Equal(t, 0, len(mgr.pendingTasks))
 	assert.Equal(t, len(rowReq.Files), len(mgr.workingTasks))
 
 	// column-based case, since the importServiceFunc return success, tasks will be sent to working list
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil, nil)
 	resp = mgr.importJob(context.TODO(), colReq, colID, 0)
 	assert.Equal(t, 0, len(mgr.pendingTasks))
 	assert.Equal(t, 1, len(mgr.workingTasks))
@@ -628,7 +631,7 @@ func TestImportManager_ImportJob(t *testing.T) {
 
 	// row-based case, since the importServiceFunc return success for 1 task
 	// the first task is sent to working list, and 1 task left in pending list
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil, nil)
 	resp = mgr.importJob(context.TODO(), rowReq, colID, 0)
 	assert.Equal(t, 0, len(mgr.pendingTasks))
 	assert.Equal(t, 1, len(mgr.workingTasks))
@@ -648,6 +651,189 @@ func TestImportManager_ImportJob(t *testing.T) {
 	}
 }
 
+func TestImportManager_ManifestImportJob(t *testing.T) {
+	var countLock sync.RWMutex
+	var globalCount = typeutil.UniqueID(0)
+
+	var idAlloc = func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error) {
+		countLock.Lock()
+		defer countLock.Unlock()
+		globalCount++
+		return globalCount, 0, nil
+	}
+
+	paramtable.Get().Save(Params.RootCoordCfg.ImportTaskSubPath.Key, "test_import_task")
+	colID := int64(100)
+	mockKv := memkv.NewMemoryKV()
+	importServiceFunc := func(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error) {
+		return &datapb.ImportTaskResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+		}, nil
+	}
+	taskFiles := [][]string{{"prefix/a.json"}, {"prefix/seg1/x.npy", "prefix/seg1/y.npy"}}
+	discoverFunc := func(ctx context.Context, prefix string) ([][]string, error) {
+		if prefix != "prefix" {
+			return nil, errors.New("mock error")
+		}
+		return taskFiles, nil
+	}
+	req := &milvuspb.ImportRequest{
+		CollectionName: "c1",
+		PartitionName:  "p1",
+		Options:        []*commonpb.KeyValuePair{{Key: importutil2.Prefix, Value: "prefix"}},
+	}
+
+	// discovery not available
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, nil, nil, nil, nil)
+	resp := mgr.importJob(context.TODO(), req, colID, 0)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+
+	// each file group makes a task of the job
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, nil, nil, nil, discoverFunc)
+	resp = mgr.importJob(context.TODO(), req, colID, 0)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, 2, len(resp.GetTasks()))
+	assert.Equal(t, 2, len(mgr.workingTasks))
+	jobID := mgr.workingTasks[resp.GetTasks()[0]].GetJobId()
+	assert.NotZero(t, jobID)
+	for i, taskID := range resp.GetTasks() {
+		assert.Equal(t, jobID, mgr.workingTasks[taskID].GetJobId())
+		assert.Equal(t, taskFiles[i], mgr.workingTasks[taskID].GetFiles())
+	}
+	state := mgr.getTaskState(resp.GetTasks()[0])
+	jobIDInfo, err := funcutil.GetAttrByKeyFromRepeatedKV(importutil2.JobID, state.GetInfos())
+	assert.NoError(t, err)
+	assert.Equal(t, strconv.FormatInt(jobID, 10), jobIDInfo)
+
+	// discovery failed
+	req.Options = []*commonpb.KeyValuePair{{Key: importutil2.Prefix, Value: "not_exist"}}
+	resp2 := mgr.importJob(context.TODO(), req, colID, 0)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, resp2.GetStatus().GetErrorCode())
+
+	// no failed file to retry
+	req.Options = []*commonpb.KeyValuePair{{Key: importutil2.RetryJob, Value: strconv.FormatInt(jobID, 10)}}
+	resp2 = mgr.importJob(context.TODO(), req, colID, 0)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, resp2.GetStatus().GetErrorCode())
+
+	// only the failed files are retried
+	err = mgr.setImportTaskStateAndReason(resp.GetTasks()[1], commonpb.ImportState_ImportFailed, "mock failure")
+	assert.NoError(t, err)
+	resp2 = mgr.importJob(context.TODO(), req, colID, 0)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp2.GetStatus().GetErrorCode())
+	assert.Equal(t, 1, len(resp2.GetTasks()))
+	retried := mgr.workingTasks[resp2.GetTasks()[0]]
+	assert.Equal(t, jobID, retried.GetJobId())
+	assert.Equal(t, taskFiles[1], retried.GetFiles())
+
+	// the retried file is in progress
+	resp2 = mgr.importJob(context.TODO(), req, colID, 0)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, resp2.GetStatus().GetErrorCode())
+
+	// the job belongs to another collection
+	err = mgr.setImportTaskStateAndReason(retried.GetId(), commonpb.ImportState_ImportFailed, "mock failure")
+	assert.NoError(t, err)
+	resp2 = mgr.importJob(context.TODO(), req, colID+1, 0)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, resp2.GetStatus().GetErrorCode())
+
+	// job not found or invalid
+	req.Options = []*commonpb.KeyValuePair{{Key: importutil2.RetryJob, Value: "-1"}}
+	resp2 = mgr.importJob(context.TODO(), req, colID, 0)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, resp2.GetStatus().GetErrorCode())
+	req.Options = []*commonpb.KeyValuePair{{Key: importutil2.RetryJob, Value: "job"}}
+	resp2 = mgr.importJob(context.TODO(), req, colID, 0)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, resp2.GetStatus().GetErrorCode())
+
+	// invalid file group
+	taskFiles = [][]string{{"prefix/a.json", "prefix/b.json"}}
+	req.Options = []*commonpb.KeyValuePair{{Key: importutil2.Prefix, Value: "prefix"}}
+	resp = mgr.importJob(context.TODO(), req, colID, 0)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+}
+
+func TestImportManager_BatchedManifestImportJob(t *testing.T) {
+	var countLock sync.RWMutex
+	var globalCount = typeutil.UniqueID(0)
+
+	var idAlloc = func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error) {
+		countLock.Lock()
+		defer countLock.Unlock()
+		globalCount++
+		return globalCount, 0, nil
+	}
+
+	paramtable.Get().Save(Params.RootCoordCfg.ImportTaskSubPath.Key, "test_import_task")
+	paramtable.Get().Save(Params.RootCoordCfg.ImportMaxPendingTaskCount.Key, "2")
+	defer paramtable.Get().Reset(Params.RootCoordCfg.ImportMaxPendingTaskCount.Key)
+	colID := int64(100)
+	mockKv := memkv.NewMemoryKV()
+	importServiceFunc := func(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error) {
+		return &datapb.ImportTaskResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+		}, nil
+	}
+	taskFiles := [][]string{
+		{"prefix/a.json"}, {"prefix/b.json"}, {"prefix/c.json"}, {"prefix/d.json"}, {"prefix/e.json"},
+		{"prefix/seg1/x.npy", "prefix/seg1/y.npy"}, {"prefix/seg2/x.npy", "prefix/seg2/y.npy"},
+	}
+	discoverFunc := func(ctx context.Context, prefix string) ([][]string, error) {
+		return taskFiles, nil
+	}
+	req := &milvuspb.ImportRequest{
+		CollectionName: "c1",
+		PartitionName:  "p1",
+		Options:        []*commonpb.KeyValuePair{{Key: importutil2.Prefix, Value: "prefix"}},
+	}
+
+	// the file groups are more than the task queue capacity, they are batched
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, nil, nil, nil, discoverFunc)
+	resp := mgr.importJob(context.TODO(), req, colID, 0)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	require.Equal(t, 2, len(resp.GetTasks()))
+	rowTask := mgr.workingTasks[resp.GetTasks()[0]]
+	assert.Equal(t, []string{"prefix/a.json", "prefix/b.json", "prefix/c.json", "prefix/d.json", "prefix/e.json"}, rowTask.GetFiles())
+	fileGroups, err := funcutil.GetAttrByKeyFromRepeatedKV(importutil2.FileGroups, rowTask.GetInfos())
+	assert.NoError(t, err)
+	assert.Equal(t, "1,1,1,1,1", fileGroups)
+	columnTask := mgr.workingTasks[resp.GetTasks()[1]]
+	assert.Equal(t, []string{"prefix/seg1/x.npy", "prefix/seg1/y.npy", "prefix/seg2/x.npy", "prefix/seg2/y.npy"}, columnTask.GetFiles())
+	fileGroups, err = funcutil.GetAttrByKeyFromRepeatedKV(importutil2.FileGroups, columnTask.GetInfos())
+	assert.NoError(t, err)
+	assert.Equal(t, "2,2", fileGroups)
+	assert.Equal(t, 1, len(req.GetOptions()))
+
+	// the groups of the failed batch are retried, batched again
+	err = mgr.setImportTaskStateAndReason(rowTask.GetId(), commonpb.ImportState_ImportFailed, "mock failure")
+	assert.NoError(t, err)
+	req.Options = []*commonpb.KeyValuePair{{Key: importutil2.RetryJob, Value: strconv.FormatInt(rowTask.GetJobId(), 10)}}
+	resp = mgr.importJob(context.TODO(), req, colID, 0)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	require.Equal(t, 2, len(resp.GetTasks()))
+	assert.Equal(t, []string{"prefix/a.json", "prefix/b.json"}, mgr.workingTasks[resp.GetTasks()[0]].GetFiles())
+	assert.Equal(t, []string{"prefix/c.json", "prefix/d.json", "prefix/e.json"}, mgr.workingTasks[resp.GetTasks()[1]].GetFiles())
+}
+
+func Test_batchImportFiles(t *testing.T) {
+	groups := [][]string{{"a.json"}, {"x/a.npy", "x/b.npy"}, {"b.json"}}
+	rowBased := []bool{true, false, true}
+
+	// no room for both kinds of groups
+	taskFiles, taskGroups := batchImportFiles(groups, rowBased, 1)
+	assert.Equal(t, groups, taskFiles)
+	assert.Nil(t, taskGroups)
+
+	taskFiles, taskGroups = batchImportFiles(groups, rowBased, 2)
+	assert.Equal(t, [][]string{{"a.json", "b.json"}, {"x/a.npy", "x/b.npy"}}, taskFiles)
+	assert.Equal(t, [][]int{{1, 1}, {2}}, taskGroups)
+
+	taskFiles, taskGroups = batchImportFiles(groups[:1], rowBased[:1], 2)
+	assert.Equal(t, [][]string{{"a.json"}}, taskFiles)
+	assert.Equal(t, [][]int{{1}}, taskGroups)
+}
+
 func TestImportManager_AllDataNodesBusy(t *testing.T) {
 	var countLock sync.RWMutex
 	var globalCount = typeutil.UniqueID(0)
@@ -706,7 +892,7 @@ func TestImportManager_AllDataNodesBusy(t *testing.T) {
 	}
 
 	// each data node owns one task
-	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil)
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil, nil)
 	for i := 0; i < len(dnList); i++ {
 		resp := mgr.importJob(context.TODO(), rowReq, colID, 0)
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
@@ -715,7 +901,7 @@ func TestImportManager_AllDataNodesBusy(t *testing.T) {
 	}
 
 	// all data nodes are busy, new task waiting in pending list
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil, nil)
 	resp := mgr.importJob(context.TODO(), rowReq, colID, 0)
 	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	assert.Equal(t, len(rowReq.Files), len(mgr.pendingTasks))
@@ -723,7 +909,7 @@ func TestImportManager_AllDataNodesBusy(t *testing.T) {
 
 	// now all data nodes are free again, new task is executed instantly
 	count = 0
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil, nil)
 	resp = mgr.importJob(context.TODO(), colReq, colID, 0)
 	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	assert.Equal(t, 0, len(mgr.pendingTasks))
@@ -781,7 +967,7 @@ func TestImportManager_TaskState(t *testing.T) {
 	}
 
 	// add 3 tasks, their ID is 10000, 10001, 10002, make sure updateTaskInfo() works correctly
-	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil)
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil, nil)
 	mgr.importJob(context.TODO(), rowReq, colID, 0)
 	rowReq.Files = []string{"f2.json"}
 	mgr.importJob(context.TODO(), rowReq, colID, 0)
@@ -890,7 +1076,7 @@ func TestImportManager_AllocFail(t *testing.T) {
 			},
 		}, nil
 	}
-	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil)
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil, nil)
 	resp := mgr.importJob(context.TODO(), rowReq, colID, 0)
 	assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	assert.Equal(t, 0, len(mgr.pendingTasks))
@@ -953,7 +1139,7 @@ func TestImportManager_ListAllTasks(t *testing.T) {
 	}
 
 	mockKv := memkv.NewMemoryKV()
-	mgr := newImportManager(context.TODO(), mockKv, idAlloc, fn, callGetSegmentStates, getCollectionName, nil)
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, fn, callGetSegmentStates, getCollectionName, nil, nil)
 
 	// add 10 tasks for collection1, id from 1 to 10
 	file1 := "f1.json"
@@ -0,0 +1,120 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutil"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// importManifestName is the name of the optional manifest file under the import prefix.
+const importManifestName = "import_manifest.json"
+
+// importManifest lists the file groups to import, each group makes an import task.
+// A group is a single row-based file, or the column-based files of the same rows.
+// The file paths are relative to the directory of the manifest.
+type importManifest struct {
+	Files [][]string `json:"files"`
+}
+
+// discoverImportFiles returns the file groups to import under the prefix.
+// The groups are read from the manifest if there is one, otherwise all the supported files under the prefix are
+// discovered: each row-based file makes a group, and the column-based files in the same directory make a group.
+func discoverImportFiles(ctx context.Context, cm storage.ChunkManager, prefix string) ([][]string, error) {
+	manifestPath := path.Join(prefix, importManifestName)
+	exist, err := cm.Exist(ctx, manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	if exist {
+		content, err := cm.Read(ctx, manifestPath)
+		if err != nil {
+			return nil, err
+		}
+		return parseImportManifest(prefix, content)
+	}
+
+	files, _, err := cm.ListWithPrefix(ctx, prefix, true)
+	if err != nil {
+		return nil, err
+	}
+	groups := groupImportFiles(files)
+	if len(groups) == 0 {
+		return nil, fmt.Errorf("no file to import under prefix '%s'", prefix)
+	}
+	log.Info("import files discovered", zap.String("prefix", prefix),
+		zap.Int("fileNum", len(files)), zap.Int("groupNum", len(groups)))
+	return groups, nil
+}
+
+func parseImportManifest(prefix string, content []byte) ([][]string, error) {
+	manifest := &importManifest{}
+	if err := json.Unmarshal(content, manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse import manifest under prefix '%s', error: %w", prefix, err)
+	}
+	groups := make([][]string, 0, len(manifest.Files))
+	for _, files := range manifest.Files {
+		if len(files) == 0 {
+			continue
+		}
+		group := make([]string, 0, len(files))
+		for _, file := range files {
+			group = append(group, path.Join(prefix, file))
+		}
+		groups = append(groups, group)
+	}
+	if len(groups) == 0 {
+		return nil, fmt.Errorf("import manifest under prefix '%s' contains no file", prefix)
+	}
+	return groups, nil
+}
+
+// groupImportFiles groups the files into import tasks, the unsupported files are ignored.
+func groupImportFiles(files []string) [][]string {
+	groups := make([][]string, 0)
+	columnGroups := make(map[string][]string)
+	for _, file := range files {
+		switch {
+		case path.Base(file) == importManifestName:
+		case strings.HasSuffix(file, importutil.JSONFileExt):
+			groups = append(groups, []string{file})
+		case strings.HasSuffix(file, importutil.NumpyFileExt):
+			dir := path.Dir(file)
+			columnGroups[dir] = append(columnGroups[dir], file)
+		}
+	}
+
+	dirs := make([]string, 0, len(columnGroups))
+	for dir := range columnGroups {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	for _, dir := range dirs {
+		groups = append(groups, columnGroups[dir])
+	}
+	return groups
+}
@@ -0,0 +1,82 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func Test_groupImportFiles(t *testing.T) {
+	groups := groupImportFiles([]string{
+		"prefix/a.json",
+		"prefix/seg2/x.npy",
+		"prefix/seg1/x.npy",
+		"prefix/seg1/y.npy",
+		"prefix/readme.txt",
+		"prefix/" + importManifestName,
+		"prefix/b.json",
+	})
+	assert.Equal(t, [][]string{
+		{"prefix/a.json"},
+		{"prefix/b.json"},
+		{"prefix/seg1/x.npy", "prefix/seg1/y.npy"},
+		{"prefix/seg2/x.npy"},
+	}, groups)
+
+	assert.Empty(t, groupImportFiles([]string{"prefix/readme.txt"}))
+}
+
+func Test_parseImportManifest(t *testing.T) {
+	groups, err := parseImportManifest("prefix", []byte(`{"files": [["a.json"], [], ["seg1/x.npy", "seg1/y.npy"]]}`))
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"prefix/a.json"}, {"prefix/seg1/x.npy", "prefix/seg1/y.npy"}}, groups)
+
+	_, err = parseImportManifest("prefix", []byte(`{"files": []}`))
+	assert.Error(t, err)
+
+	_, err = parseImportManifest("prefix", []byte(`not a manifest`))
+	assert.Error(t, err)
+}
+
+func Test_discoverImportFiles(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	cm := storage.NewLocalChunkManager(storage.RootPath(dir))
+	prefix := path.Join(dir, "import")
+
+	// nothing to import
+	_, err := discoverImportFiles(ctx, cm, prefix)
+	assert.Error(t, err)
+
+	assert.NoError(t, cm.Write(ctx, path.Join(prefix, "a.json"), []byte("{}")))
+	assert.NoError(t, cm.Write(ctx, path.Join(prefix, "seg1", "x.npy"), []byte{}))
+	groups, err := discoverImportFiles(ctx, cm, prefix)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{path.Join(prefix, "a.json")}, {path.Join(prefix, "seg1", "x.npy")}}, groups)
+
+	// the manifest takes precedence
+	assert.NoError(t, cm.Write(ctx, path.Join(prefix, importManifestName), []byte(`{"files": [["seg1/x.npy"]]}`)))
+	groups, err = discoverImportFiles(ctx, cm, prefix)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{path.Join(prefix, "seg1", "x.npy")}}, groups)
+}
@@ -56,6 +56,7 @@ type IMetaTable interface {
 	DropAlias(ctx context.Context, alias string, ts Timestamp) error
 	AlterAlias(ctx context.Context, alias string, collectionName string, ts Timestamp) error
 	AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error
+	AddCollectionField(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error
 	RenameCollection(ctx context.Context, oldName string, newName string, ts Timestamp) error
 
 	// TODO: it'll be a big cost if we handle the time travel logic, since we should always list all aliases in catalog.
@@ -446,6 +447,20 @@ func (mt *MetaTable) AlterCollection(ctx context.Context, oldColl *model.Collect
 	return nil
 }
 
+// AddCollectionField persists the fields which newColl has but oldColl doesn't.
+func (mt *MetaTable) AddCollectionField(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
+	if err := mt.catalog.AlterCollection(ctx1, oldColl, newColl, metastore.ADD, ts); err != nil {
+		return err
+	}
+	mt.collID2Meta[oldColl.CollectionID] = newColl
+	log.Info("add collection field finished", zap.Int64("collectionID", oldColl.CollectionID), zap.Uint64("ts", ts))
+	return nil
+}
+
 func (mt *MetaTable) RenameCollection(ctx context.Context, oldName string, newName string, ts Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
@@ -28,6 +28,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
 	"github.com/milvus-io/milvus/internal/metastore/mocks"
 	"github.com/milvus-io/milvus/internal/metastore/model"
@@ -600,6 +601,46 @@ func TestMetaTable_GetCollectionByName(t *testing.T) {
 	})
 }
 
+func TestMetaTable_AddCollectionField(t *testing.T) {
+	t.Run("alter metastore fail", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("AlterCollection",
+			mock.Anything, // context.Context
+			mock.Anything,
+			mock.Anything,
+			metastore.ADD,
+			mock.Anything,
+		).Return(errors.New("error"))
+		meta := &MetaTable{
+			catalog:     catalog,
+			collID2Meta: map[typeutil.UniqueID]*model.Collection{},
+		}
+		err := meta.AddCollectionField(context.Background(), nil, nil, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("add field ok", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("AlterCollection",
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			metastore.ADD,
+			mock.Anything,
+		).Return(nil)
+		meta := &MetaTable{
+			catalog:     catalog,
+			collID2Meta: map[typeutil.UniqueID]*model.Collection{},
+		}
+
+		oldColl := &model.Collection{CollectionID: 1}
+		newColl := &model.Collection{CollectionID: 1, Fields: []*model.Field{{FieldID: 100}}}
+		err := meta.AddCollectionField(context.Background(), oldColl, newColl, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, meta.collID2Meta[1], newColl)
+	})
+}
+
 func TestMetaTable_AlterCollection(t *testing.T) {
 	t.Run("alter metastore fail", func(t *testing.T) {
 		catalog := mocks.NewRootCoordCatalog(t)
@@ -71,6 +71,7 @@ type mockMetaTable struct {
 	GetPartitionByNameFunc           func(collID UniqueID, partitionName string, ts Timestamp) (UniqueID, error)
 	GetCollectionVirtualChannelsFunc func(colID int64) []string
 	AlterCollectionFunc              func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error
+	AddCollectionFieldFunc           func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error
 	RenameCollectionFunc             func(ctx context.Context, oldName string, newName string, ts Timestamp) error
 }
 
@@ -134,6 +135,10 @@ func (m mockMetaTable) AlterCollection(ctx context.Context, oldColl *model.Colle
 	return m.AlterCollectionFunc(ctx, oldColl, newColl, ts)
 }
 
+func (m mockMetaTable) AddCollectionField(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error {
+	return m.AddCollectionFieldFunc(ctx, oldColl, newColl, ts)
+}
+
 func (m *mockMetaTable) RenameCollection(ctx context.Context, oldName string, newName string, ts Timestamp) error {
 	return m.RenameCollectionFunc(ctx, oldName, newName, ts)
 }
@@ -187,6 +192,7 @@ type mockDataCoord struct {
 	broadCastAlteredCollectionFunc func(ctx context.Context, req *datapb.AlterCollectionRequest) (*commonpb.Status, error)
 	GetSegmentIndexStateFunc       func(ctx context.Context, req *indexpb.GetSegmentIndexStateRequest) (*indexpb.GetSegmentIndexStateResponse, error)
 	DropIndexFunc                  func(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error)
+	ManualCompactionFunc           func(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)
 }
 
 func newMockDataCoord() *mockDataCoord {
@@ -231,6 +237,10 @@ func (m *mockDataCoord) DropIndex(ctx context.Context, req *indexpb.DropIndexReq
 	return m.DropIndexFunc(ctx, req)
 }
 
+func (m *mockDataCoord) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return m.ManualCompactionFunc(ctx, req)
+}
+
 type mockQueryCoord struct {
 	types.QueryCoord
 	GetSegmentInfoFunc     func(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)
@@ -596,6 +606,9 @@ func withInvalidDataCoord() Opt {
 	dc.DropIndexFunc = func(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error) {
 		return nil, errors.New("error mock DropIndexFunc")
 	}
+	dc.ManualCompactionFunc = func(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
+		return nil, errors.New("error mock ManualCompaction")
+	}
 	return withDataCoord(dc)
 }
 
@@ -639,6 +652,9 @@ func withFailedDataCoord() Opt {
 	dc.DropIndexFunc = func(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error) {
 		return failStatus(commonpb.ErrorCode_UnexpectedError, "mock DropIndexFunc fail"), nil
 	}
+	dc.ManualCompactionFunc = func(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
+		return &milvuspb.ManualCompactionResponse{Status: failStatus(commonpb.ErrorCode_UnexpectedError, "mock ManualCompaction fail")}, nil
+	}
 	return withDataCoord(dc)
 }
 
@@ -679,6 +695,9 @@ func withValidDataCoord() Opt {
 	dc.DropIndexFunc = func(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error) {
 		return succStatus(), nil
 	}
+	dc.ManualCompactionFunc = func(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
+		return &milvuspb.ManualCompactionResponse{Status: succStatus(), CompactionID: 1}, nil
+	}
 	return withDataCoord(dc)
 }
 
@@ -803,6 +822,7 @@ type mockBroker struct {
 	GetSegmentIndexStateFunc func(ctx context.Context, collID UniqueID, indexName string, segIDs []UniqueID) ([]*indexpb.SegmentIndexState, error)
 
 	BroadcastAlteredCollectionFunc func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error
+	ManualCompactionFunc           func(ctx context.Context, collectionID UniqueID) error
 
 	GCConfirmFunc func(ctx context.Context, collectionID, partitionID UniqueID) bool
 }
@@ -847,6 +867,10 @@ func (b mockBroker) BroadcastAlteredCollection(ctx context.Context, req *milvusp
 	return b.BroadcastAlteredCollectionFunc(ctx, req)
 }
 
+func (b mockBroker) ManualCompaction(ctx context.Context, collectionID UniqueID) error {
+	return b.ManualCompactionFunc(ctx, collectionID)
+}
+
 func (b mockBroker) GcConfirm(ctx context.Context, collectionID, partitionID UniqueID) bool {
 	return b.GCConfirmFunc(ctx, collectionID, partitionID)
 }
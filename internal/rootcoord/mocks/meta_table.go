@@ -66,6 +66,46 @@ func (_c *IMetaTable_AddCollection_Call) Return(_a0 error) *IMetaTable_AddCollec
 	return _c
 }
 
+// AddCollectionField provides a mock function with given fields: ctx, oldColl, newColl, ts
+func (_m *IMetaTable) AddCollectionField(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts uint64) error {
+	ret := _m.Called(ctx, oldColl, newColl, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Collection, *model.Collection, uint64) error); ok {
+		r0 = rf(ctx, oldColl, newColl, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_AddCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCollectionField'
+type IMetaTable_AddCollectionField_Call struct {
+	*mock.Call
+}
+
+// AddCollectionField is a helper method to define mock.On call
+//  - ctx context.Context
+//  - oldColl *model.Collection
+//  - newColl *model.Collection
+//  - ts uint64
+func (_e *IMetaTable_Expecter) AddCollectionField(ctx interface{}, oldColl interface{}, newColl interface{}, ts interface{}) *IMetaTable_AddCollectionField_Call {
+	return &IMetaTable_AddCollectionField_Call{Call: _e.mock.On("AddCollectionField", ctx, oldColl, newColl, ts)}
+}
+
+func (_c *IMetaTable_AddCollectionField_Call) Run(run func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts uint64)) *IMetaTable_AddCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Collection), args[2].(*model.Collection), args[3].(uint64))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_AddCollectionField_Call) Return(_a0 error) *IMetaTable_AddCollectionField_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 // AddCredential provides a mock function with given fields: credInfo
 func (_m *IMetaTable) AddCredential(credInfo *internalpb.CredentialInfo) error {
 	ret := _m.Called(credInfo)
@@ -23,12 +23,10 @@ import (
 	"path"
 	"sync"
 
-	"go.etcd.io/etcd/api/v3/mvccpb"
-	v3rpc "go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
-	clientv3 "go.etcd.io/etcd/client/v3"
+	"github.com/cockroachdb/errors"
 	"go.uber.org/zap"
 
-	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
@@ -40,22 +38,22 @@ type proxyManager struct {
 	ctx              context.Context
 	cancel           context.CancelFunc
 	lock             sync.Mutex
-	etcdCli          *clientv3.Client
+	metaKv           kv.MetaKv
 	initSessionsFunc []func([]*sessionutil.Session)
 	addSessionsFunc  []func(*sessionutil.Session)
 	delSessionsFunc  []func(*sessionutil.Session)
 }
 
 // newProxyManager helper function to create a proxyManager
-// etcdEndpoints is the address list of etcd
+// metaKv is the MetaKv keeping the sessions, whose root path is empty
 // fns are the custom getSessions function list
-func newProxyManager(ctx context.Context, client *clientv3.Client, fns ...func([]*sessionutil.Session)) *proxyManager {
+func newProxyManager(ctx context.Context, metaKv kv.MetaKv, fns ...func([]*sessionutil.Session)) *proxyManager {
 	ctx2, cancel2 := context.WithCancel(ctx)
 	p := &proxyManager{
-		ctx:     ctx2,
-		cancel:  cancel2,
-		lock:    sync.Mutex{},
-		etcdCli: client,
+		ctx:    ctx2,
+		cancel: cancel2,
+		lock:   sync.Mutex{},
+		metaKv: metaKv,
 	}
 	p.initSessionsFunc = append(p.initSessionsFunc, fns...)
 	return p
@@ -77,10 +75,7 @@ func (p *proxyManager) DelSessionFunc(fns ...func(*sessionutil.Session)) {
 
 // WatchProxy starts a goroutine to watch proxy session changes on etcd
 func (p *proxyManager) WatchProxy() error {
-	ctx, cancel := context.WithTimeout(p.ctx, etcdkv.RequestTimeout)
-	defer cancel()
-
-	sessions, rev, err := p.getSessionsOnEtcd(ctx)
+	sessions, rev, err := p.getSessionsOnEtcd()
 	if err != nil {
 		return err
 	}
@@ -90,19 +85,18 @@ func (p *proxyManager) WatchProxy() error {
 		f(sessions)
 	}
 
-	eventCh := p.etcdCli.Watch(
+	eventCh := p.metaKv.WatchWithOptions(
 		p.ctx,
 		path.Join(Params.EtcdCfg.MetaRootPath.GetValue(), sessionutil.DefaultServiceRoot, typeutil.ProxyRole),
-		clientv3.WithPrefix(),
-		clientv3.WithCreatedNotify(),
-		clientv3.WithPrevKV(),
-		clientv3.WithRev(rev+1),
+		kv.WithPrefix(),
+		kv.WithPrevKV(),
+		kv.WithRevision(rev+1),
 	)
 	go p.startWatchEtcd(p.ctx, eventCh)
 	return nil
 }
 
-func (p *proxyManager) startWatchEtcd(ctx context.Context, eventCh clientv3.WatchChan) {
+func (p *proxyManager) startWatchEtcd(ctx context.Context, eventCh kv.WatchChan) {
 	log.Info("start to watch etcd")
 	for {
 		select {
@@ -116,7 +110,7 @@ func (p *proxyManager) startWatchEtcd(ctx context.Context, eventCh clientv3.Watc
 				panic("stop watching etcd loop due to closed etcd event channel")
 			}
 			if err := event.Err(); err != nil {
-				if err == v3rpc.ErrCompacted {
+				if errors.Is(err, kv.ErrCompacted) {
 					err2 := p.WatchProxy()
 					if err2 != nil {
 						log.Error("re watch proxy fails when etcd has a compaction error",
@@ -131,9 +125,9 @@ func (p *proxyManager) startWatchEtcd(ctx context.Context, eventCh clientv3.Watc
 			for _, e := range event.Events {
 				var err error
 				switch e.Type {
-				case mvccpb.PUT:
+				case kv.EventTypePut:
 					err = p.handlePutEvent(e)
-				case mvccpb.DELETE:
+				case kv.EventTypeDelete:
 					err = p.handleDeleteEvent(e)
 				}
 				if err != nil {
@@ -144,7 +138,7 @@ func (p *proxyManager) startWatchEtcd(ctx context.Context, eventCh clientv3.Watc
 	}
 }
 
-func (p *proxyManager) handlePutEvent(e *clientv3.Event) error {
+func (p *proxyManager) handlePutEvent(e *kv.Event) error {
 	session, err := p.parseSession(e.Kv.Value)
 	if err != nil {
 		return err
@@ -157,7 +151,7 @@ func (p *proxyManager) handlePutEvent(e *clientv3.Event) error {
 	return nil
 }
 
-func (p *proxyManager) handleDeleteEvent(e *clientv3.Event) error {
+func (p *proxyManager) handleDeleteEvent(e *kv.Event) error {
 	session, err := p.parseSession(e.PrevKv.Value)
 	if err != nil {
 		return err
@@ -179,20 +173,17 @@ func (p *proxyManager) parseSession(value []byte) (*sessionutil.Session, error)
 	return session, nil
 }
 
-func (p *proxyManager) getSessionsOnEtcd(ctx context.Context) ([]*sessionutil.Session, int64, error) {
-	resp, err := p.etcdCli.Get(
-		ctx,
+func (p *proxyManager) getSessionsOnEtcd() ([]*sessionutil.Session, int64, error) {
+	_, values, rev, err := p.metaKv.LoadWithRevision(
 		path.Join(Params.EtcdCfg.MetaRootPath.GetValue(), sessionutil.DefaultServiceRoot, typeutil.ProxyRole),
-		clientv3.WithPrefix(),
-		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend),
 	)
 	if err != nil {
 		return nil, 0, fmt.Errorf("proxy manager failed to watch proxy with error %w", err)
 	}
 
 	var sessions []*sessionutil.Session
-	for _, v := range resp.Kvs {
-		session, err := p.parseSession(v.Value)
+	for _, v := range values {
+		session, err := p.parseSession([]byte(v))
 		if err != nil {
 			log.Warn("failed to unmarshal session", zap.Error(err))
 			return nil, 0, err
@@ -200,7 +191,7 @@ func (p *proxyManager) getSessionsOnEtcd(ctx context.Context) ([]*sessionutil.Se
 		sessions = append(sessions, session)
 	}
 
-	return sessions, resp.Header.Revision, nil
+	return sessions, rev, nil
 }
 
 // Stop stops the proxyManager
@@ -27,6 +27,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	clientv3 "go.etcd.io/etcd/client/v3"
 
+	"github.com/milvus-io/milvus/internal/kv"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -75,7 +77,7 @@ func TestProxyManager(t *testing.T) {
 		assert.Equal(t, int64(99), sess[1].ServerID)
 		t.Log("get sessions", sess[0], sess[1])
 	}
-	pm := newProxyManager(ctx, etcdCli, f1)
+	pm := newProxyManager(ctx, etcdkv.NewEtcdKV(etcdCli, ""), f1)
 	assert.Nil(t, err)
 	fa := func(sess *sessionutil.Session) {
 		assert.Equal(t, int64(101), sess.ServerID)
@@ -128,15 +130,14 @@ func TestProxyManager_ErrCompacted(t *testing.T) {
 	f1 := func(sess []*sessionutil.Session) {
 		t.Log("get sessions num", len(sess))
 	}
-	pm := newProxyManager(ctx, etcdCli, f1)
+	pm := newProxyManager(ctx, etcdkv.NewEtcdKV(etcdCli, ""), f1)
 
-	eventCh := pm.etcdCli.Watch(
+	eventCh := pm.metaKv.WatchWithOptions(
 		pm.ctx,
 		path.Join(Params.EtcdCfg.MetaRootPath.GetValue(), sessionutil.DefaultServiceRoot, typeutil.ProxyRole),
-		clientv3.WithPrefix(),
-		clientv3.WithCreatedNotify(),
-		clientv3.WithPrevKV(),
-		clientv3.WithRev(1),
+		kv.WithPrefix(),
+		kv.WithPrevKV(),
+		kv.WithRevision(1),
 	)
 
 	for i := 1; i < 10; i++ {
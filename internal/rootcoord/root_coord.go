@@ -397,6 +397,7 @@ func (c *Core) initImportManager() error {
 		f.NewGetSegmentStatesFunc(),
 		f.NewGetCollectionNameFunc(),
 		f.NewUnsetIsImportingStateFunc(),
+		f.NewDiscoverImportFilesFunc(),
 	)
 	c.importManager.init(c.ctx)
 
@@ -431,9 +432,13 @@ func (c *Core) initInternal() error {
 	c.garbageCollector = newBgGarbageCollector(c)
 	c.stepExecutor = newBgStepExecutor(c.ctx)
 
+	sessionKv, err := sessionutil.NewSessionMetaKv(c.etcdCli)
+	if err != nil {
+		return err
+	}
 	c.proxyManager = newProxyManager(
 		c.ctx,
-		c.etcdCli,
+		sessionKv,
 		c.chanTimeTick.initSessions,
 		c.proxyClientManager.GetProxyClients,
 	)
@@ -1054,13 +1059,25 @@ func (c *Core) AlterCollection(ctx context.Context, in *milvuspb.AlterCollection
 		zap.String("role", typeutil.RootCoordRole),
 		zap.String("name", in.GetCollectionName()))
 
-	t := &alterCollectionTask{
-		baseTask: baseTask{
-			ctx:  ctx,
-			core: c,
-			done: make(chan error, 1),
-		},
-		Req: in,
+	var t task
+	if isAddFieldRequest(in) {
+		t = &addCollectionFieldTask{
+			baseTask: baseTask{
+				ctx:  ctx,
+				core: c,
+				done: make(chan error, 1),
+			},
+			Req: in,
+		}
+	} else {
+		t = &alterCollectionTask{
+			baseTask: baseTask{
+				ctx:  ctx,
+				core: c,
+				done: make(chan error, 1),
+			},
+			Req: in,
+		}
 	}
 
 	if err := c.scheduler.AddTask(t); err != nil {
@@ -413,6 +413,36 @@ func (a *AlterCollectionStep) Desc() string {
 	return fmt.Sprintf("alter collection, collectionID: %d, ts: %d", a.oldColl.CollectionID, a.ts)
 }
 
+type addCollectionFieldStep struct {
+	baseStep
+	oldColl *model.Collection
+	newColl *model.Collection
+	ts      Timestamp
+}
+
+func (a *addCollectionFieldStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := a.core.meta.AddCollectionField(ctx, a.oldColl, a.newColl, a.ts)
+	return nil, err
+}
+
+func (a *addCollectionFieldStep) Desc() string {
+	return fmt.Sprintf("add collection field, collectionID: %d, ts: %d", a.oldColl.CollectionID, a.ts)
+}
+
+type manualCompactionStep struct {
+	baseStep
+	collectionID UniqueID
+}
+
+func (m *manualCompactionStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := m.core.broker.ManualCompaction(ctx, m.collectionID)
+	return nil, err
+}
+
+func (m *manualCompactionStep) Desc() string {
+	return fmt.Sprintf("manual compaction, collectionID: %d", m.collectionID)
+}
+
 type BroadcastAlteredCollectionStep struct {
 	baseStep
 	req  *milvuspb.AlterCollectionRequest
@@ -0,0 +1,147 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// BinlogVerifyResult is the summary of a binlog file and the inconsistencies found in it.
+type BinlogVerifyResult struct {
+	CollectionID    UniqueID
+	PartitionID     UniqueID
+	SegmentID       UniqueID
+	FieldID         FieldID
+	PayloadDataType schemapb.DataType
+	EventType       EventTypeCode
+	StartTimestamp  typeutil.Timestamp
+	EndTimestamp    typeutil.Timestamp
+	EventNum        int
+	RowNum          int
+	// Problems are the inconsistencies of the event headers, timestamps and payloads.
+	Problems []string
+}
+
+func (r *BinlogVerifyResult) addProblem(format string, args ...interface{}) {
+	r.Problems = append(r.Problems, fmt.Sprintf(format, args...))
+}
+
+// VerifyBinlog reads all the events of the binlog and checks the positions in the event headers,
+// the event timestamps against the descriptor and the payload row counts.
+// An error is returned only if the binlog can't be parsed at all.
+func VerifyBinlog(data []byte) (*BinlogVerifyResult, error) {
+	reader, err := NewBinlogReader(data)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	desc := reader.descriptorEvent
+	result := &BinlogVerifyResult{
+		CollectionID:    desc.CollectionID,
+		PartitionID:     desc.PartitionID,
+		SegmentID:       desc.SegmentID,
+		FieldID:         desc.FieldID,
+		PayloadDataType: desc.PayloadDataType,
+		StartTimestamp:  desc.StartTimestamp,
+		EndTimestamp:    desc.EndTimestamp,
+	}
+
+	if desc.descriptorEventHeader.TypeCode != DescriptorEventType {
+		result.addProblem("descriptor event type is %s", desc.descriptorEventHeader.TypeCode.String())
+	}
+	offset := int32(binary.Size(MagicNumber)) + desc.descriptorEventHeader.EventLength
+	if desc.descriptorEventHeader.NextPosition != offset {
+		result.addProblem("descriptor next position %d, expected %d", desc.descriptorEventHeader.NextPosition, offset)
+	}
+	if desc.StartTimestamp > desc.EndTimestamp {
+		result.addProblem("descriptor start timestamp %d is larger than end timestamp %d", desc.StartTimestamp, desc.EndTimestamp)
+	}
+
+	for {
+		event, err := reader.NextEventReader()
+		if err != nil {
+			result.addProblem("event %d is broken: %s", result.EventNum, err.Error())
+			break
+		}
+		if event == nil {
+			break
+		}
+
+		if result.EventNum == 0 {
+			result.EventType = event.TypeCode
+		} else if event.TypeCode != result.EventType {
+			result.addProblem("event %d type %s differs from the first event type %s", result.EventNum, event.TypeCode.String(), result.EventType.String())
+		}
+		if event.NextPosition != offset+event.EventLength {
+			result.addProblem("event %d next position %d, expected %d", result.EventNum, event.NextPosition, offset+event.EventLength)
+		}
+		offset += event.EventLength
+		if consumed := int32(len(data) - reader.buffer.Len()); consumed != offset {
+			result.addProblem("event %d ends at %d, but %d bytes are consumed", result.EventNum, offset, consumed)
+			offset = consumed
+		}
+
+		if start, end, ok := eventDataTimestamps(event.eventData); ok {
+			if start > end {
+				result.addProblem("event %d start timestamp %d is larger than end timestamp %d", result.EventNum, start, end)
+			}
+			if desc.EndTimestamp != 0 && (start < desc.StartTimestamp || end > desc.EndTimestamp) {
+				result.addProblem("event %d timestamp range [%d, %d] is out of descriptor range [%d, %d]",
+					result.EventNum, start, end, desc.StartTimestamp, desc.EndTimestamp)
+			}
+		}
+
+		rows, err := event.GetPayloadLengthFromReader()
+		if err != nil {
+			result.addProblem("event %d payload is broken: %s", result.EventNum, err.Error())
+		} else {
+			result.RowNum += rows
+		}
+		result.EventNum++
+	}
+
+	if len(data) != int(offset) {
+		result.addProblem("binlog size %d, but events end at %d", len(data), offset)
+	}
+	return result, nil
+}
+
+func eventDataTimestamps(data eventData) (typeutil.Timestamp, typeutil.Timestamp, bool) {
+	switch evd := data.(type) {
+	case *insertEventData:
+		return evd.StartTimestamp, evd.EndTimestamp, true
+	case *deleteEventData:
+		return evd.StartTimestamp, evd.EndTimestamp, true
+	case *createCollectionEventData:
+		return evd.StartTimestamp, evd.EndTimestamp, true
+	case *dropCollectionEventData:
+		return evd.StartTimestamp, evd.EndTimestamp, true
+	case *createPartitionEventData:
+		return evd.StartTimestamp, evd.EndTimestamp, true
+	case *dropPartitionEventData:
+		return evd.StartTimestamp, evd.EndTimestamp, true
+	case *indexFileEventData:
+		return evd.StartTimestamp, evd.EndTimestamp, true
+	default:
+		return 0, 0, false
+	}
+}
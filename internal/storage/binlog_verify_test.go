@@ -0,0 +1,90 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+)
+
+func writeInt64Binlog(t *testing.T, eventStart, eventEnd uint64) []byte {
+	w := NewInsertBinlogWriter(schemapb.DataType_Int64, 10, 20, 30, 40)
+	defer w.Close()
+
+	e1, err := w.NextInsertEventWriter()
+	assert.NoError(t, err)
+	err = e1.AddDataToPayload([]int64{1, 2, 3})
+	assert.NoError(t, err)
+	e1.SetEventTimestamp(100, 200)
+
+	e2, err := w.NextInsertEventWriter()
+	assert.NoError(t, err)
+	err = e2.AddDataToPayload([]int64{4, 5})
+	assert.NoError(t, err)
+	e2.SetEventTimestamp(eventStart, eventEnd)
+
+	w.SetEventTimeStamp(100, 400)
+	w.AddExtra(originalSizeKey, "40")
+	err = w.Finish()
+	assert.NoError(t, err)
+	buf, err := w.GetBuffer()
+	assert.NoError(t, err)
+	return buf
+}
+
+func TestVerifyBinlog(t *testing.T) {
+	t.Run("valid binlog", func(t *testing.T) {
+		result, err := VerifyBinlog(writeInt64Binlog(t, 300, 400))
+		assert.NoError(t, err)
+		assert.Empty(t, result.Problems)
+		assert.Equal(t, UniqueID(10), result.CollectionID)
+		assert.Equal(t, UniqueID(20), result.PartitionID)
+		assert.Equal(t, UniqueID(30), result.SegmentID)
+		assert.Equal(t, FieldID(40), result.FieldID)
+		assert.Equal(t, InsertEventType, result.EventType)
+		assert.Equal(t, 2, result.EventNum)
+		assert.Equal(t, 5, result.RowNum)
+	})
+
+	t.Run("event out of descriptor range", func(t *testing.T) {
+		result, err := VerifyBinlog(writeInt64Binlog(t, 300, 500))
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(result.Problems))
+		assert.Equal(t, 5, result.RowNum)
+	})
+
+	t.Run("reversed event timestamps", func(t *testing.T) {
+		result, err := VerifyBinlog(writeInt64Binlog(t, 400, 300))
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(result.Problems))
+	})
+
+	t.Run("truncated binlog", func(t *testing.T) {
+		buf := writeInt64Binlog(t, 300, 400)
+		result, err := VerifyBinlog(buf[:len(buf)-10])
+		assert.NoError(t, err)
+		assert.NotEmpty(t, result.Problems)
+	})
+
+	t.Run("invalid magic number", func(t *testing.T) {
+		_, err := VerifyBinlog([]byte{1, 2, 3, 4, 5})
+		assert.Error(t, err)
+	})
+}
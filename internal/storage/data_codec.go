@@ -110,28 +110,36 @@ type FieldData interface {
 }
 
 type BoolFieldData struct {
-	Data []bool
+	Data      []bool
+	ValidData []bool
 }
 type Int8FieldData struct {
-	Data []int8
+	Data      []int8
+	ValidData []bool
 }
 type Int16FieldData struct {
-	Data []int16
+	Data      []int16
+	ValidData []bool
 }
 type Int32FieldData struct {
-	Data []int32
+	Data      []int32
+	ValidData []bool
 }
 type Int64FieldData struct {
-	Data []int64
+	Data      []int64
+	ValidData []bool
 }
 type FloatFieldData struct {
-	Data []float32
+	Data      []float32
+	ValidData []bool
 }
 type DoubleFieldData struct {
-	Data []float64
+	Data      []float64
+	ValidData []bool
 }
 type StringFieldData struct {
-	Data []string
+	Data      []string
+	ValidData []bool
 }
 type ArrayFieldData struct {
 	ElementType schemapb.DataType
@@ -149,6 +157,34 @@ type FloatVectorFieldData struct {
 	Dim  int
 }
 
+// NullableFieldData is implemented by the field data of scalar fields which could be null.
+// ValidData marks whether each row is not null, nil ValidData means that all rows are valid.
+type NullableFieldData interface {
+	FieldData
+	GetValidData() []bool
+	SetValidData(validData []bool)
+}
+
+// GetValidData implements NullableFieldData.GetValidData
+func (data *BoolFieldData) GetValidData() []bool   { return data.ValidData }
+func (data *Int8FieldData) GetValidData() []bool   { return data.ValidData }
+func (data *Int16FieldData) GetValidData() []bool  { return data.ValidData }
+func (data *Int32FieldData) GetValidData() []bool  { return data.ValidData }
+func (data *Int64FieldData) GetValidData() []bool  { return data.ValidData }
+func (data *FloatFieldData) GetValidData() []bool  { return data.ValidData }
+func (data *DoubleFieldData) GetValidData() []bool { return data.ValidData }
+func (data *StringFieldData) GetValidData() []bool { return data.ValidData }
+
+// SetValidData implements NullableFieldData.SetValidData
+func (data *BoolFieldData) SetValidData(validData []bool)   { data.ValidData = validData }
+func (data *Int8FieldData) SetValidData(validData []bool)   { data.ValidData = validData }
+func (data *Int16FieldData) SetValidData(validData []bool)  { data.ValidData = validData }
+func (data *Int32FieldData) SetValidData(validData []bool)  { data.ValidData = validData }
+func (data *Int64FieldData) SetValidData(validData []bool)  { data.ValidData = validData }
+func (data *FloatFieldData) SetValidData(validData []bool)  { data.ValidData = validData }
+func (data *DoubleFieldData) SetValidData(validData []bool) { data.ValidData = validData }
+func (data *StringFieldData) SetValidData(validData []bool) { data.ValidData = validData }
+
 // RowNum implements FieldData.RowNum
 func (data *BoolFieldData) RowNum() int         { return len(data.Data) }
 func (data *Int8FieldData) RowNum() int         { return len(data.Data) }
@@ -466,6 +502,9 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 		if err != nil {
 			return nil, nil, err
 		}
+		if nullable, ok := singleData.(NullableFieldData); ok && nullable.GetValidData() != nil {
+			writer.AddExtra(validDataKey, encodeValidData(nullable.GetValidData()))
+		}
 		writer.SetEventTimeStamp(typeutil.Timestamp(startTs), typeutil.Timestamp(endTs))
 
 		err = writer.Finish()
@@ -552,6 +591,10 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 		fieldID := binlogReader.FieldID
 		totalLength := 0
 		dim := 0
+		rowsBefore := 0
+		if fieldData, ok := insertData.Data[fieldID]; ok {
+			rowsBefore = fieldData.RowNum()
+		}
 
 		for {
 			eventReader, err := binlogReader.NextEventReader()
@@ -814,6 +857,13 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 			eventReader.Close()
 		}
 
+		if fieldData, ok := insertData.Data[fieldID]; ok {
+			if err := appendValidData(fieldData, rowsBefore, totalLength, binlogReader.Extras[validDataKey]); err != nil {
+				binlogReader.Close()
+				return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+			}
+		}
+
 		if rowNum <= 0 {
 			rowNum = totalLength
 		}
@@ -23,8 +23,10 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/stretchr/testify/assert"
 )
@@ -291,16 +293,16 @@ func TestInsertCodec(t *testing.T) {
 
 	insertDataEmpty := &InsertData{
 		Data: map[int64]FieldData{
-			RowIDField:        &Int64FieldData{[]int64{}},
-			TimestampField:    &Int64FieldData{[]int64{}},
-			BoolField:         &BoolFieldData{[]bool{}},
-			Int8Field:         &Int8FieldData{[]int8{}},
-			Int16Field:        &Int16FieldData{[]int16{}},
-			Int32Field:        &Int32FieldData{[]int32{}},
-			Int64Field:        &Int64FieldData{[]int64{}},
-			FloatField:        &FloatFieldData{[]float32{}},
-			DoubleField:       &DoubleFieldData{[]float64{}},
-			StringField:       &StringFieldData{[]string{}},
+			RowIDField:        &Int64FieldData{Data: []int64{}},
+			TimestampField:    &Int64FieldData{Data: []int64{}},
+			BoolField:         &BoolFieldData{Data: []bool{}},
+			Int8Field:         &Int8FieldData{Data: []int8{}},
+			Int16Field:        &Int16FieldData{Data: []int16{}},
+			Int32Field:        &Int32FieldData{Data: []int32{}},
+			Int64Field:        &Int64FieldData{Data: []int64{}},
+			FloatField:        &FloatFieldData{Data: []float32{}},
+			DoubleField:       &DoubleFieldData{Data: []float64{}},
+			StringField:       &StringFieldData{Data: []string{}},
 			BinaryVectorField: &BinaryVectorFieldData{[]byte{}, 8},
 			FloatVectorField:  &FloatVectorFieldData{[]float32{}, 4},
 			ArrayField:        &ArrayFieldData{schemapb.DataType_Int32, []*schemapb.ScalarField{}},
@@ -375,6 +377,68 @@ func TestInsertCodec(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestInsertCodecValidData(t *testing.T) {
+	schema := &etcdpb.CollectionMeta{
+		ID: CollectionID,
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: RowIDField, Name: "row_id", DataType: schemapb.DataType_Int64},
+				{FieldID: TimestampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+				{
+					FieldID:    Int64Field,
+					Name:       "field_int64",
+					DataType:   schemapb.DataType_Int64,
+					TypeParams: []*commonpb.KeyValuePair{{Key: common.FieldNullableKey, Value: "true"}},
+				},
+				{FieldID: StringField, Name: "field_string", DataType: schemapb.DataType_String},
+			},
+		},
+	}
+	insertCodec := NewInsertCodecWithSchema(schema)
+	insertData1 := &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField:     &Int64FieldData{Data: []int64{1, 2, 3}},
+			TimestampField: &Int64FieldData{Data: []int64{1, 2, 3}},
+			Int64Field:     &Int64FieldData{Data: []int64{1, 0, 3}, ValidData: []bool{true, false, true}},
+			StringField:    &StringFieldData{Data: []string{"1", "2", "3"}},
+		},
+	}
+	insertData2 := &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField:     &Int64FieldData{Data: []int64{4, 5}},
+			TimestampField: &Int64FieldData{Data: []int64{4, 5}},
+			Int64Field:     &Int64FieldData{Data: []int64{4, 5}},
+			StringField:    &StringFieldData{Data: []string{"4", "5"}},
+		},
+	}
+
+	blobs1, _, err := insertCodec.Serialize(PartitionID, SegmentID, insertData1)
+	assert.NoError(t, err)
+	for _, blob := range blobs1 {
+		blob.Key = fmt.Sprintf("1/insert_log/2/3/4/5/%d", 100)
+	}
+	blobs2, _, err := insertCodec.Serialize(PartitionID, SegmentID, insertData2)
+	assert.NoError(t, err)
+	for _, blob := range blobs2 {
+		blob.Key = fmt.Sprintf("1/insert_log/2/3/4/5/%d", 99)
+	}
+
+	_, _, data, err := insertCodec.Deserialize(append(blobs2, blobs1...))
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{4, 5, 1, 0, 3}, data.Data[Int64Field].(*Int64FieldData).Data)
+	assert.Equal(t, []bool{true, true, true, false, true}, data.Data[Int64Field].(*Int64FieldData).ValidData)
+	assert.Nil(t, data.Data[StringField].(*StringFieldData).ValidData)
+
+	merged := MergeInsertData(insertData2, insertData1)
+	assert.Equal(t, []bool{true, true, true, false, true}, merged.Data[Int64Field].(*Int64FieldData).ValidData)
+
+	validData, err := decodeValidData(encodeValidData([]bool{false, true, true, false, true, true, true, true, false}), 9)
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{false, true, true, false, true, true, true, true, false}, validData)
+	_, err = decodeValidData(encodeValidData([]bool{true}), 9)
+	assert.Error(t, err)
+}
+
 func TestDeleteCodec(t *testing.T) {
 	t.Run("int64 pk", func(t *testing.T) {
 		deleteCodec := NewDeleteCodec()
@@ -631,16 +695,16 @@ func TestMemorySize(t *testing.T) {
 
 	insertDataEmpty := &InsertData{
 		Data: map[int64]FieldData{
-			RowIDField:        &Int64FieldData{[]int64{}},
-			TimestampField:    &Int64FieldData{[]int64{}},
-			BoolField:         &BoolFieldData{[]bool{}},
-			Int8Field:         &Int8FieldData{[]int8{}},
-			Int16Field:        &Int16FieldData{[]int16{}},
-			Int32Field:        &Int32FieldData{[]int32{}},
-			Int64Field:        &Int64FieldData{[]int64{}},
-			FloatField:        &FloatFieldData{[]float32{}},
-			DoubleField:       &DoubleFieldData{[]float64{}},
-			StringField:       &StringFieldData{[]string{}},
+			RowIDField:        &Int64FieldData{Data: []int64{}},
+			TimestampField:    &Int64FieldData{Data: []int64{}},
+			BoolField:         &BoolFieldData{Data: []bool{}},
+			Int8Field:         &Int8FieldData{Data: []int8{}},
+			Int16Field:        &Int16FieldData{Data: []int16{}},
+			Int32Field:        &Int32FieldData{Data: []int32{}},
+			Int64Field:        &Int64FieldData{Data: []int64{}},
+			FloatField:        &FloatFieldData{Data: []float32{}},
+			DoubleField:       &DoubleFieldData{Data: []float64{}},
+			StringField:       &StringFieldData{Data: []string{}},
 			BinaryVectorField: &BinaryVectorFieldData{[]byte{}, 8},
 			FloatVectorField:  &FloatVectorFieldData{[]float32{}, 4},
 		},
@@ -104,6 +104,10 @@ func (ds *DataSorter) Swap(i, j int) {
 			errMsg := "undefined data type " + string(field.DataType)
 			panic(errMsg)
 		}
+		if nullable, ok := singleData.(NullableFieldData); ok && nullable.GetValidData() != nil {
+			validData := nullable.GetValidData()
+			validData[i], validData[j] = validData[j], validData[i]
+		}
 	}
 }
 
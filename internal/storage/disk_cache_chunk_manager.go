@@ -0,0 +1,451 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+	"golang.org/x/exp/mmap"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// diskCacheEntry is a remote file kept on the local disk.
+type diskCacheEntry struct {
+	filePath string
+	size     int64
+	pins     int
+	elem     *list.Element
+}
+
+// DiskCacheChunkManager keeps the files read from a remote ChunkManager on the local disk,
+// so that reading them again is a local read.
+// The cached files are bounded by capacity in bytes, and evicted in LRU order.
+// A pinned file is never evicted, it is used to keep the files of loaded segments on disk.
+// A pinned file removed from the cache still takes its room until it is unpinned, as it may be mapped.
+type DiskCacheChunkManager struct {
+	ChunkManager
+
+	localStorage *LocalChunkManager
+	cacheDir     string
+	capacity     int64
+
+	mut     sync.Mutex // protects the fields below
+	size    int64      // including the files being written and the removed files still pinned
+	lru     *list.List // front is the most recently used
+	entries map[string]*diskCacheEntry
+	removed map[string][]*diskCacheEntry // the removed files still pinned, oldest first
+	tmpSeq  int64
+
+	loading singleflight.Group
+}
+
+var _ ChunkManager = (*DiskCacheChunkManager)(nil)
+
+const (
+	// diskCacheMarker marks the folder created by disk cache, only which is cleaned at startup
+	diskCacheMarker = ".milvus_disk_cache"
+	// diskCacheMmapDir is the folder under cacheDir of the segments loaded onto disk with mmap
+	diskCacheMmapDir = "mmap"
+)
+
+// NewDiskCacheChunkManager creates a disk cache of remoteStorage, stored under cacheDir.
+// The files left in cacheDir are cleaned, as they may be partially written.
+// It fails if cacheDir is not empty and not created by disk cache, to never remove the files of others.
+func NewDiskCacheChunkManager(ctx context.Context, remoteStorage ChunkManager, cacheDir string, capacity int64) (*DiskCacheChunkManager, error) {
+	if capacity <= 0 {
+		return nil, errors.New("disk cache capacity must be positive")
+	}
+	localStorage := NewLocalChunkManager(RootPath(cacheDir))
+	if err := checkDiskCacheDir(cacheDir); err != nil {
+		return nil, err
+	}
+	if err := localStorage.Remove(ctx, cacheDir); err != nil {
+		return nil, err
+	}
+	if err := localStorage.Write(ctx, path.Join(cacheDir, diskCacheMarker), nil); err != nil {
+		return nil, err
+	}
+
+	log.Info("disk cache created", zap.String("cacheDir", cacheDir), zap.Int64("capacity", capacity))
+	return &DiskCacheChunkManager{
+		ChunkManager: remoteStorage,
+		localStorage: localStorage,
+		cacheDir:     cacheDir,
+		capacity:     capacity,
+		lru:          list.New(),
+		entries:      make(map[string]*diskCacheEntry),
+		removed:      make(map[string][]*diskCacheEntry),
+	}, nil
+}
+
+// checkDiskCacheDir checks that cacheDir is empty or created by disk cache.
+func checkDiskCacheDir(cacheDir string) error {
+	entries, err := os.ReadDir(cacheDir)
+	if os.IsNotExist(err) || len(entries) == 0 {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(path.Join(cacheDir, diskCacheMarker)); err != nil {
+		return fmt.Errorf("disk cache dir %s is not empty and not created by disk cache: %w", cacheDir, err)
+	}
+	return nil
+}
+
+// MmapDir returns the folder to load the segments onto disk with mmap,
+// the segments loaded there are read lazily from disk instead of consuming memory.
+func (dcm *DiskCacheChunkManager) MmapDir() string {
+	return path.Join(dcm.cacheDir, diskCacheMmapDir)
+}
+
+// Pin keeps the cached files from being evicted, until they are unpinned as many times.
+// The files not cached are ignored.
+func (dcm *DiskCacheChunkManager) Pin(filePaths ...string) {
+	dcm.mut.Lock()
+	defer dcm.mut.Unlock()
+	for _, filePath := range filePaths {
+		if entry, ok := dcm.entries[filePath]; ok {
+			entry.pins++
+		}
+	}
+}
+
+// Unpin releases the pins acquired by Pin.
+func (dcm *DiskCacheChunkManager) Unpin(filePaths ...string) {
+	dcm.mut.Lock()
+	defer dcm.mut.Unlock()
+	for _, filePath := range filePaths {
+		dcm.unpin(filePath)
+	}
+	dcm.evict(0)
+}
+
+// unpin releases a pin of the file, the pins of the removed file are released first,
+// as they are acquired before the file is cached again.
+// The room of the removed file is released with its last pin, must be called with mut held.
+func (dcm *DiskCacheChunkManager) unpin(filePath string) {
+	if removed := dcm.removed[filePath]; len(removed) > 0 {
+		entry := removed[0]
+		entry.pins--
+		if entry.pins == 0 {
+			dcm.size -= entry.size
+			if len(removed) == 1 {
+				delete(dcm.removed, filePath)
+			} else {
+				dcm.removed[filePath] = removed[1:]
+			}
+		}
+		return
+	}
+	if entry, ok := dcm.entries[filePath]; ok && entry.pins > 0 {
+		entry.pins--
+	}
+}
+
+// Cached returns whether the file is cached on the local disk.
+func (dcm *DiskCacheChunkManager) Cached(filePath string) bool {
+	dcm.mut.Lock()
+	defer dcm.mut.Unlock()
+	_, ok := dcm.entries[filePath]
+	return ok
+}
+
+// CachedSize returns the room taken by the cache,
+// which includes the files being written and the removed files still pinned.
+func (dcm *DiskCacheChunkManager) CachedSize() int64 {
+	dcm.mut.Lock()
+	defer dcm.mut.Unlock()
+	return dcm.size
+}
+
+// localPath returns the path of the cached file on the local disk.
+func (dcm *DiskCacheChunkManager) localPath(filePath string) string {
+	return path.Join(dcm.cacheDir, filePath)
+}
+
+// acquire pins the file if it is cached, and marks it as most recently used.
+func (dcm *DiskCacheChunkManager) acquire(filePath string) bool {
+	dcm.mut.Lock()
+	defer dcm.mut.Unlock()
+	return dcm.acquireLocked(filePath)
+}
+
+// acquireLocked must be called with mut held.
+func (dcm *DiskCacheChunkManager) acquireLocked(filePath string) bool {
+	entry, ok := dcm.entries[filePath]
+	if !ok {
+		return false
+	}
+	entry.pins++
+	dcm.lru.MoveToFront(entry.elem)
+	return true
+}
+
+// evict removes the least recently used files not pinned,
+// until there is room for another file of the given size.
+// Returns whether there is enough room, must be called with mut held.
+func (dcm *DiskCacheChunkManager) evict(size int64) bool {
+	for elem := dcm.lru.Back(); elem != nil && dcm.size+size > dcm.capacity; {
+		entry := elem.Value.(*diskCacheEntry)
+		elem = elem.Prev()
+		if entry.pins > 0 {
+			continue
+		}
+		dcm.removeEntry(entry)
+	}
+	return dcm.size+size <= dcm.capacity
+}
+
+// removeEntry must be called with mut held.
+func (dcm *DiskCacheChunkManager) removeEntry(entry *diskCacheEntry) {
+	dcm.lru.Remove(entry.elem)
+	delete(dcm.entries, entry.filePath)
+	if entry.pins > 0 {
+		// the room is released once unpinned, as the file may be mapped
+		dcm.removed[entry.filePath] = append(dcm.removed[entry.filePath], entry)
+	} else {
+		dcm.size -= entry.size
+	}
+	// the file is still readable by the ones mapped it before
+	dcm.removeLocal(dcm.localPath(entry.filePath))
+}
+
+// removeLocal removes the file on the local disk, it needs no lock.
+func (dcm *DiskCacheChunkManager) removeLocal(localPath string) {
+	if err := dcm.localStorage.Remove(context.Background(), localPath); err != nil {
+		log.Warn("failed to remove file from disk cache", zap.String("localPath", localPath), zap.Error(err))
+	}
+}
+
+// fetch downloads the file into the cache and pins it.
+// Returns the content of the file, which is not cached if there is no room for it.
+func (dcm *DiskCacheChunkManager) fetch(ctx context.Context, filePath string) ([]byte, bool, error) {
+	v, err, _ := dcm.loading.Do(filePath, func() (interface{}, error) {
+		return dcm.ChunkManager.Read(ctx, filePath)
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	content := v.([]byte)
+	size := int64(len(content))
+
+	tmpPath, cached := dcm.reserve(filePath, size)
+	if tmpPath == "" {
+		return content, cached, nil
+	}
+	// write without holding mut, the room is reserved
+	if err := dcm.localStorage.Write(ctx, tmpPath, content); err != nil {
+		log.Warn("failed to write file into disk cache", zap.String("filePath", filePath), zap.Error(err))
+		dcm.unreserve(size)
+		dcm.removeLocal(tmpPath)
+		return content, false, nil
+	}
+	published, err := dcm.publish(filePath, tmpPath, size)
+	if err != nil {
+		log.Warn("failed to write file into disk cache", zap.String("filePath", filePath), zap.Error(err))
+		dcm.removeLocal(tmpPath)
+		return content, false, nil
+	}
+	if !published {
+		dcm.removeLocal(tmpPath)
+	}
+	return content, true, nil
+}
+
+// reserve evicts files for the room of the file, and returns the temp path to write the file into.
+// Returns an empty path if the file is cached by a concurrent fetch, which is pinned then, or there is no room.
+func (dcm *DiskCacheChunkManager) reserve(filePath string, size int64) (string, bool) {
+	dcm.mut.Lock()
+	defer dcm.mut.Unlock()
+	if dcm.acquireLocked(filePath) {
+		// cached by a concurrent fetch
+		return "", true
+	}
+	if !dcm.evict(size) {
+		log.Warn("no room in disk cache, read without caching",
+			zap.String("filePath", filePath),
+			zap.Int64("size", size),
+			zap.Int64("cachedSize", dcm.size),
+			zap.Int64("capacity", dcm.capacity))
+		return "", false
+	}
+	dcm.size += size
+	dcm.tmpSeq++
+	return fmt.Sprintf("%s.%d.tmp", dcm.localPath(filePath), dcm.tmpSeq), false
+}
+
+// unreserve releases the room reserved by reserve.
+func (dcm *DiskCacheChunkManager) unreserve(size int64) {
+	dcm.mut.Lock()
+	defer dcm.mut.Unlock()
+	dcm.size -= size
+}
+
+// publish renames the written temp file to the cached file and pins it,
+// returns false if the file is cached by a concurrent fetch, which is pinned instead.
+// The reserved room is released unless the temp file is published.
+func (dcm *DiskCacheChunkManager) publish(filePath string, tmpPath string, size int64) (bool, error) {
+	dcm.mut.Lock()
+	defer dcm.mut.Unlock()
+	if dcm.acquireLocked(filePath) {
+		dcm.size -= size
+		return false, nil
+	}
+	if err := os.Rename(tmpPath, dcm.localPath(filePath)); err != nil {
+		dcm.size -= size
+		return false, err
+	}
+	entry := &diskCacheEntry{
+		filePath: filePath,
+		size:     size,
+		pins:     1,
+	}
+	entry.elem = dcm.lru.PushFront(entry)
+	dcm.entries[filePath] = entry
+	return true, nil
+}
+
+// Read reads the file from the local disk if cached, otherwise downloads it into the cache.
+func (dcm *DiskCacheChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	if dcm.acquire(filePath) {
+		defer dcm.Unpin(filePath)
+		return dcm.localStorage.Read(ctx, dcm.localPath(filePath))
+	}
+	content, cached, err := dcm.fetch(ctx, filePath)
+	if cached {
+		dcm.Unpin(filePath)
+	}
+	return content, err
+}
+
+// MultiRead reads the files through the cache.
+func (dcm *DiskCacheChunkManager) MultiRead(ctx context.Context, filePaths []string) ([][]byte, error) {
+	results := make([][]byte, len(filePaths))
+	for i, filePath := range filePaths {
+		content, err := dcm.Read(ctx, filePath)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = content
+	}
+	return results, nil
+}
+
+// ReadWithPrefix reads the files with the prefix through the cache.
+func (dcm *DiskCacheChunkManager) ReadWithPrefix(ctx context.Context, prefix string) ([]string, [][]byte, error) {
+	filePaths, _, err := dcm.ListWithPrefix(ctx, prefix, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	results, err := dcm.MultiRead(ctx, filePaths)
+	if err != nil {
+		return nil, nil, err
+	}
+	return filePaths, results, nil
+}
+
+// ReadAt reads the specific position of the file through the cache.
+func (dcm *DiskCacheChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	if !dcm.acquire(filePath) {
+		content, cached, err := dcm.fetch(ctx, filePath)
+		if err != nil {
+			return nil, err
+		}
+		if !cached {
+			if off < 0 || int64(len(content)) < off {
+				return nil, errors.New("diskCacheChunkManager: invalid offset")
+			}
+			p := make([]byte, length)
+			n := copy(p, content[off:])
+			if n < len(p) {
+				return nil, io.EOF
+			}
+			return p, nil
+		}
+	}
+	defer dcm.Unpin(filePath)
+	return dcm.localStorage.ReadAt(ctx, dcm.localPath(filePath), off, length)
+}
+
+// Mmap maps the cached file, downloading it into the cache first if not cached.
+// The mapping stays valid even if the file is evicted later.
+func (dcm *DiskCacheChunkManager) Mmap(ctx context.Context, filePath string) (*mmap.ReaderAt, error) {
+	if !dcm.acquire(filePath) {
+		_, cached, err := dcm.fetch(ctx, filePath)
+		if err != nil {
+			return nil, err
+		}
+		if !cached {
+			return nil, errors.New("no room in disk cache to mmap file " + filePath)
+		}
+	}
+	defer dcm.Unpin(filePath)
+	return dcm.localStorage.Mmap(ctx, dcm.localPath(filePath))
+}
+
+// Invalidate removes the files from the cache, even if they are pinned.
+func (dcm *DiskCacheChunkManager) Invalidate(filePaths ...string) {
+	dcm.mut.Lock()
+	defer dcm.mut.Unlock()
+	for _, filePath := range filePaths {
+		if entry, ok := dcm.entries[filePath]; ok {
+			dcm.removeEntry(entry)
+		}
+	}
+}
+
+// Remove removes the file from the remote storage and the cache.
+func (dcm *DiskCacheChunkManager) Remove(ctx context.Context, filePath string) error {
+	if err := dcm.ChunkManager.Remove(ctx, filePath); err != nil {
+		return err
+	}
+	dcm.Invalidate(filePath)
+	return nil
+}
+
+// MultiRemove removes the files from the remote storage and the cache.
+func (dcm *DiskCacheChunkManager) MultiRemove(ctx context.Context, filePaths []string) error {
+	if err := dcm.ChunkManager.MultiRemove(ctx, filePaths); err != nil {
+		return err
+	}
+	dcm.Invalidate(filePaths...)
+	return nil
+}
+
+// RemoveWithPrefix removes the files with the prefix from the remote storage and the cache.
+func (dcm *DiskCacheChunkManager) RemoveWithPrefix(ctx context.Context, prefix string) error {
+	filePaths, _, err := dcm.ListWithPrefix(ctx, prefix, true)
+	if err != nil {
+		return err
+	}
+	if err := dcm.ChunkManager.RemoveWithPrefix(ctx, prefix); err != nil {
+		return err
+	}
+	dcm.Invalidate(filePaths...)
+	return nil
+}
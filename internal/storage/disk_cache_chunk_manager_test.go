@@ -0,0 +1,184 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"os"
+	"path"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiskCacheChunkManager(t *testing.T) {
+	ctx := context.Background()
+	remoteDir := t.TempDir()
+	remote := NewLocalChunkManager(RootPath(remoteDir))
+	files := map[string][]byte{
+		path.Join(remoteDir, "a"): []byte("aaaa"),
+		path.Join(remoteDir, "b"): []byte("bbbb"),
+		path.Join(remoteDir, "c"): []byte("cccc"),
+	}
+	require.NoError(t, remote.MultiWrite(ctx, files))
+	a, b, c := path.Join(remoteDir, "a"), path.Join(remoteDir, "b"), path.Join(remoteDir, "c")
+
+	_, err := NewDiskCacheChunkManager(ctx, remote, t.TempDir(), 0)
+	assert.Error(t, err)
+
+	t.Run("cache dir", func(t *testing.T) {
+		// the files not created by disk cache are never removed
+		_, err := NewDiskCacheChunkManager(ctx, remote, remoteDir, 8)
+		assert.Error(t, err)
+		exist, err := remote.Exist(ctx, a)
+		assert.NoError(t, err)
+		assert.True(t, exist)
+
+		cacheDir := path.Join(t.TempDir(), "disk_cache")
+		dcm, err := NewDiskCacheChunkManager(ctx, remote, cacheDir, 8)
+		require.NoError(t, err)
+		assert.Equal(t, path.Join(cacheDir, "mmap"), dcm.MmapDir())
+		_, err = dcm.Read(ctx, a)
+		require.NoError(t, err)
+
+		// the files left by the last run are cleaned
+		_, err = NewDiskCacheChunkManager(ctx, remote, cacheDir, 8)
+		require.NoError(t, err)
+		_, err = os.Stat(dcm.localPath(a))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("read through cache", func(t *testing.T) {
+		dcm, err := NewDiskCacheChunkManager(ctx, remote, t.TempDir(), 8)
+		require.NoError(t, err)
+
+		content, err := dcm.Read(ctx, a)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("aaaa"), content)
+		assert.True(t, dcm.Cached(a))
+		assert.Equal(t, int64(4), dcm.CachedSize())
+
+		content, err = dcm.ReadAt(ctx, a, 1, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("aa"), content)
+
+		r, err := dcm.Mmap(ctx, b)
+		require.NoError(t, err)
+		assert.Equal(t, 4, r.Len())
+		r.Close()
+
+		// a is the least recently used
+		_, err = dcm.Read(ctx, b)
+		assert.NoError(t, err)
+		_, err = dcm.Read(ctx, c)
+		assert.NoError(t, err)
+		assert.False(t, dcm.Cached(a))
+		assert.True(t, dcm.Cached(b))
+		assert.True(t, dcm.Cached(c))
+		assert.Equal(t, int64(8), dcm.CachedSize())
+
+		_, err = dcm.Read(ctx, path.Join(remoteDir, "not_exist"))
+		assert.Error(t, err)
+	})
+
+	t.Run("pinned files are not evicted", func(t *testing.T) {
+		dcm, err := NewDiskCacheChunkManager(ctx, remote, t.TempDir(), 8)
+		require.NoError(t, err)
+
+		_, err = dcm.MultiRead(ctx, []string{a, b})
+		require.NoError(t, err)
+		dcm.Pin(a, b)
+
+		// no room for c, read without caching
+		content, err := dcm.Read(ctx, c)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("cccc"), content)
+		assert.False(t, dcm.Cached(c))
+		_, err = dcm.Mmap(ctx, c)
+		assert.Error(t, err)
+
+		dcm.Unpin(a)
+		_, err = dcm.Read(ctx, c)
+		assert.NoError(t, err)
+		assert.False(t, dcm.Cached(a))
+		assert.True(t, dcm.Cached(b))
+		assert.True(t, dcm.Cached(c))
+	})
+
+	t.Run("concurrent read", func(t *testing.T) {
+		dcm, err := NewDiskCacheChunkManager(ctx, remote, t.TempDir(), 8)
+		require.NoError(t, err)
+
+		wg := sync.WaitGroup{}
+		for i := 0; i < 30; i++ {
+			filePath := []string{a, b, c}[i%3]
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				content, err := dcm.Read(ctx, filePath)
+				assert.NoError(t, err)
+				assert.Equal(t, files[filePath], content)
+			}()
+		}
+		wg.Wait()
+
+		cached := 0
+		for _, filePath := range []string{a, b, c} {
+			if dcm.Cached(filePath) {
+				cached++
+			}
+		}
+		assert.Equal(t, 2, cached)
+		assert.Equal(t, int64(8), dcm.CachedSize())
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		dcm, err := NewDiskCacheChunkManager(ctx, remote, t.TempDir(), 8)
+		require.NoError(t, err)
+
+		_, err = dcm.Read(ctx, a)
+		require.NoError(t, err)
+		dcm.Pin(a)
+		assert.NoError(t, dcm.Remove(ctx, a))
+		assert.False(t, dcm.Cached(a))
+		// the pinned file takes its room until unpinned
+		assert.Equal(t, int64(4), dcm.CachedSize())
+		dcm.Unpin(a)
+		assert.Equal(t, int64(0), dcm.CachedSize())
+
+		exist, err := remote.Exist(ctx, a)
+		assert.NoError(t, err)
+		assert.False(t, exist)
+	})
+
+	t.Run("write failure", func(t *testing.T) {
+		cacheDir := t.TempDir()
+		dcm, err := NewDiskCacheChunkManager(ctx, remote, cacheDir, 8)
+		require.NoError(t, err)
+
+		// the folder of the cached file is occupied by a file
+		require.NoError(t, os.MkdirAll(path.Dir(path.Join(cacheDir, remoteDir)), os.ModePerm))
+		require.NoError(t, os.WriteFile(path.Join(cacheDir, remoteDir), nil, os.ModePerm))
+		content, err := dcm.Read(ctx, b)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("bbbb"), content)
+		assert.False(t, dcm.Cached(b))
+		assert.Equal(t, int64(0), dcm.CachedSize())
+	})
+}
@@ -32,6 +32,9 @@ import (
 
 const originalSizeKey = "original_size"
 
+// validDataKey is the extra key of the validity bitmap of nullable fields.
+const validDataKey = "valid_data"
+
 type descriptorEventData struct {
 	DescriptorEventDataFixPart
 	ExtraLength       int32
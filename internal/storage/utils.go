@@ -18,6 +18,7 @@ package storage
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/binary"
 	"fmt"
 	"io"
@@ -400,6 +401,16 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 	}
 
 	for _, field := range collSchema.Fields {
+		// the field is added after the message was produced, fill it with the default value.
+		if _, ok := srcFields[field.FieldID]; !ok && typeutil.IsFieldFillable(field) {
+			fieldData, err := GenDefaultFieldData(field, int(msg.NRows()))
+			if err != nil {
+				return nil, err
+			}
+			idata.Data[field.FieldID] = fieldData
+			continue
+		}
+
 		switch field.DataType {
 		case schemapb.DataType_FloatVector:
 			dim, err := GetDimFromParams(field.TypeParams)
@@ -703,6 +714,14 @@ func MergeFieldData(data *InsertData, fid FieldID, field FieldData) {
 	if field == nil {
 		return
 	}
+	rowsBefore := 0
+	if fieldData, ok := data.Data[fid]; ok {
+		rowsBefore = fieldData.RowNum()
+	}
+	defer func() {
+		mergeValidData(data.Data[fid], field, rowsBefore)
+	}()
+
 	switch field := field.(type) {
 	case *BoolFieldData:
 		mergeBoolField(data, fid, field)
@@ -750,6 +769,197 @@ func MergeInsertData(datas ...*InsertData) *InsertData {
 	return ret
 }
 
+// GenDefaultFieldData generates a column of rowNum rows, all of which are the default value of field.
+// The rows are null if the field is nullable and has no default value.
+func GenDefaultFieldData(field *schemapb.FieldSchema, rowNum int) (FieldData, error) {
+	data, err := genDefaultFieldData(field, rowNum)
+	if err != nil {
+		return nil, err
+	}
+	if typeutil.IsFieldNullable(field) && field.GetDefaultValue() == nil {
+		data.(NullableFieldData).SetValidData(make([]bool, rowNum))
+	}
+	return data, nil
+}
+
+func genDefaultFieldData(field *schemapb.FieldSchema, rowNum int) (FieldData, error) {
+	defaultValue, err := typeutil.GetDefaultValue(field)
+	if err != nil {
+		return nil, err
+	}
+
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		data := &BoolFieldData{Data: make([]bool, rowNum)}
+		for i := range data.Data {
+			data.Data[i] = defaultValue.(bool)
+		}
+		return data, nil
+	case schemapb.DataType_Int8:
+		data := &Int8FieldData{Data: make([]int8, rowNum)}
+		for i := range data.Data {
+			data.Data[i] = defaultValue.(int8)
+		}
+		return data, nil
+	case schemapb.DataType_Int16:
+		data := &Int16FieldData{Data: make([]int16, rowNum)}
+		for i := range data.Data {
+			data.Data[i] = defaultValue.(int16)
+		}
+		return data, nil
+	case schemapb.DataType_Int32:
+		data := &Int32FieldData{Data: make([]int32, rowNum)}
+		for i := range data.Data {
+			data.Data[i] = defaultValue.(int32)
+		}
+		return data, nil
+	case schemapb.DataType_Int64:
+		data := &Int64FieldData{Data: make([]int64, rowNum)}
+		for i := range data.Data {
+			data.Data[i] = defaultValue.(int64)
+		}
+		return data, nil
+	case schemapb.DataType_Float:
+		data := &FloatFieldData{Data: make([]float32, rowNum)}
+		for i := range data.Data {
+			data.Data[i] = defaultValue.(float32)
+		}
+		return data, nil
+	case schemapb.DataType_Double:
+		data := &DoubleFieldData{Data: make([]float64, rowNum)}
+		for i := range data.Data {
+			data.Data[i] = defaultValue.(float64)
+		}
+		return data, nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		data := &StringFieldData{Data: make([]string, rowNum)}
+		for i := range data.Data {
+			data.Data[i] = defaultValue.(string)
+		}
+		return data, nil
+	default:
+		return nil, fmt.Errorf("default value is not supported for field type %s", field.GetDataType().String())
+	}
+}
+
+// allValid returns the validity of rowNum rows which are all not null.
+func allValid(rowNum int) []bool {
+	validData := make([]bool, rowNum)
+	for i := range validData {
+		validData[i] = true
+	}
+	return validData
+}
+
+// mergeValidData merges the validity of src into dst, which has rowsBefore rows before merging.
+func mergeValidData(dst FieldData, src FieldData, rowsBefore int) {
+	dstNullable, ok := dst.(NullableFieldData)
+	if !ok {
+		return
+	}
+	srcNullable, ok := src.(NullableFieldData)
+	if !ok {
+		return
+	}
+	dstValid, srcValid := dstNullable.GetValidData(), srcNullable.GetValidData()
+	if dstValid == nil && srcValid == nil {
+		return
+	}
+	if dstValid == nil {
+		dstValid = allValid(rowsBefore)
+	}
+	if srcValid == nil {
+		srcValid = allValid(src.RowNum())
+	}
+	dstNullable.SetValidData(append(dstValid[:rowsBefore:rowsBefore], srcValid...))
+}
+
+// encodeValidData encodes the validity into a base64 bitmap, the i-th bit is set if the i-th row is not null.
+func encodeValidData(validData []bool) string {
+	bitmap := make([]byte, (len(validData)+7)/8)
+	for i, valid := range validData {
+		if valid {
+			bitmap[i/8] |= 1 << (i % 8)
+		}
+	}
+	return base64.StdEncoding.EncodeToString(bitmap)
+}
+
+// decodeValidData decodes the validity of rowNum rows from a base64 bitmap.
+func decodeValidData(encoded string, rowNum int) ([]bool, error) {
+	bitmap, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(bitmap) != (rowNum+7)/8 {
+		return nil, fmt.Errorf("the size of valid bitmap mismatch, expected rows: %d, bitmap bytes: %d", rowNum, len(bitmap))
+	}
+	validData := make([]bool, rowNum)
+	for i := range validData {
+		validData[i] = bitmap[i/8]&(1<<(i%8)) != 0
+	}
+	return validData, nil
+}
+
+// appendValidData appends the validity of rowNum rows read from a binlog to data, which has rowsBefore rows before reading.
+// extra is the encoded bitmap stored in the binlog, nil means that all the rows are valid.
+func appendValidData(data FieldData, rowsBefore int, rowNum int, extra interface{}) error {
+	nullable, ok := data.(NullableFieldData)
+	if !ok {
+		return nil
+	}
+
+	var blobValid []bool
+	if extra != nil {
+		encoded, ok := extra.(string)
+		if !ok {
+			return fmt.Errorf("value of %v must in string format", validDataKey)
+		}
+		var err error
+		blobValid, err = decodeValidData(encoded, rowNum)
+		if err != nil {
+			return err
+		}
+	}
+
+	validData := nullable.GetValidData()
+	if validData == nil && blobValid == nil {
+		return nil
+	}
+	if validData == nil {
+		validData = allValid(rowsBefore)
+	}
+	if blobValid == nil {
+		blobValid = allValid(rowNum)
+	}
+	nullable.SetValidData(append(validData, blobValid...))
+	return nil
+}
+
+// FillDefaultFieldData fills the nullable or default-valued fields of collSchema which are absent in data,
+// so that data written before a field was added is aligned with the latest schema.
+func FillDefaultFieldData(collSchema *schemapb.CollectionSchema, data *InsertData) error {
+	if data == nil {
+		return nil
+	}
+	tsData, ok := data.Data[common.TimeStampField]
+	if !ok {
+		return nil
+	}
+
+	for _, field := range collSchema.GetFields() {
+		if _, ok := data.Data[field.GetFieldID()]; ok || !typeutil.IsFieldFillable(field) {
+			continue
+		}
+		fieldData, err := GenDefaultFieldData(field, tsData.RowNum())
+		if err != nil {
+			return err
+		}
+		data.Data[field.GetFieldID()] = fieldData
+	}
+	return nil
+}
+
 // TODO: string type.
 func GetPkFromInsertData(collSchema *schemapb.CollectionSchema, data *InsertData) (FieldData, error) {
 	helper, err := typeutil.CreateSchemaHelper(collSchema)
@@ -1078,7 +1288,85 @@ func TransferInsertMsgToInsertRecord(schema *schemapb.CollectionSchema, msg *msg
 		NumRows: int64(msg.NumRows),
 	}
 
-	insertRecord.FieldsData = append(insertRecord.FieldsData, msg.FieldsData...)
+	// the schema of the consumer may be older or newer than the message,
+	// skip the fields unknown to the schema and fill the fields absent in the message.
+	fieldIDs := make(map[FieldID]struct{}, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		fieldIDs[field.GetFieldID()] = struct{}{}
+	}
+	passed := make(map[FieldID]struct{}, len(msg.FieldsData))
+	for _, fieldData := range msg.FieldsData {
+		passed[fieldData.GetFieldId()] = struct{}{}
+		if _, ok := fieldIDs[fieldData.GetFieldId()]; ok {
+			insertRecord.FieldsData = append(insertRecord.FieldsData, fieldData)
+		}
+	}
+	for _, field := range schema.GetFields() {
+		if _, ok := passed[field.GetFieldID()]; ok || !typeutil.IsFieldFillable(field) {
+			continue
+		}
+		fieldData, err := typeutil.GenDefaultFieldData(field, int(msg.NumRows))
+		if err != nil {
+			return nil, err
+		}
+		insertRecord.FieldsData = append(insertRecord.FieldsData, fieldData)
+	}
 
 	return insertRecord, nil
 }
+
+// GetValidData returns the validity of the nullable fields in insertData which have null values.
+func GetValidData(insertData *InsertData) map[FieldID][]bool {
+	validData := make(map[FieldID][]bool)
+	for fieldID, fieldData := range insertData.Data {
+		if nullable, ok := fieldData.(NullableFieldData); ok && nullable.GetValidData() != nil {
+			validData[fieldID] = nullable.GetValidData()
+		}
+	}
+	return validData
+}
+
+// GetInsertMsgValidData returns the validity of the nullable fields in msg which have null values,
+// which are the nullable fields without default value absent in the column based msg.
+func GetInsertMsgValidData(schema *schemapb.CollectionSchema, msg *msgstream.InsertMsg) map[FieldID][]bool {
+	validData := make(map[FieldID][]bool)
+	// row based messages contain all the fields of schema
+	if msg.IsRowBased() {
+		return validData
+	}
+
+	passed := make(map[FieldID]struct{}, len(msg.FieldsData))
+	for _, fieldData := range msg.FieldsData {
+		passed[fieldData.GetFieldId()] = struct{}{}
+	}
+	for _, field := range schema.GetFields() {
+		if _, ok := passed[field.GetFieldID()]; ok {
+			continue
+		}
+		if typeutil.IsFieldNullable(field) && field.GetDefaultValue() == nil {
+			validData[field.GetFieldID()] = make([]bool, msg.NumRows)
+		}
+	}
+	return validData
+}
+
+// MergeValidData merges the validity of src which has srcRows rows into dst which has dstRows rows,
+// the rows of fields absent in the validity are not null.
+func MergeValidData(dst map[FieldID][]bool, dstRows int, src map[FieldID][]bool, srcRows int) map[FieldID][]bool {
+	if dst == nil {
+		dst = make(map[FieldID][]bool)
+	}
+	for fieldID, dstValid := range dst {
+		if _, ok := src[fieldID]; !ok {
+			dst[fieldID] = append(dstValid[:dstRows:dstRows], allValid(srcRows)...)
+		}
+	}
+	for fieldID, srcValid := range src {
+		dstValid, ok := dst[fieldID]
+		if !ok {
+			dstValid = allValid(dstRows)
+		}
+		dst[fieldID] = append(dstValid[:dstRows:dstRows], srcValid...)
+	}
+	return dst
+}
@@ -1336,3 +1336,104 @@ func TestJson(t *testing.T) {
 	t.Log(string(ExtraBytes))
 	t.Log(ExtraLength)
 }
+
+func TestFillDefaultFieldData(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "count", DataType: schemapb.DataType_Int32,
+				DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 3}}},
+			{FieldID: 102, Name: "tag", DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.FieldNullableKey, Value: "true"}}},
+		},
+	}
+
+	t.Run("fill insert data", func(t *testing.T) {
+		data := &InsertData{
+			Data: map[FieldID]FieldData{
+				common.TimeStampField: &Int64FieldData{Data: []int64{1, 2}},
+				100:                   &Int64FieldData{Data: []int64{1, 2}},
+			},
+		}
+		err := FillDefaultFieldData(schema, data)
+		assert.NoError(t, err)
+		assert.Equal(t, []int32{3, 3}, data.Data[101].(*Int32FieldData).Data)
+		assert.Nil(t, data.Data[101].(*Int32FieldData).ValidData)
+		assert.Equal(t, []string{"", ""}, data.Data[102].(*StringFieldData).Data)
+		assert.Equal(t, []bool{false, false}, data.Data[102].(*StringFieldData).ValidData)
+	})
+
+	t.Run("fill insert msg", func(t *testing.T) {
+		msg := &msgstream.InsertMsg{
+			InsertRequest: msgpb.InsertRequest{
+				Version:    msgpb.InsertDataVersion_ColumnBased,
+				NumRows:    2,
+				Timestamps: []uint64{1, 2},
+				FieldsData: []*schemapb.FieldData{
+					{
+						FieldId: 100,
+						Type:    schemapb.DataType_Int64,
+						Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2}}},
+						}},
+					},
+				},
+			},
+		}
+		data, err := ColumnBasedInsertMsgToInsertData(msg, schema)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 2}, data.Data[100].(*Int64FieldData).Data)
+		assert.Equal(t, []int32{3, 3}, data.Data[101].(*Int32FieldData).Data)
+		assert.Equal(t, []string{"", ""}, data.Data[102].(*StringFieldData).Data)
+	})
+
+	t.Run("transfer insert msg", func(t *testing.T) {
+		msg := &msgstream.InsertMsg{
+			InsertRequest: msgpb.InsertRequest{
+				Version: msgpb.InsertDataVersion_ColumnBased,
+				NumRows: 2,
+				FieldsData: []*schemapb.FieldData{
+					{FieldId: 100, Type: schemapb.DataType_Int64},
+					{FieldId: 103, Type: schemapb.DataType_Int64},
+				},
+			},
+		}
+		record, err := TransferInsertMsgToInsertRecord(schema, msg)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(record.GetFieldsData()))
+		assert.Equal(t, int64(100), record.GetFieldsData()[0].GetFieldId())
+		assert.Equal(t, int64(101), record.GetFieldsData()[1].GetFieldId())
+		assert.Equal(t, []int32{3, 3}, record.GetFieldsData()[1].GetScalars().GetIntData().GetData())
+		assert.Equal(t, int64(102), record.GetFieldsData()[2].GetFieldId())
+
+		validData := GetInsertMsgValidData(schema, msg)
+		assert.Equal(t, map[FieldID][]bool{102: {false, false}}, validData)
+	})
+
+	t.Run("insert data valid data", func(t *testing.T) {
+		data := &InsertData{
+			Data: map[FieldID]FieldData{
+				100: &Int64FieldData{Data: []int64{1, 2}},
+				102: &StringFieldData{Data: []string{"a", ""}, ValidData: []bool{true, false}},
+			},
+		}
+		assert.Equal(t, map[FieldID][]bool{102: {true, false}}, GetValidData(data))
+	})
+
+	t.Run("merge valid data", func(t *testing.T) {
+		merged := MergeValidData(nil, 2, map[FieldID][]bool{102: {false}}, 1)
+		assert.Equal(t, map[FieldID][]bool{102: {true, true, false}}, merged)
+
+		merged = MergeValidData(merged, 3, map[FieldID][]bool{101: {false, true}}, 2)
+		assert.Equal(t, map[FieldID][]bool{
+			101: {true, true, true, false, true},
+			102: {true, true, false, true, true},
+		}, merged)
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		_, err := GenDefaultFieldData(&schemapb.FieldSchema{DataType: schemapb.DataType_FloatVector}, 1)
+		assert.Error(t, err)
+	})
+}
@@ -1223,6 +1223,16 @@ type ProxyComponent interface {
 	// error is always nil
 	GetQuerySegmentInfo(ctx context.Context, request *milvuspb.GetQuerySegmentInfoRequest) (*milvuspb.GetQuerySegmentInfoResponse, error)
 
+	// Explain notifies Proxy to return the plan of a search or query without executing it
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including collection name, partition names, expression and search params
+	//
+	// The `Status` in response struct `ExplainResponse` indicates if this operation is processed successfully or fail cause;
+	// the response returns the expression tree, the fields with their indexes and the segments to hit per shard.
+	// error is always nil
+	Explain(ctx context.Context, request *proxypb.ExplainRequest) (*proxypb.ExplainResponse, error)
+
 	// For internal usage
 	Dummy(ctx context.Context, request *milvuspb.DummyRequest) (*milvuspb.DummyResponse, error)
 
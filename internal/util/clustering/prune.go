@@ -0,0 +1,287 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustering
+
+import (
+	"math"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+)
+
+// MayMatch returns false only if no entity within the clustering range could satisfy the expression,
+// the expressions not on the clustering field are always considered to match.
+func MayMatch(expr *planpb.Expr, info *datapb.ClusteringInfo) bool {
+	if info.GetMin() == nil || info.GetMax() == nil {
+		return true
+	}
+
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_BinaryExpr:
+		switch e.BinaryExpr.GetOp() {
+		case planpb.BinaryExpr_LogicalAnd:
+			return MayMatch(e.BinaryExpr.GetLeft(), info) && MayMatch(e.BinaryExpr.GetRight(), info)
+		case planpb.BinaryExpr_LogicalOr:
+			return MayMatch(e.BinaryExpr.GetLeft(), info) || MayMatch(e.BinaryExpr.GetRight(), info)
+		}
+
+	case *planpb.Expr_UnaryRangeExpr:
+		if !isClusteringColumn(e.UnaryRangeExpr.GetColumnInfo(), info) {
+			return true
+		}
+		return unaryRangeMayMatch(e.UnaryRangeExpr.GetOp(), e.UnaryRangeExpr.GetValue(), info)
+
+	case *planpb.Expr_BinaryRangeExpr:
+		expr := e.BinaryRangeExpr
+		if !isClusteringColumn(expr.GetColumnInfo(), info) {
+			return true
+		}
+		lowerOp, upperOp := planpb.OpType_GreaterThan, planpb.OpType_LessThan
+		if expr.GetLowerInclusive() {
+			lowerOp = planpb.OpType_GreaterEqual
+		}
+		if expr.GetUpperInclusive() {
+			upperOp = planpb.OpType_LessEqual
+		}
+		return unaryRangeMayMatch(lowerOp, expr.GetLowerValue(), info) &&
+			unaryRangeMayMatch(upperOp, expr.GetUpperValue(), info)
+
+	case *planpb.Expr_TermExpr:
+		if !isClusteringColumn(e.TermExpr.GetColumnInfo(), info) {
+			return true
+		}
+		for _, value := range e.TermExpr.GetValues() {
+			if unaryRangeMayMatch(planpb.OpType_Equal, value, info) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func isClusteringColumn(column *planpb.ColumnInfo, info *datapb.ClusteringInfo) bool {
+	return column.GetFieldId() == info.GetFieldID() && len(column.GetNestedPath()) == 0
+}
+
+// unaryRangeMayMatch checks whether any value within [min, max] satisfies `value op`.
+func unaryRangeMayMatch(op planpb.OpType, value *planpb.GenericValue, info *datapb.ClusteringInfo) bool {
+	if op == planpb.OpType_PrefixMatch {
+		minData, ok1 := info.GetMin().GetData().(*schemapb.ValueField_StringData)
+		maxData, ok2 := info.GetMax().GetData().(*schemapb.ValueField_StringData)
+		if !ok1 || !ok2 {
+			return true
+		}
+		prefix, minValue, maxValue := value.GetStringVal(), minData.StringData, maxData.StringData
+		// all the strings with the prefix lie in [prefix, max of prefix], which must overlap [min, max]
+		return prefix <= maxValue && (minValue <= prefix || strings.HasPrefix(minValue, prefix))
+	}
+
+	cmpMin, ok := compareClusteringValue(value, info.GetMin())
+	if !ok {
+		return true
+	}
+	cmpMax, ok := compareClusteringValue(value, info.GetMax())
+	if !ok {
+		return true
+	}
+	switch op {
+	case planpb.OpType_GreaterThan:
+		return cmpMax < 0
+	case planpb.OpType_GreaterEqual:
+		return cmpMax <= 0
+	case planpb.OpType_LessThan:
+		return cmpMin > 0
+	case planpb.OpType_LessEqual:
+		return cmpMin >= 0
+	case planpb.OpType_Equal:
+		return cmpMin >= 0 && cmpMax <= 0
+	default:
+		return true
+	}
+}
+
+// compareClusteringValue compares the value of expression with the bound of clustering range,
+// returns false if they are not comparable.
+// Float fields are never pruned, as the value may be compared in single precision by segcore.
+func compareClusteringValue(value *planpb.GenericValue, bound *schemapb.ValueField) (int, bool) {
+	var (
+		boundInt   int64
+		boundFloat float64
+		isInt      bool
+	)
+	switch data := bound.GetData().(type) {
+	case *schemapb.ValueField_IntData:
+		boundInt, isInt = int64(data.IntData), true
+	case *schemapb.ValueField_LongData:
+		boundInt, isInt = data.LongData, true
+	case *schemapb.ValueField_DoubleData:
+		boundFloat = data.DoubleData
+	case *schemapb.ValueField_StringData:
+		v, ok := value.GetVal().(*planpb.GenericValue_StringVal)
+		if !ok {
+			return 0, false
+		}
+		return strings.Compare(v.StringVal, data.StringData), true
+	default:
+		return 0, false
+	}
+
+	switch v := value.GetVal().(type) {
+	case *planpb.GenericValue_Int64Val:
+		if isInt {
+			return compareOrdered(v.Int64Val, boundInt), true
+		}
+		return compareOrdered(float64(v.Int64Val), boundFloat), true
+	case *planpb.GenericValue_FloatVal:
+		if isInt {
+			boundFloat = float64(boundInt)
+		}
+		return compareOrdered(v.FloatVal, boundFloat), true
+	default:
+		return 0, false
+	}
+}
+
+func compareOrdered[T int64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Selectivity estimates the fraction of the entities which satisfy the expression,
+// assuming the values of the clustering field are uniformly distributed within the clustering range.
+// The expressions not on the clustering field are considered to be satisfied by all the entities.
+func Selectivity(expr *planpb.Expr, info *datapb.ClusteringInfo) float64 {
+	if !MayMatch(expr, info) {
+		return 0
+	}
+
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_BinaryExpr:
+		left, right := Selectivity(e.BinaryExpr.GetLeft(), info), Selectivity(e.BinaryExpr.GetRight(), info)
+		switch e.BinaryExpr.GetOp() {
+		case planpb.BinaryExpr_LogicalAnd:
+			return left * right
+		case planpb.BinaryExpr_LogicalOr:
+			return math.Min(1, left+right)
+		}
+
+	case *planpb.Expr_UnaryRangeExpr:
+		if !isClusteringColumn(e.UnaryRangeExpr.GetColumnInfo(), info) {
+			return 1
+		}
+		return unaryRangeSelectivity(e.UnaryRangeExpr.GetOp(), e.UnaryRangeExpr.GetValue(), info)
+
+	case *planpb.Expr_BinaryRangeExpr:
+		expr := e.BinaryRangeExpr
+		if !isClusteringColumn(expr.GetColumnInfo(), info) {
+			return 1
+		}
+		lowerOp, upperOp := planpb.OpType_GreaterThan, planpb.OpType_LessThan
+		if expr.GetLowerInclusive() {
+			lowerOp = planpb.OpType_GreaterEqual
+		}
+		if expr.GetUpperInclusive() {
+			upperOp = planpb.OpType_LessEqual
+		}
+		// the intersection of the two half ranges
+		return math.Max(0, unaryRangeSelectivity(lowerOp, expr.GetLowerValue(), info)+
+			unaryRangeSelectivity(upperOp, expr.GetUpperValue(), info)-1)
+
+	case *planpb.Expr_TermExpr:
+		if !isClusteringColumn(e.TermExpr.GetColumnInfo(), info) {
+			return 1
+		}
+		var ret float64
+		for _, value := range e.TermExpr.GetValues() {
+			ret += unaryRangeSelectivity(planpb.OpType_Equal, value, info)
+		}
+		return math.Min(1, ret)
+	}
+	return 1
+}
+
+// unaryRangeSelectivity estimates the fraction of the values within [min, max] which satisfy `value op`,
+// the integer range is considered as [min, max+1) so that each value takes the same width.
+func unaryRangeSelectivity(op planpb.OpType, value *planpb.GenericValue, info *datapb.ClusteringInfo) float64 {
+	if !unaryRangeMayMatch(op, value, info) {
+		return 0
+	}
+	minValue, maxValue, isInt, ok := numericRange(info)
+	if !ok {
+		return 1
+	}
+	var v float64
+	switch val := value.GetVal().(type) {
+	case *planpb.GenericValue_Int64Val:
+		v = float64(val.Int64Val)
+	case *planpb.GenericValue_FloatVal:
+		v = val.FloatVal
+	default:
+		return 1
+	}
+
+	width := maxValue - minValue
+	if isInt {
+		width++
+	}
+	if width <= 0 {
+		return 1
+	}
+	var matched float64
+	switch op {
+	case planpb.OpType_GreaterThan, planpb.OpType_GreaterEqual:
+		matched = maxValue - v
+		if isInt && (op == planpb.OpType_GreaterEqual || v != math.Floor(v)) {
+			matched = maxValue - math.Ceil(v) + 1
+		}
+	case planpb.OpType_LessThan, planpb.OpType_LessEqual:
+		matched = v - minValue
+		if isInt && (op == planpb.OpType_LessEqual || v != math.Floor(v)) {
+			matched = math.Floor(v) - minValue + 1
+		}
+	case planpb.OpType_Equal:
+		if !isInt {
+			return 1
+		}
+		matched = 1
+	default:
+		return 1
+	}
+	return math.Max(0, math.Min(1, matched/width))
+}
+
+func numericRange(info *datapb.ClusteringInfo) (float64, float64, bool, bool) {
+	switch minData := info.GetMin().GetData().(type) {
+	case *schemapb.ValueField_IntData:
+		return float64(minData.IntData), float64(info.GetMax().GetIntData()), true, true
+	case *schemapb.ValueField_LongData:
+		return float64(minData.LongData), float64(info.GetMax().GetLongData()), true, true
+	case *schemapb.ValueField_DoubleData:
+		return minData.DoubleData, info.GetMax().GetDoubleData(), false, true
+	default:
+		return 0, 0, false, false
+	}
+}
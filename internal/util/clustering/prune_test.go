@@ -0,0 +1,173 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustering
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+)
+
+type ClusteringSuite struct {
+	suite.Suite
+
+	intInfo    *datapb.ClusteringInfo
+	stringInfo *datapb.ClusteringInfo
+}
+
+func (suite *ClusteringSuite) SetupTest() {
+	suite.intInfo = &datapb.ClusteringInfo{
+		FieldID: 101,
+		Min:     &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 10}},
+		Max:     &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 20}},
+	}
+	suite.stringInfo = &datapb.ClusteringInfo{
+		FieldID: 102,
+		Min:     &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "abc"}},
+		Max:     &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "abz"}},
+	}
+}
+
+func TestClusteringSuite(t *testing.T) {
+	suite.Run(t, new(ClusteringSuite))
+}
+
+func unaryRange(fieldID int64, op planpb.OpType, value *planpb.GenericValue) *planpb.Expr {
+	return &planpb.Expr{Expr: &planpb.Expr_UnaryRangeExpr{UnaryRangeExpr: &planpb.UnaryRangeExpr{
+		ColumnInfo: &planpb.ColumnInfo{FieldId: fieldID},
+		Op:         op,
+		Value:      value,
+	}}}
+}
+
+func int64Value(v int64) *planpb.GenericValue {
+	return &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: v}}
+}
+
+func (suite *ClusteringSuite) TestUnaryRange() {
+	cases := []struct {
+		op    planpb.OpType
+		value *planpb.GenericValue
+		match bool
+	}{
+		{planpb.OpType_GreaterThan, int64Value(20), false},
+		{planpb.OpType_GreaterThan, int64Value(19), true},
+		{planpb.OpType_GreaterEqual, int64Value(20), true},
+		{planpb.OpType_LessThan, int64Value(10), false},
+		{planpb.OpType_LessEqual, int64Value(10), true},
+		{planpb.OpType_Equal, int64Value(9), false},
+		{planpb.OpType_Equal, int64Value(15), true},
+		{planpb.OpType_NotEqual, int64Value(15), true},
+		{planpb.OpType_GreaterThan, &planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: 20.5}}, false},
+		{planpb.OpType_LessThan, &planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: 10.5}}, true},
+	}
+	for _, c := range cases {
+		suite.Equal(c.match, MayMatch(unaryRange(101, c.op, c.value), suite.intInfo), "op %s value %v", c.op, c.value)
+	}
+
+	// not the clustering field
+	suite.True(MayMatch(unaryRange(103, planpb.OpType_GreaterThan, int64Value(100)), suite.intInfo))
+	// not clustered
+	suite.True(MayMatch(unaryRange(101, planpb.OpType_GreaterThan, int64Value(100)), nil))
+}
+
+func (suite *ClusteringSuite) TestString() {
+	stringValue := func(v string) *planpb.GenericValue {
+		return &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: v}}
+	}
+	suite.False(MayMatch(unaryRange(102, planpb.OpType_Equal, stringValue("abb")), suite.stringInfo))
+	suite.True(MayMatch(unaryRange(102, planpb.OpType_Equal, stringValue("abd")), suite.stringInfo))
+	suite.True(MayMatch(unaryRange(102, planpb.OpType_PrefixMatch, stringValue("ab")), suite.stringInfo))
+	suite.True(MayMatch(unaryRange(102, planpb.OpType_PrefixMatch, stringValue("abcd")), suite.stringInfo))
+	suite.False(MayMatch(unaryRange(102, planpb.OpType_PrefixMatch, stringValue("abb")), suite.stringInfo))
+	suite.False(MayMatch(unaryRange(102, planpb.OpType_PrefixMatch, stringValue("ac")), suite.stringInfo))
+}
+
+func (suite *ClusteringSuite) TestBinaryRangeAndTerm() {
+	binaryRange := func(lower, upper int64, inclusive bool) *planpb.Expr {
+		return &planpb.Expr{Expr: &planpb.Expr_BinaryRangeExpr{BinaryRangeExpr: &planpb.BinaryRangeExpr{
+			ColumnInfo:     &planpb.ColumnInfo{FieldId: 101},
+			LowerInclusive: inclusive,
+			UpperInclusive: inclusive,
+			LowerValue:     int64Value(lower),
+			UpperValue:     int64Value(upper),
+		}}}
+	}
+	suite.False(MayMatch(binaryRange(0, 10, false), suite.intInfo))
+	suite.True(MayMatch(binaryRange(0, 10, true), suite.intInfo))
+	suite.False(MayMatch(binaryRange(21, 30, true), suite.intInfo))
+	suite.True(MayMatch(binaryRange(12, 13, false), suite.intInfo))
+
+	term := func(values ...int64) *planpb.Expr {
+		expr := &planpb.TermExpr{ColumnInfo: &planpb.ColumnInfo{FieldId: 101}}
+		for _, v := range values {
+			expr.Values = append(expr.Values, int64Value(v))
+		}
+		return &planpb.Expr{Expr: &planpb.Expr_TermExpr{TermExpr: expr}}
+	}
+	suite.False(MayMatch(term(1, 2, 30), suite.intInfo))
+	suite.True(MayMatch(term(1, 12), suite.intInfo))
+}
+
+func (suite *ClusteringSuite) TestLogical() {
+	match := unaryRange(101, planpb.OpType_Equal, int64Value(15))
+	mismatch := unaryRange(101, planpb.OpType_Equal, int64Value(30))
+	logical := func(op planpb.BinaryExpr_BinaryOp, left, right *planpb.Expr) *planpb.Expr {
+		return &planpb.Expr{Expr: &planpb.Expr_BinaryExpr{BinaryExpr: &planpb.BinaryExpr{Op: op, Left: left, Right: right}}}
+	}
+	suite.False(MayMatch(logical(planpb.BinaryExpr_LogicalAnd, match, mismatch), suite.intInfo))
+	suite.True(MayMatch(logical(planpb.BinaryExpr_LogicalOr, match, mismatch), suite.intInfo))
+	suite.False(MayMatch(logical(planpb.BinaryExpr_LogicalOr, mismatch, mismatch), suite.intInfo))
+
+	// not x == 30 can't be pruned
+	not := &planpb.Expr{Expr: &planpb.Expr_UnaryExpr{UnaryExpr: &planpb.UnaryExpr{Op: planpb.UnaryExpr_Not, Child: mismatch}}}
+	suite.True(MayMatch(not, suite.intInfo))
+}
+
+func (suite *ClusteringSuite) TestSelectivity() {
+	// [10, 20] has 11 values
+	suite.InDelta(5.0/11, Selectivity(unaryRange(101, planpb.OpType_GreaterThan, int64Value(15)), suite.intInfo), 1e-9)
+	suite.InDelta(6.0/11, Selectivity(unaryRange(101, planpb.OpType_GreaterEqual, int64Value(15)), suite.intInfo), 1e-9)
+	suite.InDelta(5.0/11, Selectivity(unaryRange(101, planpb.OpType_LessThan, int64Value(15)), suite.intInfo), 1e-9)
+	suite.InDelta(1.0/11, Selectivity(unaryRange(101, planpb.OpType_Equal, int64Value(15)), suite.intInfo), 1e-9)
+	suite.InDelta(1, Selectivity(unaryRange(101, planpb.OpType_GreaterThan, int64Value(0)), suite.intInfo), 1e-9)
+	suite.Equal(float64(0), Selectivity(unaryRange(101, planpb.OpType_GreaterThan, int64Value(20)), suite.intInfo))
+
+	between := &planpb.Expr{Expr: &planpb.Expr_BinaryRangeExpr{BinaryRangeExpr: &planpb.BinaryRangeExpr{
+		ColumnInfo:     &planpb.ColumnInfo{FieldId: 101},
+		LowerInclusive: true,
+		UpperInclusive: true,
+		LowerValue:     int64Value(12),
+		UpperValue:     int64Value(13),
+	}}}
+	suite.InDelta(2.0/11, Selectivity(between, suite.intInfo), 1e-9)
+
+	and := &planpb.Expr{Expr: &planpb.Expr_BinaryExpr{BinaryExpr: &planpb.BinaryExpr{
+		Op:    planpb.BinaryExpr_LogicalAnd,
+		Left:  unaryRange(101, planpb.OpType_GreaterEqual, int64Value(15)),
+		Right: unaryRange(103, planpb.OpType_Equal, int64Value(1)),
+	}}}
+	suite.InDelta(6.0/11, Selectivity(and, suite.intInfo), 1e-9)
+
+	// can't be estimated
+	suite.Equal(float64(1), Selectivity(unaryRange(102, planpb.OpType_Equal, &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: "abd"}}), suite.stringInfo))
+	suite.Equal(float64(1), Selectivity(unaryRange(101, planpb.OpType_Equal, int64Value(15)), nil))
+}
@@ -0,0 +1,289 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// supported export formats
+const (
+	ParquetFormat = "parquet"
+	NumpyFormat   = "numpy"
+	JSONFormat    = "json"
+)
+
+// IsSupportedFormat returns true if the format can be exported.
+func IsSupportedFormat(format string) bool {
+	switch format {
+	case ParquetFormat, NumpyFormat, JSONFormat:
+		return true
+	}
+	return false
+}
+
+// Exporter exports the rows of sealed segments to files under a prefix through a ChunkManager.
+// Each segment is exported separately: a parquet file "<prefix>/<segmentID>.parquet", a json file
+// "<prefix>/<segmentID>.json", or numpy files "<prefix>/<segmentID>/<fieldName>.npy", so that the
+// exported files can be imported again by bulk insert.
+type Exporter struct {
+	ctx    context.Context
+	schema *schemapb.CollectionSchema
+	cm     storage.ChunkManager
+	prefix string
+	format string
+	expr   *planpb.Expr
+}
+
+// NewExporter creates an Exporter, the rows not matching the filter expression are not exported.
+func NewExporter(ctx context.Context, schema *schemapb.CollectionSchema, cm storage.ChunkManager,
+	prefix string, format string, expr string,
+) (*Exporter, error) {
+	if !IsSupportedFormat(format) {
+		return nil, fmt.Errorf("unsupported export format '%s'", format)
+	}
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return nil, err
+	}
+	predicate, err := planparserv2.ParseExpr(helper, expr)
+	if err != nil {
+		return nil, err
+	}
+	return &Exporter{
+		ctx:    ctx,
+		schema: schema,
+		cm:     cm,
+		prefix: prefix,
+		format: format,
+		expr:   predicate,
+	}, nil
+}
+
+// ExportSegment exports the rows of a sealed segment, the deleted rows are skipped.
+// It returns the number of exported rows and the written files.
+func (e *Exporter) ExportSegment(segment *datapb.SegmentInfo) (int64, []string, error) {
+	data, err := e.readInsertData(segment)
+	if err != nil {
+		return 0, nil, err
+	}
+	deleted, err := e.readDeletes(segment)
+	if err != nil {
+		return 0, nil, err
+	}
+	rows, err := e.selectRows(segment.GetID(), data, deleted)
+	if err != nil {
+		return 0, nil, err
+	}
+	if rows.RowNum() == 0 {
+		log.Info("no row to export in segment", zap.Int64("segmentID", segment.GetID()))
+		return 0, nil, nil
+	}
+
+	files, err := e.write(segment.GetID(), rows)
+	if err != nil {
+		return 0, nil, err
+	}
+	log.Info("segment exported", zap.Int64("segmentID", segment.GetID()),
+		zap.Int("rowNum", rows.RowNum()), zap.Strings("files", files))
+	return int64(rows.RowNum()), files, nil
+}
+
+func (e *Exporter) readInsertData(segment *datapb.SegmentInfo) (*storage.InsertData, error) {
+	blobs := make([]*storage.Blob, 0)
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		if fieldBinlog.GetFieldID() == common.RowIDField {
+			continue
+		}
+		paths := make([]string, 0, len(fieldBinlog.GetBinlogs()))
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			paths = append(paths, binlog.GetLogPath())
+		}
+		values, err := e.cm.MultiRead(e.ctx, paths)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read binlogs of segment %d, error: %w", segment.GetID(), err)
+		}
+		for i, value := range values {
+			blobs = append(blobs, &storage.Blob{Key: paths[i], Value: value})
+		}
+	}
+	if len(blobs) == 0 {
+		return &storage.InsertData{Data: make(map[storage.FieldID]storage.FieldData)}, nil
+	}
+
+	_, _, data, err := storage.NewInsertCodec().Deserialize(blobs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deserialize binlogs of segment %d, error: %w", segment.GetID(), err)
+	}
+	return data, nil
+}
+
+// readDeletes returns the max delete timestamp of each deleted primary key.
+func (e *Exporter) readDeletes(segment *datapb.SegmentInfo) (map[interface{}]uint64, error) {
+	deleted := make(map[interface{}]uint64)
+	blobs := make([]*storage.Blob, 0)
+	for _, fieldBinlog := range segment.GetDeltalogs() {
+		paths := make([]string, 0, len(fieldBinlog.GetBinlogs()))
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			paths = append(paths, binlog.GetLogPath())
+		}
+		values, err := e.cm.MultiRead(e.ctx, paths)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read deltalogs of segment %d, error: %w", segment.GetID(), err)
+		}
+		for i, value := range values {
+			blobs = append(blobs, &storage.Blob{Key: paths[i], Value: value})
+		}
+	}
+	if len(blobs) == 0 {
+		return deleted, nil
+	}
+
+	_, _, data, err := storage.NewDeleteCodec().Deserialize(blobs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deserialize deltalogs of segment %d, error: %w", segment.GetID(), err)
+	}
+	for i, pk := range data.Pks {
+		if ts := data.Tss[i]; ts > deleted[pk.GetValue()] {
+			deleted[pk.GetValue()] = ts
+		}
+	}
+	return deleted, nil
+}
+
+// selectRows returns the rows which are not deleted and match the filter expression.
+func (e *Exporter) selectRows(segmentID int64, data *storage.InsertData, deleted map[interface{}]uint64) (*segmentRows, error) {
+	pkField, err := typeutil.GetPrimaryFieldSchema(e.schema)
+	if err != nil {
+		return nil, err
+	}
+	all := newSegmentRows(data)
+	pks := data.Data[pkField.GetFieldID()]
+	tss, _ := data.Data[common.TimeStampField].(*storage.Int64FieldData)
+
+	candidates := all.offsets
+	if e.expr != nil {
+		matched, err := filterRows(e.ctx, e.schema, e.expr, segmentID, data)
+		if err != nil {
+			return nil, err
+		}
+		candidates = make([]int, 0, len(matched))
+		for _, offset := range matched {
+			candidates = append(candidates, int(offset))
+		}
+	}
+
+	offsets := make([]int, 0, len(candidates))
+	for _, i := range candidates {
+		if pks != nil && tss != nil {
+			// a delete only applies to the rows inserted before it
+			if ts, ok := deleted[pks.GetRow(i)]; ok && uint64(tss.Data[i]) < ts {
+				continue
+			}
+		}
+		offsets = append(offsets, i)
+	}
+	all.offsets = offsets
+	return all, nil
+}
+
+func (e *Exporter) write(segmentID int64, rows rowSource) ([]string, error) {
+	name := strconv.FormatInt(segmentID, 10)
+	contents := make(map[string][]byte)
+	switch e.format {
+	case ParquetFormat:
+		content, err := writeParquet(e.schema, rows)
+		if err != nil {
+			return nil, err
+		}
+		contents[path.Join(e.prefix, name+".parquet")] = content
+	case JSONFormat:
+		content, err := writeJSON(e.schema, rows)
+		if err != nil {
+			return nil, err
+		}
+		contents[path.Join(e.prefix, name+".json")] = content
+	case NumpyFormat:
+		columns, err := writeNumpy(e.schema, rows)
+		if err != nil {
+			return nil, err
+		}
+		for fieldName, content := range columns {
+			contents[path.Join(e.prefix, name, fieldName+".npy")] = content
+		}
+	}
+
+	if err := e.cm.MultiWrite(e.ctx, contents); err != nil {
+		return nil, fmt.Errorf("failed to write exported files of segment %d, error: %w", segmentID, err)
+	}
+	files := make([]string, 0, len(contents))
+	for file := range contents {
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// segmentRows is the rowSource of the selected rows of a segment.
+type segmentRows struct {
+	data    *storage.InsertData
+	offsets []int
+}
+
+func newSegmentRows(data *storage.InsertData) *segmentRows {
+	rowNum := 0
+	for _, fieldData := range data.Data {
+		rowNum = fieldData.RowNum()
+		break
+	}
+	offsets := make([]int, rowNum)
+	for i := range offsets {
+		offsets[i] = i
+	}
+	return &segmentRows{data: data, offsets: offsets}
+}
+
+func (s *segmentRows) RowNum() int {
+	return len(s.offsets)
+}
+
+func (s *segmentRows) Value(fieldID int64, row int) interface{} {
+	fieldData, ok := s.data.Data[fieldID]
+	if !ok {
+		return nil
+	}
+	offset := s.offsets[row]
+	if nullable, ok := fieldData.(storage.NullableFieldData); ok {
+		if valid := nullable.GetValidData(); len(valid) > offset && !valid[offset] {
+			return nil
+		}
+	}
+	return fieldData.GetRow(offset)
+}
@@ -0,0 +1,128 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+// prepareSegment writes the binlogs and deltalogs of a segment with 3 rows, the row of pk 2 is deleted.
+func prepareSegment(t *testing.T, ctx context.Context, cm storage.ChunkManager, root string) *datapb.SegmentInfo {
+	schema := &schemapb.CollectionSchema{
+		Name: "export",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: "RowID", DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "2"}}},
+		},
+	}
+	data := &storage.InsertData{Data: map[storage.FieldID]storage.FieldData{
+		common.RowIDField:     &storage.Int64FieldData{Data: []int64{1, 2, 3}},
+		common.TimeStampField: &storage.Int64FieldData{Data: []int64{10, 20, 30}},
+		100:                   &storage.Int64FieldData{Data: []int64{1, 2, 3}},
+		101:                   &storage.FloatVectorFieldData{Data: []float32{1, 2, 3, 4, 5, 6}, Dim: 2},
+	}}
+	blobs, _, err := storage.NewInsertCodecWithSchema(&etcdpb.CollectionMeta{ID: 1, Schema: schema}).Serialize(2, 3, data)
+	require.NoError(t, err)
+
+	segment := &datapb.SegmentInfo{ID: 3, CollectionID: 1, PartitionID: 2, NumOfRows: 3}
+	for _, blob := range blobs {
+		fieldID, err := strconv.ParseInt(blob.Key, 10, 64)
+		require.NoError(t, err)
+		logPath := path.Join(root, "insert_log", blob.Key, "1")
+		require.NoError(t, cm.Write(ctx, logPath, blob.Value))
+		segment.Binlogs = append(segment.Binlogs, &datapb.FieldBinlog{
+			FieldID: fieldID,
+			Binlogs: []*datapb.Binlog{{LogPath: logPath}},
+		})
+	}
+
+	// the delete of pk 3 is before its insert, so it doesn't apply
+	deletes := &storage.DeleteData{}
+	deletes.Append(storage.NewInt64PrimaryKey(2), 25)
+	deletes.Append(storage.NewInt64PrimaryKey(3), 5)
+	blob, err := storage.NewDeleteCodec().Serialize(1, 2, 3, deletes)
+	require.NoError(t, err)
+	logPath := path.Join(root, "delta_log", "1")
+	require.NoError(t, cm.Write(ctx, logPath, blob.Value))
+	segment.Deltalogs = []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogPath: logPath}}}}
+
+	return segment
+}
+
+func TestExporter_ExportSegment(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	cm := storage.NewLocalChunkManager(storage.RootPath(dir))
+	segment := prepareSegment(t, ctx, cm, dir)
+	schema := &schemapb.CollectionSchema{
+		Name: "export",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "2"}}},
+		},
+	}
+	prefix := path.Join(dir, "export")
+
+	_, err := NewExporter(ctx, schema, cm, prefix, "csv", "")
+	assert.Error(t, err)
+	_, err = NewExporter(ctx, schema, cm, prefix, JSONFormat, "pk >")
+	assert.Error(t, err)
+
+	exporter, err := NewExporter(ctx, schema, cm, prefix, JSONFormat, "")
+	assert.NoError(t, err)
+	rowNum, files, err := exporter.ExportSegment(segment)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), rowNum)
+	assert.Equal(t, []string{path.Join(prefix, "3.json")}, files)
+	content, err := cm.Read(ctx, files[0])
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"rows": [{"pk": 1, "vec": [1, 2]}, {"pk": 3, "vec": [5, 6]}]}`, string(content))
+
+	exporter, err = NewExporter(ctx, schema, cm, prefix, NumpyFormat, "pk > 1")
+	assert.NoError(t, err)
+	rowNum, files, err = exporter.ExportSegment(segment)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), rowNum)
+	sort.Strings(files)
+	assert.Equal(t, []string{path.Join(prefix, "3", "pk.npy"), path.Join(prefix, "3", "vec.npy")}, files)
+
+	// no row matches the filter, nothing is written
+	exporter, err = NewExporter(ctx, schema, cm, prefix, ParquetFormat, "pk > 10")
+	assert.NoError(t, err)
+	rowNum, files, err = exporter.ExportSegment(segment)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), rowNum)
+	assert.Empty(t, files)
+}
@@ -0,0 +1,128 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"context"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querynodev2/segments"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// filterRows returns the sorted offsets of the rows matching the filter expression.
+// The expression is evaluated by segcore on a temporary sealed segment of the rows, so that the semantics,
+// e.g. the comparisons on null values, are the same as query.
+func filterRows(ctx context.Context, schema *schemapb.CollectionSchema, expr *planpb.Expr,
+	segmentID int64, data *storage.InsertData,
+) ([]int64, error) {
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	rowNum := 0
+	for _, fieldData := range data.Data {
+		rowNum = fieldData.RowNum()
+		break
+	}
+	if rowNum == 0 {
+		return nil, nil
+	}
+
+	collection := segments.NewCollection(0, schema, querypb.LoadType_LoadCollection)
+	defer segments.DeleteCollection(collection)
+	segment, err := segments.NewSegment(collection, segmentID, 0, 0, "", segments.SegmentTypeSealed, 0, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer segments.DeleteSegment(segment)
+
+	if err := loadFilterFields(segment, schema, data, rowNum); err != nil {
+		return nil, err
+	}
+
+	plan, err := proto.Marshal(&planpb.PlanNode{
+		Node:           &planpb.PlanNode_Predicates{Predicates: expr},
+		OutputFieldIds: []int64{pkField.GetFieldID()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	retrievePlan, err := segments.NewRetrievePlan(collection, plan, typeutil.MaxTimestamp, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer retrievePlan.Delete()
+
+	result, err := segment.Retrieve(ctx, retrievePlan)
+	if err != nil {
+		return nil, err
+	}
+	// the retrieved rows are sorted by primary key
+	offsets := result.GetOffset()
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+	return offsets, nil
+}
+
+// loadFilterFields loads the system fields and the scalar fields into the segment,
+// the vector fields can't be filtered and are not loaded.
+func loadFilterFields(segment *segments.LocalSegment, schema *schemapb.CollectionSchema, data *storage.InsertData, rowNum int) error {
+	filterData := &storage.InsertData{Data: make(map[storage.FieldID]storage.FieldData)}
+	for _, field := range schema.GetFields() {
+		fieldData, ok := data.Data[field.GetFieldID()]
+		if ok && !typeutil.IsVectorType(field.GetDataType()) {
+			filterData.Data[field.GetFieldID()] = fieldData
+		}
+	}
+	if fieldData, ok := data.Data[common.TimeStampField]; ok {
+		filterData.Data[common.TimeStampField] = fieldData
+	}
+	// row ids are not exported hence not read, but segcore requires the system fields
+	if _, ok := filterData.Data[common.RowIDField]; !ok {
+		rowIDs := &storage.Int64FieldData{Data: make([]int64, rowNum)}
+		for i := range rowIDs.Data {
+			rowIDs.Data[i] = int64(i)
+		}
+		filterData.Data[common.RowIDField] = rowIDs
+	}
+	if _, ok := filterData.Data[common.TimeStampField]; !ok {
+		filterData.Data[common.TimeStampField] = &storage.Int64FieldData{Data: make([]int64, rowNum)}
+	}
+
+	insertRecord, err := storage.TransferInsertDataToInsertRecord(filterData)
+	if err != nil {
+		return err
+	}
+	for _, fieldData := range insertRecord.GetFieldsData() {
+		if err := segment.LoadField(insertRecord.GetNumRows(), fieldData); err != nil {
+			return err
+		}
+	}
+	for fieldID, validData := range storage.GetValidData(filterData) {
+		if err := segment.LoadFieldValidData(fieldID, validData); err != nil {
+			return err
+		}
+	}
+	return nil
+}
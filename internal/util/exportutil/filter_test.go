@@ -0,0 +1,117 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// mockRows is a rowSource of rows keyed by field id
+type mockRows []map[int64]interface{}
+
+func (m mockRows) RowNum() int {
+	return len(m)
+}
+
+func (m mockRows) Value(fieldID int64, row int) interface{} {
+	return m[row][fieldID]
+}
+
+func sampleSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "export",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: "RowID", DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "name", DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "max_length", Value: "64"}}},
+			{FieldID: 102, Name: "score", DataType: schemapb.DataType_Float,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.FieldNullableKey, Value: "true"}}},
+			{FieldID: 103, Name: "meta", DataType: schemapb.DataType_JSON},
+			{FieldID: 104, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "2"}}},
+		},
+	}
+}
+
+func sampleRows() mockRows {
+	return mockRows{
+		{100: int64(1), 101: "apple", 102: float32(0.5), 103: []byte(`{"color": "red", "size": 3}`), 104: []float32{1, 2}},
+		{100: int64(2), 101: "banana", 102: nil, 103: []byte(`{"color": "yellow"}`), 104: []float32{3, 4}},
+		{100: int64(3), 101: "cherry", 102: float32(2.5), 103: []byte(`{"color": "red", "size": 1}`), 104: []float32{5, 6}},
+	}
+}
+
+func sampleInsertData() *storage.InsertData {
+	return &storage.InsertData{Data: map[storage.FieldID]storage.FieldData{
+		common.TimeStampField: &storage.Int64FieldData{Data: []int64{10, 20, 30}},
+		100:                   &storage.Int64FieldData{Data: []int64{1, 2, 3}},
+		101:                   &storage.StringFieldData{Data: []string{"apple", "banana", "cherry"}},
+		102:                   &storage.FloatFieldData{Data: []float32{0.5, 0, 2.5}, ValidData: []bool{true, false, true}},
+		103: &storage.JSONFieldData{Data: [][]byte{
+			[]byte(`{"color": "red", "size": 3}`), []byte(`{"color": "yellow"}`), []byte(`{"color": "red", "size": 1}`),
+		}},
+		104: &storage.FloatVectorFieldData{Data: []float32{1, 2, 3, 4, 5, 6}, Dim: 2},
+	}}
+}
+
+func Test_filterRows(t *testing.T) {
+	paramtable.Init()
+	schema := sampleSchema()
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	require.NoError(t, err)
+	data := sampleInsertData()
+
+	cases := []struct {
+		expr     string
+		expected []int64
+	}{
+		{"pk > 1", []int64{1, 2}},
+		{"pk in [1, 3]", []int64{0, 2}},
+		{"pk not in [1, 3]", []int64{1}},
+		{"1 < pk <= 3", []int64{1, 2}},
+		{"name like \"b%\"", []int64{1}},
+		{"score >= 0.5", []int64{0, 2}},
+		{"score < 10 || pk == 2", []int64{0, 1, 2}},
+		// comparisons on null values are unknown, so are their negations
+		{"not (score > 1)", []int64{0}},
+		{"score is null", []int64{1}},
+		{"pk % 2 == 1", []int64{0, 2}},
+		{"pk > score", []int64{0, 2}},
+		{"pk > 10", []int64{}},
+	}
+	for _, c := range cases {
+		expr, err := planparserv2.ParseExpr(helper, c.expr)
+		require.NoError(t, err, c.expr)
+		offsets, err := filterRows(context.Background(), schema, expr, 1, data)
+		assert.NoError(t, err, c.expr)
+		assert.ElementsMatch(t, c.expected, offsets, c.expr)
+	}
+}
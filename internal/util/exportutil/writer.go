@@ -0,0 +1,324 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+	"github.com/apache/arrow/go/v8/parquet"
+	"github.com/apache/arrow/go/v8/parquet/pqarrow"
+	"github.com/sbinet/npyio"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// rowSource provides the rows to export, Value returns nil if the value of the field is null.
+// The values are of the types returned by storage.FieldData.GetRow.
+type rowSource interface {
+	RowNum() int
+	Value(fieldID int64, row int) interface{}
+}
+
+// exportFields returns the user fields of the schema, the system fields are not exported.
+func exportFields(schema *schemapb.CollectionSchema) []*schemapb.FieldSchema {
+	fields := make([]*schemapb.FieldSchema, 0, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() >= common.StartOfUserFieldID {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// arrayValues returns the elements of an array field value as a typed slice.
+func arrayValues(value *schemapb.ScalarField) interface{} {
+	switch data := value.GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		return data.BoolData.GetData()
+	case *schemapb.ScalarField_IntData:
+		return data.IntData.GetData()
+	case *schemapb.ScalarField_LongData:
+		return data.LongData.GetData()
+	case *schemapb.ScalarField_FloatData:
+		return data.FloatData.GetData()
+	case *schemapb.ScalarField_DoubleData:
+		return data.DoubleData.GetData()
+	case *schemapb.ScalarField_StringData:
+		return data.StringData.GetData()
+	}
+	return nil
+}
+
+// writeJSON writes the rows in the row-based json format accepted by bulk insert: {"rows": [{...}, {...}]}.
+func writeJSON(schema *schemapb.CollectionSchema, rows rowSource) ([]byte, error) {
+	fields := exportFields(schema)
+	buf := new(bytes.Buffer)
+	buf.WriteString(`{"rows":[`)
+	for i := 0; i < rows.RowNum(); i++ {
+		row := make(map[string]interface{}, len(fields))
+		for _, field := range fields {
+			value := rows.Value(field.GetFieldID(), i)
+			switch v := value.(type) {
+			case nil:
+				row[field.GetName()] = nil
+			case []byte:
+				if field.GetDataType() == schemapb.DataType_JSON {
+					row[field.GetName()] = json.RawMessage(v)
+					continue
+				}
+				// binary vectors are written as lists of uint8, the same as bulk insert requires
+				vector := make([]int, len(v))
+				for j, b := range v {
+					vector[j] = int(b)
+				}
+				row[field.GetName()] = vector
+			case *schemapb.ScalarField:
+				row[field.GetName()] = arrayValues(v)
+			default:
+				row[field.GetName()] = v
+			}
+		}
+		content, err := json.Marshal(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal row %d, error: %w", i, err)
+		}
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(content)
+	}
+	buf.WriteString(`]}`)
+	return buf.Bytes(), nil
+}
+
+// writeNumpy writes a numpy file for each field, the files are keyed by field name.
+// Null values are written as zero values since numpy has no null.
+func writeNumpy(schema *schemapb.CollectionSchema, rows rowSource) (map[string][]byte, error) {
+	fields := exportFields(schema)
+	files := make(map[string][]byte, len(fields))
+	for _, field := range fields {
+		data, err := numpyColumn(field, rows)
+		if err != nil {
+			return nil, err
+		}
+		buf := new(bytes.Buffer)
+		if err := npyio.Write(buf, data); err != nil {
+			return nil, fmt.Errorf("failed to write numpy data of field '%s', error: %w", field.GetName(), err)
+		}
+		files[field.GetName()] = buf.Bytes()
+	}
+	return files, nil
+}
+
+func numpyColumn(field *schemapb.FieldSchema, rows rowSource) (interface{}, error) {
+	var elemType reflect.Type
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		elemType = reflect.TypeOf(false)
+	case schemapb.DataType_Int8:
+		elemType = reflect.TypeOf(int8(0))
+	case schemapb.DataType_Int16:
+		elemType = reflect.TypeOf(int16(0))
+	case schemapb.DataType_Int32:
+		elemType = reflect.TypeOf(int32(0))
+	case schemapb.DataType_Int64:
+		elemType = reflect.TypeOf(int64(0))
+	case schemapb.DataType_Float:
+		elemType = reflect.TypeOf(float32(0))
+	case schemapb.DataType_Double:
+		elemType = reflect.TypeOf(float64(0))
+	case schemapb.DataType_VarChar, schemapb.DataType_String, schemapb.DataType_JSON:
+		elemType = reflect.TypeOf("")
+	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector:
+		dim, err := typeutil.GetDim(field)
+		if err != nil {
+			return nil, err
+		}
+		// vectors are written as 2-dimensional arrays, npyio requires fixed-size rows for that
+		if field.GetDataType() == schemapb.DataType_FloatVector {
+			elemType = reflect.ArrayOf(int(dim), reflect.TypeOf(float32(0)))
+		} else {
+			elemType = reflect.ArrayOf(int(dim)/8, reflect.TypeOf(uint8(0)))
+		}
+	default:
+		return nil, fmt.Errorf("field '%s' of type %s is not supported by numpy format", field.GetName(), field.GetDataType().String())
+	}
+
+	column := reflect.MakeSlice(reflect.SliceOf(elemType), rows.RowNum(), rows.RowNum())
+	for i := 0; i < rows.RowNum(); i++ {
+		value := rows.Value(field.GetFieldID(), i)
+		if value == nil {
+			continue
+		}
+		elem := column.Index(i)
+		switch v := value.(type) {
+		case []float32:
+			reflect.Copy(elem, reflect.ValueOf(v))
+		case []byte:
+			if field.GetDataType() == schemapb.DataType_JSON {
+				elem.SetString(string(v))
+			} else {
+				reflect.Copy(elem, reflect.ValueOf(v))
+			}
+		default:
+			elem.Set(reflect.ValueOf(v))
+		}
+	}
+	return column.Interface(), nil
+}
+
+func arrowType(field *schemapb.FieldSchema) (arrow.DataType, error) {
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		return arrow.FixedWidthTypes.Boolean, nil
+	case schemapb.DataType_Int8:
+		return arrow.PrimitiveTypes.Int8, nil
+	case schemapb.DataType_Int16:
+		return arrow.PrimitiveTypes.Int16, nil
+	case schemapb.DataType_Int32:
+		return arrow.PrimitiveTypes.Int32, nil
+	case schemapb.DataType_Int64:
+		return arrow.PrimitiveTypes.Int64, nil
+	case schemapb.DataType_Float:
+		return arrow.PrimitiveTypes.Float32, nil
+	case schemapb.DataType_Double:
+		return arrow.PrimitiveTypes.Float64, nil
+	case schemapb.DataType_VarChar, schemapb.DataType_String, schemapb.DataType_JSON:
+		return arrow.BinaryTypes.String, nil
+	case schemapb.DataType_FloatVector:
+		return arrow.ListOf(arrow.PrimitiveTypes.Float32), nil
+	case schemapb.DataType_BinaryVector:
+		dim, err := typeutil.GetDim(field)
+		if err != nil {
+			return nil, err
+		}
+		return &arrow.FixedSizeBinaryType{ByteWidth: int(dim) / 8}, nil
+	case schemapb.DataType_Array:
+		elemType := field.GetElementType()
+		// the elements of int8 and int16 arrays are stored as int32
+		if elemType == schemapb.DataType_Int8 || elemType == schemapb.DataType_Int16 {
+			elemType = schemapb.DataType_Int32
+		}
+		elem, err := arrowType(&schemapb.FieldSchema{Name: field.GetName(), DataType: elemType})
+		if err != nil {
+			return nil, err
+		}
+		return arrow.ListOf(elem), nil
+	}
+	return nil, fmt.Errorf("field '%s' of type %s is not supported by parquet format", field.GetName(), field.GetDataType().String())
+}
+
+// writeParquet writes the rows into a parquet file, each field makes a column.
+func writeParquet(schema *schemapb.CollectionSchema, rows rowSource) ([]byte, error) {
+	fields := exportFields(schema)
+	arrowFields := make([]arrow.Field, 0, len(fields))
+	for _, field := range fields {
+		dataType, err := arrowType(field)
+		if err != nil {
+			return nil, err
+		}
+		arrowFields = append(arrowFields, arrow.Field{
+			Name:     field.GetName(),
+			Type:     dataType,
+			Nullable: typeutil.IsFieldNullable(field),
+		})
+	}
+	arrowSchema := arrow.NewSchema(arrowFields, nil)
+
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, arrowSchema)
+	defer builder.Release()
+	for i, field := range fields {
+		for j := 0; j < rows.RowNum(); j++ {
+			if err := appendArrowValue(builder.Field(i), rows.Value(field.GetFieldID(), j)); err != nil {
+				return nil, fmt.Errorf("failed to build parquet column of field '%s', error: %w", field.GetName(), err)
+			}
+		}
+	}
+	record := builder.NewRecord()
+	defer record.Release()
+
+	buf := new(bytes.Buffer)
+	writer, err := pqarrow.NewFileWriter(arrowSchema, buf, parquet.NewWriterProperties(), pqarrow.DefaultWriterProps())
+	if err != nil {
+		return nil, err
+	}
+	if err := writer.Write(record); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func appendArrowValue(builder array.Builder, value interface{}) error {
+	if value == nil {
+		builder.AppendNull()
+		return nil
+	}
+	switch b := builder.(type) {
+	case *array.BooleanBuilder:
+		b.Append(value.(bool))
+	case *array.Int8Builder:
+		b.Append(value.(int8))
+	case *array.Int16Builder:
+		b.Append(value.(int16))
+	case *array.Int32Builder:
+		b.Append(value.(int32))
+	case *array.Int64Builder:
+		b.Append(value.(int64))
+	case *array.Float32Builder:
+		b.Append(value.(float32))
+	case *array.Float64Builder:
+		b.Append(value.(float64))
+	case *array.StringBuilder:
+		if v, ok := value.([]byte); ok {
+			b.Append(string(v))
+		} else {
+			b.Append(value.(string))
+		}
+	case *array.FixedSizeBinaryBuilder:
+		b.Append(value.([]byte))
+	case *array.ListBuilder:
+		b.Append(true)
+		var elems reflect.Value
+		if v, ok := value.(*schemapb.ScalarField); ok {
+			elems = reflect.ValueOf(arrayValues(v))
+		} else {
+			elems = reflect.ValueOf(value)
+		}
+		if elems.Kind() != reflect.Slice {
+			return fmt.Errorf("unexpected list value of type %T", value)
+		}
+		for i := 0; i < elems.Len(); i++ {
+			if err := appendArrowValue(b.ValueBuilder(), elems.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported arrow builder %T", builder)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+	"github.com/apache/arrow/go/v8/parquet/file"
+	"github.com/apache/arrow/go/v8/parquet/pqarrow"
+	"github.com/sbinet/npyio"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+)
+
+func Test_writeJSON(t *testing.T) {
+	content, err := writeJSON(sampleSchema(), sampleRows()[:2])
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"rows": [
+		{"pk": 1, "name": "apple", "score": 0.5, "meta": {"color": "red", "size": 3}, "vec": [1, 2]},
+		{"pk": 2, "name": "banana", "score": null, "meta": {"color": "yellow"}, "vec": [3, 4]}
+	]}`, string(content))
+
+	content, err = writeJSON(sampleSchema(), mockRows{})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"rows": []}`, string(content))
+}
+
+func Test_writeNumpy(t *testing.T) {
+	files, err := writeNumpy(sampleSchema(), sampleRows())
+	assert.NoError(t, err)
+	assert.Len(t, files, 5)
+
+	var pks []int64
+	assert.NoError(t, npyio.Read(bytes.NewReader(files["pk"]), &pks))
+	assert.Equal(t, []int64{1, 2, 3}, pks)
+
+	var scores []float32
+	assert.NoError(t, npyio.Read(bytes.NewReader(files["score"]), &scores))
+	assert.Equal(t, []float32{0.5, 0, 2.5}, scores)
+
+	reader, err := npyio.NewReader(bytes.NewReader(files["vec"]))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 2}, reader.Header.Descr.Shape)
+	var vectors []float32
+	assert.NoError(t, reader.Read(&vectors))
+	assert.Equal(t, []float32{1, 2, 3, 4, 5, 6}, vectors)
+
+	// array fields can't be written as numpy files
+	schema := sampleSchema()
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID: 105, Name: "tags", DataType: schemapb.DataType_Array, ElementType: schemapb.DataType_Int64,
+	})
+	_, err = writeNumpy(schema, sampleRows())
+	assert.Error(t, err)
+}
+
+func Test_writeParquet(t *testing.T) {
+	schema := sampleSchema()
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID: 105, Name: "tags", DataType: schemapb.DataType_Array, ElementType: schemapb.DataType_Int16,
+	})
+	rows := sampleRows()
+	for i := range rows {
+		rows[i][105] = &schemapb.ScalarField{Data: &schemapb.ScalarField_IntData{
+			IntData: &schemapb.IntArray{Data: []int32{int32(i), int32(i + 1)}},
+		}}
+	}
+
+	content, err := writeParquet(schema, rows)
+	assert.NoError(t, err)
+
+	reader, err := file.NewParquetReader(bytes.NewReader(content))
+	assert.NoError(t, err)
+	defer reader.Close()
+	fileReader, err := pqarrow.NewFileReader(reader, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	assert.NoError(t, err)
+	table, err := fileReader.ReadTable(context.Background())
+	assert.NoError(t, err)
+	defer table.Release()
+
+	assert.Equal(t, int64(3), table.NumRows())
+	assert.Equal(t, int64(6), table.NumCols())
+	assert.Equal(t, "pk", table.Schema().Field(0).Name)
+
+	pks := table.Column(0).Data().Chunk(0).(*array.Int64)
+	assert.Equal(t, []int64{1, 2, 3}, pks.Int64Values())
+	scores := table.Column(2).Data().Chunk(0).(*array.Float32)
+	assert.True(t, scores.IsNull(1))
+	assert.Equal(t, float32(2.5), scores.Value(2))
+	meta := table.Column(3).Data().Chunk(0).(*array.String)
+	assert.Equal(t, `{"color": "yellow"}`, meta.Value(1))
+	vectors := table.Column(4).Data().Chunk(0).(*array.List)
+	assert.Equal(t, []float32{1, 2, 3, 4, 5, 6}, vectors.ListValues().(*array.Float32).Float32Values())
+	tags := table.Column(5).Data().Chunk(0).(*array.List)
+	assert.Equal(t, []int32{0, 1, 1, 2, 2, 3}, tags.ListValues().(*array.Int32).Int32Values())
+}
@@ -17,6 +17,7 @@
 package importutil
 
 import (
+	"fmt"
 	"math"
 	"strconv"
 	"strings"
@@ -34,15 +35,20 @@ const (
 	StartTs      = "start_ts" // start timestamp to filter data, only data between StartTs and EndTs will be imported
 	EndTs        = "end_ts"   // end timestamp to filter data, only data between StartTs and EndTs will be imported
 	OptionFormat = "start_ts: 10-digit physical timestamp, e.g. 1665995420, default 0 \n" +
-		"end_ts: 10-digit physical timestamp, e.g. 1665995420, default math.MaxInt \n"
+		"end_ts: 10-digit physical timestamp, e.g. 1665995420, default math.MaxInt \n" +
+		"retry_job: ID of the import job to retry, e.g. 441211011710779393 \n"
 	BackupFlag = "backup"
+	Prefix     = "prefix"      // the path prefix to discover the import files under, used when no file is specified
+	RetryJob   = "retry_job"   // ID of the manifest import job whose failed files are to be imported again
+	FileGroups = "file_groups" // the file number of each group in a task of batched file groups, e.g. "1,1,3"
 )
 
 type ImportOptions struct {
 	OnlyValidate bool
 	TsStartPoint uint64
 	TsEndPoint   uint64
-	IsBackup     bool // whether is triggered by backup tool
+	IsBackup     bool  // whether is triggered by backup tool
+	FileGroups   []int // the file number of each group if the files are batched groups, each group is imported separately
 }
 
 func DefaultImportOptions() ImportOptions {
@@ -59,6 +65,7 @@ func DefaultImportOptions() ImportOptions {
 //
 //	start_ts: 10-digit physical timestamp, e.g. 1665995420
 //	end_ts: 10-digit physical timestamp, e.g. 1665995420
+//	retry_job: import job ID
 func ValidateOptions(options []*commonpb.KeyValuePair) error {
 	optionMap := funcutil.KeyValuePair2Map(options)
 	// StartTs should be int
@@ -80,12 +87,58 @@ func ValidateOptions(options []*commonpb.KeyValuePair) error {
 			return err
 		}
 	}
+	// RetryJob should be int
+	if value, ok := optionMap[RetryJob]; ok {
+		if _, err = strconv.ParseInt(value, 10, 64); err != nil {
+			return err
+		}
+	}
 	if startTs > endTs {
 		return errors.New("start_ts shouldn't be larger than end_ts")
 	}
+	if _, err = ParseFileGroups(options); err != nil {
+		return err
+	}
 	return nil
 }
 
+// ParseFileGroups returns the file number of each group from input options, nil if the files are not batched groups.
+func ParseFileGroups(options []*commonpb.KeyValuePair) ([]int, error) {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(FileGroups, options)
+	if err != nil {
+		return nil, nil
+	}
+	groups := make([]int, 0)
+	for _, num := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(num))
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("invalid file groups '%s'", value)
+		}
+		groups = append(groups, n)
+	}
+	return groups, nil
+}
+
+// SplitFileGroups splits the files of an import task into the groups to import separately.
+func SplitFileGroups(filePaths []string, fileGroups []int) ([][]string, error) {
+	if len(fileGroups) == 0 {
+		return [][]string{filePaths}, nil
+	}
+	groups := make([][]string, 0, len(fileGroups))
+	start := 0
+	for _, n := range fileGroups {
+		if start+n > len(filePaths) {
+			return nil, fmt.Errorf("file groups %v don't match the %d files", fileGroups, len(filePaths))
+		}
+		groups = append(groups, filePaths[start:start+n])
+		start += n
+	}
+	if start != len(filePaths) {
+		return nil, fmt.Errorf("file groups %v don't match the %d files", fileGroups, len(filePaths))
+	}
+	return groups, nil
+}
+
 // ParseTSFromOptions get (start_ts, end_ts, error) from input options.
 // return value will be composed to milvus system timestamp from physical timestamp
 func ParseTSFromOptions(options []*commonpb.KeyValuePair) (uint64, uint64, error) {
@@ -51,6 +51,41 @@ func TestValidateOptions(t *testing.T) {
 		{Key: "start_ts", Value: "3.14"},
 		{Key: "end_ts", Value: "1666007457"},
 	}))
+	assert.NoError(t, ValidateOptions([]*commonpb.KeyValuePair{
+		{Key: "retry_job", Value: "441211011710779393"},
+	}))
+	assert.Error(t, ValidateOptions([]*commonpb.KeyValuePair{
+		{Key: "retry_job", Value: "job"},
+	}))
+	assert.NoError(t, ValidateOptions([]*commonpb.KeyValuePair{
+		{Key: "file_groups", Value: "1,2"},
+	}))
+	assert.Error(t, ValidateOptions([]*commonpb.KeyValuePair{
+		{Key: "file_groups", Value: "1,0"},
+	}))
+}
+
+func TestFileGroups(t *testing.T) {
+	fileGroups, err := ParseFileGroups([]*commonpb.KeyValuePair{})
+	assert.NoError(t, err)
+	assert.Nil(t, fileGroups)
+	groups, err := SplitFileGroups([]string{"a.json"}, fileGroups)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"a.json"}}, groups)
+
+	fileGroups, err = ParseFileGroups([]*commonpb.KeyValuePair{{Key: "file_groups", Value: "1, 2"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, fileGroups)
+	groups, err = SplitFileGroups([]string{"a/x.npy", "b/x.npy", "b/y.npy"}, fileGroups)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"a/x.npy"}, {"b/x.npy", "b/y.npy"}}, groups)
+
+	_, err = SplitFileGroups([]string{"a/x.npy", "b/x.npy"}, fileGroups)
+	assert.Error(t, err)
+	_, err = SplitFileGroups([]string{"a/x.npy", "b/x.npy", "b/y.npy", "c/x.npy"}, fileGroups)
+	assert.Error(t, err)
+	_, err = ParseFileGroups([]*commonpb.KeyValuePair{{Key: "file_groups", Value: "a"}})
+	assert.Error(t, err)
 }
 
 func TestParseTSFromOptions(t *testing.T) {
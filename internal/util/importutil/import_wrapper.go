@@ -69,6 +69,7 @@ const (
 	PartitionName   = "partition"
 	PersistTimeCost = "persist_cost"
 	ProgressPercent = "progress_percent"
+	JobID           = "job_id"
 )
 
 // ReportImportAttempts is the maximum # of attempts to retry when import fails.
@@ -262,13 +263,30 @@ func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error
 		return p.doBinlogImport(filePaths, options.TsStartPoint, options.TsEndPoint)
 	}
 
+	// the batched file groups are imported one by one
+	groups, err := SplitFileGroups(filePaths, options.FileGroups)
+	if err != nil {
+		return err
+	}
+
+	tr := timerecord.NewTimeRecorder("Import task")
+	for _, group := range groups {
+		if err := p.importFiles(group, options); err != nil {
+			return err
+		}
+	}
+
+	return p.reportPersisted(p.reportImportAttempts, tr)
+}
+
+// importFiles imports a group of general data files, the row-based files or the column-based files of the same rows.
+func (p *ImportWrapper) importFiles(filePaths []string, options ImportOptions) error {
 	// normal logic for import general data files
 	rowBased, err := p.fileValidation(filePaths)
 	if err != nil {
 		return err
 	}
 
-	tr := timerecord.NewTimeRecorder("Import task")
 	if rowBased {
 		// parse and consume row-based files
 		// for row-based files, the JSONRowConsumer will generate autoid for primary key, and split rows into segments
@@ -314,7 +332,7 @@ func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error
 		triggerGC()
 	}
 
-	return p.reportPersisted(p.reportImportAttempts, tr)
+	return nil
 }
 
 // reportPersisted notify the rootcoord to mark the task state to be ImportPersisted
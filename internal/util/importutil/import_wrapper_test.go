@@ -431,6 +431,23 @@ func Test_ImportWrapperColumnBased_numpy(t *testing.T) {
 	assert.Equal(t, 5, rowCounter.rowCount)
 	assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.State)
 
+	// batched file groups are imported one by one
+	importResult.State = commonpb.ImportState_ImportStarted
+	wrapper = NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	batchedFiles := append(append([]string{}, files...), files...)
+	err = wrapper.Import(batchedFiles, DefaultImportOptions())
+	assert.Error(t, err)
+	options := DefaultImportOptions()
+	options.FileGroups = []int{len(files), len(files)}
+	err = wrapper.Import(batchedFiles, options)
+	assert.NoError(t, err)
+	assert.Equal(t, 15, rowCounter.rowCount)
+	assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.State)
+	options.FileGroups = []int{len(files)}
+	err = wrapper.Import(batchedFiles, options)
+	assert.Error(t, err)
+
 	// row count of fields not equal
 	filePath := path.Join(cm.RootPath(), "FieldInt8.npy")
 	content, err := CreateNumpyData([]int8{10})
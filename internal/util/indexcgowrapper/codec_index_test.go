@@ -171,6 +171,20 @@ func genScalarIndexCases(dtype schemapb.DataType) []indexTestCase {
 				"index_type": "flat",
 			},
 		},
+		{
+			dtype:      dtype,
+			typeParams: nil,
+			indexParams: map[string]string{
+				"index_type": "INVERTED",
+			},
+		},
+		{
+			dtype:      dtype,
+			typeParams: nil,
+			indexParams: map[string]string{
+				"index_type": "BITMAP",
+			},
+		},
 	}
 }
 
@@ -190,6 +204,20 @@ func genStringIndexCases(dtype schemapb.DataType) []indexTestCase {
 				"index_type": "marisa-trie",
 			},
 		},
+		{
+			dtype:      dtype,
+			typeParams: nil,
+			indexParams: map[string]string{
+				"index_type": "INVERTED",
+			},
+		},
+		{
+			dtype:      dtype,
+			typeParams: nil,
+			indexParams: map[string]string{
+				"index_type": "BITMAP",
+			},
+		},
 	}
 }
 
@@ -28,13 +28,16 @@ import (
 
 	"github.com/blang/semver/v4"
 	"github.com/cockroachdb/errors"
-	"go.etcd.io/etcd/api/v3/mvccpb"
-	v3rpc "go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	"github.com/samber/lo"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/kv"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/retry"
 )
@@ -96,9 +99,9 @@ type Session struct {
 	Version     semver.Version `json:"Version,omitempty"`
 
 	liveCh            <-chan bool
-	etcdCli           *clientv3.Client
-	leaseID           *clientv3.LeaseID
-	watchSessionKeyCh clientv3.WatchChan
+	metaKv            kv.MetaKv
+	leaseID           *kv.LeaseID
+	watchSessionKeyCh kv.WatchChan
 	wg                sync.WaitGroup
 
 	metaRoot string
@@ -190,11 +193,56 @@ func (s *Session) MarshalJSON() ([]byte, error) {
 
 }
 
+// NewSessionMetaKv returns the MetaKv keeping the sessions according to metastore.sessionType,
+// the root path of it is empty. The rocksdb of the sessions is opened by standalone only.
+func NewSessionMetaKv(client *clientv3.Client) (kv.MetaKv, error) {
+	if paramtable.Get().MetaStoreCfg.SessionType.GetValue() == util.MetaStoreTypeRocksdb {
+		metaKv := rocksdbkv.SharedMetaKV("")
+		if metaKv == nil {
+			return nil, errors.New("the rocksdb of sessions is not opened, which is only supported by standalone")
+		}
+		return metaKv, nil
+	}
+	return etcdkv.NewEtcdKV(client, ""), nil
+}
+
 // NewSession is a helper to build Session object.
 // ServerID, ServerName, Address, Exclusive will be assigned after Init().
 // metaRoot is a path in etcd to save session information.
 // etcdEndpoints is to init etcdCli when NewSession
+// The sessions are kept in the embedded rocksdb instead of etcd if metastore.sessionType is rocksdb.
 func NewSession(ctx context.Context, metaRoot string, client *clientv3.Client, opts ...SessionOption) *Session {
+	if paramtable.Get().MetaStoreCfg.SessionType.GetValue() == util.MetaStoreTypeRocksdb {
+		metaKv, err := NewSessionMetaKv(client)
+		if err != nil {
+			log.Warn("failed to initialize session", zap.Error(err))
+			return nil
+		}
+		return NewSessionWithMetaKv(ctx, metaRoot, metaKv, opts...)
+	}
+
+	connectEtcdFn := func() error {
+		log.Debug("Session try to connect to etcd")
+		ctx2, cancel2 := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel2()
+		if _, err := client.Get(ctx2, "health"); err != nil {
+			return err
+		}
+		return nil
+	}
+	err := retry.Do(ctx, connectEtcdFn, retry.Attempts(100))
+	if err != nil {
+		log.Warn("failed to initialize session",
+			zap.Error(err))
+		return nil
+	}
+	log.Debug("Session connect to etcd success")
+	return NewSessionWithMetaKv(ctx, metaRoot, etcdkv.NewEtcdKV(client, ""), opts...)
+}
+
+// NewSessionWithMetaKv builds Session object upon the provided MetaKv,
+// the keys of the session are the full paths so the root path of metaKv shall be empty.
+func NewSessionWithMetaKv(ctx context.Context, metaRoot string, metaKv kv.MetaKv, opts ...SessionOption) *Session {
 	session := &Session{
 		ctx:      ctx,
 		metaRoot: metaRoot,
@@ -214,24 +262,7 @@ func NewSession(ctx context.Context, metaRoot string, client *clientv3.Client, o
 	session.apply(opts...)
 
 	session.UpdateRegistered(false)
-
-	connectEtcdFn := func() error {
-		log.Debug("Session try to connect to etcd")
-		ctx2, cancel2 := context.WithTimeout(session.ctx, 5*time.Second)
-		defer cancel2()
-		if _, err := client.Get(ctx2, "health"); err != nil {
-			return err
-		}
-		session.etcdCli = client
-		return nil
-	}
-	err := retry.Do(ctx, connectEtcdFn, retry.Attempts(100))
-	if err != nil {
-		log.Warn("failed to initialize session",
-			zap.Error(err))
-		return nil
-	}
-	log.Debug("Session connect to etcd success")
+	session.metaKv = metaKv
 	return session
 }
 
@@ -291,43 +322,32 @@ func (s *Session) getServerID() (int64, error) {
 }
 
 func (s *Session) checkIDExist() {
-	s.etcdCli.Txn(s.ctx).If(
-		clientv3.Compare(
-			clientv3.Version(path.Join(s.metaRoot, DefaultServiceRoot, DefaultIDKey)),
-			"=",
-			0)).
-		Then(clientv3.OpPut(path.Join(s.metaRoot, DefaultServiceRoot, DefaultIDKey), "1")).Commit()
+	s.metaKv.CompareVersionAndSwap(path.Join(s.metaRoot, DefaultServiceRoot, DefaultIDKey), 0, "1")
 }
 
 func (s *Session) getServerIDWithKey(key string) (int64, error) {
 	for {
-		getResp, err := s.etcdCli.Get(s.ctx, path.Join(s.metaRoot, DefaultServiceRoot, key))
+		value, err := s.metaKv.Load(path.Join(s.metaRoot, DefaultServiceRoot, key))
+		if common.IsKeyNotExistError(err) {
+			log.Warn("Session there is no value", zap.String("key", key))
+			continue
+		}
 		if err != nil {
 			log.Warn("Session get etcd key error", zap.String("key", key), zap.Error(err))
 			return -1, err
 		}
-		if getResp.Count <= 0 {
-			log.Warn("Session there is no value", zap.String("key", key))
-			continue
-		}
-		value := string(getResp.Kvs[0].Value)
 		valueInt, err := strconv.ParseInt(value, 10, 64)
 		if err != nil {
 			log.Warn("Session ParseInt error", zap.String("value", value), zap.Error(err))
 			continue
 		}
-		txnResp, err := s.etcdCli.Txn(s.ctx).If(
-			clientv3.Compare(
-				clientv3.Value(path.Join(s.metaRoot, DefaultServiceRoot, key)),
-				"=",
-				value)).
-			Then(clientv3.OpPut(path.Join(s.metaRoot, DefaultServiceRoot, key), strconv.FormatInt(valueInt+1, 10))).Commit()
+		succeeded, err := s.metaKv.CompareValueAndSwap(path.Join(s.metaRoot, DefaultServiceRoot, key), value, strconv.FormatInt(valueInt+1, 10))
 		if err != nil {
 			log.Warn("Session Txn failed", zap.String("key", key), zap.Error(err))
 			return -1, err
 		}
 
-		if !txnResp.Succeeded {
+		if !succeeded {
 			log.Warn("Session Txn unsuccessful", zap.String("key", key))
 			continue
 		}
@@ -353,11 +373,11 @@ func (s *Session) getSessionKey() string {
 }
 
 func (s *Session) initWatchSessionCh() {
-	getResp, err := s.etcdCli.Get(context.Background(), s.getSessionKey())
+	_, _, revision, err := s.metaKv.LoadWithRevision(s.getSessionKey())
 	if err != nil {
 		panic(err)
 	}
-	s.watchSessionKeyCh = s.etcdCli.Watch(context.Background(), s.getSessionKey(), clientv3.WithRev(getResp.Header.Revision))
+	s.watchSessionKeyCh = s.metaKv.WatchWithOptions(context.Background(), s.getSessionKey(), kv.WithRevision(revision))
 }
 
 // registerService registers the service to etcd so that other services
@@ -375,47 +395,41 @@ func (s *Session) initWatchSessionCh() {
 //
 // Exclusive means whether this service can exist two at the same time, if so,
 // it is false. Otherwise, set it to true.
-func (s *Session) registerService() (<-chan *clientv3.LeaseKeepAliveResponse, error) {
+func (s *Session) registerService() (<-chan *kv.LeaseKeepAliveResponse, error) {
 	if s.enableActiveStandBy {
 		s.updateStandby(true)
 	}
 	completeKey := s.getCompleteKey()
-	var ch <-chan *clientv3.LeaseKeepAliveResponse
+	var ch <-chan *kv.LeaseKeepAliveResponse
 	log.Debug("service begin to register to etcd", zap.String("serverName", s.ServerName), zap.Int64("ServerID", s.ServerID))
 
 	registerFn := func() error {
-		resp, err := s.etcdCli.Grant(s.ctx, s.sessionTTL)
+		leaseID, err := s.metaKv.Grant(s.sessionTTL)
 		if err != nil {
 			log.Error("register service", zap.Error(err))
 			return err
 		}
-		s.leaseID = &resp.ID
+		s.leaseID = &leaseID
 
 		sessionJSON, err := json.Marshal(s)
 		if err != nil {
 			return err
 		}
 
-		txnResp, err := s.etcdCli.Txn(s.ctx).If(
-			clientv3.Compare(
-				clientv3.Version(completeKey),
-				"=",
-				0)).
-			Then(clientv3.OpPut(completeKey, string(sessionJSON), clientv3.WithLease(resp.ID))).Commit()
-
+		succeeded, err := s.metaKv.CompareVersionAndSwap(completeKey, 0, string(sessionJSON), kv.WithLease(leaseID))
 		if err != nil {
 			log.Warn("compare and swap error, maybe the key has already been registered", zap.Error(err))
 			return err
 		}
 
-		if !txnResp.Succeeded {
+		if !succeeded {
 			return fmt.Errorf("function CompareAndSwap error for compare is false for key: %s", s.ServerName)
 		}
 		log.Debug("put session key into etcd", zap.String("key", completeKey), zap.String("value", string(sessionJSON)))
 
 		keepAliveCtx, keepAliveCancel := context.WithCancel(context.Background())
 		s.keepAliveCancel = keepAliveCancel
-		ch, err = s.etcdCli.KeepAlive(keepAliveCtx, resp.ID)
+		ch, err = s.metaKv.KeepAlive(keepAliveCtx, leaseID)
 		if err != nil {
 			log.Warn("go error during keeping alive with etcd", zap.Error(err))
 			return err
@@ -432,7 +446,7 @@ func (s *Session) registerService() (<-chan *clientv3.LeaseKeepAliveResponse, er
 
 // processKeepAliveResponse processes the response of etcd keepAlive interface
 // If keepAlive fails for unexpected error, it will send a signal to the channel.
-func (s *Session) processKeepAliveResponse(ch <-chan *clientv3.LeaseKeepAliveResponse) (failChannel <-chan bool) {
+func (s *Session) processKeepAliveResponse(ch <-chan *kv.LeaseKeepAliveResponse) (failChannel <-chan bool) {
 	failCh := make(chan bool)
 	s.wg.Add(1)
 	go func() {
@@ -468,24 +482,23 @@ func (s *Session) processKeepAliveResponse(ch <-chan *clientv3.LeaseKeepAliveRes
 func (s *Session) GetSessions(prefix string) (map[string]*Session, int64, error) {
 	res := make(map[string]*Session)
 	key := path.Join(s.metaRoot, DefaultServiceRoot, prefix)
-	resp, err := s.etcdCli.Get(s.ctx, key, clientv3.WithPrefix(),
-		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	keys, values, revision, err := s.metaKv.LoadWithRevision(key)
 	if err != nil {
 		return nil, 0, err
 	}
-	for _, kv := range resp.Kvs {
+	for i, value := range values {
 		session := &Session{}
-		err = json.Unmarshal(kv.Value, session)
+		err = json.Unmarshal([]byte(value), session)
 		if err != nil {
 			return nil, 0, err
 		}
-		_, mapKey := path.Split(string(kv.Key))
+		_, mapKey := path.Split(keys[i])
 		log.Debug("SessionUtil GetSessions ", zap.Any("prefix", prefix),
 			zap.String("key", mapKey),
 			zap.Any("address", session.Address))
 		res[mapKey] = session
 	}
-	return res, resp.Header.Revision, nil
+	return res, revision, nil
 }
 
 // GetSessionsWithVersionRange will get all sessions with provided prefix and version range in etcd.
@@ -493,14 +506,13 @@ func (s *Session) GetSessions(prefix string) (map[string]*Session, int64, error)
 func (s *Session) GetSessionsWithVersionRange(prefix string, r semver.Range) (map[string]*Session, int64, error) {
 	res := make(map[string]*Session)
 	key := path.Join(s.metaRoot, DefaultServiceRoot, prefix)
-	resp, err := s.etcdCli.Get(s.ctx, key, clientv3.WithPrefix(),
-		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	keys, values, revision, err := s.metaKv.LoadWithRevision(key)
 	if err != nil {
 		return nil, 0, err
 	}
-	for _, kv := range resp.Kvs {
+	for i, value := range values {
 		session := &Session{}
-		err = json.Unmarshal(kv.Value, session)
+		err = json.Unmarshal([]byte(value), session)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -508,36 +520,36 @@ func (s *Session) GetSessionsWithVersionRange(prefix string, r semver.Range) (ma
 			log.Debug("Session version out of range", zap.String("version", session.Version.String()), zap.Int64("serverID", session.ServerID))
 			continue
 		}
-		_, mapKey := path.Split(string(kv.Key))
+		_, mapKey := path.Split(keys[i])
 		log.Debug("SessionUtil GetSessions ", zap.String("prefix", prefix),
 			zap.String("key", mapKey),
 			zap.String("address", session.Address))
 		res[mapKey] = session
 	}
-	return res, resp.Header.Revision, nil
+	return res, revision, nil
 }
 
 func (s *Session) GoingStop() error {
-	if s == nil || s.etcdCli == nil || s.leaseID == nil {
+	if s == nil || s.metaKv == nil || s.leaseID == nil {
 		return errors.New("the session hasn't been init")
 	}
 
 	completeKey := s.getCompleteKey()
-	resp, err := s.etcdCli.Get(s.ctx, completeKey, clientv3.WithCountOnly())
+	_, err := s.metaKv.Load(completeKey)
+	if common.IsKeyNotExistError(err) {
+		return nil
+	}
 	if err != nil {
 		log.Error("fail to get the session", zap.String("key", completeKey), zap.Error(err))
 		return err
 	}
-	if resp.Count == 0 {
-		return nil
-	}
 	s.Stopping = true
 	sessionJSON, err := json.Marshal(s)
 	if err != nil {
 		log.Error("fail to marshal the session", zap.String("key", completeKey))
 		return err
 	}
-	_, err = s.etcdCli.Put(s.ctx, completeKey, string(sessionJSON), clientv3.WithLease(*s.leaseID))
+	err = s.metaKv.SaveWithLease(completeKey, string(sessionJSON), *s.leaseID)
 	if err != nil {
 		log.Error("fail to update the session to stopping state", zap.String("key", completeKey))
 		return err
@@ -556,7 +568,7 @@ type SessionEvent struct {
 
 type sessionWatcher struct {
 	s        *Session
-	rch      clientv3.WatchChan
+	rch      kv.WatchChan
 	eventCh  chan *SessionEvent
 	prefix   string
 	rewatch  Rewatch
@@ -592,7 +604,7 @@ func (s *Session) WatchServices(prefix string, revision int64, rewatch Rewatch)
 	w := &sessionWatcher{
 		s:        s,
 		eventCh:  make(chan *SessionEvent, 100),
-		rch:      s.etcdCli.Watch(s.ctx, path.Join(s.metaRoot, DefaultServiceRoot, prefix), clientv3.WithPrefix(), clientv3.WithPrevKV(), clientv3.WithRev(revision)),
+		rch:      s.metaKv.WatchWithOptions(s.ctx, path.Join(s.metaRoot, DefaultServiceRoot, prefix), kv.WithPrefix(), kv.WithPrevKV(), kv.WithRevision(revision)),
 		prefix:   prefix,
 		rewatch:  rewatch,
 		validate: func(s *Session) bool { return true },
@@ -611,7 +623,7 @@ func (s *Session) WatchServicesWithVersionRange(prefix string, r semver.Range, r
 	w := &sessionWatcher{
 		s:        s,
 		eventCh:  make(chan *SessionEvent, 100),
-		rch:      s.etcdCli.Watch(s.ctx, path.Join(s.metaRoot, DefaultServiceRoot, prefix), clientv3.WithPrefix(), clientv3.WithPrevKV(), clientv3.WithRev(revision)),
+		rch:      s.metaKv.WatchWithOptions(s.ctx, path.Join(s.metaRoot, DefaultServiceRoot, prefix), kv.WithPrefix(), kv.WithPrevKV(), kv.WithRevision(revision)),
 		prefix:   prefix,
 		rewatch:  rewatch,
 		validate: func(s *Session) bool { return r(s.Version) },
@@ -620,7 +632,7 @@ func (s *Session) WatchServicesWithVersionRange(prefix string, r semver.Range, r
 	return w.eventCh
 }
 
-func (w *sessionWatcher) handleWatchResponse(wresp clientv3.WatchResponse) {
+func (w *sessionWatcher) handleWatchResponse(wresp kv.WatchResponse) {
 	if wresp.Err() != nil {
 		err := w.handleWatchErr(wresp.Err())
 		if err != nil {
@@ -633,7 +645,7 @@ func (w *sessionWatcher) handleWatchResponse(wresp clientv3.WatchResponse) {
 		session := &Session{}
 		var eventType SessionEventType
 		switch ev.Type {
-		case mvccpb.PUT:
+		case kv.EventTypePut:
 			log.Debug("watch services",
 				zap.Any("add kv", ev.Kv))
 			err := json.Unmarshal(ev.Kv.Value, session)
@@ -649,7 +661,7 @@ func (w *sessionWatcher) handleWatchResponse(wresp clientv3.WatchResponse) {
 			} else {
 				eventType = SessionAddEvent
 			}
-		case mvccpb.DELETE:
+		case kv.EventTypeDelete:
 			log.Debug("watch services",
 				zap.Any("delete kv", ev.PrevKv))
 			err := json.Unmarshal(ev.PrevKv.Value, session)
@@ -672,7 +684,7 @@ func (w *sessionWatcher) handleWatchResponse(wresp clientv3.WatchResponse) {
 
 func (w *sessionWatcher) handleWatchErr(err error) error {
 	// if not ErrCompacted, just close the channel
-	if err != v3rpc.ErrCompacted {
+	if !errors.Is(err, kv.ErrCompacted) {
 		//close event channel
 		log.Warn("Watch service found error", zap.Error(err))
 		close(w.eventCh)
@@ -697,7 +709,7 @@ func (w *sessionWatcher) handleWatchErr(err error) error {
 		return err
 	}
 
-	w.rch = w.s.etcdCli.Watch(w.s.ctx, path.Join(w.s.metaRoot, DefaultServiceRoot, w.prefix), clientv3.WithPrefix(), clientv3.WithPrevKV(), clientv3.WithRev(revision))
+	w.rch = w.s.metaKv.WatchWithOptions(w.s.ctx, path.Join(w.s.metaRoot, DefaultServiceRoot, w.prefix), kv.WithPrefix(), kv.WithPrevKV(), kv.WithRevision(revision))
 	return nil
 }
 
@@ -740,7 +752,7 @@ func (s *Session) LivenessCheck(ctx context.Context, callback func()) {
 				}
 				if resp.Err() != nil {
 					// if not ErrCompacted, just close the channel
-					if resp.Err() != v3rpc.ErrCompacted {
+					if !errors.Is(resp.Err(), kv.ErrCompacted) {
 						//close event channel
 						log.Warn("Watch service found error", zap.Error(resp.Err()))
 						if s.keepAliveCancel != nil {
@@ -749,21 +761,21 @@ func (s *Session) LivenessCheck(ctx context.Context, callback func()) {
 						return
 					}
 					log.Warn("Watch service found compacted error", zap.Error(resp.Err()))
-					getResp, err := s.etcdCli.Get(s.ctx, s.getSessionKey())
-					if err != nil || len(getResp.Kvs) == 0 {
+					keys, _, revision, err := s.metaKv.LoadWithRevision(s.getSessionKey())
+					if err != nil || !lo.Contains(keys, s.getSessionKey()) {
 						if s.keepAliveCancel != nil {
 							s.keepAliveCancel()
 						}
 						return
 					}
-					s.watchSessionKeyCh = s.etcdCli.Watch(s.ctx, s.getSessionKey(), clientv3.WithRev(getResp.Header.Revision))
+					s.watchSessionKeyCh = s.metaKv.WatchWithOptions(s.ctx, s.getSessionKey(), kv.WithRevision(revision))
 					continue
 				}
 				for _, event := range resp.Events {
 					switch event.Type {
-					case mvccpb.PUT:
+					case kv.EventTypePut:
 						log.Info("register session success", zap.String("role", s.ServerName), zap.String("key", string(event.Kv.Key)))
-					case mvccpb.DELETE:
+					case kv.EventTypeDelete:
 						log.Info("session key is deleted, exit...", zap.String("role", s.ServerName), zap.String("key", string(event.Kv.Key)))
 						if s.keepAliveCancel != nil {
 							s.keepAliveCancel()
@@ -788,14 +800,20 @@ func (s *Session) Revoke(timeout time.Duration) {
 	if s == nil {
 		return
 	}
-	if s.etcdCli == nil || s.leaseID == nil {
+	if s.metaKv == nil || s.leaseID == nil {
 		return
 	}
 	// can NOT use s.ctx, it may be Done here
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
-	defer cancel()
-	// ignores resp & error, just do best effort to revoke
-	_, _ = s.etcdCli.Revoke(ctx, *s.leaseID)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		// ignores error, just do best effort to revoke
+		_ = s.metaKv.Revoke(*s.leaseID)
+	}()
+	select {
+	case <-done:
+	case <-time.After(timeout):
+	}
 }
 
 // UpdateRegistered update the state of registered.
@@ -846,24 +864,26 @@ func (s *Session) ProcessActiveStandBy(activateFunc func() error) error {
 			log.Error("json marshal error", zap.Error(err))
 			return false, -1, err
 		}
-		txnResp, err := s.etcdCli.Txn(s.ctx).If(
-			clientv3.Compare(
-				clientv3.Version(s.activeKey),
-				"=",
-				0)).
-			Then(clientv3.OpPut(s.activeKey, string(sessionJSON), clientv3.WithLease(*s.leaseID))).Commit()
+		doRegistered, err := s.metaKv.CompareVersionAndSwap(s.activeKey, 0, string(sessionJSON), kv.WithLease(*s.leaseID))
 		if err != nil {
 			log.Error("register active key to etcd failed", zap.Error(err))
 			return false, -1, err
 		}
-		doRegistered := txnResp.Succeeded
 		if doRegistered {
 			log.Info(fmt.Sprintf("register ACTIVE %s", s.ServerName))
-		} else {
-			log.Info(fmt.Sprintf("ACTIVE %s has already been registered", s.ServerName))
+			return true, -1, nil
+		}
+		log.Info(fmt.Sprintf("ACTIVE %s has already been registered", s.ServerName))
+		keys, _, revision, err := s.metaKv.LoadWithRevision(s.activeKey)
+		if err != nil {
+			log.Error("load active key failed", zap.Error(err))
+			return false, -1, err
+		}
+		// the active key may be deleted after the compare, watching from the revision would miss the deletion
+		if !lo.Contains(keys, s.activeKey) {
+			return false, -1, fmt.Errorf("active key %s has been removed, retry to register", s.activeKey)
 		}
-		revision := txnResp.Header.GetRevision()
-		return doRegistered, revision, nil
+		return false, revision, nil
 	}
 	s.updateStandby(true)
 	log.Info(fmt.Sprintf("serverName: %v enter STANDBY mode", s.ServerName))
@@ -885,7 +905,7 @@ func (s *Session) ProcessActiveStandBy(activateFunc func() error) error {
 		}
 		log.Info(fmt.Sprintf("%s start to watch ACTIVE key %s", s.ServerName, s.activeKey))
 		ctx, cancel := context.WithCancel(s.ctx)
-		watchChan := s.etcdCli.Watch(ctx, s.activeKey, clientv3.WithPrevKV(), clientv3.WithRev(revision))
+		watchChan := s.metaKv.WatchWithOptions(ctx, s.activeKey, kv.WithPrevKV(), kv.WithRevision(revision))
 		select {
 		case <-ctx.Done():
 			cancel()
@@ -898,9 +918,9 @@ func (s *Session) ProcessActiveStandBy(activateFunc func() error) error {
 			}
 			for _, event := range wresp.Events {
 				switch event.Type {
-				case mvccpb.PUT:
+				case kv.EventTypePut:
 					log.Debug("watch the ACTIVE key", zap.Any("ADD", event.Kv))
-				case mvccpb.DELETE:
+				case kv.EventTypeDelete:
 					log.Debug("watch the ACTIVE key", zap.Any("DELETE", event.Kv))
 					cancel()
 				}
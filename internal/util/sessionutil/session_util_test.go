@@ -20,17 +20,20 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
-	"go.etcd.io/etcd/api/v3/mvccpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/server/v3/embed"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3client"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 func TestGetServerIDConcurrently(t *testing.T) {
@@ -193,7 +196,7 @@ func TestSessionLivenessCheck(t *testing.T) {
 	etcdCli, err := etcd.GetRemoteEtcdClient(etcdEndpoints)
 	require.NoError(t, err)
 	s := &Session{
-		etcdCli:  etcdCli,
+		metaKv:   etcdkv.NewEtcdKV(etcdCli, ""),
 		metaRoot: metaRoot,
 	}
 	ctx := context.Background()
@@ -262,17 +265,17 @@ func TestWatcherHandleWatchResp(t *testing.T) {
 
 	t.Run("handle normal events", func(t *testing.T) {
 		w := getWatcher(s, nil)
-		wresp := clientv3.WatchResponse{
-			Events: []*clientv3.Event{
+		wresp := kv.WatchResponse{
+			Events: []*kv.Event{
 				{
-					Type: mvccpb.PUT,
-					Kv: &mvccpb.KeyValue{
+					Type: kv.EventTypePut,
+					Kv: &kv.KeyValue{
 						Value: []byte(`{"ServerID": 1, "ServerName": "test1"}`),
 					},
 				},
 				{
-					Type: mvccpb.DELETE,
-					PrevKv: &mvccpb.KeyValue{
+					Type: kv.EventTypeDelete,
+					PrevKv: &kv.KeyValue{
 						Value: []byte(`{"ServerID": 2, "ServerName": "test2"}`),
 					},
 				},
@@ -287,17 +290,17 @@ func TestWatcherHandleWatchResp(t *testing.T) {
 
 	t.Run("handle abnormal events", func(t *testing.T) {
 		w := getWatcher(s, nil)
-		wresp := clientv3.WatchResponse{
-			Events: []*clientv3.Event{
+		wresp := kv.WatchResponse{
+			Events: []*kv.Event{
 				{
-					Type: mvccpb.PUT,
-					Kv: &mvccpb.KeyValue{
+					Type: kv.EventTypePut,
+					Kv: &kv.KeyValue{
 						Value: []byte(``),
 					},
 				},
 				{
-					Type: mvccpb.DELETE,
-					PrevKv: &mvccpb.KeyValue{
+					Type: kv.EventTypeDelete,
+					PrevKv: &kv.KeyValue{
 						Value: []byte(``),
 					},
 				},
@@ -311,7 +314,7 @@ func TestWatcherHandleWatchResp(t *testing.T) {
 
 	t.Run("err compacted resp, nil Rewatch", func(t *testing.T) {
 		w := getWatcher(s, nil)
-		wresp := clientv3.WatchResponse{
+		wresp := kv.WatchResponse{
 			CompactRevision: 1,
 		}
 		assert.NotPanics(t, func() {
@@ -323,7 +326,7 @@ func TestWatcherHandleWatchResp(t *testing.T) {
 		w := getWatcher(s, func(sessions map[string]*Session) error {
 			return nil
 		})
-		wresp := clientv3.WatchResponse{
+		wresp := kv.WatchResponse{
 			CompactRevision: 1,
 		}
 		assert.NotPanics(t, func() {
@@ -333,7 +336,7 @@ func TestWatcherHandleWatchResp(t *testing.T) {
 
 	t.Run("err canceled", func(t *testing.T) {
 		w := getWatcher(s, nil)
-		wresp := clientv3.WatchResponse{
+		wresp := kv.WatchResponse{
 			Canceled: true,
 		}
 
@@ -346,7 +349,7 @@ func TestWatcherHandleWatchResp(t *testing.T) {
 		w := getWatcher(s, func(sessions map[string]*Session) error {
 			return errors.New("mocked")
 		})
-		wresp := clientv3.WatchResponse{
+		wresp := kv.WatchResponse{
 			CompactRevision: 1,
 		}
 		assert.Panics(t, func() {
@@ -356,11 +359,11 @@ func TestWatcherHandleWatchResp(t *testing.T) {
 
 	t.Run("err handled but list failed", func(t *testing.T) {
 		s := NewSession(ctx, "/by-dev/session-ut", etcdCli)
-		s.etcdCli.Close()
+		etcdCli.Close()
 		w := getWatcher(s, func(sessions map[string]*Session) error {
 			return nil
 		})
-		wresp := clientv3.WatchResponse{
+		wresp := kv.WatchResponse{
 			CompactRevision: 1,
 		}
 
@@ -745,3 +748,61 @@ func TestIntegrationMode(t *testing.T) {
 	s1.Init("inittest2", "testAddr2", false, false)
 	assert.NotEqual(t, s1.ServerID, s2.ServerID)
 }
+
+func TestSessionsOnRocksdb(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.MetaStoreCfg.SessionType.Key, util.MetaStoreTypeRocksdb)
+	defer params.Reset(params.MetaStoreCfg.SessionType.Key)
+
+	metaRoot := fmt.Sprintf("%d/%s", rand.Int(), DefaultServiceRoot)
+
+	// the rocksdb is not opened
+	assert.Nil(t, NewSession(context.Background(), metaRoot, nil))
+
+	err := rocksdbkv.InitSharedMetaKV(path.Join(t.TempDir(), "rdb_meta"))
+	require.NoError(t, err)
+	defer rocksdbkv.CloseSharedMetaKV()
+	// stop watching before closing the rocksdb
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the components of standalone share the same server id
+	roles := []string{typeutil.RootCoordRole, typeutil.DataCoordRole, typeutil.ProxyRole}
+	sessions := make([]*Session, 0, len(roles))
+	for i, role := range roles {
+		s := NewSession(ctx, metaRoot, nil)
+		require.NotNil(t, s)
+		s.Init(role, fmt.Sprintf("localhost:%d", 19530+i), role != typeutil.ProxyRole, false)
+		s.Register()
+		sessions = append(sessions, s)
+	}
+
+	observer := NewSession(ctx, metaRoot, nil)
+	require.NotNil(t, observer)
+	for _, role := range roles {
+		got, _, err := observer.GetSessions(role)
+		assert.NoError(t, err)
+		assert.Len(t, got, 1)
+	}
+
+	_, rev, err := observer.GetSessions(typeutil.ProxyRole)
+	assert.NoError(t, err)
+	eventCh := observer.WatchServices(typeutil.ProxyRole, rev+1, nil)
+	proxy := sessions[len(sessions)-1]
+	proxy.Stop()
+	select {
+	case event := <-eventCh:
+		assert.Equal(t, SessionDelEvent, event.EventType)
+		assert.Equal(t, proxy.ServerID, event.Session.ServerID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no delete event of the stopped session")
+	}
+
+	got, _, err := observer.GetSessions(typeutil.ProxyRole)
+	assert.NoError(t, err)
+	assert.Len(t, got, 0)
+	for _, s := range sessions[:len(sessions)-1] {
+		s.Stop()
+	}
+}
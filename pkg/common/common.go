@@ -87,10 +87,44 @@ const (
 	DimKey         = "dim"
 )
 
+// Field type parameter keys
+const (
+	// FieldNullableKey marks a scalar field as nullable, rows missing the field are stored as null.
+	// Null is set by column: FieldData has no per-row validity, so an insert could only leave
+	// a nullable field null for all its rows by omitting the column.
+	FieldNullableKey = "nullable"
+)
+
 //  Collection properties key
 
 const (
 	CollectionTTLConfigKey = "collection.ttl.seconds"
+
+	// CollectionSchemaVersionKey records how many times the schema of a collection has been changed.
+	CollectionSchemaVersionKey = "collection.schema.version"
+
+	// CollectionAddFieldKey carries a json encoded field schema in an alter collection request,
+	// which asks rootcoord to append the field to the collection schema.
+	CollectionAddFieldKey = "collection.field.add"
+
+	// CollectionAddFieldCompactKey asks rootcoord to schedule a compaction after adding a field,
+	// so that the default value of the new field is materialized into the existing segments.
+	CollectionAddFieldCompactKey = "collection.field.add.compact"
+
+	// CollectionClusteringKey names the scalar field which the segments of a collection are clustered by,
+	// the clustering compaction sorts the rows by it so each segment covers a contiguous range of it.
+	CollectionClusteringKey = "collection.clustering.key"
+
+	// The keys below override the global segment policies for a collection,
+	// see dataCoord.segment.maxSize, maxLife, maxIdleTime and dataNode.segment.syncPeriod.
+	CollectionSegmentMaxSizeKey     = "collection.segment.maxSize.mb"
+	CollectionSegmentMaxLifetimeKey = "collection.segment.maxLife.seconds"
+	CollectionSegmentMaxIdleTimeKey = "collection.segment.maxIdleTime.seconds"
+	CollectionSyncPeriodKey         = "collection.sync.period.seconds"
+
+	// CollectionPkIndexTypeKey selects how QueryNodes index the primary keys of the sealed segments to route the deletes,
+	// see queryNode.pkIndex.type.
+	CollectionPkIndexTypeKey = "collection.pkIndex.type"
 )
 
 const (
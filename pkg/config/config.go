@@ -26,6 +26,7 @@ var (
 	ErrNotInitial   = errors.New("config is not initialized")
 	ErrIgnoreChange = errors.New("ignore change")
 	ErrKeyNotFound  = errors.New("key not found")
+	ErrInvalidValue = errors.New("invalid value")
 )
 
 func Init(opts ...Option) (*Manager, error) {
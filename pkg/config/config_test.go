@@ -128,6 +128,34 @@ func TestConfigFromRemote(t *testing.T) {
 		assert.Equal(t, "info", v)
 	})
 
+	t.Run("update with audit", func(t *testing.T) {
+		source := mgr.sources["EtcdSource"].(*EtcdSource)
+		err := source.UpdateConfig(ctx, "test.audit", "1", &Audit{Key: "test.audit", Action: AuditActionSet, NewValue: "1", Operator: "root", Time: time.Now()})
+		assert.NoError(t, err)
+		assert.Eventually(t, func() bool {
+			v, err := mgr.GetConfig("test.audit")
+			return err == nil && v == "1"
+		}, time.Second, 10*time.Millisecond)
+
+		err = source.RemoveConfig(ctx, "test.audit", &Audit{Key: "test.audit", Action: AuditActionReset, OldValue: "1", Operator: "root", Time: time.Now()})
+		assert.NoError(t, err)
+		assert.Eventually(t, func() bool {
+			_, err := mgr.GetConfig("test.audit")
+			return err != nil
+		}, time.Second, 10*time.Millisecond)
+
+		audits, err := source.ListAudits(ctx, 0)
+		assert.NoError(t, err)
+		assert.Len(t, audits, 2)
+		assert.Equal(t, AuditActionReset, audits[0].Action)
+		assert.Equal(t, AuditActionSet, audits[1].Action)
+		assert.Equal(t, "root", audits[1].Operator)
+
+		audits, err = source.ListAudits(ctx, 1)
+		assert.NoError(t, err)
+		assert.Len(t, audits, 1)
+	})
+
 	t.Run("close manager", func(t *testing.T) {
 		mgr.Close()
 
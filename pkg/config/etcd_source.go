@@ -18,29 +18,37 @@ package config
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"sync"
 	"time"
 
+	"go.etcd.io/etcd/api/v3/mvccpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 )
 
 const (
 	ReadConfigTimeout = 3 * time.Second
+	// RewatchInterval is the interval to reload and rewatch configurations after the watch is broken
+	RewatchInterval = time.Second
 )
 
 type EtcdSource struct {
 	sync.RWMutex
 	etcdCli       *clientv3.Client
 	ctx           context.Context
+	cancel        context.CancelFunc
 	currentConfig map[string]string
 	keyPrefix     string
+	revision      int64
 
-	configRefresher *refresher
-	eh              EventHandler
+	watchOnce sync.Once
+	eh        EventHandler
 }
 
 func NewEtcdSource(etcdInfo *EtcdInfo) (*EtcdSource, error) {
@@ -55,13 +63,14 @@ func NewEtcdSource(etcdInfo *EtcdInfo) (*EtcdSource, error) {
 	if err != nil {
 		return nil, err
 	}
+	ctx, cancel := context.WithCancel(context.Background())
 	es := &EtcdSource{
 		etcdCli:       etcdCli,
-		ctx:           context.Background(),
+		ctx:           ctx,
+		cancel:        cancel,
 		currentConfig: make(map[string]string),
 		keyPrefix:     etcdInfo.KeyPrefix,
 	}
-	es.configRefresher = newRefresher(etcdInfo.RefreshInterval, es.refreshConfigurations)
 	return es, nil
 }
 
@@ -83,7 +92,9 @@ func (es *EtcdSource) GetConfigurations() (map[string]string, error) {
 	if err != nil {
 		return nil, err
 	}
-	es.configRefresher.start(es.GetSourceName())
+	es.watchOnce.Do(func() {
+		go es.watchConfigurations()
+	})
 	es.RLock()
 	for key, value := range es.currentConfig {
 		configMap[key] = value
@@ -104,16 +115,90 @@ func (es *EtcdSource) GetSourceName() string {
 }
 
 func (es *EtcdSource) Close() {
+	es.cancel()
 	es.etcdCli.Close()
-	es.configRefresher.stop()
 }
 
 func (es *EtcdSource) SetEventHandler(eh EventHandler) {
-	es.configRefresher.eh = eh
+	es.Lock()
+	defer es.Unlock()
+	es.eh = eh
+}
+
+func (es *EtcdSource) configPrefix() string {
+	return es.keyPrefix + "/config"
+}
+
+// configKey returns the etcd key of the configuration, "A.B.C" is stored as "A/B/C".
+func (es *EtcdSource) configKey(key string) string {
+	return es.configPrefix() + "/" + strings.ReplaceAll(key, ".", "/")
+}
+
+func (es *EtcdSource) auditPrefix() string {
+	return es.keyPrefix + "/config_audit"
+}
+
+// UpdateConfig persists the configuration together with its audit record,
+// the configuration is applied by all the nodes watching the source.
+func (es *EtcdSource) UpdateConfig(ctx context.Context, key, value string, audit *Audit) error {
+	auditKey, auditValue, err := es.marshalAudit(audit)
+	if err != nil {
+		return err
+	}
+	_, err = es.etcdCli.Txn(ctx).Then(
+		clientv3.OpPut(es.configKey(key), value),
+		clientv3.OpPut(auditKey, auditValue),
+	).Commit()
+	return err
+}
+
+// RemoveConfig removes the configuration together with its audit record,
+// the nodes watching the source fall back to the configuration from other sources.
+func (es *EtcdSource) RemoveConfig(ctx context.Context, key string, audit *Audit) error {
+	auditKey, auditValue, err := es.marshalAudit(audit)
+	if err != nil {
+		return err
+	}
+	_, err = es.etcdCli.Txn(ctx).Then(
+		clientv3.OpDelete(es.configKey(key)),
+		clientv3.OpDelete(es.configPrefix()+"/"+key),
+		clientv3.OpPut(auditKey, auditValue),
+	).Commit()
+	return err
+}
+
+// ListAudits returns the latest audit records, newest first, limit <= 0 means no limit.
+func (es *EtcdSource) ListAudits(ctx context.Context, limit int) ([]*Audit, error) {
+	opts := []clientv3.OpOption{clientv3.WithPrefix(), clientv3.WithSort(clientv3.SortByKey, clientv3.SortDescend)}
+	if limit > 0 {
+		opts = append(opts, clientv3.WithLimit(int64(limit)))
+	}
+	resp, err := es.etcdCli.Get(ctx, es.auditPrefix()+"/", opts...)
+	if err != nil {
+		return nil, err
+	}
+	audits := make([]*Audit, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		audit := &Audit{}
+		if err := json.Unmarshal(kv.Value, audit); err != nil {
+			log.Warn("skip invalid config audit", zap.String("key", string(kv.Key)), zap.Error(err))
+			continue
+		}
+		audits = append(audits, audit)
+	}
+	return audits, nil
+}
+
+func (es *EtcdSource) marshalAudit(audit *Audit) (string, string, error) {
+	bs, err := json.Marshal(audit)
+	if err != nil {
+		return "", "", err
+	}
+	return fmt.Sprintf("%s/%020d", es.auditPrefix(), audit.Time.UnixNano()), string(bs), nil
 }
 
 func (es *EtcdSource) refreshConfigurations() error {
-	prefix := es.keyPrefix + "/config"
+	prefix := es.configPrefix()
 	ctx, cancel := context.WithTimeout(es.ctx, ReadConfigTimeout)
 	defer cancel()
 	response, err := es.etcdCli.Get(ctx, prefix, clientv3.WithPrefix())
@@ -129,10 +214,79 @@ func (es *EtcdSource) refreshConfigurations() error {
 	}
 	es.Lock()
 	defer es.Unlock()
-	err = es.configRefresher.fireEvents(es.GetSourceName(), es.currentConfig, newConfig)
+	err = fireEvents(es.eh, es.GetSourceName(), es.currentConfig, newConfig)
+	if err != nil {
+		return err
+	}
+	es.currentConfig = newConfig
+	es.revision = response.Header.GetRevision()
+	return nil
+}
+
+// watchConfigurations keeps the configurations up to date with etcd watch.
+// If the watch is broken, e.g. the revision has been compacted, the configurations are reloaded and watched again.
+func (es *EtcdSource) watchConfigurations() {
+	log.Info("start watching configurations", zap.String("source", es.GetSourceName()))
+	for {
+		es.watch()
+
+		select {
+		case <-es.ctx.Done():
+			log.Info("stop watching configurations")
+			return
+		case <-time.After(RewatchInterval):
+		}
+		if err := es.refreshConfigurations(); err != nil {
+			log.Warn("failed to reload configurations", zap.Error(err))
+		}
+	}
+}
+
+// watch applies the changes since the loaded revision until the watch is broken or the source is closed.
+func (es *EtcdSource) watch() {
+	ctx, cancel := context.WithCancel(es.ctx)
+	defer cancel()
+
+	es.RLock()
+	revision := es.revision
+	es.RUnlock()
+	watchChan := es.etcdCli.Watch(ctx, es.configPrefix(), clientv3.WithPrefix(), clientv3.WithRev(revision+1))
+	for resp := range watchChan {
+		if err := resp.Err(); err != nil {
+			log.Warn("watch configurations failed", zap.Int64("revision", revision), zap.Error(err))
+			return
+		}
+		if err := es.applyEvents(resp.Events, resp.Header.GetRevision()); err != nil {
+			log.Warn("failed to apply configuration changes", zap.Error(err))
+			return
+		}
+	}
+}
+
+func (es *EtcdSource) applyEvents(events []*clientv3.Event, revision int64) error {
+	prefix := es.configPrefix()
+	es.Lock()
+	defer es.Unlock()
+	newConfig := make(map[string]string, len(es.currentConfig))
+	for key, value := range es.currentConfig {
+		newConfig[key] = value
+	}
+	for _, event := range events {
+		key := strings.TrimPrefix(string(event.Kv.Key), prefix+"/")
+		switch event.Type {
+		case mvccpb.PUT:
+			newConfig[key] = string(event.Kv.Value)
+			newConfig[formatKey(key)] = string(event.Kv.Value)
+		case mvccpb.DELETE:
+			delete(newConfig, key)
+			delete(newConfig, formatKey(key))
+		}
+	}
+	err := fireEvents(es.eh, es.GetSourceName(), es.currentConfig, newConfig)
 	if err != nil {
 		return err
 	}
 	es.currentConfig = newConfig
+	es.revision = revision
 	return nil
 }
@@ -86,8 +86,12 @@ type Manager struct {
 	keySourceMap  map[string]string // store the key to config source, example: key is A.B.C and source is file which means the A.B.C's value is from file
 	overlays      map[string]string // store the highest priority configs which modified at runtime
 	forbiddenKeys typeutil.Set[string]
+	validators    map[string]Validator // validate the values of the key before they take effect
 }
 
+// Validator checks whether the value is valid for a key
+type Validator func(value string) error
+
 func NewManager() *Manager {
 	return &Manager{
 		Dispatcher:    NewEventDispatcher(),
@@ -95,6 +99,7 @@ func NewManager() *Manager {
 		keySourceMap:  make(map[string]string),
 		overlays:      make(map[string]string),
 		forbiddenKeys: typeutil.NewSet[string](),
+		validators:    make(map[string]Validator),
 	}
 }
 
@@ -113,7 +118,16 @@ func (m *Manager) GetConfig(key string) (string, error) {
 	if !ok {
 		return "", fmt.Errorf("key not found: %s", key)
 	}
-	return m.getConfigValueBySource(realKey, sourceName)
+	v, err := m.getConfigValueBySource(realKey, sourceName)
+	if err != nil {
+		return "", err
+	}
+	// the value from sources may be changed to anything, ignore it if invalid
+	if err := m.validate(realKey, v); err != nil {
+		log.RatedWarn(60, "ignore invalid config", zap.String("key", key), zap.String("source", sourceName), zap.Error(err))
+		return "", err
+	}
+	return v, nil
 }
 
 // GetConfigs returns all the key values
@@ -192,10 +206,14 @@ func (m *Manager) AddSource(source Source) error {
 
 // Update config at runtime, which can be called by others
 // The most used scenario is UT
-func (m *Manager) SetConfig(key, value string) {
+func (m *Manager) SetConfig(key, value string) error {
 	m.Lock()
 	defer m.Unlock()
+	if err := m.validate(formatKey(key), value); err != nil {
+		return err
+	}
 	m.overlays[formatKey(key)] = value
+	return nil
 }
 
 // Delete config at runtime, which has the highest priority to override all other sources
@@ -212,6 +230,35 @@ func (m *Manager) ResetConfig(key string) {
 	delete(m.overlays, formatKey(key))
 }
 
+// RegisterValidator registers the validator of the key,
+// invalid values are rejected by SetConfig and ignored if they come from sources.
+func (m *Manager) RegisterValidator(key string, validator Validator) {
+	m.Lock()
+	defer m.Unlock()
+	if m.validators == nil {
+		m.validators = make(map[string]Validator)
+	}
+	m.validators[formatKey(key)] = validator
+}
+
+// Validate checks whether the value is valid for the key
+func (m *Manager) Validate(key, value string) error {
+	m.RLock()
+	defer m.RUnlock()
+	return m.validate(formatKey(key), value)
+}
+
+func (m *Manager) validate(realKey, value string) error {
+	validator, ok := m.validators[realKey]
+	if !ok {
+		return nil
+	}
+	if err := validator(value); err != nil {
+		return errors.Wrapf(ErrInvalidValue, "%s: %s", realKey, err.Error())
+	}
+	return nil
+}
+
 // Ignore any of update events, which means the config cannot auto refresh anymore
 func (m *Manager) ForbidUpdate(key string) {
 	m.Lock()
@@ -321,6 +368,12 @@ func (m *Manager) OnEvent(event *Event) {
 		log.Info("ignore event for forbidden key", zap.String("key", event.Key))
 		return
 	}
+	if event.EventType != DeleteType {
+		if err := m.validate(formatKey(event.Key), event.Value); err != nil {
+			log.Warn("ignore event with invalid value", zap.Error(err), zap.Any("event", event))
+			return
+		}
+	}
 	err := m.updateEvent(event)
 	if err != nil {
 		log.Warn("failed in updating event with error", zap.Error(err), zap.Any("event", event))
@@ -17,6 +17,7 @@
 package config
 
 import (
+	"strconv"
 	"testing"
 
 	"github.com/cockroachdb/errors"
@@ -47,6 +48,37 @@ func TestAllDupliateSource(t *testing.T) {
 	assert.Error(t, err, "invalid source or source not added")
 }
 
+func TestManagerValidator(t *testing.T) {
+	t.Setenv("TEST_VALIDATE", "abc")
+	mgr, _ := Init(WithEnvSource(formatKey))
+	mgr.RegisterValidator("test.validate", func(value string) error {
+		_, err := strconv.Atoi(value)
+		return err
+	})
+
+	// invalid value from sources is ignored
+	_, err := mgr.GetConfig("test.validate")
+	assert.ErrorIs(t, err, ErrInvalidValue)
+
+	err = mgr.SetConfig("test.validate", "xyz")
+	assert.ErrorIs(t, err, ErrInvalidValue)
+	assert.Error(t, mgr.Validate("test.validate", "xyz"))
+
+	err = mgr.SetConfig("test.validate", "10")
+	assert.NoError(t, err)
+	v, err := mgr.GetConfig("test.validate")
+	assert.NoError(t, err)
+	assert.Equal(t, "10", v)
+
+	// invalid event is not applied
+	mgr.RegisterValidator("test.event", func(value string) error {
+		return errors.New("invalid")
+	})
+	mgr.OnEvent(newEvent("EnvSource", CreateType, "testevent", "b"))
+	_, ok := mgr.keySourceMap["testevent"]
+	assert.False(t, ok)
+}
+
 type ErrSource struct {
 }
 
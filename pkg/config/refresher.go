@@ -74,15 +74,19 @@ func (r *refresher) refreshPeriodically(name string) {
 }
 
 func (r *refresher) fireEvents(name string, source, target map[string]string) error {
+	return fireEvents(r.eh, name, source, target)
+}
+
+func fireEvents(eh EventHandler, name string, source, target map[string]string) error {
 	events, err := PopulateEvents(name, source, target)
 	if err != nil {
 		log.Warn("generating event error", zap.Error(err))
 		return err
 	}
 	//Generate OnEvent Callback based on the events created
-	if r.eh != nil {
+	if eh != nil {
 		for _, e := range events {
-			r.eh.OnEvent(e)
+			eh.OnEvent(e)
 		}
 	}
 	return nil
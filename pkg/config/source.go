@@ -43,7 +43,7 @@ type EtcdInfo struct {
 	CaCertFile string
 	MinVersion string
 
-	//Pull Configuration interval, unit is second
+	// Deprecated: configurations are watched from etcd, there is no need to pull them
 	RefreshInterval time.Duration
 }
 
@@ -199,6 +199,37 @@ var (
 			nodeIDLabelName,
 			channelNameLabelName,
 		})
+
+	DataNodeWALSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "wal_size",
+			Help:      "size in bytes of the write-ahead log files of the channel",
+		}, []string{
+			nodeIDLabelName,
+			channelNameLabelName,
+		})
+
+	DataNodeWALAppendLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "wal_append_latency",
+			Help:      "latency of appending a message pack to the write-ahead log",
+			Buckets:   buckets, // unit: ms
+		}, []string{nodeIDLabelName})
+
+	DataNodeWALReplayMsgCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "wal_replay_msg_count",
+			Help:      "count of messages replayed from the write-ahead log on recovery",
+		}, []string{
+			nodeIDLabelName,
+			channelNameLabelName,
+		})
 )
 
 // RegisterDataNode registers DataNode metrics
@@ -219,6 +250,9 @@ func RegisterDataNode(registry *prometheus.Registry) {
 	registry.MustRegister(DataNodeConsumeBytesCount)
 	registry.MustRegister(DataNodeForwardDeleteMsgTimeTaken)
 	registry.MustRegister(DataNodeMsgDispatcherTtLag)
+	registry.MustRegister(DataNodeWALSize)
+	registry.MustRegister(DataNodeWALAppendLatency)
+	registry.MustRegister(DataNodeWALReplayMsgCount)
 }
 
 func CleanupDataNodeCollectionMetrics(nodeID int64, collectionID int64, channel string) {
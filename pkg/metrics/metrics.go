@@ -80,6 +80,7 @@ const (
 	indexCountLabelName      = "indexed_field_count"
 	requestScope             = "scope"
 	fullMethodLabelName      = "full_method"
+	pkIndexTypeLabelName     = "pk_index_type"
 )
 
 var (
@@ -188,6 +188,28 @@ var (
 			nodeIDLabelName,
 		})
 
+	QueryNodeLazyLoadFieldLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "lazy_load_field_latency",
+			Help:      "latency of loading a lazy field of sealed segment on demand",
+			Buckets:   buckets,
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	QueryNodeLazyLoadFieldCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "lazy_load_field_count",
+			Help:      "count of lazy fields of sealed segments loaded on demand",
+		}, []string{
+			nodeIDLabelName,
+			statusLabelName,
+		})
+
 	QueryNodeReadTaskUnsolveLen = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: milvusNamespace,
@@ -335,6 +357,30 @@ var (
 			Help:      "",
 		}, []string{nodeIDLabelName, msgTypeLabelName})
 
+	// QueryNodePkIndexMemorySize records the memory size of the pk indexes of the sealed segments on the shard leaders.
+	QueryNodePkIndexMemorySize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "pk_index_memory_bytes",
+			Help:      "memory size of the pk indexes of the sealed segments, clustered by pk index type",
+		}, []string{
+			nodeIDLabelName,
+			pkIndexTypeLabelName,
+		})
+
+	// QueryNodePkIndexFalsePositiveRate records the mean estimated false positive rate of the pk indexes of the sealed segments.
+	QueryNodePkIndexFalsePositiveRate = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "pk_index_false_positive_rate",
+			Help:      "mean estimated false positive rate of the pk indexes of the sealed segments, clustered by pk index type",
+		}, []string{
+			nodeIDLabelName,
+			pkIndexTypeLabelName,
+		})
+
 	QueryNodeMsgDispatcherTtLag = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: milvusNamespace,
@@ -361,6 +407,8 @@ func RegisterQueryNode(registry *prometheus.Registry) {
 	registry.MustRegister(QueryNodeSQSegmentLatencyInCore)
 	registry.MustRegister(QueryNodeReduceLatency)
 	registry.MustRegister(QueryNodeLoadSegmentLatency)
+	registry.MustRegister(QueryNodeLazyLoadFieldLatency)
+	registry.MustRegister(QueryNodeLazyLoadFieldCount)
 	registry.MustRegister(QueryNodeReadTaskUnsolveLen)
 	registry.MustRegister(QueryNodeReadTaskReadyLen)
 	registry.MustRegister(QueryNodeReadTaskConcurrency)
@@ -378,6 +426,8 @@ func RegisterQueryNode(registry *prometheus.Registry) {
 	registry.MustRegister(QueryNodeConsumerMsgCount)
 	registry.MustRegister(QueryNodeConsumeTimeTickLag)
 	registry.MustRegister(QueryNodeMsgDispatcherTtLag)
+	registry.MustRegister(QueryNodePkIndexMemorySize)
+	registry.MustRegister(QueryNodePkIndexFalsePositiveRate)
 }
 
 func CleanupQueryNodeCollectionMetrics(nodeID int64, collectionID int64) {
@@ -24,8 +24,9 @@ import (
 
 // Meta Prefix consts
 const (
-	MetaStoreTypeEtcd  = "etcd"
-	MetaStoreTypeMysql = "mysql"
+	MetaStoreTypeEtcd    = "etcd"
+	MetaStoreTypeMysql   = "mysql"
+	MetaStoreTypeRocksdb = "rocksdb"
 
 	SegmentMetaPrefix    = "queryCoord-segmentMeta"
 	ChangeInfoMetaPrefix = "queryCoord-sealedSegmentChangeInfo"
@@ -26,4 +26,10 @@ const (
 	IndexFaissBinIvfFlat IndexType = "BIN_IVF_FLAT"
 	IndexHNSW            IndexType = "HNSW"
 	IndexDISKANN         IndexType = "DISKANN"
+
+	// scalar index types
+	IndexSTLSORT  IndexType = "STL_SORT"
+	IndexTRIE     IndexType = "Trie"
+	IndexINVERTED IndexType = "INVERTED"
+	IndexBITMAP   IndexType = "BITMAP"
 )
@@ -1,8 +1,53 @@
 package indexparamcheck
 
-import "github.com/milvus-io/milvus-proto/go-api/schemapb"
+import (
+	"fmt"
 
-// TODO: check index parameters according to the index type & data type.
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// BitmapCardinalityLimit is the param of the BITMAP index, which falls back to posting lists
+	// if there are more distinct values than the limit, 100 by default.
+	BitmapCardinalityLimit = "bitmap_cardinality_limit"
+
+	MinBitmapCardinalityLimit = 1
+	MaxBitmapCardinalityLimit = 1000
+)
+
+// CheckIndexValid checks whether the scalar index type can be built on the data type.
+//
+//	STL_SORT: sorted values, for range predicates on bool and numeric fields.
+//	Trie:     marisa trie, for VarChar fields.
+//	INVERTED: posting list per distinct value, for `==`, `in` and range predicates on integer and VarChar fields.
+//	BITMAP:   bitmap per distinct value, for low-cardinality bool, integer and VarChar fields,
+//	          posting lists are used instead if there are more distinct values than bitmap_cardinality_limit.
 func CheckIndexValid(dType schemapb.DataType, indexType IndexType, indexParams map[string]string) error {
+	switch indexType {
+	case IndexSTLSORT:
+		if !typeutil.IsArithmetic(dType) && !typeutil.IsBoolType(dType) {
+			return fmt.Errorf("%s index is not supported on %s field", indexType, dType.String())
+		}
+	case IndexTRIE:
+		if !typeutil.IsStringType(dType) {
+			return fmt.Errorf("%s index is not supported on %s field", indexType, dType.String())
+		}
+	case IndexINVERTED:
+		if !typeutil.IsIntegerType(dType) && !typeutil.IsStringType(dType) {
+			return fmt.Errorf("%s index is not supported on %s field", indexType, dType.String())
+		}
+	case IndexBITMAP:
+		if !typeutil.IsBoolType(dType) && !typeutil.IsIntegerType(dType) && !typeutil.IsStringType(dType) {
+			return fmt.Errorf("%s index is not supported on %s field", indexType, dType.String())
+		}
+		if _, ok := indexParams[BitmapCardinalityLimit]; ok &&
+			!CheckIntByRange(indexParams, BitmapCardinalityLimit, MinBitmapCardinalityLimit, MaxBitmapCardinalityLimit) {
+			return fmt.Errorf("%s should be an integer in [%d, %d]",
+				BitmapCardinalityLimit, MinBitmapCardinalityLimit, MaxBitmapCardinalityLimit)
+		}
+	default:
+		return fmt.Errorf("invalid scalar index type: %s", indexType)
+	}
 	return nil
 }
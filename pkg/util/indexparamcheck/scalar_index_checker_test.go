@@ -8,5 +8,24 @@ import (
 )
 
 func TestCheckIndexValid(t *testing.T) {
-	assert.NoError(t, CheckIndexValid(schemapb.DataType_Int64, "inverted_index", nil))
+	assert.NoError(t, CheckIndexValid(schemapb.DataType_Int64, IndexSTLSORT, nil))
+	assert.NoError(t, CheckIndexValid(schemapb.DataType_Bool, IndexSTLSORT, nil))
+	assert.Error(t, CheckIndexValid(schemapb.DataType_VarChar, IndexSTLSORT, nil))
+
+	assert.NoError(t, CheckIndexValid(schemapb.DataType_VarChar, IndexTRIE, nil))
+	assert.Error(t, CheckIndexValid(schemapb.DataType_Int64, IndexTRIE, nil))
+
+	assert.NoError(t, CheckIndexValid(schemapb.DataType_Int64, IndexINVERTED, nil))
+	assert.NoError(t, CheckIndexValid(schemapb.DataType_VarChar, IndexINVERTED, nil))
+	assert.Error(t, CheckIndexValid(schemapb.DataType_Double, IndexINVERTED, nil))
+
+	assert.NoError(t, CheckIndexValid(schemapb.DataType_Bool, IndexBITMAP, nil))
+	assert.NoError(t, CheckIndexValid(schemapb.DataType_Int8, IndexBITMAP, nil))
+	assert.NoError(t, CheckIndexValid(schemapb.DataType_VarChar, IndexBITMAP, nil))
+	assert.Error(t, CheckIndexValid(schemapb.DataType_Float, IndexBITMAP, nil))
+	assert.NoError(t, CheckIndexValid(schemapb.DataType_Int64, IndexBITMAP, map[string]string{BitmapCardinalityLimit: "500"}))
+	assert.Error(t, CheckIndexValid(schemapb.DataType_Int64, IndexBITMAP, map[string]string{BitmapCardinalityLimit: "0"}))
+	assert.Error(t, CheckIndexValid(schemapb.DataType_Int64, IndexBITMAP, map[string]string{BitmapCardinalityLimit: "x"}))
+
+	assert.Error(t, CheckIndexValid(schemapb.DataType_Int64, "inverted_index", nil))
 }
@@ -58,6 +58,13 @@ type FlowGraphMetric struct {
 	NumFlowGraph        int
 }
 
+// ChannelLoadMetric contains the load of a vchannel consumed by DataNode.
+type ChannelLoadMetric struct {
+	Channel          string
+	InsertThroughput float64 // bytes of insert messages consumed per second
+	BufferSize       int64   // bytes buffered in memory not synced yet
+}
+
 // ReadInfoInQueue contains NQ num or task num in QueryNode's task queue.
 type ReadInfoInQueue struct {
 	UnsolvedQueue    int64
@@ -85,6 +92,7 @@ type DataNodeQuotaMetrics struct {
 	Hms HardwareMetrics
 	Rms []RateMetric
 	Fgm FlowGraphMetric
+	Cms []ChannelLoadMetric
 }
 
 // ProxyQuotaMetrics are metrics of Proxy.
@@ -36,6 +36,7 @@ func (p *autoIndexConfig) init(base *BaseTable) {
 		Key:          "autoIndex.enable",
 		Version:      "2.2.0",
 		DefaultValue: "false",
+		Type:         ParamTypeBool,
 		PanicIfEmpty: true,
 	}
 	p.Enable.Init(base.mgr)
@@ -43,12 +44,14 @@ func (p *autoIndexConfig) init(base *BaseTable) {
 	p.IndexParams = ParamItem{
 		Key:     "autoIndex.params.build",
 		Version: "2.2.0",
+		Type:    ParamTypeString,
 	}
 	p.IndexParams.Init(base.mgr)
 
 	p.ExtraParams = ParamItem{
 		Key:     "autoIndex.params.extra",
 		Version: "2.2.0",
+		Type:    ParamTypeString,
 	}
 	p.ExtraParams.Init(base.mgr)
 
@@ -67,6 +70,7 @@ func (p *autoIndexConfig) init(base *BaseTable) {
 	p.AutoIndexTypeName = ParamItem{
 		Key:     "autoIndex.type",
 		Version: "2.2.0",
+		Type:    ParamTypeString,
 	}
 	p.AutoIndexTypeName.Init(base.mgr)
 }
@@ -60,6 +60,8 @@ var defaultYaml = []string{"milvus.yaml", "default.yaml", "user.yaml"}
 type BaseTable struct {
 	once sync.Once
 	mgr  *config.Manager
+	// etcdSource persists the dynamic configs, nil if etcd is not available
+	etcdSource *config.EtcdSource
 
 	configDir string
 	YamlFiles []string
@@ -151,8 +153,12 @@ func (gp *BaseTable) initConfigsFromRemote(refreshInterval int) {
 		log.Info("init with etcd failed", zap.Error(err))
 		return
 	}
-	gp.mgr.AddSource(s)
+	if err := gp.mgr.AddSource(s); err != nil {
+		log.Info("add etcd source failed", zap.Error(err))
+		return
+	}
 	s.SetEventHandler(gp.mgr)
+	gp.etcdSource = s
 }
 
 // GetConfigDir returns the config directory
@@ -211,8 +217,7 @@ func (gp *BaseTable) Remove(key string) error {
 
 // Update Config
 func (gp *BaseTable) Save(key, value string) error {
-	gp.mgr.SetConfig(key, value)
-	return nil
+	return gp.mgr.SetConfig(key, value)
 }
 
 // Reset Config to default value
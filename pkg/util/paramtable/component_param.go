@@ -206,6 +206,7 @@ type commonConfig struct {
 
 	AuthorizationEnabled ParamItem `refreshable:"false"`
 	SuperUsers           ParamItem `refreshable:"true"`
+	ConfigAPIEnabled     ParamItem `refreshable:"false"`
 
 	ClusterName ParamItem `refreshable:"false"`
 
@@ -236,6 +237,7 @@ func (p *commonConfig) init(base *BaseTable) {
 	p.ProxySubName = ParamItem{
 		Key:          "msgChannel.subNamePrefix.proxySubNamePrefix",
 		Version:      "2.1.0",
+		Type:         ParamTypeString,
 		FallbackKeys: []string{"common.subNamePrefix.proxySubNamePrefix"},
 		PanicIfEmpty: true,
 		Formatter:    chanNamePrefix,
@@ -247,6 +249,7 @@ func (p *commonConfig) init(base *BaseTable) {
 	p.RootCoordTimeTick = ParamItem{
 		Key:          "msgChannel.chanNamePrefix.rootCoordTimeTick",
 		Version:      "2.1.0",
+		Type:         ParamTypeString,
 		FallbackKeys: []string{"common.chanNamePrefix.rootCoordTimeTick"},
 		PanicIfEmpty: true,
 		Formatter:    chanNamePrefix,
@@ -257,6 +260,7 @@ func (p *commonConfig) init(base *BaseTable) {
 	p.RootCoordStatistics = ParamItem{
 		Key:          "msgChannel.chanNamePrefix.rootCoordStatistics",
 		Version:      "2.1.0",
+		Type:         ParamTypeString,
 		FallbackKeys: []string{"common.chanNamePrefix.rootCoordStatistics"},
 		PanicIfEmpty: true,
 		Formatter:    chanNamePrefix,
@@ -287,6 +291,7 @@ func (p *commonConfig) init(base *BaseTable) {
 	p.RootCoordSubName = ParamItem{
 		Key:          "msgChannel.subNamePrefix.rootCoordSubNamePrefix",
 		Version:      "2.1.0",
+		Type:         ParamTypeString,
 		FallbackKeys: []string{"common.subNamePrefix.rootCoordSubNamePrefix"},
 		PanicIfEmpty: true,
 		Formatter:    chanNamePrefix,
@@ -297,6 +302,7 @@ func (p *commonConfig) init(base *BaseTable) {
 	p.QueryCoordSearch = ParamItem{
 		Key:          "msgChannel.chanNamePrefix.search",
 		Version:      "2.1.0",
+		Type:         ParamTypeString,
 		FallbackKeys: []string{"common.chanNamePrefix.search"},
 		PanicIfEmpty: true,
 		Formatter:    chanNamePrefix,
@@ -307,6 +313,7 @@ func (p *commonConfig) init(base *BaseTable) {
 	p.QueryCoordSearchResult = ParamItem{
 		Key:          "msgChannel.chanNamePrefix.searchResult",
 		Version:      "2.1.0",
+		Type:         ParamTypeString,
 		FallbackKeys: []string{"common.chanNamePrefix.searchResult"},
 		PanicIfEmpty: true,
 		Formatter:    chanNamePrefix,
@@ -317,6 +324,7 @@ func (p *commonConfig) init(base *BaseTable) {
 	p.QueryCoordTimeTick = ParamItem{
 		Key:          "msgChannel.chanNamePrefix.queryTimeTick",
 		Version:      "2.1.0",
+		Type:         ParamTypeString,
 		FallbackKeys: []string{"common.chanNamePrefix.queryTimeTick"},
 		PanicIfEmpty: true,
 		Formatter:    chanNamePrefix,
@@ -337,6 +345,7 @@ func (p *commonConfig) init(base *BaseTable) {
 	p.DataCoordStatistic = ParamItem{
 		Key:          "msgChannel.chanNamePrefix.dataCoordStatistic",
 		Version:      "2.1.0",
+		Type:         ParamTypeString,
 		FallbackKeys: []string{"common.chanNamePrefix.dataCoordStatistic"},
 		PanicIfEmpty: true,
 		Formatter:    chanNamePrefix,
@@ -357,6 +366,7 @@ func (p *commonConfig) init(base *BaseTable) {
 	p.DataCoordSegmentInfo = ParamItem{
 		Key:          "msgChannel.chanNamePrefix.dataCoordSegmentInfo",
 		Version:      "2.1.0",
+		Type:         ParamTypeString,
 		FallbackKeys: []string{"common.chanNamePrefix.dataCoordSegmentInfo"},
 		PanicIfEmpty: true,
 		Formatter:    chanNamePrefix,
@@ -424,6 +434,8 @@ func (p *commonConfig) init(base *BaseTable) {
 		Key:          "common.retentionDuration",
 		Version:      "2.0.0",
 		DefaultValue: strconv.Itoa(DefaultRetentionDuration),
+		Type:         ParamTypeInt,
+		Min:          "0",
 		Doc:          "time travel reserved time, insert/delete will not be cleaned in this period. disable it by default",
 		Export:       true,
 	}
@@ -433,6 +445,8 @@ func (p *commonConfig) init(base *BaseTable) {
 		Key:          "common.entityExpiration",
 		Version:      "2.1.0",
 		DefaultValue: "-1",
+		Type:         ParamTypeInt,
+		Min:          "-1",
 		Formatter: func(value string) string {
 			ttl := getAsInt(value)
 			if ttl < 0 {
@@ -475,6 +489,8 @@ This configuration is only used by querynode and indexnode, it selects CPU instr
 		Key:          "common.DiskIndex.MaxDegree",
 		Version:      "2.0.0",
 		DefaultValue: strconv.Itoa(DefaultMaxDegree),
+		Type:         ParamTypeInt,
+		Min:          "1",
 		Export:       true,
 	}
 	p.MaxDegree.Init(base.mgr)
@@ -483,6 +499,8 @@ This configuration is only used by querynode and indexnode, it selects CPU instr
 		Key:          "common.DiskIndex.SearchListSize",
 		Version:      "2.0.0",
 		DefaultValue: strconv.Itoa(DefaultSearchListSize),
+		Type:         ParamTypeInt,
+		Min:          "1",
 		Export:       true,
 	}
 	p.SearchListSize.Init(base.mgr)
@@ -491,6 +509,8 @@ This configuration is only used by querynode and indexnode, it selects CPU instr
 		Key:          "common.DiskIndex.PQCodeBudgetGBRatio",
 		Version:      "2.0.0",
 		DefaultValue: fmt.Sprintf("%f", DefaultPQCodeBudgetGBRatio),
+		Type:         ParamTypeFloat,
+		Min:          "0",
 		Export:       true,
 	}
 	p.PQCodeBudgetGBRatio.Init(base.mgr)
@@ -499,6 +519,8 @@ This configuration is only used by querynode and indexnode, it selects CPU instr
 		Key:          "common.DiskIndex.BuildNumThreadsRatio",
 		Version:      "2.0.0",
 		DefaultValue: strconv.Itoa(DefaultBuildNumThreadsRatio),
+		Type:         ParamTypeFloat,
+		Min:          "0",
 		Export:       true,
 	}
 	p.BuildNumThreadsRatio.Init(base.mgr)
@@ -507,6 +529,8 @@ This configuration is only used by querynode and indexnode, it selects CPU instr
 		Key:          "common.DiskIndex.SearchCacheBudgetGBRatio",
 		Version:      "2.0.0",
 		DefaultValue: fmt.Sprintf("%f", DefaultSearchCacheBudgetGBRatio),
+		Type:         ParamTypeFloat,
+		Min:          "0",
 		Export:       true,
 	}
 	p.SearchCacheBudgetGBRatio.Init(base.mgr)
@@ -515,6 +539,8 @@ This configuration is only used by querynode and indexnode, it selects CPU instr
 		Key:          "common.DiskIndex.LoadNumThreadRatio",
 		Version:      "2.0.0",
 		DefaultValue: strconv.Itoa(DefaultLoadNumThreadRatio),
+		Type:         ParamTypeFloat,
+		Min:          "0",
 		Export:       true,
 	}
 	p.LoadNumThreadRatio.Init(base.mgr)
@@ -523,6 +549,8 @@ This configuration is only used by querynode and indexnode, it selects CPU instr
 		Key:          "common.topKLimit",
 		Version:      "2.2.1",
 		DefaultValue: "16384",
+		Type:         ParamTypeInt,
+		Min:          "1",
 		Doc: `Search limit, which applies on:
 maximum # of results to return (topK), and
 maximum # of search requests (nq).
@@ -534,6 +562,8 @@ Check https://milvus.io/docs/limitations.md for more details.`,
 		Key:          "common.DiskIndex.BeamWidthRatio",
 		Version:      "2.0.0",
 		DefaultValue: strconv.Itoa(DefaultBeamWidthRatio),
+		Type:         ParamTypeFloat,
+		Min:          "0",
 		Doc:          "",
 		Export:       true,
 	}
@@ -543,6 +573,8 @@ Check https://milvus.io/docs/limitations.md for more details.`,
 		Key:          "common.gracefulTime",
 		Version:      "2.0.0",
 		DefaultValue: strconv.Itoa(DefaultGracefulTime),
+		Type:         ParamTypeInt,
+		Min:          "0",
 		Doc:          "milliseconds. it represents the interval (in ms) by which the request arrival time needs to be subtracted in the case of Bounded Consistency.",
 		Export:       true,
 	}
@@ -552,6 +584,8 @@ Check https://milvus.io/docs/limitations.md for more details.`,
 		Key:          "common.gracefulStopTimeout",
 		Version:      "2.2.1",
 		DefaultValue: "30",
+		Type:         ParamTypeInt,
+		Min:          "0",
 		Doc:          "seconds. it will force quit the server if the graceful stop process is not completed during this time.",
 		Export:       true,
 	}
@@ -586,12 +620,23 @@ Check https://milvus.io/docs/limitations.md for more details.`,
 	p.SuperUsers = ParamItem{
 		Key:     "common.security.superUsers",
 		Version: "2.2.1",
+		Type:    ParamTypeString,
 		Doc: `The superusers will ignore some system check processes,
 like the old password verification when updating the credential`,
 		Export: true,
 	}
 	p.SuperUsers.Init(base.mgr)
 
+	p.ConfigAPIEnabled = ParamItem{
+		Key:          "common.security.configAPIEnabled",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+		Doc: `Whether to serve the dynamic config admin API on the metrics port of coords and proxies,
+which only accepts the requests of root authenticated by basic auth`,
+		Export: true,
+	}
+	p.ConfigAPIEnabled.Init(base.mgr)
+
 	p.ClusterName = ParamItem{
 		Key:          "common.cluster.name",
 		Version:      "2.0.0",
@@ -621,18 +666,21 @@ like the old password verification when updating the credential`,
 		Key:          "common.preCreatedTopic.enabled",
 		Version:      "2.3.0",
 		DefaultValue: "false",
+		Type:         ParamTypeBool,
 	}
 	p.PreCreatedTopicEnabled.Init(base.mgr)
 
 	p.TopicNames = ParamItem{
 		Key:     "common.preCreatedTopic.names",
 		Version: "2.3.0",
+		Type:    ParamTypeString,
 	}
 	p.TopicNames.Init(base.mgr)
 
 	p.TimeTicker = ParamItem{
 		Key:     "common.preCreatedTopic.timeticker",
 		Version: "2.3.0",
+		Type:    ParamTypeString,
 	}
 	p.TimeTicker.Init(base.mgr)
 }
@@ -696,6 +744,7 @@ func (l *logConfig) init(base *BaseTable) {
 		Key:          "log.level",
 		DefaultValue: "info",
 		Version:      "2.0.0",
+		Enum:         []string{"debug", "info", "warn", "error", "panic", "fatal"},
 		Doc:          "Only supports debug, info, warn, error, panic, or fatal. Default 'info'.",
 		Export:       true,
 	}
@@ -739,6 +788,7 @@ func (l *logConfig) init(base *BaseTable) {
 		Key:          "log.format",
 		DefaultValue: "text",
 		Version:      "2.0.0",
+		Enum:         []string{"text", "json"},
 		Doc:          "text or json",
 		Export:       true,
 	}
@@ -790,6 +840,8 @@ func (p *rootCoordConfig) init(base *BaseTable) {
 		Key:          "rootCoord.maxPartitionNum",
 		Version:      "2.0.0",
 		DefaultValue: "4096",
+		Type:         ParamTypeInt,
+		Min:          "1",
 		Doc:          "Maximum number of partitions in a collection",
 		Export:       true,
 	}
@@ -799,6 +851,8 @@ func (p *rootCoordConfig) init(base *BaseTable) {
 		Key:          "rootCoord.minSegmentSizeToEnableIndex",
 		Version:      "2.0.0",
 		DefaultValue: "1024",
+		Type:         ParamTypeInt,
+		Min:          "0",
 		Doc:          "It's a threshold. When the segment size is less than this value, the segment will not be indexed",
 		Export:       true,
 	}
@@ -807,6 +861,8 @@ func (p *rootCoordConfig) init(base *BaseTable) {
 	p.ImportTaskExpiration = ParamItem{
 		Key:          "rootCoord.importTaskExpiration",
 		Version:      "2.2.0",
+		Type:         ParamTypeFloat,
+		Min:          "0",
 		DefaultValue: "900", // 15 * 60 seconds
 		Doc:          "(in seconds) Duration after which an import task will expire (be killed). Default 900 seconds (15 minutes).",
 		Export:       true,
@@ -817,6 +873,8 @@ func (p *rootCoordConfig) init(base *BaseTable) {
 		Key:          "rootCoord.importTaskRetention",
 		Version:      "2.2.0",
 		DefaultValue: strconv.Itoa(24 * 60 * 60),
+		Type:         ParamTypeFloat,
+		Min:          "0",
 		Doc:          "(in seconds) Milvus will keep the record of import tasks for at least `importTaskRetention` seconds. Default 86400, seconds (24 hours).",
 		Export:       true,
 	}
@@ -826,6 +884,7 @@ func (p *rootCoordConfig) init(base *BaseTable) {
 		Key:          "rootCoord.ImportTaskSubPath",
 		Version:      "2.2.0",
 		DefaultValue: "importtask",
+		Type:         ParamTypeString,
 	}
 	p.ImportTaskSubPath.Init(base.mgr)
 
@@ -833,6 +892,8 @@ func (p *rootCoordConfig) init(base *BaseTable) {
 		Key:          "rootCoord.importMaxPendingTaskCount",
 		Version:      "2.2.2",
 		DefaultValue: strconv.Itoa(65535),
+		Type:         ParamTypeInt,
+		Min:          "0",
 	}
 	p.ImportMaxPendingTaskCount.Init(base.mgr)
 
@@ -849,15 +910,18 @@ func (p *rootCoordConfig) init(base *BaseTable) {
 // /////////////////////////////////////////////////////////////////////////////
 // --- proxy ---
 type AccessLogConfig struct {
-	Enable        ParamItem `refreshable:"false"`
-	MinioEnable   ParamItem `refreshable:"false"`
-	LocalPath     ParamItem `refreshable:"false"`
-	Filename      ParamItem `refreshable:"false"`
-	MaxSize       ParamItem `refreshable:"false"`
-	RotatedTime   ParamItem `refreshable:"false"`
-	MaxBackups    ParamItem `refreshable:"false"`
-	RemotePath    ParamItem `refreshable:"false"`
-	RemoteMaxTime ParamItem `refreshable:"false"`
+	Enable        ParamItem  `refreshable:"false"`
+	MinioEnable   ParamItem  `refreshable:"false"`
+	LocalPath     ParamItem  `refreshable:"false"`
+	Filename      ParamItem  `refreshable:"false"`
+	MaxSize       ParamItem  `refreshable:"false"`
+	RotatedTime   ParamItem  `refreshable:"false"`
+	MaxBackups    ParamItem  `refreshable:"false"`
+	RemotePath    ParamItem  `refreshable:"false"`
+	RemoteMaxTime ParamItem  `refreshable:"false"`
+	Formatter     ParamItem  `refreshable:"false"`
+	OutputFormat  ParamItem  `refreshable:"false"`
+	SampleRates   ParamGroup `refreshable:"false"`
 }
 
 type proxyConfig struct {
@@ -878,7 +942,10 @@ type proxyConfig struct {
 	MaxRoleNum               ParamItem `refreshable:"true"`
 	MaxTaskNum               ParamItem `refreshable:"false"`
 	AccessLog                AccessLogConfig
+	AuditLog                 AccessLogConfig
 	ShardLeaderCacheInterval ParamItem `refreshable:"false"`
+	SlowQuerySpanInSeconds   ParamItem `refreshable:"true"`
+	IteratorTokenSecret      ParamItem `refreshable:"false"`
 }
 
 func (p *proxyConfig) init(base *BaseTable) {
@@ -896,6 +963,8 @@ func (p *proxyConfig) init(base *BaseTable) {
 		Key:          "proxy.msgStream.timeTick.bufSize",
 		Version:      "2.2.0",
 		DefaultValue: "512",
+		Type:         ParamTypeInt,
+		Min:          "1",
 		PanicIfEmpty: true,
 		Export:       true,
 	}
@@ -904,6 +973,8 @@ func (p *proxyConfig) init(base *BaseTable) {
 	p.MaxNameLength = ParamItem{
 		Key:          "proxy.maxNameLength",
 		DefaultValue: "255",
+		Type:         ParamTypeInt,
+		Min:          "1",
 		Version:      "2.0.0",
 		PanicIfEmpty: true,
 		Doc:          "Maximum length of name for a collection or alias",
@@ -914,6 +985,8 @@ func (p *proxyConfig) init(base *BaseTable) {
 	p.MinPasswordLength = ParamItem{
 		Key:          "proxy.minPasswordLength",
 		DefaultValue: "6",
+		Type:         ParamTypeInt,
+		Min:          "1",
 		Version:      "2.0.0",
 		PanicIfEmpty: true,
 	}
@@ -922,6 +995,8 @@ func (p *proxyConfig) init(base *BaseTable) {
 	p.MaxUsernameLength = ParamItem{
 		Key:          "proxy.maxUsernameLength",
 		DefaultValue: "32",
+		Type:         ParamTypeInt,
+		Min:          "1",
 		Version:      "2.0.0",
 		PanicIfEmpty: true,
 	}
@@ -930,6 +1005,8 @@ func (p *proxyConfig) init(base *BaseTable) {
 	p.MaxPasswordLength = ParamItem{
 		Key:          "proxy.maxPasswordLength",
 		DefaultValue: "256",
+		Type:         ParamTypeInt,
+		Min:          "1",
 		Version:      "2.0.0",
 		PanicIfEmpty: true,
 	}
@@ -938,6 +1015,8 @@ func (p *proxyConfig) init(base *BaseTable) {
 	p.MaxFieldNum = ParamItem{
 		Key:          "proxy.maxFieldNum",
 		DefaultValue: "64",
+		Type:         ParamTypeInt,
+		Min:          "1",
 		Version:      "2.0.0",
 		PanicIfEmpty: true,
 		Doc: `Maximum number of fields in a collection.
@@ -950,6 +1029,8 @@ So adjust at your risk!`,
 	p.MaxShardNum = ParamItem{
 		Key:          "proxy.maxShardNum",
 		DefaultValue: "64",
+		Type:         ParamTypeInt,
+		Min:          "1",
 		Version:      "2.0.0",
 		PanicIfEmpty: true,
 		Doc:          "Maximum number of shards in a collection",
@@ -960,6 +1041,8 @@ So adjust at your risk!`,
 	p.MaxDimension = ParamItem{
 		Key:          "proxy.maxDimension",
 		DefaultValue: "32768",
+		Type:         ParamTypeInt,
+		Min:          "1",
 		Version:      "2.0.0",
 		PanicIfEmpty: true,
 		Doc:          "Maximum dimension of a vector",
@@ -989,6 +1072,8 @@ please adjust in embedded Milvus: false`,
 	p.MaxUserNum = ParamItem{
 		Key:          "proxy.maxUserNum",
 		DefaultValue: "100",
+		Type:         ParamTypeInt,
+		Min:          "1",
 		Version:      "2.0.0",
 		PanicIfEmpty: true,
 	}
@@ -997,6 +1082,8 @@ please adjust in embedded Milvus: false`,
 	p.MaxRoleNum = ParamItem{
 		Key:          "proxy.maxRoleNum",
 		DefaultValue: "10",
+		Type:         ParamTypeInt,
+		Min:          "1",
 		Version:      "2.0.0",
 		PanicIfEmpty: true,
 	}
@@ -1081,6 +1168,107 @@ please adjust in embedded Milvus: false`,
 	}
 	p.AccessLog.RemoteMaxTime.Init(base.mgr)
 
+	p.AccessLog.Formatter = ParamItem{
+		Key:          "proxy.accessLog.formatter",
+		Version:      "2.3.0",
+		DefaultValue: "",
+		Doc: `Template of the access log, e.g. "$user $method $collection $nq $topk $expr $latency",
+leave empty to print the default access log. The fields are $time_now, $time_cost (or $latency), $user, $user_addr,
+$trace_id, $method_name (or $method), $method_status, $error_code, $error_msg, $response_size, $database,
+$collection, $partition, $nq, $topk, $expr and $output_fields.`,
+		Export: true,
+	}
+	p.AccessLog.Formatter.Init(base.mgr)
+
+	p.AccessLog.OutputFormat = ParamItem{
+		Key:          "proxy.accessLog.outputFormat",
+		Version:      "2.3.0",
+		DefaultValue: "text",
+		Doc:          "Output format of the access log, text or json",
+		Export:       true,
+	}
+	p.AccessLog.OutputFormat.Init(base.mgr)
+
+	p.AccessLog.SampleRates = ParamGroup{
+		KeyPrefix: "proxy.accessLog.sampleRates.",
+		Version:   "2.3.0",
+		Doc:       "Sample rate in [0, 1] of the access log per method, e.g. search: 0.1, default: 1. Failed requests are always logged.",
+	}
+	p.AccessLog.SampleRates.Init(base.mgr)
+
+	p.AuditLog.Enable = ParamItem{
+		Key:          "proxy.auditLog.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+		Doc:          "if record the DDL, RBAC, credential, import and delete/upsert operations in the audit log",
+		Export:       true,
+	}
+	p.AuditLog.Enable.Init(base.mgr)
+
+	p.AuditLog.MinioEnable = ParamItem{
+		Key:          "proxy.auditLog.minioEnable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+		Doc:          "if upload sealed audit log file to minio",
+	}
+	p.AuditLog.MinioEnable.Init(base.mgr)
+
+	p.AuditLog.LocalPath = ParamItem{
+		Key:     "proxy.auditLog.localPath",
+		Version: "2.3.0",
+		Export:  true,
+	}
+	p.AuditLog.LocalPath.Init(base.mgr)
+
+	p.AuditLog.Filename = ParamItem{
+		Key:          "proxy.auditLog.filename",
+		Version:      "2.3.0",
+		DefaultValue: "milvus_audit_log.log",
+		Doc:          "Audit log filename, leave empty to print the audit log to stdout.",
+		Export:       true,
+	}
+	p.AuditLog.Filename.Init(base.mgr)
+
+	p.AuditLog.MaxSize = ParamItem{
+		Key:          "proxy.auditLog.maxSize",
+		Version:      "2.3.0",
+		DefaultValue: "64",
+		Doc:          "Max size for a single file, in MB.",
+	}
+	p.AuditLog.MaxSize.Init(base.mgr)
+
+	p.AuditLog.MaxBackups = ParamItem{
+		Key:          "proxy.auditLog.maxBackups",
+		Version:      "2.3.0",
+		DefaultValue: "8",
+		Doc:          "Maximum number of old audit log files to retain.",
+	}
+	p.AuditLog.MaxBackups.Init(base.mgr)
+
+	p.AuditLog.RotatedTime = ParamItem{
+		Key:          "proxy.auditLog.rotatedTime",
+		Version:      "2.3.0",
+		DefaultValue: "3600",
+		Doc:          "Max time for single audit log file in seconds",
+	}
+	p.AuditLog.RotatedTime.Init(base.mgr)
+
+	p.AuditLog.RemotePath = ParamItem{
+		Key:          "proxy.auditLog.remotePath",
+		Version:      "2.3.0",
+		DefaultValue: "audit_log/",
+		Doc:          "File path in minIO",
+	}
+	p.AuditLog.RemotePath.Init(base.mgr)
+
+	p.AuditLog.RemoteMaxTime = ParamItem{
+		Key:          "proxy.auditLog.remoteMaxTime",
+		Version:      "2.3.0",
+		DefaultValue: "720",
+		Doc:          "Max time for audit log file in minIO, in hours",
+	}
+	p.AuditLog.RemoteMaxTime.Init(base.mgr)
+
 	p.ShardLeaderCacheInterval = ParamItem{
 		Key:          "proxy.shardLeaderCacheInterval",
 		Version:      "2.2.4",
@@ -1088,6 +1276,27 @@ please adjust in embedded Milvus: false`,
 		Doc:          "time interval to update shard leader cache, in seconds",
 	}
 	p.ShardLeaderCacheInterval.Init(base.mgr)
+
+	p.SlowQuerySpanInSeconds = ParamItem{
+		Key:          "proxy.slowQuerySpanInSeconds",
+		Version:      "2.3.0",
+		DefaultValue: "5",
+		Type:         ParamTypeFloat,
+		Min:          "0",
+		Doc:          "search and query slower than this are printed in the slow query log with the cost of each stage, in seconds",
+		Export:       true,
+	}
+	p.SlowQuerySpanInSeconds.Init(base.mgr)
+
+	p.IteratorTokenSecret = ParamItem{
+		Key:          "proxy.iteratorTokenSecret",
+		Version:      "2.3.0",
+		DefaultValue: "",
+		Doc: "secret to sign the tokens of query and search iterators, must be the same on all proxies, " +
+			"a random secret is used if empty, with which the tokens are only accepted by the proxy issuing them",
+		Export: true,
+	}
+	p.IteratorTokenSecret.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -1129,6 +1338,10 @@ type queryCoordConfig struct {
 	EnableRGAutoRecover        ParamItem `refreshable:"true"`
 	CheckHealthInterval        ParamItem `refreshable:"false"`
 	CheckHealthRPCTimeout      ParamItem `refreshable:"true"`
+
+	IndexReferenceUpdateInterval ParamItem `refreshable:"false"`
+	IndexCheckInterval           ParamItem `refreshable:"false"`
+	SchemaUpdateInterval         ParamItem `refreshable:"false"`
 }
 
 func (p *queryCoordConfig) init(base *BaseTable) {
@@ -1137,6 +1350,8 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 		Key:          "queryCoord.task.retrynum",
 		Version:      "2.2.0",
 		DefaultValue: "5",
+		Type:         ParamTypeInt,
+		Min:          "0",
 	}
 	p.RetryNum.Init(base.mgr)
 
@@ -1144,6 +1359,8 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 		Key:          "queryCoord.task.retryinterval",
 		Version:      "2.2.0",
 		DefaultValue: strconv.FormatInt(int64(10*time.Second), 10),
+		Type:         ParamTypeInt,
+		Min:          "0",
 	}
 	p.RetryInterval.Init(base.mgr)
 
@@ -1159,6 +1376,8 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 		Key:          "queryCoord.taskExecutionCap",
 		Version:      "2.2.0",
 		DefaultValue: "256",
+		Type:         ParamTypeInt,
+		Min:          "1",
 		Export:       true,
 	}
 	p.TaskExecutionCap.Init(base.mgr)
@@ -1167,6 +1386,7 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 		Key:          "queryCoord.autoHandoff",
 		Version:      "2.0.0",
 		DefaultValue: "true",
+		Type:         ParamTypeBool,
 		PanicIfEmpty: true,
 		Doc:          "Enable auto handoff",
 		Export:       true,
@@ -1177,6 +1397,7 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 		Key:          "queryCoord.autoBalance",
 		Version:      "2.0.0",
 		DefaultValue: "true",
+		Type:         ParamTypeBool,
 		PanicIfEmpty: true,
 		Doc:          "Enable auto balance",
 		Export:       true,
@@ -1187,6 +1408,8 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 		Key:          "queryCoord.balancer",
 		Version:      "2.0.0",
 		DefaultValue: "RowCountBasedBalancer",
+		Type:         ParamTypeString,
+		Enum:         []string{"RoundRobinBalancer", "RowCountBasedBalancer", "ScoreBasedBalancer"},
 		PanicIfEmpty: true,
 		Doc:          "auto balancer used for segments on queryNodes",
 		Export:       true,
@@ -1197,6 +1420,8 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 		Key:          "queryCoord.globalRowCountFactor",
 		Version:      "2.0.0",
 		DefaultValue: "0.1",
+		Type:         ParamTypeFloat,
+		Min:          "0",
 		PanicIfEmpty: true,
 		Doc:          "the weight used when balancing segments among queryNodes",
 		Export:       true,
@@ -1207,6 +1432,8 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 		Key:          "queryCoord.scoreUnbalanceTolerationFactor",
 		Version:      "2.0.0",
 		DefaultValue: "1.3",
+		Type:         ParamTypeFloat,
+		Min:          "0",
 		PanicIfEmpty: true,
 		Doc:          "the largest value for unbalanced extent between from and to nodes when doing balance",
 		Export:       true,
@@ -1217,6 +1444,9 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 		Key:          "queryCoord.overloadedMemoryThresholdPercentage",
 		Version:      "2.0.0",
 		DefaultValue: "90",
+		Type:         ParamTypeFloat,
+		Min:          "0",
+		Max:          "100",
 		PanicIfEmpty: true,
 		Doc:          "The threshold percentage that memory overload",
 		Export:       true,
@@ -1227,6 +1457,8 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 		Key:          "queryCoord.balanceIntervalSeconds",
 		Version:      "2.0.0",
 		DefaultValue: "60",
+		Type:         ParamTypeInt,
+		Min:          "0",
 		PanicIfEmpty: true,
 		Export:       true,
 	}
@@ -1236,6 +1468,9 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 		Key:          "queryCoord.memoryUsageMaxDifferencePercentage",
 		Version:      "2.0.0",
 		DefaultValue: "30",
+		Type:         ParamTypeFloat,
+		Min:          "0",
+		Max:          "100",
 		PanicIfEmpty: true,
 		Export:       true,
 	}
@@ -1245,6 +1480,8 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 		Key:          "queryCoord.checkInterval",
 		Version:      "2.0.0",
 		DefaultValue: "10000",
+		Type:         ParamTypeFloat,
+		Min:          "0",
 		PanicIfEmpty: true,
 		Export:       true,
 	}
@@ -1254,6 +1491,8 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 		Key:          "queryCoord.channelTaskTimeout",
 		Version:      "2.0.0",
 		DefaultValue: "60000",
+		Type:         ParamTypeFloat,
+		Min:          "0",
 		PanicIfEmpty: true,
 		Doc:          "1 minute",
 		Export:       true,
@@ -1264,6 +1503,8 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 		Key:          "queryCoord.segmentTaskTimeout",
 		Version:      "2.0.0",
 		DefaultValue: "120000",
+		Type:         ParamTypeFloat,
+		Min:          "0",
 		PanicIfEmpty: true,
 		Doc:          "2 minute",
 		Export:       true,
@@ -1283,6 +1524,8 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 		Key:          "queryCoord.loadTimeoutSeconds",
 		Version:      "2.0.0",
 		DefaultValue: "600",
+		Type:         ParamTypeFloat,
+		Min:          "0",
 		PanicIfEmpty: true,
 		Export:       true,
 	}
@@ -1292,6 +1535,8 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 		Key:          "queryCoord.heartbeatAvailableInterval",
 		Version:      "2.2.1",
 		DefaultValue: "10000",
+		Type:         ParamTypeFloat,
+		Min:          "0",
 		PanicIfEmpty: true,
 		Doc:          "10s, Only QueryNodes which fetched heartbeats within the duration are available",
 		Export:       true,
@@ -1301,6 +1546,8 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 	p.CheckHandoffInterval = ParamItem{
 		Key:          "queryCoord.checkHandoffInterval",
 		DefaultValue: "5000",
+		Type:         ParamTypeInt,
+		Min:          "0",
 		Version:      "2.2.0",
 		PanicIfEmpty: true,
 		Export:       true,
@@ -1319,6 +1566,8 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 		Key:          "queryCoord.NextTargetSurviveTime",
 		Version:      "2.0.0",
 		DefaultValue: "300",
+		Type:         ParamTypeFloat,
+		Min:          "0",
 		PanicIfEmpty: true,
 	}
 	p.NextTargetSurviveTime.Init(base.mgr)
@@ -1351,6 +1600,7 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 		Key:          "queryCoord.enableRGAutoRecover",
 		Version:      "2.2.3",
 		DefaultValue: "true",
+		Type:         ParamTypeBool,
 		PanicIfEmpty: true,
 	}
 	p.EnableRGAutoRecover.Init(base.mgr)
@@ -1369,11 +1619,46 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 		Key:          "queryCoord.checkHealthRPCTimeout",
 		Version:      "2.2.7",
 		DefaultValue: "100",
+		Type:         ParamTypeInt,
+		Min:          "0",
 		PanicIfEmpty: true,
 		Doc:          "100ms, the timeout of check health rpc to query node",
 		Export:       true,
 	}
 	p.CheckHealthRPCTimeout.Init(base.mgr)
+
+	p.IndexReferenceUpdateInterval = ParamItem{
+		Key:          "queryCoord.indexReferenceUpdateInterval",
+		Version:      "2.3.0",
+		DefaultValue: "10",
+		Type:         ParamTypeInt,
+		Min:          "1",
+		Doc:          "The interval in seconds to save the index builds loaded by querynodes, which are kept by datacoord garbage collection",
+		Export:       true,
+	}
+	p.IndexReferenceUpdateInterval.Init(base.mgr)
+
+	p.IndexCheckInterval = ParamItem{
+		Key:          "queryCoord.indexCheckInterval",
+		Version:      "2.3.0",
+		DefaultValue: "10",
+		Type:         ParamTypeInt,
+		Min:          "1",
+		Doc:          "The interval in seconds to check whether the loaded segment indexes are outdated by rebuilt ones",
+		Export:       true,
+	}
+	p.IndexCheckInterval.Init(base.mgr)
+
+	p.SchemaUpdateInterval = ParamItem{
+		Key:          "queryCoord.schemaUpdateInterval",
+		Version:      "2.3.0",
+		DefaultValue: "10",
+		Type:         ParamTypeInt,
+		Min:          "1",
+		Doc:          "The interval in seconds to check whether the schemas of the loaded collections are altered, the altered ones are pushed to querynodes",
+		Export:       true,
+	}
+	p.SchemaUpdateInterval.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -1408,6 +1693,15 @@ type queryNodeConfig struct {
 	CacheMemoryLimit ParamItem `refreshable:"false"`
 	MmapDirPath      ParamItem `refreshable:"false"`
 
+	// disk cache
+	DiskCacheEnabled  ParamItem `refreshable:"false"`
+	DiskCacheCapacity ParamItem `refreshable:"false"`
+	DiskCacheDirPath  ParamItem `refreshable:"false"`
+
+	// lazy load
+	LazyLoadEnabled     ParamItem `refreshable:"false"`
+	LazyLoadEagerFields ParamItem `refreshable:"false"`
+
 	GroupEnabled         ParamItem `refreshable:"true"`
 	MaxReceiveChanSize   ParamItem `refreshable:"false"`
 	MaxUnsolvedQueueSize ParamItem `refreshable:"true"`
@@ -1428,6 +1722,13 @@ type queryNodeConfig struct {
 
 	// loader
 	IoPoolSize ParamItem `refreshable:"false"`
+
+	// pk index
+	PkIndexType               ParamItem `refreshable:"false"`
+	PkIndexXorFingerprintBits ParamItem `refreshable:"false"`
+
+	// slow query log
+	MaxSlowSegmentNum ParamItem `refreshable:"true"`
 }
 
 func (p *queryNodeConfig) init(base *BaseTable) {
@@ -1460,6 +1761,8 @@ func (p *queryNodeConfig) init(base *BaseTable) {
 		Key:          "queryNode.stats.publishInterval",
 		Version:      "2.0.0",
 		DefaultValue: "1000",
+		Type:         ParamTypeInt,
+		Min:          "1",
 		Doc:          "Interval for querynode to report node information (milliseconds)",
 		Export:       true,
 	}
@@ -1549,6 +1852,8 @@ func (p *queryNodeConfig) init(base *BaseTable) {
 		Key:          "queryNode.loadMemoryUsageFactor",
 		Version:      "2.0.0",
 		DefaultValue: "3",
+		Type:         ParamTypeFloat,
+		Min:          "0",
 		PanicIfEmpty: true,
 		Doc:          "The multiply factor of calculating the memory usage while loading segments",
 		Export:       true,
@@ -1559,6 +1864,9 @@ func (p *queryNodeConfig) init(base *BaseTable) {
 		Key:          "queryCoord.overloadedMemoryThresholdPercentage",
 		Version:      "2.0.0",
 		DefaultValue: "90",
+		Type:         ParamTypeFloat,
+		Min:          "0",
+		Max:          "100",
 		PanicIfEmpty: true,
 		Formatter: func(v string) string {
 			return fmt.Sprintf("%f", getAsFloat(v)/100)
@@ -1592,10 +1900,56 @@ func (p *queryNodeConfig) init(base *BaseTable) {
 	}
 	p.MmapDirPath.Init(base.mgr)
 
+	p.DiskCacheEnabled = ParamItem{
+		Key:          "queryNode.diskCache.enabled",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+		Doc:          "Keep the binlogs downloaded by query node on local disk, so that loading them again is a local read. The sealed segments are loaded onto disk with mmap if memory is not enough",
+		Export:       true,
+	}
+	p.DiskCacheEnabled.Init(base.mgr)
+
+	p.DiskCacheCapacity = ParamItem{
+		Key:          "queryNode.diskCache.capacity",
+		Version:      "2.3.0",
+		DefaultValue: "10737418240",
+		Type:         ParamTypeInt,
+		Min:          "1",
+		Doc:          "10 GB, the max size in bytes of the files kept in disk cache, least recently used files not pinned by loaded segments are evicted",
+		Export:       true,
+	}
+	p.DiskCacheCapacity.Init(base.mgr)
+
+	p.DiskCacheDirPath = ParamItem{
+		Key:          "queryNode.diskCache.dirPath",
+		Version:      "2.3.0",
+		DefaultValue: "",
+		Doc:          "The folder of disk cache, default to disk_cache under localStorage.path",
+	}
+	p.DiskCacheDirPath.Init(base.mgr)
+
+	p.LazyLoadEnabled = ParamItem{
+		Key:          "queryNode.lazyLoad.enabled",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+		Doc:          "Only load the primary key, timestamps, vector fields and indexes of sealed segments eagerly, other scalar fields are loaded the first time a request filters on or outputs them",
+		Export:       true,
+	}
+	p.LazyLoadEnabled.Init(base.mgr)
+
+	p.LazyLoadEagerFields = ParamItem{
+		Key:          "queryNode.lazyLoad.eagerFields",
+		Version:      "2.3.0",
+		DefaultValue: "",
+		Doc:          "Comma separated names of the scalar fields still loaded eagerly when lazy load is enabled",
+	}
+	p.LazyLoadEagerFields.Init(base.mgr)
+
 	p.GroupEnabled = ParamItem{
 		Key:          "queryNode.grouping.enabled",
 		Version:      "2.0.0",
 		DefaultValue: "true",
+		Type:         ParamTypeBool,
 		Export:       true,
 	}
 	p.GroupEnabled.Init(base.mgr)
@@ -1612,6 +1966,9 @@ func (p *queryNodeConfig) init(base *BaseTable) {
 		Key:          "queryNode.scheduler.maxReadConcurrentRatio",
 		Version:      "2.0.0",
 		DefaultValue: "2.0",
+		Type:         ParamTypeFloat,
+		Min:          "0",
+		Max:          "100",
 		Formatter: func(v string) string {
 			ratio := getAsFloat(v)
 			cpuNum := int64(runtime.GOMAXPROCS(0))
@@ -1636,6 +1993,8 @@ Max read concurrency must greater than or equal to 1, and less than or equal to
 		Key:          "queryNode.scheduler.unsolvedQueueSize",
 		Version:      "2.0.0",
 		DefaultValue: "10240",
+		Type:         ParamTypeInt,
+		Min:          "0",
 		Export:       true,
 	}
 	p.MaxUnsolvedQueueSize.Init(base.mgr)
@@ -1644,6 +2003,8 @@ Max read concurrency must greater than or equal to 1, and less than or equal to
 		Key:          "queryNode.grouping.maxNQ",
 		Version:      "2.0.0",
 		DefaultValue: "1000",
+		Type:         ParamTypeInt,
+		Min:          "1",
 		Export:       true,
 	}
 	p.MaxGroupNQ.Init(base.mgr)
@@ -1652,6 +2013,8 @@ Max read concurrency must greater than or equal to 1, and less than or equal to
 		Key:          "queryNode.grouping.topKMergeRatio",
 		Version:      "2.0.0",
 		DefaultValue: "10.0",
+		Type:         ParamTypeFloat,
+		Min:          "0",
 		Export:       true,
 	}
 	p.TopKMergeRatio.Init(base.mgr)
@@ -1660,6 +2023,8 @@ Max read concurrency must greater than or equal to 1, and less than or equal to
 		Key:          "queryNode.scheduler.cpuRatio",
 		Version:      "2.0.0",
 		DefaultValue: "10",
+		Type:         ParamTypeFloat,
+		Min:          "0",
 		Doc:          "ratio used to estimate read task cpu usage.",
 		Export:       true,
 	}
@@ -1669,6 +2034,7 @@ Max read concurrency must greater than or equal to 1, and less than or equal to
 		Key:          "queryNode.enableDisk",
 		Version:      "2.2.0",
 		DefaultValue: "false",
+		Type:         ParamTypeBool,
 		Doc:          "enable querynode load disk index, and search on disk index",
 		Export:       true,
 	}
@@ -1677,6 +2043,8 @@ Max read concurrency must greater than or equal to 1, and less than or equal to
 	p.DiskCapacityLimit = ParamItem{
 		Key:     "LOCAL_STORAGE_SIZE",
 		Version: "2.2.0",
+		Type:    ParamTypeInt,
+		Min:     "0",
 		Formatter: func(v string) string {
 			if len(v) == 0 {
 				diskUsage, err := disk.Usage("/")
@@ -1695,6 +2063,9 @@ Max read concurrency must greater than or equal to 1, and less than or equal to
 		Key:          "queryNode.maxDiskUsagePercentage",
 		Version:      "2.2.0",
 		DefaultValue: "95",
+		Type:         ParamTypeFloat,
+		Min:          "0",
+		Max:          "100",
 		PanicIfEmpty: true,
 		Formatter: func(v string) string {
 			return fmt.Sprintf("%f", getAsFloat(v)/100)
@@ -1707,6 +2078,8 @@ Max read concurrency must greater than or equal to 1, and less than or equal to
 		Key:          "queryNode.scheduler.maxTimestampLag",
 		Version:      "2.2.3",
 		DefaultValue: "86400",
+		Type:         ParamTypeFloat,
+		Min:          "0",
 		Export:       true,
 	}
 	p.MaxTimestampLag.Init(base.mgr)
@@ -1715,6 +2088,7 @@ Max read concurrency must greater than or equal to 1, and less than or equal to
 		Key:          "queryNode.gcenabled",
 		Version:      "2.3.0",
 		DefaultValue: "true",
+		Type:         ParamTypeBool,
 	}
 	p.GCEnabled.Init(base.mgr)
 
@@ -1761,6 +2135,41 @@ Max read concurrency must greater than or equal to 1, and less than or equal to
 		Doc:          "Control how many goroutines will the loader use to pull files, if the given value is non-positive, the value will be set to CpuNum * 8, at least 32, and at most 256",
 	}
 	p.IoPoolSize.Init(base.mgr)
+
+	p.PkIndexType = ParamItem{
+		Key:          "queryNode.pkIndex.type",
+		Version:      "2.3.0",
+		DefaultValue: "bloom_filter",
+		Doc: `How the shard leaders index the primary keys of the sealed segments to route the deletes, could be overridden by the collection property collection.pkIndex.type,
+bloom_filter: the bloom filters in the statslogs,
+xor_filter: the pk ranges in the statslogs and a xor filter built from the pk binlogs, which loads slower`,
+		Export: true,
+	}
+	p.PkIndexType.Init(base.mgr)
+
+	p.PkIndexXorFingerprintBits = ParamItem{
+		Key:          "queryNode.pkIndex.xorFingerprintBits",
+		Version:      "2.3.0",
+		DefaultValue: "8",
+		Type:         ParamTypeInt,
+		Enum:         []string{"8", "16"},
+		Doc: `The fingerprint bits of the xor filters, 8 or 16.
+8: about 1.23 bytes per pk with the false positive rate 1/256, less than the bloom filters which take about 1.38 bytes per pk with the false positive rate 0.005,
+16: about 2.46 bytes per pk with the false positive rate 1/65536`,
+		Export: true,
+	}
+	p.PkIndexXorFingerprintBits.Init(base.mgr)
+
+	p.MaxSlowSegmentNum = ParamItem{
+		Key:          "queryNode.maxSlowSegmentNum",
+		Version:      "2.3.0",
+		DefaultValue: "10",
+		Type:         ParamTypeInt,
+		Min:          "0",
+		Doc:          "the max number of the slowest segments of each shard whose search costs are returned for the slow query log",
+		Export:       true,
+	}
+	p.MaxSlowSegmentNum.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -1771,6 +2180,10 @@ type dataCoordConfig struct {
 	WatchTimeoutInterval         ParamItem `refreshable:"false"`
 	ChannelBalanceSilentDuration ParamItem `refreshable:"true"`
 	ChannelBalanceInterval       ParamItem `refreshable:"true"`
+	ChannelLoadAwareEnable       ParamItem `refreshable:"false"`
+	ChannelLoadCollectInterval   ParamItem `refreshable:"false"`
+	ChannelLoadBalanceCooldown   ParamItem `refreshable:"true"`
+	ChannelLoadImbalanceRatio    ParamItem `refreshable:"true"`
 
 	// --- SEGMENTS ---
 	SegmentMaxSize                 ParamItem `refreshable:"false"`
@@ -1798,6 +2211,8 @@ type dataCoordConfig struct {
 	SingleCompactionExpiredLogMaxSize ParamItem `refreshable:"true"`
 	SingleCompactionDeltalogMaxNum    ParamItem `refreshable:"true"`
 	GlobalCompactionInterval          ParamItem `refreshable:"false"`
+	EnableClusteringCompaction        ParamItem `refreshable:"true"`
+	ClusteringCompactionMaxTotalSize  ParamItem `refreshable:"true"`
 
 	// Garbage Collection
 	EnableGarbageCollection ParamItem `refreshable:"false"`
@@ -1806,6 +2221,12 @@ type dataCoordConfig struct {
 	GCDropTolerance         ParamItem `refreshable:"false"`
 	EnableActiveStandby     ParamItem `refreshable:"false"`
 
+	// export
+	ExportMaxConcurrentTasks ParamItem `refreshable:"false"`
+
+	// index rebuild
+	IndexRebuildCheckInterval ParamItem `refreshable:"false"`
+
 	BindIndexNodeMode          ParamItem `refreshable:"false"`
 	IndexNodeAddress           ParamItem `refreshable:"false"`
 	WithCredential             ParamItem `refreshable:"false"`
@@ -1829,6 +2250,8 @@ func (p *dataCoordConfig) init(base *BaseTable) {
 		Key:          "dataCoord.channel.balanceSilentDuration",
 		Version:      "2.2.3",
 		DefaultValue: "300",
+		Type:         ParamTypeFloat,
+		Min:          "0",
 		Doc:          "The duration after which the channel manager start background channel balancing",
 		Export:       true,
 	}
@@ -1838,11 +2261,56 @@ func (p *dataCoordConfig) init(base *BaseTable) {
 		Key:          "dataCoord.channel.balanceInterval",
 		Version:      "2.2.3",
 		DefaultValue: "360",
+		Type:         ParamTypeFloat,
+		Min:          "0",
 		Doc:          "The interval with which the channel manager check dml channel balance status",
 		Export:       true,
 	}
 	p.ChannelBalanceInterval.Init(base.mgr)
 
+	p.ChannelLoadAwareEnable = ParamItem{
+		Key:          "dataCoord.channel.loadAware.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+		Type:         ParamTypeBool,
+		Doc:          "Assign and balance channels by the insert throughput and buffer size reported by datanodes, instead of the channel number",
+		Export:       true,
+	}
+	p.ChannelLoadAwareEnable.Init(base.mgr)
+
+	p.ChannelLoadCollectInterval = ParamItem{
+		Key:          "dataCoord.channel.loadAware.collectInterval",
+		Version:      "2.3.0",
+		DefaultValue: "30",
+		Type:         ParamTypeInt,
+		Min:          "1",
+		Doc:          "The interval in seconds to collect the channel loads from datanodes",
+		Export:       true,
+	}
+	p.ChannelLoadCollectInterval.Init(base.mgr)
+
+	p.ChannelLoadBalanceCooldown = ParamItem{
+		Key:          "dataCoord.channel.loadAware.balanceCooldown",
+		Version:      "2.3.0",
+		DefaultValue: "1800",
+		Type:         ParamTypeInt,
+		Min:          "0",
+		Doc:          "The duration in seconds a channel moved by balance won't be moved again",
+		Export:       true,
+	}
+	p.ChannelLoadBalanceCooldown.Init(base.mgr)
+
+	p.ChannelLoadImbalanceRatio = ParamItem{
+		Key:          "dataCoord.channel.loadAware.imbalanceRatio",
+		Version:      "2.3.0",
+		DefaultValue: "1.5",
+		Type:         ParamTypeFloat,
+		Min:          "1",
+		Doc:          "Balance the channels of the most loaded datanode if its load exceeds the average by this ratio",
+		Export:       true,
+	}
+	p.ChannelLoadImbalanceRatio.Init(base.mgr)
+
 	p.SegmentMaxSize = ParamItem{
 		Key:          "dataCoord.segment.maxSize",
 		Version:      "2.0.0",
@@ -1856,6 +2324,8 @@ func (p *dataCoordConfig) init(base *BaseTable) {
 		Key:          "dataCoord.segment.diskSegmentMaxSize",
 		Version:      "2.0.0",
 		DefaultValue: "512",
+		Type:         ParamTypeFloat,
+		Min:          "0",
 		Doc:          "Maximun size of a segment in MB for collection which has Disk index",
 		Export:       true,
 	}
@@ -1873,6 +2343,8 @@ func (p *dataCoordConfig) init(base *BaseTable) {
 		Key:          "dataCoord.segment.assignmentExpiration",
 		Version:      "2.0.0",
 		DefaultValue: "2000",
+		Type:         ParamTypeFloat,
+		Min:          "0",
 		Doc:          "The time of the assignment expiration in ms",
 		Export:       true,
 	}
@@ -1930,6 +2402,7 @@ the number of binlog file reaches to max value.`,
 		Key:          "dataCoord.compaction.enableAutoCompaction",
 		Version:      "2.0.0",
 		DefaultValue: "true",
+		Type:         ParamTypeBool,
 		Export:       true,
 	}
 	p.EnableAutoCompaction.Init(base.mgr)
@@ -1938,6 +2411,8 @@ the number of binlog file reaches to max value.`,
 		Key:          "dataCoord.compaction.min.segment",
 		Version:      "2.0.0",
 		DefaultValue: "3",
+		Type:         ParamTypeInt,
+		Min:          "1",
 	}
 	p.MinSegmentToMerge.Init(base.mgr)
 
@@ -1945,6 +2420,8 @@ the number of binlog file reaches to max value.`,
 		Key:          "dataCoord.compaction.max.segment",
 		Version:      "2.0.0",
 		DefaultValue: "30",
+		Type:         ParamTypeInt,
+		Min:          "1",
 	}
 	p.MaxSegmentToMerge.Init(base.mgr)
 
@@ -1952,6 +2429,8 @@ the number of binlog file reaches to max value.`,
 		Key:          "dataCoord.segment.smallProportion",
 		Version:      "2.0.0",
 		DefaultValue: "0.5",
+		Min:          "0",
+		Max:          "1",
 		Doc:          "The segment is considered as \"small segment\" when its # of rows is smaller than",
 		Export:       true,
 	}
@@ -1961,6 +2440,9 @@ the number of binlog file reaches to max value.`,
 		Key:          "dataCoord.segment.compactableProportion",
 		Version:      "2.2.1",
 		DefaultValue: "0.5",
+		Type:         ParamTypeFloat,
+		Min:          "0",
+		Max:          "1",
 		Doc: `(smallProportion * segment max # of rows).
 A compaction will happen on small segments if the segment after compaction will have`,
 		Export: true,
@@ -1971,6 +2453,8 @@ A compaction will happen on small segments if the segment after compaction will
 		Key:          "dataCoord.segment.expansionRate",
 		Version:      "2.2.1",
 		DefaultValue: "1.25",
+		Type:         ParamTypeFloat,
+		Min:          "1",
 		Doc: `over (compactableProportion * segment max # of rows) rows.
 MUST BE GREATER THAN OR EQUAL TO <smallProportion>!!!
 During compaction, the size of segment # of rows is able to exceed segment max # of rows by (expansionRate-1) * 100%. `,
@@ -1982,6 +2466,8 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 		Key:          "dataCoord.compaction.timeout",
 		Version:      "2.0.0",
 		DefaultValue: "300",
+		Type:         ParamTypeInt,
+		Min:          "0",
 	}
 	p.CompactionTimeoutInSeconds.Init(base.mgr)
 
@@ -1996,6 +2482,8 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 		Key:          "dataCoord.compaction.single.ratio.threshold",
 		Version:      "2.0.0",
 		DefaultValue: "0.2",
+		Min:          "0",
+		Max:          "1",
 	}
 	p.SingleCompactionRatioThreshold.Init(base.mgr)
 
@@ -2003,6 +2491,8 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 		Key:          "dataCoord.compaction.single.deltalog.maxsize",
 		Version:      "2.0.0",
 		DefaultValue: strconv.Itoa(2 * 1024 * 1024),
+		Type:         ParamTypeInt,
+		Min:          "0",
 	}
 	p.SingleCompactionDeltaLogMaxSize.Init(base.mgr)
 
@@ -2010,6 +2500,8 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 		Key:          "dataCoord.compaction.single.expiredlog.maxsize",
 		Version:      "2.0.0",
 		DefaultValue: "10485760",
+		Type:         ParamTypeInt,
+		Min:          "0",
 	}
 	p.SingleCompactionExpiredLogMaxSize.Init(base.mgr)
 
@@ -2017,6 +2509,8 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 		Key:          "dataCoord.compaction.single.deltalog.maxnum",
 		Version:      "2.0.0",
 		DefaultValue: "200",
+		Type:         ParamTypeInt,
+		Min:          "0",
 	}
 	p.SingleCompactionDeltalogMaxNum.Init(base.mgr)
 
@@ -2027,6 +2521,27 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.GlobalCompactionInterval.Init(base.mgr)
 
+	p.EnableClusteringCompaction = ParamItem{
+		Key:          "dataCoord.compaction.clustering.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+		Type:         ParamTypeBool,
+		Doc:          "Compact the segments of the collections with a clustering key by ranges of the key, instead of merging them",
+		Export:       true,
+	}
+	p.EnableClusteringCompaction.Init(base.mgr)
+
+	p.ClusteringCompactionMaxTotalSize = ParamItem{
+		Key:          "dataCoord.compaction.clustering.maxTotalSize",
+		Version:      "2.3.0",
+		DefaultValue: "4294967296",
+		Type:         ParamTypeInt,
+		Min:          "1",
+		Doc:          "The max total size in bytes of the segments in one clustering compaction, the datanode sorts them in memory",
+		Export:       true,
+	}
+	p.ClusteringCompactionMaxTotalSize.Init(base.mgr)
+
 	p.EnableGarbageCollection = ParamItem{
 		Key:          "dataCoord.enableGarbageCollection",
 		Version:      "2.0.0",
@@ -2063,6 +2578,28 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.GCDropTolerance.Init(base.mgr)
 
+	p.ExportMaxConcurrentTasks = ParamItem{
+		Key:          "dataCoord.export.maxConcurrentTasks",
+		Version:      "2.3.0",
+		DefaultValue: "2",
+		Type:         ParamTypeInt,
+		Min:          "1",
+		Doc:          "The max number of bulk export tasks running at the same time",
+		Export:       true,
+	}
+	p.ExportMaxConcurrentTasks.Init(base.mgr)
+
+	p.IndexRebuildCheckInterval = ParamItem{
+		Key:          "dataCoord.indexRebuild.checkInterval",
+		Version:      "2.3.0",
+		DefaultValue: "10",
+		Type:         ParamTypeInt,
+		Min:          "1",
+		Doc:          "The interval in seconds to check the progress of index rebuild jobs and recycle the retired index builds",
+		Export:       true,
+	}
+	p.IndexRebuildCheckInterval.Init(base.mgr)
+
 	p.EnableActiveStandby = ParamItem{
 		Key:          "dataCoord.enableActiveStandby",
 		Version:      "2.0.0",
@@ -2075,6 +2612,8 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 		Key:          "indexCoord.segment.minSegmentNumRowsToEnableIndex",
 		Version:      "2.0.0",
 		DefaultValue: "1024",
+		Type:         ParamTypeInt,
+		Min:          "0",
 		Doc:          "It's a threshold. When the segment num rows is less than this value, the segment will not be indexed",
 		Export:       true,
 	}
@@ -2147,6 +2686,12 @@ type dataNodeConfig struct {
 
 	// Skip BF
 	SkipBFStatsLoad ParamItem `refreshable:"true"`
+
+	// write-ahead log
+	WALEnabled     ParamItem `refreshable:"false"`
+	WALDirPath     ParamItem `refreshable:"false"`
+	WALSegmentSize ParamItem `refreshable:"false"`
+	WALSyncOnWrite ParamItem `refreshable:"true"`
 }
 
 func (p *dataNodeConfig) init(base *BaseTable) {
@@ -2173,6 +2718,8 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 		Version:      "2.0.0",
 		FallbackKeys: []string{"DATA_NODE_IBUFSIZE"},
 		DefaultValue: "16777216",
+		Type:         ParamTypeInt,
+		Min:          "1",
 		PanicIfEmpty: true,
 		Doc:          "Max buffer size to flush for a single segment.",
 		Export:       true,
@@ -2183,6 +2730,7 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 		Key:          "datanode.memory.forceSyncEnable",
 		Version:      "2.2.4",
 		DefaultValue: "true",
+		Type:         ParamTypeBool,
 	}
 	p.MemoryForceSyncEnable.Init(base.mgr)
 
@@ -2190,6 +2738,8 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 		Key:          "datanode.memory.forceSyncSegmentNum",
 		Version:      "2.2.4",
 		DefaultValue: "1",
+		Type:         ParamTypeInt,
+		Min:          "1",
 	}
 	p.MemoryForceSyncSegmentNum.Init(base.mgr)
 
@@ -2204,6 +2754,9 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 			Key:          "datanode.memory.watermarkCluster",
 			Version:      "2.2.4",
 			DefaultValue: "0.5",
+			Type:         ParamTypeFloat,
+			Min:          "0",
+			Max:          "1",
 		}
 	} else {
 		log.Warn("DeployModeEnv is not set, use default", zap.Float64("default", 0.5))
@@ -2211,6 +2764,9 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 			Key:          "datanode.memory.watermarkCluster",
 			Version:      "2.2.4",
 			DefaultValue: "0.5",
+			Type:         ParamTypeFloat,
+			Min:          "0",
+			Max:          "1",
 		}
 	}
 	p.MemoryWatermark.Init(base.mgr)
@@ -2219,6 +2775,8 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 		Key:          "dataNode.segment.deleteBufBytes",
 		Version:      "2.0.0",
 		DefaultValue: "67108864",
+		Type:         ParamTypeInt,
+		Min:          "1",
 		Doc:          "Max buffer size to flush del for a single channel",
 		Export:       true,
 	}
@@ -2228,6 +2786,8 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 		Key:          "dataNode.segment.binlog.maxsize",
 		Version:      "2.0.0",
 		DefaultValue: "67108864",
+		Type:         ParamTypeInt,
+		Min:          "1",
 	}
 	p.BinLogMaxSize.Init(base.mgr)
 
@@ -2235,6 +2795,8 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 		Key:          "dataNode.segment.syncPeriod",
 		Version:      "2.0.0",
 		DefaultValue: "600",
+		Type:         ParamTypeFloat,
+		Min:          "0",
 		Doc:          "The period to sync segments if buffer is not empty.",
 		Export:       true,
 	}
@@ -2267,8 +2829,48 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 		Version:      "2.2.5",
 		PanicIfEmpty: false,
 		DefaultValue: "false",
+		Type:         ParamTypeBool,
 	}
 	p.SkipBFStatsLoad.Init(base.mgr)
+
+	p.WALEnabled = ParamItem{
+		Key:          "dataNode.wal.enabled",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+		Type:         ParamTypeBool,
+		Doc:          "Log the consumed dml messages on local disk until they are flushed, and replay them on restart, so that recovery doesn't depend on the retention of message queue",
+		Export:       true,
+	}
+	p.WALEnabled.Init(base.mgr)
+
+	p.WALDirPath = ParamItem{
+		Key:          "dataNode.wal.dirPath",
+		Version:      "2.3.0",
+		DefaultValue: "",
+		Doc:          "The folder of write-ahead logs, default to datanode_wal under localStorage.path",
+	}
+	p.WALDirPath.Init(base.mgr)
+
+	p.WALSegmentSize = ParamItem{
+		Key:          "dataNode.wal.segmentSize",
+		Version:      "2.3.0",
+		DefaultValue: "67108864",
+		Type:         ParamTypeInt,
+		Min:          "1",
+		Doc:          "64 MB, the max size in bytes of a write-ahead log file, the files are removed as a whole once their messages are flushed",
+		Export:       true,
+	}
+	p.WALSegmentSize.Init(base.mgr)
+
+	p.WALSyncOnWrite = ParamItem{
+		Key:          "dataNode.wal.syncOnWrite",
+		Version:      "2.3.0",
+		DefaultValue: "true",
+		Type:         ParamTypeBool,
+		Doc:          "Fsync the write-ahead log after each message pack, otherwise the messages in page cache are lost if the machine crashes",
+		Export:       true,
+	}
+	p.WALSyncOnWrite.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -2305,6 +2907,8 @@ func (p *indexNodeConfig) init(base *BaseTable) {
 	p.DiskCapacityLimit = ParamItem{
 		Key:     "LOCAL_STORAGE_SIZE",
 		Version: "2.2.0",
+		Type:    ParamTypeInt,
+		Min:     "0",
 		Formatter: func(v string) string {
 			if len(v) == 0 {
 				diskUsage, err := disk.Usage("/")
@@ -2323,6 +2927,9 @@ func (p *indexNodeConfig) init(base *BaseTable) {
 		Key:          "indexNode.maxDiskUsagePercentage",
 		Version:      "2.2.0",
 		DefaultValue: "95",
+		Type:         ParamTypeFloat,
+		Min:          "0",
+		Max:          "100",
 		PanicIfEmpty: true,
 		Formatter: func(v string) string {
 			return fmt.Sprintf("%f", getAsFloat(v)/100)
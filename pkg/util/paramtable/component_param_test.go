@@ -124,6 +124,8 @@ func TestComponentParam(t *testing.T) {
 		params.Save("common.security.superUsers", "")
 		assert.Equal(t, []string{""}, Params.SuperUsers.GetAsStrings())
 
+		assert.False(t, Params.ConfigAPIEnabled.GetAsBool())
+
 		assert.Equal(t, false, Params.PreCreatedTopicEnabled.GetAsBool())
 
 		params.Save("common.preCreatedTopic.names", "topic1,topic2,topic3")
@@ -174,7 +176,18 @@ func TestComponentParam(t *testing.T) {
 
 		t.Logf("AccessLog.MaxDays: %d", Params.AccessLog.RotatedTime.GetAsInt64())
 
+		assert.Equal(t, "", Params.AccessLog.Formatter.GetValue())
+		assert.Equal(t, "text", Params.AccessLog.OutputFormat.GetValue())
+		assert.Empty(t, Params.AccessLog.SampleRates.GetValue())
+
+		assert.False(t, Params.AuditLog.Enable.GetAsBool())
+		assert.Equal(t, "milvus_audit_log.log", Params.AuditLog.Filename.GetValue())
+		assert.Equal(t, "audit_log/", Params.AuditLog.RemotePath.GetValue())
+
 		t.Logf("ShardLeaderCacheInterval: %d", Params.ShardLeaderCacheInterval.GetAsInt64())
+
+		assert.Equal(t, 5*time.Second, Params.SlowQuerySpanInSeconds.GetAsDuration(time.Second))
+		assert.Equal(t, "", Params.IteratorTokenSecret.GetValue())
 	})
 
 	// t.Run("test proxyConfig panic", func(t *testing.T) {
@@ -272,6 +285,9 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 3000, checkHealthInterval)
 		checkHealthRPCTimeout := Params.CheckHealthRPCTimeout.GetAsInt()
 		assert.Equal(t, 100, checkHealthRPCTimeout)
+		assert.Equal(t, 10*time.Second, Params.IndexReferenceUpdateInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 10*time.Second, Params.IndexCheckInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 10*time.Second, Params.SchemaUpdateInterval.GetAsDuration(time.Second))
 	})
 
 	t.Run("test queryNodeConfig", func(t *testing.T) {
@@ -304,6 +320,13 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 10.0, Params.TopKMergeRatio.GetAsFloat())
 		assert.Equal(t, 10.0, Params.CPURatio.GetAsFloat())
 		assert.Equal(t, uint32(runtime.GOMAXPROCS(0)*4), Params.KnowhereThreadPoolSize.GetAsUint32())
+		assert.False(t, Params.DiskCacheEnabled.GetAsBool())
+		assert.Equal(t, int64(10737418240), Params.DiskCacheCapacity.GetAsInt64())
+		assert.False(t, Params.LazyLoadEnabled.GetAsBool())
+		assert.Equal(t, "", Params.LazyLoadEagerFields.GetValue())
+		assert.Equal(t, "bloom_filter", Params.PkIndexType.GetValue())
+		assert.Equal(t, 8, Params.PkIndexXorFingerprintBits.GetAsInt())
+		assert.Equal(t, 10, Params.MaxSlowSegmentNum.GetAsInt())
 
 		// test small indexNlist/NProbe default
 		params.Remove("queryNode.segcore.smallIndex.nlist")
@@ -341,6 +364,14 @@ func TestComponentParam(t *testing.T) {
 		assert.True(t, Params.EnableGarbageCollection.GetAsBool())
 		assert.Equal(t, Params.EnableActiveStandby.GetAsBool(), false)
 		t.Logf("dataCoord EnableActiveStandby = %t", Params.EnableActiveStandby.GetAsBool())
+		assert.False(t, Params.EnableClusteringCompaction.GetAsBool())
+		assert.Equal(t, int64(4294967296), Params.ClusteringCompactionMaxTotalSize.GetAsInt64())
+		assert.False(t, Params.ChannelLoadAwareEnable.GetAsBool())
+		assert.Equal(t, 30*time.Second, Params.ChannelLoadCollectInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 1800*time.Second, Params.ChannelLoadBalanceCooldown.GetAsDuration(time.Second))
+		assert.Equal(t, 1.5, Params.ChannelLoadImbalanceRatio.GetAsFloat())
+		assert.Equal(t, 2, Params.ExportMaxConcurrentTasks.GetAsInt())
+		assert.Equal(t, 10*time.Second, Params.IndexRebuildCheckInterval.GetAsDuration(time.Second))
 	})
 
 	t.Run("test dataNodeConfig", func(t *testing.T) {
@@ -363,6 +394,11 @@ func TestComponentParam(t *testing.T) {
 		period := Params.SyncPeriod
 		t.Logf("SyncPeriod: %v", period)
 		assert.Equal(t, 10*time.Minute, Params.SyncPeriod.GetAsDuration(time.Second))
+
+		assert.False(t, Params.WALEnabled.GetAsBool())
+		assert.Equal(t, "", Params.WALDirPath.GetValue())
+		assert.Equal(t, int64(64*1024*1024), Params.WALSegmentSize.GetAsInt64())
+		assert.True(t, Params.WALSyncOnWrite.GetAsBool())
 	})
 
 	t.Run("test indexNodeConfig", func(t *testing.T) {
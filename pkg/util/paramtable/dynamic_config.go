@@ -0,0 +1,148 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package paramtable
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/config"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+var (
+	ErrNotDynamicConfig = errors.New("not a dynamic config")
+	ErrNoEtcdSource     = errors.New("etcd config source is not available")
+)
+
+// GetDynamicConfigs returns the values of the refreshable configs, all of them if no key is given.
+func (p *ComponentParam) GetDynamicConfigs(keys ...string) (map[string]string, error) {
+	items := p.dynamicItems()
+	configs := make(map[string]string)
+	if len(keys) == 0 {
+		for key, item := range items {
+			configs[key] = item.GetValue()
+		}
+		return configs, nil
+	}
+	for _, key := range keys {
+		item, err := getDynamicItem(items, key)
+		if err != nil {
+			return nil, err
+		}
+		configs[item.Key] = item.GetValue()
+	}
+	return configs, nil
+}
+
+// SetDynamicConfig validates the value and persists it into etcd,
+// the config is refreshed on all the nodes watching etcd.
+func (p *ComponentParam) SetDynamicConfig(ctx context.Context, key, value, operator, address string) error {
+	item, err := getDynamicItem(p.dynamicItems(), key)
+	if err != nil {
+		return err
+	}
+	if err := item.Validate(value); err != nil {
+		return errors.Wrapf(config.ErrInvalidValue, "%s: %s", item.Key, err.Error())
+	}
+	if p.etcdSource == nil {
+		return ErrNoEtcdSource
+	}
+	audit := &config.Audit{
+		Key:      item.Key,
+		Action:   config.AuditActionSet,
+		OldValue: item.GetValue(),
+		NewValue: value,
+		Operator: operator,
+		Address:  address,
+		Time:     time.Now(),
+	}
+	if err := p.etcdSource.UpdateConfig(ctx, item.Key, value, audit); err != nil {
+		return err
+	}
+	log.Info("dynamic config changed", zap.Any("audit", audit))
+	return nil
+}
+
+// ResetDynamicConfig removes the value persisted in etcd,
+// the config falls back to the value from other sources.
+func (p *ComponentParam) ResetDynamicConfig(ctx context.Context, key, operator, address string) error {
+	item, err := getDynamicItem(p.dynamicItems(), key)
+	if err != nil {
+		return err
+	}
+	if p.etcdSource == nil {
+		return ErrNoEtcdSource
+	}
+	audit := &config.Audit{
+		Key:      item.Key,
+		Action:   config.AuditActionReset,
+		OldValue: item.GetValue(),
+		Operator: operator,
+		Address:  address,
+		Time:     time.Now(),
+	}
+	if err := p.etcdSource.RemoveConfig(ctx, item.Key, audit); err != nil {
+		return err
+	}
+	log.Info("dynamic config reset", zap.Any("audit", audit))
+	return nil
+}
+
+// ListConfigAudits returns the latest changes of dynamic configs, newest first.
+func (p *ComponentParam) ListConfigAudits(ctx context.Context, limit int) ([]*config.Audit, error) {
+	if p.etcdSource == nil {
+		return nil, ErrNoEtcdSource
+	}
+	return p.etcdSource.ListAudits(ctx, limit)
+}
+
+// dynamicItems collects the param items tagged with refreshable:"true",
+// items without a key are derived from other params and can't be set on their own.
+func (p *ComponentParam) dynamicItems() map[string]*ParamItem {
+	items := make(map[string]*ParamItem)
+	collectDynamicItems(reflect.ValueOf(p).Elem(), items)
+	return items
+}
+
+func collectDynamicItems(val reflect.Value, items map[string]*ParamItem) {
+	for i := 0; i < val.NumField(); i++ {
+		field := val.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if field.Type == reflect.TypeOf(ParamItem{}) {
+			item := val.Field(i).Addr().Interface().(*ParamItem)
+			if _, ok := items[item.Key]; !ok && item.Key != "" && field.Tag.Get("refreshable") == "true" {
+				items[item.Key] = item
+			}
+			continue
+		}
+		if field.Type.Kind() == reflect.Struct {
+			collectDynamicItems(val.Field(i), items)
+		}
+	}
+}
+
+func getDynamicItem(items map[string]*ParamItem, key string) (*ParamItem, error) {
+	for itemKey, item := range items {
+		if strings.EqualFold(itemKey, key) {
+			return item, nil
+		}
+	}
+	return nil, errors.Wrap(ErrNotDynamicConfig, key)
+}
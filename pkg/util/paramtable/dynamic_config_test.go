@@ -0,0 +1,74 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package paramtable
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/config"
+)
+
+func TestDynamicConfig(t *testing.T) {
+	params := &ComponentParam{}
+	params.Init()
+	ctx := context.Background()
+
+	configs, err := params.GetDynamicConfigs()
+	assert.NoError(t, err)
+	assert.Contains(t, configs, params.CommonCfg.RetentionDuration.Key)
+	assert.NotContains(t, configs, params.CommonCfg.ClusterPrefix.Key)
+
+	configs, err = params.GetDynamicConfigs("COMMON.retentionDuration")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		params.CommonCfg.RetentionDuration.Key: params.CommonCfg.RetentionDuration.GetValue(),
+	}, configs)
+
+	_, err = params.GetDynamicConfigs(params.CommonCfg.ClusterPrefix.Key)
+	assert.ErrorIs(t, err, ErrNotDynamicConfig)
+
+	err = params.SetDynamicConfig(ctx, params.CommonCfg.ClusterPrefix.Key, "prefix", "root", "")
+	assert.ErrorIs(t, err, ErrNotDynamicConfig)
+	err = params.SetDynamicConfig(ctx, params.CommonCfg.RetentionDuration.Key, "-1", "root", "")
+	assert.ErrorIs(t, err, config.ErrInvalidValue)
+	err = params.ResetDynamicConfig(ctx, params.CommonCfg.ClusterPrefix.Key, "root", "")
+	assert.ErrorIs(t, err, ErrNotDynamicConfig)
+
+	// no etcd source in unittest
+	params.etcdSource = nil
+	err = params.SetDynamicConfig(ctx, params.CommonCfg.RetentionDuration.Key, "10", "root", "")
+	assert.ErrorIs(t, err, ErrNoEtcdSource)
+	err = params.ResetDynamicConfig(ctx, params.CommonCfg.RetentionDuration.Key, "root", "")
+	assert.ErrorIs(t, err, ErrNoEtcdSource)
+	_, err = params.ListConfigAudits(ctx, 10)
+	assert.ErrorIs(t, err, ErrNoEtcdSource)
+}
+
+func TestDynamicConfigSchema(t *testing.T) {
+	params := &ComponentParam{}
+	params.Init()
+
+	for key, item := range params.dynamicItems() {
+		assert.True(t, item.hasSchema(), key)
+		value, err := item.manager.GetConfig(key)
+		if err != nil {
+			value = item.DefaultValue
+		}
+		// an empty value means the param is not set
+		if value != "" {
+			assert.NoError(t, item.Validate(value), key)
+		}
+	}
+}
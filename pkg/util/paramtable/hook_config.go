@@ -36,6 +36,7 @@ func (h *hookConfig) init(base *BaseTable) {
 	h.QueryNodePluginConfig = ParamItem{
 		Key:     "autoindex.params.search",
 		Version: "2.3.0",
+		Type:    ParamTypeString,
 	}
 	h.QueryNodePluginConfig.Init(base.mgr)
 }
@@ -16,10 +16,22 @@ import (
 	"strings"
 	"time"
 
+	"github.com/samber/lo"
+
 	"github.com/milvus-io/milvus/pkg/config"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 )
 
+// ParamType is the type of the value of a ParamItem
+type ParamType string
+
+const (
+	ParamTypeString ParamType = "string"
+	ParamTypeBool   ParamType = "bool"
+	ParamTypeInt    ParamType = "int"
+	ParamTypeFloat  ParamType = "float"
+)
+
 type ParamItem struct {
 	Key          string // which should be named as "A.B.C"
 	Version      string
@@ -29,6 +41,13 @@ type ParamItem struct {
 	PanicIfEmpty bool
 	Export       bool
 
+	// schema of the value, only the params declaring any of them are validated and every refreshable param must declare one,
+	// Min and Max are inclusive and only apply to numeric types, which are float if Type is not set.
+	Type ParamType
+	Min  string
+	Max  string
+	Enum []string
+
 	Formatter func(originValue string) string
 	Forbidden bool
 
@@ -40,6 +59,62 @@ func (pi *ParamItem) Init(manager *config.Manager) {
 	if pi.Forbidden {
 		pi.manager.ForbidUpdate(pi.Key)
 	}
+	if !pi.hasSchema() {
+		return
+	}
+	pi.manager.RegisterValidator(pi.Key, pi.Validate)
+	for _, key := range pi.FallbackKeys {
+		pi.manager.RegisterValidator(key, pi.Validate)
+	}
+}
+
+func (pi *ParamItem) hasSchema() bool {
+	return pi.Type != "" || pi.Min != "" || pi.Max != "" || len(pi.Enum) > 0
+}
+
+// GetType returns the declared type of the value,
+// which is float if only the range is declared and string if nothing is declared.
+func (pi *ParamItem) GetType() ParamType {
+	if pi.Type != "" {
+		return pi.Type
+	}
+	if pi.Min != "" || pi.Max != "" {
+		return ParamTypeFloat
+	}
+	return ParamTypeString
+}
+
+// Validate checks whether the value matches the schema of the param
+func (pi *ParamItem) Validate(value string) error {
+	if len(pi.Enum) > 0 && !lo.Contains(pi.Enum, value) {
+		return fmt.Errorf("%s should be one of %v", value, pi.Enum)
+	}
+
+	var number float64
+	var err error
+	switch pi.GetType() {
+	case ParamTypeBool:
+		_, err = strconv.ParseBool(value)
+		return err
+	case ParamTypeInt:
+		var v int64
+		v, err = strconv.ParseInt(value, 10, 64)
+		number = float64(v)
+	case ParamTypeFloat:
+		number, err = strconv.ParseFloat(value, 64)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if pi.Min != "" && number < getAsFloat(pi.Min) {
+		return fmt.Errorf("%s should not be less than %s", value, pi.Min)
+	}
+	if pi.Max != "" && number > getAsFloat(pi.Max) {
+		return fmt.Errorf("%s should not be greater than %s", value, pi.Max)
+	}
+	return nil
 }
 
 // Get original value with error
@@ -0,0 +1,74 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package paramtable
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/config"
+)
+
+func TestParamItem_Validate(t *testing.T) {
+	t.Run("declared type", func(t *testing.T) {
+		assert.Equal(t, ParamTypeBool, (&ParamItem{DefaultValue: "true", Type: ParamTypeBool}).GetType())
+		assert.Equal(t, ParamTypeFloat, (&ParamItem{DefaultValue: "0.5", Min: "0"}).GetType())
+		// types are not inferred from the default value
+		assert.Equal(t, ParamTypeString, (&ParamItem{DefaultValue: "true"}).GetType())
+		assert.Equal(t, ParamTypeString, (&ParamItem{DefaultValue: "1"}).GetType())
+		assert.Equal(t, ParamTypeString, (&ParamItem{}).GetType())
+
+		item := &ParamItem{DefaultValue: "false", Type: ParamTypeBool}
+		assert.NoError(t, item.Validate("true"))
+		assert.Error(t, item.Validate("yes"))
+
+		item = &ParamItem{DefaultValue: "10", Min: "0"}
+		assert.NoError(t, item.Validate("0.5"))
+		assert.Error(t, item.Validate("ten"))
+
+		item = &ParamItem{DefaultValue: "10"}
+		assert.NoError(t, item.Validate("ten"))
+	})
+
+	t.Run("range", func(t *testing.T) {
+		item := &ParamItem{DefaultValue: "10", Type: ParamTypeInt, Min: "1", Max: "100"}
+		assert.NoError(t, item.Validate("1"))
+		assert.NoError(t, item.Validate("100"))
+		assert.Error(t, item.Validate("0"))
+		assert.Error(t, item.Validate("101"))
+		assert.Error(t, item.Validate("1.5"))
+	})
+
+	t.Run("enum", func(t *testing.T) {
+		item := &ParamItem{DefaultValue: "text", Enum: []string{"text", "json"}}
+		assert.NoError(t, item.Validate("json"))
+		assert.Error(t, item.Validate("xml"))
+	})
+
+	t.Run("validated by manager", func(t *testing.T) {
+		manager := config.NewManager()
+		item := &ParamItem{Key: "test.validate", DefaultValue: "10", Type: ParamTypeInt, Min: "1"}
+		item.Init(manager)
+
+		assert.ErrorIs(t, manager.SetConfig("test.validate", "0"), config.ErrInvalidValue)
+		assert.Equal(t, 10, item.GetAsInt())
+		assert.NoError(t, manager.SetConfig("test.validate", "20"))
+		assert.Equal(t, 20, item.GetAsInt())
+
+		// params without schema are not validated
+		item = &ParamItem{Key: "test.noschema", DefaultValue: "10"}
+		item.Init(manager)
+		assert.NoError(t, manager.SetConfig("test.noschema", "ten"))
+		assert.Equal(t, "ten", item.GetValue())
+	})
+}
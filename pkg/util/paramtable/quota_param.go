@@ -137,6 +137,7 @@ seconds, (0 ~ 65536)`,
 		Key:          "quotaAndLimits.ddl.enabled",
 		Version:      "2.2.0",
 		DefaultValue: "false",
+		Type:         ParamTypeBool,
 		Export:       true,
 	}
 	p.DDLLimitEnabled.Init(base.mgr)
@@ -145,6 +146,7 @@ seconds, (0 ~ 65536)`,
 		Key:          "quotaAndLimits.ddl.collectionRate",
 		Version:      "2.2.0",
 		DefaultValue: max,
+		Type:         ParamTypeFloat,
 		Formatter: func(v string) string {
 			if !p.DDLLimitEnabled.GetAsBool() {
 				return max
@@ -164,6 +166,7 @@ seconds, (0 ~ 65536)`,
 		Key:          "quotaAndLimits.ddl.partitionRate",
 		Version:      "2.2.0",
 		DefaultValue: max,
+		Type:         ParamTypeFloat,
 		Formatter: func(v string) string {
 			if !p.DDLLimitEnabled.GetAsBool() {
 				return max
@@ -183,6 +186,7 @@ seconds, (0 ~ 65536)`,
 		Key:          "quotaAndLimits.indexRate.enabled",
 		Version:      "2.2.0",
 		DefaultValue: "false",
+		Type:         ParamTypeBool,
 		Export:       true,
 	}
 	p.IndexLimitEnabled.Init(base.mgr)
@@ -191,6 +195,7 @@ seconds, (0 ~ 65536)`,
 		Key:          "quotaAndLimits.indexRate.max",
 		Version:      "2.2.0",
 		DefaultValue: max,
+		Type:         ParamTypeFloat,
 		Formatter: func(v string) string {
 			if !p.IndexLimitEnabled.GetAsBool() {
 				return max
@@ -210,6 +215,7 @@ seconds, (0 ~ 65536)`,
 		Key:          "quotaAndLimits.flushRate.enabled",
 		Version:      "2.2.0",
 		DefaultValue: "false",
+		Type:         ParamTypeBool,
 		Export:       true,
 	}
 	p.FlushLimitEnabled.Init(base.mgr)
@@ -218,6 +224,7 @@ seconds, (0 ~ 65536)`,
 		Key:          "quotaAndLimits.flushRate.max",
 		Version:      "2.2.0",
 		DefaultValue: max,
+		Type:         ParamTypeFloat,
 		Formatter: func(v string) string {
 			if !p.FlushLimitEnabled.GetAsBool() {
 				return max
@@ -237,6 +244,7 @@ seconds, (0 ~ 65536)`,
 		Key:          "quotaAndLimits.compactionRate.enabled",
 		Version:      "2.2.0",
 		DefaultValue: "false",
+		Type:         ParamTypeBool,
 		Export:       true,
 	}
 	p.CompactionLimitEnabled.Init(base.mgr)
@@ -245,6 +253,7 @@ seconds, (0 ~ 65536)`,
 		Key:          "quotaAndLimits.compactionRate.max",
 		Version:      "2.2.0",
 		DefaultValue: max,
+		Type:         ParamTypeFloat,
 		Formatter: func(v string) string {
 			if !p.CompactionLimitEnabled.GetAsBool() {
 				return max
@@ -265,6 +274,7 @@ seconds, (0 ~ 65536)`,
 		Key:          "quotaAndLimits.dml.enabled",
 		Version:      "2.2.0",
 		DefaultValue: "false",
+		Type:         ParamTypeBool,
 		Doc: `dml limit rates, default no limit.
 The maximum rate will not be greater than ` + "max" + `.`,
 		Export: true,
@@ -275,6 +285,7 @@ The maximum rate will not be greater than ` + "max" + `.`,
 		Key:          "quotaAndLimits.dml.insertRate.max",
 		Version:      "2.2.0",
 		DefaultValue: max,
+		Type:         ParamTypeFloat,
 		Formatter: func(v string) string {
 			if !p.DMLLimitEnabled.GetAsBool() {
 				return max
@@ -297,6 +308,7 @@ The maximum rate will not be greater than ` + "max" + `.`,
 		Key:          "quotaAndLimits.dml.insertRate.min",
 		Version:      "2.2.0",
 		DefaultValue: min,
+		Type:         ParamTypeFloat,
 		Formatter: func(v string) string {
 			if !p.DMLLimitEnabled.GetAsBool() {
 				return min
@@ -318,6 +330,7 @@ The maximum rate will not be greater than ` + "max" + `.`,
 		Key:          "quotaAndLimits.dml.deleteRate.max",
 		Version:      "2.2.0",
 		DefaultValue: max,
+		Type:         ParamTypeFloat,
 		Formatter: func(v string) string {
 			if !p.DMLLimitEnabled.GetAsBool() {
 				return max
@@ -341,6 +354,7 @@ The maximum rate will not be greater than ` + "max" + `.`,
 		Key:          "quotaAndLimits.dml.deleteRate.min",
 		Version:      "2.2.0",
 		DefaultValue: min,
+		Type:         ParamTypeFloat,
 		Formatter: func(v string) string {
 			if !p.DMLLimitEnabled.GetAsBool() {
 				return min
@@ -362,6 +376,7 @@ The maximum rate will not be greater than ` + "max" + `.`,
 		Key:          "quotaAndLimits.dml.bulkLoadRate.max",
 		Version:      "2.2.0",
 		DefaultValue: max,
+		Type:         ParamTypeFloat,
 		Formatter: func(v string) string {
 			if !p.DMLLimitEnabled.GetAsBool() {
 				return max
@@ -385,6 +400,7 @@ The maximum rate will not be greater than ` + "max" + `.`,
 		Key:          "quotaAndLimits.dml.bulkLoadRate.min",
 		Version:      "2.2.0",
 		DefaultValue: min,
+		Type:         ParamTypeFloat,
 		Formatter: func(v string) string {
 			if !p.DMLLimitEnabled.GetAsBool() {
 				return min
@@ -407,6 +423,7 @@ The maximum rate will not be greater than ` + "max" + `.`,
 		Key:          "quotaAndLimits.dql.enabled",
 		Version:      "2.2.0",
 		DefaultValue: "false",
+		Type:         ParamTypeBool,
 		Doc: `dql limit rates, default no limit.
 The maximum rate will not be greater than ` + "max" + `.`,
 		Export: true,
@@ -417,6 +434,7 @@ The maximum rate will not be greater than ` + "max" + `.`,
 		Key:          "quotaAndLimits.dql.searchRate.max",
 		Version:      "2.2.0",
 		DefaultValue: max,
+		Type:         ParamTypeFloat,
 		Formatter: func(v string) string {
 			if !p.DQLLimitEnabled.GetAsBool() {
 				return max
@@ -436,6 +454,7 @@ The maximum rate will not be greater than ` + "max" + `.`,
 		Key:          "quotaAndLimits.dql.searchRate.min",
 		Version:      "2.2.0",
 		DefaultValue: min,
+		Type:         ParamTypeFloat,
 		Formatter: func(v string) string {
 			if !p.DQLLimitEnabled.GetAsBool() {
 				return min
@@ -457,6 +476,7 @@ The maximum rate will not be greater than ` + "max" + `.`,
 		Key:          "quotaAndLimits.dql.queryRate.max",
 		Version:      "2.2.0",
 		DefaultValue: max,
+		Type:         ParamTypeFloat,
 		Formatter: func(v string) string {
 			if !p.DQLLimitEnabled.GetAsBool() {
 				return max
@@ -476,6 +496,7 @@ The maximum rate will not be greater than ` + "max" + `.`,
 		Key:          "quotaAndLimits.dql.queryRate.min",
 		Version:      "2.2.0",
 		DefaultValue: min,
+		Type:         ParamTypeFloat,
 		Formatter: func(v string) string {
 			if !p.DQLLimitEnabled.GetAsBool() {
 				return min
@@ -498,6 +519,8 @@ The maximum rate will not be greater than ` + "max" + `.`,
 		Key:          "quotaAndLimits.limits.collection.maxNum",
 		Version:      "2.2.0",
 		DefaultValue: "65535",
+		Type:         ParamTypeInt,
+		Min:          "1",
 	}
 	p.MaxCollectionNum.Init(base.mgr)
 
@@ -506,6 +529,7 @@ The maximum rate will not be greater than ` + "max" + `.`,
 		Key:          "quotaAndLimits.limitWriting.forceDeny",
 		Version:      "2.2.0",
 		DefaultValue: "false",
+		Type:         ParamTypeBool,
 		Doc: `forceDeny ` + "false" + ` means dml requests are allowed (except for some
 specific conditions, such as memory of nodes to water marker), ` + "true" + ` means always reject all dml requests.`,
 		Export: true,
@@ -516,6 +540,7 @@ specific conditions, such as memory of nodes to water marker), ` + "true" + ` me
 		Key:          "quotaAndLimits.limitWriting.ttProtection.enabled",
 		Version:      "2.2.0",
 		DefaultValue: "false",
+		Type:         ParamTypeBool,
 		Export:       true,
 	}
 	p.TtProtectionEnabled.Init(base.mgr)
@@ -525,6 +550,7 @@ specific conditions, such as memory of nodes to water marker), ` + "true" + ` me
 		Key:          "quotaAndLimits.limitWriting.ttProtection.maxTimeTickDelay",
 		Version:      "2.2.0",
 		DefaultValue: defaultMaxTtDelay,
+		Type:         ParamTypeFloat,
 		Formatter: func(v string) string {
 			if !p.TtProtectionEnabled.GetAsBool() {
 				return fmt.Sprintf("%d", math.MaxInt64)
@@ -548,6 +574,7 @@ seconds`,
 		Key:          "quotaAndLimits.limitWriting.memProtection.enabled",
 		Version:      "2.2.0",
 		DefaultValue: "true",
+		Type:         ParamTypeBool,
 		Doc: `When memory usage > memoryHighWaterLevel, all dml requests would be rejected;
 When memoryLowWaterLevel < memory usage < memoryHighWaterLevel, reduce the dml rate;
 When memory usage < memoryLowWaterLevel, no action.`,
@@ -562,6 +589,9 @@ When memory usage < memoryLowWaterLevel, no action.`,
 		Key:          "quotaAndLimits.limitWriting.memProtection.dataNodeMemoryLowWaterLevel",
 		Version:      "2.2.0",
 		DefaultValue: lowWaterLevel,
+		Type:         ParamTypeFloat,
+		Min:          "0",
+		Max:          "1",
 		Formatter: func(v string) string {
 			if !p.MemProtectionEnabled.GetAsBool() {
 				return lowWaterLevel
@@ -582,6 +612,9 @@ When memory usage < memoryLowWaterLevel, no action.`,
 		Key:          "quotaAndLimits.limitWriting.memProtection.dataNodeMemoryHighWaterLevel",
 		Version:      "2.2.0",
 		DefaultValue: highWaterLevel,
+		Type:         ParamTypeFloat,
+		Min:          "0",
+		Max:          "1",
 		Formatter: func(v string) string {
 			if !p.MemProtectionEnabled.GetAsBool() {
 				return "1"
@@ -606,6 +639,9 @@ When memory usage < memoryLowWaterLevel, no action.`,
 		Key:          "quotaAndLimits.limitWriting.memProtection.queryNodeMemoryLowWaterLevel",
 		Version:      "2.2.0",
 		DefaultValue: lowWaterLevel,
+		Type:         ParamTypeFloat,
+		Min:          "0",
+		Max:          "1",
 		Formatter: func(v string) string {
 			if !p.MemProtectionEnabled.GetAsBool() {
 				return lowWaterLevel
@@ -627,6 +663,9 @@ When memory usage < memoryLowWaterLevel, no action.`,
 		Key:          "quotaAndLimits.limitWriting.memProtection.queryNodeMemoryHighWaterLevel",
 		Version:      "2.2.0",
 		DefaultValue: highWaterLevel,
+		Type:         ParamTypeFloat,
+		Min:          "0",
+		Max:          "1",
 		Formatter: func(v string) string {
 			if !p.MemProtectionEnabled.GetAsBool() {
 				return highWaterLevel
@@ -651,6 +690,7 @@ When memory usage < memoryLowWaterLevel, no action.`,
 		Key:          "quotaAndLimits.limitWriting.diskProtection.enabled",
 		Version:      "2.2.0",
 		DefaultValue: "true",
+		Type:         ParamTypeBool,
 		Doc:          "When the total file size of object storage is greater than `diskQuota`, all dml requests would be rejected;",
 		Export:       true,
 	}
@@ -661,6 +701,7 @@ When memory usage < memoryLowWaterLevel, no action.`,
 		Key:          "quotaAndLimits.limitWriting.diskProtection.diskQuota",
 		Version:      "2.2.0",
 		DefaultValue: quota,
+		Type:         ParamTypeFloat,
 		Formatter: func(v string) string {
 			if !p.DiskProtectionEnabled.GetAsBool() {
 				return max
@@ -683,6 +724,7 @@ When memory usage < memoryLowWaterLevel, no action.`,
 		Key:          "quotaAndLimits.limitReading.forceDeny",
 		Version:      "2.2.0",
 		DefaultValue: "false",
+		Type:         ParamTypeBool,
 		Doc: `forceDeny ` + "false" + ` means dql requests are allowed (except for some
 specific conditions, such as collection has been dropped), ` + "true" + ` means always reject all dql requests.`,
 		Export: true,
@@ -693,6 +735,7 @@ specific conditions, such as collection has been dropped), ` + "true" + ` means
 		Key:          "quotaAndLimits.limitReading.queueProtection.enabled",
 		Version:      "2.2.0",
 		DefaultValue: "false",
+		Type:         ParamTypeBool,
 		Export:       true,
 	}
 	p.QueueProtectionEnabled.Init(base.mgr)
@@ -701,6 +744,7 @@ specific conditions, such as collection has been dropped), ` + "true" + ` means
 		Key:          "quotaAndLimits.limitReading.queueProtection.nqInQueueThreshold",
 		Version:      "2.2.0",
 		DefaultValue: strconv.FormatInt(math.MaxInt64, 10),
+		Type:         ParamTypeInt,
 		Formatter: func(v string) string {
 			if !p.QueueProtectionEnabled.GetAsBool() {
 				return strconv.FormatInt(math.MaxInt64, 10)
@@ -724,6 +768,7 @@ int, default no limit`,
 		Key:          "quotaAndLimits.limitReading.queueProtection.queueLatencyThreshold",
 		Version:      "2.2.0",
 		DefaultValue: max,
+		Type:         ParamTypeFloat,
 		Formatter: func(v string) string {
 			if !p.QueueProtectionEnabled.GetAsBool() {
 				return max
@@ -748,6 +793,7 @@ milliseconds, default no limit`,
 		Key:          "quotaAndLimits.limitReading.resultProtection.enabled",
 		Version:      "2.2.0",
 		DefaultValue: "false",
+		Type:         ParamTypeBool,
 		Export:       true,
 	}
 	p.ResultProtectionEnabled.Init(base.mgr)
@@ -756,6 +802,7 @@ milliseconds, default no limit`,
 		Key:          "quotaAndLimits.limitReading.resultProtection.maxReadResultRate",
 		Version:      "2.2.0",
 		DefaultValue: max,
+		Type:         ParamTypeFloat,
 		Formatter: func(v string) string {
 			if !p.ResultProtectionEnabled.GetAsBool() {
 				return max
@@ -783,6 +830,9 @@ MB/s, default no limit`,
 		Key:          "quotaAndLimits.limitReading.coolOffSpeed",
 		Version:      "2.2.0",
 		DefaultValue: defaultSpeed,
+		Type:         ParamTypeFloat,
+		Min:          "0",
+		Max:          "1",
 		Formatter: func(v string) string {
 			// (0, 1]
 			speed := getAsFloat(v)
@@ -271,6 +271,8 @@ func (p *LocalStorageConfig) Init(base *BaseTable) {
 
 type MetaStoreConfig struct {
 	MetaStoreType ParamItem `refreshable:"false"`
+	SessionType   ParamItem `refreshable:"false"`
+	RocksdbPath   ParamItem `refreshable:"false"`
 }
 
 func (p *MetaStoreConfig) Init(base *BaseTable) {
@@ -283,6 +285,27 @@ Valid values: [etcd, mysql]`,
 		Export: true,
 	}
 	p.MetaStoreType.Init(base.mgr)
+
+	p.SessionType = ParamItem{
+		Key:          "metastore.sessionType",
+		Version:      "2.3.0",
+		DefaultValue: util.MetaStoreTypeEtcd,
+		Enum:         []string{util.MetaStoreTypeEtcd, util.MetaStoreTypeRocksdb},
+		Doc: `Where the sessions of the components are kept.
+Valid values: [etcd, rocksdb]
+rocksdb keeps the sessions in an embedded rocksdb at metastore.rocksdbPath, which is only valid for standalone since all the components must run in the same process`,
+		Export: true,
+	}
+	p.SessionType.Init(base.mgr)
+
+	p.RocksdbPath = ParamItem{
+		Key:          "metastore.rocksdbPath",
+		Version:      "2.3.0",
+		DefaultValue: "/var/lib/milvus/rdb_meta",
+		Doc:          "The path of the embedded rocksdb keeping the sessions if metastore.sessionType is rocksdb",
+		Export:       true,
+	}
+	p.RocksdbPath.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -432,6 +455,8 @@ func (p *PulsarConfig) Init(base *BaseTable) {
 		Key:          "pulsar.maxMessageSize",
 		Version:      "2.0.0",
 		DefaultValue: strconv.Itoa(SuggestPulsarMaxMessageSize),
+		Type:         ParamTypeInt,
+		Min:          "1",
 		Doc:          "5 * 1024 * 1024 Bytes, Maximum size of each message in pulsar.",
 		Export:       true,
 	}
@@ -17,6 +17,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus/pkg/config"
+	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 )
 
@@ -134,6 +135,14 @@ func TestServiceParam(t *testing.T) {
 		t.Logf("rocksmq path = %s", Params.Path.GetValue())
 	})
 
+	t.Run("test metaStoreConfig", func(t *testing.T) {
+		Params := &SParams.MetaStoreCfg
+
+		assert.Equal(t, util.MetaStoreTypeEtcd, Params.MetaStoreType.GetValue())
+		assert.Equal(t, util.MetaStoreTypeEtcd, Params.SessionType.GetValue())
+		assert.NotEqual(t, "", Params.RocksdbPath.GetValue())
+	})
+
 	t.Run("test kafkaConfig", func(t *testing.T) {
 		// test default value
 		{
@@ -47,6 +47,11 @@ func NewConcurrentMap[K comparable, V any]() *ConcurrentMap[K, V] {
 	return &ConcurrentMap[K, V]{}
 }
 
+// Len returns the number of keys in the concurrent map
+func (m *ConcurrentMap[K, V]) Len() int {
+	return int(m.len.Load())
+}
+
 func (m *ConcurrentMap[K, V]) Range(f func(key K, value V) bool) {
 	m.inner.Range(func(key, value any) bool {
 		trueKey := key.(K)
@@ -94,6 +94,7 @@ func (suite *MapUtilSuite) TestConcurrentMap() {
 	v, exist = currMap.GetOrInsert(400, "new-v")
 	suite.Equal("new-v", v)
 	suite.Equal(false, exist)
+	suite.Equal(4, currMap.Len())
 
 	currMap.Range(func(k int64, value string) bool {
 		suite.Contains([]int64{100, 200, 300, 400}, k)
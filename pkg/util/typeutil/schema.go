@@ -19,12 +19,14 @@ package typeutil
 import (
 	"fmt"
 	"math"
+	"sort"
 	"strconv"
 
 	"github.com/cockroachdb/errors"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 )
 
@@ -322,6 +324,138 @@ func IsStringType(dataType schemapb.DataType) bool {
 	}
 }
 
+// IsFieldNullable returns true if the field is declared nullable through its type params.
+func IsFieldNullable(field *schemapb.FieldSchema) bool {
+	for _, kv := range field.GetTypeParams() {
+		if kv.GetKey() == common.FieldNullableKey {
+			nullable, err := strconv.ParseBool(kv.GetValue())
+			return err == nil && nullable
+		}
+	}
+	return false
+}
+
+// IsFieldFillable returns true if rows missing the field can be filled by the server,
+// which means the field either has a default value or is nullable.
+func IsFieldFillable(field *schemapb.FieldSchema) bool {
+	return field.GetDefaultValue() != nil || IsFieldNullable(field)
+}
+
+// ValidateDefaultValue checks whether the default value of field matches its data type.
+func ValidateDefaultValue(field *schemapb.FieldSchema) error {
+	defaultValue := field.GetDefaultValue()
+	if defaultValue == nil {
+		return nil
+	}
+	matched := false
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		_, matched = defaultValue.GetData().(*schemapb.ValueField_BoolData)
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		_, matched = defaultValue.GetData().(*schemapb.ValueField_IntData)
+	case schemapb.DataType_Int64:
+		_, matched = defaultValue.GetData().(*schemapb.ValueField_LongData)
+	case schemapb.DataType_Float:
+		_, matched = defaultValue.GetData().(*schemapb.ValueField_FloatData)
+	case schemapb.DataType_Double:
+		_, matched = defaultValue.GetData().(*schemapb.ValueField_DoubleData)
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		_, matched = defaultValue.GetData().(*schemapb.ValueField_StringData)
+	default:
+		return fmt.Errorf("default value is not supported for field type %s", field.GetDataType().String())
+	}
+	if !matched {
+		return fmt.Errorf("type of default value mismatches the field type %s, field: %s",
+			field.GetDataType().String(), field.GetName())
+	}
+	return nil
+}
+
+// GetDefaultValue returns the default value of field as the go type used by storage field data.
+// The zero value of the data type is returned if the field has no default value.
+func GetDefaultValue(field *schemapb.FieldSchema) (interface{}, error) {
+	if err := ValidateDefaultValue(field); err != nil {
+		return nil, err
+	}
+	defaultValue := field.GetDefaultValue()
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		return defaultValue.GetBoolData(), nil
+	case schemapb.DataType_Int8:
+		return int8(defaultValue.GetIntData()), nil
+	case schemapb.DataType_Int16:
+		return int16(defaultValue.GetIntData()), nil
+	case schemapb.DataType_Int32:
+		return defaultValue.GetIntData(), nil
+	case schemapb.DataType_Int64:
+		return defaultValue.GetLongData(), nil
+	case schemapb.DataType_Float:
+		return defaultValue.GetFloatData(), nil
+	case schemapb.DataType_Double:
+		return defaultValue.GetDoubleData(), nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return defaultValue.GetStringData(), nil
+	default:
+		return nil, fmt.Errorf("default value is not supported for field type %s", field.GetDataType().String())
+	}
+}
+
+// GenDefaultFieldData generates a column of numRows rows, all of which are the default value of field.
+func GenDefaultFieldData(field *schemapb.FieldSchema, numRows int) (*schemapb.FieldData, error) {
+	if err := ValidateDefaultValue(field); err != nil {
+		return nil, err
+	}
+	defaultValue := field.GetDefaultValue()
+	scalars := &schemapb.ScalarField{}
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		data := make([]bool, numRows)
+		for i := range data {
+			data[i] = defaultValue.GetBoolData()
+		}
+		scalars.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		data := make([]int32, numRows)
+		for i := range data {
+			data[i] = defaultValue.GetIntData()
+		}
+		scalars.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}
+	case schemapb.DataType_Int64:
+		data := make([]int64, numRows)
+		for i := range data {
+			data[i] = defaultValue.GetLongData()
+		}
+		scalars.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}
+	case schemapb.DataType_Float:
+		data := make([]float32, numRows)
+		for i := range data {
+			data[i] = defaultValue.GetFloatData()
+		}
+		scalars.Data = &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: data}}
+	case schemapb.DataType_Double:
+		data := make([]float64, numRows)
+		for i := range data {
+			data[i] = defaultValue.GetDoubleData()
+		}
+		scalars.Data = &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}}
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		data := make([]string, numRows)
+		for i := range data {
+			data[i] = defaultValue.GetStringData()
+		}
+		scalars.Data = &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}}
+	default:
+		return nil, fmt.Errorf("default value is not supported for field type %s", field.GetDataType().String())
+	}
+
+	return &schemapb.FieldData{
+		Type:      field.GetDataType(),
+		FieldName: field.GetName(),
+		FieldId:   field.GetFieldID(),
+		Field:     &schemapb.FieldData_Scalars{Scalars: scalars},
+	}, nil
+}
+
 // AppendFieldData appends fields data of specified index from src to dst
 func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx int64) {
 	for i, fieldData := range src {
@@ -903,3 +1037,28 @@ func SelectMinPK[T ResultWithID](results []T, cursors []int64) int {
 
 	return sel
 }
+
+// SortByPK sorts the rows of ids and fieldsData by primary key, as SelectMinPK expects sorted results.
+// It returns the sorted copies and the original index of each sorted row,
+// or the inputs themselves and a nil order if they are sorted already.
+func SortByPK(ids *schemapb.IDs, fieldsData []*schemapb.FieldData) (*schemapb.IDs, []*schemapb.FieldData, []int) {
+	order := make([]int, GetSizeOfIDs(ids))
+	for i := range order {
+		order[i] = i
+	}
+	less := func(i, j int) bool {
+		return ComparePKInSlice(ids, order[i], order[j])
+	}
+	if sort.SliceIsSorted(order, less) {
+		return ids, fieldsData, nil
+	}
+	sort.SliceStable(order, less)
+
+	sortedIDs := &schemapb.IDs{}
+	sortedFieldsData := make([]*schemapb.FieldData, len(fieldsData))
+	for _, idx := range order {
+		AppendPKs(sortedIDs, GetPK(ids, int64(idx)))
+		AppendFieldData(sortedFieldsData, fieldsData, int64(idx))
+	}
+	return sortedIDs, sortedFieldsData, order
+}
@@ -733,6 +733,70 @@ func TestGetPrimaryFieldSchema(t *testing.T) {
 	assert.Equal(t, schemapb.DataType_Int64, primaryField.DataType)
 }
 
+func TestDefaultValue(t *testing.T) {
+	t.Run("nullable", func(t *testing.T) {
+		field := &schemapb.FieldSchema{
+			Name:     "field",
+			DataType: schemapb.DataType_Int64,
+		}
+		assert.False(t, IsFieldNullable(field))
+		assert.False(t, IsFieldFillable(field))
+
+		field.TypeParams = []*commonpb.KeyValuePair{{Key: common.FieldNullableKey, Value: "true"}}
+		assert.True(t, IsFieldNullable(field))
+		assert.True(t, IsFieldFillable(field))
+
+		value, err := GetDefaultValue(field)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), value)
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+		field := &schemapb.FieldSchema{
+			Name:         "field",
+			DataType:     schemapb.DataType_Int64,
+			DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "a"}},
+		}
+		assert.Error(t, ValidateDefaultValue(field))
+		_, err := GetDefaultValue(field)
+		assert.Error(t, err)
+		_, err = GenDefaultFieldData(field, 1)
+		assert.Error(t, err)
+
+		field.DataType = schemapb.DataType_FloatVector
+		assert.Error(t, ValidateDefaultValue(field))
+	})
+
+	t.Run("gen field data", func(t *testing.T) {
+		cases := []struct {
+			field    *schemapb.FieldSchema
+			expected interface{}
+		}{
+			{&schemapb.FieldSchema{DataType: schemapb.DataType_Bool, DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_BoolData{BoolData: true}}}, true},
+			{&schemapb.FieldSchema{DataType: schemapb.DataType_Int8, DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 8}}}, int8(8)},
+			{&schemapb.FieldSchema{DataType: schemapb.DataType_Int16, DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 16}}}, int16(16)},
+			{&schemapb.FieldSchema{DataType: schemapb.DataType_Int32, DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 32}}}, int32(32)},
+			{&schemapb.FieldSchema{DataType: schemapb.DataType_Int64, DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 64}}}, int64(64)},
+			{&schemapb.FieldSchema{DataType: schemapb.DataType_Float, DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_FloatData{FloatData: 1.5}}}, float32(1.5)},
+			{&schemapb.FieldSchema{DataType: schemapb.DataType_Double, DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_DoubleData{DoubleData: 2.5}}}, float64(2.5)},
+			{&schemapb.FieldSchema{DataType: schemapb.DataType_VarChar, DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "a"}}}, "a"},
+		}
+		for _, c := range cases {
+			value, err := GetDefaultValue(c.field)
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, value)
+
+			fieldData, err := GenDefaultFieldData(c.field, 3)
+			assert.NoError(t, err)
+			assert.Equal(t, c.field.GetDataType(), fieldData.GetType())
+			for i := 0; i < 3; i++ {
+				data := GetData(fieldData, i)
+				assert.NotNil(t, data)
+			}
+		}
+	})
+}
+
 func TestGetPK(t *testing.T) {
 	type args struct {
 		data *schemapb.IDs
@@ -890,6 +954,37 @@ func TestComparePk(t *testing.T) {
 	assert.False(t, less)
 }
 
+func TestSortByPK(t *testing.T) {
+	ids := &schemapb.IDs{
+		IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"c", "a", "b"}}},
+	}
+	fieldsData := []*schemapb.FieldData{
+		{
+			Type:    schemapb.DataType_Int64,
+			FieldId: 100,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{3, 1, 2}}},
+				},
+			},
+		},
+	}
+
+	sortedIDs, sortedFieldsData, order := SortByPK(ids, fieldsData)
+	assert.Equal(t, []string{"a", "b", "c"}, sortedIDs.GetStrId().GetData())
+	assert.Equal(t, []int64{1, 2, 3}, sortedFieldsData[0].GetScalars().GetLongData().GetData())
+	assert.Equal(t, int64(100), sortedFieldsData[0].GetFieldId())
+	assert.Equal(t, []int{1, 2, 0}, order)
+	// the inputs are left untouched
+	assert.Equal(t, []string{"c", "a", "b"}, ids.GetStrId().GetData())
+
+	// sorted inputs are returned as they are
+	sortedIDs2, sortedFieldsData2, order := SortByPK(sortedIDs, sortedFieldsData)
+	assert.Same(t, sortedIDs, sortedIDs2)
+	assert.Equal(t, sortedFieldsData, sortedFieldsData2)
+	assert.Nil(t, order)
+}
+
 func TestCalcColumnSize(t *testing.T) {
 	fieldValues := map[int64]any{
 		100: []int8{0, 1},
@@ -111,6 +111,7 @@ CREATE TABLE if not exists milvus_meta.`indexes` (
     index_id BIGINT NOT NULL,
     index_name VARCHAR(256),
     index_params VARCHAR(2048),
+    type_params VARCHAR(2048),
     user_index_params VARCHAR(2048),
     is_auto_index BOOL DEFAULT FALSE,
     create_time bigint unsigned,
@@ -118,7 +119,7 @@ CREATE TABLE if not exists milvus_meta.`indexes` (
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
     PRIMARY KEY (id),
-    INDEX idx_tenant_id_collection_id_index_id (tenant_id, collection_id, index_id)
+    UNIQUE KEY uk_tenant_id_collection_id_index_id (tenant_id, collection_id, index_id)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 -- index file paths
@@ -141,21 +142,15 @@ CREATE TABLE if not exists milvus_meta.segments (
     segment_id BIGINT NOT NULL,
     collection_id BIGINT NOT NULL,
     partition_id BIGINT NOT NULL,
-    num_rows BIGINT NOT NULL,
-    max_row_num INT COMMENT 'estimate max rows',
     dm_channel VARCHAR(128) NOT NULL,
-    dml_position TEXT COMMENT 'checkpoint',
-    start_position TEXT,
-    compaction_from VARCHAR(4096) COMMENT 'old segment IDs',
-    created_by_compaction BOOL,
+    num_rows BIGINT NOT NULL,
     segment_state TINYINT UNSIGNED NOT NULL,
-    last_expire_time bigint unsigned COMMENT 'segment assignment expiration time',
-    dropped_at bigint unsigned,
-    is_deleted BOOL DEFAULT FALSE,
+    segment_info MEDIUMBLOB NOT NULL COMMENT 'segment info without binlogs',
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
     PRIMARY KEY (id),
-    INDEX idx_tenant_id_collection_id_segment_id (tenant_id, collection_id, segment_id)
+    UNIQUE KEY uk_tenant_id_segment_id (tenant_id, segment_id),
+    INDEX idx_tenant_id_collection_id_partition_id (tenant_id, collection_id, partition_id)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 -- segment indexes
@@ -165,20 +160,23 @@ CREATE TABLE if not exists milvus_meta.segment_indexes (
     collection_id BIGINT NOT NULL,
     partition_id BIGINT NOT NULL,
     segment_id BIGINT NOT NULL,
-    field_id BIGINT NOT NULL,
+    num_rows BIGINT NOT NULL,
     index_id BIGINT NOT NULL,
-    index_build_id BIGINT,
-    enable_index BOOL NOT NULL,
+    build_id BIGINT NOT NULL,
+    node_id BIGINT NOT NULL,
+    index_version BIGINT NOT NULL,
+    index_state INT NOT NULL,
+    fail_reason VARCHAR(2048),
     create_time bigint unsigned,
     index_file_keys VARCHAR(4096),
     index_size BIGINT UNSIGNED,
-    `version` INT UNSIGNED,
+    index_params VARCHAR(2048) COMMENT 'index params the segment index is built with',
+    rebuild_job_id BIGINT NOT NULL DEFAULT 0,
     is_deleted BOOL DEFAULT FALSE COMMENT 'as mark_deleted',
-    recycled BOOL DEFAULT FALSE COMMENT 'binlog files truly deleted',
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
     PRIMARY KEY (id),
-    UNIQUE KEY uk_tenant_id_segment_id_index_id (tenant_id, segment_id, index_id),
+    UNIQUE KEY uk_tenant_id_build_id (tenant_id, build_id),
     INDEX idx_tenant_id_collection_id_segment_id_index_id (tenant_id, collection_id, segment_id, index_id)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
@@ -186,20 +184,20 @@ CREATE TABLE if not exists milvus_meta.segment_indexes (
 CREATE TABLE if not exists milvus_meta.binlogs (
     id     BIGINT NOT NULL AUTO_INCREMENT,
     tenant_id VARCHAR(128) DEFAULT NULL,
-    field_id BIGINT NOT NULL,
-    segment_id BIGINT NOT NULL,
     collection_id BIGINT NOT NULL,
-    log_type SMALLINT UNSIGNED NOT NULL COMMENT 'binlog、stats binlog、delta binlog',
+    segment_id BIGINT NOT NULL,
+    field_id BIGINT NOT NULL,
+    log_type SMALLINT UNSIGNED NOT NULL COMMENT '1: insert binlog, 2: delta binlog, 3: stats binlog',
+    log_id BIGINT NOT NULL,
     num_entries BIGINT,
     timestamp_from BIGINT UNSIGNED,
     timestamp_to BIGINT UNSIGNED,
     log_path VARCHAR(256) NOT NULL,
     log_size BIGINT NOT NULL,
-    is_deleted BOOL DEFAULT FALSE,
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
     PRIMARY KEY (id),
-    INDEX idx_tenant_id_segment_id_log_type (tenant_id, segment_id, log_type)
+    INDEX idx_tenant_id_segment_id (tenant_id, segment_id)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 -- users
@@ -267,4 +265,78 @@ CREATE TABLE if not exists milvus_meta.grant_id (
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
     INDEX idx_grant_id_tenant_grantor (tenant_id, grant_id, grantor_id, is_deleted),
     PRIMARY KEY (id)
-) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
\ No newline at end of file
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- dm channels
+CREATE TABLE if not exists milvus_meta.dm_channels (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    channel_name VARCHAR(256) NOT NULL,
+    removed BOOL NOT NULL DEFAULT FALSE COMMENT 'channel is waiting for removal',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_channel_name (tenant_id, channel_name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- channel checkpoints
+CREATE TABLE if not exists milvus_meta.channel_checkpoints (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    channel_name VARCHAR(256) NOT NULL,
+    position BLOB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_channel_name (tenant_id, channel_name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- collection load infos
+CREATE TABLE if not exists milvus_meta.collection_load_infos (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    load_info BLOB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_collection_id (tenant_id, collection_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- partition load infos
+CREATE TABLE if not exists milvus_meta.partition_load_infos (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    partition_id BIGINT NOT NULL,
+    load_info BLOB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_collection_id_partition_id (tenant_id, collection_id, partition_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- replicas
+CREATE TABLE if not exists milvus_meta.replicas (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    replica_id BIGINT NOT NULL,
+    replica_info BLOB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_collection_id_replica_id (tenant_id, collection_id, replica_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- resource groups
+CREATE TABLE if not exists milvus_meta.resource_groups (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    name VARCHAR(256) NOT NULL,
+    group_info BLOB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_name (tenant_id, name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,155 @@
+/*
+ upgrade the meta tables created by milvus 2.2 to the schema of milvus 2.3
+
+ Notices:
+    1. segments, segment_indexes and binlogs were never written by 2.2, they are recreated with the new schema.
+    2. dm_channels, channel_checkpoints, collection_load_infos, partition_load_infos, replicas and resource_groups
+       are added for the DataCoord and QueryCoord meta.
+ */
+
+-- indexes
+ALTER TABLE milvus_meta.`indexes` ADD COLUMN type_params VARCHAR(2048) AFTER index_params;
+ALTER TABLE milvus_meta.`indexes` DROP INDEX idx_tenant_id_collection_id_index_id;
+ALTER TABLE milvus_meta.`indexes` ADD UNIQUE KEY uk_tenant_id_collection_id_index_id (tenant_id, collection_id, index_id);
+
+-- segments
+DROP TABLE if exists milvus_meta.segments;
+CREATE TABLE if not exists milvus_meta.segments (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    segment_id BIGINT NOT NULL,
+    collection_id BIGINT NOT NULL,
+    partition_id BIGINT NOT NULL,
+    dm_channel VARCHAR(128) NOT NULL,
+    num_rows BIGINT NOT NULL,
+    segment_state TINYINT UNSIGNED NOT NULL,
+    segment_info MEDIUMBLOB NOT NULL COMMENT 'segment info without binlogs',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_segment_id (tenant_id, segment_id),
+    INDEX idx_tenant_id_collection_id_partition_id (tenant_id, collection_id, partition_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- segment indexes
+DROP TABLE if exists milvus_meta.segment_indexes;
+CREATE TABLE if not exists milvus_meta.segment_indexes (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    partition_id BIGINT NOT NULL,
+    segment_id BIGINT NOT NULL,
+    num_rows BIGINT NOT NULL,
+    index_id BIGINT NOT NULL,
+    build_id BIGINT NOT NULL,
+    node_id BIGINT NOT NULL,
+    index_version BIGINT NOT NULL,
+    index_state INT NOT NULL,
+    fail_reason VARCHAR(2048),
+    create_time bigint unsigned,
+    index_file_keys VARCHAR(4096),
+    index_size BIGINT UNSIGNED,
+    index_params VARCHAR(2048) COMMENT 'index params the segment index is built with',
+    rebuild_job_id BIGINT NOT NULL DEFAULT 0,
+    is_deleted BOOL DEFAULT FALSE COMMENT 'as mark_deleted',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_build_id (tenant_id, build_id),
+    INDEX idx_tenant_id_collection_id_segment_id_index_id (tenant_id, collection_id, segment_id, index_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- binlogs
+DROP TABLE if exists milvus_meta.binlogs;
+CREATE TABLE if not exists milvus_meta.binlogs (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    segment_id BIGINT NOT NULL,
+    field_id BIGINT NOT NULL,
+    log_type SMALLINT UNSIGNED NOT NULL COMMENT '1: insert binlog, 2: delta binlog, 3: stats binlog',
+    log_id BIGINT NOT NULL,
+    num_entries BIGINT,
+    timestamp_from BIGINT UNSIGNED,
+    timestamp_to BIGINT UNSIGNED,
+    log_path VARCHAR(256) NOT NULL,
+    log_size BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    INDEX idx_tenant_id_segment_id (tenant_id, segment_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- dm channels
+CREATE TABLE if not exists milvus_meta.dm_channels (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    channel_name VARCHAR(256) NOT NULL,
+    removed BOOL NOT NULL DEFAULT FALSE COMMENT 'channel is waiting for removal',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_channel_name (tenant_id, channel_name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- channel checkpoints
+CREATE TABLE if not exists milvus_meta.channel_checkpoints (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    channel_name VARCHAR(256) NOT NULL,
+    position BLOB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_channel_name (tenant_id, channel_name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- collection load infos
+CREATE TABLE if not exists milvus_meta.collection_load_infos (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    load_info BLOB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_collection_id (tenant_id, collection_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- partition load infos
+CREATE TABLE if not exists milvus_meta.partition_load_infos (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    partition_id BIGINT NOT NULL,
+    load_info BLOB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_collection_id_partition_id (tenant_id, collection_id, partition_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- replicas
+CREATE TABLE if not exists milvus_meta.replicas (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    replica_id BIGINT NOT NULL,
+    replica_info BLOB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_collection_id_replica_id (tenant_id, collection_id, replica_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- resource groups
+CREATE TABLE if not exists milvus_meta.resource_groups (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    name VARCHAR(256) NOT NULL,
+    group_info BLOB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_name (tenant_id, name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;